@@ -0,0 +1,109 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ambient
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/kclient"
+)
+
+// Enrollment maps the namespaces of the mesh to their dataplane mode, as published by the control plane.
+// A nil Enrollment means none is published, and the dataplane mode is read from the namespace labels.
+type Enrollment map[string]string
+
+// Mode returns the dataplane mode of the namespace.
+func (e Enrollment) Mode(namespace string) string {
+	return e[namespace]
+}
+
+// enrollmentConfigMapName returns the name of the enrollment ConfigMap published by the control plane of the revision.
+func enrollmentConfigMapName(revision string) string {
+	if revision == "" || revision == "default" {
+		return constants.AmbientEnrollmentConfigMapName
+	}
+	return constants.AmbientEnrollmentConfigMapName + "-" + revision
+}
+
+// parseEnrollment returns the Enrollment of the ConfigMap, or nil if it does not exist.
+func parseEnrollment(cm *corev1.ConfigMap) (Enrollment, error) {
+	if cm == nil {
+		return nil, nil
+	}
+	enrollment := Enrollment{}
+	if data := cm.Data[constants.AmbientEnrollmentNamespacesKey]; data != "" {
+		if err := json.Unmarshal([]byte(data), &enrollment); err != nil {
+			return nil, fmt.Errorf("failed to parse %s of ConfigMap %s/%s: %v",
+				constants.AmbientEnrollmentNamespacesKey, cm.Namespace, cm.Name, err)
+		}
+	}
+	return enrollment, nil
+}
+
+// setupEnrollmentHandlers watches the enrollment ConfigMap, reconciling the pods of the node when it changes.
+func (s *Server) setupEnrollmentHandlers(systemNamespace, revision string) {
+	name := enrollmentConfigMapName(revision)
+	s.configmaps = kclient.NewFiltered[*corev1.ConfigMap](s.kubeClient, kclient.Filter{
+		Namespace:     systemNamespace,
+		FieldSelector: "metadata.name=" + name,
+	})
+	s.configmaps.AddEventHandler(controllers.ObjectHandler(func(controllers.Object) {
+		s.updateEnrollment(s.configmaps.Get(name, systemNamespace))
+	}))
+}
+
+func (s *Server) updateEnrollment(cm *corev1.ConfigMap) {
+	enrollment, err := parseEnrollment(cm)
+	if err != nil {
+		// Keep the last known enrollment rather than removing pods from the mesh
+		log.Errorf("ignoring ambient enrollment update: %v", err)
+		return
+	}
+	s.mu.Lock()
+	s.enrollment = enrollment
+	s.mu.Unlock()
+	if enrollment == nil {
+		log.Infof("no ambient enrollment published, using namespace labels")
+	} else {
+		log.Infof("ambient enrollment updated, %d namespaces set a dataplane mode", len(enrollment))
+	}
+	// The CNI plugin reads the enrollment of the new pods from the config file
+	s.UpdateConfig()
+	s.ReconcileNamespaces()
+}
+
+func (s *Server) getEnrollment() Enrollment {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.enrollment
+}
+
+// namespaceMode returns the dataplane mode of the namespace, from the enrollment published by the control plane if
+// any, or else from the namespace labels.
+func (s *Server) namespaceMode(name string) (string, error) {
+	if enrollment := s.getEnrollment(); enrollment != nil {
+		return enrollment.Mode(name), nil
+	}
+	ns := s.namespaces.Get(name, "")
+	if ns == nil {
+		return "", fmt.Errorf("failed to find namespace %v", name)
+	}
+	return ns.GetLabels()[constants.DataplaneMode], nil
+}
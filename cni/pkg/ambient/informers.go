@@ -15,8 +15,6 @@
 package ambient
 
 import (
-	"fmt"
-
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	klabels "k8s.io/apimachinery/pkg/labels"
@@ -25,6 +23,7 @@ import (
 	"istio.io/istio/pkg/config/constants"
 	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/kclient"
+	istiolog "istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/util/sets"
 )
 
@@ -47,7 +46,8 @@ func (s *Server) setupHandlers() {
 			s.EnqueueNamespace(ns)
 		},
 		UpdateFunc: func(oldNs, newNs *corev1.Namespace) {
-			if oldNs.Labels[constants.DataplaneMode] != newNs.Labels[constants.DataplaneMode] {
+			// The labels are ignored when the control plane publishes the enrollment
+			if s.getEnrollment() == nil && oldNs.Labels[constants.DataplaneMode] != newNs.Labels[constants.DataplaneMode] {
 				s.EnqueueNamespace(newNs)
 			}
 		},
@@ -62,7 +62,7 @@ func (s *Server) Run(stop <-chan struct{}) {
 func (s *Server) ReconcileNamespaces() sets.Set[string] {
 	processed := sets.New[string]()
 	for _, ns := range s.namespaces.List(metav1.NamespaceAll, klabels.Everything()) {
-		processed.Merge(s.enqueueNamespace(ns))
+		processed.Merge(s.enqueueNamespace(ns.GetName()))
 	}
 	return processed
 }
@@ -70,14 +70,16 @@ func (s *Server) ReconcileNamespaces() sets.Set[string] {
 // EnqueueNamespace takes a Namespace and enqueues all Pod objects that make need an update
 // TODO it is sort of pointless/confusing/implicit to populate Old and New with the same reference here
 func (s *Server) EnqueueNamespace(o controllers.Object) {
-	s.enqueueNamespace(o)
+	s.enqueueNamespace(o.GetName())
 }
 
-func (s *Server) enqueueNamespace(o controllers.Object) sets.Set[string] {
-	namespace := o.GetName()
-	matchAmbient := o.GetLabels()[constants.DataplaneMode] == constants.DataplaneModeAmbient
+func (s *Server) enqueueNamespace(namespace string) sets.Set[string] {
+	mode, err := s.namespaceMode(namespace)
+	if err != nil {
+		log.Warnf("failed to get dataplane mode of namespace %s: %v", namespace, err)
+	}
 	processed := sets.New[string]()
-	if matchAmbient {
+	if mode == constants.DataplaneModeAmbient {
 		log.Infof("Namespace %s is enabled in ambient mesh", namespace)
 	} else {
 		log.Infof("Namespace %s is disabled from ambient mesh", namespace)
@@ -109,42 +111,51 @@ func (s *Server) Reconcile(input any) error {
 	log.Debugf("reconciling pod")
 	switch event.Event {
 	case controllers.EventAdd:
-		ns := s.namespaces.Get(pod.Namespace, "")
-		if ns == nil {
-			return fmt.Errorf("failed to find namespace %v", ns)
+		mode, err := s.namespaceMode(pod.Namespace)
+		if err != nil {
+			return err
 		}
 		// Typically, a pod Add is handled by the CNI plugin.
 		// But if CNI restarts, we clear the rules, so this can happen due to CNI restart as well
-		if PodRedirectionEnabled(ns, pod) && !IsPodInIpset(pod) {
+		if PodRedirectionEnabled(mode, pod) && !IsPodInIpset(pod) {
 			log.Debugf("Pod added not in ipset, adding")
-			s.AddPodToMesh(pod)
+			s.addPodToMesh(pod, log)
 		}
 	case controllers.EventUpdate:
 		// For update, we just need to handle opt outs
 		newPod := event.New.(*corev1.Pod)
 		oldPod := event.Old.(*corev1.Pod)
-		ns := s.namespaces.Get(newPod.Namespace, "")
-		if ns == nil {
-			return fmt.Errorf("failed to find namespace %v", ns)
+		mode, err := s.namespaceMode(newPod.Namespace)
+		if err != nil {
+			return err
 		}
 		wasEnabled := oldPod.Annotations[constants.AmbientRedirection] == constants.AmbientRedirectionEnabled
-		nowEnabled := PodRedirectionEnabled(ns, newPod)
+		nowEnabled := PodRedirectionEnabled(mode, newPod)
 		if wasEnabled && !nowEnabled {
 			log.Debugf("Pod no longer matches, removing from mesh")
 			s.DelPodFromMesh(newPod, event)
 		} else if !wasEnabled && nowEnabled {
 			log.Debugf("Pod now matches, adding to mesh")
-			s.AddPodToMesh(pod)
+			s.addPodToMesh(pod, log)
 		} else if nowEnabled && !IsPodInIpset(pod) {
 			// This can happen if a node cleanup happens as part of a ztunnel recycle,
 			// cleaning up the node-level ipset with all the pod IPs
 			// If this happens we re-queue everything for reconciliation, and need to
 			// make sure existing pods get re-added to the ipset if they aren't already there.
 			log.Debugf("Pod is enabled but not in ipset, (re)adding to mesh")
-			s.AddPodToMesh(pod)
+			s.addPodToMesh(pod, log)
 		}
 	case controllers.EventDelete:
 		s.DelPodFromMesh(pod, event)
 	}
 	return nil
 }
+
+// addPodToMesh adds the pod to the mesh, unless enrollment is disabled at runtime.
+func (s *Server) addPodToMesh(pod *corev1.Pod, log *istiolog.Scope) {
+	if s.enrollmentDisabled.Load() {
+		log.Infof("ambient enrollment is disabled, not adding pod to mesh")
+		return
+	}
+	s.AddPodToMesh(pod)
+}
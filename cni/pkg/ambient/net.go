@@ -301,9 +301,7 @@ func (s *Server) cleanupPodsEbpfOnNode() error {
 	if s.ebpfServer == nil {
 		return fmt.Errorf("uninitialized ebpf server")
 	}
-	for _, ns := range s.namespaces.List(
-		metav1.NamespaceAll, klabels.Set{pconstants.DataplaneMode: pconstants.DataplaneModeAmbient}.AsSelector()) {
-		namespace := ns.GetName()
+	for _, namespace := range s.ambientNamespaces() {
 		for _, pod := range s.pods.List(namespace, klabels.Everything()) {
 			log.Infof("cleanup Pod %s in %s", pod.Name, namespace)
 			if err := s.delPodEbpfOnNode(pod.Status.PodIP, true); err != nil {
@@ -316,3 +314,21 @@ func (s *Server) cleanupPodsEbpfOnNode() error {
 	}
 	return nil
 }
+
+// ambientNamespaces returns the namespaces in ambient mode.
+func (s *Server) ambientNamespaces() []string {
+	var namespaces []string
+	if enrollment := s.getEnrollment(); enrollment != nil {
+		for ns, mode := range enrollment {
+			if mode == pconstants.DataplaneModeAmbient {
+				namespaces = append(namespaces, ns)
+			}
+		}
+		return namespaces
+	}
+	for _, ns := range s.namespaces.List(
+		metav1.NamespaceAll, klabels.Set{pconstants.DataplaneMode: pconstants.DataplaneModeAmbient}.AsSelector()) {
+		namespaces = append(namespaces, ns.GetName())
+	}
+	return namespaces
+}
@@ -36,6 +36,44 @@ import (
 	"istio.io/istio/pkg/util/sets"
 )
 
+// staleSandboxIPs returns the IPs of the ipset entries of the pod which are not among its current IPs. They were
+// allocated to a previous sandbox of the pod, as a restart of the container runtime can recreate the sandbox, with a
+// new network namespace, while the pod keeps its UID. Entries without comment, on kernels not supporting them, are
+// never considered stale.
+func staleSandboxIPs(entries []netlink.IPSetEntry, uid string, ips []string) []string {
+	if uid == "" {
+		return nil
+	}
+	current := sets.New(ips...)
+	var stale []string
+	for _, e := range entries {
+		if e.Comment == uid && !current.Contains(e.IP.String()) {
+			stale = append(stale, e.IP.String())
+		}
+	}
+	return stale
+}
+
+// ReconcilePodSandbox removes the redirection programmed for a previous sandbox of the pod, returning whether its
+// sandbox changed. The redirection of the current IPs is then programmed again by AddPodToMesh.
+func ReconcilePodSandbox(pod *corev1.Pod, ips []string) bool {
+	entries, err := Ipset.List()
+	if err != nil {
+		log.Errorf("Failed to list ipset entries: %v", err)
+		return false
+	}
+	stale := staleSandboxIPs(entries, string(pod.UID), ips)
+	if len(stale) == 0 {
+		return false
+	}
+	log.Infof("Sandbox of pod '%s/%s' (%s) changed, removing the redirection of its previous IPs %v",
+		pod.Namespace, pod.Name, string(pod.UID), stale)
+	for _, ip := range stale {
+		delIPsetAndRoute(ip)
+	}
+	return true
+}
+
 func IsPodInIpset(pod *corev1.Pod) bool {
 	ipset, err := Ipset.List()
 	if err != nil {
@@ -0,0 +1,65 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ambient
+
+import (
+	"net"
+	"testing"
+
+	"github.com/vishvananda/netlink"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestStaleSandboxIPs(t *testing.T) {
+	entries := []netlink.IPSetEntry{
+		{IP: net.ParseIP("10.0.0.1").To4(), Comment: "pod-a"},
+		{IP: net.ParseIP("10.0.0.2").To4(), Comment: "pod-b"},
+		{IP: net.ParseIP("10.0.0.3").To4()},
+	}
+	cases := []struct {
+		name string
+		uid  string
+		ips  []string
+		want []string
+	}{
+		{
+			name: "new pod",
+			uid:  "pod-c",
+			ips:  []string{"10.0.0.4"},
+		},
+		{
+			name: "same sandbox",
+			uid:  "pod-a",
+			ips:  []string{"10.0.0.1"},
+		},
+		{
+			name: "recreated sandbox",
+			uid:  "pod-a",
+			ips:  []string{"10.0.0.4"},
+			want: []string{"10.0.0.1"},
+		},
+		{
+			name: "entry without comment",
+			uid:  "",
+			ips:  []string{"10.0.0.4"},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, staleSandboxIPs(entries, tt.uid, tt.ips), tt.want)
+		})
+	}
+}
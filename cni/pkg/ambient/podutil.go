@@ -40,9 +40,9 @@ var annotationRemovePatch = []byte(fmt.Sprintf(
 ))
 
 // PodRedirectionEnabled determines if a pod should or should not be configured
-// to have traffic redirected thru the node proxy.
-func PodRedirectionEnabled(namespace *corev1.Namespace, pod *corev1.Pod) bool {
-	if namespace.GetLabels()[constants.DataplaneMode] != constants.DataplaneModeAmbient {
+// to have traffic redirected thru the node proxy, given the dataplane mode of its namespace.
+func PodRedirectionEnabled(namespaceMode string, pod *corev1.Pod) bool {
+	if namespaceMode != constants.DataplaneModeAmbient {
 		// Namespace does not have ambient mode enabled
 		return false
 	}
@@ -21,6 +21,7 @@ import (
 	"net"
 	"os"
 	"sync"
+	"sync/atomic"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,18 +44,28 @@ type Server struct {
 
 	namespaces kclient.Client[*corev1.Namespace]
 	pods       kclient.Client[*corev1.Pod]
+	configmaps kclient.Client[*corev1.ConfigMap]
 
 	mu         sync.RWMutex
 	ztunnelPod *corev1.Pod
+	// enrollment is the dataplane mode of the namespaces published by the control plane, if any
+	enrollment Enrollment
 
 	iptablesCommand lazy.Lazy[string]
 	redirectMode    RedirectMode
 	ebpfServer      *ebpf.RedirectServer
+
+	// enrollmentDisabled is set while adding pods to the mesh is disabled at runtime
+	enrollmentDisabled atomic.Bool
 }
 
 type AmbientConfigFile struct {
 	ZTunnelReady bool   `json:"ztunnelReady"`
 	RedirectMode string `json:"redirectMode"`
+	// EnrollmentDisabled is set while adding pods to the mesh is disabled at runtime
+	EnrollmentDisabled bool `json:"enrollmentDisabled,omitempty"`
+	// Enrollment is the dataplane mode of the namespaces published by the control plane, or null if none is
+	Enrollment Enrollment `json:"enrollment"`
 }
 
 func NewServer(ctx context.Context, args AmbientArgs) (*Server, error) {
@@ -94,6 +105,7 @@ func NewServer(ctx context.Context, args AmbientArgs) (*Server, error) {
 	log.Infof("Ambient enrolled IPs before reconciling: %+v", s.getEnrolledIPSets())
 
 	s.setupHandlers()
+	s.setupEnrollmentHandlers(args.SystemNamespace, args.Revision)
 
 	s.UpdateConfig()
 
@@ -133,6 +145,23 @@ func (s *Server) Start() {
 	}()
 }
 
+// SetEnrollmentEnabled enables or disables adding pods to the mesh. Pods already in the mesh are kept, and pods are
+// still removed from the mesh while disabled. The pods which were not added are added once it is enabled again.
+func (s *Server) SetEnrollmentEnabled(enabled bool) {
+	wasDisabled := s.enrollmentDisabled.Swap(!enabled)
+	if wasDisabled == !enabled {
+		return
+	}
+	// The CNI plugin adds the new pods to the mesh, unless disabled in the config file
+	s.UpdateConfig()
+	if enabled {
+		log.Info("ambient enrollment enabled, reconciling pods")
+		s.ReconcileNamespaces()
+	} else {
+		log.Info("ambient enrollment disabled, pods are no longer added to the mesh")
+	}
+}
+
 func (s *Server) Stop() {
 	log.Info("CNI ambient server terminating, cleaning up node net rules")
 	s.cleanupNode()
@@ -142,8 +171,10 @@ func (s *Server) UpdateConfig() {
 	log.Debug("Generating new ambient config file")
 
 	cfg := &AmbientConfigFile{
-		ZTunnelReady: s.isZTunnelRunning(),
-		RedirectMode: s.redirectMode.String(),
+		ZTunnelReady:       s.isZTunnelRunning(),
+		RedirectMode:       s.redirectMode.String(),
+		EnrollmentDisabled: s.enrollmentDisabled.Load(),
+		Enrollment:         s.getEnrollment(),
 	}
 
 	if err := cfg.write(); err != nil {
@@ -19,7 +19,10 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
@@ -32,11 +35,14 @@ import (
 	udsLog "istio.io/istio/cni/pkg/log"
 	"istio.io/istio/cni/pkg/monitoring"
 	"istio.io/istio/cni/pkg/repair"
+	"istio.io/istio/cni/pkg/runtimeconfig"
 	"istio.io/istio/pkg/cmd"
 	"istio.io/istio/pkg/collateral"
 	"istio.io/istio/pkg/ctrlz"
 	"istio.io/istio/pkg/env"
+	"istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/log"
+	"istio.io/istio/pkg/tracing"
 	"istio.io/istio/pkg/version"
 	iptables "istio.io/istio/tools/istio-iptables/pkg/constants"
 )
@@ -55,6 +61,10 @@ var rootCmd = &cobra.Command{
 	Short:        "Install and configure Istio CNI plugin on a node, detect and repair pod which is broken by race condition.",
 	SilenceUsage: true,
 	PreRunE: func(c *cobra.Command, args []string) error {
+		if dir := viper.GetString(constants.LogPersistDir); dir != "" {
+			logOptions.WithTeeToJSONFile(logPersistPath(dir, ambient.Revision),
+				viper.GetInt(constants.LogPersistMaxSize), viper.GetInt(constants.LogPersistMaxBackups))
+		}
 		if err := log.Configure(logOptions); err != nil {
 			log.Errorf("Failed to configure log %v", err)
 		}
@@ -74,16 +84,37 @@ var rootCmd = &cobra.Command{
 		log.Infof("CNI install configuration: \n%+v", cfg.InstallConfig)
 		log.Infof("CNI race repair configuration: \n%+v", cfg.RepairConfig)
 
+		if cfg.InstallConfig.OneShot {
+			return runOnce(ctx, cfg)
+		}
+
 		// Start metrics server
 		monitoring.SetupMonitoring(cfg.InstallConfig.MonitoringPort, "/metrics", ctx.Done())
 
+		if cfg.RepairConfig.ShadowRulesOnly {
+			// The plugin installed on the node is left alone, as well as the pods: only the rules are compared
+			install.SetReady(install.StartServer())
+			repair.StartShadowEvaluation(ctx, cfg.RepairConfig)
+			<-ctx.Done()
+			return nil
+		}
+
 		// Start UDS log server
 		udsLogger := udsLog.NewUDSLogger()
+		if cfg.InstallConfig.PluginTracingEnabled {
+			shutdown, err := tracing.Initialize()
+			if err != nil {
+				return fmt.Errorf("failed to initialize tracing: %v", err)
+			}
+			defer shutdown()
+			udsLogger.EnablePluginTracing()
+		}
 		if err = udsLogger.StartUDSLogServer(cfg.InstallConfig.LogUDSAddress, ctx.Done()); err != nil {
 			log.Errorf("Failed to start up UDS Log Server: %v", err)
 			return
 		}
 
+		var ambientServer *ambient.Server
 		if cfg.InstallConfig.AmbientEnabled {
 			// Start ambient controller
 			redirectMode := ambient.IptablesMode
@@ -101,13 +132,25 @@ var rootCmd = &cobra.Command{
 			}
 			server.Start()
 			defer server.Stop()
+			ambientServer = server
 		}
 
 		isReady := install.StartServer()
 
-		installer := install.NewInstaller(&cfg.InstallConfig, isReady)
+		installer, err := newInstaller(cfg, isReady)
+		if err != nil {
+			return err
+		}
 
-		repair.StartRepair(ctx, cfg.RepairConfig)
+		// The repair controller is started even if disabled when the runtime flags may enable it
+		repairController := repair.StartRepair(ctx, cfg.RepairConfig, cfg.InstallConfig.RuntimeConfigMap != "")
+		repair.StartShadowEvaluation(ctx, cfg.RepairConfig)
+
+		if cfg.InstallConfig.RuntimeConfigMap != "" {
+			if err = watchRuntimeConfig(ctx, cfg, repairController, ambientServer); err != nil {
+				return
+			}
+		}
 
 		if err = installer.Run(ctx); err != nil {
 			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
@@ -131,6 +174,132 @@ var rootCmd = &cobra.Command{
 	},
 }
 
+// watchRuntimeConfig applies the flags of the runtime ConfigMap to the node agent as they change.
+func watchRuntimeConfig(ctx context.Context, cfg *config.Config, repairController *repair.Controller, ambientServer *ambient.Server) error {
+	restConfig, err := kube.DefaultRestConfig("", "")
+	if err != nil {
+		return fmt.Errorf("failed to create kube config for the runtime flags: %v", err)
+	}
+	client, err := kube.NewClient(kube.NewClientConfigForRestConfig(restConfig), "")
+	if err != nil {
+		return fmt.Errorf("failed to create kube client for the runtime flags: %v", err)
+	}
+	watcher := runtimeconfig.NewWatcher(client, ambient.PodNamespace, cfg.InstallConfig.RuntimeConfigMap, runtimeconfig.Flags{
+		RepairEnabled:            cfg.RepairConfig.Enabled,
+		RepairReconcileInterval:  cfg.RepairConfig.ReconcileInterval,
+		AmbientEnrollmentEnabled: true,
+	})
+	watcher.AddHandler(func(flags runtimeconfig.Flags) {
+		if repairController != nil {
+			repairController.SetEnabled(flags.RepairEnabled)
+			repairController.SetReconcileInterval(flags.RepairReconcileInterval)
+		}
+		if ambientServer != nil {
+			ambientServer.SetEnrollmentEnabled(flags.AmbientEnrollmentEnabled)
+		}
+	})
+	client.RunAndWait(ctx.Done())
+	go watcher.Run(ctx.Done())
+	return nil
+}
+
+// newInstaller returns the installer of the node artifacts, with the options of the configuration. It is shared by the
+// DaemonSet and the one-shot installations.
+func newInstaller(cfg *config.Config, isReady *atomic.Value) (*install.Installer, error) {
+	installer := install.NewInstaller(&cfg.InstallConfig, isReady)
+	if cfg.InstallConfig.KubeconfigVerificationEnabled {
+		installer.SetKubeconfigVerification()
+	}
+	cacheGCEnabled := cfg.InstallConfig.CNICacheGCInterval > 0
+	if cfg.InstallConfig.NodeStatusEnabled || cfg.InstallConfig.MaintenanceWindowAnnotation != "" || cacheGCEnabled ||
+		cfg.InstallConfig.ReconcilePauseEnabled || cfg.InstallConfig.CapabilityDetectionEnabled || cfg.InstallConfig.PodConditionsEnabled {
+		restConfig, err := kube.DefaultRestConfig("", "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kube config for the installer: %v", err)
+		}
+		client, err := kube.NewClient(kube.NewClientConfigForRestConfig(restConfig), "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kube client for the installer: %v", err)
+		}
+		if cfg.InstallConfig.NodeStatusEnabled {
+			installer.SetNodeStatusReporter(install.NewNodeStatusReporter(client.Dynamic(),
+				cfg.InstallConfig.K8sNodeName, cfg.InstallConfig.Revision))
+		}
+		if cfg.InstallConfig.MaintenanceWindowAnnotation != "" {
+			installer.SetMaintenanceWindow(install.NewNodeAnnotationMaintenanceWindow(
+				client.Kube(), cfg.InstallConfig.K8sNodeName, cfg.InstallConfig.MaintenanceWindowAnnotation))
+		}
+		if cacheGCEnabled {
+			installer.SetCNICacheGC(client.Kube())
+		}
+		if cfg.InstallConfig.ReconcilePauseEnabled {
+			installer.SetReconcilePause(install.NewNodeAnnotationReconcilePause(client.Kube(), cfg.InstallConfig.K8sNodeName))
+		}
+		if cfg.InstallConfig.CapabilityDetectionEnabled {
+			installer.SetCapabilityDetection(client.Kube().Discovery())
+		}
+		if cfg.InstallConfig.PodConditionsEnabled {
+			if cfg.InstallConfig.PodName == "" || cfg.InstallConfig.PodNamespace == "" {
+				return nil, fmt.Errorf("%s requires the POD_NAME and POD_NAMESPACE environment variables", constants.PodConditionsEnabled)
+			}
+			installer.SetPodConditionReporter(install.NewPodConditionReporter(
+				client.Kube(), cfg.InstallConfig.PodNamespace, cfg.InstallConfig.PodName))
+		}
+	}
+	return installer, nil
+}
+
+// runOnce installs the artifacts on the node, verifies them and returns, for the nodes where the installer is run by a
+// privileged Job or init container rather than a DaemonSet. Only the installer runs: the race repair, the ambient node
+// agent and the UDS log server need a long-running pod.
+func runOnce(ctx context.Context, cfg *config.Config) error {
+	if cfg.InstallConfig.AmbientEnabled {
+		return fmt.Errorf("%s is not supported with ambient, which needs a long-running node agent", constants.OneShot)
+	}
+	isReady := &atomic.Value{}
+	isReady.Store(false)
+	installer, err := newInstaller(cfg, isReady)
+	if err != nil {
+		return err
+	}
+	if err := installer.RunOnce(ctx); err != nil {
+		log.Errorf("one-shot installation failed: %v", err)
+		return err
+	}
+	return nil
+}
+
+var (
+	verifyBinDirs     []string
+	verifyCredentials bool
+)
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the Istio CNI plugin installed on the node, without modifying it.",
+	Long: "Verify the Istio CNI plugin installed on the node, without modifying it: the artifacts are claimed by the " +
+		"revision, the istio-cni binary is installed, the CNI config file invokes the plugin and the API server accepts the " +
+		"credentials of its kubeconfig. " +
+		"It is meant to be run on the node after a one-shot installation, with the paths of the node.",
+	SilenceUsage: true,
+	RunE: func(c *cobra.Command, args []string) error {
+		cfg, err := constructConfig()
+		if err != nil {
+			return err
+		}
+		cfg.InstallConfig.CNIBinTargetDirs = verifyBinDirs
+		var verifyKubeconfig func(ctx context.Context, kubeconfigFilepath string) error
+		if verifyCredentials {
+			verifyKubeconfig = install.VerifyKubeconfigCredentials
+		}
+		if err := install.Verify(c.Context(), &cfg.InstallConfig, verifyKubeconfig); err != nil {
+			return fmt.Errorf("istio-cni installation is invalid:\n%v", err)
+		}
+		_, _ = fmt.Fprintln(c.OutOrStdout(), "istio-cni installation verified")
+		return nil
+	},
+}
+
 // GetCommand returns the main cobra.Command object for this application
 func GetCommand() *cobra.Command {
 	return rootCmd
@@ -144,6 +313,11 @@ func init() {
 	ctrlzOptions.AttachCobraFlags(rootCmd)
 
 	rootCmd.AddCommand(version.CobraCommand())
+	rootCmd.AddCommand(verifyCmd)
+	verifyCmd.Flags().StringSliceVar(&verifyBinDirs, "cni-bin-dirs", []string{constants.HostCNIBinDir, constants.SecondaryBinDir},
+		"Directories where the CNI binaries are installed. The directories missing from the node are not checked")
+	verifyCmd.Flags().BoolVar(&verifyCredentials, "verify-credentials", true,
+		"Whether to verify that the API server accepts the credentials of the kubeconfig of the plugin")
 	rootCmd.AddCommand(collateral.CobraCommand(rootCmd, &doc.GenManHeader{
 		Title:   "Istio CNI Plugin Installer",
 		Section: "install-cni CLI",
@@ -158,6 +332,7 @@ func init() {
 
 	// Not configurable in CNI helm charts
 	registerStringParameter(constants.MountedCNINetDir, "/host/etc/cni/net.d", "Directory on the container where CNI networks are installed")
+	registerStringParameter(constants.CNICacheDir, "/host/var/lib/cni", "Directory on the container where the CNI cache of the container runtime is mounted")
 	registerStringParameter(constants.CNINetworkConfigFile, "", "CNI config template as a file")
 	registerStringParameter(constants.KubeconfigFilename, "ZZZ-istio-cni-kubeconfig",
 		"Name of the kubeconfig file which CNI plugin will use when interacting with API server")
@@ -169,6 +344,48 @@ func init() {
 	registerStringParameter(constants.LogUDSAddress, "/var/run/istio-cni/log.sock", "The UDS server address which CNI plugin will copy log output to")
 	registerBooleanParameter(constants.AmbientEnabled, false, "Whether ambient controller is enabled")
 	registerBooleanParameter(constants.EbpfEnabled, false, "Whether ebpf redirection is enabled")
+	registerBooleanParameter(constants.NodeStatusEnabled, false, "Whether to publish an IstioCNINodeStatus summarizing the installation on the node")
+	registerBooleanParameter(constants.PluginTracingEnabled, false,
+		"Whether to emit OpenTelemetry spans for the CNI plugin invocations reporting their timing (plugin_timing in the CNI config), "+
+			"configured by the OTEL_* environment variables")
+	registerStringParameter(constants.MaintenanceWindowAnnotation, "",
+		"If set, replacing the CNI config file or binaries is deferred until the node has this annotation set to true. "+
+			"Other changes, such as refreshing the kubeconfig, are made immediately")
+	registerBooleanParameter(constants.ReconcilePauseEnabled, false,
+		"Whether the reconciliation of the node artifacts can be paused with the "+install.ReconcilePauseAnnotation+" node annotation, "+
+			"e.g. to edit the CNI config file by hand while debugging")
+	registerBooleanParameter(constants.CapabilityDetectionEnabled, false,
+		"Whether to detect the capabilities of the API server on startup, such as TokenRequest and EndpointSlices, and report them. "+
+			"They are only reported, and do not change how the plugin is authenticated")
+	registerBooleanParameter(constants.KubeconfigVerification, true,
+		"Whether to verify that the API server accepts the credentials of the kubeconfig written for the plugin, with the permissions "+
+			"the plugin needs. The installation is not ready while they are not accepted")
+	registerStringParameter(constants.RuntimeConfigMap, "",
+		"If set, the name of the ConfigMap in the namespace of the node agent holding the flags applied without a restart: "+
+			"logLevel, repairEnabled, repairReconcileInterval and ambientEnrollmentEnabled")
+	registerStringParameter(constants.CNICacheGCInterval, "0s",
+		"The interval at which the CNI result cache entries of the pods deleted from the node are purged. Zero disables the purge")
+	registerBooleanParameter(constants.CNICacheCleanup, false,
+		"Whether to remove Istio CNI from the CNI result cache entries of the pods of the node when the node agent terminates. "+
+			"Only meant to be enabled when uninstalling, as the cleanup runs on every termination")
+	registerStringParameter(constants.ArtifactsOwner, "istio",
+		"The istio-cni deployment owning the node artifacts. The artifacts installed by another owner are left untouched, unless adopted")
+	registerBooleanParameter(constants.AdoptArtifacts, false,
+		"Whether to take over the node artifacts installed by another istio-cni deployment, rewriting them in place")
+	registerStringParameter(constants.LogPersistDir, "",
+		"If set, the directory on the node where the logs are also written as JSON lines, so they outlive the pod for postmortems")
+	registerIntegerParameter(constants.LogPersistMaxSize, 10, "The maximum size in megabytes of a persisted log file before it is rotated")
+	registerIntegerParameter(constants.LogPersistMaxBackups, 5, "The maximum number of rotated persisted log files to keep")
+	registerBooleanParameter(constants.OneShot, false,
+		"Whether to install the plugin on the node, verify it and exit, e.g. when run by a privileged Job or init container "+
+			"where a long-running privileged DaemonSet is not allowed. The plugin is then left installed on exit. It requires "+
+			constants.KubeconfigTokenFile+", as the token of the pod would not outlive it")
+	registerStringParameter(constants.KubeconfigTokenFile, "",
+		"If set, the file holding the token of the kubeconfig written for the plugin, e.g. a kubernetes.io/service-account-token "+
+			"Secret mounted in the pod, instead of the projected token of the pod. Required by one-shot installations")
+	registerBooleanParameter(constants.PodConditionsEnabled, false,
+		"Whether to report the state of the installation with conditions on the pod of the installer, named by the POD_NAME "+
+			"and POD_NAMESPACE environment variables: BinariesInstalled, KubeconfigValid and ConflistChained")
 	// Repair
 	registerBooleanParameter(constants.RepairEnabled, true, "Whether to enable race condition repair or not")
 	registerBooleanParameter(constants.RepairDeletePods, false, "Controller will delete pods when detecting pod broken by race condition")
@@ -191,20 +408,37 @@ func init() {
 		"A set of label selectors in label=value format that will be added to the pod list filters")
 	registerStringParameter(constants.RepairFieldSelectors, "",
 		"A set of field selectors in label=value format that will be added to the pod list filters")
+	registerStringParameter(constants.RepairReconcileInterval, "0s",
+		"The interval at which all the pods of the node are checked, in addition to checking the pods as they change. Zero disables the periodic check")
+	registerBooleanParameter(constants.RepairShadowRules, false,
+		"Whether to compare the rules of the running pods of the node with the ones the installed plugin would program, "+
+			"reporting the differences by metrics without applying the rules")
+	registerBooleanParameter(constants.RepairShadowRulesOnly, false,
+		"Whether to only compare the rules of the running pods of the node with the ones this version of the plugin would program, "+
+			"without installing it, to evaluate an upgrade while the previous version stays installed")
+}
+
+// logPersistPath returns the file the logs are persisted to in dir. The logs of a non-default revision are named after
+// the revision, so that the installers of several revisions can share the directory.
+func logPersistPath(dir, rev string) string {
+	if rev != "" && rev != "default" {
+		return filepath.Join(dir, rev+"-istio-cni.log")
+	}
+	return filepath.Join(dir, "istio-cni.log")
 }
 
 func registerStringParameter(name, value, usage string) {
-	rootCmd.Flags().String(name, value, usage)
+	rootCmd.PersistentFlags().String(name, value, usage)
 	registerEnvironment(name, value, usage)
 }
 
 func registerIntegerParameter(name string, value int, usage string) {
-	rootCmd.Flags().Int(name, value, usage)
+	rootCmd.PersistentFlags().Int(name, value, usage)
 	registerEnvironment(name, value, usage)
 }
 
 func registerBooleanParameter(name string, value bool, usage string) {
-	rootCmd.Flags().Bool(name, value, usage)
+	rootCmd.PersistentFlags().Bool(name, value, usage)
 	registerEnvironment(name, value, usage)
 }
 
@@ -217,7 +451,7 @@ func registerEnvironment[T env.Parseable](name string, defaultValue T, usage str
 }
 
 func bindViper(name string) {
-	if err := viper.BindPFlag(name, rootCmd.Flags().Lookup(name)); err != nil {
+	if err := viper.BindPFlag(name, rootCmd.PersistentFlags().Lookup(name)); err != nil {
 		log.Error(err)
 		os.Exit(1)
 	}
@@ -242,6 +476,8 @@ func constructConfig() (*config.Config, error) {
 		K8sServiceHost:     os.Getenv("KUBERNETES_SERVICE_HOST"),
 		K8sServicePort:     os.Getenv("KUBERNETES_SERVICE_PORT"),
 		K8sNodeName:        os.Getenv("KUBERNETES_NODE_NAME"),
+		K8sProxyURL:        getEnvAnyCase("HTTPS_PROXY"),
+		K8sNoProxy:         getEnvAnyCase("NO_PROXY"),
 
 		CNIBinSourceDir:   constants.CNIBinDir,
 		CNIBinTargetDirs:  []string{constants.HostCNIBinDir, constants.SecondaryBinDir},
@@ -251,10 +487,59 @@ func constructConfig() (*config.Config, error) {
 
 		AmbientEnabled: viper.GetBool(constants.AmbientEnabled),
 		EbpfEnabled:    viper.GetBool(constants.EbpfEnabled),
+
+		NodeStatusEnabled:    viper.GetBool(constants.NodeStatusEnabled),
+		PluginTracingEnabled: viper.GetBool(constants.PluginTracingEnabled),
+
+		MaintenanceWindowAnnotation: viper.GetString(constants.MaintenanceWindowAnnotation),
+		ReconcilePauseEnabled:       viper.GetBool(constants.ReconcilePauseEnabled),
+		CapabilityDetectionEnabled:  viper.GetBool(constants.CapabilityDetectionEnabled),
+
+		KubeconfigVerificationEnabled: viper.GetBool(constants.KubeconfigVerification),
+
+		Revision:       ambient.Revision,
+		ArtifactsOwner: viper.GetString(constants.ArtifactsOwner),
+		AdoptArtifacts: viper.GetBool(constants.AdoptArtifacts),
+
+		RuntimeConfigMap: viper.GetString(constants.RuntimeConfigMap),
+
+		CNICacheDir:     viper.GetString(constants.CNICacheDir),
+		CNICacheCleanup: viper.GetBool(constants.CNICacheCleanup),
+
+		OneShot:             viper.GetBool(constants.OneShot),
+		KubeconfigTokenFile: viper.GetString(constants.KubeconfigTokenFile),
+
+		PodConditionsEnabled: viper.GetBool(constants.PodConditionsEnabled),
+		PodName:              os.Getenv("POD_NAME"),
+		PodNamespace:         os.Getenv("POD_NAMESPACE"),
+	}
+
+	// The artifacts of a non-default revision which are not explicitly named are named after the revision, so that
+	// the installers of several revisions can share the node.
+	if rev := installCfg.Revision; rev != "" && rev != "default" {
+		if !viper.IsSet(constants.CNIBinariesPrefix) {
+			installCfg.CNIBinariesPrefix = rev + "-"
+		}
+		if !viper.IsSet(constants.KubeconfigFilename) {
+			installCfg.KubeconfigFilename = "ZZZ-istio-cni-" + rev + "-kubeconfig"
+		}
+		// In chained mode, each revision has its own entry in the shared CNI config file instead
+		if !installCfg.ChainedCNIPlugin && !viper.IsSet(constants.CNIConfName) {
+			installCfg.CNIConfName = "YYY-istio-cni-" + rev + ".conf"
+		}
+		if !viper.IsSet(constants.LogUDSAddress) {
+			installCfg.LogUDSAddress = filepath.Join(filepath.Dir(installCfg.LogUDSAddress), rev+"-log.sock")
+		}
+	}
+
+	cacheGCInterval, err := time.ParseDuration(viper.GetString(constants.CNICacheGCInterval))
+	if err != nil || cacheGCInterval < 0 {
+		return nil, fmt.Errorf("invalid %s %q: expected a positive duration", constants.CNICacheGCInterval,
+			viper.GetString(constants.CNICacheGCInterval))
 	}
+	installCfg.CNICacheGCInterval = cacheGCInterval
 
 	if len(installCfg.K8sNodeName) == 0 {
-		var err error
 		installCfg.K8sNodeName, err = os.Hostname()
 		if err != nil {
 			return nil, err
@@ -275,7 +560,24 @@ func constructConfig() (*config.Config, error) {
 		InitExitCode:       viper.GetInt(constants.RepairInitExitCode),
 		LabelSelectors:     viper.GetString(constants.RepairLabelSelectors),
 		FieldSelectors:     viper.GetString(constants.RepairFieldSelectors),
+		ShadowRulesEnabled: viper.GetBool(constants.RepairShadowRules),
+		ShadowRulesOnly:    viper.GetBool(constants.RepairShadowRulesOnly),
 	}
+	reconcileInterval, err := time.ParseDuration(viper.GetString(constants.RepairReconcileInterval))
+	if err != nil || reconcileInterval < 0 {
+		return nil, fmt.Errorf("invalid %s %q: expected a positive duration", constants.RepairReconcileInterval,
+			viper.GetString(constants.RepairReconcileInterval))
+	}
+	repairCfg.ReconcileInterval = reconcileInterval
 
 	return &config.Config{InstallConfig: installCfg, RepairConfig: repairCfg}, nil
 }
+
+// getEnvAnyCase returns the value of the environment variable, falling back to its lowercase form as is
+// conventional for proxy variables.
+func getEnvAnyCase(name string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return os.Getenv(strings.ToLower(name))
+}
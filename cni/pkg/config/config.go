@@ -17,6 +17,7 @@ package config
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type Config struct {
@@ -59,6 +60,10 @@ type InstallConfig struct {
 	K8sServicePort string
 	// KUBERNETES_NODE_NAME
 	K8sNodeName string
+	// HTTPS_PROXY, the proxy used to reach the API server from the node
+	K8sProxyURL string
+	// NO_PROXY, the hosts reached directly rather than through K8sProxyURL
+	K8sNoProxy string
 
 	// Directory from where the CNI binaries should be copied
 	CNIBinSourceDir string
@@ -78,6 +83,56 @@ type InstallConfig struct {
 
 	// Whether ebpf is enabled
 	EbpfEnabled bool
+
+	// Whether to publish an IstioCNINodeStatus for the node
+	NodeStatusEnabled bool
+
+	// Whether to emit OpenTelemetry spans for the CNI plugin invocations
+	PluginTracingEnabled bool
+
+	// Node annotation which, when set to true, opens the maintenance window for disruptive changes.
+	// If empty, disruptive changes are made immediately.
+	MaintenanceWindowAnnotation string
+
+	// Whether the reconciliation of the node artifacts can be paused with a node annotation, for break-glass debugging.
+	ReconcilePauseEnabled bool
+
+	// Whether to detect the capabilities of the API server on startup and report them.
+	CapabilityDetectionEnabled bool
+
+	// Whether to verify the credentials of the kubeconfig written for the plugin with the API server.
+	KubeconfigVerificationEnabled bool
+
+	// The Istio revision of the installer. The node artifacts are claimed by a single revision at a time.
+	Revision string
+	// The istio-cni deployment owning the node artifacts, e.g. to tell apart the builds of different distributions.
+	ArtifactsOwner string
+	// Whether to take over the node artifacts installed by another owner, rather than leaving them untouched.
+	AdoptArtifacts bool
+
+	// Name of the ConfigMap, in the namespace of the node agent, holding the flags applied without a restart.
+	// If empty, the flags can only be changed by restarting the node agent.
+	RuntimeConfigMap string
+
+	// Location of the CNI cache of the container runtime in the container's filesystem
+	CNICacheDir string
+	// The interval at which the CNI result cache entries of deleted pods are purged. Zero disables the purge.
+	CNICacheGCInterval time.Duration
+	// Whether to remove Istio CNI from the CNI result cache entries on cleanup. The cleanup runs whenever the node agent
+	// terminates, so this is only meant to be enabled when uninstalling.
+	CNICacheCleanup bool
+
+	// Whether to install the artifacts, verify them and exit, rather than watching the node to keep them installed.
+	OneShot bool
+	// File holding the token of the kubeconfig written for the plugin. If empty, the projected token of the service
+	// account of the pod is used, which is bound to the pod and refreshed by the node agent as it expires.
+	KubeconfigTokenFile string
+
+	// Whether to patch the install conditions on the pod of the installer
+	PodConditionsEnabled bool
+	// The name and namespace of the pod of the installer
+	PodName      string
+	PodNamespace string
 }
 
 // RepairConfig struct defines the Istio CNI race repair configuration
@@ -111,6 +166,17 @@ type RepairConfig struct {
 	// Label and field selectors to select pods managed by race repair.
 	LabelSelectors string
 	FieldSelectors string
+
+	// Interval at which all the pods of the node are checked, in addition to checking the pods as they change.
+	// Zero disables the periodic check.
+	ReconcileInterval time.Duration
+
+	// Whether to compare the rules of the running pods with the ones the installed plugin would program, reporting
+	// the differences without applying the rules
+	ShadowRulesEnabled bool
+	// Whether to only compare the rules, without installing the plugin, so that the rules of a new version are
+	// evaluated against the ones programmed by the installed plugin before upgrading it
+	ShadowRulesOnly bool
 }
 
 func (c InstallConfig) String() string {
@@ -132,10 +198,27 @@ func (c InstallConfig) String() string {
 	b.WriteString("K8sServiceHost: " + c.K8sServiceHost + "\n")
 	b.WriteString("K8sServicePort: " + fmt.Sprint(c.K8sServicePort) + "\n")
 	b.WriteString("K8sNodeName: " + c.K8sNodeName + "\n")
+	b.WriteString("K8sProxyURL: " + c.K8sProxyURL + "\n")
+	b.WriteString("K8sNoProxy: " + c.K8sNoProxy + "\n")
 	b.WriteString("MonitoringPort: " + fmt.Sprint(c.MonitoringPort) + "\n")
 	b.WriteString("LogUDSAddress: " + fmt.Sprint(c.LogUDSAddress) + "\n")
 
 	b.WriteString("AmbientEnabled: " + fmt.Sprint(c.AmbientEnabled) + "\n")
+	b.WriteString("NodeStatusEnabled: " + fmt.Sprint(c.NodeStatusEnabled) + "\n")
+	b.WriteString("PluginTracingEnabled: " + fmt.Sprint(c.PluginTracingEnabled) + "\n")
+	b.WriteString("MaintenanceWindowAnnotation: " + c.MaintenanceWindowAnnotation + "\n")
+	b.WriteString("ReconcilePauseEnabled: " + fmt.Sprint(c.ReconcilePauseEnabled) + "\n")
+	b.WriteString("CapabilityDetectionEnabled: " + fmt.Sprint(c.CapabilityDetectionEnabled) + "\n")
+	b.WriteString("KubeconfigVerificationEnabled: " + fmt.Sprint(c.KubeconfigVerificationEnabled) + "\n")
+	b.WriteString("Revision: " + c.Revision + "\n")
+	b.WriteString("ArtifactsOwner: " + c.ArtifactsOwner + "\n")
+	b.WriteString("AdoptArtifacts: " + fmt.Sprint(c.AdoptArtifacts) + "\n")
+	b.WriteString("RuntimeConfigMap: " + c.RuntimeConfigMap + "\n")
+	b.WriteString("OneShot: " + fmt.Sprint(c.OneShot) + "\n")
+	b.WriteString("KubeconfigTokenFile: " + c.KubeconfigTokenFile + "\n")
+	b.WriteString("PodConditionsEnabled: " + fmt.Sprint(c.PodConditionsEnabled) + "\n")
+	b.WriteString("PodName: " + c.PodName + "\n")
+	b.WriteString("PodNamespace: " + c.PodNamespace + "\n")
 
 	return b.String()
 }
@@ -154,5 +237,8 @@ func (c RepairConfig) String() string {
 	b.WriteString("InitExitCode: " + fmt.Sprint(c.InitExitCode) + "\n")
 	b.WriteString("LabelSelectors: " + c.LabelSelectors + "\n")
 	b.WriteString("FieldSelectors: " + c.FieldSelectors + "\n")
+	b.WriteString("ReconcileInterval: " + c.ReconcileInterval.String() + "\n")
+	b.WriteString("ShadowRulesEnabled: " + fmt.Sprint(c.ShadowRulesEnabled) + "\n")
+	b.WriteString("ShadowRulesOnly: " + fmt.Sprint(c.ShadowRulesOnly) + "\n")
 	return b.String()
 }
@@ -17,22 +17,40 @@ package constants
 // Command line arguments
 const (
 	// Install
-	MountedCNINetDir     = "mounted-cni-net-dir"
-	CNINetDir            = "cni-net-dir"
-	CNIConfName          = "cni-conf-name"
-	ChainedCNIPlugin     = "chained-cni-plugin"
-	CNINetworkConfigFile = "cni-network-config-file"
-	CNINetworkConfig     = "cni-network-config"
-	LogLevel             = "log-level"
-	KubeconfigFilename   = "kubecfg-file-name"
-	KubeconfigMode       = "kubeconfig-mode"
-	KubeCAFile           = "kube-ca-file"
-	SkipTLSVerify        = "skip-tls-verify"
-	CNIBinariesPrefix    = "cni-binaries-prefix"
-	MonitoringPort       = "monitoring-port"
-	LogUDSAddress        = "log-uds-address"
-	AmbientEnabled       = "ambient-enabled"
-	EbpfEnabled          = "ebpf-enabled"
+	MountedCNINetDir            = "mounted-cni-net-dir"
+	CNINetDir                   = "cni-net-dir"
+	CNIConfName                 = "cni-conf-name"
+	ChainedCNIPlugin            = "chained-cni-plugin"
+	CNINetworkConfigFile        = "cni-network-config-file"
+	CNINetworkConfig            = "cni-network-config"
+	LogLevel                    = "log-level"
+	KubeconfigFilename          = "kubecfg-file-name"
+	KubeconfigMode              = "kubeconfig-mode"
+	KubeCAFile                  = "kube-ca-file"
+	SkipTLSVerify               = "skip-tls-verify"
+	CNIBinariesPrefix           = "cni-binaries-prefix"
+	MonitoringPort              = "monitoring-port"
+	LogUDSAddress               = "log-uds-address"
+	AmbientEnabled              = "ambient-enabled"
+	EbpfEnabled                 = "ebpf-enabled"
+	NodeStatusEnabled           = "node-status-enabled"
+	PluginTracingEnabled        = "plugin-tracing-enabled"
+	MaintenanceWindowAnnotation = "maintenance-window-annotation"
+	ReconcilePauseEnabled       = "reconcile-pause-enabled"
+	CapabilityDetectionEnabled  = "capability-detection-enabled"
+	KubeconfigVerification      = "kubeconfig-verification-enabled"
+	RuntimeConfigMap            = "runtime-config-map"
+	CNICacheDir                 = "cni-cache-dir"
+	CNICacheGCInterval          = "cni-cache-gc-interval"
+	CNICacheCleanup             = "cni-cache-cleanup"
+	ArtifactsOwner              = "artifacts-owner"
+	AdoptArtifacts              = "adopt-artifacts"
+	LogPersistDir               = "log-persist-dir"
+	LogPersistMaxSize           = "log-persist-max-size"
+	LogPersistMaxBackups        = "log-persist-max-backups"
+	OneShot                     = "one-shot"
+	KubeconfigTokenFile         = "kubeconfig-token-file"
+	PodConditionsEnabled        = "pod-conditions-enabled"
 
 	// Repair
 	RepairEnabled            = "repair-enabled"
@@ -48,6 +66,9 @@ const (
 	RepairInitExitCode       = "repair-init-container-exit-code"
 	RepairLabelSelectors     = "repair-label-selectors"
 	RepairFieldSelectors     = "repair-field-selectors"
+	RepairReconcileInterval  = "repair-reconcile-interval"
+	RepairShadowRules        = "repair-shadow-rules"
+	RepairShadowRulesOnly    = "repair-shadow-rules-only"
 )
 
 // Internal constants
@@ -55,6 +76,8 @@ const (
 	DefaultKubeconfigMode = 0o600
 
 	UDSLogPath      = "/log"
+	UDSTimingPath   = "/timing"
+	UDSEventPath    = "/event"
 	SecondaryBinDir = "/host/secondary-bin-dir"
 
 	// K8s liveness and readiness endpoints
@@ -0,0 +1,175 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// apiCallAttempts is the number of attempts of an API server call, before it fails.
+	apiCallAttempts = 3
+	// apiCallInitialBackoff is the delay before retrying a failed API server call, doubled on each retry.
+	apiCallInitialBackoff = 500 * time.Millisecond
+	apiCallMaxBackoff     = 30 * time.Second
+	// apiCircuitFailureThreshold is the number of consecutive failed API server calls opening the circuit, after
+	// which the calls fail immediately until the circuit closes.
+	apiCircuitFailureThreshold = 3
+	// apiCircuitMinOpen is how long the circuit opens for, doubled each time the call trying the API server once the
+	// circuit closes fails again.
+	apiCircuitMinOpen = 10 * time.Second
+	apiCircuitMaxOpen = 5 * time.Minute
+)
+
+var errAPICircuitOpen = errors.New("API server circuit breaker open")
+
+// apiCallGuard guards the API server calls of the installer with a jittered exponential backoff and a circuit
+// breaker. When the API server is unavailable, e.g. during a cluster wide outage, the installers of all the nodes
+// would otherwise retry their calls in lockstep, delaying its recovery.
+type apiCallGuard struct {
+	mu sync.Mutex
+	// failures is the number of consecutive calls which failed, after their retries
+	failures int
+	// openUntil is when the circuit lets a call try the API server again, if it is open
+	openUntil time.Time
+
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+	// jitter spreads the delays, so that the installers do not retry at the same time
+	jitter func(d time.Duration) time.Duration
+}
+
+func newAPICallGuard() *apiCallGuard {
+	return &apiCallGuard{
+		now:    time.Now,
+		sleep:  sleepContext,
+		jitter: jitter,
+	}
+}
+
+// do runs an API server call, retrying it with a backoff while the API server is unavailable. While the circuit is
+// open, the call fails immediately without reaching the API server. A nil guard runs the call once.
+func (g *apiCallGuard) do(ctx context.Context, call string, fn func(ctx context.Context) error) error {
+	if g == nil {
+		return fn(ctx)
+	}
+	attempts, err := g.admit()
+	if err != nil {
+		apiCallsRejected.With(callLabel.Value(call)).Increment()
+		return fmt.Errorf("%s: %w", call, err)
+	}
+	backoff := apiCallInitialBackoff
+	for attempt := 1; ; attempt++ {
+		err = fn(ctx)
+		if !apiServerUnavailable(err) {
+			g.succeeded()
+			return err
+		}
+		if attempt >= attempts || ctx.Err() != nil {
+			break
+		}
+		apiCallRetries.With(callLabel.Value(call)).Increment()
+		if g.sleep(ctx, g.jitter(backoff)) != nil {
+			break
+		}
+		backoff = min(2*backoff, apiCallMaxBackoff)
+	}
+	g.failed(call)
+	return err
+}
+
+// admit returns the number of attempts of a call, or an error if the circuit is open. Once the circuit closes after
+// consecutive failures, the calls try the API server once, until one succeeds.
+func (g *apiCallGuard) admit() (int, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.now().Before(g.openUntil) {
+		return 0, fmt.Errorf("%w until %s", errAPICircuitOpen, g.openUntil.Format(time.RFC3339))
+	}
+	if g.failures >= apiCircuitFailureThreshold {
+		return 1, nil
+	}
+	return apiCallAttempts, nil
+}
+
+func (g *apiCallGuard) succeeded() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.failures >= apiCircuitFailureThreshold {
+		installLog.Infof("API server reachable again, closing the circuit breaker")
+	}
+	g.failures = 0
+	g.openUntil = time.Time{}
+	apiCircuitOpen.Record(0)
+}
+
+func (g *apiCallGuard) failed(call string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.failures++
+	if g.failures < apiCircuitFailureThreshold {
+		return
+	}
+	open := apiCircuitMinOpen
+	for i := apiCircuitFailureThreshold; i < g.failures && open < apiCircuitMaxOpen; i++ {
+		open *= 2
+	}
+	open = g.jitter(min(open, apiCircuitMaxOpen))
+	g.openUntil = g.now().Add(open)
+	installLog.Warnf("API server unavailable after %d consecutive failed calls (last %s), opening the circuit breaker for %v",
+		g.failures, call, open.Round(time.Second))
+	apiCircuitOpen.Record(1)
+}
+
+// apiServerUnavailable returns whether an error shows the API server is unavailable, or overloaded. Other errors,
+// e.g. an object not found or a forbidden call, are responses of an available API server.
+func apiServerUnavailable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if kerrors.IsServerTimeout(err) || kerrors.IsTimeout(err) || kerrors.IsTooManyRequests(err) ||
+		kerrors.IsServiceUnavailable(err) || kerrors.IsInternalError(err) || kerrors.IsUnexpectedServerError(err) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// jitter returns a random delay between half and all of d.
+func jitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
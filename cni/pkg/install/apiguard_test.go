@@ -0,0 +1,94 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"istio.io/istio/pkg/monitoring/monitortest"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestAPICallGuard(t *testing.T) {
+	mt := monitortest.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var slept []time.Duration
+	g := &apiCallGuard{
+		now: func() time.Time { return now },
+		sleep: func(_ context.Context, d time.Duration) error {
+			slept = append(slept, d)
+			return nil
+		},
+		jitter: func(d time.Duration) time.Duration { return d },
+	}
+	ctx := context.Background()
+	unavailable := kerrors.NewServiceUnavailable("down")
+	calls := 0
+	failing := func(context.Context) error {
+		calls++
+		return unavailable
+	}
+
+	// An available API server answering with an error is not retried
+	notFound := kerrors.NewNotFound(schema.GroupResource{Resource: "nodes"}, "node-1")
+	err := g.do(ctx, "test", func(context.Context) error { return notFound })
+	assert.Equal(t, kerrors.IsNotFound(err), true)
+
+	// The unavailable API server is retried with an exponential backoff
+	for i := 0; i < apiCircuitFailureThreshold; i++ {
+		assert.Equal(t, kerrors.IsServiceUnavailable(g.do(ctx, "test", failing)), true)
+	}
+	assert.Equal(t, calls, apiCircuitFailureThreshold*apiCallAttempts)
+	assert.Equal(t, slept[:2], []time.Duration{apiCallInitialBackoff, 2 * apiCallInitialBackoff})
+	mt.Assert(apiCallRetries.Name(), map[string]string{"call": "test"},
+		monitortest.Exactly(float64(apiCircuitFailureThreshold*(apiCallAttempts-1))))
+
+	// The circuit is now open, the calls fail without reaching the API server
+	calls = 0
+	err = g.do(ctx, "test", failing)
+	assert.Equal(t, errors.Is(err, errAPICircuitOpen), true)
+	assert.Equal(t, calls, 0)
+	mt.Assert(apiCallsRejected.Name(), map[string]string{"call": "test"}, monitortest.Exactly(1))
+	mt.Assert(apiCircuitOpen.Name(), nil, monitortest.Exactly(1))
+
+	// Once it closes, a single call tries the API server, reopening the circuit for longer if it fails
+	now = now.Add(apiCircuitMinOpen)
+	assert.Equal(t, kerrors.IsServiceUnavailable(g.do(ctx, "test", failing)), true)
+	assert.Equal(t, calls, 1)
+	assert.Equal(t, g.openUntil, now.Add(2*apiCircuitMinOpen))
+
+	// A successful call closes the circuit
+	now = g.openUntil
+	assert.NoError(t, g.do(ctx, "test", func(context.Context) error { return nil }))
+	assert.Equal(t, g.failures, 0)
+	mt.Assert(apiCircuitOpen.Name(), nil, monitortest.Exactly(0))
+}
+
+func TestNilAPICallGuard(t *testing.T) {
+	var g *apiCallGuard
+	calls := 0
+	err := g.do(context.Background(), "test", func(context.Context) error {
+		calls++
+		return kerrors.NewServiceUnavailable("down")
+	})
+	assert.Equal(t, kerrors.IsServiceUnavailable(err), true)
+	assert.Equal(t, calls, 1)
+}
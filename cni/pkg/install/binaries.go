@@ -24,11 +24,14 @@ import (
 
 // Copies/mirrors any files present in a single source dir to N number of target dirs
 // and returns a set of the filenames copied.
-func copyBinaries(srcDir string, targetDirs []string, binariesPrefix string) (sets.Set[string], error) {
+// Replacing a different, existing binary is disruptive, so if canReplace is set and returns false, the existing
+// binary is kept and its path is returned in the list of deferred replacements instead.
+func copyBinaries(srcDir string, targetDirs []string, binariesPrefix string, canReplace func() bool) (sets.Set[string], []string, error) {
 	copiedFilenames := sets.Set[string]{}
+	var deferred []string
 	srcFiles, err := os.ReadDir(srcDir)
 	if err != nil {
-		return copiedFilenames, err
+		return copiedFilenames, deferred, err
 	}
 
 	for _, f := range srcFiles {
@@ -47,9 +50,15 @@ func copyBinaries(srcDir string, targetDirs []string, binariesPrefix string) (se
 			}
 			targetFilepath := filepath.Join(targetDir, targetFilename)
 
+			if canReplace != nil && binaryChanged(srcFilepath, targetFilepath) && !canReplace() {
+				installLog.Infof("Deferring replacement of %s until the maintenance window.", targetFilepath)
+				deferred = append(deferred, targetFilepath)
+				continue
+			}
+
 			err := file.AtomicCopy(srcFilepath, targetDir, targetFilename)
 			if err != nil {
-				return copiedFilenames, err
+				return copiedFilenames, deferred, err
 			}
 			installLog.Infof("Copied %s to %s.", filename, targetFilepath)
 		}
@@ -57,5 +66,31 @@ func copyBinaries(srcDir string, targetDirs []string, binariesPrefix string) (se
 		copiedFilenames.Insert(targetFilename)
 	}
 
-	return copiedFilenames, nil
+	return copiedFilenames, deferred, nil
+}
+
+// binaryFilenames returns the set of the filenames copyBinaries would copy, without copying them.
+func binaryFilenames(srcDir string, binariesPrefix string) (sets.Set[string], error) {
+	filenames := sets.Set[string]{}
+	srcFiles, err := os.ReadDir(srcDir)
+	if err != nil {
+		return filenames, err
+	}
+	for _, f := range srcFiles {
+		if !f.IsDir() {
+			filenames.Insert(binariesPrefix + f.Name())
+		}
+	}
+	return filenames, nil
+}
+
+// binaryChanged returns whether the target binary exists, with contents different from the source binary.
+func binaryChanged(srcFilepath, targetFilepath string) bool {
+	target, err := fileSHA256(targetFilepath)
+	if err != nil {
+		// Missing, so copying it is not a replacement
+		return false
+	}
+	src, err := fileSHA256(srcFilepath)
+	return err != nil || src != target
 }
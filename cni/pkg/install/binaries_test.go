@@ -61,7 +61,7 @@ func TestCopyBinaries(t *testing.T) {
 				file.WriteOrFail(t, filepath.Join(targetDir, filename), []byte(contents))
 			}
 
-			binariesCopied, err := copyBinaries(srcDir, []string{targetDir}, c.prefix)
+			binariesCopied, _, err := copyBinaries(srcDir, []string{targetDir}, c.prefix, nil)
 			if err != nil {
 				t.Fatal(err)
 			}
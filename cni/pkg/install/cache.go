@@ -0,0 +1,266 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/kubernetes"
+
+	"istio.io/istio/cni/pkg/config"
+	"istio.io/istio/cni/pkg/util"
+	"istio.io/istio/pkg/file"
+)
+
+// cniCacheGracePeriod is the minimum age of the cache entries purged by the garbage collection, so that the entries
+// of pods being set up are never purged before their pod is listed.
+const cniCacheGracePeriod = time.Minute
+
+// cniCacheEntry is an entry of the CNI result cache, written by the container runtime for each network attachment of
+// a pod. On DEL, the runtime invokes the plugins with the config cached on ADD: once the config refers to a removed
+// or incompatible istio-cni plugin, the DEL fails and the sandbox of the pod cannot be removed.
+type cniCacheEntry struct {
+	path string
+	// fields are the fields of the entry, kept as is so that rewriting the entry preserves the fields of other versions
+	fields map[string]json.RawMessage
+	// config is the CNI network config cached on ADD
+	config []byte
+	// args are the CNI_ARGS of the ADD, identifying the pod
+	args map[string]string
+}
+
+// cniCacheResultsDir returns the directory holding the CNI result cache entries.
+func cniCacheResultsDir(cfg *config.InstallConfig) string {
+	return filepath.Join(cfg.CNICacheDir, "results")
+}
+
+// readCNICache reads the entries of the CNI result cache. Entries which cannot be parsed are skipped.
+func readCNICache(cfg *config.InstallConfig) ([]*cniCacheEntry, error) {
+	if cfg.CNICacheDir == "" {
+		return nil, nil
+	}
+	dir := cniCacheResultsDir(cfg)
+	files, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var entries []*cniCacheEntry
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, f.Name())
+		entry, err := readCNICacheEntry(path)
+		if err != nil {
+			installLog.Debugf("skipping CNI cache entry %s: %v", path, err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func readCNICacheEntry(path string) (*cniCacheEntry, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	entry := &cniCacheEntry{path: path, args: map[string]string{}}
+	if err := json.Unmarshal(b, &entry.fields); err != nil {
+		return nil, err
+	}
+	// The config is a JSON []byte, so base64 encoded
+	if err := json.Unmarshal(entry.fields["config"], &entry.config); err != nil {
+		return nil, fmt.Errorf("invalid config: %v", err)
+	}
+	if raw, f := entry.fields["cniArgs"]; f {
+		var args [][2]string
+		if err := json.Unmarshal(raw, &args); err != nil {
+			return nil, fmt.Errorf("invalid cniArgs: %v", err)
+		}
+		for _, arg := range args {
+			entry.args[arg[0]] = arg[1]
+		}
+	}
+	return entry, nil
+}
+
+// usesIstioCNI returns whether the cached config invokes the istio-cni plugin named with the binaries prefix.
+func (e *cniCacheEntry) usesIstioCNI(cfg *config.InstallConfig) bool {
+	var conf map[string]any
+	if err := json.Unmarshal(e.config, &conf); err != nil {
+		return false
+	}
+	istioCniExecutableName := cfg.CNIBinariesPrefix + "istio-cni"
+	if conf["type"] == istioCniExecutableName {
+		return true
+	}
+	plugins, err := util.GetPlugins(conf)
+	if err != nil {
+		return false
+	}
+	for _, rawPlugin := range plugins {
+		if plugin, err := util.GetPlugin(rawPlugin); err == nil && plugin["type"] == istioCniExecutableName {
+			return true
+		}
+	}
+	return false
+}
+
+// removeIstioCNI rewrites the entry with the istio-cni plugin removed from the cached config, so that the DEL of the
+// pod only invokes the other plugins. If istio-cni is the only plugin, the entry is removed.
+func (e *cniCacheEntry) removeIstioCNI(cfg *config.InstallConfig) error {
+	var conf map[string]any
+	if err := json.Unmarshal(e.config, &conf); err != nil {
+		return err
+	}
+	istioCniExecutableName := cfg.CNIBinariesPrefix + "istio-cni"
+	plugins, err := util.GetPlugins(conf)
+	if err != nil {
+		// Standalone config, only invoking istio-cni
+		return os.Remove(e.path)
+	}
+	kept := make([]any, 0, len(plugins))
+	for _, rawPlugin := range plugins {
+		if plugin, err := util.GetPlugin(rawPlugin); err == nil && plugin["type"] == istioCniExecutableName {
+			continue
+		}
+		kept = append(kept, rawPlugin)
+	}
+	if len(kept) == 0 {
+		return os.Remove(e.path)
+	}
+	conf["plugins"] = kept
+	if e.config, err = json.Marshal(conf); err != nil {
+		return err
+	}
+	if e.fields["config"], err = json.Marshal(e.config); err != nil {
+		return err
+	}
+	b, err := json.Marshal(e.fields)
+	if err != nil {
+		return err
+	}
+	return file.AtomicWrite(e.path, b, os.FileMode(0o600))
+}
+
+// podKey returns the namespace/name of the pod of the entry, if known.
+func (e *cniCacheEntry) podKey() (string, bool) {
+	ns, name := e.args["K8S_POD_NAMESPACE"], e.args["K8S_POD_NAME"]
+	if ns == "" || name == "" {
+		return "", false
+	}
+	return ns + "/" + name, true
+}
+
+// SetCNICacheGC configures the installer to periodically purge the CNI result cache entries of the pods deleted from
+// the node, checking the pods with the client.
+func (in *Installer) SetCNICacheGC(client kubernetes.Interface) {
+	in.cniCacheClient = client
+}
+
+// runCNICacheGC purges the stale CNI result cache entries at the configured interval, until the context is done.
+func (in *Installer) runCNICacheGC(ctx context.Context) {
+	ticker := time.NewTicker(in.cfg.CNICacheGCInterval)
+	defer ticker.Stop()
+	for {
+		if err := in.gcCNICache(ctx); err != nil {
+			installLog.Warnf("failed to purge the stale CNI cache entries: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// gcCNICache removes the CNI result cache entries invoking istio-cni whose pod is no longer on the node. The runtime
+// normally removes the entry on DEL, but entries of pods deleted while the plugin failed, for example during an
+// upgrade, are left behind and fail every later DEL attempt of the runtime.
+func (in *Installer) gcCNICache(ctx context.Context) error {
+	entries, err := readCNICache(in.cfg)
+	if err != nil || len(entries) == 0 {
+		return err
+	}
+	var pods *corev1.PodList
+	err = in.apiCalls.do(ctx, "cni-cache-gc", func(ctx context.Context) (err error) {
+		pods, err = in.cniCacheClient.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector("spec.nodeName", in.cfg.K8sNodeName).String(),
+		})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("list pods: %v", err)
+	}
+	// UIDs of the pods of the node, by namespace/name
+	live := map[string]string{}
+	for _, pod := range pods.Items {
+		live[pod.Namespace+"/"+pod.Name] = string(pod.UID)
+	}
+
+	now := time.Now()
+	for _, entry := range entries {
+		if !entry.usesIstioCNI(in.cfg) {
+			continue
+		}
+		key, ok := entry.podKey()
+		if !ok {
+			continue
+		}
+		// A pod recreated with the same name is another pod
+		if liveUID, f := live[key]; f && (entry.args["K8S_POD_UID"] == "" || entry.args["K8S_POD_UID"] == liveUID) {
+			continue
+		}
+		if info, err := os.Stat(entry.path); err != nil || now.Sub(info.ModTime()) < cniCacheGracePeriod {
+			continue
+		}
+		installLog.Infof("removing the stale CNI cache entry %s of deleted pod %s", entry.path, key)
+		if err := os.Remove(entry.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		cniCachePurged.Increment()
+	}
+	return nil
+}
+
+// cleanupCNICache removes istio-cni from the configs cached for the pods of the node, so that the runtime can still
+// remove their sandbox once the istio-cni binary is uninstalled.
+func cleanupCNICache(cfg *config.InstallConfig) error {
+	entries, err := readCNICache(cfg)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if !entry.usesIstioCNI(cfg) {
+			continue
+		}
+		installLog.Infof("Removing Istio CNI from CNI cache entry: %s", entry.path)
+		if err := entry.removeIstioCNI(cfg); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("%s: %v", entry.path, err)
+		}
+	}
+	return nil
+}
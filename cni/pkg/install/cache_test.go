@@ -0,0 +1,130 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"istio.io/istio/cni/pkg/config"
+	"istio.io/istio/cni/pkg/util"
+	"istio.io/istio/pkg/file"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+// writeCNICacheEntry writes a cache entry like the container runtime does, returning its path. The pod UID is used as
+// the container ID.
+func writeCNICacheEntry(t *testing.T, cacheDir, confFile, ns, name, uid string, age time.Duration) string {
+	t.Helper()
+	conf, err := os.ReadFile(filepath.Join("testdata", confFile))
+	assert.NoError(t, err)
+	b, err := json.Marshal(map[string]any{
+		"kind":        "cniCacheV1",
+		"containerId": uid,
+		"config":      conf,
+		"ifName":      "eth0",
+		"networkName": "dbnet",
+		"cniArgs": [][2]string{
+			{"K8S_POD_NAMESPACE", ns},
+			{"K8S_POD_NAME", name},
+			{"K8S_POD_UID", uid},
+		},
+		"result": map[string]any{"cniVersion": "0.4.0"},
+	})
+	assert.NoError(t, err)
+	path := filepath.Join(cacheDir, "results", "dbnet-"+uid+"-eth0")
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	assert.NoError(t, os.WriteFile(path, b, 0o600))
+	modTime := time.Now().Add(-age)
+	assert.NoError(t, os.Chtimes(path, modTime, modTime))
+	return path
+}
+
+func TestGCCNICache(t *testing.T) {
+	cacheDir := t.TempDir()
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "live", Namespace: "default", UID: types.UID("uid-1")},
+		Spec:       corev1.PodSpec{NodeName: "node-1"},
+	}
+	live := writeCNICacheEntry(t, cacheDir, "list-with-istio.conflist", "default", "live", "uid-1", time.Hour)
+	recreated := writeCNICacheEntry(t, cacheDir, "list-with-istio.conflist", "default", "live", "uid-0", time.Hour)
+	deleted := writeCNICacheEntry(t, cacheDir, "list-with-istio.conflist", "default", "deleted", "uid-2", time.Hour)
+	recent := writeCNICacheEntry(t, cacheDir, "list-with-istio.conflist", "default", "recent", "uid-3", 0)
+	other := writeCNICacheEntry(t, cacheDir, "list-no-istio.conflist", "default", "other", "uid-4", time.Hour)
+
+	cfg := &config.InstallConfig{CNICacheDir: cacheDir, K8sNodeName: "node-1"}
+	installer := NewInstaller(cfg, nil)
+	installer.SetCNICacheGC(fake.NewSimpleClientset(pod))
+	assert.NoError(t, installer.gcCNICache(context.Background()))
+
+	assert.Equal(t, file.Exists(live), true)
+	assert.Equal(t, file.Exists(recreated), false)
+	assert.Equal(t, file.Exists(deleted), false)
+	// Entries of pods being set up, and entries not invoking istio-cni, are kept
+	assert.Equal(t, file.Exists(recent), true)
+	assert.Equal(t, file.Exists(other), true)
+}
+
+func TestCleanupCNICache(t *testing.T) {
+	cacheDir := t.TempDir()
+	chained := writeCNICacheEntry(t, cacheDir, "list-with-istio.conflist", "default", "chained", "uid-1", 0)
+	standalone := writeCNICacheEntry(t, cacheDir, "istio-cni.conf", "default", "standalone", "uid-2", 0)
+	other := writeCNICacheEntry(t, cacheDir, "list-no-istio.conflist", "default", "other", "uid-3", 0)
+	before, err := os.ReadFile(other)
+	assert.NoError(t, err)
+
+	cfg := &config.InstallConfig{CNICacheDir: cacheDir}
+	assert.NoError(t, cleanupCNICache(cfg))
+
+	entry, err := readCNICacheEntry(chained)
+	assert.NoError(t, err)
+	assert.Equal(t, entry.usesIstioCNI(cfg), false)
+	conf := map[string]any{}
+	assert.NoError(t, json.Unmarshal(entry.config, &conf))
+	plugins, err := util.GetPlugins(conf)
+	assert.NoError(t, err)
+	assert.Equal(t, len(plugins), 2)
+	// The other fields of the entry are preserved
+	assert.Equal(t, entry.args["K8S_POD_NAME"], "chained")
+	assert.Equal(t, string(entry.fields["kind"]), `"cniCacheV1"`)
+
+	assert.Equal(t, file.Exists(standalone), false)
+	after, err := os.ReadFile(other)
+	assert.NoError(t, err)
+	assert.Equal(t, after, before)
+}
+
+func TestCleanupKeepsCNICacheUnlessEnabled(t *testing.T) {
+	cacheDir := t.TempDir()
+	standalone := writeCNICacheEntry(t, cacheDir, "istio-cni.conf", "default", "standalone", "uid-1", 0)
+
+	// Restarts and rollouts of the node agent leave the cache of the running pods alone
+	cfg := &config.InstallConfig{CNICacheDir: cacheDir, MountedCNINetDir: t.TempDir()}
+	assert.NoError(t, NewInstaller(cfg, nil).Cleanup())
+	assert.Equal(t, file.Exists(standalone), true)
+
+	cfg.CNICacheCleanup = true
+	assert.NoError(t, NewInstaller(cfg, nil).Cleanup())
+	assert.Equal(t, file.Exists(standalone), false)
+}
@@ -0,0 +1,185 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/discovery"
+
+	"istio.io/istio/cni/pkg/constants"
+	"istio.io/istio/pkg/monitoring"
+	"istio.io/istio/security/pkg/util"
+)
+
+const (
+	capabilityTokenRequest   = "TokenRequest"
+	capabilityEndpointSlices = "EndpointSlices"
+	capabilityBoundToken     = "BoundServiceAccountToken"
+
+	// tokenRefreshFraction is the fraction of the lifetime of a bound token after which the kubeconfig is refreshed,
+	// matching the rotation of the projected token by the kubelet.
+	tokenRefreshFraction = 0.8
+	// minTokenRefresh is the shortest delay before refreshing the kubeconfig, so a token about to expire does not
+	// make the installer loop.
+	minTokenRefresh = time.Minute
+)
+
+var (
+	capabilityLabel = monitoring.CreateLabel("capability")
+
+	apiServerCapability = monitoring.NewGauge(
+		"istio_cni_install_api_server_capability",
+		"Whether a capability of the API server used by the Istio CNI installer is available",
+	)
+)
+
+// APIServerCapabilities are the capabilities of the API server, and of the service account token it issued, which
+// determine how the installer authenticates the plugin. A capability is only set if it was detected, so a capability
+// failing to be detected falls back to the behavior supported by older clusters.
+type APIServerCapabilities struct {
+	// Version is the version reported by the API server, if known.
+	Version string
+	// TokenRequest is set if the API server issues tokens with the serviceaccounts/token subresource.
+	TokenRequest bool
+	// EndpointSlices is set if the API server serves discovery.k8s.io/v1 EndpointSlices.
+	EndpointSlices bool
+	// BoundToken is set if the mounted service account token is a bound token, which expires and is rotated by the
+	// kubelet, rather than a legacy token stored in a Secret.
+	BoundToken bool
+	// TokenAudiences are the audiences of the mounted token.
+	TokenAudiences []string
+	// TokenExpiry is the expiry of the mounted token, if it expires.
+	TokenExpiry time.Time
+}
+
+// SetCapabilityDetection configures the installer to detect the capabilities of the API server with the client on
+// startup. Without it, only the mounted service account token is inspected. The detected capabilities are only
+// reported: the installer authenticates the plugin the same way whether they are available or not.
+func (in *Installer) SetCapabilityDetection(client discovery.DiscoveryInterface) {
+	in.discoveryClient = client
+}
+
+// detectCapabilities detects the capabilities of the API server and reports them.
+func (in *Installer) detectCapabilities() {
+	capabilities := detectTokenCapabilities(readServiceAccountToken())
+	if in.discoveryClient != nil {
+		detectAPIServerCapabilities(in.discoveryClient, &capabilities)
+	}
+	in.capabilities = capabilities
+	reportCapabilities(capabilities)
+}
+
+// detectAPIServerCapabilities sets the capabilities served by the API server. Failing discovery leaves them unset.
+func detectAPIServerCapabilities(client discovery.DiscoveryInterface, capabilities *APIServerCapabilities) {
+	if v, err := client.ServerVersion(); err != nil {
+		installLog.Warnf("failed to get the API server version, assuming an older cluster: %v", err)
+	} else {
+		capabilities.Version = v.GitVersion
+	}
+	if resources, err := client.ServerResourcesForGroupVersion("v1"); err != nil {
+		installLog.Warnf("failed to discover the core API resources, assuming TokenRequest is not supported: %v", err)
+	} else {
+		for _, r := range resources.APIResources {
+			if r.Name == "serviceaccounts/token" {
+				capabilities.TokenRequest = true
+			}
+		}
+	}
+	if resources, err := client.ServerResourcesForGroupVersion("discovery.k8s.io/v1"); err != nil {
+		installLog.Debugf("discovery.k8s.io/v1 is not served, assuming EndpointSlices are not supported: %v", err)
+	} else {
+		for _, r := range resources.APIResources {
+			if r.Name == "endpointslices" {
+				capabilities.EndpointSlices = true
+			}
+		}
+	}
+}
+
+// detectTokenCapabilities inspects the claims of a service account token. A token without expiry, or which cannot be
+// parsed, is a legacy token valid until its Secret is deleted.
+func detectTokenCapabilities(token string) APIServerCapabilities {
+	capabilities := APIServerCapabilities{}
+	if token == "" {
+		return capabilities
+	}
+	exp, err := util.GetExp(token)
+	if err != nil || exp.IsZero() {
+		return capabilities
+	}
+	capabilities.BoundToken = true
+	capabilities.TokenExpiry = exp
+	if aud, err := util.GetAud(token); err == nil {
+		capabilities.TokenAudiences = aud
+	}
+	return capabilities
+}
+
+// tokenRefreshTime returns when the kubeconfig embedding a token with these capabilities must be refreshed, after
+// most of the remaining lifetime of the token. The zero time is returned for tokens which do not expire, or expire too
+// soon to be refreshed before the kubelet rotates them, which the installer already watches.
+func (c APIServerCapabilities) tokenRefreshTime(now time.Time) time.Time {
+	if !c.BoundToken || c.TokenExpiry.IsZero() {
+		return time.Time{}
+	}
+	delay := time.Duration(float64(c.TokenExpiry.Sub(now)) * tokenRefreshFraction)
+	if delay < minTokenRefresh {
+		return time.Time{}
+	}
+	return now.Add(delay)
+}
+
+// readServiceAccountToken returns the mounted service account token, or an empty string if it cannot be read.
+func readServiceAccountToken() string {
+	token, err := os.ReadFile(constants.ServiceAccountPath + "/token")
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(token))
+}
+
+// reportCapabilities logs the capabilities, and publishes them as metrics.
+func reportCapabilities(c APIServerCapabilities) {
+	version := c.Version
+	if version == "" {
+		version = "unknown"
+	}
+	tokenKind := "legacy (no expiry), embedded as is"
+	if c.BoundToken {
+		tokenKind = "bound (audiences " + strings.Join(c.TokenAudiences, ",") + ", expires " + c.TokenExpiry.UTC().Format(time.RFC3339) +
+			"), kubeconfig refreshed before expiry"
+	}
+	installLog.Infof("API server capabilities: version %s, TokenRequest %v, EndpointSlices %v, service account token %s",
+		version, c.TokenRequest, c.EndpointSlices, tokenKind)
+	if c.Version != "" && !c.TokenRequest && c.BoundToken {
+		installLog.Warnf("the service account token is bound but the API server does not advertise TokenRequest, " +
+			"the kubeconfig will stop working if the kubelet does not rotate the token")
+	}
+
+	for capability, available := range map[string]bool{
+		capabilityTokenRequest:   c.TokenRequest,
+		capabilityEndpointSlices: c.EndpointSlices,
+		capabilityBoundToken:     c.BoundToken,
+	} {
+		v := 0.0
+		if available {
+			v = 1
+		}
+		apiServerCapability.With(capabilityLabel.Value(capability)).Record(v)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func testToken(claims string) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"RS256"}`)) + "." + enc.EncodeToString([]byte(claims)) + ".signature"
+}
+
+func TestDetectTokenCapabilities(t *testing.T) {
+	exp := time.Unix(1700000000, 0)
+	cases := []struct {
+		name  string
+		token string
+		want  APIServerCapabilities
+	}{
+		{
+			name: "no token",
+		},
+		{
+			name:  "not a JWT",
+			token: saToken,
+		},
+		{
+			name:  "legacy token",
+			token: testToken(`{"iss":"kubernetes/serviceaccount","sub":"system:serviceaccount:istio-system:istio-cni"}`),
+		},
+		{
+			name:  "bound token",
+			token: testToken(fmt.Sprintf(`{"aud":["https://kubernetes.default.svc"],"exp":%d}`, exp.Unix())),
+			want: APIServerCapabilities{
+				BoundToken:     true,
+				TokenAudiences: []string{"https://kubernetes.default.svc"},
+				TokenExpiry:    exp,
+			},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, detectTokenCapabilities(tt.token), tt.want)
+		})
+	}
+}
+
+func TestDetectAPIServerCapabilities(t *testing.T) {
+	newDiscovery := func(resources ...*metav1.APIResourceList) *fakediscovery.FakeDiscovery {
+		d := fake.NewSimpleClientset().Discovery().(*fakediscovery.FakeDiscovery)
+		d.FakedServerVersion = &version.Info{GitVersion: "v1.30.0"}
+		d.Resources = resources
+		return d
+	}
+
+	old := APIServerCapabilities{}
+	detectAPIServerCapabilities(newDiscovery(&metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "serviceaccounts"}},
+	}), &old)
+	assert.Equal(t, old, APIServerCapabilities{Version: "v1.30.0"})
+
+	modern := APIServerCapabilities{}
+	detectAPIServerCapabilities(newDiscovery(&metav1.APIResourceList{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "serviceaccounts"}, {Name: "serviceaccounts/token"}},
+	}, &metav1.APIResourceList{
+		GroupVersion: "discovery.k8s.io/v1",
+		APIResources: []metav1.APIResource{{Name: "endpointslices"}},
+	}), &modern)
+	assert.Equal(t, modern, APIServerCapabilities{Version: "v1.30.0", TokenRequest: true, EndpointSlices: true})
+}
+
+func TestTokenRefreshTime(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	cases := []struct {
+		name         string
+		capabilities APIServerCapabilities
+		want         time.Time
+	}{
+		{
+			name:         "legacy token",
+			capabilities: APIServerCapabilities{},
+		},
+		{
+			name:         "bound token",
+			capabilities: APIServerCapabilities{BoundToken: true, TokenExpiry: now.Add(time.Hour)},
+			want:         now.Add(48 * time.Minute),
+		},
+		{
+			name:         "bound token about to expire",
+			capabilities: APIServerCapabilities{BoundToken: true, TokenExpiry: now.Add(time.Minute)},
+		},
+		{
+			name:         "expired bound token",
+			capabilities: APIServerCapabilities{BoundToken: true, TokenExpiry: now.Add(-time.Hour)},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.capabilities.tokenRefreshTime(now), tt.want)
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"istio.io/istio/cni/pkg/config"
+	"istio.io/istio/pkg/file"
+)
+
+const (
+	defaultRevision = "default"
+	// defaultOwner is the owner of the artifacts claimed before the claims recorded their owner.
+	defaultOwner = "istio"
+)
+
+// errOwnershipMismatch is returned when the artifacts were installed by another istio-cni deployment.
+var errOwnershipMismatch = errors.New("ownership mismatch")
+
+// artifactsClaim records the revision owning the artifacts installed on the node with a given name prefix: the
+// istio-cni binaries, their entry in the CNI config file and the kubeconfig.
+type artifactsClaim struct {
+	Revision string `json:"revision"`
+	// Owner is the istio-cni deployment which installed the artifacts, e.g. to tell apart builds of different
+	// distributions managing the same nodes.
+	Owner string `json:"owner,omitempty"`
+}
+
+// revision returns the revision of the installer.
+func revision(cfg *config.InstallConfig) string {
+	if cfg.Revision == "" {
+		return defaultRevision
+	}
+	return cfg.Revision
+}
+
+// owner returns the owner of the artifacts installed by the installer.
+func owner(cfg *config.InstallConfig) string {
+	if cfg.ArtifactsOwner == "" {
+		return defaultOwner
+	}
+	return cfg.ArtifactsOwner
+}
+
+// NodeStatusName returns the name of the IstioCNINodeStatus published by the installer of the revision.
+func NodeStatusName(nodeName, rev string) string {
+	if rev == "" || rev == defaultRevision {
+		return nodeName
+	}
+	return nodeName + "-" + rev
+}
+
+// claimFilepath returns the file claiming the artifacts named with the binaries prefix. It is not a CNI config file,
+// so it is ignored by the container runtime.
+func claimFilepath(cfg *config.InstallConfig) string {
+	return filepath.Join(cfg.MountedCNINetDir, cfg.CNIBinariesPrefix+"istio-cni.claim")
+}
+
+// claimArtifacts claims the artifacts of the installer for its revision. The artifacts claimed by another revision
+// are left untouched and an error is returned: the first revision installed keeps its artifacts until its installer
+// is removed, and other revisions must name their artifacts differently to share the node.
+//
+// The artifacts claimed by another owner are left untouched as well and an errOwnershipMismatch error is returned,
+// unless the installer adopts them. Adopting the artifacts only transfers the claim: they are then rewritten in place
+// by the installer, so the chaining of the pods never stops. Unclaimed artifacts predate the claims: they are named
+// with the binaries prefix of the installer, which tells the owners apart, so they are adopted when upgrading.
+func claimArtifacts(cfg *config.InstallConfig) error {
+	path := claimFilepath(cfg)
+	rev := revision(cfg)
+	b, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		var claim artifactsClaim
+		if err := json.Unmarshal(b, &claim); err != nil || claim.Revision == "" {
+			installLog.Warnf("replacing invalid claim of the istio-cni artifacts %s: %s", path, string(b))
+		} else if claimedBy := claimOwner(claim); claimedBy != owner(cfg) {
+			if !cfg.AdoptArtifacts {
+				return fmt.Errorf("%w: the istio-cni artifacts named with the %q prefix are owned by the %q revision of %q in %s, "+
+					"not by %q; enable adopting the artifacts to take them over, or remove the other istio-cni deployment",
+					errOwnershipMismatch, cfg.CNIBinariesPrefix, claim.Revision, claimedBy, path, owner(cfg))
+			}
+			installLog.Warnf("adopting the istio-cni artifacts of the %q revision of %q in %s", claim.Revision, claimedBy, path)
+		} else if claim.Revision != rev {
+			return fmt.Errorf("the istio-cni artifacts named with the %q prefix are claimed by the %q revision in %s; "+
+				"install the %q revision with another binaries prefix, or remove the claim if the %q revision is no longer installed",
+				cfg.CNIBinariesPrefix, claim.Revision, path, rev, claim.Revision)
+		} else {
+			return nil
+		}
+	case os.IsNotExist(err):
+		if existing := unclaimedArtifacts(cfg); len(existing) > 0 {
+			installLog.Infof("adopting the unclaimed istio-cni artifacts named with the %q prefix: %v", cfg.CNIBinariesPrefix, existing)
+		}
+	default:
+		return err
+	}
+	b, err = json.Marshal(artifactsClaim{Revision: rev, Owner: owner(cfg)})
+	if err != nil {
+		return err
+	}
+	installLog.Infof("claiming the istio-cni artifacts for the %q revision of %q in %s", rev, owner(cfg), path)
+	return file.AtomicWrite(path, b, os.FileMode(0o644))
+}
+
+// claimOwner returns the owner of a claim.
+func claimOwner(claim artifactsClaim) string {
+	if claim.Owner == "" {
+		return defaultOwner
+	}
+	return claim.Owner
+}
+
+// unclaimedArtifacts returns the artifacts of the installer already on the node, when they are not claimed.
+func unclaimedArtifacts(cfg *config.InstallConfig) []string {
+	var existing []string
+	for _, targetDir := range cfg.CNIBinTargetDirs {
+		if bin := filepath.Join(targetDir, cfg.CNIBinariesPrefix+"istio-cni"); file.Exists(bin) {
+			existing = append(existing, bin)
+		}
+	}
+	if cfg.KubeconfigFilename != "" {
+		if kubeconfig := filepath.Join(cfg.MountedCNINetDir, cfg.KubeconfigFilename); file.Exists(kubeconfig) {
+			existing = append(existing, kubeconfig)
+		}
+	}
+	if path, ok := installedCNIConfigFilepath(cfg); ok {
+		existing = append(existing, path)
+	}
+	return existing
+}
+
+// releaseArtifacts removes the claim of the artifacts, if held by the revision of the installer.
+func releaseArtifacts(cfg *config.InstallConfig) error {
+	path := claimFilepath(cfg)
+	b, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var claim artifactsClaim
+	if err := json.Unmarshal(b, &claim); err == nil && claim.Revision != revision(cfg) {
+		return nil
+	}
+	installLog.Infof("Removing claim of the istio-cni artifacts: %s", path)
+	return os.Remove(path)
+}
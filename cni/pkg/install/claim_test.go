@@ -0,0 +1,163 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"istio.io/istio/cni/pkg/config"
+	testutils "istio.io/istio/pilot/test/util"
+	"istio.io/istio/pkg/file"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestClaimArtifacts(t *testing.T) {
+	netDir := t.TempDir()
+	stable := &config.InstallConfig{MountedCNINetDir: netDir}
+	canary := &config.InstallConfig{MountedCNINetDir: netDir, Revision: "canary"}
+	claim := filepath.Join(netDir, "istio-cni.claim")
+
+	// The first revision claims the artifacts, and keeps them when reinstalled
+	assert.NoError(t, claimArtifacts(stable))
+	assert.NoError(t, claimArtifacts(stable))
+	assert.Equal(t, string(testutils.ReadFile(t, claim)), `{"revision":"default","owner":"istio"}`)
+
+	// Another revision refuses to install the same artifacts, and does not release the claim
+	assert.Error(t, claimArtifacts(canary))
+	assert.NoError(t, releaseArtifacts(canary))
+	assert.Equal(t, file.Exists(claim), true)
+
+	// Artifacts named after the revision can be installed alongside
+	canary.CNIBinariesPrefix = "canary-"
+	assert.NoError(t, claimArtifacts(canary))
+	assert.Equal(t, string(testutils.ReadFile(t, filepath.Join(netDir, "canary-istio-cni.claim"))), `{"revision":"canary","owner":"istio"}`)
+
+	// Releasing the claim lets another revision take over
+	assert.NoError(t, releaseArtifacts(stable))
+	assert.Equal(t, file.Exists(claim), false)
+	canary.CNIBinariesPrefix = ""
+	assert.NoError(t, claimArtifacts(canary))
+
+	// Invalid claims are replaced
+	assert.NoError(t, os.WriteFile(claim, []byte("{"), 0o644))
+	assert.NoError(t, claimArtifacts(stable))
+	assert.Equal(t, string(testutils.ReadFile(t, claim)), `{"revision":"default","owner":"istio"}`)
+}
+
+func TestClaimArtifactsOwnership(t *testing.T) {
+	netDir := t.TempDir()
+	binDir := t.TempDir()
+	claim := filepath.Join(netDir, "istio-cni.claim")
+	downstream := &config.InstallConfig{MountedCNINetDir: netDir, CNIBinTargetDirs: []string{binDir}, ArtifactsOwner: "downstream"}
+
+	// Claims recorded before the owners are owned by the default owner
+	assert.NoError(t, os.WriteFile(claim, []byte(`{"revision":"default"}`), 0o644))
+	assert.NoError(t, claimArtifacts(&config.InstallConfig{MountedCNINetDir: netDir}))
+	err := claimArtifacts(downstream)
+	assert.Equal(t, errors.Is(err, errOwnershipMismatch), true)
+	assert.Equal(t, string(testutils.ReadFile(t, claim)), `{"revision":"default"}`)
+
+	// Adopting the artifacts transfers the claim, whatever the revision of the previous owner
+	assert.NoError(t, os.WriteFile(claim, []byte(`{"revision":"canary","owner":"istio"}`), 0o644))
+	downstream.AdoptArtifacts = true
+	assert.NoError(t, claimArtifacts(downstream))
+	assert.Equal(t, string(testutils.ReadFile(t, claim)), `{"revision":"default","owner":"downstream"}`)
+
+	// Unclaimed artifacts with the same prefix predate the claims, so they are adopted when upgrading
+	assert.NoError(t, os.Remove(claim))
+	assert.NoError(t, os.WriteFile(filepath.Join(binDir, "istio-cni"), []byte("binary"), 0o755))
+	downstream.AdoptArtifacts = false
+	assert.NoError(t, claimArtifacts(downstream))
+	assert.Equal(t, string(testutils.ReadFile(t, claim)), `{"revision":"default","owner":"downstream"}`)
+}
+
+func TestInstallerOwnershipMismatch(t *testing.T) {
+	netDir := t.TempDir()
+	binDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(binDir, "istio-cni"), []byte("binary"), 0o755))
+	assert.NoError(t, claimArtifacts(&config.InstallConfig{MountedCNINetDir: netDir}))
+
+	cfg := &config.InstallConfig{MountedCNINetDir: netDir, CNIBinTargetDirs: []string{binDir}, ArtifactsOwner: "downstream"}
+	isReady := &atomic.Value{}
+	isReady.Store(false)
+	in := NewInstaller(cfg, isReady)
+	_, err := in.installAll(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, in.nodeStatus().Owner, "downstream")
+	assert.Equal(t, in.nodeStatus().OwnershipMismatch != "", true)
+	assert.Equal(t, in.nodeStatus().ClaimConflict, "")
+
+	// The artifacts of the other owner are not cleaned up
+	assert.NoError(t, in.Cleanup())
+	assert.Equal(t, file.Exists(filepath.Join(binDir, "istio-cni")), true)
+}
+
+func TestInstallerClaimConflict(t *testing.T) {
+	netDir := t.TempDir()
+	binDir := t.TempDir()
+	kubeconfig := filepath.Join(netDir, "kubeconfig")
+	assert.NoError(t, os.WriteFile(filepath.Join(binDir, "istio-cni"), []byte("binary"), 0o755))
+	assert.NoError(t, os.WriteFile(kubeconfig, []byte("kubeconfig"), 0o600))
+	assert.NoError(t, claimArtifacts(&config.InstallConfig{MountedCNINetDir: netDir}))
+
+	cfg := &config.InstallConfig{MountedCNINetDir: netDir, CNIBinTargetDirs: []string{binDir}, Revision: "canary"}
+	isReady := &atomic.Value{}
+	isReady.Store(false)
+	in := NewInstaller(cfg, isReady)
+	in.kubeconfigFilepath = kubeconfig
+	_, err := in.installAll(context.Background())
+	assert.Error(t, err)
+	assert.Equal(t, in.nodeStatus().Revision, "canary")
+	assert.Equal(t, in.nodeStatus().ClaimConflict != "", true)
+
+	// The artifacts of the other revision are not cleaned up
+	assert.NoError(t, in.Cleanup())
+	assert.Equal(t, file.Exists(filepath.Join(binDir, "istio-cni")), true)
+	assert.Equal(t, file.Exists(kubeconfig), true)
+}
+
+func TestInsertCNIConfigOfAnotherRevision(t *testing.T) {
+	existing := testutils.ReadFile(t, filepath.Join("testdata", "list-with-istio.conflist"))
+	prefixed := testutils.ReadFile(t, filepath.Join("testdata", "istio-cni-prefixed.conf"))
+	out, err := insertCNIConfig(prefixed, existing)
+	assert.NoError(t, err)
+	// Reinserting the config of the same revision replaces its entry
+	out, err = insertCNIConfig(prefixed, out)
+	assert.NoError(t, err)
+
+	var conflist struct {
+		Plugins []struct {
+			Type string `json:"type"`
+		} `json:"plugins"`
+	}
+	assert.NoError(t, json.Unmarshal(out, &conflist))
+	var types []string
+	for _, p := range conflist.Plugins {
+		types = append(types, p.Type)
+	}
+	assert.Equal(t, types, []string{"bridge", "tuning", "istio-cni", "prefix-istio-cni"})
+}
+
+func TestNodeStatusName(t *testing.T) {
+	assert.Equal(t, NodeStatusName("node-1", ""), "node-1")
+	assert.Equal(t, NodeStatusName("node-1", "default"), "node-1")
+	assert.Equal(t, NodeStatusName("node-1", "canary"), "node-1-canary")
+}
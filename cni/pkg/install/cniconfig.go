@@ -28,6 +28,13 @@ import (
 	"istio.io/istio/cni/pkg/config"
 	"istio.io/istio/cni/pkg/util"
 	"istio.io/istio/pkg/file"
+	"istio.io/istio/tools/istio-iptables/pkg/dependencies"
+)
+
+const (
+	portmapPluginType = "portmap"
+	// portmapChainedKey is the key of the istio-cni config set when the portmap plugin is chained before istio-cni.
+	portmapChainedKey = "portmap_chained"
 )
 
 type pluginConfig struct {
@@ -49,6 +56,8 @@ type cniConfigVars struct {
 	k8sServicePort     string
 	k8sNodeName        string
 	logUDSAddress      string
+	iptablesBackend    string
+	pluginType         string
 }
 
 func getPluginConfig(cfg *config.InstallConfig) pluginConfig {
@@ -66,7 +75,7 @@ func getCNIConfigTemplate(cfg *config.InstallConfig) cniConfigTemplate {
 	}
 }
 
-func getCNIConfigVars(cfg *config.InstallConfig) cniConfigVars {
+func getCNIConfigVars(cfg *config.InstallConfig, iptablesBackend dependencies.IptablesBackend) cniConfigVars {
 	return cniConfigVars{
 		cniNetDir:          cfg.CNINetDir,
 		kubeconfigFilename: cfg.KubeconfigFilename,
@@ -75,16 +84,18 @@ func getCNIConfigVars(cfg *config.InstallConfig) cniConfigVars {
 		k8sServicePort:     cfg.K8sServicePort,
 		k8sNodeName:        cfg.K8sNodeName,
 		logUDSAddress:      cfg.LogUDSAddress,
+		iptablesBackend:    string(iptablesBackend),
+		pluginType:         cfg.CNIBinariesPrefix + "istio-cni",
 	}
 }
 
-func createCNIConfigFile(ctx context.Context, cfg *config.InstallConfig) (string, error) {
+func createCNIConfigFile(ctx context.Context, cfg *config.InstallConfig, iptablesBackend dependencies.IptablesBackend) (string, error) {
 	cniConfig, err := readCNIConfigTemplate(getCNIConfigTemplate(cfg))
 	if err != nil {
 		return "", err
 	}
 
-	cniConfig = replaceCNIConfigVars(cniConfig, getCNIConfigVars(cfg))
+	cniConfig = replaceCNIConfigVars(cniConfig, getCNIConfigVars(cfg, iptablesBackend))
 
 	return writeCNIConfig(ctx, cniConfig, getPluginConfig(cfg))
 }
@@ -117,6 +128,8 @@ func replaceCNIConfigVars(cniConfig []byte, vars cniConfigVars) []byte {
 	cniConfigStr = strings.ReplaceAll(cniConfigStr, "__KUBERNETES_SERVICE_HOST__", vars.k8sServiceHost)
 	cniConfigStr = strings.ReplaceAll(cniConfigStr, "__KUBERNETES_SERVICE_PORT__", vars.k8sServicePort)
 	cniConfigStr = strings.ReplaceAll(cniConfigStr, "__KUBERNETES_NODE_NAME__", vars.k8sNodeName)
+	cniConfigStr = strings.ReplaceAll(cniConfigStr, "__IPTABLES_BACKEND__", vars.iptablesBackend)
+	cniConfigStr = strings.ReplaceAll(cniConfigStr, "__CNI_PLUGIN_TYPE__", vars.pluginType)
 
 	installLog.Infof("CNI config: %s", cniConfigStr)
 
@@ -133,18 +146,15 @@ func writeCNIConfig(ctx context.Context, cniConfig []byte, cfg pluginConfig) (st
 		if !file.Exists(cniConfigFilepath) {
 			return "", fmt.Errorf("CNI config file %s removed during configuration", cniConfigFilepath)
 		}
-		// This section overwrites an existing plugins list entry for istio-cni
-		existingCNIConfig, err := os.ReadFile(cniConfigFilepath)
+		// This section only rewrites the plugins list entry for istio-cni, preserving concurrent changes of the others
+		written, err := syncCNIConfig(cniConfigFilepath, cniConfig, os.ReadFile)
 		if err != nil {
 			return "", err
 		}
-		cniConfig, err = insertCNIConfig(cniConfig, existingCNIConfig)
-		if err != nil {
-			return "", err
+		if !written {
+			installLog.Infof("Istio entry of CNI config %s is up to date, not modifying", cniConfigFilepath)
 		}
-	}
-
-	if err = file.AtomicWrite(cniConfigFilepath, cniConfig, os.FileMode(0o644)); err != nil {
+	} else if err = file.AtomicWrite(cniConfigFilepath, cniConfig, os.FileMode(0o644)); err != nil {
 		installLog.Errorf("Failed to write CNI config file %v: %v", cniConfigFilepath, err)
 		return cniConfigFilepath, err
 	}
@@ -305,17 +315,27 @@ func insertCNIConfig(newCNIConfig, existingCNIConfig []byte) ([]byte, error) {
 			return nil, fmt.Errorf("existing CNI config: %v", err)
 		}
 
+		// Replace the entry of the same istio-cni binary, leaving the entries of other revisions untouched
 		for i, rawPlugin := range plugins {
 			plugin, err := util.GetPlugin(rawPlugin)
 			if err != nil {
 				return nil, fmt.Errorf("existing CNI plugin: %v", err)
 			}
-			if plugin["type"] == "istio-cni" {
+			if plugin["type"] == istioMap["type"] {
 				plugins = append(plugins[:i], plugins[i+1:]...)
 				break
 			}
 		}
 
+		// istio-cni is appended to the chain, so it runs after a portmap plugin translating the hostPort traffic
+		delete(istioMap, portmapChainedKey)
+		for _, rawPlugin := range plugins {
+			if plugin, err := util.GetPlugin(rawPlugin); err == nil && plugin["type"] == portmapPluginType {
+				istioMap[portmapChainedKey] = true
+				break
+			}
+		}
+
 		newMap["plugins"] = append(plugins, istioMap)
 	}
 
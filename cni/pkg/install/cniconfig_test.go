@@ -15,7 +15,9 @@
 package install
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -338,6 +340,11 @@ func TestInsertCNIConfig(t *testing.T) {
 			existingConfFilename: "list-with-istio.conflist",
 			newConfFilename:      "istio-cni.conf",
 		},
+		{
+			name:                 "list network file with portmap",
+			existingConfFilename: "list-with-portmap.conflist",
+			newConfFilename:      "istio-cni.conf",
+		},
 	}
 
 	for _, c := range cases {
@@ -361,6 +368,73 @@ func TestInsertCNIConfig(t *testing.T) {
 	}
 }
 
+func TestSyncCNIConfig(t *testing.T) {
+	istioConf := testutils.ReadFile(t, filepath.Join("testdata", "istio-cni.conf"))
+	existingConf := testutils.ReadFile(t, filepath.Join("testdata", "list.conflist"))
+	goldenFilepath := filepath.Join("testdata", "list.conflist.golden")
+
+	t.Run("rewrites only changed config", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "list.conflist")
+		if err := os.WriteFile(path, existingConf, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		written, err := syncCNIConfig(path, istioConf, os.ReadFile)
+		assert.NoError(t, err)
+		assert.Equal(t, written, true)
+		testutils.CompareBytes(t, testutils.ReadFile(t, path), testutils.ReadFile(t, goldenFilepath), goldenFilepath)
+
+		written, err = syncCNIConfig(path, istioConf, os.ReadFile)
+		assert.NoError(t, err)
+		assert.Equal(t, written, false)
+	})
+
+	t.Run("preserves concurrent change", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "list.conflist")
+		if err := os.WriteFile(path, existingConf, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		reads := 0
+		read := func(path string) ([]byte, error) {
+			reads++
+			if reads == 2 {
+				// The merged config is already written, and is about to replace the file
+				tmps, _ := filepath.Glob(path + ".tmp.*")
+				assert.Equal(t, len(tmps), 1)
+				// Another writer changes the file between the read merged into and the rename
+				changed := bytes.ReplaceAll(existingConf, []byte(`"500"`), []byte(`"1024"`))
+				if err := os.WriteFile(path, changed, 0o644); err != nil {
+					return nil, err
+				}
+			}
+			return os.ReadFile(path)
+		}
+		written, err := syncCNIConfig(path, istioConf, read)
+		assert.NoError(t, err)
+		assert.Equal(t, written, true)
+
+		result := testutils.ReadFile(t, path)
+		assert.Equal(t, bytes.Contains(result, []byte(`"1024"`)), true)
+		assert.Equal(t, bytes.Contains(result, []byte(`"istio-cni"`)), true)
+		// The merge into the previous content was discarded
+		tmps, _ := filepath.Glob(path + ".tmp.*")
+		assert.Equal(t, len(tmps), 0)
+	})
+
+	t.Run("gives up on continuous changes", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "list.conflist")
+		reads := 0
+		read := func(string) ([]byte, error) {
+			reads++
+			return bytes.ReplaceAll(existingConf, []byte(`"500"`), []byte(fmt.Sprintf("%q", fmt.Sprint(reads)))), nil
+		}
+		_, err := syncCNIConfig(path, istioConf, read)
+		assert.Error(t, err)
+		assert.Equal(t, file.Exists(path), false)
+		tmps, _ := filepath.Glob(path + ".tmp.*")
+		assert.Equal(t, len(tmps), 0)
+	})
+}
+
 const (
 	// For testing purposes, set kubeconfigFilename equivalent to the path in the test files and use __KUBECONFIG_FILENAME__
 	// CreateCNIConfigFile joins the MountedCNINetDir and KubeconfigFilename if __KUBECONFIG_FILEPATH__ was used
@@ -487,7 +561,7 @@ func TestCreateCNIConfigFile(t *testing.T) {
 
 				ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
 				defer cancel()
-				resultFilepath, err := createCNIConfigFile(ctx, &cfg)
+				resultFilepath, err := createCNIConfigFile(ctx, &cfg, "")
 				if err != nil {
 					assert.Equal(t, resultFilepath, "")
 					if err == context.DeadlineExceeded {
@@ -0,0 +1,112 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+)
+
+// cniConfigSyncAttempts bounds the merges of the istio-cni entry into a CNI config file changed concurrently by other
+// writers.
+const cniConfigSyncAttempts = 3
+
+// syncCNIConfig merges the istio-cni entry into the existing CNI config file of the primary CNI, which is shared with
+// other writers such as the agents of cloud CNIs. Only the istio-cni entry of the plugin list is changed, and the
+// file is only rewritten if the entry differs. The merged config is written to a temporary file, and a change of the
+// file by another writer is detected by its content hash right before the temporary file replaces it; the entry is
+// then merged again into the new content, rather than overwriting the change.
+// It returns whether the file was rewritten.
+func syncCNIConfig(path string, istioConfig []byte, read func(string) ([]byte, error)) (bool, error) {
+	for attempt := 1; attempt <= cniConfigSyncAttempts; attempt++ {
+		existing, err := read(path)
+		if err != nil {
+			return false, err
+		}
+		merged, err := insertCNIConfig(istioConfig, existing)
+		if err != nil {
+			return false, err
+		}
+		if sameCNIConfig(merged, existing) {
+			return false, nil
+		}
+
+		tmp, err := writeTempCNIConfig(path, merged)
+		if err != nil {
+			installLog.Errorf("Failed to write CNI config file %v: %v", path, err)
+			return false, err
+		}
+		// The file is replaced atomically, so it is either the content merged into, or a concurrent change
+		current, err := read(path)
+		if err != nil {
+			_ = os.Remove(tmp)
+			return false, err
+		}
+		if sha256.Sum256(current) != sha256.Sum256(existing) {
+			_ = os.Remove(tmp)
+			cniConfigConflicts.Increment()
+			installLog.Warnf("CNI config file %s changed while merging the istio-cni entry (attempt %d/%d), merging again",
+				path, attempt, cniConfigSyncAttempts)
+			continue
+		}
+		if err := os.Rename(tmp, path); err != nil {
+			_ = os.Remove(tmp)
+			installLog.Errorf("Failed to write CNI config file %v: %v", path, err)
+			return false, err
+		}
+		return true, nil
+	}
+	return false, fmt.Errorf("CNI config file %s changed concurrently during %d attempts to merge the istio-cni entry", path, cniConfigSyncAttempts)
+}
+
+// writeTempCNIConfig writes the CNI config to a temporary file next to the config file, to be renamed over it.
+func writeTempCNIConfig(path string, data []byte) (string, error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp.")
+	if err != nil {
+		return "", err
+	}
+	if err := os.Chmod(f.Name(), 0o644); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// sameCNIConfig returns whether two CNI configs are equivalent, regardless of their formatting.
+func sameCNIConfig(a, b []byte) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+	var am, bm any
+	if json.Unmarshal(a, &am) != nil || json.Unmarshal(b, &bm) != nil {
+		return false
+	}
+	return reflect.DeepEqual(am, bm)
+}
@@ -16,10 +16,15 @@ package install
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"sync/atomic"
+	"time"
+
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes"
 
 	"istio.io/istio/cni/pkg/config"
 	"istio.io/istio/cni/pkg/constants"
@@ -27,6 +32,7 @@ import (
 	"istio.io/istio/pkg/file"
 	"istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/util/sets"
+	"istio.io/istio/tools/istio-iptables/pkg/dependencies"
 )
 
 var installLog = log.RegisterScope("install", "CNI install")
@@ -36,6 +42,47 @@ type Installer struct {
 	isReady            *atomic.Value
 	kubeconfigFilepath string
 	cniConfigFilepath  string
+
+	nodeStatusReporter   *NodeStatusReporter
+	podConditionReporter *PodConditionReporter
+
+	maintenanceWindow             MaintenanceWindow
+	maintenanceWindowPollInterval time.Duration
+	// deferredChanges are the disruptive changes deferred until the maintenance window opens
+	deferredChanges []string
+	// cniConfigDeferred is set if the rewrite of the CNI config file is deferred
+	cniConfigDeferred bool
+
+	detectIptablesBackends func() dependencies.BackendDetection
+	// iptablesBackend is the iptables backend detected on the node, if any
+	iptablesBackend dependencies.IptablesBackend
+	// iptablesBackendMismatch describes the mix of iptables backends on the node, if any
+	iptablesBackendMismatch string
+	// claimConflict describes the claim of the artifacts by another revision preventing the installation, if any
+	claimConflict string
+	// ownershipMismatch describes the artifacts of another owner preventing the installation, if any
+	ownershipMismatch string
+
+	// cniCacheClient checks the pods of the node when purging the CNI result cache, if set
+	cniCacheClient kubernetes.Interface
+
+	// discoveryClient detects the capabilities of the API server on startup, if set
+	discoveryClient discovery.DiscoveryInterface
+	capabilities    APIServerCapabilities
+	// tokenRefresh is when the kubeconfig must be refreshed before its bound token expires, if it does
+	tokenRefresh time.Time
+	// verifyKubeconfig verifies the credentials of the installed kubeconfig with the API server, if set
+	verifyKubeconfig func(ctx context.Context, kubeconfigFilepath string) error
+	// kubeconfigVerificationError describes why the credentials of the kubeconfig are not accepted, if they are not
+	kubeconfigVerificationError string
+
+	// apiCalls guards the API server calls of the install and watch loops
+	apiCalls *apiCallGuard
+
+	reconcilePause ReconcilePause
+	// pausedArtifacts are the artifacts whose reconciliation is paused, with the expiry of their pause
+	pausedArtifacts map[string]time.Time
+	now             func() time.Time
 }
 
 // NewInstaller returns an instance of Installer with the given config
@@ -44,14 +91,57 @@ func NewInstaller(cfg *config.InstallConfig, isReady *atomic.Value) *Installer {
 		cfg:                cfg,
 		kubeconfigFilepath: filepath.Join(cfg.MountedCNINetDir, cfg.KubeconfigFilename),
 		isReady:            isReady,
+
+		maintenanceWindowPollInterval: defaultMaintenanceWindowPollInterval,
+		detectIptablesBackends:        dependencies.DetectIptablesBackends,
+		apiCalls:                      newAPICallGuard(),
+		now:                           time.Now,
 	}
 }
 
+// SetNodeStatusReporter configures the installer to publish the IstioCNINodeStatus of its node after each install.
+func (in *Installer) SetNodeStatusReporter(reporter *NodeStatusReporter) {
+	in.nodeStatusReporter = reporter
+}
+
 func (in *Installer) installAll(ctx context.Context) (sets.Set[string], error) {
+	// The pod conditions reflect the artifacts on the node whether the install succeeds or not
+	defer in.reportPodConditions(ctx)
+
+	// The artifacts are named after the binaries prefix. If the installers of several revisions use the same names,
+	// only the revision claiming the artifacts installs them, rather than having the installers overwrite each other.
+	// Likewise, the artifacts installed by another istio-cni deployment are only replaced when adopting them.
+	if err := claimArtifacts(in.cfg); err != nil {
+		if errors.Is(err, errOwnershipMismatch) {
+			in.ownershipMismatch = err.Error()
+			cniInstalls.With(resultLabel.Value(resultOwnershipMismatch)).Increment()
+		} else {
+			in.claimConflict = err.Error()
+			cniInstalls.With(resultLabel.Value(resultArtifactsClaimed)).Increment()
+		}
+		in.reportNodeStatus(ctx)
+		return nil, fmt.Errorf("claim artifacts: %v", err)
+	}
+	in.claimConflict = ""
+	in.ownershipMismatch = ""
+
+	// The paused artifacts are left as they are, e.g. while being edited by hand
+	in.updateReconcilePauses(ctx)
+
+	// Disruptive changes are only made if allowed by the maintenance window, if any
+	allowed := in.disruptionAllowed(ctx)
+
 	// Install binaries
 	// Currently we _always_ do this, since the binaries do not live in a shared location
-	// and we harm no one by doing so.
-	copiedFiles, err := copyBinaries(in.cfg.CNIBinSourceDir, in.cfg.CNIBinTargetDirs, in.cfg.CNIBinariesPrefix)
+	// and we harm no one by doing so, except when replacing them outside a maintenance window.
+	var copiedFiles sets.Set[string]
+	var deferred []string
+	var err error
+	if in.paused(artifactBinaries) {
+		copiedFiles, err = binaryFilenames(in.cfg.CNIBinSourceDir, in.cfg.CNIBinariesPrefix)
+	} else {
+		copiedFiles, deferred, err = copyBinaries(in.cfg.CNIBinSourceDir, in.cfg.CNIBinTargetDirs, in.cfg.CNIBinariesPrefix, allowed)
+	}
 	if err != nil {
 		cniInstalls.With(resultLabel.Value(resultCopyBinariesFailure)).Increment()
 		return copiedFiles, fmt.Errorf("copy binaries: %v", err)
@@ -60,26 +150,67 @@ func (in *Installer) installAll(ctx context.Context) (sets.Set[string], error) {
 	// Install kubeconfig (if needed) - we write/update this in the shared node CNI netdir,
 	// which may be watched by other CNIs, and so we don't want to trigger writes to this file
 	// unless it's missing or the contents are not what we expect.
-	if err := maybeWriteKubeConfigFile(in.cfg); err != nil {
+	if in.paused(artifactKubeconfig) {
+		installLog.Infof("reconciliation of the kubeconfig paused, not checking %s", in.kubeconfigFilepath)
+	} else if err := maybeWriteKubeConfigFile(in.cfg); err != nil {
 		cniInstalls.With(resultLabel.Value(resultCreateKubeConfigFailure)).Increment()
 		return copiedFiles, fmt.Errorf("write kubeconfig: %v", err)
 	}
+	// A bound token expires, so the kubeconfig embedding it is refreshed even if the rotation of the token is missed
+	in.tokenRefresh = detectTokenCapabilities(readServiceAccountToken()).tokenRefreshTime(in.now())
+	if err := in.apiCalls.do(ctx, "connectivity", func(ctx context.Context) error {
+		return verifyAPIServerConnectivity(ctx, in.cfg)
+	}); err != nil {
+		// Not fatal, the proxy may become available later on
+		installLog.Warnf("API server connectivity check failed: %v", err)
+	}
+	in.checkKubeconfig(ctx)
+
+	// The plugin programs the pod rules with the iptables backend of the node, as the rules of another backend
+	// would be bypassed.
+	in.detectIptablesBackend()
 
 	// Install CNI netdir config (if needed) - we write/update this in the shared node CNI netdir,
 	// which may be watched by other CNIs, and so we don't want to trigger writes to this file
 	// unless it's missing or the contents are not what we expect.
-	if err := checkValidCNIConfig(in.cfg, in.cniConfigFilepath); err != nil {
-		installLog.Infof("missing (or invalid) configuration detected, (re)writing CNI config file at %s", in.cniConfigFilepath)
-		cfgPath, err := createCNIConfigFile(ctx, in.cfg)
-		if err != nil {
-			cniInstalls.With(resultLabel.Value(resultCreateCNIConfigFailure)).Increment()
-			return copiedFiles, fmt.Errorf("create CNI config file: %v", err)
+	err = checkValidCNIConfig(in.cfg, in.cniConfigFilepath)
+	if err == nil {
+		err = checkCNIConfigIptablesBackend(in.cfg, in.cniConfigFilepath, in.iptablesBackend)
+	}
+	if in.paused(artifactCNIConfig) {
+		if existing, ok := installedCNIConfigFilepath(in.cfg); ok && in.cniConfigFilepath == "" {
+			in.cniConfigFilepath = existing
+		}
+		installLog.Infof("reconciliation of the CNI config paused, not modifying %s", in.cniConfigFilepath)
+	} else if err != nil || in.cniConfigDeferred {
+		existing, ok := installedCNIConfigFilepath(in.cfg)
+		if ok && cniConfigUpToDate(in.cfg, existing, in.iptablesBackend) {
+			installLog.Infof("valid Istio config present in node-level CNI file %s, not modifying", existing)
+			in.cniConfigFilepath = existing
+			in.cniConfigDeferred = false
+		} else if ok && allowed != nil && !allowed() {
+			// The existing configuration is still valid, keep using it until it can be replaced
+			installLog.Infof("valid Istio config present in node-level CNI file %s, deferring rewrite until the maintenance window", existing)
+			in.cniConfigFilepath = existing
+			in.cniConfigDeferred = true
+			deferred = append(deferred, existing)
+		} else {
+			installLog.Infof("missing (or invalid) configuration detected, (re)writing CNI config file at %s", in.cniConfigFilepath)
+			cfgPath, err := createCNIConfigFile(ctx, in.cfg, in.iptablesBackend)
+			if err != nil {
+				cniInstalls.With(resultLabel.Value(resultCreateCNIConfigFailure)).Increment()
+				return copiedFiles, fmt.Errorf("create CNI config file: %v", err)
+			}
+			in.cniConfigFilepath = cfgPath
+			in.cniConfigDeferred = false
 		}
-		in.cniConfigFilepath = cfgPath
 	} else {
 		installLog.Infof("valid Istio config present in node-level CNI file %s, not modifying", in.cniConfigFilepath)
 	}
 
+	in.setDeferredChanges(deferred)
+	in.reportNodeStatus(ctx)
+
 	return copiedFiles, nil
 }
 
@@ -90,12 +221,18 @@ func (in *Installer) installAll(ctx context.Context) (sets.Set[string], error) {
 // If changes occurred but the config is still valid, only the binaries and (optionally) svcAcct credentials
 // will be redeployed.
 func (in *Installer) Run(ctx context.Context) error {
+	// The kubeconfig depends on the capabilities of the cluster, which older clusters lack
+	in.detectCapabilities()
 	installedBins, err := in.installAll(ctx)
 	if err != nil {
 		return err
 	}
 	installLog.Info("Installation succeed, start watching for re-installation.")
 
+	if in.cniCacheClient != nil && in.cfg.CNICacheGCInterval > 0 {
+		go in.runCNICacheGC(ctx)
+	}
+
 	for {
 		// if sleepWatchInstall yields without error, that means the config might have been modified in some fashion.
 		// so we rerun `install`, which will update the modified config if it has fallen out of sync with
@@ -115,10 +252,21 @@ func (in *Installer) Run(ctx context.Context) error {
 	}
 }
 
-// Cleanup remove Istio CNI's config, kubeconfig file, and binaries.
+// Cleanup remove Istio CNI's config, kubeconfig file, and binaries, and removes Istio CNI from the CNI result cache if
+// enabled.
+// Nothing is removed if the artifacts are claimed by another revision or owner.
 func (in *Installer) Cleanup() error {
 	istioCniExecutableName := in.cfg.CNIBinariesPrefix + "istio-cni"
 
+	if in.claimConflict != "" {
+		installLog.Info("Not cleaning up the artifacts claimed by another revision.")
+		return nil
+	}
+	if in.ownershipMismatch != "" {
+		installLog.Info("Not cleaning up the artifacts owned by another istio-cni deployment.")
+		return nil
+	}
+
 	installLog.Info("Cleaning up.")
 	if len(in.cniConfigFilepath) > 0 && file.Exists(in.cniConfigFilepath) {
 		if in.cfg.ChainedCNIPlugin {
@@ -167,6 +315,15 @@ func (in *Installer) Cleanup() error {
 		}
 	}
 
+	// Without its binary, the DEL of the pods set up with Istio CNI would fail if the cached configs still invoked it.
+	// The cleanup also runs on restarts and rollouts, which must leave the cache of the running pods alone, so the
+	// cache is only rewritten when uninstalling is explicitly requested.
+	if in.cfg.CNICacheCleanup {
+		if err := cleanupCNICache(in.cfg); err != nil {
+			return err
+		}
+	}
+
 	for _, targetDir := range in.cfg.CNIBinTargetDirs {
 		if istioCNIBin := filepath.Join(targetDir, istioCniExecutableName); file.Exists(istioCNIBin) {
 			installLog.Infof("Removing binary: %s", istioCNIBin)
@@ -175,7 +332,7 @@ func (in *Installer) Cleanup() error {
 			}
 		}
 	}
-	return nil
+	return releaseArtifacts(in.cfg)
 }
 
 // sleepWatchInstall  blocks until any file change for the binaries or config are detected.
@@ -216,7 +373,8 @@ func (in *Installer) sleepWatchInstall(ctx context.Context, installedBinFiles se
 		// Before we process whether any file events have been triggered, we must check that the file is correct
 		// at this moment, and if not, yield. This is to catch other CNIs which might have mutated the file between
 		// the (theoretical) window after we initially install/write, but before we actually start the filewatch.
-		if err := checkValidCNIConfig(in.cfg, in.cniConfigFilepath); err != nil {
+		// A paused CNI config is not repaired, so its changes are only checked once the pause ends.
+		if err := checkValidCNIConfig(in.cfg, in.cniConfigFilepath); err != nil && !in.paused(artifactCNIConfig) {
 			return nil
 		}
 
@@ -231,13 +389,64 @@ func (in *Installer) sleepWatchInstall(ctx context.Context, installedBinFiles se
 		case <-ctx.Done():
 			return ctx.Err()
 		default:
-			// Valid configuration; set isReady to true and wait for modifications before checking again
-			SetReady(in.isReady)
-			cniInstalls.With(resultLabel.Value(resultSuccess)).Increment()
+			// Valid configuration; set isReady to true and wait for modifications before checking again.
+			// The installation is not ready while the plugin cannot use its kubeconfig.
+			if in.kubeconfigVerificationError == "" {
+				SetReady(in.isReady)
+				cniInstalls.With(resultLabel.Value(resultSuccess)).Increment()
+			}
 			// Pod set to "NotReady" before termination
-			return watcher.Wait(ctx)
+			return in.waitForChangeOrMaintenanceWindow(ctx, watcher)
+		}
+	}
+}
+
+// detectIptablesBackend detects the iptables backend of the node, preferring the backend of the kubelet. A node mixing
+// both backends is reported, but does not prevent the installation.
+func (in *Installer) detectIptablesBackend() {
+	backend, mismatch := in.detectIptablesBackends().Backend()
+	if mismatch != "" && mismatch != in.iptablesBackendMismatch {
+		installLog.Warnf("%s, programming the pod rules with the %s backend", mismatch, backend)
+	}
+	if backend != in.iptablesBackend {
+		installLog.Infof("detected the %q iptables backend on the node", backend)
+	}
+	in.iptablesBackend = backend
+	in.iptablesBackendMismatch = mismatch
+}
+
+// checkCNIConfigIptablesBackend returns an error if the istio-cni config records an iptables backend other than the
+// given one. Configs not recording the backend, e.g. from custom templates, are not checked.
+func checkCNIConfigIptablesBackend(cfg *config.InstallConfig, cniConfigFilepath string, backend dependencies.IptablesBackend) error {
+	istioCniExecutableName := cfg.CNIBinariesPrefix + "istio-cni"
+	cniConfigMap, err := util.ReadCNIConfigMap(cniConfigFilepath)
+	if err != nil {
+		return err
+	}
+	plugins := []map[string]any{cniConfigMap}
+	if cfg.ChainedCNIPlugin {
+		rawPlugins, err := util.GetPlugins(cniConfigMap)
+		if err != nil {
+			return fmt.Errorf("%s: %w", cniConfigFilepath, err)
+		}
+		plugins = nil
+		for _, rawPlugin := range rawPlugins {
+			plugin, err := util.GetPlugin(rawPlugin)
+			if err != nil {
+				return fmt.Errorf("%s: %w", cniConfigFilepath, err)
+			}
+			plugins = append(plugins, plugin)
 		}
 	}
+	for _, plugin := range plugins {
+		if plugin["type"] != istioCniExecutableName {
+			continue
+		}
+		if installed, ok := plugin["iptables_backend"]; ok && installed != string(backend) {
+			return fmt.Errorf("istio-cni CNI config uses the %q iptables backend rather than %q: %s", installed, backend, cniConfigFilepath)
+		}
+	}
+	return nil
 }
 
 // checkValidCNIConfig returns an error if an invalid CNI configuration is detected
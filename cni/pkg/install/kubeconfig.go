@@ -15,11 +15,23 @@
 package install
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"time"
 
+	"golang.org/x/net/http/httpproxy"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/client-go/tools/clientcmd/api/latest"
 	"sigs.k8s.io/yaml"
@@ -30,6 +42,19 @@ import (
 	"istio.io/istio/pkg/file"
 )
 
+const (
+	connectivityCheckTimeout = 5 * time.Second
+	// kubeconfigRecheckInterval is the interval at which credentials of the kubeconfig are verified again, while they
+	// are not accepted by the API server.
+	kubeconfigRecheckInterval = 30 * time.Second
+)
+
+// kubeconfigAccessChecks are the permissions the plugin needs, verified with the credentials of the kubeconfig.
+var kubeconfigAccessChecks = []authorizationv1.ResourceAttributes{
+	{Verb: "get", Resource: "pods"},
+	{Verb: "get", Resource: "namespaces"},
+}
+
 type kubeconfig struct {
 	// The full kubeconfig
 	Full string
@@ -46,9 +71,15 @@ func createKubeConfig(cfg *config.InstallConfig) (kubeconfig, error) {
 		return kubeconfig{}, fmt.Errorf("KUBERNETES_SERVICE_PORT not set. Is this not running within a pod?")
 	}
 
-	protocol := model.GetOrDefault(cfg.K8sServiceProtocol, "https")
 	cluster := &api.Cluster{
-		Server: fmt.Sprintf("%s://%s", protocol, net.JoinHostPort(cfg.K8sServiceHost, cfg.K8sServicePort)),
+		Server: apiServerURL(cfg),
+	}
+	proxy, err := apiServerProxy(cfg)
+	if err != nil {
+		return kubeconfig{}, err
+	}
+	if proxy != nil {
+		cluster.ProxyURL = proxy.String()
 	}
 
 	if cfg.SkipTLSVerify {
@@ -63,7 +94,7 @@ func createKubeConfig(cfg *config.InstallConfig) (kubeconfig, error) {
 		cluster.CertificateAuthorityData = caContents
 	}
 
-	token, err := os.ReadFile(constants.ServiceAccountPath + "/token")
+	token, err := os.ReadFile(model.GetOrDefault(cfg.KubeconfigTokenFile, constants.ServiceAccountPath+"/token"))
 	if err != nil {
 		return kubeconfig{}, err
 	}
@@ -109,6 +140,9 @@ func createKubeConfig(cfg *config.InstallConfig) (kubeconfig, error) {
 	for _, c := range kcfg.Clusters {
 		// Not actually sensitive, just annoyingly verbose.
 		c.CertificateAuthority = "REDACTED"
+		if proxy != nil {
+			c.ProxyURL = proxy.Redacted()
+		}
 	}
 	lrcfg, err := latest.Scheme.ConvertToVersion(kcfg, latest.ExternalVersion)
 	if err != nil {
@@ -130,6 +164,143 @@ func createKubeConfig(cfg *config.InstallConfig) (kubeconfig, error) {
 	}, nil
 }
 
+func apiServerURL(cfg *config.InstallConfig) string {
+	protocol := model.GetOrDefault(cfg.K8sServiceProtocol, "https")
+	return fmt.Sprintf("%s://%s", protocol, net.JoinHostPort(cfg.K8sServiceHost, cfg.K8sServicePort))
+}
+
+// apiServerProxy returns the proxy used to reach the API server, following the usual HTTPS_PROXY and NO_PROXY
+// semantics. Nil is returned if the API server is reached directly.
+func apiServerProxy(cfg *config.InstallConfig) (*url.URL, error) {
+	if cfg.K8sProxyURL == "" {
+		return nil, nil
+	}
+	// The proxy function silently ignores invalid proxies, so validate it first
+	if _, err := url.Parse(cfg.K8sProxyURL); err != nil {
+		return nil, fmt.Errorf("invalid proxy: %v", err)
+	}
+	server, err := url.Parse(apiServerURL(cfg))
+	if err != nil {
+		return nil, err
+	}
+	proxyConfig := &httpproxy.Config{
+		HTTPProxy:  cfg.K8sProxyURL,
+		HTTPSProxy: cfg.K8sProxyURL,
+		NoProxy:    cfg.K8sNoProxy,
+	}
+	return proxyConfig.ProxyFunc()(server)
+}
+
+// verifyAPIServerConnectivity checks that the API server can be reached through the configured proxy. Any HTTP
+// response proves connectivity, so the request is not authenticated. Nothing is checked if no proxy is used.
+func verifyAPIServerConnectivity(ctx context.Context, cfg *config.InstallConfig) error {
+	proxy, err := apiServerProxy(cfg)
+	if err != nil || proxy == nil {
+		return err
+	}
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.SkipTLSVerify} // nolint: gosec // user explicitly opted into insecure
+	if !cfg.SkipTLSVerify {
+		caContents, err := os.ReadFile(model.GetOrDefault(cfg.KubeCAFile, constants.ServiceAccountPath+"/ca.crt"))
+		if err != nil {
+			return err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		tlsConfig.RootCAs.AppendCertsFromPEM(caContents)
+	}
+	client := &http.Client{
+		Timeout: connectivityCheckTimeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyURL(proxy),
+			TLSClientConfig: tlsConfig,
+		},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiServerURL(cfg)+"/version", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach API server through proxy %s: %w", proxy.Redacted(), err)
+	}
+	_ = resp.Body.Close()
+	installLog.Debugf("reached API server through proxy %s, status %d", proxy.Redacted(), resp.StatusCode)
+	return nil
+}
+
+// verifyKubeconfigCredentials checks that the API server accepts the credentials of the kubeconfig written for the
+// plugin, and grants them the permissions the plugin needs. A wrong token audience or a missing role binding would
+// otherwise only surface when the pods fail to start.
+func verifyKubeconfigCredentials(ctx context.Context, kubeconfigFilepath string) error {
+	restConfig, err := clientcmd.BuildConfigFromFlags("", kubeconfigFilepath)
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig %s: %v", kubeconfigFilepath, err)
+	}
+	restConfig.Timeout = connectivityCheckTimeout
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return err
+	}
+	return checkKubeconfigAccess(ctx, client)
+}
+
+// checkKubeconfigAccess reviews the permissions the plugin needs with SelfSubjectAccessReviews, which any
+// authenticated user may create.
+func checkKubeconfigAccess(ctx context.Context, client kubernetes.Interface) error {
+	for _, attributes := range kubeconfigAccessChecks {
+		attributes := attributes
+		review := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attributes},
+		}
+		res, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+		if !res.Status.Allowed {
+			reason := res.Status.Reason
+			if reason == "" {
+				reason = "no rule allows it"
+			}
+			return fmt.Errorf("the kubeconfig is not allowed to %s %s: %s", attributes.Verb, attributes.Resource, reason)
+		}
+	}
+	return nil
+}
+
+// SetKubeconfigVerification configures the installer to verify the credentials of the kubeconfig after each install.
+// While they are not accepted, the installation is not ready.
+func (in *Installer) SetKubeconfigVerification() {
+	in.verifyKubeconfig = verifyKubeconfigCredentials
+}
+
+// checkKubeconfig verifies the credentials of the installed kubeconfig, if enabled. The result is kept as is if the
+// API server cannot be reached, as it says nothing about the credentials.
+func (in *Installer) checkKubeconfig(ctx context.Context) {
+	if in.verifyKubeconfig == nil {
+		return
+	}
+	err := in.apiCalls.do(ctx, "kubeconfig", func(ctx context.Context) error {
+		return in.verifyKubeconfig(ctx, in.kubeconfigFilepath)
+	})
+	if err != nil && (apiServerUnavailable(err) || errors.Is(err, errAPICircuitOpen)) {
+		installLog.Warnf("failed to verify the kubeconfig credentials, the API server is unavailable: %v", err)
+		return
+	}
+	if err != nil {
+		if in.kubeconfigVerificationError == "" {
+			installLog.Errorf("the kubeconfig credentials of the plugin are not accepted by the API server: %v", err)
+		}
+		in.kubeconfigVerificationError = err.Error()
+		kubeconfigVerified.Record(0)
+		cniInstalls.With(resultLabel.Value(resultKubeconfigUnauthorized)).Increment()
+		return
+	}
+	if in.kubeconfigVerificationError != "" {
+		installLog.Infof("the kubeconfig credentials of the plugin are accepted by the API server again")
+	}
+	in.kubeconfigVerificationError = ""
+	kubeconfigVerified.Record(1)
+}
+
 // maybeWriteKubeConfigFile will validate the existing kubeConfig file, and rewrite/replace it if required.
 func maybeWriteKubeConfigFile(cfg *config.InstallConfig) error {
 	kc, err := createKubeConfig(cfg)
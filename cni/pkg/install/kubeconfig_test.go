@@ -15,13 +15,24 @@
 package install
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"testing"
 
+	"go.uber.org/atomic"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
 	"istio.io/istio/cni/pkg/config"
 	"istio.io/istio/cni/pkg/constants"
 	testutils "istio.io/istio/pilot/test/util"
+	"istio.io/istio/pkg/test/util/assert"
 )
 
 const (
@@ -44,6 +55,9 @@ func TestCreateValidKubeconfigFile(t *testing.T) {
 		kubeCAFilepath     string
 		skipTLSVerify      bool
 		cniNetDir          string
+		k8sProxyURL        string
+		k8sNoProxy         string
+		golden             string
 	}{
 		{
 			name:            "k8s service host not set",
@@ -73,6 +87,31 @@ func TestCreateValidKubeconfigFile(t *testing.T) {
 			skipTLSVerify:  true,
 			cniNetDir:      filepath.Join(t.TempDir(), "nonexistent-dir"),
 		},
+		{
+			name:           "proxied",
+			k8sServiceHost: k8sServiceHost,
+			k8sServicePort: k8sServicePort,
+			skipTLSVerify:  true,
+			k8sProxyURL:    "http://proxy.example.com:3128",
+			k8sNoProxy:     "localhost,.cluster.local",
+			golden:         "testdata/kubeconfig-proxy",
+		},
+		{
+			name:           "proxy bypassed by no proxy",
+			k8sServiceHost: k8sServiceHost,
+			k8sServicePort: k8sServicePort,
+			skipTLSVerify:  true,
+			k8sProxyURL:    "http://proxy.example.com:3128",
+			k8sNoProxy:     "10.96.0.0/12",
+		},
+		{
+			name:            "invalid proxy",
+			expectedFailure: true,
+			k8sServiceHost:  k8sServiceHost,
+			k8sServicePort:  k8sServicePort,
+			skipTLSVerify:   true,
+			k8sProxyURL:     "http://proxy example:3128",
+		},
 	}
 
 	for _, c := range cases {
@@ -90,6 +129,8 @@ func TestCreateValidKubeconfigFile(t *testing.T) {
 				K8sServiceHost:     c.k8sServiceHost,
 				K8sServicePort:     c.k8sServicePort,
 				SkipTLSVerify:      c.skipTLSVerify,
+				K8sProxyURL:        c.k8sProxyURL,
+				K8sNoProxy:         c.k8sNoProxy,
 			}
 			result, err := createKubeConfig(cfg)
 			if err != nil {
@@ -106,6 +147,9 @@ func TestCreateValidKubeconfigFile(t *testing.T) {
 			if c.skipTLSVerify {
 				goldenFilepath = "testdata/kubeconfig-skip-tls"
 			}
+			if c.golden != "" {
+				goldenFilepath = c.golden
+			}
 
 			testutils.CompareContent(t, []byte(result.Full), goldenFilepath)
 		})
@@ -198,3 +242,75 @@ func TestCheckMismatchedExistingKubeConfig(t *testing.T) {
 		t.Fatalf("expected no error, matching kubeconfig present, got %+v", err)
 	}
 }
+
+func TestVerifyAPIServerConnectivity(t *testing.T) {
+	var proxied atomic.Int32
+	// A plain HTTP proxy receives the absolute URL of the API server
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Host == "apiserver.example.com:443" {
+			proxied.Inc()
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(proxy.Close)
+
+	cfg := &config.InstallConfig{
+		K8sServiceProtocol: "http",
+		K8sServiceHost:     "apiserver.example.com",
+		K8sServicePort:     "443",
+		SkipTLSVerify:      true,
+		K8sProxyURL:        proxy.URL,
+	}
+	assert.NoError(t, verifyAPIServerConnectivity(context.Background(), cfg))
+	assert.Equal(t, proxied.Load(), int32(1))
+
+	// Nothing to verify when the API server is not reached through the proxy
+	cfg.K8sNoProxy = ".example.com"
+	assert.NoError(t, verifyAPIServerConnectivity(context.Background(), cfg))
+	assert.Equal(t, proxied.Load(), int32(1))
+
+	// Unreachable proxy
+	proxy.Close()
+	cfg.K8sNoProxy = ""
+	assert.Error(t, verifyAPIServerConnectivity(context.Background(), cfg))
+}
+
+func TestCheckKubeconfigAccess(t *testing.T) {
+	allowed := map[string]bool{"pods": true}
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = allowed[review.Spec.ResourceAttributes.Resource]
+		return true, review, nil
+	})
+	assert.Error(t, checkKubeconfigAccess(context.Background(), client))
+
+	allowed["namespaces"] = true
+	assert.NoError(t, checkKubeconfigAccess(context.Background(), client))
+}
+
+func TestInstallerCheckKubeconfig(t *testing.T) {
+	in := NewInstaller(&config.InstallConfig{}, nil)
+	in.apiCalls = nil
+
+	// Nothing is verified unless enabled
+	in.checkKubeconfig(context.Background())
+	assert.Equal(t, in.nodeStatus().KubeconfigVerificationError, "")
+
+	var verifyErr error
+	in.verifyKubeconfig = func(context.Context, string) error {
+		return verifyErr
+	}
+	verifyErr = kerrors.NewUnauthorized("invalid bearer token, token audiences [\"istio-ca\"] is invalid for the target audiences")
+	in.checkKubeconfig(context.Background())
+	assert.Equal(t, in.nodeStatus().KubeconfigVerificationError != "", true)
+
+	// The result is kept while the API server is unavailable
+	verifyErr = kerrors.NewServiceUnavailable("unavailable")
+	in.checkKubeconfig(context.Background())
+	assert.Equal(t, in.nodeStatus().KubeconfigVerificationError != "", true)
+
+	verifyErr = nil
+	in.checkKubeconfig(context.Background())
+	assert.Equal(t, in.nodeStatus().KubeconfigVerificationError, "")
+}
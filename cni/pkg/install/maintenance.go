@@ -0,0 +1,216 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"istio.io/istio/cni/pkg/config"
+	"istio.io/istio/cni/pkg/util"
+	"istio.io/istio/tools/istio-iptables/pkg/dependencies"
+)
+
+// defaultMaintenanceWindowPollInterval is how often the maintenance window is checked while changes are deferred.
+const defaultMaintenanceWindowPollInterval = 30 * time.Second
+
+// MaintenanceWindow decides whether disruptive changes to the node CNI setup can be made. Replacing the CNI config
+// file briefly breaks the chaining of the CNI plugins, and replacing the binaries may fail pods being set up at
+// that moment, so these changes can be deferred until the node is in a maintenance window. Changes that do not
+// affect pods being set up, such as refreshing the kubeconfig, are always made immediately.
+type MaintenanceWindow interface {
+	// Open returns whether the node is in a maintenance window.
+	Open(ctx context.Context) (bool, error)
+}
+
+// nodeAnnotationMaintenanceWindow is open while the node has the annotation set to true.
+type nodeAnnotationMaintenanceWindow struct {
+	client     kubernetes.Interface
+	nodeName   string
+	annotation string
+}
+
+// NewNodeAnnotationMaintenanceWindow returns a MaintenanceWindow which is open while the node has the annotation
+// set to true.
+func NewNodeAnnotationMaintenanceWindow(client kubernetes.Interface, nodeName, annotation string) MaintenanceWindow {
+	return &nodeAnnotationMaintenanceWindow{
+		client:     client,
+		nodeName:   nodeName,
+		annotation: annotation,
+	}
+}
+
+func (w *nodeAnnotationMaintenanceWindow) Open(ctx context.Context) (bool, error) {
+	node, err := w.client.CoreV1().Nodes().Get(ctx, w.nodeName, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	open, _ := strconv.ParseBool(node.Annotations[w.annotation])
+	return open, nil
+}
+
+// SetMaintenanceWindow configures the installer to defer disruptive changes until the maintenance window is open.
+func (in *Installer) SetMaintenanceWindow(window MaintenanceWindow) {
+	in.maintenanceWindow = window
+}
+
+// maintenanceWindowOpen checks the maintenance window. Errors close the window, as disruptive changes are only
+// made when known to be allowed.
+func (in *Installer) maintenanceWindowOpen(ctx context.Context) bool {
+	var open bool
+	err := in.apiCalls.do(ctx, "maintenance-window", func(ctx context.Context) (err error) {
+		open, err = in.maintenanceWindow.Open(ctx)
+		return err
+	})
+	if err != nil {
+		installLog.Warnf("failed to check the node maintenance window, deferring disruptive changes: %v", err)
+		return false
+	}
+	return open
+}
+
+// disruptionAllowed returns a func reporting whether disruptive changes can be made, checking the maintenance
+// window at most once. It returns nil if no maintenance window is configured, in which case changes are always
+// allowed.
+func (in *Installer) disruptionAllowed(ctx context.Context) func() bool {
+	if in.maintenanceWindow == nil {
+		return nil
+	}
+	var once sync.Once
+	var allowed bool
+	return func() bool {
+		once.Do(func() {
+			allowed = in.maintenanceWindowOpen(ctx)
+		})
+		return allowed
+	}
+}
+
+// setDeferredChanges records the changes deferred by the last install, which are retried once the maintenance
+// window opens.
+func (in *Installer) setDeferredChanges(deferred []string) {
+	if len(deferred) > 0 {
+		installLog.Infof("deferred disruptive changes until the node maintenance window: %v", deferred)
+	} else if len(in.deferredChanges) > 0 {
+		installLog.Infof("applied the deferred disruptive changes")
+	}
+	in.deferredChanges = deferred
+	deferredChanges.Record(float64(len(deferred)))
+}
+
+// waitForChangeOrMaintenanceWindow waits like watcher.Wait, but also returns once the maintenance window opens while
+// changes are deferred, so that they get applied, once the paused artifacts change, e.g. as a pause expires, or once
+// the kubeconfig must be refreshed before its token expires.
+func (in *Installer) waitForChangeOrMaintenanceWindow(ctx context.Context, watcher *util.Watcher) error {
+	var refresh <-chan time.Time
+	if !in.tokenRefresh.IsZero() {
+		timer := time.NewTimer(in.tokenRefresh.Sub(in.now()))
+		defer timer.Stop()
+		refresh = timer.C
+	}
+	var recheck <-chan time.Time
+	if in.kubeconfigVerificationError != "" {
+		timer := time.NewTimer(kubeconfigRecheckInterval)
+		defer timer.Stop()
+		recheck = timer.C
+	}
+	if len(in.deferredChanges) == 0 && len(in.pausedArtifacts) == 0 && refresh == nil && recheck == nil {
+		return watcher.Wait(ctx)
+	}
+	var poll <-chan time.Time
+	if len(in.deferredChanges) > 0 || len(in.pausedArtifacts) > 0 {
+		ticker := time.NewTicker(in.maintenanceWindowPollInterval)
+		defer ticker.Stop()
+		poll = ticker.C
+	}
+	for {
+		select {
+		case <-watcher.Events:
+			return nil
+		case err := <-watcher.Errors:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-refresh:
+			installLog.Info("service account token nearing expiry, refreshing the kubeconfig")
+			return nil
+		case <-recheck:
+			installLog.Info("verifying the kubeconfig credentials again")
+			return nil
+		case <-poll:
+			if len(in.deferredChanges) > 0 && in.maintenanceWindowOpen(ctx) {
+				return nil
+			}
+			if len(in.pausedArtifacts) > 0 && in.reconcilePauseChanged(ctx) {
+				return nil
+			}
+		}
+	}
+}
+
+// installedCNIConfigFilepath returns the CNI config file which already holds a valid istio-cni configuration, if
+// any. Rewriting it replaces the configuration in use, which is disruptive, unlike a first install.
+func installedCNIConfigFilepath(cfg *config.InstallConfig) (string, bool) {
+	filename := cfg.CNIConfName
+	if filename == "" && !cfg.ChainedCNIPlugin {
+		filename = "YYY-istio-cni.conf"
+	}
+	if filename == "" {
+		var err error
+		if filename, err = getDefaultCNINetwork(cfg.MountedCNINetDir); err != nil {
+			return "", false
+		}
+	}
+	path := filepath.Join(cfg.MountedCNINetDir, filename)
+	if checkValidCNIConfig(cfg, path) != nil {
+		return "", false
+	}
+	return path, true
+}
+
+// cniConfigUpToDate returns whether the istio-cni configuration of the CNI config file is the one that would be
+// written. Typically on startup, the configuration installed by the previous run is unchanged: there is nothing to
+// replace, so nothing to defer either.
+func cniConfigUpToDate(cfg *config.InstallConfig, path string, iptablesBackend dependencies.IptablesBackend) bool {
+	if cfg.ChainedCNIPlugin && strings.HasSuffix(path, ".conf") {
+		// Converted to a .conflist when written
+		return false
+	}
+	template, err := readCNIConfigTemplate(getCNIConfigTemplate(cfg))
+	if err != nil {
+		return false
+	}
+	istioConfig := replaceCNIConfigVars(template, getCNIConfigVars(cfg, iptablesBackend))
+	existing, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	if !cfg.ChainedCNIPlugin {
+		return sameCNIConfig(istioConfig, existing)
+	}
+	merged, err := insertCNIConfig(istioConfig, existing)
+	if err != nil {
+		return false
+	}
+	return sameCNIConfig(merged, existing)
+}
@@ -0,0 +1,132 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"istio.io/istio/cni/pkg/config"
+	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/pkg/test/util/file"
+)
+
+func TestNodeAnnotationMaintenanceWindow(t *testing.T) {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	client := fake.NewSimpleClientset(node)
+	w := NewNodeAnnotationMaintenanceWindow(client, "node-1", "example.com/maintenance")
+
+	open, err := w.Open(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, open, false)
+
+	node.Annotations = map[string]string{"example.com/maintenance": "true"}
+	_, err = client.CoreV1().Nodes().Update(context.Background(), node, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	open, err = w.Open(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, open, true)
+
+	_, err = NewNodeAnnotationMaintenanceWindow(client, "missing", "example.com/maintenance").Open(context.Background())
+	if err == nil {
+		t.Fatal("expected an error for a missing node")
+	}
+}
+
+func TestCopyBinariesDeferred(t *testing.T) {
+	srcDir := t.TempDir()
+	targetDir := t.TempDir()
+	file.WriteOrFail(t, filepath.Join(srcDir, "istio-cni"), []byte("cni111"))
+	file.WriteOrFail(t, filepath.Join(srcDir, "istio-iptables"), []byte("iptables111"))
+	file.WriteOrFail(t, filepath.Join(srcDir, "new"), []byte("new111"))
+	file.WriteOrFail(t, filepath.Join(targetDir, "istio-cni"), []byte("cni000"))
+	file.WriteOrFail(t, filepath.Join(targetDir, "istio-iptables"), []byte("iptables111"))
+
+	closed := func() bool { return false }
+	copied, deferred, err := copyBinaries(srcDir, []string{targetDir}, "", closed)
+	assert.NoError(t, err)
+	assert.Equal(t, deferred, []string{filepath.Join(targetDir, "istio-cni")})
+	assert.Equal(t, copied.Contains("istio-cni"), true)
+	// The changed binary is kept, while unchanged and new binaries are installed
+	assert.Equal(t, file.AsStringOrFail(t, filepath.Join(targetDir, "istio-cni")), "cni000")
+	assert.Equal(t, file.AsStringOrFail(t, filepath.Join(targetDir, "new")), "new111")
+
+	open := func() bool { return true }
+	_, deferred, err = copyBinaries(srcDir, []string{targetDir}, "", open)
+	assert.NoError(t, err)
+	assert.Equal(t, len(deferred), 0)
+	assert.Equal(t, file.AsStringOrFail(t, filepath.Join(targetDir, "istio-cni")), "cni111")
+}
+
+func TestInstalledCNIConfigFilepath(t *testing.T) {
+	netDir := t.TempDir()
+	cfg := &config.InstallConfig{MountedCNINetDir: netDir, ChainedCNIPlugin: true}
+
+	// Nothing installed yet
+	_, ok := installedCNIConfigFilepath(cfg)
+	assert.Equal(t, ok, false)
+
+	contents, err := os.ReadFile(filepath.Join("testdata", "list-no-istio.conflist"))
+	assert.NoError(t, err)
+	file.WriteOrFail(t, filepath.Join(netDir, "list.conflist"), contents)
+	_, ok = installedCNIConfigFilepath(cfg)
+	assert.Equal(t, ok, false)
+
+	contents, err = os.ReadFile(filepath.Join("testdata", "list-with-istio.conflist"))
+	assert.NoError(t, err)
+	file.WriteOrFail(t, filepath.Join(netDir, "list.conflist"), contents)
+	path, ok := installedCNIConfigFilepath(cfg)
+	assert.Equal(t, ok, true)
+	assert.Equal(t, path, filepath.Join(netDir, "list.conflist"))
+}
+
+func TestCNIConfigUpToDate(t *testing.T) {
+	template, err := os.ReadFile(filepath.Join("testdata", "istio-cni.conf.template"))
+	assert.NoError(t, err)
+	list, err := os.ReadFile(filepath.Join("testdata", "list.conflist"))
+	assert.NoError(t, err)
+
+	for _, chained := range []bool{false, true} {
+		chained := chained
+		t.Run(strconv.FormatBool(chained), func(t *testing.T) {
+			netDir := t.TempDir()
+			if chained {
+				file.WriteOrFail(t, filepath.Join(netDir, "list.conflist"), list)
+			}
+			cfg := &config.InstallConfig{
+				MountedCNINetDir:   netDir,
+				ChainedCNIPlugin:   chained,
+				CNINetworkConfig:   string(template),
+				KubeconfigFilename: "ZZZ-istio-cni-kubeconfig",
+				LogLevel:           "info",
+			}
+			path, err := createCNIConfigFile(context.Background(), cfg, "")
+			assert.NoError(t, err)
+			assert.Equal(t, cniConfigUpToDate(cfg, path, ""), true)
+
+			// The config installed by a previous run is only replaced if it differs
+			cfg.LogLevel = "debug"
+			assert.Equal(t, cniConfigUpToDate(cfg, path, ""), false)
+			assert.Equal(t, cniConfigUpToDate(cfg, filepath.Join(netDir, "missing.conflist"), ""), false)
+		})
+	}
+}
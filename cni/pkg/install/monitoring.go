@@ -18,10 +18,15 @@ import "istio.io/istio/pkg/monitoring"
 
 var (
 	resultLabel                   = monitoring.CreateLabel("result")
+	artifactLabel                 = monitoring.CreateLabel("artifact")
+	callLabel                     = monitoring.CreateLabel("call")
 	resultSuccess                 = "SUCCESS"
 	resultCopyBinariesFailure     = "COPY_BINARIES_FAILURE"
 	resultCreateKubeConfigFailure = "CREATE_KUBECONFIG_FAILURE"
 	resultCreateCNIConfigFailure  = "CREATE_CNI_CONFIG_FAILURE"
+	resultArtifactsClaimed        = "ARTIFACTS_CLAIMED"
+	resultOwnershipMismatch       = "OWNERSHIP_MISMATCH"
+	resultKubeconfigUnauthorized  = "KUBECONFIG_UNAUTHORIZED"
 
 	cniInstalls = monitoring.NewSum(
 		"istio_cni_installs_total",
@@ -32,4 +37,44 @@ var (
 		"istio_cni_install_ready",
 		"Whether the CNI plugin installation is ready or not",
 	)
+
+	kubeconfigVerified = monitoring.NewGauge(
+		"istio_cni_install_kubeconfig_verified",
+		"Whether the credentials of the kubeconfig of the CNI plugin are accepted by the API server, with the permissions the plugin needs",
+	)
+
+	deferredChanges = monitoring.NewGauge(
+		"istio_cni_install_deferred_changes",
+		"Number of disruptive changes to the node CNI setup deferred until the node maintenance window",
+	)
+
+	reconcilePaused = monitoring.NewGauge(
+		"istio_cni_install_reconcile_paused",
+		"Whether the reconciliation of a node artifact by the Istio CNI installer is paused by the node annotation",
+	)
+
+	cniCachePurged = monitoring.NewSum(
+		"istio_cni_cache_entries_purged_total",
+		"Total number of stale CNI result cache entries of deleted pods purged by the Istio CNI installer",
+	)
+
+	apiCallRetries = monitoring.NewSum(
+		"istio_cni_install_api_call_retries_total",
+		"Total number of API server calls retried by the Istio CNI installer while the API server is unavailable",
+	)
+
+	apiCallsRejected = monitoring.NewSum(
+		"istio_cni_install_api_calls_rejected_total",
+		"Total number of API server calls of the Istio CNI installer failed without reaching the API server, as the circuit breaker is open",
+	)
+
+	cniConfigConflicts = monitoring.NewSum(
+		"istio_cni_install_config_conflicts_total",
+		"Total number of merges of the istio-cni entry into the CNI config file retried, as the file was changed concurrently by another writer",
+	)
+
+	apiCircuitOpen = monitoring.NewGauge(
+		"istio_cni_install_api_circuit_open",
+		"Whether the circuit breaker of the API server calls of the Istio CNI installer is open",
+	)
 )
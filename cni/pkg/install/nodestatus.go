@@ -0,0 +1,204 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/version"
+)
+
+var (
+	// NodeStatusGVR is the resource of IstioCNINodeStatus, a cluster scoped object published by each installer
+	// (named after its node) summarizing the artifacts installed on the node.
+	NodeStatusGVR = schema.GroupVersionResource{Group: "cni.istio.io", Version: "v1alpha1", Resource: "istiocninodestatuses"}
+	nodeStatusGVK = NodeStatusGVR.GroupVersion().WithKind("IstioCNINodeStatus")
+	nodeGVR       = schema.GroupVersionResource{Version: "v1", Resource: "nodes"}
+)
+
+// NodeStatus is the status of the Istio CNI installation on a node.
+type NodeStatus struct {
+	// PluginVersion is the version of the installed istio-cni plugin binary.
+	PluginVersion string `json:"pluginVersion,omitempty"`
+	// PluginSHA256 is the hex encoded SHA-256 of the installed istio-cni plugin binary.
+	PluginSHA256 string `json:"pluginSHA256,omitempty"`
+	// ConflistPath is the node path of the CNI config file containing the istio-cni plugin.
+	ConflistPath string `json:"conflistPath,omitempty"`
+	// ConflistSHA256 is the hex encoded SHA-256 of the CNI config file.
+	ConflistSHA256 string `json:"conflistSHA256,omitempty"`
+	// KubeconfigFingerprint is the hex encoded SHA-256 of the kubeconfig used by the plugin.
+	KubeconfigFingerprint string `json:"kubeconfigFingerprint,omitempty"`
+	// KubeconfigVerificationError describes why the credentials of the kubeconfig are not accepted, if they are not.
+	KubeconfigVerificationError string `json:"kubeconfigVerificationError,omitempty"`
+	// IptablesBackend is the iptables backend the plugin programs the pod rules with, if detected.
+	IptablesBackend string `json:"iptablesBackend,omitempty"`
+	// IptablesBackendMismatch describes the mix of iptables backends on the node, if any. The pod rules are still
+	// programmed with IptablesBackend.
+	IptablesBackendMismatch string `json:"iptablesBackendMismatch,omitempty"`
+	// Revision is the Istio revision of the installer.
+	Revision string `json:"revision,omitempty"`
+	// ClaimConflict describes the claim of the artifacts by another revision preventing the installation, if any.
+	ClaimConflict string `json:"claimConflict,omitempty"`
+	// Owner is the istio-cni deployment owning the artifacts installed by the installer.
+	Owner string `json:"owner,omitempty"`
+	// OwnershipMismatch describes the artifacts of another istio-cni deployment preventing the installation, if any.
+	OwnershipMismatch string `json:"ownershipMismatch,omitempty"`
+	// PausedArtifacts are the artifacts whose reconciliation is paused by the node annotation, if any.
+	PausedArtifacts []string `json:"pausedArtifacts,omitempty"`
+	// LastReconcileTime is the last time the installer validated or reinstalled the artifacts.
+	LastReconcileTime metav1.Time `json:"lastReconcileTime"`
+}
+
+// NodeStatusReporter publishes the IstioCNINodeStatus of a node. The status is owned by the Node, so that it is
+// garbage collected when the node is removed.
+type NodeStatusReporter struct {
+	client   dynamic.Interface
+	nodeName string
+	// name is the name of the IstioCNINodeStatus, see NodeStatusName
+	name string
+	now  func() time.Time
+	// owner is the reference to the Node, looked up once
+	owner *metav1.OwnerReference
+}
+
+func NewNodeStatusReporter(client dynamic.Interface, nodeName, revision string) *NodeStatusReporter {
+	return &NodeStatusReporter{
+		client:   client,
+		nodeName: nodeName,
+		name:     NodeStatusName(nodeName, revision),
+		now:      time.Now,
+	}
+}
+
+// Report creates or updates the IstioCNINodeStatus of the node with the given status.
+func (r *NodeStatusReporter) Report(ctx context.Context, status NodeStatus) error {
+	status.LastReconcileTime = metav1.NewTime(r.now())
+	statusMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&status)
+	if err != nil {
+		return err
+	}
+	owner, err := r.ownerReference(ctx)
+	if err != nil {
+		return err
+	}
+	client := r.client.Resource(NodeStatusGVR)
+	existing, err := client.Get(ctx, r.name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(nodeStatusGVK)
+		obj.SetName(r.name)
+		obj.SetOwnerReferences([]metav1.OwnerReference{owner})
+		obj.Object["status"] = statusMap
+		_, err = client.Create(ctx, obj, metav1.CreateOptions{})
+		return err
+	} else if err != nil {
+		return err
+	}
+	// The statuses published before the owner was set are adopted
+	if slices.FindFunc(existing.GetOwnerReferences(), func(ref metav1.OwnerReference) bool { return ref.UID == owner.UID }) == nil {
+		existing.SetOwnerReferences(append(existing.GetOwnerReferences(), owner))
+	}
+	existing.Object["status"] = statusMap
+	_, err = client.Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// ownerReference returns the reference to the Node of the reporter.
+func (r *NodeStatusReporter) ownerReference(ctx context.Context) (metav1.OwnerReference, error) {
+	if r.owner != nil {
+		return *r.owner, nil
+	}
+	node, err := r.client.Resource(nodeGVR).Get(ctx, r.nodeName, metav1.GetOptions{})
+	if err != nil {
+		return metav1.OwnerReference{}, fmt.Errorf("get node %s: %v", r.nodeName, err)
+	}
+	r.owner = &metav1.OwnerReference{
+		APIVersion: "v1",
+		Kind:       "Node",
+		Name:       node.GetName(),
+		UID:        node.GetUID(),
+	}
+	return *r.owner, nil
+}
+
+// nodeStatus computes the status of the artifacts currently installed on the node.
+func (in *Installer) nodeStatus() NodeStatus {
+	status := NodeStatus{
+		PluginVersion:           version.Info.Version,
+		ConflistPath:            in.cniConfigFilepath,
+		IptablesBackend:         string(in.iptablesBackend),
+		IptablesBackendMismatch: in.iptablesBackendMismatch,
+		Revision:                revision(in.cfg),
+		ClaimConflict:           in.claimConflict,
+		Owner:                   owner(in.cfg),
+		OwnershipMismatch:       in.ownershipMismatch,
+		PausedArtifacts:         in.pausedArtifactNames(),
+
+		KubeconfigVerificationError: in.kubeconfigVerificationError,
+	}
+	istioCniExecutableName := in.cfg.CNIBinariesPrefix + "istio-cni"
+	for _, targetDir := range in.cfg.CNIBinTargetDirs {
+		if sum, err := fileSHA256(filepath.Join(targetDir, istioCniExecutableName)); err == nil {
+			status.PluginSHA256 = sum
+			break
+		}
+	}
+	if sum, err := fileSHA256(in.cniConfigFilepath); err == nil {
+		status.ConflistSHA256 = sum
+	}
+	if sum, err := fileSHA256(in.kubeconfigFilepath); err == nil {
+		status.KubeconfigFingerprint = sum
+	}
+	return status
+}
+
+// reportNodeStatus publishes the node status, if enabled. Failures are not fatal to the installation.
+func (in *Installer) reportNodeStatus(ctx context.Context) {
+	if in.nodeStatusReporter == nil {
+		return
+	}
+	status := in.nodeStatus()
+	if err := in.apiCalls.do(ctx, "node-status", func(ctx context.Context) error {
+		return in.nodeStatusReporter.Report(ctx, status)
+	}); err != nil {
+		installLog.Warnf("failed to report node status: %v", err)
+	}
+}
+
+func fileSHA256(path string) (string, error) {
+	if path == "" {
+		return "", fmt.Errorf("no file path")
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:]), nil
+}
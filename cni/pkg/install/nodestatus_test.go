@@ -0,0 +1,134 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"istio.io/istio/cni/pkg/config"
+	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/tools/istio-iptables/pkg/dependencies"
+)
+
+func TestNodeStatusReporter(t *testing.T) {
+	node := &unstructured.Unstructured{}
+	node.SetAPIVersion("v1")
+	node.SetKind("Node")
+	node.SetName("node-1")
+	node.SetUID("node-uid")
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{NodeStatusGVR: "IstioCNINodeStatusList", nodeGVR: "NodeList"}, node)
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewNodeStatusReporter(client, "node-1", "")
+	r.now = func() time.Time { return now }
+
+	owner := []metav1.OwnerReference{{APIVersion: "v1", Kind: "Node", Name: "node-1", UID: "node-uid"}}
+	get := func() NodeStatus {
+		t.Helper()
+		obj, err := client.Resource(NodeStatusGVR).Get(context.Background(), "node-1", metav1.GetOptions{})
+		assert.NoError(t, err)
+		// The status is garbage collected along with the node
+		assert.Equal(t, obj.GetOwnerReferences(), owner)
+		status, _, err := unstructured.NestedMap(obj.Object, "status")
+		assert.NoError(t, err)
+		var res NodeStatus
+		assert.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(status, &res))
+		return res
+	}
+
+	// First report creates the object
+	assert.NoError(t, r.Report(context.Background(), NodeStatus{PluginVersion: "1.0", ConflistSHA256: "abc"}))
+	assert.Equal(t, get(), NodeStatus{PluginVersion: "1.0", ConflistSHA256: "abc", LastReconcileTime: metav1.NewTime(now)})
+
+	// Later reports update it
+	now = now.Add(time.Minute)
+	assert.NoError(t, r.Report(context.Background(), NodeStatus{PluginVersion: "1.1", ConflistSHA256: "def"}))
+	assert.Equal(t, get(), NodeStatus{PluginVersion: "1.1", ConflistSHA256: "def", LastReconcileTime: metav1.NewTime(now)})
+
+	// A status published without an owner is adopted
+	obj, err := client.Resource(NodeStatusGVR).Get(context.Background(), "node-1", metav1.GetOptions{})
+	assert.NoError(t, err)
+	obj.SetOwnerReferences(nil)
+	_, err = client.Resource(NodeStatusGVR).Update(context.Background(), obj, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	assert.NoError(t, r.Report(context.Background(), NodeStatus{PluginVersion: "1.1"}))
+	get()
+
+	// Nothing is published for a missing node
+	assert.Error(t, NewNodeStatusReporter(client, "missing", "").Report(context.Background(), NodeStatus{}))
+
+	// The status of another revision is owned by the same node
+	assert.NoError(t, NewNodeStatusReporter(client, "node-1", "canary").Report(context.Background(), NodeStatus{}))
+	obj, err = client.Resource(NodeStatusGVR).Get(context.Background(), "node-1-canary", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, obj.GetOwnerReferences(), owner)
+}
+
+func TestInstallerNodeStatus(t *testing.T) {
+	binDir := t.TempDir()
+	netDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(binDir, "istio-cni"), []byte("binary"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(netDir, "list.conflist"), []byte("conflist"), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(netDir, "kubeconfig"), []byte("kubeconfig"), 0o600))
+
+	in := NewInstaller(&config.InstallConfig{
+		MountedCNINetDir:   netDir,
+		KubeconfigFilename: "kubeconfig",
+		CNIBinTargetDirs:   []string{filepath.Join(binDir, "missing"), binDir},
+	}, nil)
+	in.cniConfigFilepath = filepath.Join(netDir, "list.conflist")
+
+	status := in.nodeStatus()
+	assert.Equal(t, status.PluginSHA256, "9a3a45d01531a20e89ac6ae10b0b0beb0492acd7216a368aa062d1a5fecaf9cd")
+	assert.Equal(t, status.ConflistSHA256, "50a32c0e8d8dbfe7455c1692f6aebbca26ee48f9a4a9548338112f3a7d826394")
+	assert.Equal(t, status.KubeconfigFingerprint, "7bed87591d8e748370af7323601ddb272b6fca75bde51165038f06084bc63b90")
+	assert.Equal(t, status.ConflistPath, in.cniConfigFilepath)
+}
+
+func TestInstallerIptablesBackend(t *testing.T) {
+	netDir := t.TempDir()
+	cfg := &config.InstallConfig{MountedCNINetDir: netDir}
+	in := NewInstaller(cfg, nil)
+
+	detection := dependencies.BackendDetection{Kubelet: dependencies.IptablesBackendNFT}
+	in.detectIptablesBackends = func() dependencies.BackendDetection { return detection }
+	in.detectIptablesBackend()
+	assert.Equal(t, in.nodeStatus().IptablesBackend, "nft")
+	assert.Equal(t, in.nodeStatus().IptablesBackendMismatch, "")
+
+	// Legacy rules alongside the nft kubelet chains are reported, and the backend of the kubelet is kept
+	detection.InUse = []dependencies.IptablesBackend{dependencies.IptablesBackendLegacy}
+	in.detectIptablesBackend()
+	assert.Equal(t, in.nodeStatus().IptablesBackend, "nft")
+	assert.Equal(t, in.nodeStatus().IptablesBackendMismatch, "the kubelet uses the nft iptables backend, but the node also has legacy rules")
+
+	// The CNI config is rewritten when it records another backend
+	conf := filepath.Join(netDir, "istio-cni.conf")
+	assert.NoError(t, os.WriteFile(conf, []byte(`{"type": "istio-cni", "iptables_backend": "legacy"}`), 0o644))
+	assert.Error(t, checkCNIConfigIptablesBackend(cfg, conf, dependencies.IptablesBackendNFT))
+	assert.NoError(t, checkCNIConfigIptablesBackend(cfg, conf, dependencies.IptablesBackendLegacy))
+	assert.NoError(t, os.WriteFile(conf, []byte(`{"type": "istio-cni"}`), 0o644))
+	assert.NoError(t, checkCNIConfigIptablesBackend(cfg, conf, dependencies.IptablesBackendNFT))
+}
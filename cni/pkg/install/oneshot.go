@@ -0,0 +1,175 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"istio.io/istio/cni/pkg/config"
+	"istio.io/istio/cni/pkg/constants"
+	"istio.io/istio/pkg/file"
+	"istio.io/istio/security/pkg/util"
+)
+
+// RunOnce installs the artifacts, verifies them and returns, rather than watching the node to reinstall them as Run
+// does. It suits the nodes where a long-running privileged DaemonSet is not allowed, the installer then being run by a
+// privileged Job or init container. The artifacts are left on the node, so Cleanup must not be called afterwards.
+//
+// The plugin keeps using the kubeconfig written by the installer, so its token must outlive the pod of the installer:
+// the projected token of the pod is bound to it and is refreshed by the DaemonSet only, so a long-lived token must be
+// provided, e.g. by a kubernetes.io/service-account-token Secret.
+func (in *Installer) RunOnce(ctx context.Context) error {
+	if err := checkLongLivedToken(in.cfg); err != nil {
+		return err
+	}
+	in.detectCapabilities()
+	if _, err := in.installAll(ctx); err != nil {
+		return err
+	}
+	if len(in.deferredChanges) > 0 {
+		installLog.Warnf("changes deferred until the maintenance window are not made by a one-shot installation: %v", in.deferredChanges)
+	}
+	if in.kubeconfigVerificationError != "" {
+		return fmt.Errorf("verify kubeconfig: %s", in.kubeconfigVerificationError)
+	}
+	if err := Verify(ctx, in.cfg, nil); err != nil {
+		return err
+	}
+	SetReady(in.isReady)
+	cniInstalls.With(resultLabel.Value(resultSuccess)).Increment()
+	installLog.Info("Installation succeed, exiting as a one-shot installation.")
+	return nil
+}
+
+// checkLongLivedToken returns an error unless the kubeconfig of the plugin is written with a token which does not
+// expire. Once the projected token of the pod is invalidated, every pod ADD on the node would fail.
+func checkLongLivedToken(cfg *config.InstallConfig) error {
+	if cfg.KubeconfigTokenFile == "" {
+		return fmt.Errorf("a one-shot installation needs a long-lived token for the plugin, set with %s, "+
+			"as the token of the pod is invalidated once the pod is deleted", constants.KubeconfigTokenFile)
+	}
+	token, err := os.ReadFile(cfg.KubeconfigTokenFile)
+	if err != nil {
+		return fmt.Errorf("read the token of the plugin: %v", err)
+	}
+	exp, err := util.GetExp(strings.TrimSpace(string(token)))
+	if err != nil {
+		return fmt.Errorf("invalid token %s: %v", cfg.KubeconfigTokenFile, err)
+	}
+	if !exp.IsZero() {
+		return fmt.Errorf("the token %s expires at %v, a one-shot installation needs a token which does not expire, "+
+			"e.g. of a kubernetes.io/service-account-token Secret", cfg.KubeconfigTokenFile, exp)
+	}
+	return nil
+}
+
+// Verify checks, without modifying the node, that the artifacts of the installer are installed: they are claimed by
+// its revision and owner, the istio-cni binary is in the binary directories, the CNI config file invokes the plugin
+// and the kubeconfig of the plugin exists. If verifyCredentials is set, the credentials of the kubeconfig are also
+// verified with the API server. All the failed checks are returned.
+//
+// It is shared by one-shot installations and the verify command, which the node can run at any time afterwards.
+func Verify(ctx context.Context, cfg *config.InstallConfig,
+	verifyCredentials func(ctx context.Context, kubeconfigFilepath string) error,
+) error {
+	var errs []error
+	if err := verifyClaim(cfg); err != nil {
+		errs = append(errs, fmt.Errorf("claim: %v", err))
+	}
+	if err := verifyBinaries(cfg); err != nil {
+		errs = append(errs, fmt.Errorf("binaries: %v", err))
+	}
+	if err := verifyCNIConfig(cfg); err != nil {
+		errs = append(errs, fmt.Errorf("CNI config: %v", err))
+	}
+	kubeconfigFilepath := filepath.Join(cfg.MountedCNINetDir, cfg.KubeconfigFilename)
+	if !file.Exists(kubeconfigFilepath) {
+		errs = append(errs, fmt.Errorf("kubeconfig: missing kubeconfig file %s", kubeconfigFilepath))
+	} else if verifyCredentials != nil {
+		if err := verifyCredentials(ctx, kubeconfigFilepath); err != nil {
+			errs = append(errs, fmt.Errorf("kubeconfig: %v", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// VerifyKubeconfigCredentials checks that the API server accepts the credentials of the kubeconfig written for the
+// plugin, with the permissions the plugin needs. It can be passed to Verify.
+func VerifyKubeconfigCredentials(ctx context.Context, kubeconfigFilepath string) error {
+	return verifyKubeconfigCredentials(ctx, kubeconfigFilepath)
+}
+
+// verifyClaim returns an error unless the artifacts are claimed by the revision and owner of the installer.
+func verifyClaim(cfg *config.InstallConfig) error {
+	path := claimFilepath(cfg)
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var claim artifactsClaim
+	if err := json.Unmarshal(b, &claim); err != nil || claim.Revision == "" {
+		return fmt.Errorf("invalid claim of the istio-cni artifacts %s: %s", path, string(b))
+	}
+	if claim.Revision != revision(cfg) || claimOwner(claim) != owner(cfg) {
+		return fmt.Errorf("the istio-cni artifacts are claimed by the %q revision of %q in %s, not by the %q revision of %q",
+			claim.Revision, claimOwner(claim), path, revision(cfg), owner(cfg))
+	}
+	return nil
+}
+
+// verifyBinaries returns an error unless the istio-cni binary is in each of the binary directories present on the node.
+func verifyBinaries(cfg *config.InstallConfig) error {
+	name := cfg.CNIBinariesPrefix + "istio-cni"
+	found := false
+	for _, targetDir := range cfg.CNIBinTargetDirs {
+		if info, err := os.Stat(targetDir); err != nil || !info.IsDir() {
+			continue
+		}
+		bin := filepath.Join(targetDir, name)
+		info, err := os.Stat(bin)
+		if err != nil {
+			return fmt.Errorf("missing binary %s", bin)
+		}
+		if info.Mode()&0o111 == 0 {
+			return fmt.Errorf("binary %s is not executable", bin)
+		}
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("none of the binary directories %v exists", cfg.CNIBinTargetDirs)
+	}
+	return nil
+}
+
+// verifyCNIConfig returns an error unless the CNI config file used by the container runtime invokes the plugin.
+func verifyCNIConfig(cfg *config.InstallConfig) error {
+	filename := cfg.CNIConfName
+	if filename == "" && !cfg.ChainedCNIPlugin {
+		filename = "YYY-istio-cni.conf"
+	}
+	if filename == "" {
+		var err error
+		if filename, err = getDefaultCNINetwork(cfg.MountedCNINetDir); err != nil {
+			return err
+		}
+	}
+	return checkValidCNIConfig(cfg, filepath.Join(cfg.MountedCNINetDir, filename))
+}
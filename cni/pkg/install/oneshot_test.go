@@ -0,0 +1,97 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"istio.io/istio/cni/pkg/config"
+	"istio.io/istio/pkg/file"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestVerify(t *testing.T) {
+	ctx := context.Background()
+	netDir := t.TempDir()
+	binDir := t.TempDir()
+	cfg := &config.InstallConfig{
+		MountedCNINetDir:   netDir,
+		ChainedCNIPlugin:   true,
+		KubeconfigFilename: "ZZZ-istio-cni-kubeconfig",
+		CNIBinTargetDirs:   []string{binDir, filepath.Join(t.TempDir(), "missing")},
+	}
+
+	// Every missing artifact is reported
+	err := Verify(ctx, cfg, nil)
+	assert.Error(t, err)
+	for _, check := range []string{"claim:", "binaries:", "CNI config:", "kubeconfig:"} {
+		assert.Equal(t, strings.Contains(err.Error(), check), true)
+	}
+
+	assert.NoError(t, claimArtifacts(cfg))
+	assert.NoError(t, os.WriteFile(filepath.Join(binDir, "istio-cni"), []byte("binary"), 0o644))
+	assert.NoError(t, file.AtomicCopy(filepath.Join("testdata", "list.conflist.golden"), netDir, "list.conflist"))
+	assert.NoError(t, os.WriteFile(filepath.Join(netDir, cfg.KubeconfigFilename), []byte("kubeconfig"), 0o600))
+
+	// The binary must be executable
+	err = Verify(ctx, cfg, nil)
+	assert.Error(t, err)
+	assert.Equal(t, strings.Contains(err.Error(), "not executable"), true)
+
+	// The binary directories missing from the node are not checked
+	assert.NoError(t, os.Chmod(filepath.Join(binDir, "istio-cni"), 0o755))
+	assert.NoError(t, Verify(ctx, cfg, nil))
+
+	// The credentials are only verified if requested
+	rejected := func(context.Context, string) error { return errors.New("unauthorized") }
+	err = Verify(ctx, cfg, rejected)
+	assert.Error(t, err)
+	assert.Equal(t, strings.Contains(err.Error(), "kubeconfig: unauthorized"), true)
+
+	// The artifacts of another revision are not those of the installer
+	canary := *cfg
+	canary.Revision = "canary"
+	err = Verify(ctx, &canary, nil)
+	assert.Error(t, err)
+	assert.Equal(t, strings.Contains(err.Error(), "claim:"), true)
+}
+
+func TestCheckLongLivedToken(t *testing.T) {
+	jwt := func(claims string) string {
+		return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".c2ln"
+	}
+	dir := t.TempDir()
+	cfg := &config.InstallConfig{}
+	// The token of the pod does not outlive it
+	assert.Error(t, checkLongLivedToken(cfg))
+
+	cfg.KubeconfigTokenFile = filepath.Join(dir, "token")
+	assert.Error(t, checkLongLivedToken(cfg))
+
+	assert.NoError(t, os.WriteFile(cfg.KubeconfigTokenFile, []byte(jwt(`{"sub":"system:serviceaccount:istio-system:istio-cni","exp":4102444800}`)), 0o600))
+	err := checkLongLivedToken(cfg)
+	assert.Error(t, err)
+	assert.Equal(t, strings.Contains(err.Error(), "expires"), true)
+
+	// The tokens of the service-account-token Secrets do not expire
+	assert.NoError(t, os.WriteFile(cfg.KubeconfigTokenFile, []byte(jwt(`{"sub":"system:serviceaccount:istio-system:istio-cni"}`)+"\n"), 0o600))
+	assert.NoError(t, checkLongLivedToken(cfg))
+}
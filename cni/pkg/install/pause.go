@@ -0,0 +1,232 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ReconcilePauseAnnotation is the node annotation pausing the reconciliation of some of the node artifacts, so that
+// they can be edited by hand while debugging an incident, without the installer reverting them. Its value is a comma
+// separated list of <artifact>=<expiry>, the expiry being an RFC 3339 time, e.g.
+// "cni-config=2024-05-01T12:00:00Z". The artifacts are binaries, cni-config and kubeconfig.
+const ReconcilePauseAnnotation = "cni.istio.io/reconcile-paused"
+
+// The artifacts whose reconciliation can be paused.
+const (
+	artifactBinaries   = "binaries"
+	artifactCNIConfig  = "cni-config"
+	artifactKubeconfig = "kubeconfig"
+)
+
+var pausableArtifacts = []string{artifactBinaries, artifactCNIConfig, artifactKubeconfig}
+
+// maxReconcilePause bounds the expiry of the pauses, so that a forgotten pause does not leave the node unmanaged.
+const maxReconcilePause = 24 * time.Hour
+
+// ReconcilePause pauses the reconciliation of node artifacts.
+type ReconcilePause interface {
+	// Paused returns the paused artifacts, with the expiry of their pause.
+	Paused(ctx context.Context) (map[string]time.Time, error)
+	// Notify reports that the reconciliation of an artifact was paused or resumed.
+	Notify(ctx context.Context, paused bool, message string)
+}
+
+// nodeAnnotationReconcilePause pauses the artifacts listed in the ReconcilePauseAnnotation of the node, reporting the
+// pauses with events on the node.
+type nodeAnnotationReconcilePause struct {
+	client   kubernetes.Interface
+	nodeName string
+}
+
+// NewNodeAnnotationReconcilePause returns a ReconcilePause pausing the artifacts listed in the
+// ReconcilePauseAnnotation of the node.
+func NewNodeAnnotationReconcilePause(client kubernetes.Interface, nodeName string) ReconcilePause {
+	return &nodeAnnotationReconcilePause{
+		client:   client,
+		nodeName: nodeName,
+	}
+}
+
+func (p *nodeAnnotationReconcilePause) Paused(ctx context.Context) (map[string]time.Time, error) {
+	node, err := p.client.CoreV1().Nodes().Get(ctx, p.nodeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return parseReconcilePause(node.Annotations[ReconcilePauseAnnotation])
+}
+
+func (p *nodeAnnotationReconcilePause) Notify(ctx context.Context, paused bool, message string) {
+	eventType, reason := corev1.EventTypeNormal, "IstioCNIReconcileResumed"
+	if paused {
+		eventType, reason = corev1.EventTypeWarning, "IstioCNIReconcilePaused"
+	}
+	now := metav1.Now()
+	// Node events are recorded in the default namespace and named like the kubelet does
+	event := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s.%x", p.nodeName, now.UnixNano()),
+			Namespace: metav1.NamespaceDefault,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind: "Node",
+			Name: p.nodeName,
+		},
+		Reason:         reason,
+		Message:        message,
+		Type:           eventType,
+		Source:         corev1.EventSource{Component: "istio-cni", Host: p.nodeName},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+	}
+	if _, err := p.client.CoreV1().Events(metav1.NamespaceDefault).Create(ctx, event, metav1.CreateOptions{}); err != nil {
+		installLog.Warnf("failed to record the %s event on node %s: %v", reason, p.nodeName, err)
+	}
+}
+
+// parseReconcilePause parses the value of the ReconcilePauseAnnotation.
+func parseReconcilePause(value string) (map[string]time.Time, error) {
+	paused := map[string]time.Time{}
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		artifact, expiry, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid entry %q, expected <artifact>=<expiry>", entry)
+		}
+		artifact = strings.TrimSpace(artifact)
+		if !isPausableArtifact(artifact) {
+			return nil, fmt.Errorf("unknown artifact %q, expected one of %v", artifact, pausableArtifacts)
+		}
+		t, err := time.Parse(time.RFC3339, strings.TrimSpace(expiry))
+		if err != nil {
+			return nil, fmt.Errorf("invalid expiry of the %s pause: %v", artifact, err)
+		}
+		paused[artifact] = t
+	}
+	return paused, nil
+}
+
+func isPausableArtifact(artifact string) bool {
+	for _, a := range pausableArtifacts {
+		if a == artifact {
+			return true
+		}
+	}
+	return false
+}
+
+// SetReconcilePause configures the installer to leave the artifacts paused by p untouched until their pause expires.
+func (in *Installer) SetReconcilePause(p ReconcilePause) {
+	in.reconcilePause = p
+}
+
+// activeReconcilePauses returns the artifacts whose reconciliation is currently paused. Failing to check the pauses,
+// as during an outage of the API server, keeps the last known pauses until they expire, so that the hand edits they
+// protect are not reverted. Pauses expiring further than maxReconcilePause are ignored.
+func (in *Installer) activeReconcilePauses(ctx context.Context) map[string]time.Time {
+	if in.reconcilePause == nil {
+		return nil
+	}
+	var paused map[string]time.Time
+	err := in.apiCalls.do(ctx, "reconcile-pause", func(ctx context.Context) (err error) {
+		paused, err = in.reconcilePause.Paused(ctx)
+		return err
+	})
+	if err != nil {
+		installLog.Warnf("failed to check the paused artifacts, keeping the last known pauses: %v", err)
+		paused = make(map[string]time.Time, len(in.pausedArtifacts))
+		for artifact, expiry := range in.pausedArtifacts {
+			paused[artifact] = expiry
+		}
+	}
+	now := in.now()
+	for artifact, expiry := range paused {
+		if !expiry.After(now) {
+			delete(paused, artifact)
+		} else if expiry.Sub(now) > maxReconcilePause {
+			installLog.Warnf("ignoring the pause of the %s reconciliation until %s, pauses are limited to %v",
+				artifact, expiry.Format(time.RFC3339), maxReconcilePause)
+			delete(paused, artifact)
+		}
+	}
+	return paused
+}
+
+// updateReconcilePauses refreshes the paused artifacts before an install. The pauses are reported loudly, as the
+// installer does not repair the paused artifacts.
+func (in *Installer) updateReconcilePauses(ctx context.Context) {
+	paused := in.activeReconcilePauses(ctx)
+	for _, artifact := range pausableArtifacts {
+		expiry, isPaused := paused[artifact]
+		_, wasPaused := in.pausedArtifacts[artifact]
+		if isPaused && !wasPaused {
+			in.reconcilePause.Notify(ctx, true, fmt.Sprintf("Istio CNI reconciliation of the %s paused until %s by the %s annotation",
+				artifact, expiry.Format(time.RFC3339), ReconcilePauseAnnotation))
+		} else if !isPaused && wasPaused {
+			in.reconcilePause.Notify(ctx, false, fmt.Sprintf("Istio CNI reconciliation of the %s resumed", artifact))
+		}
+		if isPaused {
+			installLog.Warnf("reconciliation of the %s is paused until %s, its changes are not reverted",
+				artifact, expiry.Format(time.RFC3339))
+			reconcilePaused.With(artifactLabel.Value(artifact)).Record(1)
+		} else {
+			reconcilePaused.With(artifactLabel.Value(artifact)).Record(0)
+		}
+	}
+	in.pausedArtifacts = paused
+}
+
+// paused returns whether the reconciliation of the artifact is paused.
+func (in *Installer) paused(artifact string) bool {
+	_, f := in.pausedArtifacts[artifact]
+	return f
+}
+
+// pausedArtifactNames returns the sorted names of the paused artifacts.
+func (in *Installer) pausedArtifactNames() []string {
+	var names []string
+	for artifact := range in.pausedArtifacts {
+		names = append(names, artifact)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// reconcilePauseChanged returns whether the paused artifacts changed since the last install, e.g. as a pause expired
+// or was lifted.
+func (in *Installer) reconcilePauseChanged(ctx context.Context) bool {
+	paused := in.activeReconcilePauses(ctx)
+	if len(paused) != len(in.pausedArtifacts) {
+		return true
+	}
+	for artifact, expiry := range paused {
+		if prev, f := in.pausedArtifacts[artifact]; !f || !prev.Equal(expiry) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"istio.io/istio/cni/pkg/config"
+	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/pkg/test/util/file"
+	"istio.io/istio/pkg/util/sets"
+)
+
+func TestParseReconcilePause(t *testing.T) {
+	expiry := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name    string
+		value   string
+		want    map[string]time.Time
+		wantErr bool
+	}{
+		{name: "empty", value: "", want: map[string]time.Time{}},
+		{
+			name:  "single",
+			value: "cni-config=2024-05-01T12:00:00Z",
+			want:  map[string]time.Time{artifactCNIConfig: expiry},
+		},
+		{
+			name:  "several",
+			value: "cni-config=2024-05-01T12:00:00Z, kubeconfig = 2024-05-01T14:00:00+02:00",
+			want:  map[string]time.Time{artifactCNIConfig: expiry, artifactKubeconfig: expiry},
+		},
+		{name: "no expiry", value: "cni-config", wantErr: true},
+		{name: "invalid expiry", value: "cni-config=1h", wantErr: true},
+		{name: "unknown artifact", value: "conflist=2024-05-01T12:00:00Z", wantErr: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got, err := parseReconcilePause(c.value)
+			if c.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, len(got), len(c.want))
+			for artifact, expiry := range c.want {
+				assert.Equal(t, got[artifact].Equal(expiry), true)
+			}
+		})
+	}
+}
+
+func TestReconcilePause(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	client := fake.NewSimpleClientset(node)
+	in := &Installer{cfg: &config.InstallConfig{}, now: func() time.Time { return now }}
+	in.SetReconcilePause(NewNodeAnnotationReconcilePause(client, "node-1"))
+	ctx := context.Background()
+	setAnnotation := func(value string) {
+		node.Annotations = map[string]string{ReconcilePauseAnnotation: value}
+		_, err := client.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+		assert.NoError(t, err)
+	}
+	events := func() []string {
+		list, err := client.CoreV1().Events(metav1.NamespaceDefault).List(ctx, metav1.ListOptions{})
+		assert.NoError(t, err)
+		var reasons []string
+		for _, e := range list.Items {
+			reasons = append(reasons, e.Reason)
+		}
+		return reasons
+	}
+
+	in.updateReconcilePauses(ctx)
+	assert.Equal(t, in.paused(artifactCNIConfig), false)
+	assert.Equal(t, len(events()), 0)
+
+	// Expired pauses and pauses longer than the limit are ignored
+	setAnnotation("cni-config=2024-05-01T13:00:00Z,binaries=2024-05-01T11:00:00Z,kubeconfig=2024-05-03T12:00:00Z")
+	assert.Equal(t, in.reconcilePauseChanged(ctx), true)
+	in.updateReconcilePauses(ctx)
+	assert.Equal(t, in.paused(artifactCNIConfig), true)
+	assert.Equal(t, in.paused(artifactBinaries), false)
+	assert.Equal(t, in.paused(artifactKubeconfig), false)
+	assert.Equal(t, in.nodeStatus().PausedArtifacts, []string{artifactCNIConfig})
+	assert.Equal(t, events(), []string{"IstioCNIReconcilePaused"})
+	assert.Equal(t, in.reconcilePauseChanged(ctx), false)
+
+	// The pause expires
+	now = now.Add(2 * time.Hour)
+	assert.Equal(t, in.reconcilePauseChanged(ctx), true)
+	in.updateReconcilePauses(ctx)
+	assert.Equal(t, in.paused(artifactCNIConfig), false)
+	assert.Equal(t, len(in.nodeStatus().PausedArtifacts), 0)
+	assert.Equal(t, len(events()), 2)
+
+	// An invalid annotation pauses nothing
+	setAnnotation("cni-config=2024-05-01T15:00:00Z,conflist=2024-05-01T15:00:00Z")
+	assert.Equal(t, in.reconcilePauseChanged(ctx), false)
+}
+
+// stubReconcilePause returns its pauses, or its error if set.
+type stubReconcilePause struct {
+	paused map[string]time.Time
+	err    error
+}
+
+func (p *stubReconcilePause) Paused(context.Context) (map[string]time.Time, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return p.paused, nil
+}
+
+func (p *stubReconcilePause) Notify(context.Context, bool, string) {}
+
+func TestReconcilePauseReadFailure(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	pause := &stubReconcilePause{paused: map[string]time.Time{artifactCNIConfig: now.Add(time.Hour)}}
+	in := &Installer{cfg: &config.InstallConfig{}, now: func() time.Time { return now }}
+	in.SetReconcilePause(pause)
+	ctx := context.Background()
+
+	in.updateReconcilePauses(ctx)
+	assert.Equal(t, in.paused(artifactCNIConfig), true)
+
+	// The last known pauses are kept while they cannot be read
+	pause.err = errors.New("api server unavailable")
+	assert.Equal(t, in.reconcilePauseChanged(ctx), false)
+	in.updateReconcilePauses(ctx)
+	assert.Equal(t, in.paused(artifactCNIConfig), true)
+
+	// Until they expire
+	now = now.Add(2 * time.Hour)
+	assert.Equal(t, in.reconcilePauseChanged(ctx), true)
+	in.updateReconcilePauses(ctx)
+	assert.Equal(t, in.paused(artifactCNIConfig), false)
+}
+
+func TestBinaryFilenames(t *testing.T) {
+	srcDir := t.TempDir()
+	file.WriteOrFail(t, filepath.Join(srcDir, "istio-cni"), []byte("cni"))
+	file.WriteOrFail(t, filepath.Join(srcDir, "istio-iptables"), []byte("iptables"))
+	names, err := binaryFilenames(srcDir, "prefix-")
+	assert.NoError(t, err)
+	assert.Equal(t, sets.SortedList(names), []string{"prefix-istio-cni", "prefix-istio-iptables"})
+}
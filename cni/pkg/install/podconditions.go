@@ -0,0 +1,142 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// The conditions reported on the istio-cni pod, detailing the state of the installation on its node.
+const (
+	// PodConditionBinariesInstalled is true if the istio-cni binary is installed in the binary directories of the node.
+	PodConditionBinariesInstalled corev1.PodConditionType = "cni.istio.io/BinariesInstalled"
+	// PodConditionKubeconfigValid is true if the kubeconfig of the plugin is installed and its credentials are accepted.
+	PodConditionKubeconfigValid corev1.PodConditionType = "cni.istio.io/KubeconfigValid"
+	// PodConditionConflistChained is true if the CNI config used by the container runtime invokes the plugin.
+	PodConditionConflistChained corev1.PodConditionType = "cni.istio.io/ConflistChained"
+)
+
+// PodConditionReporter patches the install conditions of the istio-cni pod.
+type PodConditionReporter struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+	now       func() time.Time
+}
+
+func NewPodConditionReporter(client kubernetes.Interface, namespace, name string) *PodConditionReporter {
+	return &PodConditionReporter{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		now:       time.Now,
+	}
+}
+
+// Report patches the given conditions on the pod. The transition time of a condition is kept unless its status changes.
+func (r *PodConditionReporter) Report(ctx context.Context, conditions []corev1.PodCondition) error {
+	pod, err := r.client.CoreV1().Pods(r.namespace).Get(ctx, r.name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	existing := map[corev1.PodConditionType]corev1.PodCondition{}
+	for _, c := range pod.Status.Conditions {
+		existing[c.Type] = c
+	}
+	now := metav1.NewTime(r.now())
+	changed := false
+	for i, c := range conditions {
+		old, f := existing[c.Type]
+		if f && old.Status == c.Status {
+			conditions[i].LastTransitionTime = old.LastTransitionTime
+		} else {
+			conditions[i].LastTransitionTime = now
+		}
+		if !f || old.Status != c.Status || old.Reason != c.Reason || old.Message != c.Message {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	// The conditions are merged by type, leaving the conditions of the kubelet untouched
+	patch, err := json.Marshal(map[string]any{"status": map[string]any{"conditions": conditions}})
+	if err != nil {
+		return err
+	}
+	_, err = r.client.CoreV1().Pods(r.namespace).Patch(ctx, r.name, types.StrategicMergePatchType, patch, metav1.PatchOptions{}, "status")
+	return err
+}
+
+// SetPodConditionReporter configures the installer to patch the install conditions of its pod after each install.
+func (in *Installer) SetPodConditionReporter(reporter *PodConditionReporter) {
+	in.podConditionReporter = reporter
+}
+
+// podConditions computes the conditions of the artifacts currently installed on the node.
+func (in *Installer) podConditions() []corev1.PodCondition {
+	kubeconfigErr := in.verifyKubeconfigInstalled()
+	var conflistErr error
+	if in.cniConfigFilepath != "" {
+		conflistErr = checkValidCNIConfig(in.cfg, in.cniConfigFilepath)
+	} else {
+		conflistErr = verifyCNIConfig(in.cfg)
+	}
+	return []corev1.PodCondition{
+		podCondition(PodConditionBinariesInstalled, verifyBinaries(in.cfg), "BinaryMissing"),
+		podCondition(PodConditionKubeconfigValid, kubeconfigErr, "KubeconfigInvalid"),
+		podCondition(PodConditionConflistChained, conflistErr, "PluginNotChained"),
+	}
+}
+
+// verifyKubeconfigInstalled returns an error unless the kubeconfig is installed and its credentials are not rejected.
+func (in *Installer) verifyKubeconfigInstalled() error {
+	if _, err := os.Stat(in.kubeconfigFilepath); err != nil {
+		return fmt.Errorf("missing kubeconfig %s", in.kubeconfigFilepath)
+	}
+	if in.kubeconfigVerificationError != "" {
+		return fmt.Errorf("credentials not accepted by the API server: %v", in.kubeconfigVerificationError)
+	}
+	return nil
+}
+
+func podCondition(t corev1.PodConditionType, err error, reason string) corev1.PodCondition {
+	if err != nil {
+		return corev1.PodCondition{Type: t, Status: corev1.ConditionFalse, Reason: reason, Message: err.Error()}
+	}
+	return corev1.PodCondition{Type: t, Status: corev1.ConditionTrue}
+}
+
+// reportPodConditions patches the pod conditions, if enabled. Failures are not fatal to the installation.
+func (in *Installer) reportPodConditions(ctx context.Context) {
+	if in.podConditionReporter == nil {
+		return
+	}
+	conditions := in.podConditions()
+	if err := in.apiCalls.do(ctx, "pod-conditions", func(ctx context.Context) error {
+		return in.podConditionReporter.Report(ctx, conditions)
+	}); err != nil {
+		installLog.Warnf("failed to report pod conditions: %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"istio.io/istio/cni/pkg/config"
+	"istio.io/istio/pkg/file"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestPodConditionReporter(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "istio-cni-node-abc", Namespace: "istio-system"},
+		Status: corev1.PodStatus{Conditions: []corev1.PodCondition{
+			{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+		}},
+	})
+	now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	r := NewPodConditionReporter(client, "istio-system", "istio-cni-node-abc")
+	r.now = func() time.Time { return now }
+
+	get := func() map[corev1.PodConditionType]corev1.PodCondition {
+		t.Helper()
+		pod, err := client.CoreV1().Pods("istio-system").Get(ctx, "istio-cni-node-abc", metav1.GetOptions{})
+		assert.NoError(t, err)
+		res := map[corev1.PodConditionType]corev1.PodCondition{}
+		for _, c := range pod.Status.Conditions {
+			res[c.Type] = c
+		}
+		return res
+	}
+
+	assert.NoError(t, r.Report(ctx, []corev1.PodCondition{
+		{Type: PodConditionBinariesInstalled, Status: corev1.ConditionTrue},
+		{Type: PodConditionConflistChained, Status: corev1.ConditionFalse, Reason: "PluginNotChained", Message: "missing"},
+	}))
+	conditions := get()
+	// The conditions of the kubelet are left untouched
+	assert.Equal(t, conditions[corev1.PodReady].Status, corev1.ConditionTrue)
+	assert.Equal(t, conditions[PodConditionBinariesInstalled].Status, corev1.ConditionTrue)
+	assert.Equal(t, conditions[PodConditionConflistChained].Message, "missing")
+	assert.Equal(t, conditions[PodConditionConflistChained].LastTransitionTime.Time.Equal(now), true)
+
+	// The transition time only changes with the status
+	later := now.Add(time.Minute)
+	r.now = func() time.Time { return later }
+	assert.NoError(t, r.Report(ctx, []corev1.PodCondition{
+		{Type: PodConditionBinariesInstalled, Status: corev1.ConditionTrue},
+		{Type: PodConditionConflistChained, Status: corev1.ConditionTrue},
+	}))
+	conditions = get()
+	assert.Equal(t, conditions[PodConditionBinariesInstalled].LastTransitionTime.Time.Equal(now), true)
+	assert.Equal(t, conditions[PodConditionConflistChained].Status, corev1.ConditionTrue)
+	assert.Equal(t, conditions[PodConditionConflistChained].LastTransitionTime.Time.Equal(later), true)
+}
+
+func TestInstallerPodConditions(t *testing.T) {
+	netDir := t.TempDir()
+	binDir := t.TempDir()
+	in := NewInstaller(&config.InstallConfig{
+		MountedCNINetDir:   netDir,
+		ChainedCNIPlugin:   true,
+		KubeconfigFilename: "kubeconfig",
+		CNIBinTargetDirs:   []string{binDir},
+	}, nil)
+
+	status := func() map[corev1.PodConditionType]corev1.ConditionStatus {
+		res := map[corev1.PodConditionType]corev1.ConditionStatus{}
+		for _, c := range in.podConditions() {
+			res[c.Type] = c.Status
+		}
+		return res
+	}
+	assert.Equal(t, status(), map[corev1.PodConditionType]corev1.ConditionStatus{
+		PodConditionBinariesInstalled: corev1.ConditionFalse,
+		PodConditionKubeconfigValid:   corev1.ConditionFalse,
+		PodConditionConflistChained:   corev1.ConditionFalse,
+	})
+
+	assert.NoError(t, os.WriteFile(filepath.Join(binDir, "istio-cni"), []byte("binary"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(netDir, "kubeconfig"), []byte("kubeconfig"), 0o600))
+	assert.NoError(t, file.AtomicCopy(filepath.Join("testdata", "list.conflist.golden"), netDir, "list.conflist"))
+	assert.Equal(t, status(), map[corev1.PodConditionType]corev1.ConditionStatus{
+		PodConditionBinariesInstalled: corev1.ConditionTrue,
+		PodConditionKubeconfigValid:   corev1.ConditionTrue,
+		PodConditionConflistChained:   corev1.ConditionTrue,
+	})
+
+	// The kubeconfig is not valid while its credentials are rejected
+	in.kubeconfigVerificationError = "unauthorized"
+	assert.Equal(t, status()[PodConditionKubeconfigValid], corev1.ConditionFalse)
+}
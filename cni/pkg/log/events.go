@@ -0,0 +1,76 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"istio.io/istio/cni/pkg/constants"
+	"istio.io/istio/pkg/log"
+	"istio.io/istio/pkg/monitoring"
+)
+
+// PluginEvent reports an event of a CNI plugin invocation to the node agent. Unlike the timing, which is only sent
+// if enabled, events are always reported so their metrics are recorded.
+type PluginEvent struct {
+	Type string `json:"type"`
+	// Pod is the namespace/name of the pod, if known
+	Pod string `json:"pod,omitempty"`
+}
+
+// PluginEventSandboxChanged is reported when the pod had its sandbox recreated with new IPs, and the redirection of
+// the previous ones was removed.
+const PluginEventSandboxChanged = "sandboxChanged"
+
+var podSandboxChanges = monitoring.NewSum(
+	"istio_cni_pod_sandbox_changes_total",
+	"Number of pods added by the CNI plugin whose sandbox was recreated, leaving stale redirection to remove",
+)
+
+// ReportPluginEvent sends an event of a plugin invocation to the node agent listening on the UDS address.
+func ReportPluginEvent(address string, event PluginEvent) error {
+	return postToUDS(address, constants.UDSEventPath, event)
+}
+
+func (l *UDSLogger) handleEvent(w http.ResponseWriter, req *http.Request) {
+	if req.Body == nil {
+		return
+	}
+	defer req.Body.Close()
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		log.Errorf("Failed to read event from cni plugin: %v", err)
+		return
+	}
+	var event PluginEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		log.Errorf("Failed to unmarshal CNI plugin event: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	processEvent(event)
+}
+
+func processEvent(event PluginEvent) {
+	switch event.Type {
+	case PluginEventSandboxChanged:
+		podSandboxChanges.Increment()
+		pluginLog.WithLabels("pod", event.Pod).Infof("removed redirection of the previous sandbox of the pod")
+	default:
+		pluginLog.Debugf("ignoring unknown plugin event %q", event.Type)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"path/filepath"
+	"testing"
+
+	"istio.io/istio/pkg/monitoring/monitortest"
+	"istio.io/istio/pkg/test"
+)
+
+func TestPluginEventSandboxChange(t *testing.T) {
+	mt := monitortest.New(t)
+	udsSock := filepath.Join(t.TempDir(), "cni.sock")
+	logger := NewUDSLogger()
+	if err := logger.StartUDSLogServer(udsSock, test.NewStop(t)); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, typ := range []string{"unknown", PluginEventSandboxChanged} {
+		if err := ReportPluginEvent(udsSock, PluginEvent{Type: typ, Pod: "default/pod"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mt.Assert(podSandboxChanges.Name(), nil, monitortest.Exactly(1))
+}
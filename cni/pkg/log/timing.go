@@ -0,0 +1,179 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	traceapi "go.opentelemetry.io/otel/trace"
+
+	"istio.io/istio/cni/pkg/constants"
+	"istio.io/istio/pkg/log"
+	"istio.io/istio/pkg/monitoring"
+	"istio.io/istio/pkg/tracing"
+)
+
+// PluginTiming reports the duration of a CNI plugin invocation, and of its phases, to the node agent.
+type PluginTiming struct {
+	// Command is the CNI command, such as ADD
+	Command string `json:"command"`
+	// Pod is the namespace/name of the pod, if known
+	Pod      string        `json:"pod,omitempty"`
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+	Result   string        `json:"result"`
+	Phases   []PluginPhase `json:"phases,omitempty"`
+	// ErrorCode is the name of the code of the error of a failed invocation
+	ErrorCode string `json:"errorCode,omitempty"`
+}
+
+// PluginPhase is a phase of a CNI plugin invocation, such as loading the kubeconfig or programming the
+// redirection rules.
+type PluginPhase struct {
+	Name     string        `json:"name"`
+	Start    time.Time     `json:"start"`
+	Duration time.Duration `json:"duration"`
+}
+
+const (
+	PluginResultSuccess = "success"
+	PluginResultError   = "error"
+)
+
+var (
+	commandLabel = monitoring.CreateLabel("command")
+	phaseLabel   = monitoring.CreateLabel("phase")
+	resultLabel  = monitoring.CreateLabel("result")
+	codeLabel    = monitoring.CreateLabel("code")
+
+	pluginDurationBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30}
+
+	pluginDuration = monitoring.NewDistribution(
+		"istio_cni_plugin_duration_seconds",
+		"Duration of CNI plugin invocations",
+		pluginDurationBuckets,
+	)
+
+	pluginPhaseDuration = monitoring.NewDistribution(
+		"istio_cni_plugin_phase_duration_seconds",
+		"Duration of the phases of CNI plugin invocations",
+		pluginDurationBuckets,
+	)
+
+	pluginErrors = monitoring.NewSum(
+		"istio_cni_plugin_errors_total",
+		"Number of failed CNI plugin invocations, by error code",
+	)
+)
+
+// ReportPluginTiming sends the timing of a plugin invocation to the node agent listening on the UDS address.
+func ReportPluginTiming(address string, timing PluginTiming) error {
+	return postToUDS(address, constants.UDSTimingPath, timing)
+}
+
+// postToUDS sends a report of the plugin to the node agent listening on the UDS address.
+func postToUDS(address, path string, report any) error {
+	body, err := json.Marshal(report)
+	if err != nil {
+		return err
+	}
+	c := http.Client{
+		Transport: &http.Transport{
+			DialContext: func(_ context.Context, _, _ string) (net.Conn, error) {
+				return net.Dial("unix", address)
+			},
+		},
+		Timeout: 100 * time.Millisecond,
+	}
+	resp, err := c.Post("http://unix"+path, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (l *UDSLogger) handleTiming(w http.ResponseWriter, req *http.Request) {
+	if req.Body == nil {
+		return
+	}
+	defer req.Body.Close()
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		log.Errorf("Failed to read timing report from cni plugin: %v", err)
+		return
+	}
+	var timing PluginTiming
+	if err := json.Unmarshal(data, &timing); err != nil {
+		log.Errorf("Failed to unmarshal CNI plugin timing: %v", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	l.processTiming(timing)
+}
+
+func (l *UDSLogger) processTiming(timing PluginTiming) {
+	pluginDuration.With(commandLabel.Value(timing.Command), resultLabel.Value(timing.Result)).Record(timing.Duration.Seconds())
+	for _, p := range timing.Phases {
+		pluginPhaseDuration.With(commandLabel.Value(timing.Command), phaseLabel.Value(p.Name)).Record(p.Duration.Seconds())
+	}
+	if timing.ErrorCode != "" {
+		pluginErrors.With(commandLabel.Value(timing.Command), codeLabel.Value(timing.ErrorCode)).Increment()
+	}
+
+	scope := pluginLog.WithLabels("command", timing.Command, "result", timing.Result, "duration", timing.Duration)
+	if timing.Pod != "" {
+		scope = scope.WithLabels("pod", timing.Pod)
+	}
+	for _, p := range timing.Phases {
+		scope = scope.WithLabels(p.Name, p.Duration)
+	}
+	if timing.ErrorCode != "" {
+		scope = scope.WithLabels("errorCode", timing.ErrorCode)
+	}
+	scope.Debugf("plugin invocation complete")
+
+	if l.tracing {
+		emitPluginSpans(timing)
+	}
+}
+
+// emitPluginSpans records the invocation as a span, with a child span per phase, using the timestamps
+// reported by the plugin.
+func emitPluginSpans(timing PluginTiming) {
+	ctx, span := tracing.Start(context.Background(), "cni "+timing.Command,
+		traceapi.WithTimestamp(timing.Start),
+		traceapi.WithAttributes(
+			attribute.String("cni.command", timing.Command),
+			attribute.String("cni.result", timing.Result),
+			attribute.String("k8s.pod", timing.Pod),
+		))
+	for _, p := range timing.Phases {
+		_, ps := tracing.Start(ctx, p.Name, traceapi.WithTimestamp(p.Start))
+		ps.End(traceapi.WithTimestamp(p.Start.Add(p.Duration)))
+	}
+	span.End(traceapi.WithTimestamp(timing.Start.Add(timing.Duration)))
+}
@@ -0,0 +1,76 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"istio.io/istio/pkg/monitoring/monitortest"
+	"istio.io/istio/pkg/test"
+)
+
+func TestPluginTiming(t *testing.T) {
+	mt := monitortest.New(t)
+	udsSock := filepath.Join(t.TempDir(), "cni.sock")
+	logger := NewUDSLogger()
+	if err := logger.StartUDSLogServer(udsSock, test.NewStop(t)); err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	err := ReportPluginTiming(udsSock, PluginTiming{
+		Command:  "ADD",
+		Pod:      "default/pod",
+		Start:    start,
+		Duration: 3 * time.Second,
+		Result:   PluginResultSuccess,
+		Phases: []PluginPhase{
+			{Name: "kubeconfig", Start: start, Duration: time.Second},
+			{Name: "rules", Start: start.Add(time.Second), Duration: 2 * time.Second},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mt.Assert(pluginDuration.Name(), map[string]string{"command": "ADD", "result": PluginResultSuccess}, monitortest.Distribution(1, 3))
+	mt.Assert(pluginPhaseDuration.Name(), map[string]string{"command": "ADD", "phase": "kubeconfig"}, monitortest.Distribution(1, 1))
+	mt.Assert(pluginPhaseDuration.Name(), map[string]string{"command": "ADD", "phase": "rules"}, monitortest.Distribution(1, 2))
+}
+
+func TestPluginTimingErrorCode(t *testing.T) {
+	mt := monitortest.New(t)
+	udsSock := filepath.Join(t.TempDir(), "cni.sock")
+	logger := NewUDSLogger()
+	if err := logger.StartUDSLogServer(udsSock, test.NewStop(t)); err != nil {
+		t.Fatal(err)
+	}
+
+	err := ReportPluginTiming(udsSock, PluginTiming{
+		Command:   "ADD",
+		Pod:       "default/pod",
+		Start:     time.Now(),
+		Duration:  time.Second,
+		Result:    PluginResultError,
+		ErrorCode: "token_expired",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mt.Assert(pluginErrors.Name(), map[string]string{"command": "ADD", "code": "token_expired"}, monitortest.Exactly(1))
+}
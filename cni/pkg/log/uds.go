@@ -33,6 +33,8 @@ var pluginLog = log.RegisterScope("cni", "CNI network plugin")
 type UDSLogger struct {
 	mu            sync.Mutex
 	loggingServer *http.Server
+	// tracing enables OpenTelemetry spans for the plugin timing reports
+	tracing bool
 }
 
 type cniLog struct {
@@ -44,6 +46,8 @@ func NewUDSLogger() *UDSLogger {
 	l := &UDSLogger{}
 	mux := http.NewServeMux()
 	mux.HandleFunc(constants.UDSLogPath, l.handleLog)
+	mux.HandleFunc(constants.UDSTimingPath, l.handleTiming)
+	mux.HandleFunc(constants.UDSEventPath, l.handleEvent)
 	loggingServer := &http.Server{
 		Handler: mux,
 	}
@@ -51,6 +55,12 @@ func NewUDSLogger() *UDSLogger {
 	return l
 }
 
+// EnablePluginTracing emits an OpenTelemetry span for each plugin invocation reported to the server. The
+// tracing provider must be initialized, see tracing.Initialize.
+func (l *UDSLogger) EnablePluginTracing() {
+	l.tracing = true
+}
+
 // StartUDSLogServer starts up a UDS server which receives log reported from CNI network plugin.
 func (l *UDSLogger) StartUDSLogServer(sockAddress string, stop <-chan struct{}) error {
 	if sockAddress == "" {
@@ -25,6 +25,7 @@ import (
 
 	"istio.io/istio/cni/pkg/ambient"
 	ebpf "istio.io/istio/cni/pkg/ebpf/server"
+	"istio.io/istio/pkg/config/constants"
 	"istio.io/istio/pkg/log"
 )
 
@@ -33,17 +34,27 @@ func checkAmbient(
 	ambientConfig ambient.AmbientConfigFile,
 	podName, podNamespace, podIfname, podNetNs string,
 	podIPs []net.IPNet,
+	timer *invocationTimer,
 ) (bool, error) {
 	pod, err := client.CoreV1().Pods(podNamespace).Get(context.Background(), podName, metav1.GetOptions{})
 	if err != nil {
 		return false, err
 	}
-	ns, err := client.CoreV1().Namespaces().Get(context.Background(), podNamespace, metav1.GetOptions{})
-	if err != nil {
-		return false, err
+	// Prefer the enrollment published by the control plane over the namespace labels
+	mode := ambientConfig.Enrollment.Mode(podNamespace)
+	if ambientConfig.Enrollment == nil {
+		ns, err := client.CoreV1().Namespaces().Get(context.Background(), podNamespace, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		mode = ns.GetLabels()[constants.DataplaneMode]
 	}
 
-	if ambient.PodRedirectionEnabled(ns, pod) {
+	if ambient.PodRedirectionEnabled(mode, pod) {
+		if ambientConfig.EnrollmentDisabled {
+			log.Infof("ambient enrollment is disabled, not adding pod %s/%s to mesh", podNamespace, podName)
+			return false, nil
+		}
 		if ambientConfig.RedirectMode == ambient.EbpfMode.String() {
 			ifIndex, mac, err := ambient.GetIndexAndPeerMac(podIfname, podNetNs)
 			if err != nil {
@@ -73,6 +84,15 @@ func checkAmbient(
 			// Can't set this on GKE, but needed in AWS.. so silently ignore failures
 			_ = ambient.SetProc("/proc/sys/net/ipv4/conf/"+podIfname+"/rp_filter", "0")
 
+			ips := make([]string, 0, len(podIPs))
+			for _, ip := range podIPs {
+				ips = append(ips, ip.IP.String())
+			}
+			// A pod keeping its UID with new IPs had its sandbox recreated, e.g. by a restart of the container runtime
+			if ambient.ReconcilePodSandbox(pod, ips) {
+				timer.sandboxChange()
+			}
+
 			for _, ip := range podIPs {
 				err = ambient.AddPodToMesh(client, pod, ip.IP.String())
 				if err != nil {
@@ -0,0 +1,163 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"strings"
+
+	"github.com/containernetworking/cni/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ErrorCode identifies the cause of a failed CNI ADD. It is returned to the container runtime in the CNI error, and
+// shows up in the kubelet events of the pod, so that pod start failures can be triaged automatically. The codes start
+// at 100, the lower ones being reserved by the CNI specification:
+//
+//	Code  Name             Cause
+//	100   internal         Unexpected failure, see the istio-cni logs of the node
+//	101   invalid_config   The plugin configuration in the CNI network configuration list is invalid
+//	102   config_missing   A file written by the istio-cni node agent, such as the kubeconfig, is missing
+//	103   token_expired    The API server rejected the credentials of the kubeconfig
+//	104   api_unreachable  The API server could not be reached, or is overloaded
+//	105   iptables_locked  Another process held the xtables lock of the pod network namespace
+//	106   strict           The redirection of the pod could not be guaranteed, in strict mode
+type ErrorCode uint
+
+const (
+	ErrorCodeInternal       ErrorCode = 100
+	ErrorCodeInvalidConfig  ErrorCode = 101
+	ErrorCodeConfigMissing  ErrorCode = 102
+	ErrorCodeTokenExpired   ErrorCode = 103
+	ErrorCodeAPIUnreachable ErrorCode = 104
+	ErrorCodeIptablesLocked ErrorCode = 105
+	ErrorCodeStrict         ErrorCode = 106
+)
+
+type errorCodeInfo struct {
+	name string
+	// hint is the remediation returned to the runtime with the error
+	hint string
+}
+
+var errorCodes = map[ErrorCode]errorCodeInfo{
+	ErrorCodeInternal: {
+		name: "internal",
+		hint: "check the logs of the istio-cni node agent on the node",
+	},
+	ErrorCodeInvalidConfig: {
+		name: "invalid_config",
+		hint: "check the istio-cni entry of the CNI network configuration list on the node, rewritten by the istio-cni node agent",
+	},
+	ErrorCodeConfigMissing: {
+		name: "config_missing",
+		hint: "check that the istio-cni node agent is running on the node, as it writes the kubeconfig and configuration of the plugin",
+	},
+	ErrorCodeTokenExpired: {
+		name: "token_expired",
+		hint: "the istio-cni node agent refreshes the token of the kubeconfig, check that it is running and can reach the API server",
+	},
+	ErrorCodeAPIUnreachable: {
+		name: "api_unreachable",
+		hint: "check the connectivity from the node to the API server; the pod is retried by the kubelet",
+	},
+	ErrorCodeIptablesLocked: {
+		name: "iptables_locked",
+		hint: "another process holds the xtables lock, e.g. a concurrent pod start or a node agent; the pod is retried by the kubelet",
+	},
+	ErrorCodeStrict: {
+		name: "strict",
+		hint: "the strict mode of istio-cni prevents the pod from starting without the redirection of its traffic; check the istio-cni logs of the node",
+	},
+}
+
+// Name returns the name of the code, as reported in the metrics.
+func (c ErrorCode) Name() string {
+	if info, f := errorCodes[c]; f {
+		return info.name
+	}
+	return errorCodes[ErrorCodeInternal].name
+}
+
+// codedError is an error whose code is known from the context it occurred in.
+type codedError struct {
+	code ErrorCode
+	err  error
+}
+
+func (e *codedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *codedError) Unwrap() error {
+	return e.err
+}
+
+// withErrorCode sets the code of an error, if any.
+func withErrorCode(code ErrorCode, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &codedError{code: code, err: err}
+}
+
+// classifyError returns the code of an error, from the context it occurred in if known, or from its cause.
+func classifyError(err error) ErrorCode {
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+	var cniErr *types.Error
+	if errors.As(err, &cniErr) {
+		if _, f := errorCodes[ErrorCode(cniErr.Code)]; f {
+			return ErrorCode(cniErr.Code)
+		}
+		return ErrorCodeInternal
+	}
+	var netErr net.Error
+	switch {
+	case kerrors.IsUnauthorized(err):
+		return ErrorCodeTokenExpired
+	case kerrors.IsServerTimeout(err), kerrors.IsTimeout(err), kerrors.IsTooManyRequests(err), kerrors.IsServiceUnavailable(err),
+		errors.As(err, &netErr), errors.Is(err, context.DeadlineExceeded):
+		return ErrorCodeAPIUnreachable
+	case errors.Is(err, fs.ErrNotExist):
+		return ErrorCodeConfigMissing
+	case strings.Contains(err.Error(), "xtables lock"):
+		return ErrorCodeIptablesLocked
+	}
+	return ErrorCodeInternal
+}
+
+// toCNIError converts an error to the CNI error returned to the runtime, with its code and a remediation hint.
+func toCNIError(err error) error {
+	if err == nil {
+		return nil
+	}
+	var cniErr *types.Error
+	if errors.As(err, &cniErr) {
+		return err
+	}
+	code := classifyError(err)
+	return &types.Error{
+		Code:    uint(code),
+		Msg:     fmt.Sprintf("istio-cni error %d (%s): %v", code, code.Name(), err),
+		Details: errorCodes[code].hint,
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/containernetworking/cni/pkg/types"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestClassifyError(t *testing.T) {
+	_, notExist := os.Stat("/nonexistent/kubeconfig")
+	cases := []struct {
+		name string
+		err  error
+		want ErrorCode
+	}{
+		{"unknown", errors.New("boom"), ErrorCodeInternal},
+		{"coded", withErrorCode(ErrorCodeInvalidConfig, errors.New("bad json")), ErrorCodeInvalidConfig},
+		{"unauthorized", fmt.Errorf("get pod: %w", kerrors.NewUnauthorized("token expired")), ErrorCodeTokenExpired},
+		{"unavailable", kerrors.NewServiceUnavailable("down"), ErrorCodeAPIUnreachable},
+		{"missing file", notExist, ErrorCodeConfigMissing},
+		{"xtables lock", errors.New("iptables-restore: Another app is currently holding the xtables lock"), ErrorCodeIptablesLocked},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, classifyError(tt.err), tt.want)
+		})
+	}
+}
+
+func TestToCNIError(t *testing.T) {
+	assert.NoError(t, toCNIError(nil))
+
+	err := toCNIError(kerrors.NewUnauthorized("token expired"))
+	var cniErr *types.Error
+	assert.Equal(t, errors.As(err, &cniErr), true)
+	assert.Equal(t, cniErr.Code, uint(ErrorCodeTokenExpired))
+	assert.Equal(t, strings.HasPrefix(cniErr.Msg, "istio-cni error 103 (token_expired): "), true)
+	assert.Equal(t, cniErr.Details, errorCodes[ErrorCodeTokenExpired].hint)
+	// The code is kept once converted
+	assert.Equal(t, classifyError(err), ErrorCodeTokenExpired)
+	assert.Equal(t, toCNIError(err), err)
+}
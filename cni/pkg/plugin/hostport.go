@@ -0,0 +1,69 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"istio.io/istio/pkg/slices"
+)
+
+// The modes of the pods publishing ports on the node, when the hostPort traffic is translated by the portmap plugin.
+// On some kernels, the DNAT of portmap conflicts with the redirection of the translated connections to the proxy.
+const (
+	// hostPortModeIntercept redirects the hostPort traffic to the proxy, like the traffic of the other ports.
+	hostPortModeIntercept = "intercept"
+	// hostPortModeExclude excludes the container ports published with a hostPort from the inbound redirection, so the
+	// translated connections reach the application directly, while the rest of the traffic is redirected.
+	hostPortModeExclude = "exclude"
+)
+
+func validateHostPortMode(mode string) error {
+	switch mode {
+	case "", hostPortModeIntercept, hostPortModeExclude:
+		return nil
+	default:
+		return fmt.Errorf("invalid host_port_mode %q, must be one of %s or %s", mode, hostPortModeIntercept, hostPortModeExclude)
+	}
+}
+
+// effectiveHostPortMode returns the mode applying to the pod. Pods without hostPorts, and the pods of nodes where the
+// hostPorts are not translated by a chained portmap plugin, are intercepted as usual.
+func effectiveHostPortMode(conf *Config, pi *PodInfo) string {
+	if len(pi.HostPorts) == 0 || !conf.PortmapChained || conf.HostPortMode == "" {
+		return hostPortModeIntercept
+	}
+	return conf.HostPortMode
+}
+
+// excludeHostPorts excludes the container ports published with a hostPort from the inbound redirection.
+func (rd *Redirect) excludeHostPorts(ports []int32) {
+	if len(ports) == 0 {
+		return
+	}
+	hostPorts := slices.Map(ports, func(p int32) string {
+		return strconv.Itoa(int(p))
+	})
+	rd.excludeInboundPorts = strings.Join(dedupPorts(append(splitPorts(rd.excludeInboundPorts), hostPorts...)), ",")
+	// An explicit list of included ports takes precedence over the excluded ports, so they are removed from it too
+	if rd.includeInboundPorts != "" && rd.includeInboundPorts != "*" {
+		included := slices.FilterInPlace(splitPorts(rd.includeInboundPorts), func(p string) bool {
+			return !slices.Contains(hostPorts, strings.TrimSpace(p))
+		})
+		rd.includeInboundPorts = strings.Join(included, ",")
+	}
+}
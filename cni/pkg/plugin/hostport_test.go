@@ -0,0 +1,140 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+
+	"istio.io/istio/pkg/util/sets"
+)
+
+func TestExtractPodInfoHostPorts(t *testing.T) {
+	pod := &v1.Pod{Spec: v1.PodSpec{
+		Containers: []v1.Container{
+			{Name: "app", Ports: []v1.ContainerPort{{ContainerPort: 9090}, {ContainerPort: 8080, HostPort: 80}}},
+			{Name: "other", Ports: []v1.ContainerPort{{ContainerPort: 8443, HostPort: 443}}},
+			{Name: ISTIOPROXY, Ports: []v1.ContainerPort{{ContainerPort: 15090, HostPort: 15090}}},
+		},
+	}}
+	pi := ExtractPodInfo(pod)
+	if want := []int32{8080, 8443}; !reflect.DeepEqual(pi.HostPorts, want) {
+		t.Fatalf("expected hostPorts %v, got %v", want, pi.HostPorts)
+	}
+}
+
+func TestExcludeHostPorts(t *testing.T) {
+	rd := &Redirect{excludeInboundPorts: "15020,15021,15090", includeInboundPorts: "*"}
+	rd.excludeHostPorts([]int32{8080, 15020})
+	if rd.excludeInboundPorts != "15020,15021,15090,8080" {
+		t.Fatalf("unexpected excludeInboundPorts %q", rd.excludeInboundPorts)
+	}
+	if rd.includeInboundPorts != "*" {
+		t.Fatalf("unexpected includeInboundPorts %q", rd.includeInboundPorts)
+	}
+
+	rd = &Redirect{excludeInboundPorts: "15020,15021,15090", includeInboundPorts: "8080,9090"}
+	rd.excludeHostPorts([]int32{8080})
+	if rd.includeInboundPorts != "9090" {
+		t.Fatalf("expected the hostPort to be removed from includeInboundPorts, got %q", rd.includeInboundPorts)
+	}
+}
+
+func TestParseConfigHostPortMode(t *testing.T) {
+	base := fmt.Sprintf(conf, currentVersion, currentVersion, ifname, sandboxDirectory, "mock")
+	for mode, valid := range map[string]bool{"": true, "intercept": true, "exclude": true, "skip": false, "drop": false} {
+		_, err := parseConfig([]byte(withHostPortConfig(base, mode, true)))
+		if valid && err != nil {
+			t.Fatalf("unexpected error for mode %q: %v", mode, err)
+		}
+		if !valid && err == nil {
+			t.Fatalf("expected an error for mode %q", mode)
+		}
+	}
+}
+
+// TestCmdAddHostPorts covers the pods with hostPorts, with a portmap plugin chained before istio-cni or not.
+func TestCmdAddHostPorts(t *testing.T) {
+	tests := []struct {
+		name           string
+		mode           string
+		portmapChained bool
+		hostPorts      []int32
+		wantProgrammed bool
+		wantExcluded   string
+	}{
+		{
+			name:           "exclude without portmap",
+			mode:           hostPortModeExclude,
+			hostPorts:      []int32{8080},
+			wantProgrammed: true,
+			wantExcluded:   "15020,15021,15090",
+		},
+		{
+			name:           "exclude without hostPorts",
+			mode:           hostPortModeExclude,
+			portmapChained: true,
+			wantProgrammed: true,
+			wantExcluded:   "15020,15021,15090",
+		},
+		{
+			name:           "exclude with portmap",
+			mode:           hostPortModeExclude,
+			portmapChained: true,
+			hostPorts:      []int32{8080, 8443},
+			wantProgrammed: true,
+			wantExcluded:   "15020,15021,15090,8080,8443",
+		},
+		{
+			name:           "intercept with portmap",
+			mode:           hostPortModeIntercept,
+			portmapChained: true,
+			hostPorts:      []int32{8080},
+			wantProgrammed: true,
+			wantExcluded:   "15020,15021,15090",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer resetGlobalTestVariables()
+			singletonMockInterceptRuleMgr.lastRedirect = nil
+			testContainers = sets.New("mockContainer", ISTIOPROXY)
+			testHostPorts = tt.hostPorts
+			cniConf := fmt.Sprintf(conf, currentVersion, currentVersion, ifname, sandboxDirectory, "mock")
+			testCmdAddWithStdinData(t, withHostPortConfig(cniConf, tt.mode, tt.portmapChained))
+
+			if nsenterFuncCalled != tt.wantProgrammed {
+				t.Fatalf("expected the redirection to be programmed: %v, got %v", tt.wantProgrammed, nsenterFuncCalled)
+			}
+			if !tt.wantProgrammed {
+				return
+			}
+			r := singletonMockInterceptRuleMgr.lastRedirect[len(singletonMockInterceptRuleMgr.lastRedirect)-1]
+			if r.excludeInboundPorts != tt.wantExcluded {
+				t.Fatalf("expected excludeInboundPorts %q, got %q", tt.wantExcluded, r.excludeInboundPorts)
+			}
+		})
+	}
+}
+
+// withHostPortConfig adds the settings written by the installer and the chart to the plugin config.
+func withHostPortConfig(cniConf, mode string, portmapChained bool) string {
+	return strings.Replace(cniConf, `"log_level"`,
+		fmt.Sprintf(`"host_port_mode": %q, "portmap_chained": %v, "log_level"`, mode, portmapChained), 1)
+}
@@ -18,21 +18,25 @@ package plugin
 
 import (
 	"fmt"
+	"io"
+	"os/exec"
+	"strings"
 
 	"github.com/containernetworking/plugins/pkg/ns"
 
 	"istio.io/istio/pkg/log"
+	"istio.io/istio/tools/istio-iptables/pkg/capture"
 	"istio.io/istio/tools/istio-iptables/pkg/cmd"
 	"istio.io/istio/tools/istio-iptables/pkg/config"
+	"istio.io/istio/tools/istio-iptables/pkg/constants"
 	"istio.io/istio/tools/istio-iptables/pkg/dependencies"
 )
 
 // getNs is a unit test override variable for interface create.
 var getNs = ns.GetNS
 
-// Program defines a method which programs iptables based on the parameters
-// provided in Redirect.
-func (ipt *iptables) Program(podName, netns string, rdrct *Redirect) error {
+// iptablesConfig returns the configuration of istio-iptables programming the redirection in the pod network namespace.
+func iptablesConfig(netns string, rdrct *Redirect) *config.Config {
 	cfg := config.DefaultConfig()
 	cfg.CNIMode = true
 	cfg.NetworkNamespace = netns
@@ -53,7 +57,15 @@ func (ipt *iptables) Program(podName, netns string, rdrct *Redirect) error {
 	cfg.CaptureAllDNS = rdrct.dnsRedirect
 	cfg.DropInvalid = rdrct.invalidDrop
 	cfg.DualStack = rdrct.dualStack
+	cfg.IPTablesBackend = rdrct.iptablesBackend
 	cfg.FillConfigFromEnvironment()
+	return cfg
+}
+
+// Program defines a method which programs iptables based on the parameters
+// provided in Redirect.
+func (ipt *iptables) Program(podName, netns string, rdrct *Redirect) error {
+	cfg := iptablesConfig(netns, rdrct)
 
 	netNs, err := getNs(netns)
 	if err != nil {
@@ -68,3 +80,100 @@ func (ipt *iptables) Program(podName, netns string, rdrct *Redirect) error {
 		return cmd.ProgramIptables(cfg)
 	})
 }
+
+// Verify reads back the nat table of the pod network namespace, to check the traffic is redirected to the proxy.
+func (ipt *iptables) Verify(podName, netns string, rdrct *Redirect) error {
+	if dependencies.DryRunFilePath.Get() != "" {
+		return nil
+	}
+	saved, err := saveRules(netns, rdrct, constants.NAT)
+	if err != nil {
+		return fmt.Errorf("failed to read the rules of %v: %v", podName, err)
+	}
+	for saveCmd, save := range saved {
+		if err := verifyRedirectRules(save, rdrct); err != nil {
+			return fmt.Errorf("%s: %v", saveCmd, err)
+		}
+	}
+	return nil
+}
+
+// GenerateRules returns the rules programmed by Program for the redirection, in the iptables-restore format, without
+// applying them.
+func GenerateRules(rdrct *Redirect) (string, error) {
+	rec := &restoreRecorder{}
+	if err := capture.NewIptablesConfigurator(iptablesConfig("", rdrct), rec).Run(); err != nil {
+		return "", err
+	}
+	return rec.String(), nil
+}
+
+// SaveRules returns the rules of all the tables of the pod network namespace, as saved by iptables-save.
+func SaveRules(netns string, rdrct *Redirect) (string, error) {
+	saved, err := saveRules(netns, rdrct, "")
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, saveCmd := range []string{constants.IPTABLESSAVE, constants.IP6TABLESSAVE} {
+		b.Write(saved[saveCmd])
+	}
+	return b.String(), nil
+}
+
+// saveRules runs iptables-save, and ip6tables-save for dual stack pods, in the pod network namespace, returning their
+// output by command. If table is empty, all the tables are saved.
+func saveRules(netns string, rdrct *Redirect, table string) (map[string][]byte, error) {
+	backend, err := dependencies.ParseIptablesBackend(rdrct.iptablesBackend)
+	if err != nil {
+		return nil, err
+	}
+	ipv, err := dependencies.DetectIptablesVersionForBackend("", backend)
+	if err != nil {
+		return nil, err
+	}
+	saveCmds := []string{constants.IPTABLESSAVE}
+	if rdrct.dualStack {
+		saveCmds = append(saveCmds, constants.IP6TABLESSAVE)
+	}
+	var args []string
+	if table != "" {
+		args = []string{"-t", table}
+	}
+
+	netNs, err := getNs(netns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open netns %q: %s", netns, err)
+	}
+	defer netNs.Close()
+
+	saved := map[string][]byte{}
+	err = netNs.Do(func(_ ns.NetNS) error {
+		for _, saveCmd := range saveCmds {
+			save, err := exec.Command(ipv.Command(saveCmd), args...).Output()
+			if err != nil {
+				return fmt.Errorf("%s failed: %v", saveCmd, err)
+			}
+			saved[saveCmd] = save
+		}
+		return nil
+	})
+	return saved, err
+}
+
+// restoreRecorder records the input of the iptables-restore commands, rather than running them.
+type restoreRecorder struct {
+	strings.Builder
+}
+
+func (r *restoreRecorder) Run(cmd string, stdin io.ReadSeeker, _ ...string) error {
+	if stdin == nil || (cmd != constants.IPTABLESRESTORE && cmd != constants.IP6TABLESRESTORE) {
+		return nil
+	}
+	_, err := io.Copy(r, stdin)
+	return err
+}
+
+func (r *restoreRecorder) RunQuietlyAndIgnore(cmd string, stdin io.ReadSeeker, args ...string) {
+	_ = r.Run(cmd, stdin, args...)
+}
@@ -29,3 +29,18 @@ var ErrNotImplemented = errors.New("not implemented")
 func (ipt *iptables) Program(podName, netns string, rdrct *Redirect) error {
 	return ErrNotImplemented
 }
+
+// Verify reads back the rules programmed in the pod network namespace.
+func (ipt *iptables) Verify(podName, netns string, rdrct *Redirect) error {
+	return ErrNotImplemented
+}
+
+// GenerateRules returns the rules programmed by Program for the redirection, without applying them.
+func GenerateRules(rdrct *Redirect) (string, error) {
+	return "", ErrNotImplemented
+}
+
+// SaveRules returns the rules of all the tables of the pod network namespace.
+func SaveRules(netns string, rdrct *Redirect) (string, error) {
+	return "", ErrNotImplemented
+}
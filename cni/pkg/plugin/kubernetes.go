@@ -42,8 +42,29 @@ type PodInfo struct {
 	ProxyEnvironments map[string]string
 	ProxyUID          *int64
 	ProxyGID          *int64
+	// NativeSidecar is set if the proxy is a native sidecar, an init container which keeps running while the
+	// following init containers and the containers run.
+	NativeSidecar bool
+	// UserContainers are the containers of the pod which are not injected by Istio, init containers first.
+	UserContainers []ContainerInfo
+	// HostPorts are the container ports of the user containers published on the node with a hostPort.
+	HostPorts []int32
 }
 
+// ContainerInfo describes a container of the pod, for the interception of its traffic.
+type ContainerInfo struct {
+	Name string
+	// UID is the user the container runs as, if set by the container or the pod security context
+	UID  *int64
+	Init bool
+	// BeforeProxy is set for the init containers which run before the proxy is started, whose traffic cannot be
+	// intercepted. Without native sidecars, this is the case of all the init containers.
+	BeforeProxy bool
+}
+
+// istioContainers are the containers injected by Istio.
+var istioContainers = sets.New(ISTIOINIT, ISTIOPROXY, ISTIOVALIDATION, ENABLECOREDUMP)
+
 // newK8sClient returns a Kubernetes client
 func newK8sClient(conf Config) (*kubernetes.Clientset, error) {
 	// Some config can be passed in a kubeconfig file
@@ -98,9 +119,64 @@ func ExtractPodInfo(pod *v1.Pod) *PodInfo {
 			}
 		}
 	}
+	pi.NativeSidecar, pi.UserContainers = userContainers(pod)
+	pi.HostPorts = hostPorts(pod)
 	return pi
 }
 
+// hostPorts returns the sorted container ports published on the node with a hostPort, excluding those of the
+// containers injected by Istio.
+func hostPorts(pod *v1.Pod) []int32 {
+	ports := sets.New[int32]()
+	for _, c := range containers(pod) {
+		if istioContainers.Contains(c.Name) {
+			continue
+		}
+		for _, p := range c.Ports {
+			if p.HostPort != 0 {
+				ports.Insert(p.ContainerPort)
+			}
+		}
+	}
+	return sets.SortedList(ports)
+}
+
+// userContainers returns whether the proxy is a native sidecar, and the containers of the pod not injected by Istio.
+// The init containers ordered after a native sidecar only start once the proxy has started, so their traffic is
+// intercepted like the traffic of the containers.
+func userContainers(pod *v1.Pod) (bool, []ContainerInfo) {
+	native := false
+	res := make([]ContainerInfo, 0, len(pod.Spec.InitContainers)+len(pod.Spec.Containers))
+	for _, c := range pod.Spec.InitContainers {
+		if c.Name == ISTIOPROXY {
+			native = c.RestartPolicy != nil && *c.RestartPolicy == v1.ContainerRestartPolicyAlways
+			continue
+		}
+		if istioContainers.Contains(c.Name) {
+			continue
+		}
+		res = append(res, ContainerInfo{Name: c.Name, UID: runAsUser(pod, c), Init: true, BeforeProxy: !native})
+	}
+	for _, c := range pod.Spec.Containers {
+		if istioContainers.Contains(c.Name) {
+			continue
+		}
+		res = append(res, ContainerInfo{Name: c.Name, UID: runAsUser(pod, c)})
+	}
+	return native, res
+}
+
+// runAsUser returns the user a container runs as, as set by its security context or the security context of the pod.
+func runAsUser(pod *v1.Pod, c v1.Container) *int64 {
+	if c.SecurityContext != nil && c.SecurityContext.RunAsUser != nil {
+		return c.SecurityContext.RunAsUser
+	}
+	if pod.Spec.SecurityContext != nil {
+		return pod.Spec.SecurityContext.RunAsUser
+	}
+	return nil
+}
+
 // containers fetches all containers in the pod.
 // This is used to extract init containers (istio-init and istio-validation), and the sidecar.
 // The sidecar can be a normal container or init in Kubernetes 1.28+
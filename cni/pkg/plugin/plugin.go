@@ -18,8 +18,11 @@ package plugin
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"net"
+	"os"
 	"runtime/debug"
 	"strconv"
 	"time"
@@ -46,8 +49,10 @@ var (
 )
 
 const (
-	ISTIOINIT  = "istio-init"
-	ISTIOPROXY = "istio-proxy"
+	ISTIOINIT       = "istio-init"
+	ISTIOPROXY      = "istio-proxy"
+	ISTIOVALIDATION = "istio-validation"
+	ENABLECOREDUMP  = "enable-core-dump"
 )
 
 // Kubernetes a K8s specific struct to hold config
@@ -83,6 +88,23 @@ type Config struct {
 	LogUDSAddress  string     `json:"log_uds_address"`
 	AmbientEnabled bool       `json:"ambient_enabled"`
 	Kubernetes     Kubernetes `json:"kubernetes"`
+	// IptablesBackend is the iptables backend used by the node, as detected by the installer.
+	// If empty, the default iptables commands are used.
+	IptablesBackend string `json:"iptables_backend"`
+	// HostPortMode selects how the pods with hostPorts are redirected when the portmap plugin is chained before
+	// istio-cni: intercept (the default) or exclude. Injected pods are always redirected, as their validation init
+	// container would fail without redirection.
+	HostPortMode string `json:"host_port_mode"`
+	// PortmapChained is set by the installer when the portmap plugin runs before istio-cni in the chain.
+	PortmapChained bool `json:"portmap_chained"`
+	// Strict fails the ADD of the pods of all namespaces when the redirection of their traffic cannot be guaranteed,
+	// rather than letting them start without redirection.
+	Strict bool `json:"strict"`
+	// StrictNamespaces enables the strict mode for the namespaces with the strictAnnotation.
+	StrictNamespaces bool `json:"strict_namespaces"`
+	// PluginTiming reports the timing of the ADD invocations to the node agent. It is opt-in, as the report is an
+	// additional call made before the invocation returns.
+	PluginTiming bool `json:"plugin_timing"`
 }
 
 // K8sArgs is the valid CNI_ARGS used for Kubernetes
@@ -102,6 +124,9 @@ func parseConfig(stdin []byte) (*Config, error) {
 	if err := json.Unmarshal(stdin, &conf); err != nil {
 		return nil, fmt.Errorf("failed to parse network configuration: %v", err)
 	}
+	if err := validateHostPortMode(conf.HostPortMode); err != nil {
+		return nil, err
+	}
 
 	// Parse previous result. Remove this if your plugin is not chained.
 	if conf.RawPrevResult != nil {
@@ -150,6 +175,11 @@ func GetLoggingOptions(udsAddress string) *log.Options {
 
 // CmdAdd is called for ADD requests
 func CmdAdd(args *skel.CmdArgs) (err error) {
+	timer := newInvocationTimer("ADD")
+	var conf *Config
+	defer func() {
+		timer.report(conf, err)
+	}()
 	// Defer a panic recover, so that in case if panic we can still return
 	// a proper error to the runtime.
 	defer func() {
@@ -163,22 +193,24 @@ func CmdAdd(args *skel.CmdArgs) (err error) {
 			err = fmt.Errorf(msg)
 		}
 		if err != nil {
+			// Return the code of the error and a remediation hint to the runtime, surfacing them in the events of the pod
+			err = toCNIError(err)
 			log.Errorf("istio-cni cmdAdd error: %v", err)
 		}
 	}()
 
-	conf, err := parseConfig(args.StdinData)
+	conf, err = parseConfig(args.StdinData)
 	if err != nil {
 		log.Errorf("istio-cni cmdAdd failed to parse config %v %v", string(args.StdinData), err)
-		return err
+		return withErrorCode(ErrorCodeInvalidConfig, err)
 	}
-	if err := doRun(args, conf); err != nil {
+	if err := doRun(args, conf, timer); err != nil {
 		return err
 	}
 	return pluginResponse(conf)
 }
 
-func doRun(args *skel.CmdArgs, conf *Config) error {
+func doRun(args *skel.CmdArgs, conf *Config, timer *invocationTimer) error {
 	setupLogging(conf)
 
 	var loggedPrevResult any
@@ -209,6 +241,7 @@ func doRun(args *skel.CmdArgs, conf *Config) error {
 		log.Debugf("Not a kubernetes pod")
 		return nil
 	}
+	timer.setPod(podNamespace, podName)
 
 	for _, excludeNs := range conf.Kubernetes.ExcludeNamespaces {
 		if podNamespace == excludeNs {
@@ -217,8 +250,13 @@ func doRun(args *skel.CmdArgs, conf *Config) error {
 		}
 	}
 
+	done := timer.phase(phaseKubeconfig)
 	client, err := newKubeClient(*conf)
+	done()
 	if err != nil {
+		if _, statErr := os.Stat(conf.Kubernetes.Kubeconfig); errors.Is(statErr, fs.ErrNotExist) {
+			return withErrorCode(ErrorCodeConfigMissing, err)
+		}
 		return err
 	}
 
@@ -237,7 +275,9 @@ func doRun(args *skel.CmdArgs, conf *Config) error {
 			return err
 		}
 		log.Infof("istio-cni ambient cmdAdd podName: %s podIPs: %+v", podName, podIPs)
-		added, err = checkAmbient(client, *ambientConf, podName, podNamespace, args.IfName, args.Netns, podIPs)
+		done = timer.phase(phaseAmbient)
+		added, err = checkAmbient(client, *ambientConf, podName, podNamespace, args.IfName, args.Netns, podIPs, timer)
+		done()
 		if err != nil {
 			log.Errorf("istio-cni cmdAdd failed to check ambient: %s", err)
 			return err
@@ -251,6 +291,7 @@ func doRun(args *skel.CmdArgs, conf *Config) error {
 
 	pi := &PodInfo{}
 	var k8sErr error
+	done = timer.phase(phaseAPI)
 	for attempt := 1; attempt <= podRetrievalMaxRetries; attempt++ {
 		pi, k8sErr = getKubePodInfo(client, podName, podNamespace)
 		if k8sErr == nil {
@@ -259,6 +300,7 @@ func doRun(args *skel.CmdArgs, conf *Config) error {
 		log.Debugf("Failed to get %s/%s pod info: %v", podNamespace, podName, k8sErr)
 		time.Sleep(podRetrievalInterval)
 	}
+	done()
 	if k8sErr != nil {
 		log.Errorf("Failed to get %s/%s pod info: %v", podNamespace, podName, k8sErr)
 		return k8sErr
@@ -307,6 +349,13 @@ func doRun(args *skel.CmdArgs, conf *Config) error {
 		return nil
 	}
 
+	strict, err := isStrict(conf, client, podNamespace)
+	if err != nil {
+		return err
+	}
+
+	hostPortMode := effectiveHostPortMode(conf, pi)
+
 	log.Debugf("Setting up redirect")
 
 	redirect, err := NewRedirect(pi)
@@ -314,6 +363,11 @@ func doRun(args *skel.CmdArgs, conf *Config) error {
 		log.Errorf("redirect failed due to bad params: %v", err)
 		return err
 	}
+	if hostPortMode == hostPortModeExclude {
+		log.Infof("excluding hostPorts %v from the inbound redirection", pi.HostPorts)
+		redirect.excludeHostPorts(pi.HostPorts)
+	}
+	redirect.iptablesBackend = conf.IptablesBackend
 
 	// Get the constructor for the configured type of InterceptRuleMgr
 	interceptMgrCtor := GetInterceptRuleMgrCtor(interceptRuleMgrType)
@@ -323,9 +377,16 @@ func doRun(args *skel.CmdArgs, conf *Config) error {
 	}
 
 	rulesMgr := interceptMgrCtor()
+	done = timer.phase(phaseRules)
+	defer done()
 	if err := rulesMgr.Program(podName, args.Netns, redirect); err != nil {
 		return err
 	}
+	if strict {
+		if err := verifyRedirect(rulesMgr, podName, args.Netns, redirect); err != nil {
+			return withErrorCode(ErrorCodeStrict, fmt.Errorf("failed to verify the redirection: %v", err))
+		}
+	}
 
 	return nil
 }
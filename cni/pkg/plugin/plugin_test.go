@@ -25,9 +25,11 @@ import (
 	"github.com/containernetworking/cni/pkg/types"
 	cniv1 "github.com/containernetworking/cni/pkg/types/100"
 	"github.com/containernetworking/plugins/pkg/testutils"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"istio.io/api/label"
+	"istio.io/istio/pkg/ptr"
 	"istio.io/istio/pkg/util/sets"
 )
 
@@ -46,6 +48,9 @@ var (
 	testLabels                    = map[string]string{}
 	testAnnotations               = map[string]string{}
 	testProxyEnv                  = map[string]string{}
+	testHostPorts                 []int32
+	testNamespaceAnnotations      = map[string]string{}
+	testNamespaceErr              error
 	singletonMockInterceptRuleMgr = &mockInterceptRuleMgr{}
 )
 
@@ -97,10 +102,13 @@ var conf = `{
 
 type mockInterceptRuleMgr struct {
 	lastRedirect []*Redirect
+	verified     bool
+	verifyErr    error
 }
 
 func init() {
 	testAnnotations[sidecarStatusKey] = "true"
+	getKubeNamespaceAnnotations = mockgetK8sNamespaceAnnotations
 }
 
 func (mrdir *mockInterceptRuleMgr) Program(podName, netns string, redirect *Redirect) error {
@@ -109,6 +117,11 @@ func (mrdir *mockInterceptRuleMgr) Program(podName, netns string, redirect *Redi
 	return nil
 }
 
+func (mrdir *mockInterceptRuleMgr) Verify(podName, netns string, redirect *Redirect) error {
+	mrdir.verified = true
+	return mrdir.verifyErr
+}
+
 func NewMockInterceptRuleMgr() InterceptRuleMgr {
 	return singletonMockInterceptRuleMgr
 }
@@ -127,10 +140,15 @@ func mockgetK8sPodInfo(client *kubernetes.Clientset, podName, podNamespace strin
 	pi.Labels = testLabels
 	pi.Annotations = testAnnotations
 	pi.ProxyEnvironments = testProxyEnv
+	pi.HostPorts = testHostPorts
 
 	return &pi, nil
 }
 
+func mockgetK8sNamespaceAnnotations(client *kubernetes.Clientset, namespace string) (map[string]string, error) {
+	return testNamespaceAnnotations, testNamespaceErr
+}
+
 func resetGlobalTestVariables() {
 	getKubePodInfoCalled = false
 	nsenterFuncCalled = false
@@ -138,6 +156,11 @@ func resetGlobalTestVariables() {
 	testLabels = map[string]string{}
 	testAnnotations = map[string]string{}
 	testProxyEnv = map[string]string{}
+	testHostPorts = nil
+	testNamespaceAnnotations = map[string]string{}
+	testNamespaceErr = nil
+	singletonMockInterceptRuleMgr.verified = false
+	singletonMockInterceptRuleMgr.verifyErr = nil
 
 	testAnnotations[sidecarStatusKey] = "true"
 	k8Args = "K8S_POD_NAMESPACE=istio-system;K8S_POD_NAME=testPodName"
@@ -492,6 +515,121 @@ func TestCmdAddEnableDualStack(t *testing.T) {
 	}
 }
 
+func TestExtractPodInfoUserContainers(t *testing.T) {
+	pod := &v1.Pod{Spec: v1.PodSpec{
+		SecurityContext: &v1.PodSecurityContext{RunAsUser: ptr.Of(int64(1000))},
+		InitContainers: []v1.Container{
+			{Name: ISTIOVALIDATION},
+			{Name: "before"},
+			{Name: ISTIOPROXY, RestartPolicy: ptr.Of(v1.ContainerRestartPolicyAlways)},
+			{Name: "after", SecurityContext: &v1.SecurityContext{RunAsUser: ptr.Of(int64(2000))}},
+		},
+		Containers: []v1.Container{{Name: "app"}},
+	}}
+	want := []ContainerInfo{
+		{Name: "before", UID: ptr.Of(int64(1000)), Init: true, BeforeProxy: true},
+		{Name: "after", UID: ptr.Of(int64(2000)), Init: true},
+		{Name: "app", UID: ptr.Of(int64(1000))},
+	}
+
+	pi := ExtractPodInfo(pod)
+	if !pi.NativeSidecar {
+		t.Fatalf("expected the proxy to be a native sidecar")
+	}
+	if !reflect.DeepEqual(pi.UserContainers, want) {
+		t.Fatalf("expected user containers %+v, got %+v", want, pi.UserContainers)
+	}
+
+	// Without native sidecars, no init container is intercepted
+	pod.Spec.InitContainers = pod.Spec.InitContainers[:2]
+	pod.Spec.Containers = append(pod.Spec.Containers, v1.Container{Name: ISTIOPROXY})
+	pi = ExtractPodInfo(pod)
+	if pi.NativeSidecar {
+		t.Fatalf("expected the proxy not to be a native sidecar")
+	}
+	if !reflect.DeepEqual(pi.UserContainers, []ContainerInfo{want[0], want[2]}) {
+		t.Fatalf("expected user containers %+v, got %+v", []ContainerInfo{want[0], want[2]}, pi.UserContainers)
+	}
+}
+
+func TestNewRedirectExcludeInitContainers(t *testing.T) {
+	containers := []ContainerInfo{
+		{Name: "before", UID: ptr.Of(int64(1000)), Init: true, BeforeProxy: true},
+		{Name: "other-before", UID: ptr.Of(int64(1001)), Init: true, BeforeProxy: true},
+		{Name: "after", UID: ptr.Of(int64(2000)), Init: true},
+		{Name: "root", UID: ptr.Of(int64(0)), Init: true},
+		{Name: "app", UID: ptr.Of(int64(3000))},
+	}
+	tests := []struct {
+		name       string
+		annotation string
+		want       string
+		wantErr    bool
+	}{
+		{
+			name: "not set",
+			want: "1337",
+		},
+		{
+			name:       "all before the proxy",
+			annotation: "*",
+			want:       "1337,1000,1001",
+		},
+		{
+			name:       "by name",
+			annotation: "after, before",
+			want:       "1337,1000,2000",
+		},
+		{
+			name:       "unknown container",
+			annotation: "app",
+			wantErr:    true,
+		},
+		{
+			name:       "root user",
+			annotation: "root",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pi := &PodInfo{
+				Annotations:    map[string]string{},
+				UserContainers: containers,
+			}
+			if tt.annotation != "" {
+				pi.Annotations[excludeInitContainersKey] = tt.annotation
+			}
+			redir, err := NewRedirect(pi)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got redirect %+v", redir)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if redir.noRedirectUID != tt.want {
+				t.Fatalf("expected noRedirectUID %q, got %q", tt.want, redir.noRedirectUID)
+			}
+		})
+	}
+
+	t.Run("user shared with an intercepted container", func(t *testing.T) {
+		pi := &PodInfo{
+			Annotations: map[string]string{excludeInitContainersKey: "before"},
+			UserContainers: []ContainerInfo{
+				{Name: "before", UID: ptr.Of(int64(1000)), Init: true, BeforeProxy: true},
+				{Name: "app", UID: ptr.Of(int64(1000))},
+			},
+		}
+		if _, err := NewRedirect(pi); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+}
+
 func MockInterceptRuleMgrCtor() InterceptRuleMgr {
 	return NewMockInterceptRuleMgr()
 }
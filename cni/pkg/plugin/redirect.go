@@ -23,6 +23,7 @@ import (
 
 	"istio.io/api/annotation"
 	"istio.io/istio/pkg/log"
+	"istio.io/istio/pkg/util/sets"
 	"istio.io/istio/tools/istio-iptables/pkg/cmd"
 )
 
@@ -41,6 +42,10 @@ const (
 	defaultIncludeInboundPorts   = "*"
 	defaultIncludeOutboundPorts  = ""
 	defaultExcludeInterfaces     = ""
+	defaultExcludeInitContainers = ""
+
+	// excludeInitContainersAll exempts all the init containers which run before the proxy is started.
+	excludeInitContainersAll = "*"
 )
 
 var (
@@ -57,19 +62,25 @@ var (
 
 	kubevirtInterfacesKey = annotation.SidecarTrafficKubevirtInterfaces.Name
 
+	// excludeInitContainersKey lists the init containers whose outbound traffic is not redirected to the proxy, or "*"
+	// for all the init containers which run before the proxy is started. Their traffic is exempted by their user, which
+	// must not be shared with an intercepted container.
+	excludeInitContainersKey = "traffic.sidecar.istio.io/excludeInitContainers"
+
 	annotationRegistry = map[string]*annotationParam{
-		"inject":               {injectAnnotationKey, "", alwaysValidFunc},
-		"status":               {sidecarStatusKey, "", alwaysValidFunc},
-		"redirectMode":         {sidecarInterceptModeKey, defaultRedirectMode, validateInterceptionMode},
-		"ports":                {sidecarPortListKey, "", validatePortList},
-		"includeIPCidrs":       {includeIPCidrsKey, defaultRedirectIPCidr, validateCIDRListWithWildcard},
-		"excludeIPCidrs":       {excludeIPCidrsKey, defaultRedirectExcludeIPCidr, validateCIDRList},
-		"excludeInboundPorts":  {excludeInboundPortsKey, defaultRedirectExcludePort, validatePortListWithWildcard},
-		"includeInboundPorts":  {includeInboundPortsKey, defaultIncludeInboundPorts, validatePortListWithWildcard},
-		"excludeOutboundPorts": {excludeOutboundPortsKey, defaultRedirectExcludePort, validatePortListWithWildcard},
-		"includeOutboundPorts": {includeOutboundPortsKey, defaultIncludeOutboundPorts, validatePortListWithWildcard},
-		"kubevirtInterfaces":   {kubevirtInterfacesKey, defaultKubevirtInterfaces, alwaysValidFunc},
-		"excludeInterfaces":    {excludeInterfacesKey, defaultExcludeInterfaces, alwaysValidFunc},
+		"inject":                {injectAnnotationKey, "", alwaysValidFunc},
+		"status":                {sidecarStatusKey, "", alwaysValidFunc},
+		"redirectMode":          {sidecarInterceptModeKey, defaultRedirectMode, validateInterceptionMode},
+		"ports":                 {sidecarPortListKey, "", validatePortList},
+		"includeIPCidrs":        {includeIPCidrsKey, defaultRedirectIPCidr, validateCIDRListWithWildcard},
+		"excludeIPCidrs":        {excludeIPCidrsKey, defaultRedirectExcludeIPCidr, validateCIDRList},
+		"excludeInboundPorts":   {excludeInboundPortsKey, defaultRedirectExcludePort, validatePortListWithWildcard},
+		"includeInboundPorts":   {includeInboundPortsKey, defaultIncludeInboundPorts, validatePortListWithWildcard},
+		"excludeOutboundPorts":  {excludeOutboundPortsKey, defaultRedirectExcludePort, validatePortListWithWildcard},
+		"includeOutboundPorts":  {includeOutboundPortsKey, defaultIncludeOutboundPorts, validatePortListWithWildcard},
+		"kubevirtInterfaces":    {kubevirtInterfacesKey, defaultKubevirtInterfaces, alwaysValidFunc},
+		"excludeInterfaces":     {excludeInterfacesKey, defaultExcludeInterfaces, alwaysValidFunc},
+		"excludeInitContainers": {excludeInitContainersKey, defaultExcludeInitContainers, alwaysValidFunc},
 	}
 )
 
@@ -90,6 +101,7 @@ type Redirect struct {
 	dnsRedirect          bool
 	dualStack            bool
 	invalidDrop          bool
+	iptablesBackend      string
 }
 
 type annotationValidationFunc func(value string) error
@@ -230,6 +242,19 @@ func NewRedirect(pi *PodInfo) (*Redirect, error) {
 		redir.noRedirectGID = defaultNoRedirectGID
 	}
 
+	isFound, excludeInitContainers, valErr := getAnnotationOrDefault("excludeInitContainers", pi.Annotations)
+	if valErr != nil {
+		return nil, fmt.Errorf("annotation value error for value %s; annotationFound = %t: %v",
+			"excludeInitContainers", isFound, valErr)
+	}
+	excludedUIDs, err := initContainersExcludedUIDs(pi, redir.noRedirectUID, excludeInitContainers)
+	if err != nil {
+		return nil, fmt.Errorf("annotation value error for value %s: %v", "excludeInitContainers", err)
+	}
+	for _, uid := range excludedUIDs {
+		redir.noRedirectUID += "," + uid
+	}
+
 	isFound, redir.includeIPCidrs, valErr = getAnnotationOrDefault("includeIPCidrs", pi.Annotations)
 	if valErr != nil {
 		return nil, fmt.Errorf("annotation value error for value %s; annotationFound = %t: %v",
@@ -301,3 +326,56 @@ func NewRedirect(pi *PodInfo) (*Redirect, error) {
 	}
 	return redir, nil
 }
+
+// initContainersExcludedUIDs returns the users of the init containers whose traffic is exempted from the redirection,
+// besides the proxy user. The init containers which bypass the proxy by running as its user are reported, as are,
+// if the annotation is set, the init containers which are not exempted while their traffic cannot be intercepted.
+func initContainersExcludedUIDs(pi *PodInfo, proxyUID, excludeInitContainers string) ([]string, error) {
+	excluded := sets.New[string]()
+	for _, name := range strings.Split(excludeInitContainers, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			excluded.Insert(name)
+		}
+	}
+	all := excluded.Contains(excludeInitContainersAll)
+	// Only the pods using the annotation are told about the init containers left intercepted, most pods with init
+	// containers running before the proxy do not need it
+	annotated := excluded.Len() > 0
+
+	uids := sets.New[string]()
+	intercepted := map[string]string{}
+	for _, c := range pi.UserContainers {
+		var uid string
+		if c.UID != nil {
+			uid = strconv.FormatInt(*c.UID, 10)
+		}
+		exempted := c.Init && (excluded.Contains(c.Name) || all && c.BeforeProxy)
+		if !exempted {
+			if c.Init && uid == proxyUID {
+				log.Warnf("init container %s runs as the proxy user %s, its traffic bypasses the proxy", c.Name, uid)
+			} else if c.BeforeProxy && annotated {
+				log.Warnf("init container %s runs before the proxy is started, its traffic is redirected to the proxy and dropped; "+
+					"exempt it with the %s annotation", c.Name, excludeInitContainersKey)
+			}
+			if uid != "" {
+				intercepted[uid] = c.Name
+			}
+			continue
+		}
+		excluded.Delete(c.Name)
+		if c.UID == nil || *c.UID == 0 {
+			return nil, fmt.Errorf("init container %s must set a non-root runAsUser to be exempted", c.Name)
+		}
+		uids.Insert(uid)
+	}
+	excluded.Delete(excludeInitContainersAll)
+	if excluded.Len() > 0 {
+		return nil, fmt.Errorf("unknown init containers %v", sets.SortedList(excluded))
+	}
+	for uid := range uids {
+		if name, f := intercepted[uid]; f {
+			return nil, fmt.Errorf("exempted init containers run as user %s, which is shared with the intercepted container %s", uid, name)
+		}
+	}
+	return sets.SortedList(uids.Delete(proxyUID)), nil
+}
@@ -0,0 +1,115 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bufio"
+	"sort"
+	"strings"
+)
+
+// RuleDiff is the difference between the rules programmed in a pod network namespace and the ones the plugin would
+// program for the pod. Each rule is reported as "<table> <chain> <rule>".
+type RuleDiff struct {
+	// Missing are the rules the plugin would program, which are not in the pod network namespace.
+	Missing []string
+	// Unexpected are the rules of the pod network namespace the plugin would not program.
+	Unexpected []string
+}
+
+// Empty returns whether the rules are the same.
+func (d RuleDiff) Empty() bool {
+	return len(d.Missing) == 0 && len(d.Unexpected) == 0
+}
+
+// DiffRules compares the rules the plugin would program, in the iptables-restore format of GenerateRules, with the
+// rules saved from the pod network namespace by SaveRules. The rules are compared per table and chain, regardless of
+// their order and of the order of their options, which iptables-save rewrites.
+func DiffRules(generated, saved string) RuleDiff {
+	want := parseRules(generated)
+	have := parseRules(saved)
+	var diff RuleDiff
+	for rule, n := range want {
+		for i := have[rule]; i < n; i++ {
+			diff.Missing = append(diff.Missing, rule)
+		}
+	}
+	for rule, n := range have {
+		for i := want[rule]; i < n; i++ {
+			diff.Unexpected = append(diff.Unexpected, rule)
+		}
+	}
+	sort.Strings(diff.Missing)
+	sort.Strings(diff.Unexpected)
+	return diff
+}
+
+// parseRules counts the rules appended to the chains of a ruleset in the iptables-restore format, by table and chain.
+func parseRules(ruleset string) map[string]int {
+	rules := map[string]int{}
+	table := ""
+	scanner := bufio.NewScanner(strings.NewReader(ruleset))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if t, found := strings.CutPrefix(line, "*"); found {
+			table = strings.TrimSpace(t)
+			continue
+		}
+		rule, found := strings.CutPrefix(line, "-A ")
+		if !found {
+			continue
+		}
+		chain, rule, _ := strings.Cut(rule, " ")
+		rules[table+" "+chain+" "+normalizeRule(rule)]++
+	}
+	return rules
+}
+
+// normalizeRule sorts the options of a rule, each with its arguments and negation, dropping the protocol matches that
+// iptables-save adds for the port options.
+func normalizeRule(rule string) string {
+	var options []string
+	current := ""
+	negated := false
+	for _, token := range strings.Fields(rule) {
+		switch {
+		case token == "!":
+			negated = true
+		case strings.HasPrefix(token, "-"):
+			if current != "" {
+				options = append(options, current)
+			}
+			current = token
+			if negated {
+				current = "! " + token
+				negated = false
+			}
+		default:
+			current += " " + token
+		}
+	}
+	if current != "" {
+		options = append(options, current)
+	}
+	res := options[:0]
+	for _, o := range options {
+		if o == "-m tcp" || o == "-m udp" {
+			continue
+		}
+		res = append(res, o)
+	}
+	sort.Strings(res)
+	return strings.Join(res, " ")
+}
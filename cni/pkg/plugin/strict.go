@@ -0,0 +1,128 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"istio.io/istio/tools/istio-iptables/pkg/constants"
+)
+
+// The strict mode fails the ADD of the pods whose traffic cannot be guaranteed to be redirected to their proxy, rather
+// than letting them start without redirection: the rules are read back once programmed. It is enabled for all the
+// namespaces by the plugin configuration, or, when the plugin configuration allows it, for the namespaces with the
+// strict annotation.
+const strictAnnotation = "cni.istio.io/strict"
+
+// getKubeNamespaceAnnotations is a unit test override variable for interface create.
+var getKubeNamespaceAnnotations = getK8sNamespaceAnnotations
+
+func getK8sNamespaceAnnotations(client *kubernetes.Clientset, namespace string) (map[string]string, error) {
+	ns, err := client.CoreV1().Namespaces().Get(context.TODO(), namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return ns.Annotations, nil
+}
+
+// isStrict returns whether the strict mode applies to the pods of the namespace. The annotation only enables the
+// strict mode, so that it cannot be disabled for a namespace when enabled by the plugin configuration. The namespace
+// is only looked up when the plugin configuration allows the annotation, so that the ADD does not depend on the API
+// server otherwise.
+func isStrict(conf *Config, client *kubernetes.Clientset, namespace string) (bool, error) {
+	if conf.Strict {
+		return true, nil
+	}
+	if !conf.StrictNamespaces {
+		return false, nil
+	}
+	annotations, err := getKubeNamespaceAnnotations(client, namespace)
+	if err != nil {
+		// The strict mode is not enabled for all the namespaces, so the pod is not failed for an API server error
+		log.Warnf("failed to get the annotations of namespace %s, assuming it is not strict: %v", namespace, err)
+		return false, nil
+	}
+	val, f := annotations[strictAnnotation]
+	if !f {
+		return false, nil
+	}
+	strict, err := strconv.ParseBool(val)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s annotation %q on namespace %s: %v", strictAnnotation, val, namespace, err)
+	}
+	return strict, nil
+}
+
+// InterceptRuleVerifier is implemented by the InterceptRuleMgr able to check the rules they programmed, as required
+// by the strict mode.
+type InterceptRuleVerifier interface {
+	Verify(podName, netns string, redirect *Redirect) error
+}
+
+// verifyRedirect checks the rules programmed for the pod, failing if they cannot be checked.
+func verifyRedirect(mgr InterceptRuleMgr, podName, netns string, redirect *Redirect) error {
+	verifier, ok := mgr.(InterceptRuleVerifier)
+	if !ok {
+		return fmt.Errorf("the interception rules of %T cannot be verified", mgr)
+	}
+	return verifier.Verify(podName, netns, redirect)
+}
+
+// verifyRedirectRules checks that the nat table saved by iptables-save redirects the traffic of the pod to the proxy.
+// The inbound redirection is only checked in REDIRECT mode, as TPROXY is programmed in the mangle table.
+func verifyRedirectRules(save []byte, rdrct *Redirect) error {
+	rules := map[string][]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(save))
+	for scanner.Scan() {
+		line, found := strings.CutPrefix(scanner.Text(), "-A ")
+		if !found {
+			continue
+		}
+		chain, rule, _ := strings.Cut(line, " ")
+		rules[chain] = append(rules[chain], rule)
+	}
+	hasJump := func(chain, target string) bool {
+		for _, rule := range rules[chain] {
+			if strings.HasSuffix(rule, "-j "+target) || strings.Contains(rule, "-j "+target+" ") {
+				return true
+			}
+		}
+		return false
+	}
+
+	required := [][2]string{
+		{constants.OUTPUT, constants.ISTIOOUTPUT},
+		{constants.ISTIOREDIRECT, constants.REDIRECT},
+	}
+	if rdrct.includeInboundPorts != "" && rdrct.redirectMode == redirectModeREDIRECT {
+		required = append(required,
+			[2]string{constants.PREROUTING, constants.ISTIOINBOUND},
+			[2]string{constants.ISTIOINREDIRECT, constants.REDIRECT})
+	}
+	for _, r := range required {
+		if !hasJump(r[0], r[1]) {
+			return fmt.Errorf("the nat table of the pod has no rule jumping from %s to %s", r[0], r[1])
+		}
+	}
+	return nil
+}
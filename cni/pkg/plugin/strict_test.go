@@ -0,0 +1,171 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/containernetworking/cni/pkg/types"
+
+	"istio.io/istio/pkg/util/sets"
+)
+
+const natSave = `*nat
+:PREROUTING ACCEPT [0:0]
+:OUTPUT ACCEPT [0:0]
+:ISTIO_INBOUND - [0:0]
+:ISTIO_IN_REDIRECT - [0:0]
+:ISTIO_OUTPUT - [0:0]
+:ISTIO_REDIRECT - [0:0]
+-A PREROUTING -p tcp -j ISTIO_INBOUND
+-A OUTPUT -p tcp -j ISTIO_OUTPUT
+-A ISTIO_INBOUND -p tcp -m tcp --dport 15008 -j RETURN
+-A ISTIO_INBOUND -p tcp -j ISTIO_IN_REDIRECT
+-A ISTIO_IN_REDIRECT -p tcp -j REDIRECT --to-ports 15006
+-A ISTIO_OUTPUT -j ISTIO_REDIRECT
+-A ISTIO_REDIRECT -p tcp -j REDIRECT --to-ports 15001
+COMMIT
+`
+
+func TestVerifyRedirectRules(t *testing.T) {
+	withoutRule := func(rule string) string {
+		return strings.Replace(natSave, rule+"\n", "", 1)
+	}
+	cases := []struct {
+		name     string
+		save     string
+		redirect *Redirect
+		wantErr  bool
+	}{
+		{
+			name:     "complete",
+			save:     natSave,
+			redirect: &Redirect{redirectMode: redirectModeREDIRECT, includeInboundPorts: "*"},
+		},
+		{
+			name:     "empty",
+			save:     "*nat\nCOMMIT\n",
+			redirect: &Redirect{redirectMode: redirectModeREDIRECT, includeInboundPorts: "*"},
+			wantErr:  true,
+		},
+		{
+			name:     "no outbound jump",
+			save:     withoutRule("-A OUTPUT -p tcp -j ISTIO_OUTPUT"),
+			redirect: &Redirect{redirectMode: redirectModeREDIRECT},
+			wantErr:  true,
+		},
+		{
+			name:     "no inbound jump",
+			save:     withoutRule("-A PREROUTING -p tcp -j ISTIO_INBOUND"),
+			redirect: &Redirect{redirectMode: redirectModeREDIRECT, includeInboundPorts: "*"},
+			wantErr:  true,
+		},
+		{
+			name:     "no inbound redirection",
+			save:     withoutRule("-A PREROUTING -p tcp -j ISTIO_INBOUND"),
+			redirect: &Redirect{redirectMode: redirectModeREDIRECT},
+		},
+		{
+			name:     "tproxy",
+			save:     withoutRule("-A PREROUTING -p tcp -j ISTIO_INBOUND"),
+			redirect: &Redirect{redirectMode: redirectModeTPROXY, includeInboundPorts: "*"},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyRedirectRules([]byte(tt.save), tt.redirect)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("expected error: %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCmdAddStrict(t *testing.T) {
+	newKubeClient = mocknewK8sClient
+	getKubePodInfo = mockgetK8sPodInfo
+	cniConf := fmt.Sprintf(conf, currentVersion, currentVersion, ifname, sandboxDirectory, "mock")
+	strictConf := strings.Replace(cniConf, `"log_level"`, `"strict": true, "log_level"`, 1)
+	strictNamespacesConf := strings.Replace(cniConf, `"log_level"`, `"strict_namespaces": true, "log_level"`, 1)
+
+	t.Run("not strict", func(t *testing.T) {
+		defer resetGlobalTestVariables()
+		testContainers = sets.New("mockContainer", ISTIOPROXY)
+		testCmdAddWithStdinData(t, cniConf)
+		if singletonMockInterceptRuleMgr.verified {
+			t.Fatalf("expected the redirection not to be verified")
+		}
+	})
+
+	t.Run("strict namespace", func(t *testing.T) {
+		defer resetGlobalTestVariables()
+		testContainers = sets.New("mockContainer", ISTIOPROXY)
+		testNamespaceAnnotations = map[string]string{strictAnnotation: "true"}
+		testCmdAddWithStdinData(t, strictNamespacesConf)
+		if !singletonMockInterceptRuleMgr.verified {
+			t.Fatalf("expected the redirection to be verified")
+		}
+	})
+
+	t.Run("strict namespace not allowed", func(t *testing.T) {
+		defer resetGlobalTestVariables()
+		testContainers = sets.New("mockContainer", ISTIOPROXY)
+		testNamespaceAnnotations = map[string]string{strictAnnotation: "true"}
+		testCmdAddWithStdinData(t, cniConf)
+		if singletonMockInterceptRuleMgr.verified {
+			t.Fatalf("expected the redirection not to be verified")
+		}
+	})
+
+	t.Run("namespace lookup failure", func(t *testing.T) {
+		defer resetGlobalTestVariables()
+		testContainers = sets.New("mockContainer", ISTIOPROXY)
+		testNamespaceErr = errors.New("connection refused")
+		testCmdAddWithStdinData(t, strictNamespacesConf)
+		if singletonMockInterceptRuleMgr.verified {
+			t.Fatalf("expected the redirection not to be verified")
+		}
+	})
+
+	t.Run("invalid namespace annotation", func(t *testing.T) {
+		defer resetGlobalTestVariables()
+		testContainers = sets.New("mockContainer", ISTIOPROXY)
+		testNamespaceAnnotations = map[string]string{strictAnnotation: "yes please"}
+		if err := CmdAdd(testSetArgs(strictNamespacesConf)); err == nil {
+			t.Fatalf("expected an error")
+		}
+	})
+
+	t.Run("verification failure", func(t *testing.T) {
+		defer resetGlobalTestVariables()
+		testContainers = sets.New("mockContainer", ISTIOPROXY)
+		singletonMockInterceptRuleMgr.verifyErr = errors.New("no rule jumping from OUTPUT to ISTIO_OUTPUT")
+		assertStrictError(t, CmdAdd(testSetArgs(strictConf)))
+	})
+}
+
+func assertStrictError(t *testing.T, err error) {
+	t.Helper()
+	var cniErr *types.Error
+	if !errors.As(err, &cniErr) {
+		t.Fatalf("expected a CNI error, got %v", err)
+	}
+	if cniErr.Code != uint(ErrorCodeStrict) {
+		t.Fatalf("expected code %d, got %d: %v", ErrorCodeStrict, cniErr.Code, cniErr.Msg)
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"time"
+
+	udsLog "istio.io/istio/cni/pkg/log"
+	"istio.io/istio/pkg/log"
+)
+
+// Phases of a plugin invocation
+const (
+	phaseKubeconfig = "kubeconfig"
+	phaseAPI        = "api"
+	phaseAmbient    = "ambient"
+	phaseRules      = "rules"
+)
+
+// invocationTimer measures a plugin invocation and its phases, to report them to the node agent.
+type invocationTimer struct {
+	timing         udsLog.PluginTiming
+	sandboxChanged bool
+}
+
+func newInvocationTimer(command string) *invocationTimer {
+	return &invocationTimer{timing: udsLog.PluginTiming{Command: command, Start: time.Now()}}
+}
+
+func (t *invocationTimer) setPod(namespace, name string) {
+	t.timing.Pod = namespace + "/" + name
+}
+
+// phase starts measuring a phase, returning the function ending it.
+func (t *invocationTimer) phase(name string) func() {
+	start := time.Now()
+	return func() {
+		t.timing.Phases = append(t.timing.Phases, udsLog.PluginPhase{Name: name, Start: start, Duration: time.Since(start)})
+	}
+}
+
+// sandboxChange records that the pod had its sandbox recreated, and the redirection of its previous sandbox removed.
+func (t *invocationTimer) sandboxChange() {
+	t.sandboxChanged = true
+}
+
+// report sends the events of the invocation to the node agent through the UDS log server, and the timing if enabled
+// by the plugin config. Reporting is best effort, as it must not affect the outcome of the invocation.
+func (t *invocationTimer) report(conf *Config, err error) {
+	t.timing.Duration = time.Since(t.timing.Start)
+	switch {
+	case err != nil:
+		t.timing.Result = udsLog.PluginResultError
+		t.timing.ErrorCode = classifyError(err).Name()
+	default:
+		t.timing.Result = udsLog.PluginResultSuccess
+	}
+	if conf == nil || conf.LogUDSAddress == "" {
+		return
+	}
+	if t.sandboxChanged {
+		event := udsLog.PluginEvent{Type: udsLog.PluginEventSandboxChanged, Pod: t.timing.Pod}
+		if err := udsLog.ReportPluginEvent(conf.LogUDSAddress, event); err != nil {
+			log.Debugf("failed to report sandbox change of %s to the node agent: %v", t.timing.Pod, err)
+		}
+	}
+	if !conf.PluginTiming {
+		return
+	}
+	if err := udsLog.ReportPluginTiming(conf.LogUDSAddress, t.timing); err != nil {
+		log.Debugf("failed to report timing of %s to the node agent: %v", t.timing.Command, err)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugin
+
+import (
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/atomic"
+
+	"istio.io/istio/cni/pkg/constants"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestInvocationTimerReport(t *testing.T) {
+	udsSock := filepath.Join(t.TempDir(), "cni.sock")
+	l, err := net.Listen("unix", udsSock)
+	assert.NoError(t, err)
+	reports := atomic.NewInt32(0)
+	events := atomic.NewInt32(0)
+	mux := http.NewServeMux()
+	mux.HandleFunc(constants.UDSTimingPath, func(w http.ResponseWriter, r *http.Request) {
+		reports.Inc()
+	})
+	mux.HandleFunc(constants.UDSEventPath, func(w http.ResponseWriter, r *http.Request) {
+		events.Inc()
+	})
+	srv := &http.Server{Handler: mux}
+	go func() {
+		_ = srv.Serve(l)
+	}()
+	t.Cleanup(func() {
+		_ = srv.Close()
+	})
+
+	// Reporting is opt-in
+	newInvocationTimer("ADD").report(&Config{LogUDSAddress: udsSock}, nil)
+	assert.Equal(t, reports.Load(), int32(0))
+
+	newInvocationTimer("ADD").report(&Config{LogUDSAddress: udsSock, PluginTiming: true}, nil)
+	assert.Equal(t, reports.Load(), int32(1))
+	assert.Equal(t, events.Load(), int32(0))
+
+	// Events are reported even if the timing is not
+	timer := newInvocationTimer("ADD")
+	timer.setPod("default", "pod")
+	timer.sandboxChange()
+	timer.report(&Config{LogUDSAddress: udsSock}, nil)
+	assert.Equal(t, reports.Load(), int32(1))
+	assert.Equal(t, events.Load(), int32(1))
+}
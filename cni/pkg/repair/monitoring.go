@@ -28,9 +28,30 @@ var (
 	resultSuccess = "success"
 	resultSkip    = "skip"
 	resultFail    = "fail"
+	resultMatch   = "match"
+	resultDiffer  = "differ"
+
+	kindLabel      = monitoring.CreateLabel("kind")
+	kindMissing    = "missing"
+	kindUnexpected = "unexpected"
 
 	podsRepaired = monitoring.NewSum(
 		"istio_cni_repair_pods_repaired_total",
 		"Total number of pods repaired by repair controller",
 	)
+
+	shadowPodsEvaluated = monitoring.NewSum(
+		"istio_cni_shadow_pods_evaluated_total",
+		"Total number of running pods whose rules were compared with the ones the installed plugin would program",
+	)
+
+	shadowRuleDifferences = monitoring.NewSum(
+		"istio_cni_shadow_rule_differences_total",
+		"Total number of rules of running pods missing or unexpected, compared with the ones the installed plugin would program",
+	)
+
+	shadowPodsDiffering = monitoring.NewGauge(
+		"istio_cni_shadow_pods_differing",
+		"Number of running pods of the node whose rules differ from the ones the installed plugin would program",
+	)
 )
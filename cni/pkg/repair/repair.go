@@ -24,10 +24,12 @@ import (
 
 var repairLog = log.RegisterScope("repair", "CNI race condition repair")
 
-func StartRepair(ctx context.Context, cfg config.RepairConfig) {
-	if !cfg.Enabled {
+// StartRepair starts the race condition repair. If standby is set, the repair controller is started even though the
+// repair is disabled, so that it can be enabled at runtime. Returns nil if the controller is not started.
+func StartRepair(ctx context.Context, cfg config.RepairConfig, standby bool) *Controller {
+	if !cfg.Enabled && !standby {
 		repairLog.Info("CNI repair is disable.")
-		return
+		return nil
 	}
 	repairLog.Info("Start CNI race condition repair.")
 
@@ -40,8 +42,10 @@ func StartRepair(ctx context.Context, cfg config.RepairConfig) {
 	if err != nil {
 		repairLog.Fatalf("Fatal error constructing repair controller: %+v", err)
 	}
+	rc.SetEnabled(cfg.Enabled)
 	go rc.Run(ctx.Done())
 	client.RunAndWait(ctx.Done())
+	return rc
 }
 
 // Set up Kubernetes client using kubeconfig (or in-cluster config if no file provided)
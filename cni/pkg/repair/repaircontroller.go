@@ -18,9 +18,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 
 	"istio.io/istio/cni/pkg/config"
@@ -36,6 +39,11 @@ type Controller struct {
 	queue        controllers.Queue
 	cfg          config.RepairConfig
 	repairedPods map[types.NamespacedName]types.UID
+
+	// disabled is set while the repair is disabled at runtime; pods are watched but not repaired
+	disabled atomic.Bool
+	// reconcileInterval receives the interval at which all the pods are checked when changed at runtime
+	reconcileInterval chan time.Duration
 }
 
 func NewRepairController(client kube.Client, cfg config.RepairConfig) (*Controller, error) {
@@ -43,6 +51,8 @@ func NewRepairController(client kube.Client, cfg config.RepairConfig) (*Controll
 		cfg:          cfg,
 		client:       client,
 		repairedPods: map[types.NamespacedName]types.UID{},
+
+		reconcileInterval: make(chan time.Duration, 1),
 	}
 	fieldSelectors := []string{}
 	if cfg.FieldSelectors != "" {
@@ -64,10 +74,67 @@ func NewRepairController(client kube.Client, cfg config.RepairConfig) (*Controll
 
 func (c *Controller) Run(stop <-chan struct{}) {
 	kube.WaitForCacheSync("repair controller", stop, c.pods.HasSynced)
+	go c.runPeriodicReconcile(stop)
 	c.queue.Run(stop)
 	c.pods.ShutdownHandlers()
 }
 
+// SetEnabled enables or disables the repair. Pods which changed while the repair was disabled are checked once it
+// is enabled again.
+func (c *Controller) SetEnabled(enabled bool) {
+	if wasDisabled := c.disabled.Swap(!enabled); wasDisabled && enabled {
+		repairLog.Info("CNI race condition repair enabled")
+		c.enqueueAll()
+	} else if !wasDisabled && !enabled {
+		repairLog.Info("CNI race condition repair disabled")
+	}
+}
+
+// SetReconcileInterval changes the interval at which all the pods are checked. Zero disables the periodic check.
+func (c *Controller) SetReconcileInterval(interval time.Duration) {
+	// Only the latest interval matters
+	select {
+	case <-c.reconcileInterval:
+	default:
+	}
+	c.reconcileInterval <- interval
+}
+
+// runPeriodicReconcile checks all the pods at the reconcile interval, until stop is closed.
+func (c *Controller) runPeriodicReconcile(stop <-chan struct{}) {
+	var ticker *time.Ticker
+	var tick <-chan time.Time
+	reset := func(interval time.Duration) {
+		if ticker != nil {
+			ticker.Stop()
+			ticker, tick = nil, nil
+		}
+		if interval > 0 {
+			ticker = time.NewTicker(interval)
+			tick = ticker.C
+		}
+	}
+	reset(c.cfg.ReconcileInterval)
+	defer reset(0)
+	for {
+		select {
+		case <-stop:
+			return
+		case interval := <-c.reconcileInterval:
+			repairLog.Infof("checking all pods every %v", interval)
+			reset(interval)
+		case <-tick:
+			c.enqueueAll()
+		}
+	}
+}
+
+func (c *Controller) enqueueAll() {
+	for _, pod := range c.pods.List(metav1.NamespaceAll, klabels.Everything()) {
+		c.queue.AddObject(pod)
+	}
+}
+
 func (c *Controller) Reconcile(key types.NamespacedName) error {
 	pod := c.pods.Get(key.Name, key.Namespace)
 	if pod == nil {
@@ -79,6 +146,9 @@ func (c *Controller) Reconcile(key types.NamespacedName) error {
 }
 
 func (c *Controller) ReconcilePod(pod *corev1.Pod) (err error) {
+	if c.disabled.Load() {
+		return // Skip, repair is disabled at runtime
+	}
 	if !c.matchesFilter(pod) {
 		return // Skip, pod doesn't need repair
 	}
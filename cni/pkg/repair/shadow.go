@@ -0,0 +1,169 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repair
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"istio.io/istio/cni/pkg/config"
+	"istio.io/istio/cni/pkg/plugin"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// ShadowController compares the redirection rules programmed in the running pods of the node with the ones this
+// version of the plugin would program for them, without applying them. This validates an upgrade of the plugin
+// changing the rules on production nodes, either before the upgrade with ShadowRulesOnly, while the previous version
+// stays installed and programs the new pods, or after it, as the pods started before the upgrade keep the rules of
+// the previous version. The differing rules are reported by metrics and logged.
+type ShadowController struct {
+	cfg   config.RepairConfig
+	pods  kclient.Client[*corev1.Pod]
+	queue controllers.Queue
+
+	// evaluated are the UIDs of the pods whose rules were compared. The rules are only programmed when a pod starts,
+	// so a pod is not compared again as it changes.
+	evaluated map[types.NamespacedName]types.UID
+	// differing are the pods whose rules differ
+	differing sets.Set[types.NamespacedName]
+
+	// Unit test overrides
+	podNetNs      func(pod *corev1.Pod) (string, error)
+	generateRules func(redirect *plugin.Redirect) (string, error)
+	saveRules     func(netns string, redirect *plugin.Redirect) (string, error)
+}
+
+func NewShadowController(client kube.Client, cfg config.RepairConfig) *ShadowController {
+	c := &ShadowController{
+		cfg:       cfg,
+		evaluated: map[types.NamespacedName]types.UID{},
+		differing: sets.New[types.NamespacedName](),
+		podNetNs: func(pod *corev1.Pod) (string, error) {
+			return runInHost(func() (string, error) { return getPodNetNs(pod) })
+		},
+		generateRules: plugin.GenerateRules,
+		saveRules:     plugin.SaveRules,
+	}
+	c.pods = kclient.NewFiltered[*corev1.Pod](client, kclient.Filter{
+		FieldSelector: fmt.Sprintf("spec.nodeName=%v", cfg.NodeName),
+	})
+	c.queue = controllers.NewQueue("shadow rules",
+		controllers.WithReconciler(c.Reconcile),
+		controllers.WithMaxAttempts(5))
+	c.pods.AddEventHandler(controllers.ObjectHandler(c.queue.AddObject))
+	return c
+}
+
+// StartShadowEvaluation starts comparing the rules of the running pods with the ones of this version of the plugin,
+// if enabled.
+func StartShadowEvaluation(ctx context.Context, cfg config.RepairConfig) {
+	if !cfg.ShadowRulesEnabled && !cfg.ShadowRulesOnly {
+		return
+	}
+	repairLog.Info("Start CNI shadow rule evaluation.")
+	client, err := clientSetup()
+	if err != nil {
+		repairLog.Fatalf("CNI shadow rule evaluation could not construct clientSet: %s", err)
+	}
+	c := NewShadowController(client, cfg)
+	go c.Run(ctx.Done())
+	client.RunAndWait(ctx.Done())
+}
+
+func (c *ShadowController) Run(stop <-chan struct{}) {
+	kube.WaitForCacheSync("shadow rules", stop, c.pods.HasSynced)
+	c.queue.Run(stop)
+	c.pods.ShutdownHandlers()
+}
+
+func (c *ShadowController) Reconcile(key types.NamespacedName) error {
+	pod := c.pods.Get(key.Name, key.Namespace)
+	if pod == nil {
+		delete(c.evaluated, key)
+		c.differing.Delete(key)
+		shadowPodsDiffering.Record(float64(c.differing.Len()))
+		return nil
+	}
+	if uid, f := c.evaluated[key]; f && uid == pod.UID {
+		return nil
+	}
+	if !c.redirected(pod) {
+		return nil
+	}
+
+	diff, err := c.evaluate(pod)
+	if err != nil {
+		shadowPodsEvaluated.With(resultLabel.Value(resultFail)).Increment()
+		return fmt.Errorf("failed to compare the rules of pod %s/%s: %v", pod.Namespace, pod.Name, err)
+	}
+	c.evaluated[key] = pod.UID
+	if diff.Empty() {
+		c.differing.Delete(key)
+		shadowPodsEvaluated.With(resultLabel.Value(resultMatch)).Increment()
+	} else {
+		c.differing.Insert(key)
+		shadowPodsEvaluated.With(resultLabel.Value(resultDiffer)).Increment()
+		shadowRuleDifferences.With(kindLabel.Value(kindMissing)).RecordInt(int64(len(diff.Missing)))
+		shadowRuleDifferences.With(kindLabel.Value(kindUnexpected)).RecordInt(int64(len(diff.Unexpected)))
+		repairLog.WithLabels("pod", key.String()).Warnf("rules differ from the ones of the installed plugin, missing: [%s], unexpected: [%s]",
+			strings.Join(diff.Missing, "; "), strings.Join(diff.Unexpected, "; "))
+	}
+	shadowPodsDiffering.Record(float64(c.differing.Len()))
+	return nil
+}
+
+// redirected returns whether the traffic of the pod was redirected by the plugin when it started. The pods with
+// hostPorts are left out, as their rules depend on the hostPort mode of the plugin.
+func (c *ShadowController) redirected(pod *corev1.Pod) bool {
+	if pod.Spec.HostNetwork || pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" {
+		return false
+	}
+	if _, f := pod.Annotations[c.cfg.SidecarAnnotation]; !f {
+		return false
+	}
+	pi := plugin.ExtractPodInfo(pod)
+	if !pi.Containers.Contains(plugin.ISTIOPROXY) || pi.Containers.Contains(plugin.ISTIOINIT) {
+		return false
+	}
+	return (pi.ProxyType == "" || pi.ProxyType == "sidecar") && len(pi.HostPorts) == 0
+}
+
+// evaluate compares the rules of the pod with the ones the plugin would program for it.
+func (c *ShadowController) evaluate(pod *corev1.Pod) (plugin.RuleDiff, error) {
+	redirect, err := plugin.NewRedirect(plugin.ExtractPodInfo(pod))
+	if err != nil {
+		return plugin.RuleDiff{}, fmt.Errorf("setup redirect: %v", err)
+	}
+	generated, err := c.generateRules(redirect)
+	if err != nil {
+		return plugin.RuleDiff{}, fmt.Errorf("generate rules: %v", err)
+	}
+	netns, err := c.podNetNs(pod)
+	if err != nil {
+		return plugin.RuleDiff{}, fmt.Errorf("get netns: %v", err)
+	}
+	saved, err := c.saveRules(netns, redirect)
+	if err != nil {
+		return plugin.RuleDiff{}, fmt.Errorf("save rules: %v", err)
+	}
+	return plugin.DiffRules(generated, saved), nil
+}
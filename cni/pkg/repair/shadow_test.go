@@ -0,0 +1,90 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package repair
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/istio/cni/pkg/config"
+	"istio.io/istio/cni/pkg/plugin"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/monitoring/monitortest"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func makeSidecarPod(name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Annotations: map[string]string{"sidecar.istio.io/status": "something"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "app"}, {Name: plugin.ISTIOPROXY}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning, PodIP: "10.0.0.1"},
+	}
+}
+
+func TestShadowController(t *testing.T) {
+	generated := `* nat
+-A OUTPUT -p tcp -j ISTIO_OUTPUT
+-A ISTIO_OUTPUT -j ISTIO_REDIRECT
+COMMIT
+`
+	saved := map[string]string{
+		"same": `*nat
+-A OUTPUT -p tcp -j ISTIO_OUTPUT
+-A ISTIO_OUTPUT -j ISTIO_REDIRECT
+COMMIT
+`,
+		"changed": `*nat
+-A OUTPUT -p tcp -j ISTIO_OUTPUT
+COMMIT
+`,
+	}
+	notInjected := makeSidecarPod("not-injected")
+	notInjected.Annotations = nil
+
+	mt := monitortest.New(t)
+	client := fakeClient(makeSidecarPod("same"), makeSidecarPod("changed"), notInjected)
+	c := NewShadowController(client, config.RepairConfig{SidecarAnnotation: "sidecar.istio.io/status"})
+	c.podNetNs = func(pod *corev1.Pod) (string, error) {
+		return pod.Name, nil
+	}
+	c.generateRules = func(*plugin.Redirect) (string, error) {
+		return generated, nil
+	}
+	c.saveRules = func(netns string, _ *plugin.Redirect) (string, error) {
+		return saved[netns], nil
+	}
+	t.Cleanup(func() {
+		assert.NoError(t, c.queue.WaitForClose(time.Second))
+	})
+	stop := test.NewStop(t)
+	client.RunAndWait(stop)
+	go c.Run(stop)
+	kube.WaitForCacheSync("test", stop, c.queue.HasSynced)
+
+	mt.Assert(shadowPodsEvaluated.Name(), map[string]string{"result": resultMatch}, monitortest.Exactly(1))
+	mt.Assert(shadowPodsEvaluated.Name(), map[string]string{"result": resultDiffer}, monitortest.Exactly(1))
+	mt.Assert(shadowRuleDifferences.Name(), map[string]string{"kind": kindMissing}, monitortest.Exactly(1))
+	mt.Assert(shadowPodsDiffering.Name(), nil, monitortest.Exactly(1))
+}
@@ -0,0 +1,114 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtimeconfig
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+
+	"istio.io/istio/pkg/log"
+)
+
+// Keys of the runtime flags in the ConfigMap.
+const (
+	LogLevelKey                 = "logLevel"
+	RepairEnabledKey            = "repairEnabled"
+	RepairReconcileIntervalKey  = "repairReconcileInterval"
+	AmbientEnrollmentEnabledKey = "ambientEnrollmentEnabled"
+)
+
+// Flags are the operational flags of the node agent which can be changed without restarting it.
+type Flags struct {
+	// LogLevel is the output level of the node agent logs, either a level applied to all scopes or a comma-separated
+	// list of scope:level pairs. If empty, the levels set on startup are used.
+	LogLevel string
+	// RepairEnabled enables the race condition repair.
+	RepairEnabled bool
+	// RepairReconcileInterval is the interval at which all the pods of the node are checked by the race condition
+	// repair, in addition to checking the pods as they change. Zero disables the periodic check.
+	RepairReconcileInterval time.Duration
+	// AmbientEnrollmentEnabled enables adding pods to the ambient mesh. Pods are still removed while disabled.
+	AmbientEnrollmentEnabled bool
+}
+
+var stringToLevel = map[string]log.Level{
+	"debug": log.DebugLevel,
+	"info":  log.InfoLevel,
+	"warn":  log.WarnLevel,
+	"error": log.ErrorLevel,
+	"fatal": log.FatalLevel,
+	"none":  log.NoneLevel,
+}
+
+// parseFlags overrides the defaults with the flags set in the ConfigMap data. Invalid flags are reported and keep
+// their default value, so that a typo does not prevent applying the other flags.
+func parseFlags(data map[string]string, defaults Flags) (Flags, error) {
+	flags := defaults
+	var errs *multierror.Error
+	if v, f := data[LogLevelKey]; f {
+		if _, err := parseLogLevels(v); err != nil {
+			errs = multierror.Append(errs, err)
+		} else {
+			flags.LogLevel = v
+		}
+	}
+	if v, f := data[RepairEnabledKey]; f {
+		if b, err := strconv.ParseBool(v); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("invalid %s %q: %v", RepairEnabledKey, v, err))
+		} else {
+			flags.RepairEnabled = b
+		}
+	}
+	if v, f := data[RepairReconcileIntervalKey]; f {
+		if d, err := time.ParseDuration(v); err != nil || d < 0 {
+			errs = multierror.Append(errs, fmt.Errorf("invalid %s %q: expected a positive duration", RepairReconcileIntervalKey, v))
+		} else {
+			flags.RepairReconcileInterval = d
+		}
+	}
+	if v, f := data[AmbientEnrollmentEnabledKey]; f {
+		if b, err := strconv.ParseBool(v); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("invalid %s %q: %v", AmbientEnrollmentEnabledKey, v, err))
+		} else {
+			flags.AmbientEnrollmentEnabled = b
+		}
+	}
+	return flags, errs.ErrorOrNil()
+}
+
+// parseLogLevels parses a level applied to all scopes, or a comma-separated list of scope:level pairs. The levels of
+// all scopes are returned under the empty scope name.
+func parseLogLevels(s string) (map[string]log.Level, error) {
+	levels := map[string]log.Level{}
+	if s == "" {
+		return levels, nil
+	}
+	for _, sl := range strings.Split(s, ",") {
+		scope, level, f := strings.Cut(strings.TrimSpace(sl), ":")
+		if !f {
+			scope, level = "", scope
+		}
+		l, ok := stringToLevel[strings.ToLower(level)]
+		if !ok {
+			return nil, fmt.Errorf("invalid %s %q: unknown level %q", LogLevelKey, s, level)
+		}
+		levels[scope] = l
+	}
+	return levels, nil
+}
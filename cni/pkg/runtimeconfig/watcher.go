@@ -0,0 +1,137 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtimeconfig
+
+import (
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/log"
+)
+
+var runtimeLog = log.RegisterScope("runtimeconfig", "CNI runtime flags")
+
+// Watcher applies the flags of a ConfigMap to the node agent as the ConfigMap changes, so that they can be changed
+// without rolling the DaemonSet. The flags missing from the ConfigMap, or the whole ConfigMap, fall back to the values
+// set on startup.
+type Watcher struct {
+	name       types.NamespacedName
+	configMaps kclient.Client[*corev1.ConfigMap]
+	queue      controllers.Queue
+
+	defaults Flags
+	// startupLevels are the output levels of the log scopes set on startup
+	startupLevels map[string]log.Level
+
+	mu       sync.Mutex
+	current  Flags
+	handlers []func(Flags)
+}
+
+// NewWatcher creates a Watcher of the ConfigMap. The defaults are the flags set on startup.
+func NewWatcher(client kube.Client, namespace, name string, defaults Flags) *Watcher {
+	w := &Watcher{
+		name:          types.NamespacedName{Namespace: namespace, Name: name},
+		defaults:      defaults,
+		current:       defaults,
+		startupLevels: map[string]log.Level{},
+	}
+	for n, s := range log.Scopes() {
+		w.startupLevels[n] = s.GetOutputLevel()
+	}
+	w.queue = controllers.NewQueue("cni runtime config",
+		controllers.WithReconciler(w.Reconcile),
+		controllers.WithMaxAttempts(5))
+	w.configMaps = kclient.NewFiltered[*corev1.ConfigMap](client, kclient.Filter{
+		Namespace:     namespace,
+		FieldSelector: "metadata.name=" + name,
+	})
+	w.configMaps.AddEventHandler(controllers.ObjectHandler(w.queue.AddObject))
+	return w
+}
+
+// AddHandler registers a handler called with the flags whenever they change.
+func (w *Watcher) AddHandler(h func(Flags)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.handlers = append(w.handlers, h)
+}
+
+// Flags returns the flags currently applied.
+func (w *Watcher) Flags() Flags {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.current
+}
+
+// Run applies the flags of the ConfigMap until stop is closed.
+func (w *Watcher) Run(stop <-chan struct{}) {
+	kube.WaitForCacheSync("cni runtime config", stop, w.configMaps.HasSynced)
+	// Apply the flags even if the ConfigMap does not exist yet
+	w.queue.Add(w.name)
+	w.queue.Run(stop)
+	w.configMaps.ShutdownHandlers()
+}
+
+// Reconcile applies the flags of the ConfigMap.
+func (w *Watcher) Reconcile(key types.NamespacedName) error {
+	if key != w.name {
+		return nil
+	}
+	var data map[string]string
+	if cm := w.configMaps.Get(key.Name, key.Namespace); cm != nil {
+		data = cm.Data
+	}
+	flags, err := parseFlags(data, w.defaults)
+	if err != nil {
+		// Not retried, the ConfigMap has to be fixed
+		runtimeLog.Errorf("invalid runtime flags in ConfigMap %s, using the startup values instead: %v", key, err)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if flags == w.current {
+		return nil
+	}
+	runtimeLog.Infof("applying runtime flags from ConfigMap %s: %+v", key, flags)
+	if flags.LogLevel != w.current.LogLevel {
+		w.applyLogLevel(flags.LogLevel)
+	}
+	w.current = flags
+	for _, h := range w.handlers {
+		h(flags)
+	}
+	return nil
+}
+
+// applyLogLevel restores the output levels set on startup, then applies the levels.
+func (w *Watcher) applyLogLevel(level string) {
+	// The level was validated when parsing the flags
+	levels, _ := parseLogLevels(level)
+	for n, s := range log.Scopes() {
+		if l, f := levels[n]; f {
+			s.SetOutputLevel(l)
+		} else if l, f := levels[""]; f {
+			s.SetOutputLevel(l)
+		} else if l, f := w.startupLevels[n]; f {
+			s.SetOutputLevel(l)
+		}
+	}
+}
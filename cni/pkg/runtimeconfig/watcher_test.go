@@ -0,0 +1,133 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtimeconfig
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/kclient/clienttest"
+	"istio.io/istio/pkg/log"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestParseFlags(t *testing.T) {
+	defaults := Flags{RepairEnabled: true, AmbientEnrollmentEnabled: true}
+	cases := []struct {
+		name    string
+		data    map[string]string
+		want    Flags
+		wantErr bool
+	}{
+		{
+			name: "no flags",
+			want: defaults,
+		},
+		{
+			name: "all flags",
+			data: map[string]string{
+				LogLevelKey:                 "default:debug,repair:warn",
+				RepairEnabledKey:            "false",
+				RepairReconcileIntervalKey:  "10m",
+				AmbientEnrollmentEnabledKey: "false",
+			},
+			want: Flags{LogLevel: "default:debug,repair:warn", RepairReconcileInterval: 10 * time.Minute},
+		},
+		{
+			name: "invalid flags keep their default",
+			data: map[string]string{
+				LogLevelKey:                 "verbose",
+				RepairEnabledKey:            "no",
+				RepairReconcileIntervalKey:  "-1s",
+				AmbientEnrollmentEnabledKey: "false",
+			},
+			want:    Flags{RepairEnabled: true},
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseFlags(tt.data, defaults)
+			assert.Equal(t, err != nil, tt.wantErr)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestParseLogLevels(t *testing.T) {
+	levels, err := parseLogLevels("info")
+	assert.NoError(t, err)
+	assert.Equal(t, levels, map[string]log.Level{"": log.InfoLevel})
+
+	levels, err = parseLogLevels("default:debug, repair:WARN")
+	assert.NoError(t, err)
+	assert.Equal(t, levels, map[string]log.Level{"default": log.DebugLevel, "repair": log.WarnLevel})
+
+	_, err = parseLogLevels("repair:verbose")
+	assert.Error(t, err)
+}
+
+func TestWatcher(t *testing.T) {
+	scope := log.RegisterScope("runtimeconfig-test", "")
+	scope.SetOutputLevel(log.InfoLevel)
+
+	stop := test.NewStop(t)
+	client := kube.NewFakeClient()
+	w := NewWatcher(client, "istio-system", "istio-cni-runtime-config", Flags{RepairEnabled: true, AmbientEnrollmentEnabled: true})
+	var calls atomic.Int32
+	w.AddHandler(func(Flags) {
+		calls.Add(1)
+	})
+	client.RunAndWait(stop)
+	go w.Run(stop)
+
+	configMaps := clienttest.NewWriter[*corev1.ConfigMap](t, client)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "istio-cni-runtime-config", Namespace: "istio-system"},
+		Data: map[string]string{
+			LogLevelKey:                 "runtimeconfig-test:debug",
+			AmbientEnrollmentEnabledKey: "false",
+		},
+	}
+	configMaps.Create(cm)
+	want := Flags{LogLevel: "runtimeconfig-test:debug", RepairEnabled: true}
+	assert.EventuallyEqual(t, w.Flags, want)
+	assert.Equal(t, scope.GetOutputLevel(), log.DebugLevel)
+	assert.Equal(t, calls.Load(), int32(1))
+
+	// ConfigMaps of another name are ignored
+	configMaps.Create(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "istio-system"},
+		Data:       map[string]string{RepairEnabledKey: "false"},
+	})
+
+	// Unchanged flags are not applied again
+	cm.Labels = map[string]string{"changed": "true"}
+	configMaps.Update(cm)
+	assert.Equal(t, w.Flags(), want)
+	assert.Equal(t, calls.Load(), int32(1))
+
+	// Removing the ConfigMap restores the startup values
+	configMaps.Delete(cm.Name, cm.Namespace)
+	assert.EventuallyEqual(t, w.Flags, Flags{RepairEnabled: true, AmbientEnrollmentEnabled: true})
+	assert.Equal(t, scope.GetOutputLevel(), log.InfoLevel)
+	assert.Equal(t, calls.Load(), int32(2))
+}
@@ -33,6 +33,8 @@ import (
 	"istio.io/istio/istioctl/pkg/config"
 	"istio.io/istio/istioctl/pkg/dashboard"
 	"istio.io/istio/istioctl/pkg/describe"
+	"istio.io/istio/istioctl/pkg/gateway"
+	"istio.io/istio/istioctl/pkg/impact"
 	"istio.io/istio/istioctl/pkg/injector"
 	"istio.io/istio/istioctl/pkg/install"
 	"istio.io/istio/istioctl/pkg/internaldebug"
@@ -45,6 +47,7 @@ import (
 	"istio.io/istio/istioctl/pkg/revision"
 	"istio.io/istio/istioctl/pkg/root"
 	"istio.io/istio/istioctl/pkg/tag"
+	"istio.io/istio/istioctl/pkg/tenant"
 	"istio.io/istio/istioctl/pkg/util"
 	"istio.io/istio/istioctl/pkg/validate"
 	"istio.io/istio/istioctl/pkg/version"
@@ -210,6 +213,9 @@ debug and diagnose their Istio mesh.
 	experimentalCmd.AddCommand(proxyconfig.StatsConfigCmd(ctx))
 	experimentalCmd.AddCommand(checkinject.Cmd(ctx))
 	experimentalCmd.AddCommand(waypoint.Cmd(ctx))
+	experimentalCmd.AddCommand(gateway.Cmd(ctx))
+	experimentalCmd.AddCommand(impact.Cmd(ctx))
+	experimentalCmd.AddCommand(tenant.Cmd(ctx))
 
 	analyzeCmd := analyze.Analyze(ctx)
 	hideInheritedFlags(analyzeCmd, cli.FlagIstioNamespace)
@@ -0,0 +1,104 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/istio/istioctl/pkg/cli"
+	"istio.io/istio/istioctl/pkg/clioptions"
+	"istio.io/istio/istioctl/pkg/util/handlers"
+	"istio.io/istio/pilot/pkg/xds"
+)
+
+func Cmd(ctx cli.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway",
+		Short: "Commands to inspect the gateways deployed by Istio for Kubernetes Gateway API resources",
+	}
+	cmd.AddCommand(previewCmd(ctx))
+	return cmd
+}
+
+func previewCmd(ctx cli.Context) *cobra.Command {
+	var opts clioptions.ControlPlaneOptions
+	cmd := &cobra.Command{
+		Use:   "preview <name>[.<namespace>]",
+		Short: "Renders the resources Istio generates for a Gateway, without applying them",
+		Long: `Renders the Deployment, Service and other resources istiod generates for a Kubernetes Gateway with the
+current GatewayClass and injection templates, without applying them. This allows reviewing the effect of changes to
+the gateway templates before they are rolled out.`,
+		Example: `  # Preview the resources generated for the Gateway "gateway" in the "default" namespace
+  istioctl x gateway preview gateway.default`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kubeClient, err := ctx.CLIClientWithRevision(opts.Revision)
+			if err != nil {
+				return err
+			}
+			name, ns := handlers.InferPodInfo(args[0], ctx.NamespaceOrDefault(ctx.Namespace()))
+			path := fmt.Sprintf("debug/gateway_preview?gateway=%s&namespace=%s", url.QueryEscape(name), url.QueryEscape(ns))
+			res, err := kubeClient.AllDiscoveryDo(context.Background(), ctx.IstioNamespace(), path)
+			if err != nil {
+				return err
+			}
+			return writePreview(cmd.OutOrStdout(), res)
+		},
+	}
+	opts.AttachControlPlaneFlags(cmd)
+	return cmd
+}
+
+// writePreview writes the resources rendered by any istiod instance. Only the instance running the gateway
+// deployment controller renders them, the other instances respond with an error.
+func writePreview(out io.Writer, input map[string][]byte) error {
+	istiods := make([]string, 0, len(input))
+	for istiod := range input {
+		istiods = append(istiods, istiod)
+	}
+	sort.Strings(istiods)
+
+	var errs []string
+	for _, istiod := range istiods {
+		var preview xds.GatewayPreview
+		if err := json.Unmarshal(input[istiod], &preview); err != nil {
+			return fmt.Errorf("failed to parse response from %v: %v", istiod, err)
+		}
+		if preview.Error != "" {
+			errs = append(errs, fmt.Sprintf("%v: %v", istiod, preview.Error))
+			continue
+		}
+		for i, resource := range preview.Resources {
+			if i > 0 {
+				_, _ = fmt.Fprintln(out, "---")
+			}
+			_, _ = fmt.Fprint(out, strings.TrimSuffix(resource, "\n")+"\n")
+		}
+		return nil
+	}
+	if len(errs) == 0 {
+		return errors.New("no istiod instance rendered the gateway")
+	}
+	return fmt.Errorf("no istiod instance rendered the gateway:\n%v", strings.Join(errs, "\n"))
+}
@@ -0,0 +1,67 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"bytes"
+	"testing"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestWritePreview(t *testing.T) {
+	notLeader := []byte(`{"error":"not the leader of the gateway deployment controller"}`)
+	cases := []struct {
+		name    string
+		input   map[string][]byte
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "rendered by leader",
+			input: map[string][]byte{
+				"istiod-a": notLeader,
+				"istiod-b": []byte(`{"resources":["kind: Deployment\n","kind: Service"]}`),
+			},
+			want: "kind: Deployment\n---\nkind: Service\n",
+		},
+		{
+			name: "not rendered",
+			input: map[string][]byte{
+				"istiod-a": notLeader,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid response",
+			input: map[string][]byte{
+				"istiod-a": []byte(`not json`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			err := writePreview(out, tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, out.String(), tt.want)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impact
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/istio/istioctl/pkg/cli"
+	"istio.io/istio/istioctl/pkg/clioptions"
+	"istio.io/istio/istioctl/pkg/util/handlers"
+	"istio.io/istio/pilot/pkg/xds"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// kinds are the kinds of the resources which can be analyzed, by their lowercase name.
+var kinds = map[string]string{
+	"service": "Service",
+	"svc":     "Service",
+	"secret":  "Secret",
+	"gateway": "Gateway",
+	"gtw":     "Gateway",
+}
+
+func Cmd(ctx cli.Context) *cobra.Command {
+	var opts clioptions.ControlPlaneOptions
+	cmd := &cobra.Command{
+		Use:   "impact <service|secret|gateway> <name>[.<namespace>]",
+		Short: "Lists the resources and proxies affected by the deletion of a Service, Secret or Gateway",
+		Long: `Lists the Istio and Gateway API resources depending on a Service, Secret or Kubernetes Gateway, and the proxies
+whose configuration depends on it, as tracked by istiod to scope its pushes. These would be affected by the deletion of
+the resource, so it can be decommissioned safely once they are migrated.`,
+		Example: `  # List what depends on the Service "reviews" in the "default" namespace
+  istioctl x impact service reviews.default
+
+  # List what depends on the Secret "ingress-cert" in the "istio-system" namespace
+  istioctl x impact secret ingress-cert.istio-system`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return fmt.Errorf("expected the kind and the name of the resource")
+			}
+			if _, f := kinds[strings.ToLower(args[0])]; !f {
+				return fmt.Errorf("unsupported kind %q, expected service, secret or gateway", args[0])
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kubeClient, err := ctx.CLIClientWithRevision(opts.Revision)
+			if err != nil {
+				return err
+			}
+			name, ns := handlers.InferPodInfo(args[1], ctx.NamespaceOrDefault(ctx.Namespace()))
+			path := fmt.Sprintf("debug/impactz?kind=%s&name=%s&namespace=%s",
+				kinds[strings.ToLower(args[0])], url.QueryEscape(name), url.QueryEscape(ns))
+			res, err := kubeClient.AllDiscoveryDo(context.Background(), ctx.IstioNamespace(), path)
+			if err != nil {
+				return err
+			}
+			return writeImpact(cmd.OutOrStdout(), res)
+		},
+	}
+	opts.AttachControlPlaneFlags(cmd)
+	return cmd
+}
+
+// writeImpact writes the impact analysis merged from all the istiod instances, each reporting its connected proxies.
+func writeImpact(out io.Writer, input map[string][]byte) error {
+	var resource string
+	resources := sets.New[string]()
+	proxies := sets.New[string]()
+	for istiod, b := range input {
+		var analysis xds.ImpactAnalysis
+		if err := json.Unmarshal(b, &analysis); err != nil {
+			return fmt.Errorf("failed to parse response from %v: %v", istiod, err)
+		}
+		resource = analysis.Resource
+		resources.InsertAll(analysis.Resources...)
+		proxies.InsertAll(analysis.Proxies...)
+	}
+	if resource == "" {
+		return fmt.Errorf("no istiod instance analyzed the resource")
+	}
+	if resources.IsEmpty() && proxies.IsEmpty() {
+		_, _ = fmt.Fprintf(out, "Nothing depends on %s.\n", resource)
+		return nil
+	}
+	_, _ = fmt.Fprintf(out, "Deleting %s affects:\n", resource)
+	if !resources.IsEmpty() {
+		_, _ = fmt.Fprintln(out, "Resources:")
+		for _, r := range sets.SortedList(resources) {
+			_, _ = fmt.Fprintf(out, "  %s\n", r)
+		}
+	}
+	if !proxies.IsEmpty() {
+		_, _ = fmt.Fprintln(out, "Proxies:")
+		for _, p := range sets.SortedList(proxies) {
+			_, _ = fmt.Fprintf(out, "  %s\n", p)
+		}
+	}
+	return nil
+}
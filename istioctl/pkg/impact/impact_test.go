@@ -0,0 +1,66 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package impact
+
+import (
+	"bytes"
+	"testing"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestWriteImpact(t *testing.T) {
+	cases := []struct {
+		name    string
+		input   map[string][]byte
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "merged proxies",
+			input: map[string][]byte{
+				"istiod-a": []byte(`{"resource":"Service/default/reviews","resources":["HTTPRoute/default/api"],"proxies":["gw-1.infra"]}`),
+				"istiod-b": []byte(`{"resource":"Service/default/reviews","resources":["HTTPRoute/default/api"],"proxies":["app-1.default"]}`),
+			},
+			want: "Deleting Service/default/reviews affects:\nResources:\n  HTTPRoute/default/api\nProxies:\n  app-1.default\n  gw-1.infra\n",
+		},
+		{
+			name: "no dependents",
+			input: map[string][]byte{
+				"istiod-a": []byte(`{"resource":"Secret/default/unused"}`),
+			},
+			want: "Nothing depends on Secret/default/unused.\n",
+		},
+		{
+			name: "invalid response",
+			input: map[string][]byte{
+				"istiod-a": []byte(`not json`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			err := writeImpact(out, tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, out.String(), tt.want)
+		})
+	}
+}
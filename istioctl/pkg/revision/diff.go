@@ -0,0 +1,343 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/proto"
+
+	"istio.io/istio/istioctl/pkg/cli"
+	"istio.io/istio/istioctl/pkg/tag"
+	istioctlutil "istio.io/istio/istioctl/pkg/util"
+	"istio.io/istio/istioctl/pkg/util/configdump"
+	"istio.io/istio/pilot/pkg/xds"
+	"istio.io/istio/pkg/util/protomarshal"
+)
+
+// ConfigDiff is the difference between the configurations two revisions generate for the same proxy.
+type ConfigDiff struct {
+	Proxy     string       `json:"proxy"`
+	From      string       `json:"from"`
+	To        string       `json:"to"`
+	Clusters  ResourceDiff `json:"clusters"`
+	Listeners ResourceDiff `json:"listeners"`
+	Routes    ResourceDiff `json:"routes"`
+}
+
+// ResourceDiff lists the resources of a type added, removed or changed by the new revision.
+type ResourceDiff struct {
+	Added   []string          `json:"added,omitempty"`
+	Removed []string          `json:"removed,omitempty"`
+	Changed []ChangedResource `json:"changed,omitempty"`
+}
+
+// ChangedResource is a resource generated differently by the two revisions.
+type ChangedResource struct {
+	Name string `json:"name"`
+	// Diff is the unified diff of the JSON of the resource, if requested.
+	Diff string `json:"diff,omitempty"`
+}
+
+// Empty returns whether both revisions generate the same resources.
+func (d ResourceDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+func revisionDiffCommand(ctx cli.Context) *cobra.Command {
+	var from, to string
+	var details bool
+	diffCmd := &cobra.Command{
+		Use:   "diff [<type>/]<name>[.<namespace>] --from <revision> --to <revision>",
+		Short: "Compare the configuration two revisions generate for the same proxy",
+		Long: `Asks the istiod instances of two revisions to generate the configuration of the same proxy, and lists the
+clusters, listeners and routes added, removed or changed by the second revision. This validates a control plane
+upgrade before any proxy is moved to the new revision.
+
+The proxy is described to both revisions by the node it sent to its control plane, so it does not need to be
+connected to either of them. Secrets and endpoints are not compared.`,
+		Example: `  # Compare the configuration the default and canary revisions generate for a pod
+  istioctl x revision diff productpage-v1-6b746f74dc-9stvs.default --from default --to canary
+
+  # Show the changes of each changed resource, for a pod of a deployment
+  istioctl x revision diff deployment/productpage-v1 --from default --to canary --details`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if from == "" || to == "" {
+				return fmt.Errorf("both --from and --to revisions must be specified")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := ctx.CLIClient()
+			if err != nil {
+				return err
+			}
+			podName, ns, err := ctx.InferPodInfoFromTypedResource(args[0], ctx.Namespace())
+			if err != nil {
+				return err
+			}
+			envoyDump, err := client.EnvoyDo(context.TODO(), podName, ns, "GET", "config_dump")
+			if err != nil {
+				return fmt.Errorf("could not contact sidecar: %w", err)
+			}
+			node, err := proxyNode(envoyDump)
+			if err != nil {
+				return err
+			}
+			dumps := make([]*configdump.Wrapper, 0, 2)
+			for _, rev := range []string{from, to} {
+				dump, err := previewConfigDump(ctx, rev, node)
+				if err != nil {
+					return err
+				}
+				dumps = append(dumps, dump)
+			}
+			diff, err := diffConfigDumps(dumps[0], dumps[1], details)
+			if err != nil {
+				return err
+			}
+			diff.Proxy, diff.From, diff.To = podName+"."+ns, from, to
+			return printConfigDiff(cmd.OutOrStdout(), diff, revArgs.output)
+		},
+	}
+	diffCmd.Flags().StringVar(&from, "from", "", "Revision currently generating the configuration of the proxy")
+	diffCmd.Flags().StringVar(&to, "to", "", "Revision to compare the configuration of the proxy with")
+	diffCmd.Flags().BoolVar(&details, "details", false, "Show the unified diff of each changed resource")
+	return diffCmd
+}
+
+// proxyNode returns the node of the proxy encoded for the preview endpoint of istiod, from its Envoy config dump.
+func proxyNode(envoyDump []byte) (string, error) {
+	dump := &configdump.Wrapper{}
+	if err := json.Unmarshal(envoyDump, dump); err != nil {
+		return "", fmt.Errorf("failed to parse the config dump of the proxy: %v", err)
+	}
+	bootstrap, err := dump.GetBootstrapConfigDump()
+	if err != nil {
+		return "", err
+	}
+	if bootstrap.GetBootstrap().GetNode() == nil {
+		return "", fmt.Errorf("the config dump of the proxy has no node")
+	}
+	return xds.EncodePreviewNode(bootstrap.GetBootstrap().GetNode())
+}
+
+// previewConfigDump asks the istiod instances of the revision to generate the configuration of the proxy.
+func previewConfigDump(ctx cli.Context, revision, node string) (*configdump.Wrapper, error) {
+	client, err := ctx.CLIClientWithRevision(revision)
+	if err != nil {
+		return nil, err
+	}
+	res, err := client.AllDiscoveryDo(context.TODO(), ctx.IstioNamespace(), "debug/config_dump_preview?node="+node)
+	if err != nil {
+		return nil, fmt.Errorf("revision %s: %v", revision, err)
+	}
+	// All the instances of a revision generate the same configuration
+	istiods := make([]string, 0, len(res))
+	for istiod := range res {
+		istiods = append(istiods, istiod)
+	}
+	sort.Strings(istiods)
+	for _, istiod := range istiods {
+		dump := &configdump.Wrapper{}
+		if err := json.Unmarshal(res[istiod], dump); err == nil {
+			return dump, nil
+		}
+	}
+	return nil, fmt.Errorf("no istiod instance of revision %s generated the configuration of the proxy", revision)
+}
+
+// diffConfigDumps compares the clusters, listeners and routes of the config dumps, by name.
+func diffConfigDumps(from, to *configdump.Wrapper, details bool) (*ConfigDiff, error) {
+	diff := &ConfigDiff{}
+	for _, d := range []struct {
+		out     *ResourceDiff
+		extract func(*configdump.Wrapper) (map[string]proto.Message, error)
+	}{
+		{&diff.Clusters, dumpClusters},
+		{&diff.Listeners, dumpListeners},
+		{&diff.Routes, dumpRoutes},
+	} {
+		a, err := d.extract(from)
+		if err != nil {
+			return nil, err
+		}
+		b, err := d.extract(to)
+		if err != nil {
+			return nil, err
+		}
+		if *d.out, err = diffResources(a, b, details); err != nil {
+			return nil, err
+		}
+	}
+	return diff, nil
+}
+
+func diffResources(from, to map[string]proto.Message, details bool) (ResourceDiff, error) {
+	var diff ResourceDiff
+	for _, name := range sortedNames(from) {
+		if _, f := to[name]; !f {
+			diff.Removed = append(diff.Removed, name)
+		}
+	}
+	for _, name := range sortedNames(to) {
+		a, f := from[name]
+		if !f {
+			diff.Added = append(diff.Added, name)
+			continue
+		}
+		b := to[name]
+		if proto.Equal(a, b) {
+			continue
+		}
+		changed := ChangedResource{Name: name}
+		if details {
+			text, err := unifiedDiff(name, a, b)
+			if err != nil {
+				return diff, err
+			}
+			changed.Diff = text
+		}
+		diff.Changed = append(diff.Changed, changed)
+	}
+	return diff, nil
+}
+
+func unifiedDiff(name string, from, to proto.Message) (string, error) {
+	a, err := protomarshal.ToJSONWithIndent(from, "  ")
+	if err != nil {
+		return "", err
+	}
+	b, err := protomarshal.ToJSONWithIndent(to, "  ")
+	if err != nil {
+		return "", err
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		FromFile: name + " (from)",
+		A:        difflib.SplitLines(a),
+		ToFile:   name + " (to)",
+		B:        difflib.SplitLines(b),
+		Context:  3,
+	})
+}
+
+func sortedNames(resources map[string]proto.Message) []string {
+	names := make([]string, 0, len(resources))
+	for name := range resources {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func dumpClusters(w *configdump.Wrapper) (map[string]proto.Message, error) {
+	dump, err := w.GetDynamicClusterDump(true)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]proto.Message{}
+	for _, dc := range dump.GetDynamicActiveClusters() {
+		c := &cluster.Cluster{}
+		if err := dc.GetCluster().UnmarshalTo(c); err != nil {
+			return nil, err
+		}
+		out[c.Name] = c
+	}
+	return out, nil
+}
+
+func dumpListeners(w *configdump.Wrapper) (map[string]proto.Message, error) {
+	dump, err := w.GetDynamicListenerDump(true)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]proto.Message{}
+	for _, dl := range dump.GetDynamicListeners() {
+		l := &listener.Listener{}
+		if err := dl.GetActiveState().GetListener().UnmarshalTo(l); err != nil {
+			return nil, err
+		}
+		out[l.Name] = l
+	}
+	return out, nil
+}
+
+func dumpRoutes(w *configdump.Wrapper) (map[string]proto.Message, error) {
+	dump, err := w.GetDynamicRouteDump(true)
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]proto.Message{}
+	for _, drc := range dump.GetDynamicRouteConfigs() {
+		r := &route.RouteConfiguration{}
+		if err := drc.GetRouteConfig().UnmarshalTo(r); err != nil {
+			return nil, err
+		}
+		out[r.Name] = r
+	}
+	return out, nil
+}
+
+func printConfigDiff(w io.Writer, diff *ConfigDiff, format string) error {
+	switch format {
+	case istioctlutil.JSONFormat:
+		return tag.PrintJSON(w, diff)
+	case istioctlutil.TableFormat:
+		printConfigDiffSummary(w, diff)
+		return nil
+	default:
+		return fmt.Errorf("unknown format %s", format)
+	}
+}
+
+func printConfigDiffSummary(w io.Writer, diff *ConfigDiff) {
+	_, _ = fmt.Fprintf(w, "Configuration of %s generated by revision %s compared to revision %s:\n", diff.Proxy, diff.To, diff.From)
+	for _, d := range []struct {
+		kind string
+		diff ResourceDiff
+	}{
+		{"Clusters", diff.Clusters},
+		{"Listeners", diff.Listeners},
+		{"Routes", diff.Routes},
+	} {
+		if d.diff.Empty() {
+			_, _ = fmt.Fprintf(w, "%s Match\n", d.kind)
+			continue
+		}
+		_, _ = fmt.Fprintf(w, "%s: %d added, %d removed, %d changed\n", d.kind, len(d.diff.Added), len(d.diff.Removed), len(d.diff.Changed))
+		for _, name := range d.diff.Added {
+			_, _ = fmt.Fprintf(w, "  + %s\n", name)
+		}
+		for _, name := range d.diff.Removed {
+			_, _ = fmt.Fprintf(w, "  - %s\n", name)
+		}
+		for _, c := range d.diff.Changed {
+			_, _ = fmt.Fprintf(w, "  ~ %s\n", c.Name)
+			if c.Diff != "" {
+				_, _ = fmt.Fprintln(w, c.Diff)
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestDiffResources(t *testing.T) {
+	c := func(name string, timeout time.Duration) proto.Message {
+		return &cluster.Cluster{Name: name, ConnectTimeout: durationpb.New(timeout)}
+	}
+	from := map[string]proto.Message{
+		"outbound|80||reviews": c("outbound|80||reviews", time.Second),
+		"outbound|80||ratings": c("outbound|80||ratings", time.Second),
+		"outbound|80||details": c("outbound|80||details", time.Second),
+	}
+	to := map[string]proto.Message{
+		"outbound|80||reviews": c("outbound|80||reviews", 2*time.Second),
+		"outbound|80||ratings": c("outbound|80||ratings", time.Second),
+		"outbound|80||catalog": c("outbound|80||catalog", time.Second),
+	}
+
+	diff, err := diffResources(from, to, false)
+	assert.NoError(t, err)
+	assert.Equal(t, diff, ResourceDiff{
+		Added:   []string{"outbound|80||catalog"},
+		Removed: []string{"outbound|80||details"},
+		Changed: []ChangedResource{{Name: "outbound|80||reviews"}},
+	})
+
+	diff, err = diffResources(from, to, true)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Contains(diff.Changed[0].Diff, `+  "connectTimeout": "2s"`), true)
+
+	diff, err = diffResources(from, from, true)
+	assert.NoError(t, err)
+	assert.Equal(t, diff.Empty(), true)
+}
+
+func TestPrintConfigDiff(t *testing.T) {
+	diff := &ConfigDiff{
+		Proxy:    "productpage.default",
+		From:     "default",
+		To:       "canary",
+		Clusters: ResourceDiff{Added: []string{"outbound|80||catalog"}, Changed: []ChangedResource{{Name: "outbound|80||reviews"}}},
+	}
+	out := &bytes.Buffer{}
+	assert.NoError(t, printConfigDiff(out, diff, "table"))
+	assert.Equal(t, out.String(), `Configuration of productpage.default generated by revision canary compared to revision default:
+Clusters: 1 added, 0 removed, 1 changed
+  + outbound|80||catalog
+  ~ outbound|80||reviews
+Listeners Match
+Routes Match
+`)
+	assert.Error(t, printConfigDiff(out, diff, "yaml"))
+}
@@ -112,6 +112,7 @@ func Cmd(ctx cli.Context) *cobra.Command {
 
 	revisionCmd.AddCommand(revisionListCommand(ctx))
 	revisionCmd.AddCommand(revisionDescribeCommand(ctx))
+	revisionCmd.AddCommand(revisionDiffCommand(ctx))
 	return revisionCmd
 }
 
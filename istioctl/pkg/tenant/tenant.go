@@ -0,0 +1,99 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/istio/istioctl/pkg/cli"
+	"istio.io/istio/pkg/servicemesh/tenant"
+)
+
+func Cmd(ctx cli.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenant",
+		Short: "Commands to manage the tenants of a multi-tenant mesh",
+	}
+	cmd.AddCommand(onboardCmd(ctx))
+	return cmd
+}
+
+func onboardCmd(ctx cli.Context) *cobra.Command {
+	var (
+		req        tenant.Request
+		memberRoll string
+	)
+	cmd := &cobra.Command{
+		Use:   "onboard <tenant>",
+		Short: "Onboards a tenant of the mesh",
+		Long: `Onboards a tenant of the mesh: labels its namespaces, creating them if needed, adds them to the
+ServiceMeshMemberRoll of the control plane, and creates their default PeerAuthentication, Sidecar and Telemetry, the
+RoleBindings of the tenant admins and an initial Gateway admitting the routes of the namespaces of the tenant.
+The default PeerAuthentication of the namespaces which already existed is PERMISSIVE, unless
+--strict-existing-namespaces is set, as their workloads may still receive plaintext traffic.
+The resources which already exist are left as they are, so the command can be run again to complete an onboarding.`,
+		Example: `  # Onboard the tenant "payments" with two namespaces, administered by the "payments-admins" group
+  istioctl x tenant onboard payments --namespaces payments,payments-batch --admin-group payments-admins \
+    --gateway-class istio`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			kubeClient, err := ctx.CLIClient()
+			if err != nil {
+				return err
+			}
+			onboarder, err := tenant.NewOnboarder(kubeClient, ctx.IstioNamespace(), memberRoll)
+			if err != nil {
+				return err
+			}
+			req.Name = args[0]
+			res, err := onboarder.Onboard(context.Background(), req)
+			writeResult(cmd.OutOrStdout(), res)
+			return err
+		},
+	}
+	cmd.Flags().StringSliceVar(&req.Namespaces, "namespaces", nil,
+		"The namespaces of the tenant. The initial Gateway is created in the first one")
+	cmd.Flags().StringVarP(&req.Revision, "revision", "r", "",
+		"The control plane revision injecting the pods of the tenant, if not the default one")
+	cmd.Flags().StringVar(&req.AdminGroup, "admin-group", "",
+		"The group of the tenant admins, bound to the admin cluster role in the namespaces. No RoleBinding is created if not set")
+	cmd.Flags().StringVar(&req.AdminClusterRole, "admin-cluster-role", tenant.DefaultAdminClusterRole,
+		"The cluster role of the tenant admins in the namespaces")
+	cmd.Flags().StringVar(&req.GatewayClass, "gateway-class", "",
+		"The GatewayClass of the initial Gateway of the tenant. No Gateway is created if not set")
+	cmd.Flags().BoolVar(&req.StrictExistingNamespaces, "strict-existing-namespaces", false,
+		"Enforce STRICT mTLS in the namespaces which existed before the onboarding. Their default PeerAuthentication is PERMISSIVE otherwise")
+	cmd.Flags().StringVar(&memberRoll, "member-roll", "default",
+		"The ServiceMeshMemberRoll of the control plane the namespaces are added to. Set it to an empty string for a cluster-wide control plane")
+	_ = cmd.MarkFlagRequired("namespaces")
+	return cmd
+}
+
+// writeResult writes the resources of the onboarding, including those handled before a failure.
+func writeResult(out io.Writer, res tenant.Result) {
+	for _, r := range res.Created {
+		_, _ = fmt.Fprintf(out, "created: %s\n", r)
+	}
+	for _, r := range res.Updated {
+		_, _ = fmt.Fprintf(out, "updated: %s\n", r)
+	}
+	for _, r := range res.Existing {
+		_, _ = fmt.Fprintf(out, "unchanged: %s\n", r)
+	}
+}
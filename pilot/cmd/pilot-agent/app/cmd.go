@@ -82,6 +82,7 @@ func NewRootCommand() *cobra.Command {
 	rootCmd.AddCommand(proxyCmd)
 	rootCmd.AddCommand(requestCmd)
 	rootCmd.AddCommand(waitCmd)
+	rootCmd.AddCommand(preStopCmd)
 	rootCmd.AddCommand(version.CobraCommand())
 	rootCmd.AddCommand(iptables.GetCommand())
 	rootCmd.AddCommand(cleaniptables.GetCommand())
@@ -0,0 +1,75 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/istio/pkg/log"
+)
+
+var (
+	failHealthChecks    bool
+	deregistrationDelay time.Duration
+	adminURL            string
+
+	preStopCmd = &cobra.Command{
+		Use:   "prestop",
+		Short: "Prepares the termination of a proxy behind external load balancers",
+		Long: "Fails the health checks of Envoy, so external load balancers stop sending new connections to the proxy, " +
+			"then waits for them to deregister it. Envoy is drained when the proxy is terminated, once this command returns. " +
+			"It is meant to be run as the preStop hook of the proxy container.",
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			if failHealthChecks {
+				client := &http.Client{
+					Timeout: 5 * time.Second,
+				}
+				if err := failEnvoyHealthChecks(client, adminURL); err != nil {
+					// Still wait, the load balancers may deregister the proxy once it stops accepting connections
+					log.Warnf("Failed to fail Envoy health checks: %v", err)
+				} else {
+					log.Infof("Envoy health checks failed")
+				}
+			}
+			log.Infof("Waiting %v for load balancers to deregister the proxy", deregistrationDelay)
+			time.Sleep(deregistrationDelay)
+			return nil
+		},
+	}
+)
+
+func failEnvoyHealthChecks(client *http.Client, adminURL string) error {
+	resp, err := client.Post(adminURL+"/healthcheck/fail", "", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP status code %v", resp.StatusCode)
+	}
+	return nil
+}
+
+func init() {
+	preStopCmd.PersistentFlags().BoolVar(&failHealthChecks, "failHealthChecks", true, "fail the health checks of Envoy before waiting")
+	preStopCmd.PersistentFlags().DurationVar(&deregistrationDelay, "deregistrationDelay", 0,
+		"duration to wait for load balancers to deregister the proxy")
+	preStopCmd.PersistentFlags().StringVar(&adminURL, "adminURL", "http://localhost:15000", "URL of the Envoy admin API")
+}
@@ -65,6 +65,7 @@ func NewRootCommand() *cobra.Command {
 		Manual:  "Istio Pilot Discovery",
 	}))
 	rootCmd.AddCommand(requestCmd)
+	rootCmd.AddCommand(replaySnapshotCmd)
 
 	return rootCmd
 }
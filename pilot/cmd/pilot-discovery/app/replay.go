@@ -0,0 +1,46 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"istio.io/istio/pilot/pkg/xds"
+)
+
+var replaySnapshotCmd = &cobra.Command{
+	Use:   "replay-snapshot <snapshot>",
+	Short: "Generates the xDS configuration of the proxy of a config snapshot",
+	Long: "Replays a config snapshot exported by the /debug/config_snapshot endpoint of istiod, without access to the cluster, " +
+		"and prints the listeners, clusters, routes and endpoints generated for its proxy.",
+	Example: `  kubectl port-forward -n istio-system deploy/istiod 15014 &
+  curl -o snapshot.tar.gz 'localhost:15014/debug/config_snapshot?proxyID=productpage-v1-123.default'
+  pilot-discovery replay-snapshot snapshot.tar.gz`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		f, err := os.Open(args[0])
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		snap, err := xds.ReadConfigSnapshot(f)
+		if err != nil {
+			return err
+		}
+		return xds.ReplayConfigSnapshot(c.OutOrStdout(), snap)
+	},
+}
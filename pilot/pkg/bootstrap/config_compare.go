@@ -21,40 +21,56 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/maps"
+	"istio.io/istio/pkg/monitoring"
+	"istio.io/istio/pkg/util/hash"
+)
+
+var (
+	kindTag = monitoring.CreateLabel("kind")
+
+	skippedConfigUpdates = monitoring.NewSum(
+		"pilot_config_updates_skipped",
+		"Total number of config updates not triggering a push, as they do not change the config semantically.",
+	)
 )
 
 // needsPush checks whether the passed in config has same spec and hence push needs
 // to be triggered. This is to avoid unnecessary pushes only when labels have changed
-// for example.
+// for example, or when a client rewrites an identical config.
 func needsPush(prev config.Config, curr config.Config) bool {
 	if prev.GroupVersionKind != curr.GroupVersionKind {
 		// This should never happen.
 		return true
 	}
+	// The Gateway API types are converted as a whole, reading any of their metadata, and their status is written
+	// back from the conversion. So only updates changing none of them, such as an identical rewrite, are skipped.
+	if prev.GroupVersionKind.Group == gvk.KubernetesGateway.Group {
+		return !maps.Equal(prev.Labels, curr.Labels) || !maps.Equal(prev.Annotations, curr.Annotations) ||
+			specChanged(prev.Spec, curr.Spec) || specChanged(prev.Status, curr.Status)
+	}
 	// If the config is not Istio, let us just push.
 	if !strings.HasSuffix(prev.GroupVersionKind.Group, "istio.io") {
 		return true
 	}
-	// If current/previous metadata has "*istio.io" label/annotation, just push
-	for label := range curr.Meta.Labels {
-		if strings.Contains(label, "istio.io") {
-			return true
-		}
+	// If the config asks for it, always push
+	if _, f := curr.Labels[constants.AlwaysPushLabel]; f {
+		return true
 	}
-	for annotation := range curr.Meta.Annotations {
-		if strings.Contains(annotation, "istio.io") {
-			return true
-		}
+	if _, f := curr.Annotations[constants.AlwaysPushLabel]; f {
+		return true
 	}
-	for label := range prev.Meta.Labels {
-		if strings.Contains(label, "istio.io") {
-			return true
-		}
+	if _, f := prev.Labels[constants.AlwaysPushLabel]; f {
+		return true
 	}
-	for annotation := range prev.Meta.Annotations {
-		if strings.Contains(annotation, "istio.io") {
-			return true
-		}
+	if _, f := prev.Annotations[constants.AlwaysPushLabel]; f {
+		return true
+	}
+	// If current/previous metadata has a different "*istio.io" label/annotation, just push
+	if istioMetadataChanged(prev.Labels, curr.Labels) || istioMetadataChanged(prev.Annotations, curr.Annotations) {
+		return true
 	}
 	prevspecProto, okProtoP := prev.Spec.(proto.Message)
 	currspecProto, okProtoC := curr.Spec.(proto.Message)
@@ -68,3 +84,50 @@ func needsPush(prev config.Config, curr config.Config) bool {
 	}
 	return true
 }
+
+// istioMetadataChanged returns true if a "*istio.io" label or annotation was added, removed or changed.
+func istioMetadataChanged(prev, curr map[string]string) bool {
+	for k, v := range curr {
+		if strings.Contains(k, "istio.io") {
+			if pv, f := prev[k]; !f || pv != v {
+				return true
+			}
+		}
+	}
+	for k := range prev {
+		if strings.Contains(k, "istio.io") {
+			if _, f := curr[k]; !f {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// specChanged returns whether a spec or status changed, comparing the hashes of their JSON encodings. Values which
+// cannot be encoded are always considered changed.
+func specChanged(prev, curr any) bool {
+	prevHash, err := specHash(prev)
+	if err != nil {
+		return true
+	}
+	currHash, err := specHash(curr)
+	if err != nil {
+		return true
+	}
+	return prevHash != currHash
+}
+
+// specHash returns the hash of the JSON encoding of a spec or status, which is stable for a given value.
+func specHash(s any) (string, error) {
+	if s == nil {
+		return "nil", nil
+	}
+	b, err := config.ToJSON(s)
+	if err != nil {
+		return "", err
+	}
+	h := hash.New()
+	h.Write(b)
+	return h.Sum(), nil
+}
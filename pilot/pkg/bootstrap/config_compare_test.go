@@ -17,6 +17,8 @@ package bootstrap
 import (
 	"testing"
 
+	k8s "sigs.k8s.io/gateway-api/apis/v1beta1"
+
 	networking "istio.io/api/networking/v1alpha3"
 	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/config/constants"
@@ -192,6 +194,85 @@ func TestNeedsPush(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "istio.io annotation unchanged",
+			prev: config.Config{
+				Meta: config.Meta{
+					GroupVersionKind: gvk.VirtualService,
+					Name:             "acme2-v1",
+					Namespace:        "not-default",
+					Annotations:      map[string]string{"networking.istio.io/exportTo": "."},
+					ResourceVersion:  "1",
+				},
+				Spec: &networking.VirtualService{Hosts: []string{"acme"}},
+			},
+			curr: config.Config{
+				Meta: config.Meta{
+					GroupVersionKind: gvk.VirtualService,
+					Name:             "acme2-v1",
+					Namespace:        "not-default",
+					Annotations:      map[string]string{"networking.istio.io/exportTo": "."},
+					ResourceVersion:  "2",
+				},
+				Spec: &networking.VirtualService{Hosts: []string{"acme"}},
+			},
+			expected: false,
+		},
+		{
+			name: "istio.io annotation changed",
+			prev: config.Config{
+				Meta: config.Meta{
+					GroupVersionKind: gvk.VirtualService,
+					Name:             "acme2-v1",
+					Namespace:        "not-default",
+					Annotations:      map[string]string{"networking.istio.io/exportTo": "."},
+				},
+				Spec: &networking.VirtualService{},
+			},
+			curr: config.Config{
+				Meta: config.Meta{
+					GroupVersionKind: gvk.VirtualService,
+					Name:             "acme2-v1",
+					Namespace:        "not-default",
+					Annotations:      map[string]string{"networking.istio.io/exportTo": "*"},
+				},
+				Spec: &networking.VirtualService{},
+			},
+			expected: true,
+		},
+		{
+			name:     "gateway api identical rewrite",
+			prev:     httpRoute("1", nil, "example.com", nil),
+			curr:     httpRoute("2", nil, "example.com", nil),
+			expected: false,
+		},
+		{
+			name:     "gateway api spec change",
+			prev:     httpRoute("1", nil, "example.com", nil),
+			curr:     httpRoute("2", nil, "example.org", nil),
+			expected: true,
+		},
+		{
+			name:     "gateway api label change",
+			prev:     httpRoute("1", nil, "example.com", nil),
+			curr:     httpRoute("2", map[string]string{"app": "foo"}, "example.com", nil),
+			expected: true,
+		},
+		{
+			name: "gateway api status change",
+			prev: httpRoute("1", nil, "example.com", nil),
+			curr: httpRoute("2", nil, "example.com", &k8s.HTTPRouteStatus{RouteStatus: k8s.RouteStatus{Parents: []k8s.RouteParentStatus{{
+				ParentRef: k8s.ParentReference{Name: "gateway"},
+			}}}}),
+			expected: true,
+		},
+		{
+			// The status cannot be encoded, so the rewrite cannot be told apart from a change
+			name:     "gateway api status encode error",
+			prev:     withStatus(httpRoute("1", nil, "example.com", nil), make(chan int)),
+			curr:     withStatus(httpRoute("2", nil, "example.com", nil), make(chan int)),
+			expected: true,
+		},
 	}
 
 	for _, c := range cases {
@@ -202,3 +283,25 @@ func TestNeedsPush(t *testing.T) {
 		})
 	}
 }
+
+func httpRoute(resourceVersion string, labels map[string]string, hostname string, status *k8s.HTTPRouteStatus) config.Config {
+	c := config.Config{
+		Meta: config.Meta{
+			GroupVersionKind: gvk.HTTPRoute,
+			Name:             "route",
+			Namespace:        "default",
+			Labels:           labels,
+			ResourceVersion:  resourceVersion,
+		},
+		Spec: &k8s.HTTPRouteSpec{Hostnames: []k8s.Hostname{k8s.Hostname(hostname)}},
+	}
+	if status != nil {
+		c.Status = status
+	}
+	return c
+}
+
+func withStatus(c config.Config, status config.Status) config.Config {
+	c.Status = status
+	return c
+}
@@ -15,15 +15,20 @@
 package bootstrap
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
+	"strings"
+	"sync/atomic"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/apimachinery/pkg/types"
 
 	meshconfig "istio.io/api/mesh/v1alpha1"
 	"istio.io/istio/pilot/pkg/autoregistration"
 	configaggregate "istio.io/istio/pilot/pkg/config/aggregate"
+	"istio.io/istio/pilot/pkg/config/freeze"
 	"istio.io/istio/pilot/pkg/config/kube/crdclient"
 	"istio.io/istio/pilot/pkg/config/kube/gateway"
 	ingress "istio.io/istio/pilot/pkg/config/kube/ingress"
@@ -130,6 +135,31 @@ func (s *Server) initConfigController(args *PilotArgs) error {
 
 	// Create the config store.
 	s.environment.ConfigStore = aggregateConfigController
+	if features.EnableConfigFreeze && s.kubeClient != nil {
+		store := freeze.NewStore(aggregateConfigController)
+		s.configFreeze = freeze.NewController(s.kubeClient, store, meshConfig.GetRootNamespace(), s.XDSServer)
+		s.environment.ConfigStore = store
+		s.addStartFunc("config freeze", func(stop <-chan struct{}) error {
+			go s.configFreeze.Run(stop)
+			return nil
+		})
+		if features.EnableStatus {
+			if s.statusManager == nil {
+				s.initStatusManager(args)
+			}
+			s.addTerminatingStartFunc("config freeze status", func(stop <-chan struct{}) error {
+				leaderelection.
+					NewLeaderElection(args.Namespace, args.PodName, leaderelection.ConfigFreezeStatusController, args.Revision, s.kubeClient).
+					AddRunFunction(func(leaderStop <-chan struct{}) {
+						s.configFreeze.SetStatusWrite(true, s.statusManager)
+						<-leaderStop
+						s.configFreeze.SetStatusWrite(false, nil)
+					}).
+					Run(stop)
+				return nil
+			})
+		}
+	}
 
 	// Defer starting the controller until after the service is created.
 	s.addStartFunc("config controller", func(stop <-chan struct{}) error {
@@ -146,6 +176,7 @@ func (s *Server) initK8SConfigStore(args *PilotArgs) error {
 	}
 	configController := s.makeKubeConfigController(args)
 	s.ConfigStores = append(s.ConfigStores, configController)
+	s.XDSServer.ListConfigWatches = configController.ActiveWatches
 
 	if features.EnableGatewayAPI {
 		if s.statusManager == nil && features.EnableGatewayAPIStatus {
@@ -153,8 +184,14 @@ func (s *Server) initK8SConfigStore(args *PilotArgs) error {
 		}
 		gwc := gateway.NewController(s.kubeClient, configController, s.kubeClient.CrdWatcher().WaitForCRD,
 			s.environment.CredentialsController, args.RegistryOptions.KubeOptions)
+		if s.federation != nil {
+			gwc.SetFederatedGatewayDiscovery(s.ServiceController())
+		}
 		s.environment.GatewayAPIController = gwc
 		s.ConfigStores = append(s.ConfigStores, s.environment.GatewayAPIController)
+		s.addReadinessFacet("gatewayapi", func() bool {
+			return gwc.Health().Ready
+		})
 		s.addTerminatingStartFunc("gateway status", func(stop <-chan struct{}) error {
 			leaderelection.
 				NewLeaderElection(args.Namespace, args.PodName, leaderelection.GatewayStatusController, args.Revision, s.kubeClient).
@@ -175,6 +212,15 @@ func (s *Server) initK8SConfigStore(args *PilotArgs) error {
 			return nil
 		})
 		if features.EnableGatewayAPIDeploymentController {
+			// Only the leader runs the deployment controller, so only it can preview the generated resources
+			var deploymentController atomic.Pointer[gateway.DeploymentController]
+			s.XDSServer.PreviewGateway = func(name types.NamespacedName) ([]string, error) {
+				controller := deploymentController.Load()
+				if controller == nil {
+					return nil, errors.New("not the leader of the gateway deployment controller")
+				}
+				return controller.Preview(name)
+			}
 			s.addTerminatingStartFunc("gateway deployment controller", func(stop <-chan struct{}) error {
 				leaderelection.
 					NewPerRevisionLeaderElection(args.Namespace, args.PodName, leaderelection.GatewayDeploymentController, args.Revision, s.kubeClient).
@@ -182,14 +228,16 @@ func (s *Server) initK8SConfigStore(args *PilotArgs) error {
 						// We can only run this if the Gateway CRD is created
 						if s.kubeClient.CrdWatcher().WaitForCRD(gvr.KubernetesGateway, leaderStop) {
 							controller := gateway.NewDeploymentController(s.kubeClient, s.clusterID, s.environment,
-								s.webhookInfo.getWebhookConfig, s.webhookInfo.addHandler, args.Revision)
+								s.webhookInfo.getWebhookConfig, s.webhookInfo.addHandler, args.Revision, gwc.Identity())
 							// Start informers again. This fixes the case where informers for namespace do not start,
 							// as we create them only after acquiring the leader lock
 							// Note: stop here should be the overall pilot stop, NOT the leader election stop. We are
 							// basically lazy loading the informer, if we stop it when we lose the lock we will never
 							// recreate it again.
 							s.kubeClient.RunAndWait(stop)
+							deploymentController.Store(controller)
 							controller.Run(leaderStop)
+							deploymentController.Store(nil)
 						}
 					}).
 					Run(stop)
@@ -212,6 +260,21 @@ func (s *Server) initK8SConfigStore(args *PilotArgs) error {
 			return nil
 		})
 	}
+	if features.RouteIngestionGateway != "" {
+		ns, name, ok := strings.Cut(features.RouteIngestionGateway, "/")
+		if !ok || ns == "" || name == "" {
+			return fmt.Errorf("invalid PILOT_ROUTE_INGESTION_GATEWAY %q, expected namespace/name", features.RouteIngestionGateway)
+		}
+		gw := types.NamespacedName{Namespace: ns, Name: name}
+		s.addTerminatingStartFunc("route ingestion controller", func(stop <-chan struct{}) error {
+			leaderelection.
+				NewLeaderElection(args.Namespace, args.PodName, leaderelection.RouteIngestionController, args.Revision, s.kubeClient).
+				AddRunFunction(func(leaderStop <-chan struct{}) {
+					ior.RunIngestion(ior.NewKubeClient(s.kubeClient), gw, leaderStop)
+				}).Run(stop)
+			return nil
+		})
+	}
 	var err error
 	s.RWConfigStore, err = configaggregate.MakeWriteableCache(s.ConfigStores, configController)
 	if err != nil {
@@ -366,6 +429,9 @@ func (s *Server) makeKubeConfigController(args *PilotArgs) *crdclient.Client {
 	if args.RegistryOptions.KubeOptions.DiscoveryNamespacesFilter != nil {
 		opts.NamespacesFilter = args.RegistryOptions.KubeOptions.DiscoveryNamespacesFilter.Filter
 	}
+	if s.kubeClient.IsMultiTenant() {
+		opts.LazyWatchKinds = features.LazyWatchKinds
+	}
 	return crdclient.New(s.kubeClient, opts)
 }
 
@@ -37,6 +37,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	"istio.io/api/security/v1beta1"
+	"istio.io/istio/pilot/pkg/config/freeze"
 	kubecredentials "istio.io/istio/pilot/pkg/credentials/kube"
 	"istio.io/istio/pilot/pkg/features"
 	istiogrpc "istio.io/istio/pilot/pkg/grpc"
@@ -106,6 +107,7 @@ type Server struct {
 	configController       model.ConfigStoreController
 	ConfigStores           []model.ConfigStoreController
 	serviceEntryController *serviceentry.Controller
+	configFreeze           *freeze.Controller
 
 	httpServer  *http.Server // debug, monitoring and readiness Server.
 	httpAddr    string
@@ -152,6 +154,9 @@ type Server struct {
 	server                  server.Instance
 
 	readinessProbes map[string]readinessProbe
+	// readinessFacets are the readiness probes of subsystems, served on /ready/<facet>. Unlike readinessProbes, they
+	// do not take istiod out of service.
+	readinessFacets map[string]readinessProbe
 	readinessFlags  *readinessFlags
 
 	// duration used for graceful shutdown.
@@ -228,6 +233,7 @@ func NewServer(args *PilotArgs, initFuncs ...func(*Server)) (*Server, error) {
 		httpMux:                 http.NewServeMux(),
 		monitoringMux:           http.NewServeMux(),
 		readinessProbes:         make(map[string]readinessProbe),
+		readinessFacets:         make(map[string]readinessProbe),
 		readinessFlags:          &readinessFlags{},
 		workloadTrustBundle:     tb.NewTrustBundle(nil),
 		server:                  server.New(),
@@ -338,6 +344,7 @@ func NewServer(args *PilotArgs, initFuncs ...func(*Server)) (*Server, error) {
 	s.initRegistryEventHandlers()
 
 	s.initDiscoveryService()
+	s.initWarmStandby(args)
 
 	// Notice that the order of authenticators matters, since at runtime
 	// authenticators are activated sequentially and the first successful attempt
@@ -437,6 +444,34 @@ func (s *Server) Start(stop <-chan struct{}) error {
 
 	// Race condition - if waitForCache is too fast and we run this as a startup function,
 	// the grpc server would be started before CA is registered. Listening should be last.
+	if err := s.serveGRPC(); err != nil {
+		return err
+	}
+
+	if s.httpsServer != nil {
+		httpsListener, err := net.Listen("tcp", s.httpsServer.Addr)
+		if err != nil {
+			return err
+		}
+		go func() {
+			log.Infof("starting webhook service at %s", httpsListener.Addr())
+			if err := s.httpsServer.ServeTLS(httpsListener, "", ""); network.IsUnexpectedListenerError(err) {
+				log.Errorf("error serving https server: %v", err)
+			}
+		}()
+	}
+
+	if s.federation != nil {
+		go s.federation.StartServer(stop)
+	}
+
+	s.waitForShutdown(stop)
+
+	return nil
+}
+
+// serveGRPC starts listening on the gRPC discovery ports.
+func (s *Server) serveGRPC() error {
 	if s.secureGrpcAddress != "" {
 		grpcListener, err := net.Listen("tcp", s.secureGrpcAddress)
 		if err != nil {
@@ -462,26 +497,6 @@ func (s *Server) Start(stop <-chan struct{}) error {
 			}
 		}()
 	}
-
-	if s.httpsServer != nil {
-		httpsListener, err := net.Listen("tcp", s.httpsServer.Addr)
-		if err != nil {
-			return err
-		}
-		go func() {
-			log.Infof("starting webhook service at %s", httpsListener.Addr())
-			if err := s.httpsServer.ServeTLS(httpsListener, "", ""); network.IsUnexpectedListenerError(err) {
-				log.Errorf("error serving https server: %v", err)
-			}
-		}()
-	}
-
-	if s.federation != nil {
-		go s.federation.StartServer(stop)
-	}
-
-	s.waitForShutdown(stop)
-
 	return nil
 }
 
@@ -590,6 +605,21 @@ func (s *Server) istiodReadyHandler(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// istiodReadyFacetHandler serves the readiness of a subsystem of istiod, given by the path /ready/<facet>.
+func (s *Server) istiodReadyFacetHandler(w http.ResponseWriter, req *http.Request) {
+	name := strings.TrimPrefix(req.URL.Path, "/ready/")
+	fn, f := s.readinessFacets[name]
+	if !f {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if !fn() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
 // initServers initializes http and grpc servers
 func (s *Server) initServers(args *PilotArgs) {
 	s.initGrpcServer(args.KeepaliveOptions)
@@ -655,6 +685,7 @@ func (s *Server) initIstiodAdminServer(args *PilotArgs, whc func() map[string]st
 
 	// Readiness Handler.
 	s.httpMux.HandleFunc("/ready", s.istiodReadyHandler)
+	s.httpMux.HandleFunc("/ready/", s.istiodReadyFacetHandler)
 
 	return nil
 }
@@ -817,6 +848,11 @@ func (s *Server) addReadinessProbe(name string, fn readinessProbe) {
 	s.readinessProbes[name] = fn
 }
 
+// adds a readiness facet for a subsystem of Istiod Server.
+func (s *Server) addReadinessFacet(name string, fn readinessProbe) {
+	s.readinessFacets[name] = fn
+}
+
 // addTerminatingStartFunc adds a function that should terminate before the serve shuts down
 // This is useful to do cleanup activities
 // This is does not guarantee they will terminate gracefully - best effort only
@@ -903,6 +939,7 @@ func (s *Server) initRegistryEventHandlers() {
 			// For update events, trigger push only if spec has changed.
 			if event == model.EventUpdate && !needsPush(prev, curr) {
 				log.Debugf("skipping push for %s as spec has not changed", prev.Key())
+				skippedConfigUpdates.With(kindTag.Value(curr.GroupVersionKind.Kind)).Increment()
 				return
 			}
 			pushReq := &model.PushRequest{
@@ -930,6 +967,9 @@ func (s *Server) initRegistryEventHandlers() {
 
 			s.configController.RegisterEventHandler(schema.GroupVersionKind(), configHandler)
 		}
+		if s.federation != nil && features.EnableGatewayAPI {
+			s.federation.RegisterConfigHandlers(s.configController)
+		}
 		if s.environment.GatewayAPIController != nil {
 			s.environment.GatewayAPIController.RegisterEventHandler(gvk.Namespace, func(config.Config, config.Config, model.Event) {
 				s.XDSServer.ConfigUpdate(&model.PushRequest{
@@ -950,6 +990,19 @@ func (s *Server) initRegistryEventHandlers() {
 					Reason: model.NewReasonStats(model.SecretTrigger),
 				})
 			})
+			s.environment.GatewayAPIController.RegisterEventHandler(gvk.ConfigMap, func(_ config.Config, ref config.Config, _ model.Event) {
+				s.XDSServer.ConfigUpdate(&model.PushRequest{
+					Full: true,
+					ConfigsUpdated: map[model.ConfigKey]struct{}{
+						{
+							Kind:      kind.MustFromGVK(ref.GroupVersionKind),
+							Name:      ref.Name,
+							Namespace: ref.Namespace,
+						}: {},
+					},
+					Reason: model.NewReasonStats(model.ConfigUpdate),
+				})
+			})
 		}
 	}
 }
@@ -15,24 +15,53 @@
 package bootstrap
 
 import (
+	"time"
+
 	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pkg/config/audit"
 	"istio.io/istio/pkg/config/schema/collections"
 	"istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/webhooks/validation/controller"
 	"istio.io/istio/pkg/webhooks/validation/server"
 )
 
+const (
+	// configAuditFlushInterval is the interval the config audit events are written to the ConfigMaps at.
+	configAuditFlushInterval = 10 * time.Second
+	// configAuditPruneInterval is the interval the events of the deleted namespaces are forgotten at.
+	configAuditPruneInterval = 10 * time.Minute
+)
+
 func (s *Server) initConfigValidation(args *PilotArgs) error {
 	if s.kubeClient == nil {
 		return nil
 	}
 
 	log.Info("initializing config validator")
+	if features.ConfigAuditBufferSize > 0 {
+		s.XDSServer.ConfigAudit = audit.NewLog(features.ConfigAuditBufferSize)
+		pruner := audit.NewPruner(s.kubeClient, s.XDSServer.ConfigAudit, configAuditPruneInterval)
+		s.addStartFunc("config audit pruner", func(stop <-chan struct{}) error {
+			go pruner.Run(stop)
+			return nil
+		})
+		if features.EnableConfigAuditConfigMap {
+			sink := audit.NewConfigMapSink(s.kubeClient, s.XDSServer.ConfigAudit, args.Namespace, configAuditFlushInterval)
+			s.addStartFunc("config audit sink", func(stop <-chan struct{}) error {
+				go sink.Run(stop)
+				return nil
+			})
+		}
+	}
 	// always start the validation server
 	params := server.Options{
 		Schemas:      collections.PilotGatewayAPI(),
 		DomainSuffix: args.RegistryOptions.KubeOptions.DomainSuffix,
 		Mux:          s.httpsMux,
+		Audit:        s.XDSServer.ConfigAudit,
+	}
+	if s.configFreeze != nil {
+		params.Freeze = s.configFreeze
 	}
 	_, err := server.New(params)
 	if err != nil {
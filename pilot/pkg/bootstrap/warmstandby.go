@@ -0,0 +1,46 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootstrap
+
+import (
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pilot/pkg/leaderelection"
+)
+
+// initWarmStandby holds the xDS serving of the replica until it obtains the warm standby lock of its revision, when
+// warm standby is enabled. Until then, the replica runs its controllers and recomputes its push context on every
+// config change like any other replica, so the takeover only costs the reconnection of the proxies. The standby
+// replica is ready and serves the webhooks and the CA, so rollouts of istiod are not blocked by it; only the xDS
+// connections are refused, and the proxies retry them on another replica.
+func (s *Server) initWarmStandby(args *PilotArgs) {
+	if !features.EnableWarmStandby || s.kubeClient == nil {
+		return
+	}
+	s.XDSServer.SetStandby(true)
+	s.addTerminatingStartFunc("warm standby", func(stop <-chan struct{}) error {
+		leaderelection.
+			NewPerRevisionLeaderElection(args.Namespace, args.PodName, leaderelection.WarmStandbyController, args.Revision, s.kubeClient).
+			AddRunFunction(func(leaderStop <-chan struct{}) {
+				log.Infof("warm standby: lock obtained, serving xDS with push context %s", s.environment.PushContext().PushVersion)
+				s.XDSServer.SetStandby(false)
+				<-leaderStop
+				// Serving xDS from several replicas is always safe, the lock only spares the proxies the connections to
+				// the standby replicas. Stopping here would disconnect all the proxies, so the replica keeps serving.
+				log.Warnf("warm standby: lock lost, still serving xDS")
+			}).
+			Run(stop)
+		return nil
+	})
+}
@@ -0,0 +1,29 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bootstrap
+
+import (
+	"testing"
+
+	"istio.io/istio/pilot/pkg/xds"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestInitWarmStandbyWithoutKubernetes(t *testing.T) {
+	s := &Server{XDSServer: &xds.DiscoveryServer{}}
+	s.initWarmStandby(&PilotArgs{})
+	// Without the lock to wait for, the replica serves right away
+	assert.Equal(t, s.XDSServer.IsStandby(), false)
+}
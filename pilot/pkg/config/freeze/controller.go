@@ -0,0 +1,174 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package freeze defers the config changes made during the freeze windows of a mesh: the changes are accepted, but
+// the proxies keep being served the config as it was at the start of the window, until its end. The windows are set
+// by the WindowsAnnotation of the root namespace of the mesh.
+package freeze
+
+import (
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/status"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/kclient"
+	istiolog "istio.io/istio/pkg/log"
+	"istio.io/istio/pkg/monitoring"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// WindowsAnnotation is the annotation of the root namespace of the mesh listing its freeze windows, see ParseWindows.
+const WindowsAnnotation = "config.istio.io/freeze-windows"
+
+var log = istiolog.RegisterScope("freeze", "config freeze windows")
+
+var configFrozen = monitoring.NewGauge(
+	"pilot_config_frozen",
+	"Whether the config changes are deferred until the end of a freeze window (1) or not (0).",
+)
+
+// Controller freezes and thaws the Store according to the freeze windows of the mesh. The snapshot of the config is
+// not persisted: when istiod starts during a window, the changes made earlier in the window are served. The resources
+// changed during a window are reported with the DeferredCondition.
+type Controller struct {
+	store         *Store
+	rootNamespace string
+	namespaces    kclient.Client[*corev1.Namespace]
+	xdsUpdater    model.XDSUpdater
+	now           func() time.Time
+
+	mu          sync.Mutex
+	windows     Windows
+	frozenUntil time.Time
+	timer       *time.Timer
+	// deferred are the resources changed during the current window
+	deferred         sets.Set[deferredKey]
+	statusController *status.Controller
+}
+
+// NewController returns a Controller freezing the store during the freeze windows of the root namespace.
+func NewController(client kube.Client, store *Store, rootNamespace string, xdsUpdater model.XDSUpdater) *Controller {
+	c := &Controller{
+		store:         store,
+		rootNamespace: rootNamespace,
+		xdsUpdater:    xdsUpdater,
+		now:           time.Now,
+		deferred:      sets.New[deferredKey](),
+	}
+	for _, s := range store.Schemas().All() {
+		if typ := s.GroupVersionKind(); statusKind(typ) {
+			store.RegisterEventHandler(typ, c.configChanged)
+		}
+	}
+	// Only the root namespace is watched, as a multi-tenant istiod cannot watch the other namespaces of the cluster
+	c.namespaces = kclient.NewFiltered[*corev1.Namespace](client, kclient.Filter{FieldSelector: "metadata.name=" + rootNamespace})
+	c.namespaces.AddEventHandler(controllers.ObjectHandler(func(controllers.Object) {
+		c.sync()
+	}))
+	return c
+}
+
+// Run applies the freeze windows once the config is synced, as the snapshot of a freeze is only complete then.
+func (c *Controller) Run(stop <-chan struct{}) {
+	if !kube.WaitForCacheSync("config freeze", stop, c.namespaces.HasSynced, c.store.HasSynced) {
+		return
+	}
+	c.sync()
+	<-stop
+	c.namespaces.ShutdownHandlers()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+}
+
+// DeferredUntil returns the end of the freeze window the changes of the kind are deferred until, if any.
+func (c *Controller) DeferredUntil(group, kind string) (time.Time, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.frozenUntil.IsZero() || !c.store.Frozen(group, kind) {
+		return time.Time{}, false
+	}
+	return c.frozenUntil, true
+}
+
+// sync reads the freeze windows of the root namespace. Invalid windows are ignored, keeping the previous ones, so that
+// a mistake in the annotation does not end a freeze.
+func (c *Controller) sync() {
+	if !c.store.HasSynced() {
+		return
+	}
+	var windows Windows
+	if ns := c.namespaces.Get(c.rootNamespace, ""); ns != nil {
+		if v, f := ns.Annotations[WindowsAnnotation]; f {
+			var err error
+			windows, err = ParseWindows(v)
+			if err != nil {
+				log.Warnf("ignoring invalid %s annotation on namespace %s: %v", WindowsAnnotation, c.rootNamespace, err)
+				return
+			}
+		}
+	}
+	c.mu.Lock()
+	c.windows = windows
+	c.mu.Unlock()
+	c.reconcile()
+}
+
+// reconcile freezes or thaws the store as of now, and schedules the next reconciliation at the next start or end of a
+// window. Thawing the store pushes the deferred changes.
+func (c *Controller) reconcile() {
+	c.mu.Lock()
+	now := c.now()
+	thawed := false
+	if w, f := c.windows.Active(now); f {
+		if c.store.Freeze() {
+			log.Infof("config freeze window %v started, deferring the config changes until %v", w, w.End.Format(time.RFC3339))
+		}
+		c.frozenUntil = w.End
+		configFrozen.Record(1)
+	} else {
+		thawed = c.store.Thaw()
+		c.frozenUntil = time.Time{}
+		configFrozen.Record(0)
+	}
+	var deferred []deferredKey
+	if thawed {
+		deferred = c.deferred.UnsortedList()
+		c.deferred = sets.New[deferredKey]()
+	}
+	if c.timer != nil {
+		c.timer.Stop()
+		c.timer = nil
+	}
+	if next, f := c.windows.NextTransition(now); f {
+		c.timer = time.AfterFunc(next.Sub(now), c.reconcile)
+	}
+	c.mu.Unlock()
+
+	if thawed {
+		log.Infof("config freeze ended, pushing the deferred config changes")
+		c.xdsUpdater.ConfigUpdate(&model.PushRequest{
+			Full:   true,
+			Reason: model.NewReasonStats(model.ConfigFreeze),
+		})
+		c.thawed(deferred)
+	}
+}
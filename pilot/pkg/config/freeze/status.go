@@ -0,0 +1,130 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package freeze
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"istio.io/api/meta/v1alpha1"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/status"
+	"istio.io/istio/pkg/config"
+)
+
+// DeferredCondition is the type of the condition set on the status of the resources changed during a freeze window:
+// it is true until the end of the window, as their changes are not pushed to the proxies before.
+const DeferredCondition = "Deferred"
+
+// deferredKey identifies a resource changed during a freeze window.
+type deferredKey struct {
+	typ       config.GroupVersionKind
+	name      string
+	namespace string
+}
+
+// statusKind returns whether the status of the resources of the kind is written by the Controller. Only the Istio
+// kinds have an IstioStatus, and the services and workloads of the mesh are never deferred.
+func statusKind(typ config.GroupVersionKind) bool {
+	return strings.HasSuffix(typ.Group, "istio.io") && !unfrozenKinds.Contains(typ)
+}
+
+// SetStatusWrite enables writing the DeferredCondition on the resources changed during a freeze window. Only one
+// istiod writes the status.
+func (c *Controller) SetStatusWrite(enabled bool, statusManager *status.Manager) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if enabled && statusManager != nil {
+		c.statusController = statusManager.CreateIstioStatusController(func(s *v1alpha1.IstioStatus, context any) *v1alpha1.IstioStatus {
+			return setCondition(s, context.(*v1alpha1.IstioCondition))
+		})
+	} else {
+		c.statusController = nil
+	}
+}
+
+// configChanged records the resources changed during a freeze window, and reports that their changes are deferred.
+func (c *Controller) configChanged(old, cur config.Config, event model.Event) {
+	if event == model.EventDelete || (event == model.EventUpdate && old.Generation == cur.Generation) {
+		// Deleted resources have no status, and the updates of the status are not changes
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.frozenUntil.IsZero() {
+		return
+	}
+	c.deferred.Insert(deferredKey{typ: cur.GroupVersionKind, name: cur.Name, namespace: cur.Namespace})
+	c.writeStatus(cur, &v1alpha1.IstioCondition{
+		Type:   DeferredCondition,
+		Status: "True",
+		Reason: "FreezeWindow",
+		Message: fmt.Sprintf("The changes are not pushed to the proxies before the end of the config freeze window at %s",
+			c.frozenUntil.Format(time.RFC3339)),
+		LastTransitionTime: timestamppb.Now(),
+	})
+}
+
+// thawed reports that the changes of the resources changed during the freeze window are no longer deferred.
+func (c *Controller) thawed(deferred []deferredKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range deferred {
+		cfg := c.store.ConfigStoreController.Get(key.typ, key.name, key.namespace)
+		if cfg == nil {
+			continue
+		}
+		c.writeStatus(*cfg, &v1alpha1.IstioCondition{
+			Type:               DeferredCondition,
+			Status:             "False",
+			Reason:             "FreezeWindowEnded",
+			Message:            "The changes were pushed to the proxies at the end of the config freeze window",
+			LastTransitionTime: timestamppb.Now(),
+		})
+	}
+}
+
+// writeStatus enqueues the update of the condition of the resource, if this istiod writes the status. Must be called
+// with mu held.
+func (c *Controller) writeStatus(cfg config.Config, condition *v1alpha1.IstioCondition) {
+	if c.statusController == nil {
+		return
+	}
+	c.statusController.EnqueueStatusUpdateResource(condition, status.ResourceFromModelConfig(cfg))
+}
+
+// setCondition returns a copy of the status with the condition set, replacing any condition of the same type.
+func setCondition(current *v1alpha1.IstioStatus, condition *v1alpha1.IstioCondition) *v1alpha1.IstioStatus {
+	if current == nil {
+		current = &v1alpha1.IstioStatus{}
+	}
+	res := current.DeepCopy()
+	condition = condition.DeepCopy()
+	for i, existing := range res.Conditions {
+		if existing.Type == condition.Type {
+			if existing.Status == condition.Status {
+				// Keep the time of the transition
+				condition.LastTransitionTime = existing.LastTransitionTime
+			}
+			res.Conditions[i] = condition
+			return res
+		}
+	}
+	res.Conditions = append(res.Conditions, condition)
+	return res
+}
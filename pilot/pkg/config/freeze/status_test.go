@@ -0,0 +1,72 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package freeze
+
+import (
+	"testing"
+	"time"
+
+	"istio.io/api/meta/v1alpha1"
+	"istio.io/istio/pilot/pkg/config/memory"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/collections"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/pkg/util/sets"
+)
+
+func TestStatusKind(t *testing.T) {
+	assert.Equal(t, statusKind(gvk.VirtualService), true)
+	assert.Equal(t, statusKind(gvk.ServiceEntry), false)
+	assert.Equal(t, statusKind(gvk.HTTPRoute), false)
+}
+
+func TestConfigChanged(t *testing.T) {
+	c := &Controller{
+		store:    NewStore(memory.NewController(memory.MakeSkipValidation(collections.Pilot))),
+		deferred: sets.New[deferredKey](),
+	}
+	vs := config.Config{Meta: config.Meta{GroupVersionKind: gvk.VirtualService, Name: "vs", Namespace: "ns", Generation: 1}}
+	key := deferredKey{typ: gvk.VirtualService, name: "vs", namespace: "ns"}
+
+	// Changes are only deferred during a window
+	c.configChanged(config.Config{}, vs, model.EventAdd)
+	assert.Equal(t, c.deferred.Contains(key), false)
+
+	c.frozenUntil = time.Now().Add(time.Hour)
+	updated := vs.DeepCopy()
+	// Updates of the status are not changes
+	c.configChanged(vs, updated, model.EventUpdate)
+	assert.Equal(t, c.deferred.Contains(key), false)
+	updated.Generation = 2
+	c.configChanged(vs, updated, model.EventUpdate)
+	assert.Equal(t, c.deferred.Contains(key), true)
+}
+
+func TestSetCondition(t *testing.T) {
+	deferred := &v1alpha1.IstioCondition{Type: DeferredCondition, Status: "True", Message: "deferred"}
+	reconciled := &v1alpha1.IstioCondition{Type: "Reconciled", Status: "True"}
+
+	got := setCondition(nil, deferred)
+	assert.Equal(t, got.Conditions, []*v1alpha1.IstioCondition{deferred})
+
+	current := &v1alpha1.IstioStatus{Conditions: []*v1alpha1.IstioCondition{reconciled, deferred}}
+	ended := &v1alpha1.IstioCondition{Type: DeferredCondition, Status: "False", Message: "ended"}
+	got = setCondition(current, ended)
+	assert.Equal(t, got.Conditions, []*v1alpha1.IstioCondition{reconciled, ended})
+	// The current status is not modified
+	assert.Equal(t, current.Conditions[1], deferred)
+}
@@ -0,0 +1,119 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package freeze
+
+import (
+	"sync"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/schema/resource"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// unfrozenKinds are read by the service registry directly from the config controller, so they are not frozen in the
+// store: the services and workloads of the mesh are always kept up to date.
+var unfrozenKinds = sets.New(gvk.ServiceEntry, gvk.WorkloadEntry, gvk.WorkloadGroup)
+
+// Store is a model.ConfigStore serving, while frozen, the config as it was when it was frozen. The changes made in the
+// meantime are accepted by the underlying store, and only served once the store is thawed. The snapshot is shared by
+// all the callers, so copies of it are returned, as callers may sort the configs they list.
+type Store struct {
+	model.ConfigStoreController
+
+	mu       sync.RWMutex
+	snapshot map[config.GroupVersionKind][]config.Config
+}
+
+// NewStore returns a Store serving the config of the given store until frozen.
+func NewStore(store model.ConfigStoreController) *Store {
+	return &Store{ConfigStoreController: store}
+}
+
+// Frozen returns whether the config of the kind, of any version, is frozen.
+func (s *Store) Frozen(group, kind string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for typ := range s.snapshot {
+		if typ.Group == group && typ.Kind == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Freeze takes a snapshot of the config, served until Thaw is called. It returns whether the store was not frozen yet.
+func (s *Store) Freeze() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.snapshot != nil {
+		return false
+	}
+	snapshot := map[config.GroupVersionKind][]config.Config{}
+	s.ConfigStoreController.Schemas().ForEach(func(schema resource.Schema) bool {
+		typ := schema.GroupVersionKind()
+		if !unfrozenKinds.Contains(typ) {
+			snapshot[typ] = s.ConfigStoreController.List(typ, "")
+		}
+		return false
+	})
+	s.snapshot = snapshot
+	return true
+}
+
+// Thaw drops the snapshot, serving the current config again. It returns whether the store was frozen.
+func (s *Store) Thaw() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	frozen := s.snapshot != nil
+	s.snapshot = nil
+	return frozen
+}
+
+func (s *Store) Get(typ config.GroupVersionKind, name, namespace string) *config.Config {
+	s.mu.RLock()
+	configs, f := s.snapshot[typ]
+	s.mu.RUnlock()
+	if !f {
+		return s.ConfigStoreController.Get(typ, name, namespace)
+	}
+	for _, c := range configs {
+		if c.Name == name && c.Namespace == namespace {
+			return &c
+		}
+	}
+	return nil
+}
+
+func (s *Store) List(typ config.GroupVersionKind, namespace string) []config.Config {
+	s.mu.RLock()
+	configs, f := s.snapshot[typ]
+	s.mu.RUnlock()
+	if !f {
+		return s.ConfigStoreController.List(typ, namespace)
+	}
+	if namespace == "" {
+		return slices.Clone(configs)
+	}
+	var out []config.Config
+	for _, c := range configs {
+		if c.Namespace == namespace {
+			out = append(out, c)
+		}
+	}
+	return out
+}
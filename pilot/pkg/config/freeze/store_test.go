@@ -0,0 +1,71 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package freeze
+
+import (
+	"testing"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/config/memory"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/collections"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestStore(t *testing.T) {
+	underlying := memory.NewController(memory.MakeSkipValidation(collections.Pilot))
+	store := NewStore(underlying)
+	create := func(typ config.GroupVersionKind, name string, spec config.Spec) {
+		t.Helper()
+		if _, err := underlying.Create(config.Config{
+			Meta: config.Meta{GroupVersionKind: typ, Name: name, Namespace: "ns"},
+			Spec: spec,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	names := func(typ config.GroupVersionKind, namespace string) []string {
+		var out []string
+		for _, c := range store.List(typ, namespace) {
+			out = append(out, c.Name)
+		}
+		return out
+	}
+
+	create(gvk.VirtualService, "before", &networking.VirtualService{Hosts: []string{"before"}})
+	assert.Equal(t, store.Freeze(), true)
+	assert.Equal(t, store.Freeze(), false)
+	assert.Equal(t, store.Frozen(gvk.VirtualService.Group, gvk.VirtualService.Kind), true)
+	assert.Equal(t, store.Frozen(gvk.ServiceEntry.Group, gvk.ServiceEntry.Kind), false)
+
+	create(gvk.VirtualService, "during", &networking.VirtualService{Hosts: []string{"during"}})
+	create(gvk.ServiceEntry, "during", &networking.ServiceEntry{Hosts: []string{"during.example.com"}})
+	assert.Equal(t, names(gvk.VirtualService, ""), []string{"before"})
+	assert.Equal(t, names(gvk.VirtualService, "ns"), []string{"before"})
+	assert.Equal(t, names(gvk.VirtualService, "other"), nil)
+	assert.Equal(t, store.Get(gvk.VirtualService, "during", "ns") == nil, true)
+	assert.Equal(t, store.Get(gvk.VirtualService, "before", "ns") != nil, true)
+	assert.Equal(t, names(gvk.ServiceEntry, ""), []string{"during"})
+	// The snapshot is not shared with the callers
+	store.List(gvk.VirtualService, "")[0].Name = "modified"
+	store.Get(gvk.VirtualService, "before", "ns").Name = "modified"
+	assert.Equal(t, names(gvk.VirtualService, ""), []string{"before"})
+
+	assert.Equal(t, store.Thaw(), true)
+	assert.Equal(t, store.Thaw(), false)
+	assert.Equal(t, len(store.List(gvk.VirtualService, "")), 2)
+	assert.Equal(t, store.Get(gvk.VirtualService, "during", "ns") != nil, true)
+}
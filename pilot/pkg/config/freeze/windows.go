@@ -0,0 +1,107 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package freeze
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Window is a period during which the config changes are not pushed to the proxies. It starts at Start, included, and
+// ends at End, excluded.
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Contains returns whether t is in the window.
+func (w Window) Contains(t time.Time) bool {
+	return !t.Before(w.Start) && t.Before(w.End)
+}
+
+func (w Window) String() string {
+	return w.Start.Format(time.RFC3339) + "/" + w.End.Format(time.RFC3339)
+}
+
+// Windows are the freeze windows of a mesh, sorted by start.
+type Windows []Window
+
+// ParseWindows parses a comma separated list of RFC 3339 time intervals, for example
+// "2026-12-21T00:00:00Z/2027-01-04T00:00:00Z,2027-03-31T18:00:00+02:00/2027-04-01T08:00:00+02:00". Overlapping windows
+// are merged.
+func ParseWindows(s string) (Windows, error) {
+	var windows Windows
+	for _, interval := range strings.Split(s, ",") {
+		interval = strings.TrimSpace(interval)
+		if interval == "" {
+			continue
+		}
+		start, end, found := strings.Cut(interval, "/")
+		if !found {
+			return nil, fmt.Errorf("invalid freeze window %q, expected <start>/<end>", interval)
+		}
+		w := Window{}
+		var err error
+		if w.Start, err = time.Parse(time.RFC3339, strings.TrimSpace(start)); err != nil {
+			return nil, fmt.Errorf("invalid start of freeze window %q: %v", interval, err)
+		}
+		if w.End, err = time.Parse(time.RFC3339, strings.TrimSpace(end)); err != nil {
+			return nil, fmt.Errorf("invalid end of freeze window %q: %v", interval, err)
+		}
+		if !w.End.After(w.Start) {
+			return nil, fmt.Errorf("invalid freeze window %q, the end must be after the start", interval)
+		}
+		windows = append(windows, w)
+	}
+	sort.Slice(windows, func(i, j int) bool {
+		return windows[i].Start.Before(windows[j].Start)
+	})
+	merged := Windows{}
+	for _, w := range windows {
+		if last := len(merged) - 1; last >= 0 && !w.Start.After(merged[last].End) {
+			if w.End.After(merged[last].End) {
+				merged[last].End = w.End
+			}
+			continue
+		}
+		merged = append(merged, w)
+	}
+	return merged, nil
+}
+
+// Active returns the window containing t, if any.
+func (ws Windows) Active(t time.Time) (Window, bool) {
+	for _, w := range ws {
+		if w.Contains(t) {
+			return w, true
+		}
+	}
+	return Window{}, false
+}
+
+// NextTransition returns the first start or end of a window after t, if any.
+func (ws Windows) NextTransition(t time.Time) (time.Time, bool) {
+	for _, w := range ws {
+		if w.Start.After(t) {
+			return w.Start, true
+		}
+		if w.End.After(t) {
+			return w.End, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,111 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package freeze
+
+import (
+	"testing"
+	"time"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func date(day, hour int) time.Time {
+	return time.Date(2026, 12, day, hour, 0, 0, 0, time.UTC)
+}
+
+func TestParseWindows(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		want    Windows
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			in:   "",
+			want: Windows{},
+		},
+		{
+			name: "sorted",
+			in:   "2026-12-24T00:00:00Z/2026-12-26T00:00:00Z, 2026-12-01T00:00:00Z/2026-12-02T00:00:00Z",
+			want: Windows{{Start: date(1, 0), End: date(2, 0)}, {Start: date(24, 0), End: date(26, 0)}},
+		},
+		{
+			name: "merged",
+			in:   "2026-12-01T00:00:00Z/2026-12-03T00:00:00Z,2026-12-02T00:00:00Z/2026-12-04T00:00:00Z,2026-12-04T00:00:00Z/2026-12-05T00:00:00Z",
+			want: Windows{{Start: date(1, 0), End: date(5, 0)}},
+		},
+		{
+			name: "offset",
+			in:   "2026-12-01T02:00:00+02:00/2026-12-01T12:00:00+02:00",
+			want: Windows{{Start: date(1, 0).In(time.FixedZone("", 2*3600)), End: date(1, 10).In(time.FixedZone("", 2*3600))}},
+		},
+		{
+			name:    "no end",
+			in:      "2026-12-01T00:00:00Z",
+			wantErr: true,
+		},
+		{
+			name:    "invalid time",
+			in:      "2026-12-01/2026-12-02",
+			wantErr: true,
+		},
+		{
+			name:    "end before start",
+			in:      "2026-12-02T00:00:00Z/2026-12-01T00:00:00Z",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseWindows(tt.in)
+			assert.Equal(t, err != nil, tt.wantErr)
+			if tt.wantErr {
+				return
+			}
+			assert.Equal(t, len(got), len(tt.want))
+			for i := range got {
+				if !got[i].Start.Equal(tt.want[i].Start) || !got[i].End.Equal(tt.want[i].End) {
+					t.Fatalf("got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestWindowsTransitions(t *testing.T) {
+	windows := Windows{{Start: date(1, 0), End: date(2, 0)}, {Start: date(24, 0), End: date(26, 0)}}
+	cases := []struct {
+		now     time.Time
+		active  bool
+		next    time.Time
+		hasNext bool
+	}{
+		{now: date(1, 0).Add(-time.Hour), next: date(1, 0), hasNext: true},
+		{now: date(1, 0), active: true, next: date(2, 0), hasNext: true},
+		{now: date(2, 0), next: date(24, 0), hasNext: true},
+		{now: date(25, 0), active: true, next: date(26, 0), hasNext: true},
+		{now: date(26, 0)},
+	}
+	for _, tt := range cases {
+		t.Run(tt.now.String(), func(t *testing.T) {
+			_, active := windows.Active(tt.now)
+			assert.Equal(t, active, tt.active)
+			next, hasNext := windows.NextTransition(tt.now)
+			assert.Equal(t, hasNext, tt.hasNext)
+			assert.Equal(t, next.Equal(tt.next), true)
+		})
+	}
+}
@@ -52,6 +52,7 @@ import (
 	"istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/maps"
 	"istio.io/istio/pkg/queue"
+	"istio.io/istio/pkg/util/sets"
 )
 
 var scope = log.RegisterScope("kube", "Kubernetes client messages")
@@ -86,6 +87,9 @@ type Client struct {
 	// namespacesFilter is only used to initiate filtered informer.
 	namespacesFilter func(obj interface{}) bool
 	filtersByGVK     map[config.GroupVersionKind]kubetypes.Filter
+
+	// lazy manages the kinds that are only watched in the namespaces they are used in. May be nil.
+	lazy *lazyWatches
 }
 
 type Option struct {
@@ -94,6 +98,9 @@ type Option struct {
 	Identifier       string
 	NamespacesFilter func(obj interface{}) bool
 	FiltersByGVK     map[config.GroupVersionKind]kubetypes.Filter
+	// LazyWatchKinds lists the kinds that are only watched in a namespace once an object of the kind is found
+	// there, rather than in all namespaces.
+	LazyWatchKinds sets.String
 }
 
 var _ model.ConfigStoreController = &Client{}
@@ -127,6 +134,12 @@ func NewForSchemas(client kube.Client, opts Option, schemas collection.Schemas)
 		namespacesFilter: opts.NamespacesFilter,
 		filtersByGVK:     opts.FiltersByGVK,
 	}
+	if len(opts.LazyWatchKinds) > 0 {
+		out.lazy = newLazyWatches(out, opts.LazyWatchKinds)
+		if mrc := client.GetMemberRollController(); mrc != nil {
+			mrc.Register(out.lazy, "crd-controller lazy watches")
+		}
+	}
 
 	for _, s := range out.schemas.All() {
 		// From the spec: "Its name MUST be in the format <.spec.name>.<.spec.group>."
@@ -151,6 +164,10 @@ func (cl *Client) Run(stop <-chan struct{}) {
 	t0 := time.Now()
 	cl.logger.Infof("Starting Pilot K8S CRD controller")
 
+	if cl.lazy != nil {
+		go cl.lazy.run(stop)
+	}
+
 	if !kube.WaitForCacheSync("crdclient", stop, cl.informerSynced) {
 		cl.logger.Errorf("Failed to sync Pilot K8S CRD controller cache")
 		return
@@ -181,12 +198,15 @@ func (cl *Client) Schemas() collection.Schemas {
 
 // Get implements store interface
 func (cl *Client) Get(typ config.GroupVersionKind, name, namespace string) *config.Config {
-	h, f := cl.kind(typ)
-	if !f {
+	var obj controllers.Object
+	if h, f := cl.kind(typ); f {
+		obj = h.Get(name, namespace)
+	} else if lk, f := cl.lazyKind(typ); f {
+		obj = lk.get(name, namespace)
+	} else {
 		cl.logger.Warnf("unknown type: %s", typ)
 		return nil
 	}
-	obj := h.Get(name, namespace)
 	if obj == nil {
 		cl.logger.Debugf("couldn't find %s/%s in informer index", namespace, name)
 		return nil
@@ -254,13 +274,15 @@ func (cl *Client) Delete(typ config.GroupVersionKind, name, namespace string, re
 
 // List implements store interface
 func (cl *Client) List(kind config.GroupVersionKind, namespace string) []config.Config {
-	h, f := cl.kind(kind)
-	if !f {
+	var list []controllers.Object
+	if h, f := cl.kind(kind); f {
+		list = h.List(namespace, klabels.Everything())
+	} else if lk, f := cl.lazyKind(kind); f {
+		list = lk.list(namespace)
+	} else {
 		return nil
 	}
 
-	list := h.List(namespace, klabels.Everything())
-
 	out := make([]config.Config, 0, len(list))
 	for _, item := range list {
 		cfg := TranslateObject(item, kind, cl.domainSuffix)
@@ -283,6 +305,16 @@ func (cl *Client) kind(r config.GroupVersionKind) (kclient.Untyped, bool) {
 	return ch, ok
 }
 
+func (cl *Client) lazyKind(r config.GroupVersionKind) (*lazyKind, bool) {
+	if cl.lazy == nil {
+		return nil, false
+	}
+	cl.kindsMu.RLock()
+	defer cl.kindsMu.RUnlock()
+	lk, ok := cl.lazy.kinds[r]
+	return lk, ok
+}
+
 func TranslateObject(r runtime.Object, gvk config.GroupVersionKind, domainSuffix string) config.Config {
 	translateFunc, f := translationMap[gvk]
 	if !f {
@@ -337,7 +369,6 @@ func (cl *Client) addCRD(name string) {
 		return
 	}
 	resourceGVK := s.GroupVersionKind()
-	gvr := s.GroupVersionResource()
 
 	if !features.EnableGatewayAPI && s.Group() == gvk.KubernetesGateway.Group {
 		scope.Infof("Skipping CRD %v as GatewayAPI support is not enabled", s.GroupVersionKind())
@@ -353,6 +384,10 @@ func (cl *Client) addCRD(name string) {
 		cl.logger.Debugf("added resource that already exists: %v", resourceGVK)
 		return
 	}
+	if cl.lazy != nil && cl.lazy.kinds[resourceGVK] != nil {
+		cl.logger.Debugf("added resource that is already lazily watched: %v", resourceGVK)
+		return
+	}
 	filter := cl.filtersByGVK[resourceGVK]
 	objectFilter := filter.ObjectFilter
 	filter.ObjectFilter = func(t any) bool {
@@ -368,6 +403,19 @@ func (cl *Client) addCRD(name string) {
 		}
 		return config.LabelsInRevision(t.(controllers.Object).GetLabels(), cl.revision)
 	}
+	if cl.lazy != nil && cl.lazy.watchesLazily(s) {
+		cl.logger.Infof("Watching CRD %v only in namespaces where it is used", resourceGVK)
+		cl.lazy.add(s, filter)
+		return
+	}
+
+	cl.kinds[resourceGVK] = cl.newInformer(s, filter)
+}
+
+// newInformer creates an informer for the schema, which pushes its events to the queue.
+func (cl *Client) newInformer(s resource.Schema, filter kubetypes.Filter) kclient.Untyped {
+	resourceGVK := s.GroupVersionKind()
+	gvr := s.GroupVersionResource()
 	var kc kclient.Untyped
 	if s.IsBuiltin() {
 		kc = kclient.NewUntypedInformer(cl.client, gvr, filter)
@@ -404,8 +452,7 @@ func (cl *Client) addCRD(name string) {
 			})
 		},
 	})
-
-	cl.kinds[resourceGVK] = kc
+	return kc
 }
 
 func (cl *Client) onEvent(resourceGVK config.GroupVersionKind, old controllers.Object, curr controllers.Object, event model.Event) {
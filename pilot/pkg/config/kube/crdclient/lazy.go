@@ -0,0 +1,298 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crdclient
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/resource"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/kubetypes"
+	"istio.io/istio/pkg/maps"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// WatchDebugInfo describes a kind watched by the client, for debugging.
+type WatchDebugInfo struct {
+	Kind string `json:"kind"`
+	// Lazy is true if the kind is only watched in the namespaces it is used in.
+	Lazy bool `json:"lazy"`
+	// Namespaces lists the namespaces with an active watch of a lazily watched kind.
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// lazyWatches manages the kinds that are only watched in the namespaces they are used in. In multi-tenant
+// istiod, every watched kind requires a watch per member namespace, even if a tenant never uses the kind.
+// Instead, the cluster is periodically probed for lazily watched kinds, and a watch of a member namespace is
+// started once an object of the kind is found there. The watches of a namespace are stopped once it leaves the
+// member roll.
+type lazyWatches struct {
+	cl *Client
+	// names of the kinds to watch lazily
+	names sets.String
+	// kinds is guarded by Client.kindsMu
+	kinds map[config.GroupVersionKind]*lazyKind
+
+	mu         sync.RWMutex
+	namespaces sets.String
+	stop       <-chan struct{}
+
+	// trigger requests an immediate probe, such as when member namespaces are added.
+	trigger chan struct{}
+	// probe returns the namespaces any object of the resource exists in.
+	probe func(gvr schema.GroupVersionResource) (sets.String, error)
+}
+
+type lazyKind struct {
+	schema resource.Schema
+	filter kubetypes.Filter
+
+	mu     sync.RWMutex
+	active map[string]kclient.Untyped
+}
+
+func newLazyWatches(cl *Client, names sets.String) *lazyWatches {
+	lw := &lazyWatches{
+		cl:         cl,
+		names:      names,
+		kinds:      map[config.GroupVersionKind]*lazyKind{},
+		namespaces: sets.New[string](),
+		trigger:    make(chan struct{}, 1),
+	}
+	lw.probe = lw.probeAPIServer
+	return lw
+}
+
+// watchesLazily determines if the schema is only watched in the namespaces it is used in.
+func (lw *lazyWatches) watchesLazily(s resource.Schema) bool {
+	return !s.IsClusterScoped() && lw.names.Contains(s.Kind())
+}
+
+// add registers a lazily watched schema. Must be called with Client.kindsMu held.
+func (lw *lazyWatches) add(s resource.Schema, filter kubetypes.Filter) {
+	lw.kinds[s.GroupVersionKind()] = &lazyKind{
+		schema: s,
+		filter: filter,
+		active: map[string]kclient.Untyped{},
+	}
+	lw.requestProbe()
+}
+
+// SetNamespaces implements MemberRollListener, updating the set of namespaces to probe. The watches of the
+// namespaces that were removed are stopped.
+func (lw *lazyWatches) SetNamespaces(namespaces []string) {
+	lw.mu.Lock()
+	removed := lw.namespaces.Difference(sets.New(namespaces...))
+	lw.namespaces = sets.New(namespaces...)
+	lw.mu.Unlock()
+
+	lw.cl.kindsMu.RLock()
+	kinds := maps.Values(lw.kinds)
+	lw.cl.kindsMu.RUnlock()
+	for _, lk := range kinds {
+		for ns := range removed {
+			lw.deactivate(lk, ns)
+		}
+	}
+	lw.requestProbe()
+}
+
+func (lw *lazyWatches) requestProbe() {
+	select {
+	case lw.trigger <- struct{}{}:
+	default:
+	}
+}
+
+func (lw *lazyWatches) run(stop <-chan struct{}) {
+	lw.mu.Lock()
+	lw.stop = stop
+	lw.mu.Unlock()
+
+	ticker := time.NewTicker(features.LazyWatchProbeInterval)
+	defer ticker.Stop()
+	for {
+		lw.probeAll()
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		case <-lw.trigger:
+		}
+	}
+}
+
+// probeAll starts the watches of all kinds found in a member namespace they are not yet watched in. Each kind is
+// probed with a single request for the whole cluster, and only if it is not yet watched in every member namespace.
+func (lw *lazyWatches) probeAll() {
+	lw.mu.RLock()
+	namespaces := lw.namespaces.Copy()
+	lw.mu.RUnlock()
+	lw.cl.kindsMu.RLock()
+	kinds := maps.Values(lw.kinds)
+	lw.cl.kindsMu.RUnlock()
+
+	for _, lk := range kinds {
+		unwatched := namespaces.Difference(sets.New(lk.activeNamespaces()...))
+		if unwatched.IsEmpty() {
+			continue
+		}
+		found, err := lw.probe(lk.schema.GroupVersionResource())
+		if err != nil {
+			lw.cl.logger.Debugf("failed to probe %v: %v", lk.schema.Kind(), err)
+			continue
+		}
+		for ns := range unwatched.Intersection(found) {
+			lw.activate(lk, ns)
+		}
+	}
+}
+
+func (lw *lazyWatches) probeAPIServer(gvr schema.GroupVersionResource) (sets.String, error) {
+	l, err := lw.cl.client.Metadata().Resource(gvr).Namespace(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	found := sets.New[string]()
+	for _, item := range l.Items {
+		found.Insert(item.Namespace)
+	}
+	return found, nil
+}
+
+// activate starts watching the kind in the namespace.
+func (lw *lazyWatches) activate(lk *lazyKind, namespace string) {
+	lw.mu.RLock()
+	stop := lw.stop
+	lw.mu.RUnlock()
+
+	lk.mu.Lock()
+	defer lk.mu.Unlock()
+	if _, f := lk.active[namespace]; f {
+		return
+	}
+	lw.cl.logger.Infof("starting watch of %v in namespace %s", lk.schema.Kind(), namespace)
+	filter := lk.filter
+	filter.Namespace = namespace
+	kc := lw.cl.newInformer(lk.schema, filter)
+	lk.active[namespace] = kc
+	if stop != nil {
+		kc.Start(stop)
+	}
+}
+
+// deactivate stops watching the kind in the namespace. The objects of the namespace are removed, as if they were
+// deleted. The informer of the namespace is shared through the informer factory, so it is only released by its
+// handlers, and is reused if the namespace is watched again.
+func (lw *lazyWatches) deactivate(lk *lazyKind, namespace string) {
+	lk.mu.Lock()
+	kc, f := lk.active[namespace]
+	delete(lk.active, namespace)
+	lk.mu.Unlock()
+	if !f {
+		return
+	}
+	lw.cl.logger.Infof("stopping watch of %v in namespace %s", lk.schema.Kind(), namespace)
+	kc.ShutdownHandlers()
+	resourceGVK := lk.schema.GroupVersionKind()
+	for _, obj := range kc.List(namespace, klabels.Everything()) {
+		obj := obj
+		lw.cl.queue.Push(func() error {
+			lw.cl.onEvent(resourceGVK, nil, obj, model.EventDelete)
+			return nil
+		})
+	}
+}
+
+func (lw *lazyWatches) debugInfo() []WatchDebugInfo {
+	lw.cl.kindsMu.RLock()
+	kinds := maps.Values(lw.kinds)
+	lw.cl.kindsMu.RUnlock()
+	return slices.Map(kinds, func(lk *lazyKind) WatchDebugInfo {
+		return WatchDebugInfo{
+			Kind:       lk.schema.Kind(),
+			Lazy:       true,
+			Namespaces: lk.activeNamespaces(),
+		}
+	})
+}
+
+func (lk *lazyKind) isActive(namespace string) bool {
+	lk.mu.RLock()
+	defer lk.mu.RUnlock()
+	_, f := lk.active[namespace]
+	return f
+}
+
+func (lk *lazyKind) activeNamespaces() []string {
+	lk.mu.RLock()
+	defer lk.mu.RUnlock()
+	return slices.Sort(maps.Keys(lk.active))
+}
+
+func (lk *lazyKind) get(name, namespace string) controllers.Object {
+	lk.mu.RLock()
+	kc, f := lk.active[namespace]
+	lk.mu.RUnlock()
+	if !f {
+		return nil
+	}
+	return kc.Get(name, namespace)
+}
+
+func (lk *lazyKind) list(namespace string) []controllers.Object {
+	lk.mu.RLock()
+	defer lk.mu.RUnlock()
+	if namespace != metav1.NamespaceAll {
+		kc, f := lk.active[namespace]
+		if !f {
+			return nil
+		}
+		return kc.List(namespace, klabels.Everything())
+	}
+	var out []controllers.Object
+	for ns, kc := range lk.active {
+		out = append(out, kc.List(ns, klabels.Everything())...)
+	}
+	return out
+}
+
+// ActiveWatches returns the kinds watched by the client. For lazily watched kinds, the namespaces with an
+// active watch are included.
+func (cl *Client) ActiveWatches() []WatchDebugInfo {
+	var out []WatchDebugInfo
+	for k := range cl.allKinds() {
+		out = append(out, WatchDebugInfo{Kind: k.Kind})
+	}
+	if cl.lazy != nil {
+		out = append(out, cl.lazy.debugInfo()...)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Kind < out[j].Kind
+	})
+	return out
+}
@@ -0,0 +1,99 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crdclient
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/collection"
+	"istio.io/istio/pkg/config/schema/collections"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/kclient/clienttest"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/pkg/util/sets"
+)
+
+func TestLazyWatches(t *testing.T) {
+	fake := kube.NewFakeClient()
+	schemas := collection.NewSchemasBuilder().MustAdd(collections.Sidecar).MustAdd(collections.VirtualService).Build()
+	for _, s := range schemas.All() {
+		clienttest.MakeCRD(t, fake, s.GroupVersionResource())
+	}
+	store := NewForSchemas(fake, Option{LazyWatchKinds: sets.New(gvk.Sidecar.Kind)}, schemas)
+	store.lazy.probe = func(schema.GroupVersionResource) (sets.String, error) {
+		l, err := fake.Istio().NetworkingV1alpha3().Sidecars(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		found := sets.New[string]()
+		for _, sc := range l.Items {
+			found.Insert(sc.Namespace)
+		}
+		return found, nil
+	}
+	stop := test.NewStop(t)
+	go store.Run(stop)
+	fake.RunAndWait(stop)
+	kube.WaitForCacheSync("test", stop, store.HasSynced)
+
+	createSidecar := func(namespace string) {
+		t.Helper()
+		if _, err := store.Create(config.Config{
+			Meta: config.Meta{GroupVersionKind: gvk.Sidecar, Name: "sidecar", Namespace: namespace},
+			Spec: &v1alpha3.Sidecar{},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sidecars := func() int {
+		return len(store.List(gvk.Sidecar, metav1.NamespaceAll))
+	}
+
+	// Only namespaces using the kind are watched
+	createSidecar("ns1")
+	store.lazy.SetNamespaces([]string{"ns1", "ns2"})
+	assert.EventuallyEqual(t, sidecars, 1)
+	assert.Equal(t, store.Get(gvk.Sidecar, "sidecar", "ns1") != nil, true)
+	assert.Equal(t, store.ActiveWatches(), []WatchDebugInfo{
+		{Kind: gvk.Sidecar.Kind, Lazy: true, Namespaces: []string{"ns1"}},
+		{Kind: gvk.VirtualService.Kind},
+	})
+
+	// First use of the kind in another namespace starts its watch
+	createSidecar("ns2")
+	store.lazy.requestProbe()
+	assert.EventuallyEqual(t, sidecars, 2)
+	assert.Equal(t, store.ActiveWatches()[0].Namespaces, []string{"ns1", "ns2"})
+
+	// Namespaces outside the member roll are not watched
+	createSidecar("ns3")
+	store.lazy.requestProbe()
+	assert.EventuallyEqual(t, sidecars, 2)
+	assert.Equal(t, store.ActiveWatches()[0].Namespaces, []string{"ns1", "ns2"})
+
+	// Watches of namespaces leaving the member roll are stopped, and their objects dropped
+	store.lazy.SetNamespaces([]string{"ns2"})
+	assert.EventuallyEqual(t, sidecars, 1)
+	assert.Equal(t, store.Get(gvk.Sidecar, "sidecar", "ns1") == nil, true)
+	assert.Equal(t, store.ActiveWatches()[0].Namespaces, []string{"ns2"})
+}
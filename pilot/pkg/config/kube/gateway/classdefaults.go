@@ -0,0 +1,249 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+	k8s "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/model/kstatus"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/schema/kind"
+	"istio.io/istio/pkg/config/validation"
+	"istio.io/istio/pkg/util/protomarshal"
+)
+
+const (
+	// gatewayClassDefaultsExtension is the gatewayExtensionLabel value of the defaults of a GatewayClass, referenced
+	// by its parametersRef.
+	gatewayClassDefaultsExtension = "gateway-class-defaults"
+	// The keys of the ConfigMap data holding the defaults, each in the YAML format of the Istio API field.
+	classDefaultsRetriesKey          = "retries"
+	classDefaultsConnectionPoolKey   = "connectionPool"
+	classDefaultsOutlierDetectionKey = "outlierDetection"
+)
+
+// gatewayClassDefaults are the defaults a GatewayClass applies to the routes and clusters of its gateways.
+type gatewayClassDefaults struct {
+	// retries is the retry policy of the routes which do not set one
+	retries *istio.HTTPRetry
+	// trafficPolicy holds the connection pool and outlier detection settings of the clusters whose destination
+	// rule does not set them
+	trafficPolicy *istio.TrafficPolicy
+}
+
+// annotate sets the defaults on the annotations of a generated Istio Gateway.
+func (d *gatewayClassDefaults) annotate(meta map[string]string) {
+	if d == nil {
+		return
+	}
+	if d.retries != nil {
+		if js, err := protomarshal.ToJSON(d.retries); err == nil {
+			meta[model.InternalGatewayRetryPolicyAnnotation] = js
+		}
+	}
+	if d.trafficPolicy != nil {
+		if js, err := protomarshal.ToJSON(d.trafficPolicy); err == nil {
+			meta[model.InternalGatewayTrafficPolicyAnnotation] = js
+		}
+	}
+}
+
+// getGatewayClassDefaults resolves the defaults of the Istio GatewayClasses from the ConfigMaps referenced by their
+// parametersRef. Classes with invalid parameters are reported as not accepted, and their gateways get no defaults.
+// Response is ClassName -> defaults
+func getGatewayClassDefaults(r configContext, classes map[string]k8s.GatewayController) map[string]*gatewayClassDefaults {
+	res := map[string]*gatewayClassDefaults{}
+	for _, obj := range r.GatewayClass {
+		if _, f := classes[obj.Name]; !f {
+			continue
+		}
+		gwc := obj.Spec.(*k8s.GatewayClassSpec)
+		if gwc.ParametersRef == nil {
+			continue
+		}
+		defaults, err := buildGatewayClassDefaults(r, obj.Name, gwc.ParametersRef)
+		if err != nil {
+			obj.Status.(*kstatus.WrappedStatus).Mutate(func(s config.Status) config.Status {
+				gcs := s.(*k8s.GatewayClassStatus)
+				gcs.Conditions = kstatus.UpdateConditionIfChanged(gcs.Conditions, metav1.Condition{
+					Type:               string(k8sv1.GatewayClassConditionStatusAccepted),
+					Status:             kstatus.StatusFalse,
+					ObservedGeneration: obj.Generation,
+					LastTransitionTime: metav1.Now(),
+					Reason:             string(k8sv1.GatewayClassReasonInvalidParameters),
+					Message:            err.Error(),
+				})
+				return gcs
+			})
+			continue
+		}
+		res[obj.Name] = defaults
+	}
+	return res
+}
+
+func buildGatewayClassDefaults(r configContext, className string, ref *k8sv1.ParametersReference) (*gatewayClassDefaults, error) {
+	if string(ref.Group) != gvk.ConfigMap.Group || string(ref.Kind) != gvk.ConfigMap.Kind {
+		return nil, fmt.Errorf("unsupported parametersRef %s/%s, only ConfigMap is allowed", ref.Group, ref.Kind)
+	}
+	if ref.Namespace == nil {
+		return nil, fmt.Errorf("parametersRef ConfigMap %s must set a namespace", ref.Name)
+	}
+	ns := string(*ref.Namespace)
+
+	// Track the reference even if the ConfigMap does not exist yet, so creating it updates the gateways.
+	key := model.ConfigKey{Kind: kind.ConfigMap, Name: ref.Name, Namespace: ns}
+	r.resourceReferences[key] = append(r.resourceReferences[key], model.ConfigKey{
+		Kind: kind.GatewayClass,
+		Name: className,
+	})
+
+	cm := r.ExtensionConfigMaps[types.NamespacedName{Namespace: ns, Name: ref.Name}]
+	if cm == nil {
+		return nil, fmt.Errorf("parametersRef ConfigMap %s/%s not found", ns, ref.Name)
+	}
+	if t := cm.Labels[gatewayExtensionLabel]; t != gatewayClassDefaultsExtension {
+		return nil, fmt.Errorf("unsupported extension type %q for ConfigMap %s/%s", t, ns, ref.Name)
+	}
+	defaults, err := parseGatewayClassDefaults(cm)
+	if err != nil {
+		return nil, fmt.Errorf("invalid defaults in ConfigMap %s/%s: %v", ns, ref.Name, err)
+	}
+	return defaults, nil
+}
+
+// parseGatewayClassDefaults parses and validates the defaults of an extension ConfigMap. The defaults are validated
+// as if they were set on a VirtualService and a DestinationRule, so they are bounded like any other setting.
+func parseGatewayClassDefaults(cm *corev1.ConfigMap) (*gatewayClassDefaults, error) {
+	defaults := &gatewayClassDefaults{}
+	if data, f := cm.Data[classDefaultsRetriesKey]; f {
+		defaults.retries = &istio.HTTPRetry{}
+		if err := protomarshal.ApplyYAMLStrict(data, defaults.retries); err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", classDefaultsRetriesKey, err)
+		}
+		if _, err := validation.ValidateVirtualService(config.Config{
+			Meta: config.Meta{Name: cm.Name, Namespace: cm.Namespace},
+			Spec: &istio.VirtualService{
+				Hosts: []string{"default"},
+				Http: []*istio.HTTPRoute{{
+					Route:   []*istio.HTTPRouteDestination{{Destination: &istio.Destination{Host: "default"}}},
+					Retries: defaults.retries,
+				}},
+			},
+		}); err != nil {
+			return nil, fmt.Errorf("invalid %s: %v", classDefaultsRetriesKey, err)
+		}
+	}
+	connectionPool, hasConnectionPool := cm.Data[classDefaultsConnectionPoolKey]
+	outlierDetection, hasOutlierDetection := cm.Data[classDefaultsOutlierDetectionKey]
+	if hasConnectionPool || hasOutlierDetection {
+		defaults.trafficPolicy = &istio.TrafficPolicy{}
+		if hasConnectionPool {
+			defaults.trafficPolicy.ConnectionPool = &istio.ConnectionPoolSettings{}
+			if err := protomarshal.ApplyYAMLStrict(connectionPool, defaults.trafficPolicy.ConnectionPool); err != nil {
+				return nil, fmt.Errorf("invalid %s: %v", classDefaultsConnectionPoolKey, err)
+			}
+		}
+		if hasOutlierDetection {
+			defaults.trafficPolicy.OutlierDetection = &istio.OutlierDetection{}
+			if err := protomarshal.ApplyYAMLStrict(outlierDetection, defaults.trafficPolicy.OutlierDetection); err != nil {
+				return nil, fmt.Errorf("invalid %s: %v", classDefaultsOutlierDetectionKey, err)
+			}
+		}
+		if _, err := validation.ValidateDestinationRule(config.Config{
+			Meta: config.Meta{Name: cm.Name, Namespace: cm.Namespace},
+			Spec: &istio.DestinationRule{Host: "*", TrafficPolicy: defaults.trafficPolicy},
+		}); err != nil {
+			return nil, err
+		}
+	}
+	// The drain, scheduling, environment, probe, monitoring and PROXY protocol settings are applied to the generated
+	// resources by the deployment controller
+	drain, hasDrain := cm.Data[classDefaultsDrainKey]
+	if hasDrain {
+		if _, err := parseGatewayDrain(drain); err != nil {
+			return nil, err
+		}
+	}
+	scheduling, hasScheduling := cm.Data[classDefaultsSchedulingKey]
+	if hasScheduling {
+		if _, err := parseGatewayScheduling(scheduling); err != nil {
+			return nil, err
+		}
+	}
+	environment, hasEnvironment := cm.Data[classDefaultsEnvironmentKey]
+	if hasEnvironment {
+		if _, err := parseGatewayEnvironment(environment); err != nil {
+			return nil, err
+		}
+	}
+	probes, hasProbes := cm.Data[classDefaultsProbesKey]
+	if hasProbes {
+		if _, err := parseGatewayProbes(probes); err != nil {
+			return nil, err
+		}
+	}
+	monitoring, hasMonitoring := cm.Data[classDefaultsMonitoringKey]
+	if hasMonitoring {
+		if _, err := parseGatewayMonitoring(monitoring); err != nil {
+			return nil, err
+		}
+	}
+	proxyProtocol, hasProxyProtocol := cm.Data[classDefaultsProxyProtocolKey]
+	if hasProxyProtocol {
+		if _, err := parseGatewayProxyProtocol(proxyProtocol); err != nil {
+			return nil, err
+		}
+	}
+	if defaults.retries == nil && defaults.trafficPolicy == nil && !hasDrain && !hasScheduling && !hasEnvironment && !hasProbes && !hasMonitoring &&
+		!hasProxyProtocol {
+		return nil, fmt.Errorf("none of %q, %q, %q, %q, %q, %q, %q, %q or %q is set", classDefaultsRetriesKey, classDefaultsConnectionPoolKey,
+			classDefaultsOutlierDetectionKey, classDefaultsDrainKey, classDefaultsSchedulingKey, classDefaultsEnvironmentKey,
+			classDefaultsProbesKey, classDefaultsMonitoringKey, classDefaultsProxyProtocolKey)
+	}
+	return defaults, nil
+}
+
+// classDefault returns the name of the class of the gateway and the value of one of its defaults, read by the
+// deployment controller from the ConfigMap referenced by the class. The classes are not watched in multi-tenant mode,
+// so they have no defaults there.
+func (d *DeploymentController) classDefault(gw k8s.Gateway, key string) (string, string, bool) {
+	if d.gatewayClasses == nil || d.configMaps == nil {
+		return "", "", false
+	}
+	gc := d.gatewayClasses.Get(string(gw.Spec.GatewayClassName), "")
+	if gc == nil {
+		return "", "", false
+	}
+	ref := gc.Spec.ParametersRef
+	if ref == nil || ref.Namespace == nil || string(ref.Group) != gvk.ConfigMap.Group || string(ref.Kind) != gvk.ConfigMap.Kind {
+		return "", "", false
+	}
+	cm := d.configMaps.Get(ref.Name, string(*ref.Namespace))
+	if cm == nil || cm.Labels[gatewayExtensionLabel] != gatewayClassDefaultsExtension {
+		return "", "", false
+	}
+	data, f := cm.Data[key]
+	return gc.Name, data, f
+}
@@ -0,0 +1,190 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/kind"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func classDefaultsConfigMap(extension string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "defaults",
+			Namespace: "istio-system",
+			Labels:    map[string]string{gatewayExtensionLabel: extension},
+		},
+		Data: data,
+	}
+}
+
+func TestParseGatewayClassDefaults(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    map[string]string
+		want    *gatewayClassDefaults
+		wantErr bool
+	}{
+		{
+			name: "full",
+			data: map[string]string{
+				classDefaultsRetriesKey:          "{attempts: 2, perTryTimeout: 2s, retryOn: 5xx}",
+				classDefaultsConnectionPoolKey:   "{tcp: {maxConnections: 100}, http: {http1MaxPendingRequests: 10}}",
+				classDefaultsOutlierDetectionKey: "{consecutive5xxErrors: 5, interval: 10s, baseEjectionTime: 30s}",
+			},
+			want: &gatewayClassDefaults{
+				retries: &istio.HTTPRetry{Attempts: 2, PerTryTimeout: durationpb.New(2 * time.Second), RetryOn: "5xx"},
+				trafficPolicy: &istio.TrafficPolicy{
+					ConnectionPool: &istio.ConnectionPoolSettings{
+						Tcp:  &istio.ConnectionPoolSettings_TCPSettings{MaxConnections: 100},
+						Http: &istio.ConnectionPoolSettings_HTTPSettings{Http1MaxPendingRequests: 10},
+					},
+					OutlierDetection: &istio.OutlierDetection{
+						Consecutive_5XxErrors: wrapperspb.UInt32(5),
+						Interval:              durationpb.New(10 * time.Second),
+						BaseEjectionTime:      durationpb.New(30 * time.Second),
+					},
+				},
+			},
+		},
+		{
+			name: "retries only",
+			data: map[string]string{classDefaultsRetriesKey: "{attempts: 0}"},
+			want: &gatewayClassDefaults{retries: &istio.HTTPRetry{}},
+		},
+		{
+			name: "drain only",
+			data: map[string]string{classDefaultsDrainKey: "{deregistrationDelay: 15s}"},
+			want: &gatewayClassDefaults{},
+		},
+		{
+			name: "scheduling only",
+			data: map[string]string{classDefaultsSchedulingKey: "{affinity: {}}"},
+			want: &gatewayClassDefaults{},
+		},
+		{
+			name: "environment only",
+			data: map[string]string{classDefaultsEnvironmentKey: "{trustBundles: [{name: pki, configMap: pki}]}"},
+			want: &gatewayClassDefaults{},
+		},
+		{
+			name:    "invalid environment",
+			data:    map[string]string{classDefaultsEnvironmentKey: "{volumes: [{name: host, hostPath: {path: /}}]}"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid scheduling",
+			data:    map[string]string{classDefaultsSchedulingKey: "{topologySpreadConstraints: [{maxSkew: 1}]}"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid drain",
+			data:    map[string]string{classDefaultsDrainKey: "{deregistrationDelay: -1s}"},
+			wantErr: true,
+		},
+		{
+			name:    "empty",
+			data:    map[string]string{},
+			wantErr: true,
+		},
+		{
+			name:    "negative attempts",
+			data:    map[string]string{classDefaultsRetriesKey: "{attempts: -1}"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid outlier detection",
+			data:    map[string]string{classDefaultsOutlierDetectionKey: "{maxEjectionPercent: 200}"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    map[string]string{classDefaultsConnectionPoolKey: "{tcp: {maxConnection: 100}}"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseGatewayClassDefaults(classDefaultsConfigMap(gatewayClassDefaultsExtension, tt.data))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestBuildGatewayClassDefaults(t *testing.T) {
+	ref := &k8sv1.ParametersReference{Group: "", Kind: "ConfigMap", Name: "defaults", Namespace: ptr.Of(k8sv1.Namespace("istio-system"))}
+	newContext := func(cms ...*corev1.ConfigMap) configContext {
+		ctx := configContext{
+			GatewayResources:   GatewayResources{ExtensionConfigMaps: map[types.NamespacedName]*corev1.ConfigMap{}},
+			resourceReferences: map[model.ConfigKey][]model.ConfigKey{},
+		}
+		for _, cm := range cms {
+			ctx.ExtensionConfigMaps[config.NamespacedName(cm)] = cm
+		}
+		return ctx
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		ctx := newContext(classDefaultsConfigMap(gatewayClassDefaultsExtension, map[string]string{classDefaultsRetriesKey: "{attempts: 1}"}))
+		defaults, err := buildGatewayClassDefaults(ctx, "istio", ref)
+		assert.NoError(t, err)
+		assert.Equal(t, defaults.retries.Attempts, int32(1))
+		assert.Equal(t, ctx.resourceReferences, map[model.ConfigKey][]model.ConfigKey{
+			{Kind: kind.ConfigMap, Name: "defaults", Namespace: "istio-system"}: {{Kind: kind.GatewayClass, Name: "istio"}},
+		})
+
+		meta := map[string]string{}
+		defaults.annotate(meta)
+		assert.Equal(t, meta, map[string]string{model.InternalGatewayRetryPolicyAnnotation: `{"attempts":1}`})
+	})
+	t.Run("missing configmap is still tracked", func(t *testing.T) {
+		ctx := newContext()
+		_, err := buildGatewayClassDefaults(ctx, "istio", ref)
+		assert.Error(t, err)
+		assert.Equal(t, len(ctx.resourceReferences), 1)
+	})
+	t.Run("missing namespace", func(t *testing.T) {
+		_, err := buildGatewayClassDefaults(newContext(), "istio", &k8sv1.ParametersReference{Kind: "ConfigMap", Name: "defaults"})
+		assert.Error(t, err)
+	})
+	t.Run("unsupported kind", func(t *testing.T) {
+		_, err := buildGatewayClassDefaults(newContext(), "istio", &k8sv1.ParametersReference{Group: "example.com", Kind: "Defaults", Name: "defaults"})
+		assert.Error(t, err)
+	})
+	t.Run("unsupported extension type", func(t *testing.T) {
+		ctx := newContext(classDefaultsConfigMap(corsExtension, map[string]string{classDefaultsRetriesKey: "{attempts: 1}"}))
+		_, err := buildGatewayClassDefaults(ctx, "istio", ref)
+		assert.Error(t, err)
+	})
+}
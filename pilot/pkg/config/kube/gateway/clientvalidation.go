@@ -0,0 +1,106 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	creds "istio.io/istio/pilot/pkg/model/credentials"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/kind"
+)
+
+const (
+	// gatewayTLSClientValidationKey is the listener TLS option enabling the validation of the client certificates by a
+	// terminating listener. "required" rejects the clients without a valid certificate, while "optional" only rejects
+	// the clients presenting an invalid one.
+	gatewayTLSClientValidationKey = "gateway.istio.io/tls-client-validation"
+	// gatewayTLSClientCASecretKey is the listener TLS option naming the Secret, in the namespace of the Gateway, whose
+	// ca.crt holds the CA bundle the client certificates are validated with, and whose optional ca.crl holds the
+	// revoked certificates. Without it, the CA is read from the Secret of the listener certificate, or from the Secret
+	// named after it with the -cacert suffix. It implies a required validation, unless set otherwise.
+	gatewayTLSClientCASecretKey = "gateway.istio.io/tls-client-ca-secret"
+)
+
+// buildTLSClientValidation applies the client certificate validation options of a terminating listener.
+func buildTLSClientValidation(
+	ctx configContext,
+	out *istio.ServerTLSSettings,
+	options map[k8sv1.AnnotationKey]k8sv1.AnnotationValue,
+	gw config.Config,
+) *ConfigError {
+	v, hasValidation := options[gatewayTLSClientValidationKey]
+	caSecret, hasCA := options[gatewayTLSClientCASecretKey]
+	if !hasValidation && !hasCA {
+		return nil
+	}
+	mode := istio.ServerTLSSettings_MUTUAL
+	switch strings.ToLower(strings.TrimSpace(string(v))) {
+	case "", "required":
+	case "optional":
+		mode = istio.ServerTLSSettings_OPTIONAL_MUTUAL
+	default:
+		return &ConfigError{
+			Reason:  InvalidTLS,
+			Message: fmt.Sprintf("invalid %s %q, expected required or optional", gatewayTLSClientValidationKey, v),
+		}
+	}
+	if out.Mode == istio.ServerTLSSettings_MUTUAL && mode != istio.ServerTLSSettings_MUTUAL {
+		return &ConfigError{
+			Reason:  InvalidTLS,
+			Message: fmt.Sprintf("%s %q conflicts with %s MUTUAL", gatewayTLSClientValidationKey, v, gatewayTLSTerminateModeKey),
+		}
+	}
+	out.Mode = mode
+	if !hasCA {
+		return nil
+	}
+
+	name := string(caSecret)
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return &ConfigError{
+			Reason: InvalidTLS,
+			Message: fmt.Sprintf("invalid %s %q, expected the name of a Secret in the namespace of the Gateway: %s",
+				gatewayTLSClientCASecretKey, name, strings.Join(errs, ", ")),
+		}
+	}
+	secret := model.ConfigKey{
+		Kind:      kind.Secret,
+		Name:      name,
+		Namespace: gw.Namespace,
+	}
+	ctx.resourceReferences[secret] = append(ctx.resourceReferences[secret], model.ConfigKey{
+		Kind:      kind.KubernetesGateway,
+		Namespace: gw.Namespace,
+		Name:      gw.Name,
+	})
+	if ctx.Credentials != nil {
+		if _, err := ctx.Credentials.GetCaCert(secret.Name, secret.Namespace); err != nil {
+			return &ConfigError{
+				Reason:  InvalidTLS,
+				Message: fmt.Sprintf("invalid %s %s, %v", gatewayTLSClientCASecretKey, name, err),
+			}
+		}
+	}
+	// The CA bundle is served as the CA of a credential of its own, see creds.ToCaResourceName
+	out.CaCertificates = creds.ToKubernetesGatewayResource(secret.Namespace, secret.Name) + creds.SdsCaSuffix
+	return nil
+}
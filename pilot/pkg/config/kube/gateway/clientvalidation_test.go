@@ -0,0 +1,113 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/kind"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestBuildTLSClientValidation(t *testing.T) {
+	gw := config.Config{Meta: config.Meta{Name: "gw", Namespace: "tenant"}}
+	cases := []struct {
+		name    string
+		mutual  bool
+		options map[k8sv1.AnnotationKey]k8sv1.AnnotationValue
+		want    *istio.ServerTLSSettings
+		wantErr bool
+	}{
+		{
+			name: "no options",
+			want: &istio.ServerTLSSettings{Mode: istio.ServerTLSSettings_SIMPLE},
+		},
+		{
+			name:    "required",
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{gatewayTLSClientValidationKey: "Required"},
+			want:    &istio.ServerTLSSettings{Mode: istio.ServerTLSSettings_MUTUAL},
+		},
+		{
+			name:    "optional",
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{gatewayTLSClientValidationKey: "optional"},
+			want:    &istio.ServerTLSSettings{Mode: istio.ServerTLSSettings_OPTIONAL_MUTUAL},
+		},
+		{
+			name:    "CA secret",
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{gatewayTLSClientCASecretKey: "client-ca"},
+			want: &istio.ServerTLSSettings{
+				Mode:           istio.ServerTLSSettings_MUTUAL,
+				CaCertificates: "kubernetes-gateway://tenant/client-ca-cacert",
+			},
+		},
+		{
+			name: "optional with CA secret",
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{
+				gatewayTLSClientValidationKey: "optional",
+				gatewayTLSClientCASecretKey:   "client-ca",
+			},
+			want: &istio.ServerTLSSettings{
+				Mode:           istio.ServerTLSSettings_OPTIONAL_MUTUAL,
+				CaCertificates: "kubernetes-gateway://tenant/client-ca-cacert",
+			},
+		},
+		{
+			name:    "required with terminate mode MUTUAL",
+			mutual:  true,
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{gatewayTLSClientValidationKey: "required"},
+			want:    &istio.ServerTLSSettings{Mode: istio.ServerTLSSettings_MUTUAL},
+		},
+		{
+			name:    "optional with terminate mode MUTUAL",
+			mutual:  true,
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{gatewayTLSClientValidationKey: "optional"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown validation",
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{gatewayTLSClientValidationKey: "always"},
+			wantErr: true,
+		},
+		{
+			name:    "CA secret in another namespace",
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{gatewayTLSClientCASecretKey: "other/client-ca"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := configContext{resourceReferences: map[model.ConfigKey][]model.ConfigKey{}}
+			out := &istio.ServerTLSSettings{Mode: istio.ServerTLSSettings_SIMPLE}
+			if tt.mutual {
+				out.Mode = istio.ServerTLSSettings_MUTUAL
+			}
+			err := buildTLSClientValidation(ctx, out, tt.options, gw)
+			assert.Equal(t, err != nil, tt.wantErr)
+			if tt.wantErr {
+				return
+			}
+			assert.Equal(t, out, tt.want)
+			if _, f := tt.options[gatewayTLSClientCASecretKey]; f {
+				secret := model.ConfigKey{Kind: kind.Secret, Name: "client-ca", Namespace: "tenant"}
+				assert.Equal(t, ctx.resourceReferences[secret], []model.ConfigKey{{Kind: kind.KubernetesGateway, Name: "gw", Namespace: "tenant"}})
+			}
+		})
+	}
+}
@@ -41,16 +41,29 @@ type RouteParentResult struct {
 	RouteError *ConfigError
 }
 
-func createRouteStatus(parentResults []RouteParentResult, obj config.Config, currentParents []k8s.RouteParentStatus) []k8s.RouteParentStatus {
+func createRouteStatus(
+	id *controllerIdentity,
+	parentResults []RouteParentResult,
+	obj config.Config,
+	currentParents []k8s.RouteParentStatus,
+) []k8s.RouteParentStatus {
 	parents := make([]k8s.RouteParentStatus, 0, len(parentResults))
 	// Fill in all the gateways that are already present but not owned by us. This is non-trivial as there may be multiple
 	// gateway controllers that are exposing their status on the same route. We need to attempt to manage ours properly (including
 	// removing gateway references when they are removed), without mangling other Controller's status.
 	for _, r := range currentParents {
-		if r.ControllerName != controllerName {
-			// We don't own this status, so keep it around
-			parents = append(parents, r)
+		if r.ControllerName == id.controllerName {
+			continue
+		}
+		if id.previousControllerNames.Contains(string(r.ControllerName)) {
+			// We owned this status under a previous controller name. It is stale, so drop it; if the parent is
+			// still ours, it is added back below under the current name, keeping its conditions.
+			log.Debugf("removing stale status of %v/%v for parent %v written by %v",
+				obj.Namespace, obj.Name, parentRefString(r.ParentRef), r.ControllerName)
+			continue
 		}
+		// We don't own this status, so keep it around
+		parents = append(parents, r)
 	}
 	// Collect all of our unique parent references. There may be multiple when we have a route without section name,
 	// but reference a parent with multiple sections.
@@ -58,7 +71,15 @@ func createRouteStatus(parentResults []RouteParentResult, obj config.Config, cur
 	seen := map[k8s.ParentReference][]RouteParentResult{}
 	seenReasons := sets.New[ParentErrorReason]()
 	successCount := map[k8s.ParentReference]int{}
+	// The deleted parents, and the parents whose limits the route exceeds, are always reported, whatever the reason of
+	// the other parents, so they are not ranked
+	unranked := map[k8s.ParentReference]RouteParentResult{}
 	for _, incoming := range parentResults {
+		if incoming.DeniedReason != nil &&
+			(incoming.DeniedReason.Reason == ParentErrorParentNotFound || incoming.DeniedReason.Reason == ParentErrorConfigLimitExceeded) {
+			unranked[incoming.OriginalReference] = incoming
+			continue
+		}
 		// We will append it if it is our first occurrence, or the existing one has an error. This means
 		// if *any* section has no errors, we will declare Admitted
 		if incoming.DeniedReason == nil {
@@ -74,7 +95,10 @@ func createRouteStatus(parentResults []RouteParentResult, obj config.Config, cur
 	reasonRanking := []ParentErrorReason{
 		// No errors is preferred
 		ParentNoError,
+		// Bound, but not served yet
+		ParentErrorPending,
 		// All route level errors
+		ParentErrorInvalidHostname,
 		ParentErrorNotAllowed,
 		ParentErrorNoHostname,
 		ParentErrorParentRefConflict,
@@ -118,6 +142,11 @@ func createRouteStatus(parentResults []RouteParentResult, obj config.Config, cur
 		// Once we find the best reason, do not consider any others
 		break
 	}
+	for k, result := range unranked {
+		if _, f := report[k]; !f {
+			report[k] = result
+		}
+	}
 
 	// Now we fill in all the parents we do own
 	for k, gw := range report {
@@ -152,14 +181,22 @@ func createRouteStatus(parentResults []RouteParentResult, obj config.Config, cur
 
 		var currentConditions []metav1.Condition
 		currentStatus := slices.FindFunc(currentParents, func(s k8sv1.RouteParentStatus) bool {
-			return parentRefString(s.ParentRef) == parentRefString(gw.OriginalReference)
+			return parentRefString(s.ParentRef) == parentRefString(gw.OriginalReference) &&
+				s.ControllerName == id.controllerName
 		})
+		if currentStatus == nil {
+			// Carry over the conditions of a status written under a previous controller name
+			currentStatus = slices.FindFunc(currentParents, func(s k8sv1.RouteParentStatus) bool {
+				return parentRefString(s.ParentRef) == parentRefString(gw.OriginalReference) &&
+					id.previousControllerNames.Contains(string(s.ControllerName))
+			})
+		}
 		if currentStatus != nil {
 			currentConditions = currentStatus.Conditions
 		}
 		parents = append(parents, k8s.RouteParentStatus{
 			ParentRef:      gw.OriginalReference,
-			ControllerName: controllerName,
+			ControllerName: id.controllerName,
 			Conditions:     setConditions(obj.Generation, currentConditions, conds),
 		})
 	}
@@ -178,7 +215,14 @@ const (
 	ParentErrorNotAllowed        = ParentErrorReason(k8s.RouteReasonNotAllowedByListeners)
 	ParentErrorNoHostname        = ParentErrorReason(k8s.RouteReasonNoMatchingListenerHostname)
 	ParentErrorParentRefConflict = ParentErrorReason("ParentRefConflict")
-	ParentNoError                = ParentErrorReason("")
+	ParentErrorInvalidHostname   = ParentErrorReason("InvalidHostname")
+	// ParentErrorParentNotFound is reported for the parents a route was attached to, which were deleted
+	ParentErrorParentNotFound = ParentErrorReason("ParentNotFound")
+	// ParentErrorConfigLimitExceeded is reported for the parents a route exceeds the configuration limits of
+	ParentErrorConfigLimitExceeded = ParentErrorReason("ConfigLimitExceeded")
+	// ParentErrorPending is reported for the parents which are valid, but do not serve the route yet
+	ParentErrorPending = ParentErrorReason(k8s.RouteReasonPending)
+	ParentNoError      = ParentErrorReason("")
 )
 
 type ConfigErrorReason = string
@@ -195,10 +239,30 @@ const (
 	InvalidDestinationKind ConfigErrorReason = ConfigErrorReason(k8s.RouteReasonInvalidKind)
 	// InvalidDestinationNotFound indicates a destination does not exist
 	InvalidDestinationNotFound ConfigErrorReason = ConfigErrorReason(k8s.RouteReasonBackendNotFound)
+	// InvalidDestinationProtocol indicates the protocol of a destination port is not supported by the route
+	InvalidDestinationProtocol ConfigErrorReason = "UnsupportedProtocol"
 	// InvalidParentRef indicates we could not refer to the parent we request
 	InvalidParentRef ConfigErrorReason = "InvalidParentReference"
 	// InvalidFilter indicates an issue with the filters
 	InvalidFilter ConfigErrorReason = "InvalidFilter"
+	// InvalidHostname indicates a listener hostname is invalid
+	InvalidHostname ConfigErrorReason = "InvalidHostname"
+	// QuotaExceeded indicates a managed Gateway exceeds the quota of its namespace or the mesh
+	QuotaExceeded ConfigErrorReason = "QuotaExceeded"
+	// InvalidIPAccessPolicy indicates the IP access policy of a listener is invalid
+	InvalidIPAccessPolicy ConfigErrorReason = "InvalidIPAccessPolicy"
+	// ConflictingListenerPolicy indicates a listener shares its filter chain with a listener having different policies
+	ConflictingListenerPolicy ConfigErrorReason = "ConflictingListenerPolicy"
+	// InvalidForwardedHeaders indicates the forwarded headers annotations of a Gateway are invalid
+	InvalidForwardedHeaders ConfigErrorReason = "InvalidForwardedHeaders"
+	// InvalidTargetPorts indicates the listener target ports annotation of a Gateway is invalid
+	InvalidTargetPorts ConfigErrorReason = "InvalidTargetPorts"
+	// InvalidAuthentication indicates the authentication annotations of a route are invalid
+	InvalidAuthentication ConfigErrorReason = "InvalidAuthentication"
+	// InvalidMirrorComparison indicates the mirror comparison annotation of a route is invalid
+	InvalidMirrorComparison ConfigErrorReason = "InvalidMirrorComparison"
+	// InvalidUnmatchedMethodResponse indicates the unmatched method response annotation of a Gateway is invalid
+	InvalidUnmatchedMethodResponse ConfigErrorReason = "InvalidUnmatchedMethodResponse"
 	// InvalidTLS indicates an issue with TLS settings
 	InvalidTLS ConfigErrorReason = ConfigErrorReason(k8sv1.ListenerReasonInvalidCertificateRef)
 	// InvalidListenerRefNotPermitted indicates a listener reference was not permitted
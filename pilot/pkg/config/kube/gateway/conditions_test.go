@@ -17,12 +17,15 @@ package gateway
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8s "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/pkg/util/sets"
 )
 
 func TestCreateRouteStatus(t *testing.T) {
@@ -31,7 +34,7 @@ func TestCreateRouteStatus(t *testing.T) {
 	parentStatus := []k8s.RouteParentStatus{
 		{
 			ParentRef:      parentRef,
-			ControllerName: controllerName,
+			ControllerName: controllerName(),
 			Conditions: []metav1.Condition{
 				{
 					Type:               string(k8s.RouteReasonAccepted),
@@ -99,7 +102,7 @@ func TestCreateRouteStatus(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := createRouteStatus(tt.args.gateways, tt.args.obj, tt.args.current)
+			got := createRouteStatus(envIdentity(), tt.args.gateways, tt.args.obj, tt.args.current)
 			equal := reflect.DeepEqual(got, tt.args.current)
 			if equal != tt.wantEqual {
 				t.Errorf("route status: old: %+v, new: %+v", tt.args.current, got)
@@ -107,3 +110,50 @@ func TestCreateRouteStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateRouteStatusPreviousControllerName(t *testing.T) {
+	id := newControllerIdentity(defaultClassName(), controllerName(), sets.New("example.com/previous-controller"))
+	lastTransitionTime := metav1.NewTime(metav1.Now().Add(-time.Hour))
+	parentRef := httpRouteSpec.ParentRefs[0]
+	removedRef := k8s.ParentReference{Name: "removed"}
+	otherRef := k8s.ParentReference{Name: "other"}
+	conditions := []metav1.Condition{
+		{
+			Type:               string(k8s.RouteConditionAccepted),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+			LastTransitionTime: lastTransitionTime,
+			Reason:             string(k8s.RouteReasonAccepted),
+			Message:            "Route was valid",
+		},
+		{
+			Type:               string(k8s.RouteConditionResolvedRefs),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+			LastTransitionTime: lastTransitionTime,
+			Reason:             string(k8s.RouteReasonResolvedRefs),
+			Message:            "All references resolved",
+		},
+	}
+	current := []k8s.RouteParentStatus{
+		{ParentRef: parentRef, ControllerName: "example.com/previous-controller", Conditions: conditions},
+		{ParentRef: removedRef, ControllerName: "example.com/previous-controller", Conditions: conditions},
+		{ParentRef: otherRef, ControllerName: "example.com/other-controller", Conditions: conditions},
+	}
+	httpRoute := config.Config{
+		Meta: config.Meta{
+			GroupVersionKind: gvk.HTTPRoute,
+			Namespace:        "foo",
+			Name:             "bar",
+			Generation:       1,
+		},
+		Spec: &httpRouteSpec,
+	}
+
+	got := createRouteStatus(id, []RouteParentResult{{OriginalReference: parentRef}}, httpRoute, current)
+	// The stale status of the previous controller name is re-stamped, or removed if the parent is no longer ours
+	assert.Equal(t, got, []k8s.RouteParentStatus{
+		{ParentRef: otherRef, ControllerName: "example.com/other-controller", Conditions: conditions},
+		{ParentRef: parentRef, ControllerName: controllerName(), Conditions: conditions},
+	})
+}
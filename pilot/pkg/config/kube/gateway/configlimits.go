@@ -0,0 +1,209 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/util/sets"
+)
+
+const (
+	// maxRoutesPerGatewayAnnotation overrides PILOT_MAX_HTTPROUTES_PER_GATEWAY for the Gateways of a namespace.
+	maxRoutesPerGatewayAnnotation = "gateway.istio.io/max-routes-per-gateway"
+	// maxVirtualHostsPerGatewayAnnotation overrides PILOT_MAX_VIRTUAL_HOSTS_PER_GATEWAY for the Gateways of a namespace.
+	maxVirtualHostsPerGatewayAnnotation = "gateway.istio.io/max-virtual-hosts-per-gateway"
+	// maxMatchesPerRuleAnnotation overrides PILOT_MAX_MATCHES_PER_HTTPROUTE_RULE for the routes of a namespace.
+	maxMatchesPerRuleAnnotation = "gateway.istio.io/max-matches-per-rule"
+)
+
+// gatewayConfigUsage tracks the HTTPRoutes and GRPCRoutes accepted by a Gateway, and their hostnames, against the
+// limits of the Gateway. A limit of 0 means no limit.
+type gatewayConfigUsage struct {
+	maxRoutes       int
+	maxVirtualHosts int
+	// routes are the keys of the accepted routes, which include their kind
+	routes       sets.String
+	virtualHosts sets.String
+}
+
+func (u *gatewayConfigUsage) unlimited() bool {
+	return u.maxRoutes <= 0 && u.maxVirtualHosts <= 0
+}
+
+// admit accepts a route with its hostnames on the Gateway, or returns the limit it exceeds. The routes are admitted
+// in order of creation, so that the accepted routes are never displaced by newer ones. The GRPCRoutes are admitted
+// after the HTTPRoutes.
+func (u *gatewayConfigUsage) admit(route string, hosts sets.String) string {
+	if u.unlimited() {
+		return ""
+	}
+	if exceeds(u.maxRoutes, u.routes.Len()) {
+		return fmt.Sprintf("configuration limit exceeded: the Gateway is limited to %d routes", u.maxRoutes)
+	}
+	if u.maxVirtualHosts > 0 {
+		added := hosts.Difference(u.virtualHosts).Len()
+		if u.virtualHosts.Len()+added > u.maxVirtualHosts {
+			return fmt.Sprintf("configuration limit exceeded: the Gateway is limited to %d hostnames, the route adds %d to the %d in use",
+				u.maxVirtualHosts, added, u.virtualHosts.Len())
+		}
+	}
+	u.routes.Insert(route)
+	u.virtualHosts.Merge(hosts)
+	return ""
+}
+
+// gatewayUsage returns the usage of a Gateway, with the limits of its namespace.
+func (ctx configContext) gatewayUsage(gw types.NamespacedName) *gatewayConfigUsage {
+	if u, f := ctx.gatewayConfigUsage[gw]; f {
+		return u
+	}
+	ns := ctx.Namespaces[gw.Namespace]
+	u := &gatewayConfigUsage{
+		maxRoutes:       namespaceLimit(ns, maxRoutesPerGatewayAnnotation, features.MaxHTTPRoutesPerGateway),
+		maxVirtualHosts: namespaceLimit(ns, maxVirtualHostsPerGatewayAnnotation, features.MaxVirtualHostsPerGateway),
+		routes:          sets.New[string](),
+		virtualHosts:    sets.New[string](),
+	}
+	if !u.unlimited() {
+		ctx.gatewayConfigUsage[gw] = u
+	}
+	return u
+}
+
+// namespaceLimit returns a limit, overridden by an annotation of the namespace. The namespace may be nil, if it is
+// not known.
+func namespaceLimit(ns *corev1.Namespace, annotation string, def int) int {
+	if ns == nil {
+		return def
+	}
+	return quotaOverride(ns, annotation, def)
+}
+
+// applyHTTPRouteLimits denies the parents of an HTTPRoute exceeding the configuration limits.
+func applyHTTPRouteLimits(ctx configContext, obj config.Config, parents []routeParentReference) {
+	route := obj.Spec.(*k8s.HTTPRouteSpec)
+	ruleMatches := make([]int, 0, len(route.Rules))
+	for _, rule := range route.Rules {
+		ruleMatches = append(ruleMatches, len(rule.Matches))
+	}
+	applyRouteLimits(ctx, obj, route.Hostnames, ruleMatches, parents)
+}
+
+// applyGRPCRouteLimits denies the parents of a GRPCRoute exceeding the configuration limits, which are shared with
+// the HTTPRoutes.
+func applyGRPCRouteLimits(ctx configContext, obj config.Config, parents []routeParentReference) {
+	route := obj.Spec.(*k8s.GRPCRouteSpec)
+	ruleMatches := make([]int, 0, len(route.Rules))
+	for _, rule := range route.Rules {
+		ruleMatches = append(ruleMatches, len(rule.Matches))
+	}
+	applyRouteLimits(ctx, obj, route.Hostnames, ruleMatches, parents)
+}
+
+// applyRouteLimits denies the parents of a route exceeding the configuration limits, which bound the size of the
+// configuration generated for the shared gateways and proxies. A route with too many matches in a rule is not
+// accepted by any parent, and a route exceeding the limits of a Gateway is not accepted by that Gateway. ruleMatches
+// holds the number of matches of each rule of the route.
+func applyRouteLimits(ctx configContext, obj config.Config, hostnames []k8s.Hostname, ruleMatches []int, parents []routeParentReference) {
+	maxMatches := namespaceLimit(ctx.Namespaces[obj.Namespace], maxMatchesPerRuleAnnotation, features.MaxMatchesPerHTTPRouteRule)
+	for i, matches := range ruleMatches {
+		if exceeds(maxMatches, matches-1) {
+			msg := fmt.Sprintf("configuration limit exceeded: rule %d has %d matches, the rules are limited to %d matches",
+				i, matches, maxMatches)
+			for j := range parents {
+				denyParentForLimit(ctx, obj.Namespace, &parents[j], msg)
+			}
+			return
+		}
+	}
+
+	// The route is admitted once per Gateway, with its hostnames on all the listeners it binds to
+	hosts := map[types.NamespacedName]sets.String{}
+	var gateways []types.NamespacedName
+	for _, p := range parents {
+		gw, ok := limitedGateway(p, obj.Namespace)
+		if !ok {
+			continue
+		}
+		if _, f := hosts[gw]; !f {
+			hosts[gw] = sets.New[string]()
+			gateways = append(gateways, gw)
+		}
+		hosts[gw].InsertAll(routeVirtualHosts(hostnames, p)...)
+	}
+	denied := map[types.NamespacedName]string{}
+	for _, gw := range gateways {
+		if msg := ctx.gatewayUsage(gw).admit(obj.Key(), hosts[gw]); msg != "" {
+			denied[gw] = msg
+		}
+	}
+	if len(denied) == 0 {
+		return
+	}
+	for i, p := range parents {
+		if gw, ok := limitedGateway(p, obj.Namespace); ok && denied[gw] != "" {
+			denyParentForLimit(ctx, obj.Namespace, &parents[i], denied[gw])
+		}
+	}
+}
+
+// limitedGateway returns the Gateway a route binds to by the parent, if the parent is a Gateway programmed by us.
+func limitedGateway(p routeParentReference, localNamespace string) (types.NamespacedName, bool) {
+	if p.DeniedReason != nil || p.IsMesh() || p.IsFederated() {
+		return types.NamespacedName{}, false
+	}
+	pk, err := toInternalParentReference(p.OriginalReference, localNamespace)
+	if err != nil || pk.Kind != gvk.KubernetesGateway {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: pk.Namespace, Name: pk.Name}, true
+}
+
+// routeVirtualHosts returns the hostnames a route adds to a listener: its own hostnames, or the one of the listener.
+func routeVirtualHosts(hostnames []k8s.Hostname, p routeParentReference) []string {
+	if len(hostnames) > 0 {
+		return hostnameToStringList(hostnames)
+	}
+	if p.Hostname != "" {
+		return []string{p.Hostname}
+	}
+	return []string{"*"}
+}
+
+// denyParentForLimit denies a parent of a route, which is then no longer counted as attached to the parent.
+func denyParentForLimit(ctx configContext, localNamespace string, p *routeParentReference, msg string) {
+	if p.DeniedReason != nil {
+		return
+	}
+	if pk, err := toInternalParentReference(p.OriginalReference, localNamespace); err == nil {
+		if pk.Kind == gvk.Service {
+			pk = meshParentKey
+		}
+		for _, pi := range ctx.GatewayReferences[pk] {
+			if pi.InternalName == p.InternalName && pi.AttachedRoutes > 0 {
+				pi.AttachedRoutes--
+			}
+		}
+	}
+	p.DeniedReason = &ParentError{Reason: ParentErrorConfigLimitExceeded, Message: msg}
+}
@@ -0,0 +1,154 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestApplyRouteLimits(t *testing.T) {
+	test.SetForTest(t, &features.MaxHTTPRoutesPerGateway, 2)
+	test.SetForTest(t, &features.MaxVirtualHostsPerGateway, 0)
+	test.SetForTest(t, &features.MaxMatchesPerHTTPRouteRule, 2)
+
+	gatewayKey := parentKey{Kind: gvk.KubernetesGateway, Name: "gateway", Namespace: "istio-system"}
+	newContext := func(namespaces map[string]*corev1.Namespace) configContext {
+		return configContext{
+			GatewayResources: GatewayResources{Namespaces: namespaces},
+			GatewayReferences: map[parentKey][]*parentInfo{
+				gatewayKey: {{InternalName: "istio-system/http"}, {InternalName: "istio-system/https"}},
+			},
+			gatewayConfigUsage: map[types.NamespacedName]*gatewayConfigUsage{},
+		}
+	}
+	route := func(name string, hostnames []k8s.Hostname, matches ...k8s.HTTPRouteMatch) config.Config {
+		return config.Config{
+			Meta: config.Meta{GroupVersionKind: gvk.HTTPRoute, Name: name, Namespace: "apps"},
+			Spec: &k8s.HTTPRouteSpec{Hostnames: hostnames, Rules: []k8s.HTTPRouteRule{{Matches: matches}}},
+		}
+	}
+	grpcRoute := func(name string, hostnames []k8s.Hostname, matches ...k8s.GRPCRouteMatch) config.Config {
+		return config.Config{
+			Meta: config.Meta{GroupVersionKind: gvk.GRPCRoute, Name: name, Namespace: "apps"},
+			Spec: &k8s.GRPCRouteSpec{Hostnames: hostnames, Rules: []k8s.GRPCRouteRule{{Matches: matches}}},
+		}
+	}
+	// attach binds the route to both listeners of the Gateway, as extractParentReferenceInfo does, and applies the limits
+	attach := func(ctx configContext, obj config.Config) []routeParentReference {
+		ref := k8s.ParentReference{Name: "gateway", Namespace: (*k8s.Namespace)(ptr.Of("istio-system"))}
+		var parents []routeParentReference
+		for _, pi := range ctx.GatewayReferences[gatewayKey] {
+			pi.AttachedRoutes++
+			parents = append(parents, routeParentReference{InternalName: pi.InternalName, OriginalReference: ref})
+		}
+		if obj.GroupVersionKind == gvk.GRPCRoute {
+			applyGRPCRouteLimits(ctx, obj, parents)
+		} else {
+			applyHTTPRouteLimits(ctx, obj, parents)
+		}
+		return parents
+	}
+	deniedReason := func(parents []routeParentReference) ParentErrorReason {
+		if parents[0].DeniedReason == nil {
+			return ParentNoError
+		}
+		return parents[0].DeniedReason.Reason
+	}
+
+	t.Run("routes per gateway", func(t *testing.T) {
+		ctx := newContext(nil)
+		assert.Equal(t, deniedReason(attach(ctx, route("first", nil))), ParentNoError)
+		assert.Equal(t, deniedReason(attach(ctx, route("second", nil))), ParentNoError)
+		third := attach(ctx, route("third", nil))
+		assert.Equal(t, deniedReason(third), ParentErrorConfigLimitExceeded)
+		assert.Equal(t, third[1].DeniedReason.Reason, ParentErrorConfigLimitExceeded)
+		// The denied route is not counted as attached to the listeners
+		for _, pi := range ctx.GatewayReferences[gatewayKey] {
+			assert.Equal(t, pi.AttachedRoutes, int32(2))
+		}
+	})
+
+	t.Run("grpc routes", func(t *testing.T) {
+		ctx := newContext(nil)
+		assert.Equal(t, deniedReason(attach(ctx, route("first", nil))), ParentNoError)
+		// Routes of different kinds with the same name are counted separately
+		assert.Equal(t, deniedReason(attach(ctx, grpcRoute("first", nil))), ParentNoError)
+		assert.Equal(t, deniedReason(attach(ctx, grpcRoute("third", nil))), ParentErrorConfigLimitExceeded)
+	})
+
+	t.Run("grpc matches per rule", func(t *testing.T) {
+		ctx := newContext(nil)
+		match := k8s.GRPCRouteMatch{}
+		parents := attach(ctx, grpcRoute("three", nil, match, match, match))
+		assert.Equal(t, deniedReason(parents), ParentErrorConfigLimitExceeded)
+	})
+
+	t.Run("namespace override", func(t *testing.T) {
+		ctx := newContext(map[string]*corev1.Namespace{
+			"istio-system": {ObjectMeta: metav1.ObjectMeta{
+				Name:        "istio-system",
+				Annotations: map[string]string{maxRoutesPerGatewayAnnotation: "0", maxVirtualHostsPerGatewayAnnotation: "2"},
+			}},
+		})
+		assert.Equal(t, deniedReason(attach(ctx, route("first", []k8s.Hostname{"a.example.com", "b.example.com"}))), ParentNoError)
+		// Hostnames already in use are not counted again
+		assert.Equal(t, deniedReason(attach(ctx, route("second", []k8s.Hostname{"a.example.com"}))), ParentNoError)
+		assert.Equal(t, deniedReason(attach(ctx, route("third", []k8s.Hostname{"c.example.com"}))), ParentErrorConfigLimitExceeded)
+	})
+
+	t.Run("matches per rule", func(t *testing.T) {
+		ctx := newContext(nil)
+		prefix := pathMatch(k8s.PathMatchPathPrefix, "/")
+		assert.Equal(t, deniedReason(attach(ctx, route("two", nil, prefix, prefix))), ParentNoError)
+		parents := attach(ctx, route("three", nil, prefix, prefix, prefix))
+		assert.Equal(t, deniedReason(parents), ParentErrorConfigLimitExceeded)
+		assert.Equal(t, parents[0].DeniedReason.Message,
+			"configuration limit exceeded: rule 0 has 3 matches, the rules are limited to 2 matches")
+	})
+}
+
+func TestConfigLimitExceededStatus(t *testing.T) {
+	limited := k8s.ParentReference{Name: "limited"}
+	other := k8s.ParentReference{Name: "other"}
+	obj := config.Config{Meta: config.Meta{GroupVersionKind: gvk.HTTPRoute, Namespace: "apps", Name: "route"}}
+	parents := createRouteStatus(envIdentity(), []RouteParentResult{
+		{OriginalReference: limited, DeniedReason: &ParentError{Reason: ParentErrorConfigLimitExceeded, Message: "limit"}},
+		{OriginalReference: other},
+	}, obj, nil)
+	// The route accepted by another parent still reports the limit it exceeds
+	assert.Equal(t, len(parents), 2)
+	for _, p := range parents {
+		if p.ParentRef.Name != limited.Name {
+			continue
+		}
+		for _, c := range p.Conditions {
+			if c.Type == string(k8s.RouteConditionAccepted) {
+				assert.Equal(t, c.Reason, string(ParentErrorConfigLimitExceeded))
+			}
+		}
+	}
+}
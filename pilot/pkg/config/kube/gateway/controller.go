@@ -24,6 +24,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	klabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"istio.io/istio/pilot/pkg/credentials"
@@ -43,6 +44,7 @@ import (
 	"istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/namespace"
 	istiolog "istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/maps"
 	"istio.io/istio/pkg/slices"
@@ -70,11 +72,28 @@ type Controller struct {
 	// Gateway-api types reference namespace labels directly, so we need access to these
 	namespaces       kclient.Client[*corev1.Namespace]
 	namespaceHandler model.EventHandler
+	// members holds the namespaces of the member roll instead of namespaces in multi-tenant mode. Their labels are
+	// not used to select routes, but their annotations set the gateway quotas.
+	members *namespace.MemberNamespaces
 
 	// Gateway-api types reference secrets directly, so we need access to these
 	credentialsController credentials.MulticlusterController
 	secretHandler         model.EventHandler
 
+	// ExtensionRef filters reference ConfigMaps directly, so we need access to these
+	configMaps       kclient.Client[*corev1.ConfigMap]
+	configMapHandler model.EventHandler
+
+	// runtimeConfig watches the ConfigMap changing the identity of the controller at runtime, if enabled
+	runtimeConfig kclient.Client[*corev1.ConfigMap]
+	// identity determines the GatewayClasses owned by the controller
+	identity *Identity
+
+	// The ServiceEntries and DestinationRules generated for external hostnames, and the policies generated for the
+	// authentication of routes, are consumed by the service registry and the push context like the ones of the cluster,
+	// so their handlers are notified when the generated configs change.
+	generatedHandlers map[config.GroupVersionKind][]model.EventHandler
+
 	// the cluster where the gateway-api controller runs
 	cluster cluster.ID
 	// domain stores the cluster domain, typically cluster.local
@@ -89,10 +108,27 @@ type Controller struct {
 	statusController *status.Controller
 	statusEnabled    *atomic.Bool
 
+	// nacks tracks configuration rejected by the proxies of each Gateway, so it can be reported on its status.
+	nacks *nackTracker
+	// listenerAcks tracks the generations of each Gateway whose listeners were accepted by its proxies.
+	listenerAcks *listenerAckTracker
+
+	// federatedGateways provides the Gateways exported by the federated peer meshes, if federation is enabled.
+	federatedGateways model.FederatedGatewayDiscovery
+
+	// reconciles records the outcome of the last reconciliations, reported by Health.
+	reconciles reconcileTracker
+
 	waitForCRD func(class schema.GroupVersionResource, stop <-chan struct{}) bool
 }
 
-var _ model.GatewayController = &Controller{}
+var (
+	_ model.GatewayController          = &Controller{}
+	_ model.GatewayNackReporter        = &Controller{}
+	_ model.GatewayListenerAckReporter = &Controller{}
+	_ model.GatewayRouteTracer         = &Controller{}
+	_ model.GatewayHealthReporter      = &Controller{}
+)
 
 func NewController(
 	kc kube.Client,
@@ -112,7 +148,12 @@ func NewController(
 		statusController:      ctl,
 		// Disabled by default, we will enable only if we win the leader election
 		statusEnabled: atomic.NewBool(false),
+		nacks:         newNackTracker(),
+		listenerAcks:  newListenerAckTracker(),
 		waitForCRD:    waitForCRD,
+		identity:      NewIdentity(),
+
+		generatedHandlers: map[config.GroupVersionKind][]model.EventHandler{},
 	}
 
 	if !kc.IsMultiTenant() {
@@ -127,12 +168,37 @@ func NewController(
 				}
 			},
 		})
+	} else {
+		gatewayController.members = namespace.NewMemberNamespaces(kc.Kube(), kc.GetMemberRollController(), "gateway-controller")
+		gatewayController.members.AddHandler(func(string) {
+			if gatewayController.namespaceHandler != nil {
+				gatewayController.namespaceHandler(config.Config{}, config.Config{}, model.EventUpdate)
+			}
+		})
 	}
 
 	if credsController != nil {
 		credsController.AddSecretHandler(gatewayController.secretEvent)
 	}
 
+	gatewayController.configMaps = kclient.NewFiltered[*corev1.ConfigMap](kc, kclient.Filter{
+		LabelSelector: gatewayExtensionLabel,
+		ObjectFilter:  options.GetFilter(),
+	})
+	gatewayController.configMaps.AddEventHandler(controllers.ObjectHandler(func(o controllers.Object) {
+		gatewayController.configMapEvent(o.GetName(), o.GetNamespace())
+	}))
+
+	if features.GatewayAPIRuntimeConfigMap != "" {
+		gatewayController.runtimeConfig = kclient.NewFiltered[*corev1.ConfigMap](kc, kclient.Filter{
+			Namespace:     options.SystemNamespace,
+			FieldSelector: "metadata.name=" + features.GatewayAPIRuntimeConfigMap,
+		})
+		gatewayController.runtimeConfig.AddEventHandler(controllers.ObjectHandler(func(controllers.Object) {
+			gatewayController.runtimeConfigEvent(options.SystemNamespace)
+		}))
+	}
+
 	return gatewayController
 }
 
@@ -140,6 +206,10 @@ func (c *Controller) Schemas() collection.Schemas {
 	return collection.SchemasFor(
 		collections.VirtualService,
 		collections.Gateway,
+		collections.ServiceEntry,
+		collections.DestinationRule,
+		collections.RequestAuthentication,
+		collections.AuthorizationPolicy,
 	)
 }
 
@@ -148,7 +218,8 @@ func (c *Controller) Get(typ config.GroupVersionKind, name, namespace string) *c
 }
 
 func (c *Controller) List(typ config.GroupVersionKind, namespace string) []config.Config {
-	if typ != gvk.Gateway && typ != gvk.VirtualService {
+	if typ != gvk.Gateway && typ != gvk.VirtualService && typ != gvk.ServiceEntry && typ != gvk.DestinationRule &&
+		typ != gvk.RequestAuthentication && typ != gvk.AuthorizationPolicy {
 		return nil
 	}
 
@@ -159,6 +230,14 @@ func (c *Controller) List(typ config.GroupVersionKind, namespace string) []confi
 		return filterNamespace(c.state.Gateway, namespace)
 	case gvk.VirtualService:
 		return filterNamespace(c.state.VirtualService, namespace)
+	case gvk.ServiceEntry:
+		return filterNamespace(c.state.ServiceEntry, namespace)
+	case gvk.DestinationRule:
+		return filterNamespace(c.state.DestinationRule, namespace)
+	case gvk.RequestAuthentication:
+		return filterNamespace(c.state.RequestAuthentication, namespace)
+	case gvk.AuthorizationPolicy:
+		return filterNamespace(c.state.AuthorizationPolicy, namespace)
 	default:
 		return nil
 	}
@@ -175,13 +254,22 @@ func (c *Controller) SetStatusWrite(enabled bool, statusManager *status.Manager)
 	}
 }
 
+// SetFederatedGatewayDiscovery allows the routes to attach to the Gateways exported by the federated peer meshes.
+func (c *Controller) SetFederatedGatewayDiscovery(d model.FederatedGatewayDiscovery) {
+	c.federatedGateways = d
+}
+
 // Reconcile takes in a current snapshot of the gateway-api configs, and regenerates our internal state.
 // Any status updates required will be enqueued as well.
 func (c *Controller) Reconcile(ps *model.PushContext) error {
 	t0 := time.Now()
-	defer func() {
-		log.Debugf("reconcile complete in %v", time.Since(t0))
-	}()
+	err := c.reconcile(ps)
+	c.reconciles.record(t0, err)
+	log.Debugf("reconcile complete in %v", time.Since(t0))
+	return err
+}
+
+func (c *Controller) reconcile(ps *model.PushContext) error {
 	gatewayClass := c.cache.List(gvk.GatewayClass, metav1.NamespaceAll)
 	gateway := c.cache.List(gvk.KubernetesGateway, metav1.NamespaceAll)
 	httpRoute := c.cache.List(gvk.HTTPRoute, metav1.NamespaceAll)
@@ -200,14 +288,22 @@ func (c *Controller) Reconcile(ps *model.PushContext) error {
 		ReferenceGrant: referenceGrant,
 		Domain:         c.domain,
 		Context:        NewGatewayContext(ps),
+		controllerID:   c.identity.load(),
+	}
+	if features.EnableGatewayAPINackStatus {
+		input.Rejections = c.nacks.snapshot()
+	}
+	if features.EnableGatewayAPIProgrammedOnAck {
+		input.ListenerAcks = c.listenerAcks.observe(ps.PushVersion, gateway)
+	}
+	if c.federatedGateways != nil {
+		input.FederatedGateways = c.federatedGateways.FederatedGateways()
 	}
 
 	if !input.hasResources() {
 		// Early exit for common case of no gateway-api used.
-		c.stateMu.Lock()
-		defer c.stateMu.Unlock()
 		// make sure we clear out the state, to handle the last gateway-api resource being removed
-		c.state = IstioResources{}
+		c.setState(IstioResources{})
 		return nil
 	}
 
@@ -218,6 +314,9 @@ func (c *Controller) Reconcile(ps *model.PushContext) error {
 			namespaces[ns.Name] = ns
 		}
 	} else {
+		for _, ns := range c.members.List() {
+			namespaces[ns.Name] = ns
+		}
 		// we don't support namespace selectors in multi-tenant Istio right now,
 		// so we remove them, inducing default behavior (namespace-local)
 		for _, obj := range gateway {
@@ -233,6 +332,12 @@ func (c *Controller) Reconcile(ps *model.PushContext) error {
 	}
 	input.Namespaces = namespaces
 
+	extensionConfigMaps := map[types.NamespacedName]*corev1.ConfigMap{}
+	for _, cm := range c.configMaps.List(metav1.NamespaceAll, klabels.Everything()) {
+		extensionConfigMaps[config.NamespacedName(cm)] = cm
+	}
+	input.ExtensionConfigMaps = extensionConfigMaps
+
 	if c.credentialsController != nil {
 		credentials, err := c.credentialsController.ForCluster(c.cluster)
 		if err != nil {
@@ -242,16 +347,29 @@ func (c *Controller) Reconcile(ps *model.PushContext) error {
 	}
 
 	output := convertResources(input)
+	reportOrphanedRoutes(output.DeniedRouteParents)
 
 	// Handle all status updates
 	c.QueueStatusUpdates(input)
 
-	c.stateMu.Lock()
-	defer c.stateMu.Unlock()
-	c.state = output
+	c.setState(output)
 	return nil
 }
 
+// setState replaces the computed Istio resources, notifying the handlers of the generated configs which changed.
+func (c *Controller) setState(state IstioResources) {
+	c.stateMu.Lock()
+	old := c.state
+	c.state = state
+	c.stateMu.Unlock()
+
+	// The handlers are notified outside of the lock, as they may read the generated configs back
+	c.notifyGeneratedConfigs(gvk.ServiceEntry, old.ServiceEntry, state.ServiceEntry)
+	c.notifyGeneratedConfigs(gvk.DestinationRule, old.DestinationRule, state.DestinationRule)
+	c.notifyGeneratedConfigs(gvk.RequestAuthentication, old.RequestAuthentication, state.RequestAuthentication)
+	c.notifyGeneratedConfigs(gvk.AuthorizationPolicy, old.AuthorizationPolicy, state.AuthorizationPolicy)
+}
+
 func (c *Controller) QueueStatusUpdates(r GatewayResources) {
 	c.handleStatusUpdates(r.GatewayClass)
 	c.handleStatusUpdates(r.Gateway)
@@ -300,18 +418,26 @@ func (c *Controller) RegisterEventHandler(typ config.GroupVersionKind, handler m
 		c.namespaceHandler = handler
 	case gvk.Secret:
 		c.secretHandler = handler
+	case gvk.ConfigMap:
+		c.configMapHandler = handler
+	case gvk.ServiceEntry, gvk.DestinationRule, gvk.RequestAuthentication, gvk.AuthorizationPolicy:
+		c.generatedHandlers[typ] = append(c.generatedHandlers[typ], handler)
 	}
 	// For all other types, do nothing as c.cache has been registered
 }
 
 func (c *Controller) Run(stop <-chan struct{}) {
 	if c.namespaces == nil {
+		go func() {
+			<-stop
+			c.members.Shutdown()
+		}()
 		return
 	}
 	if features.EnableGatewayAPIGatewayClassController {
 		go func() {
 			if c.waitForCRD(gvr.GatewayClass, stop) {
-				gcc := NewClassController(c.client)
+				gcc := NewClassController(c.client, c.identity)
 				c.client.RunAndWait(stop)
 				gcc.Run(stop)
 			}
@@ -319,8 +445,15 @@ func (c *Controller) Run(stop <-chan struct{}) {
 	}
 }
 
+// Identity returns the identity of the controller, to share with the DeploymentController.
+func (c *Controller) Identity() *Identity {
+	return c.identity
+}
+
 func (c *Controller) HasSynced() bool {
-	return c.cache.HasSynced() && (c.namespaces == nil || c.namespaces.HasSynced())
+	return c.cache.HasSynced() && (c.namespaces == nil || c.namespaces.HasSynced()) &&
+		(c.members == nil || c.members.HasSynced()) && c.configMaps.HasSynced() &&
+		(c.runtimeConfig == nil || c.runtimeConfig.HasSynced())
 }
 
 func (c *Controller) SecretAllowed(resourceName string, namespace string) bool {
@@ -386,6 +519,64 @@ func (c *Controller) secretEvent(name, namespace string) {
 	}
 }
 
+// configMapEvent handles a change to an extension ConfigMap, triggering an update of all routes, gateways and gateway
+// classes referencing it.
+func (c *Controller) configMapEvent(name, namespace string) {
+	c.stateMu.RLock()
+	impactedConfigs := slices.Clone(c.state.ResourceReferences[model.ConfigKey{
+		Kind:      kind.ConfigMap,
+		Namespace: namespace,
+		Name:      name,
+	}])
+	c.stateMu.RUnlock()
+	// A new IP access policy is not referenced yet, so the Gateway it targets is read from the policy itself
+	if cm := c.configMaps.Get(name, namespace); cm != nil && cm.Labels[gatewayExtensionLabel] == ipAccessExtension {
+		if cfg, err := parseIPAccessConfig(cm); err == nil {
+			impactedConfigs = append(impactedConfigs, model.ConfigKey{Kind: kind.KubernetesGateway, Namespace: namespace, Name: cfg.TargetRef.Name})
+		}
+	}
+	if len(impactedConfigs) == 0 || c.configMapHandler == nil {
+		return
+	}
+	log.Debugf("configmap %s/%s changed, triggering configmap handler", namespace, name)
+	for _, cfg := range impactedConfigs {
+		gk := gvk.HTTPRoute
+		switch cfg.Kind {
+		case kind.GatewayClass:
+			gk = gvk.GatewayClass
+		case kind.KubernetesGateway:
+			gk = gvk.KubernetesGateway
+		}
+		ref := config.Config{
+			Meta: config.Meta{
+				GroupVersionKind: gk,
+				Namespace:        cfg.Namespace,
+				Name:             cfg.Name,
+			},
+		}
+		c.configMapHandler(ref, ref, model.EventUpdate)
+	}
+}
+
+// runtimeConfigEvent handles a change to the runtime ConfigMap. If the identity of the controller changes, all the
+// Gateway API resources are converted again, as the GatewayClasses it owns may have changed.
+func (c *Controller) runtimeConfigEvent(namespace string) {
+	old := c.identity.load()
+	defaultClass, controller := runtimeIdentity(c.runtimeConfig.Get(features.GatewayAPIRuntimeConfigMap, namespace))
+	if !c.identity.set(defaultClass, controller) || c.configMapHandler == nil {
+		return
+	}
+	for _, name := range []v1beta1.ObjectName{old.defaultClassName, defaultClass} {
+		ref := config.Config{
+			Meta: config.Meta{
+				GroupVersionKind: gvk.GatewayClass,
+				Name:             string(name),
+			},
+		}
+		c.configMapHandler(ref, ref, model.EventUpdate)
+	}
+}
+
 // deepCopyStatus creates a copy of all configs, with a copy of the status field that we can mutate.
 // This allows our functions to call Status.Mutate, and then we can later persist all changes into the
 // API server.
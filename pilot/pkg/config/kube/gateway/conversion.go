@@ -26,6 +26,7 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	klabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
 	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
@@ -41,6 +42,7 @@ import (
 	"istio.io/istio/pkg/config/protocol"
 	"istio.io/istio/pkg/config/schema/gvk"
 	"istio.io/istio/pkg/config/schema/kind"
+	"istio.io/istio/pkg/config/security"
 	"istio.io/istio/pkg/ptr"
 	"istio.io/istio/pkg/slices"
 	"istio.io/istio/pkg/util/sets"
@@ -69,6 +71,14 @@ func convertResources(r GatewayResources) IstioResources {
 		GatewayResources:   r,
 		AllowedReferences:  convertReferencePolicies(r),
 		resourceReferences: make(map[model.ConfigKey][]model.ConfigKey),
+		deniedRouteParents: make(map[types.NamespacedName][]DeniedRouteParent),
+		routeRetryBudgets:  make(map[string]model.RetryBudget),
+		existingGateways:   existingGateways(r.Gateway),
+
+		routeAuthentications: make(map[types.NamespacedName]*routeAuthnAttachment),
+		gatewayConfigUsage:   make(map[types.NamespacedName]*gatewayConfigUsage),
+
+		routeGatewayNamespaces: make(map[types.NamespacedName]sets.String),
 	}
 
 	gw, gwMap, nsReferences := convertGateways(ctx)
@@ -76,6 +86,9 @@ func convertResources(r GatewayResources) IstioResources {
 	result.Gateway = gw
 
 	result.VirtualService = convertVirtualService(ctx)
+	result.ServiceEntry, result.DestinationRule = convertExternalHostnames(ctx)
+	result.DestinationRule = append(result.DestinationRule, convertMirrorComparisons(ctx, result.VirtualService)...)
+	result.RequestAuthentication, result.AuthorizationPolicy = convertRouteAuthentications(ctx)
 
 	// Once we have gone through all route computation, we will know how many routes bound to each gateway.
 	// Report this in the status.
@@ -89,6 +102,7 @@ func convertResources(r GatewayResources) IstioResources {
 	result.AllowedReferences = ctx.AllowedReferences
 	result.ReferencedNamespaceKeys = nsReferences
 	result.ResourceReferences = ctx.resourceReferences
+	result.DeniedRouteParents = ctx.deniedRouteParents
 	return result
 }
 
@@ -175,8 +189,20 @@ func convertVirtualService(r configContext) []config.Config {
 	for _, obj := range r.GRPCRoute {
 		buildGRPCVirtualServices(r, obj, gatewayRoutes, meshRoutes)
 	}
-	for _, vsByHost := range gatewayRoutes {
+	methodNotAllowed := sets.New[string]()
+	for _, parents := range r.GatewayReferences {
+		for _, p := range parents {
+			if p.MethodNotAllowed {
+				methodNotAllowed.Insert(p.InternalName)
+			}
+		}
+	}
+	for gw, vsByHost := range gatewayRoutes {
 		for _, vsConfig := range vsByHost {
+			annotateRetryBudgets(r, vsConfig)
+			if methodNotAllowed.Contains(gw) {
+				appendMethodNotAllowedRoutes(vsConfig)
+			}
 			result = append(result, *vsConfig)
 		}
 	}
@@ -191,7 +217,7 @@ func convertVirtualService(r configContext) []config.Config {
 func convertHTTPRoute(r k8s.HTTPRouteRule, ctx configContext,
 	obj config.Config, pos int, enforceRefGrant bool,
 ) (*istio.HTTPRoute, *ConfigError) {
-	// TODO: implement rewrite, timeout, corspolicy, retries
+	// TODO: implement rewrite, timeout
 	vs := &istio.HTTPRoute{}
 	// Auto-name the route. If upstream defines an explicit name, will use it instead
 	// The position within the route is unique
@@ -248,9 +274,22 @@ func convertHTTPRoute(r k8s.HTTPRouteRule, ctx configContext,
 			if err != nil {
 				return nil, err
 			}
+			applyMirrorComparison(obj, mirror, enforceRefGrant)
 			vs.Mirrors = append(vs.Mirrors, mirror)
 		case k8sv1.HTTPRouteFilterURLRewrite:
 			vs.Rewrite = createRewriteFilter(filter.URLRewrite)
+		case k8sv1.HTTPRouteFilterExtensionRef:
+			ext, err := createExtensionRefFilter(ctx, filter.ExtensionRef, obj)
+			if err != nil {
+				return nil, err
+			}
+			if ext.cors != nil {
+				vs.CorsPolicy = ext.cors
+			}
+			if ext.retries != nil {
+				vs.Retries = ext.retries
+				ctx.routeRetryBudgets[vs.Name] = *ext.retryBudget
+			}
 		default:
 			return nil, &ConfigError{
 				Reason:  InvalidFilter,
@@ -344,6 +383,7 @@ func convertGRPCRoute(r k8s.GRPCRouteRule, ctx configContext,
 			if err != nil {
 				return nil, err
 			}
+			applyMirrorComparison(obj, mirror, enforceRefGrant)
 			vs.Mirrors = append(vs.Mirrors, mirror)
 		default:
 			return nil, &ConfigError{
@@ -389,10 +429,21 @@ func buildHTTPVirtualServices(
 ) {
 	route := obj.Spec.(*k8s.HTTPRouteSpec)
 	parentRefs := extractParentReferenceInfo(ctx.GatewayReferences, route.ParentRefs, route.Hostnames, gvk.HTTPRoute, obj.Namespace)
+	applyHTTPRouteLimits(ctx, obj, parentRefs)
 	reportStatus := func(results []RouteParentResult) {
 		obj.Status.(*kstatus.WrappedStatus).Mutate(func(s config.Status) config.Status {
 			rs := s.(*k8s.HTTPRouteStatus)
-			rs.Parents = createRouteStatus(results, obj, rs.Parents)
+			// The Gateways the route was attached to may have been deleted, leaving the route silently unserved
+			orphans := orphanedParents(ctx, obj, route.ParentRefs, rs.Parents)
+			for _, o := range orphans {
+				key := obj.NamespacedName()
+				ctx.deniedRouteParents[key] = append(ctx.deniedRouteParents[key], DeniedRouteParent{
+					Parent:  parentName(o.OriginalReference, obj.Namespace),
+					Reason:  string(o.DeniedReason.Reason),
+					Message: o.DeniedReason.Message,
+				})
+			}
+			rs.Parents = createRouteStatus(ctx.identity(), append(results, orphans...), obj, rs.Parents)
 			return rs
 		})
 	}
@@ -430,11 +481,21 @@ func buildHTTPVirtualServices(
 		return res
 	}
 	meshResult, gwResult := buildMeshAndGatewayRoutes(parentRefs, convertRules)
+	authn := parseRouteAuthentication(obj)
+	if authn != nil && authn.err != nil && gwResult.error == nil {
+		gwResult.error = authn.err
+	}
+	if err := unscopedAuthnError(route, authn, parentRefs); err != nil && gwResult.error == nil {
+		gwResult.error = err
+	}
+	if _, err := parseMirrorComparison(obj); err != nil && gwResult.error == nil {
+		gwResult.error = err
+	}
 
 	reportStatus(slices.Map(parentRefs, func(r routeParentReference) RouteParentResult {
 		res := RouteParentResult{
 			OriginalReference: r.OriginalReference,
-			DeniedReason:      r.DeniedReason,
+			DeniedReason:      federatedParentDenial(r),
 			RouteError:        gwResult.error,
 		}
 		if r.IsMesh() {
@@ -442,8 +503,32 @@ func buildHTTPVirtualServices(
 		}
 		return res
 	}))
+	for _, r := range parentRefs {
+		if r.DeniedReason != nil {
+			key := obj.NamespacedName()
+			ctx.deniedRouteParents[key] = append(ctx.deniedRouteParents[key], DeniedRouteParent{
+				Parent:  parentName(r.OriginalReference, obj.Namespace),
+				Reason:  string(r.DeniedReason.Reason),
+				Message: r.DeniedReason.Message,
+			})
+		}
+	}
 	count := 0
 	for _, parent := range filteredReferences(parentRefs) {
+		if parent.IsFederated() {
+			// The Gateway is programmed by the peer mesh
+			continue
+		}
+		if !attachRouteAuthentication(ctx, obj, authn, parent) {
+			continue
+		}
+		if !parent.IsMesh() {
+			key := obj.NamespacedName()
+			if ctx.routeGatewayNamespaces[key] == nil {
+				ctx.routeGatewayNamespaces[key] = sets.New[string]()
+			}
+			ctx.routeGatewayNamespaces[key].Insert(string(ptr.OrDefault(parent.OriginalReference.Namespace, k8s.Namespace(obj.Namespace))))
+		}
 		// for gateway routes, build one VS per gateway+host
 		routeMap := gatewayRoutes
 		routeKey := parent.InternalName
@@ -598,10 +683,11 @@ func buildGRPCVirtualServices(
 ) {
 	route := obj.Spec.(*k8s.GRPCRouteSpec)
 	parentRefs := extractParentReferenceInfo(ctx.GatewayReferences, route.ParentRefs, route.Hostnames, gvk.HTTPRoute, obj.Namespace)
+	applyGRPCRouteLimits(ctx, obj, parentRefs)
 	reportStatus := func(results []RouteParentResult) {
 		obj.Status.(*kstatus.WrappedStatus).Mutate(func(s config.Status) config.Status {
 			rs := s.(*k8s.GRPCRouteStatus)
-			rs.Parents = createRouteStatus(results, obj, rs.Parents)
+			rs.Parents = createRouteStatus(ctx.identity(), results, obj, rs.Parents)
 			return rs
 		})
 	}
@@ -639,11 +725,14 @@ func buildGRPCVirtualServices(
 		return res
 	}
 	meshResult, gwResult := buildMeshAndGatewayRoutes(parentRefs, convertRules)
+	if _, err := parseMirrorComparison(obj); err != nil && gwResult.error == nil {
+		gwResult.error = err
+	}
 
 	reportStatus(slices.Map(parentRefs, func(r routeParentReference) RouteParentResult {
 		res := RouteParentResult{
 			OriginalReference: r.OriginalReference,
-			DeniedReason:      r.DeniedReason,
+			DeniedReason:      federatedParentDenial(r),
 			RouteError:        gwResult.error,
 		}
 		if r.IsMesh() {
@@ -653,6 +742,10 @@ func buildGRPCVirtualServices(
 	}))
 	count := 0
 	for _, parent := range filteredReferences(parentRefs) {
+		if parent.IsFederated() {
+			// The Gateway is programmed by the peer mesh
+			continue
+		}
 		// for gateway routes, build one VS per gateway+host
 		routeMap := gatewayRoutes
 		routeKey := parent.InternalName
@@ -824,6 +917,8 @@ func toInternalParentReference(p k8s.ParentReference, localNamespace string) (pa
 		ik = gvk.KubernetesGateway
 	} else if kind == gvk.Service.Kind && group == gvk.Service.Group {
 		ik = gvk.Service
+	} else if kind == federatedGatewayKind && group == federatedGatewayGroup {
+		ik = federatedGatewayGVK
 	} else {
 		return empty, fmt.Errorf("unsupported parentKey: %v/%v", p.Group, kind)
 	}
@@ -843,6 +938,12 @@ func referenceAllowed(
 	hostnames []k8s.Hostname,
 	namespace string,
 ) *ParentError {
+	if err := validateHostnames(hostnames); err != nil {
+		return &ParentError{
+			Reason:  ParentErrorInvalidHostname,
+			Message: err.Error(),
+		}
+	}
 	if parentRef.Kind == gvk.Service {
 		// TODO: check if the service reference is valid
 		if false {
@@ -980,7 +1081,7 @@ func buildTCPVirtualService(ctx configContext, obj config.Config) []config.Confi
 	reportStatus := func(results []RouteParentResult) {
 		obj.Status.(*kstatus.WrappedStatus).Mutate(func(s config.Status) config.Status {
 			rs := s.(*k8s.TCPRouteStatus)
-			rs.Parents = createRouteStatus(results, obj, rs.Parents)
+			rs.Parents = createRouteStatus(ctx.identity(), results, obj, rs.Parents)
 			return rs
 		})
 	}
@@ -1009,7 +1110,7 @@ func buildTCPVirtualService(ctx configContext, obj config.Config) []config.Confi
 	reportStatus(slices.Map(parentRefs, func(r routeParentReference) RouteParentResult {
 		res := RouteParentResult{
 			OriginalReference: r.OriginalReference,
-			DeniedReason:      r.DeniedReason,
+			DeniedReason:      federatedParentDenial(r),
 			RouteError:        gwResult.error,
 		}
 		if r.IsMesh() {
@@ -1058,7 +1159,7 @@ func buildTLSVirtualService(ctx configContext, obj config.Config) []config.Confi
 	reportStatus := func(results []RouteParentResult) {
 		obj.Status.(*kstatus.WrappedStatus).Mutate(func(s config.Status) config.Status {
 			rs := s.(*k8s.TLSRouteStatus)
-			rs.Parents = createRouteStatus(results, obj, rs.Parents)
+			rs.Parents = createRouteStatus(ctx.identity(), results, obj, rs.Parents)
 			return rs
 		})
 	}
@@ -1087,7 +1188,7 @@ func buildTLSVirtualService(ctx configContext, obj config.Config) []config.Confi
 	reportStatus(slices.Map(parentRefs, func(r routeParentReference) RouteParentResult {
 		res := RouteParentResult{
 			OriginalReference: r.OriginalReference,
-			DeniedReason:      r.DeniedReason,
+			DeniedReason:      federatedParentDenial(r),
 			RouteError:        gwResult.error,
 		}
 		if r.IsMesh() {
@@ -1432,8 +1533,10 @@ func buildDestination(ctx configContext, to k8s.BackendRef, ns string, enforceRe
 			return nil, &ConfigError{Reason: InvalidDestination, Message: "serviceName invalid; the name of the Service must be used, not the hostname."}
 		}
 		hostname := fmt.Sprintf("%s.%s.svc.%s", to.Name, namespace, ctx.Domain)
-		if ctx.Context.GetService(hostname, namespace) == nil {
+		if svc := ctx.Context.GetService(hostname, namespace); svc == nil {
 			invalidBackendErr = &ConfigError{Reason: InvalidDestinationNotFound, Message: fmt.Sprintf("backend(%s) not found", hostname)}
+		} else {
+			invalidBackendErr = checkBackendProtocol(svc, int(*to.Port), k)
 		}
 		return &istio.Destination{
 			// TODO: implement ReferencePolicy for cross namespace
@@ -1456,8 +1559,10 @@ func buildDestination(ctx configContext, to k8s.BackendRef, ns string, enforceRe
 		if strings.Contains(string(to.Name), ".") {
 			return nil, &ConfigError{Reason: InvalidDestination, Message: "serviceName invalid; the name of the Service must be used, not the hostname."}
 		}
-		if ctx.Context.GetService(hostname, namespace) == nil {
+		if svc := ctx.Context.GetService(hostname, namespace); svc == nil {
 			invalidBackendErr = &ConfigError{Reason: InvalidDestinationNotFound, Message: fmt.Sprintf("backend(%s) not found", hostname)}
+		} else {
+			invalidBackendErr = checkBackendProtocol(svc, int(*to.Port), k)
 		}
 		return &istio.Destination{
 			Host: hostname,
@@ -1474,8 +1579,10 @@ func buildDestination(ctx configContext, to k8s.BackendRef, ns string, enforceRe
 			return nil, &ConfigError{Reason: InvalidDestination, Message: "namespace may not be set with Hostname type"}
 		}
 		hostname := string(to.Name)
-		if ctx.Context.GetService(hostname, namespace) == nil {
+		if svc := ctx.Context.GetService(hostname, namespace); svc == nil {
 			invalidBackendErr = &ConfigError{Reason: InvalidDestinationNotFound, Message: fmt.Sprintf("backend(%s) not found", hostname)}
+		} else {
+			invalidBackendErr = checkBackendProtocol(svc, int(*to.Port), k)
 		}
 		return &istio.Destination{
 			Host: string(to.Name),
@@ -1488,11 +1595,30 @@ func buildDestination(ctx configContext, to k8s.BackendRef, ns string, enforceRe
 	}
 }
 
+// checkBackendProtocol returns an error if the backend port is declared, by its appProtocol or its name, with a
+// protocol the route cannot be forwarded with. Such a backend is still routed to, as the traffic would otherwise
+// fail without any hint of the cause.
+func checkBackendProtocol(svc *model.Service, port int, k config.GroupVersionKind) *ConfigError {
+	if k != gvk.HTTPRoute && k != gvk.GRPCRoute {
+		return nil
+	}
+	p, f := svc.Ports.GetByPort(port)
+	if !f || p.Protocol.IsUnsupported() || p.Protocol.IsHTTP() || p.Protocol.IsHTTPS() {
+		return nil
+	}
+	return &ConfigError{
+		Reason: InvalidDestinationProtocol,
+		Message: fmt.Sprintf("backend(%s) port %d is declared with the %s protocol, which cannot serve a %s; "+
+			"set the appProtocol or the name of the port to an HTTP protocol", svc.Hostname, port, p.Protocol, k.Kind),
+	}
+}
+
 // https://github.com/kubernetes-sigs/gateway-api/blob/cea484e38e078a2c1997d8c7a62f410a1540f519/apis/v1beta1/httproute_types.go#L207-L212
 func isInvalidBackend(err *ConfigError) bool {
 	return err.Reason == InvalidDestinationPermit ||
 		err.Reason == InvalidDestinationNotFound ||
-		err.Reason == InvalidDestinationKind
+		err.Reason == InvalidDestinationKind ||
+		err.Reason == InvalidDestinationProtocol
 }
 
 func headerListToMap(hl []k8s.HTTPHeader) map[string]string {
@@ -1788,12 +1914,13 @@ func createGRPCURIMatch(match k8s.GRPCRouteMatch) (*istio.StringMatch, *ConfigEr
 func getGatewayClasses(r GatewayResources) map[string]k8s.GatewayController {
 	res := map[string]k8s.GatewayController{}
 	// Setup builtin ones - these can be overridden possibly
-	for name, controller := range builtinClasses {
+	id := r.identity()
+	for name, controller := range id.builtinClasses {
 		res[string(name)] = controller
 	}
 	for _, obj := range r.GatewayClass {
 		gwc := obj.Spec.(*k8s.GatewayClassSpec)
-		_, known := classInfos[gwc.ControllerName]
+		_, known := id.classInfos[gwc.ControllerName]
 		if !known {
 			continue
 		}
@@ -1845,6 +1972,18 @@ type configContext struct {
 
 	// key: referenced resources(e.g. secrets), value: gateway-api resources(e.g. gateways)
 	resourceReferences map[model.ConfigKey][]model.ConfigKey
+	// key: HTTPRoute, value: the parent references it could not attach to
+	deniedRouteParents map[types.NamespacedName][]DeniedRouteParent
+	// key: name of a generated HTTP route, value: the retry budget set by its ExtensionRef filter
+	routeRetryBudgets map[string]model.RetryBudget
+	// existingGateways are the names of all the Gateways, of any class, to tell deleted parents from foreign ones
+	existingGateways sets.Set[types.NamespacedName]
+	// key: name of the generated policies, value: the authentication required by an HTTPRoute on a Gateway
+	routeAuthentications map[types.NamespacedName]*routeAuthnAttachment
+	// key: Gateway, value: the HTTPRoutes it accepted, against its configuration limits
+	gatewayConfigUsage map[types.NamespacedName]*gatewayConfigUsage
+	// key: HTTPRoute, value: the namespaces of the Gateways it is attached to
+	routeGatewayNamespaces map[types.NamespacedName]sets.String
 }
 
 // parentInfo holds info about a "parent" - something that can be referenced as a ParentRef in the API.
@@ -1868,6 +2007,8 @@ type parentInfo struct {
 	ReportAttachedRoutes func()
 	SectionName          k8s.SectionName
 	Port                 k8sv1.PortNumber
+	// MethodNotAllowed is set if the requests of unmatched methods get a 405 rather than a 404
+	MethodNotAllowed bool
 }
 
 // routeParentReference holds information about a route's parent reference
@@ -1915,6 +2056,11 @@ func convertGateways(r configContext) ([]config.Config, map[parentKey][]*parentI
 	// used to ensure we handle namespace updates for those keys.
 	namespaceLabelReferences := sets.New[string]()
 	classes := getGatewayClasses(r.GatewayResources)
+	classDefaults := getGatewayClassDefaults(r, classes)
+	ipAccess := getGatewayIPAccessPolicies(r)
+	chainConflicts := sharedChainConflicts(r, classes, ipAccess)
+	violations := gatewayQuotaViolations(r.GatewayResources, classes)
+	id := r.identity()
 	for _, obj := range r.Gateway {
 		obj := obj
 		kgw := obj.Spec.(*k8s.GatewaySpec)
@@ -1923,7 +2069,7 @@ func convertGateways(r configContext) ([]config.Config, map[parentKey][]*parentI
 			// No gateway class found, this may be meant for another controller; should be skipped.
 			continue
 		}
-		classInfo, f := classInfos[controllerName]
+		classInfo, f := id.classInfos[controllerName]
 		if !f {
 			continue
 		}
@@ -1931,6 +2077,11 @@ func convertGateways(r configContext) ([]config.Config, map[parentKey][]*parentI
 			// We found it, but don't want to handle this class
 			continue
 		}
+		if msg, f := violations[config.NamespacedName(obj)]; f {
+			// The gateway is not deployed, so there is nothing to program
+			reportGatewayStatus(r, obj, classInfo, nil, nil, &ConfigError{Reason: QuotaExceeded, Message: msg})
+			continue
+		}
 
 		servers := []*istio.Server{}
 
@@ -1941,10 +2092,25 @@ func convertGateways(r configContext) ([]config.Config, map[parentKey][]*parentI
 			reportGatewayStatus(r, obj, classInfo, gatewayServices, servers, err)
 			continue
 		}
+		forwardedHeaders, forwardedHeadersErr := gatewayForwardedHeadersPolicy(obj)
+		if err == nil {
+			// Like the address warnings, an invalid policy is a soft failure
+			err = forwardedHeadersErr
+		}
+		listenerPorts := resolveListenerPorts(obj.Annotations, kgw.Listeners)
+		if err == nil {
+			err = listenerPorts.err
+		}
+		methodNotAllowed, methodNotAllowedErr := gatewayMethodNotAllowed(obj)
+		if err == nil {
+			err = methodNotAllowedErr
+		}
 		for i, l := range kgw.Listeners {
 			i := i
 			namespaceLabelReferences.InsertAll(getNamespaceLabelReferences(l.AllowedRoutes)...)
-			server, programmed := buildListener(r, obj, l, i, controllerName)
+			listenerIPAccess := ipAccess[config.NamespacedName(obj)].forListener(l.Name)
+			server, programmed := buildListener(r, obj, l, i, controllerName, listenerIPAccess, listenerPorts.conflicts[i],
+				chainConflicts[config.NamespacedName(obj)][i])
 
 			servers = append(servers, server)
 			if controllerName == constants.ManagedGatewayMeshController {
@@ -1953,6 +2119,12 @@ func convertGateways(r configContext) ([]config.Config, map[parentKey][]*parentI
 			}
 			meta := parentMeta(obj, &l.Name)
 			meta[model.InternalGatewayServiceAnnotation] = strings.Join(gatewayServices, ",")
+			if alpn := listenerALPNProtocols(l); len(alpn) > 0 {
+				meta[model.InternalGatewayALPNAnnotation] = strings.Join(alpn, ",")
+			}
+			classDefaults[string(kgw.GatewayClassName)].annotate(meta)
+			listenerIPAccess.annotate(meta)
+			annotateForwardedHeaders(meta, forwardedHeaders)
 			// Each listener generates an Istio Gateway with a single Server. This allows binding to a specific listener.
 			gatewayConfig := config.Config{
 				Meta: config.Meta{
@@ -1984,6 +2156,7 @@ func convertGateways(r configContext) ([]config.Config, map[parentKey][]*parentI
 				OriginalHostname: string(ptr.OrEmpty(l.Hostname)),
 				SectionName:      l.Name,
 				Port:             l.Port,
+				MethodNotAllowed: methodNotAllowed,
 			}
 			pri.ReportAttachedRoutes = func() {
 				reportListenerAttachedRoutes(i, obj, pri.AttachedRoutes)
@@ -2016,6 +2189,7 @@ func convertGateways(r configContext) ([]config.Config, map[parentKey][]*parentI
 
 		reportGatewayStatus(r, obj, classInfo, gatewayServices, servers, err)
 	}
+	addFederatedGatewayParents(gwMap, r.FederatedGateways)
 	// Insert a parent for Mesh references.
 	gwMap[meshParentKey] = []*parentInfo{
 		{
@@ -2032,6 +2206,25 @@ func convertGateways(r configContext) ([]config.Config, map[parentKey][]*parentI
 	return result, gwMap, namespaceLabelReferences
 }
 
+// gatewayQuotaViolations determines the managed Gateways exceeding their quotas. See quotaViolations.
+func gatewayQuotaViolations(r GatewayResources, classes map[string]k8s.GatewayController) map[types.NamespacedName]string {
+	var candidates []quotaCandidate
+	id := r.identity()
+	for _, obj := range r.Gateway {
+		kgw := obj.Spec.(*k8s.GatewaySpec)
+		ci, f := id.classInfos[classes[string(kgw.GatewayClassName)]]
+		if !f {
+			continue
+		}
+		if c, ok := newQuotaCandidate(obj.Meta, kgw, ci); ok {
+			candidates = append(candidates, c)
+		}
+	}
+	return quotaViolations(candidates, tenantGatewayQuota(), func(ns string) gatewayQuota {
+		return namespaceGatewayQuota(r.Namespaces[ns])
+	})
+}
+
 // Gateway currently requires a listener (https://github.com/kubernetes-sigs/gateway-api/pull/1596).
 // We don't *really* care about the listener, but it may make sense to add a warning if users do not
 // configure it in an expected way so that we have consistency and can make changes in the future as needed.
@@ -2109,6 +2302,26 @@ func reportGatewayStatus(
 			Message: msg,
 		}
 	}
+	if rejection, f := r.Rejections[types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name}]; f &&
+		gatewayConditions[string(k8sv1.GatewayConditionProgrammed)].error == nil {
+		gatewayConditions[string(k8sv1.GatewayConditionProgrammed)].error = &ConfigError{
+			Reason:  string(k8sv1.GatewayReasonInvalid),
+			Message: "Generated configuration was rejected by the gateway proxy: " + rejectionMessage(rejection),
+		}
+	}
+	if r.ListenerAcks != nil && IsManaged(obj.Spec.(*k8s.GatewaySpec)) &&
+		gatewayConditions[string(k8sv1.GatewayConditionProgrammed)].error == nil {
+		// The proxies of automatically deployed Gateways are known, so wait until one serves the listeners
+		if acked, f := r.ListenerAcks[types.NamespacedName{Namespace: obj.Namespace, Name: obj.Name}]; !f || acked != obj.Generation {
+			gatewayConditions[string(k8sv1.GatewayConditionProgrammed)].error = &ConfigError{
+				Reason:  string(k8sv1.GatewayReasonPending),
+				Message: "Waiting for a gateway proxy to accept the generated listeners",
+			}
+		}
+	}
+	if gatewayErr != nil && gatewayErr.Reason == QuotaExceeded {
+		gatewayConditions[string(k8sv1.GatewayConditionProgrammed)].error = gatewayErr
+	}
 	obj.Status.(*kstatus.WrappedStatus).Mutate(func(s config.Status) config.Status {
 		gs := s.(*k8s.GatewayStatus)
 		addressesToReport := external
@@ -2246,7 +2459,9 @@ func getNamespaceLabelReferences(routes *k8s.AllowedRoutes) []string {
 	return res
 }
 
-func buildListener(r configContext, obj config.Config, l k8s.Listener, listenerIndex int, controllerName k8s.GatewayController) (*istio.Server, bool) {
+func buildListener(r configContext, obj config.Config, l k8s.Listener, listenerIndex int, controllerName k8s.GatewayController,
+	ipAccess *ipAccessPolicy, portConflict *listenerPortConflict, chainConflict *ConfigError,
+) (*istio.Server, bool) {
 	listenerConditions := map[string]*condition{
 		string(k8sv1.ListenerConditionAccepted): {
 			reason:  string(k8sv1.ListenerReasonAccepted),
@@ -2277,6 +2492,50 @@ func buildListener(r configContext, obj config.Config, l k8s.Listener, listenerI
 		}
 		ok = false
 	}
+	if l.Hostname != nil {
+		if err := validateHostname(*l.Hostname); err != nil {
+			listenerConditions[string(k8sv1.ListenerConditionAccepted)].error = &ConfigError{
+				Reason:  InvalidHostname,
+				Message: err.Error(),
+			}
+			listenerConditions[string(k8sv1.ListenerConditionProgrammed)].error = &ConfigError{
+				Reason:  string(k8sv1.GatewayReasonInvalid),
+				Message: "Invalid hostname",
+			}
+			ok = false
+		}
+	}
+	if portConflict != nil {
+		reason := k8sv1.ListenerReasonPortUnavailable
+		if portConflict.protocol {
+			reason = k8sv1.ListenerReasonProtocolConflict
+		}
+		listenerConditions[string(k8sv1.ListenerConditionConflicted)].error = &ConfigError{
+			Reason:  string(reason),
+			Message: portConflict.message,
+		}
+		listenerConditions[string(k8sv1.ListenerConditionAccepted)].error = &ConfigError{
+			Reason:  string(k8sv1.ListenerReasonPortUnavailable),
+			Message: portConflict.message,
+		}
+		listenerConditions[string(k8sv1.ListenerConditionProgrammed)].error = &ConfigError{
+			Reason:  string(k8sv1.GatewayReasonInvalid),
+			Message: "Listener port conflict",
+		}
+		ok = false
+	} else if chainConflict != nil {
+		listenerConditions[string(k8sv1.ListenerConditionConflicted)].error = chainConflict
+		listenerConditions[string(k8sv1.ListenerConditionAccepted)].error = chainConflict
+		listenerConditions[string(k8sv1.ListenerConditionProgrammed)].error = &ConfigError{
+			Reason:  string(k8sv1.GatewayReasonInvalid),
+			Message: "Listener policy conflict",
+		}
+		ok = false
+	}
+	if ipAccess != nil && ipAccess.err != nil && listenerConditions[string(k8sv1.ListenerConditionResolvedRefs)].error == nil {
+		// The listener is still programmed, an invalid policy denying all of its connections
+		listenerConditions[string(k8sv1.ListenerConditionResolvedRefs)].error = ipAccess.err
+	}
 	hostnames := buildHostnameMatch(obj.Namespace, r.GatewayResources, l)
 	server := &istio.Server{
 		Port: &istio.Port{
@@ -2311,7 +2570,7 @@ func buildTLS(ctx configContext, tls *k8s.GatewayTLSConfig, gw config.Config, is
 		return nil, nil
 	}
 	// Explicitly not supported: file mounted
-	// Not yet implemented: TLS mode, https redirect, max protocol version, SANs, CipherSuites, VerifyCertificate
+	// Not yet implemented: TLS mode, https redirect, SANs, VerifyCertificate
 	out := &istio.ServerTLSSettings{
 		HttpsRedirect: false,
 	}
@@ -2326,6 +2585,9 @@ func buildTLS(ctx configContext, tls *k8s.GatewayTLSConfig, gw config.Config, is
 		if tls.Options != nil && tls.Options[gatewayTLSTerminateModeKey] == "MUTUAL" {
 			out.Mode = istio.ServerTLSSettings_MUTUAL
 		}
+		if err := buildTLSOptions(out, tls.Options); err != nil {
+			return out, err
+		}
 		if len(tls.CertificateRefs) != 1 {
 			// This is required in the API, should be rejected in validation
 			return out, &ConfigError{Reason: InvalidTLS, Message: "exactly 1 certificateRefs should be present for TLS termination"}
@@ -2346,6 +2608,9 @@ func buildTLS(ctx configContext, tls *k8s.GatewayTLSConfig, gw config.Config, is
 			}
 		}
 		out.CredentialName = cred
+		if err := buildTLSClientValidation(ctx, out, tls.Options, gw); err != nil {
+			return out, err
+		}
 	case k8sv1.TLSModePassthrough:
 		out.Mode = istio.ServerTLSSettings_PASSTHROUGH
 		if isAutoPassthrough {
@@ -2355,6 +2620,88 @@ func buildTLS(ctx configContext, tls *k8s.GatewayTLSConfig, gw config.Config, is
 	return out, nil
 }
 
+// buildTLSOptions applies the TLS options of a terminating listener which are not part of the Gateway API.
+// ALPN protocols are only validated here, as the Istio API has no field for them; see listenerALPNProtocols.
+func buildTLSOptions(out *istio.ServerTLSSettings, options map[k8sv1.AnnotationKey]k8sv1.AnnotationValue) *ConfigError {
+	if v, f := options[gatewayTLSMinVersionKey]; f {
+		version, err := parseTLSVersion(string(v))
+		if err != nil {
+			return &ConfigError{Reason: InvalidTLS, Message: fmt.Sprintf("invalid %s: %v", gatewayTLSMinVersionKey, err)}
+		}
+		out.MinProtocolVersion = version
+	}
+	if v, f := options[gatewayTLSMaxVersionKey]; f {
+		version, err := parseTLSVersion(string(v))
+		if err != nil {
+			return &ConfigError{Reason: InvalidTLS, Message: fmt.Sprintf("invalid %s: %v", gatewayTLSMaxVersionKey, err)}
+		}
+		out.MaxProtocolVersion = version
+	}
+	if out.MinProtocolVersion != istio.ServerTLSSettings_TLS_AUTO && out.MaxProtocolVersion != istio.ServerTLSSettings_TLS_AUTO &&
+		out.MinProtocolVersion > out.MaxProtocolVersion {
+		return &ConfigError{
+			Reason:  InvalidTLS,
+			Message: fmt.Sprintf("%s %v is higher than %s %v", gatewayTLSMinVersionKey, out.MinProtocolVersion, gatewayTLSMaxVersionKey, out.MaxProtocolVersion),
+		}
+	}
+	if v, f := options[gatewayTLSCipherSuitesKey]; f {
+		for _, cs := range splitTLSOption(string(v)) {
+			if cs == "" || !security.IsValidCipherSuite(cs) {
+				return &ConfigError{Reason: InvalidTLS, Message: fmt.Sprintf("invalid %s: unsupported cipher suite %q", gatewayTLSCipherSuitesKey, cs)}
+			}
+			out.CipherSuites = append(out.CipherSuites, cs)
+		}
+	}
+	if v, f := options[gatewayTLSALPNProtocolsKey]; f {
+		if _, err := parseALPNProtocols(string(v)); err != nil {
+			return &ConfigError{Reason: InvalidTLS, Message: fmt.Sprintf("invalid %s: %v", gatewayTLSALPNProtocolsKey, err)}
+		}
+	}
+	return nil
+}
+
+// parseTLSVersion parses a TLS version named like the Istio API, for example TLSV1_2.
+func parseTLSVersion(s string) (istio.ServerTLSSettings_TLSProtocol, error) {
+	v, f := istio.ServerTLSSettings_TLSProtocol_value[strings.ToUpper(strings.TrimSpace(s))]
+	if !f {
+		return istio.ServerTLSSettings_TLS_AUTO, fmt.Errorf("unknown TLS version %q, expected one of TLSV1_0, TLSV1_1, TLSV1_2 or TLSV1_3", s)
+	}
+	return istio.ServerTLSSettings_TLSProtocol(v), nil
+}
+
+// parseALPNProtocols parses a comma separated list of ALPN protocols.
+func parseALPNProtocols(s string) ([]string, error) {
+	protocols := splitTLSOption(s)
+	for _, p := range protocols {
+		// ALPN protocol IDs are 1 to 255 bytes long
+		if p == "" || len(p) > 255 {
+			return nil, fmt.Errorf("invalid ALPN protocol %q", p)
+		}
+	}
+	return protocols, nil
+}
+
+// listenerALPNProtocols returns the ALPN protocols set on a terminating listener. Invalid protocols are ignored, as they
+// are reported when building the TLS settings of the listener.
+func listenerALPNProtocols(l k8s.Listener) []string {
+	if l.TLS == nil || (l.TLS.Mode != nil && *l.TLS.Mode != k8sv1.TLSModeTerminate) {
+		return nil
+	}
+	v, f := l.TLS.Options[gatewayTLSALPNProtocolsKey]
+	if !f {
+		return nil
+	}
+	protocols, err := parseALPNProtocols(string(v))
+	if err != nil {
+		return nil
+	}
+	return protocols
+}
+
+func splitTLSOption(s string) []string {
+	return slices.Map(strings.Split(s, ","), strings.TrimSpace)
+}
+
 func buildSecretReference(ctx configContext, ref k8s.SecretObjectReference, gw config.Config) (string, *ConfigError) {
 	if !nilOrEqual((*string)(ref.Group), gvk.Secret.Group) || !nilOrEqual((*string)(ref.Kind), gvk.Secret.Kind) {
 		return "", &ConfigError{Reason: InvalidTLS, Message: fmt.Sprintf("invalid certificate reference %v, only secret is allowed", objectReferenceString(ref))}
@@ -2397,6 +2744,15 @@ func objectReferenceString(ref k8s.SecretObjectReference) string {
 		ptr.OrEmpty(ref.Namespace))
 }
 
+// parentName returns a readable <namespace>/<name>[/<section>] name for a parent reference.
+func parentName(ref k8s.ParentReference, localNamespace string) string {
+	name := fmt.Sprintf("%s/%s", ptr.OrDefault(ref.Namespace, k8s.Namespace(localNamespace)), ref.Name)
+	if ref.SectionName != nil {
+		name += "/" + string(*ref.SectionName)
+	}
+	return name
+}
+
 func parentRefString(ref k8s.ParentReference) string {
 	return fmt.Sprintf("%s/%s/%s/%s/%d.%s",
 		ptr.OrEmpty(ref.Group),
@@ -27,6 +27,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
 	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/yaml"
 
@@ -359,9 +361,6 @@ D2lWusoe2/nEqfDVVWGWlyJ7yOmqaVm/iNUN9B2N2g==
 func init() {
 	features.EnableAlphaGatewayAPI = true
 	features.EnableAmbientControllers = true
-	// Recompute with ambient enabled
-	classInfos = getClassInfos()
-	builtinClasses = getBuiltinClasses()
 }
 
 func TestConvertResources(t *testing.T) {
@@ -1293,6 +1292,80 @@ func TestHumanReadableJoin(t *testing.T) {
 	}
 }
 
+func TestBuildTLSOptions(t *testing.T) {
+	cases := []struct {
+		name    string
+		options map[k8sv1.AnnotationKey]k8sv1.AnnotationValue
+		want    *istio.ServerTLSSettings
+		wantErr bool
+	}{
+		{
+			name: "no options",
+			want: &istio.ServerTLSSettings{},
+		},
+		{
+			name: "all options",
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{
+				gatewayTLSMinVersionKey:    "TLSV1_2",
+				gatewayTLSMaxVersionKey:    "tlsv1_3",
+				gatewayTLSCipherSuitesKey:  "ECDHE-ECDSA-AES256-GCM-SHA384, ECDHE-RSA-AES256-GCM-SHA384",
+				gatewayTLSALPNProtocolsKey: "h2,http/1.1",
+			},
+			want: &istio.ServerTLSSettings{
+				MinProtocolVersion: istio.ServerTLSSettings_TLSV1_2,
+				MaxProtocolVersion: istio.ServerTLSSettings_TLSV1_3,
+				CipherSuites:       []string{"ECDHE-ECDSA-AES256-GCM-SHA384", "ECDHE-RSA-AES256-GCM-SHA384"},
+			},
+		},
+		{
+			name:    "unknown version",
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{gatewayTLSMinVersionKey: "1.2"},
+			wantErr: true,
+		},
+		{
+			name: "min version higher than max version",
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{
+				gatewayTLSMinVersionKey: "TLSV1_3",
+				gatewayTLSMaxVersionKey: "TLSV1_2",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unknown cipher suite",
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{gatewayTLSCipherSuitesKey: "ECDHE-RSA-AES256-GCM-SHA384,NULL-SHA"},
+			wantErr: true,
+		},
+		{
+			name:    "empty ALPN protocol",
+			options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{gatewayTLSALPNProtocolsKey: "h2,"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &istio.ServerTLSSettings{}
+			err := buildTLSOptions(out, tt.options)
+			assert.Equal(t, err != nil, tt.wantErr)
+			if !tt.wantErr {
+				assert.Equal(t, out, tt.want)
+			}
+		})
+	}
+}
+
+func TestListenerALPNProtocols(t *testing.T) {
+	listener := func(mode k8sv1.TLSModeType, alpn string) k8s.Listener {
+		return k8s.Listener{TLS: &k8s.GatewayTLSConfig{
+			Mode:    &mode,
+			Options: map[k8sv1.AnnotationKey]k8sv1.AnnotationValue{gatewayTLSALPNProtocolsKey: k8sv1.AnnotationValue(alpn)},
+		}}
+	}
+	assert.Equal(t, listenerALPNProtocols(k8s.Listener{}), nil)
+	assert.Equal(t, listenerALPNProtocols(listener(k8sv1.TLSModeTerminate, "h2, http/1.1")), []string{"h2", "http/1.1"})
+	assert.Equal(t, listenerALPNProtocols(listener(k8sv1.TLSModePassthrough, "h2")), nil)
+	assert.Equal(t, listenerALPNProtocols(listener(k8sv1.TLSModeTerminate, ",")), nil)
+}
+
 func BenchmarkBuildHTTPVirtualServices(b *testing.B) {
 	ports := []*model.Port{
 		{
@@ -1341,8 +1414,11 @@ func BenchmarkBuildHTTPVirtualServices(b *testing.B) {
 	kr := splitInput(b, input)
 	kr.Context = NewGatewayContext(cg.PushContext())
 	ctx := configContext{
-		GatewayResources:  kr,
-		AllowedReferences: convertReferencePolicies(kr),
+		GatewayResources:   kr,
+		AllowedReferences:  convertReferencePolicies(kr),
+		deniedRouteParents: map[types.NamespacedName][]DeniedRouteParent{},
+
+		routeGatewayNamespaces: map[types.NamespacedName]sets.String{},
 	}
 	_, gwMap, _ := convertGateways(ctx)
 	ctx.GatewayReferences = gwMap
@@ -1445,3 +1521,42 @@ func TestExtractGatewayServices(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckBackendProtocol(t *testing.T) {
+	svc := &model.Service{
+		Hostname: "httpbin.default.svc.domain.suffix",
+		Ports: model.PortList{
+			{Name: "http", Port: 80, Protocol: "HTTP"},
+			{Name: "https", Port: 443, Protocol: "HTTPS"},
+			{Name: "grpc", Port: 9000, Protocol: "GRPC"},
+			{Name: "tcp", Port: 34000, Protocol: "TCP"},
+			{Name: "mongo", Port: 27017, Protocol: "Mongo"},
+			{Name: "sniffed", Port: 8080, Protocol: "UnsupportedProtocol"},
+		},
+	}
+	cases := []struct {
+		port    int
+		kind    config.GroupVersionKind
+		invalid bool
+	}{
+		{port: 80, kind: gvk.HTTPRoute},
+		{port: 443, kind: gvk.HTTPRoute},
+		{port: 9000, kind: gvk.GRPCRoute},
+		{port: 8080, kind: gvk.HTTPRoute},
+		{port: 1234, kind: gvk.HTTPRoute},
+		{port: 34000, kind: gvk.HTTPRoute, invalid: true},
+		{port: 27017, kind: gvk.GRPCRoute, invalid: true},
+		{port: 34000, kind: gvk.TCPRoute},
+	}
+	for _, tt := range cases {
+		t.Run(fmt.Sprintf("%s/%d", tt.kind.Kind, tt.port), func(t *testing.T) {
+			err := checkBackendProtocol(svc, tt.port, tt.kind)
+			if !tt.invalid {
+				assert.Equal(t, err, nil)
+				return
+			}
+			assert.Equal(t, err.Reason, InvalidDestinationProtocol)
+			assert.Equal(t, isInvalidBackend(err), true)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/yaml"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/schema/kind"
+)
+
+const (
+	// gatewayExtensionLabel marks a ConfigMap as an extension that can be referenced by an ExtensionRef
+	// filter. Only ConfigMaps with this label are watched; the value is the type of the extension.
+	gatewayExtensionLabel = "gateway.istio.io/extension"
+	// corsExtension is the gatewayExtensionLabel value of a CORS policy. The policy is read from the
+	// corsExtension key of the ConfigMap data.
+	corsExtension = "cors"
+)
+
+// corsConfig is the format of a CORS policy stored in an extension ConfigMap.
+type corsConfig struct {
+	// AllowOrigins lists the origins allowed to make requests. Entries prefixed with "regex:" are
+	// matched as regular expressions, "*" allows any origin, and anything else is an exact match.
+	AllowOrigins     []string `json:"allowOrigins,omitempty"`
+	AllowMethods     []string `json:"allowMethods,omitempty"`
+	AllowHeaders     []string `json:"allowHeaders,omitempty"`
+	ExposeHeaders    []string `json:"exposeHeaders,omitempty"`
+	MaxAge           string   `json:"maxAge,omitempty"`
+	AllowCredentials *bool    `json:"allowCredentials,omitempty"`
+}
+
+// routeExtension is the outcome of an ExtensionRef filter of a route.
+type routeExtension struct {
+	cors        *istio.CorsPolicy
+	retries     *istio.HTTPRetry
+	retryBudget *model.RetryBudget
+}
+
+// createExtensionRefFilter resolves an ExtensionRef filter of a route. The supported extensions are a CORS policy
+// and a retry budget, stored in a ConfigMap in the namespace of the route.
+func createExtensionRefFilter(ctx configContext, ref *k8sv1.LocalObjectReference, obj config.Config) (*routeExtension, *ConfigError) {
+	if ref == nil {
+		return nil, &ConfigError{Reason: InvalidFilter, Message: "extensionRef must be set"}
+	}
+	if string(ref.Group) != gvk.ConfigMap.Group || string(ref.Kind) != gvk.ConfigMap.Kind {
+		return nil, &ConfigError{
+			Reason:  InvalidFilter,
+			Message: fmt.Sprintf("unsupported extensionRef %s/%s, only ConfigMap is allowed", ref.Group, ref.Kind),
+		}
+	}
+
+	// Track the reference even if the ConfigMap does not exist yet, so creating it updates the route.
+	key := model.ConfigKey{Kind: kind.ConfigMap, Name: string(ref.Name), Namespace: obj.Namespace}
+	ctx.resourceReferences[key] = append(ctx.resourceReferences[key], model.ConfigKey{
+		Kind:      kind.HTTPRoute,
+		Namespace: obj.Namespace,
+		Name:      obj.Name,
+	})
+
+	cm := ctx.ExtensionConfigMaps[types.NamespacedName{Namespace: obj.Namespace, Name: string(ref.Name)}]
+	if cm == nil {
+		return nil, &ConfigError{
+			Reason:  InvalidFilter,
+			Message: fmt.Sprintf("extensionRef ConfigMap %s/%s not found", obj.Namespace, ref.Name),
+		}
+	}
+	switch t := cm.Labels[gatewayExtensionLabel]; t {
+	case corsExtension:
+		cors, err := buildCorsPolicy(cm)
+		if err != nil {
+			return nil, &ConfigError{
+				Reason:  InvalidFilter,
+				Message: fmt.Sprintf("invalid CORS policy in ConfigMap %s/%s: %v", cm.Namespace, cm.Name, err),
+			}
+		}
+		return &routeExtension{cors: cors}, nil
+	case retryBudgetExtension:
+		retries, budget, err := buildRetryBudget(cm)
+		if err != nil {
+			return nil, &ConfigError{
+				Reason:  InvalidFilter,
+				Message: fmt.Sprintf("invalid retry budget in ConfigMap %s/%s: %v", cm.Namespace, cm.Name, err),
+			}
+		}
+		return &routeExtension{retries: retries, retryBudget: budget}, nil
+	default:
+		return nil, &ConfigError{
+			Reason:  InvalidFilter,
+			Message: fmt.Sprintf("unsupported extension type %q for ConfigMap %s/%s", t, cm.Namespace, cm.Name),
+		}
+	}
+}
+
+// buildCorsPolicy converts the CORS policy of an extension ConfigMap into an Istio CorsPolicy.
+func buildCorsPolicy(cm *corev1.ConfigMap) (*istio.CorsPolicy, error) {
+	data, f := cm.Data[corsExtension]
+	if !f {
+		return nil, fmt.Errorf("missing %q key", corsExtension)
+	}
+	cfg := corsConfig{}
+	if err := yaml.UnmarshalStrict([]byte(data), &cfg); err != nil {
+		return nil, err
+	}
+	if len(cfg.AllowOrigins) == 0 {
+		return nil, fmt.Errorf("allowOrigins must be set")
+	}
+
+	out := &istio.CorsPolicy{
+		AllowMethods:  cfg.AllowMethods,
+		AllowHeaders:  cfg.AllowHeaders,
+		ExposeHeaders: cfg.ExposeHeaders,
+	}
+	for _, origin := range cfg.AllowOrigins {
+		out.AllowOrigins = append(out.AllowOrigins, createOriginMatch(origin))
+	}
+	if cfg.MaxAge != "" {
+		maxAge, err := time.ParseDuration(cfg.MaxAge)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maxAge: %v", err)
+		}
+		out.MaxAge = durationpb.New(maxAge)
+	}
+	if cfg.AllowCredentials != nil {
+		out.AllowCredentials = wrapperspb.Bool(*cfg.AllowCredentials)
+	}
+	return out, nil
+}
+
+func createOriginMatch(origin string) *istio.StringMatch {
+	if origin == "*" {
+		return &istio.StringMatch{MatchType: &istio.StringMatch_Regex{Regex: ".*"}}
+	}
+	if regex, f := strings.CutPrefix(origin, "regex:"); f {
+		return &istio.StringMatch{MatchType: &istio.StringMatch_Regex{Regex: regex}}
+	}
+	return &istio.StringMatch{MatchType: &istio.StringMatch_Exact{Exact: origin}}
+}
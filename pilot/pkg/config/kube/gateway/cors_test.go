@@ -0,0 +1,153 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/schema/kind"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func corsConfigMap(extension string, data string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cors",
+			Namespace: "default",
+			Labels:    map[string]string{gatewayExtensionLabel: extension},
+		},
+		Data: map[string]string{corsExtension: data},
+	}
+}
+
+func TestBuildCorsPolicy(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    string
+		want    *istio.CorsPolicy
+		wantErr bool
+	}{
+		{
+			name: "full",
+			data: `
+allowOrigins: ["https://example.com", "regex:https://.*\\.example\\.com", "*"]
+allowMethods: [GET, POST]
+allowHeaders: [x-custom]
+exposeHeaders: [x-exposed]
+maxAge: 24h
+allowCredentials: true
+`,
+			want: &istio.CorsPolicy{
+				AllowOrigins: []*istio.StringMatch{
+					{MatchType: &istio.StringMatch_Exact{Exact: "https://example.com"}},
+					{MatchType: &istio.StringMatch_Regex{Regex: `https://.*\.example\.com`}},
+					{MatchType: &istio.StringMatch_Regex{Regex: ".*"}},
+				},
+				AllowMethods:     []string{"GET", "POST"},
+				AllowHeaders:     []string{"x-custom"},
+				ExposeHeaders:    []string{"x-exposed"},
+				MaxAge:           durationpb.New(24 * time.Hour),
+				AllowCredentials: wrapperspb.Bool(true),
+			},
+		},
+		{
+			name: "origins only",
+			data: `allowOrigins: ["https://example.com"]`,
+			want: &istio.CorsPolicy{
+				AllowOrigins: []*istio.StringMatch{
+					{MatchType: &istio.StringMatch_Exact{Exact: "https://example.com"}},
+				},
+			},
+		},
+		{
+			name:    "missing origins",
+			data:    `allowMethods: [GET]`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid max age",
+			data:    `{allowOrigins: ["*"], maxAge: forever}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    `{allowOrigins: ["*"], allowOrigin: "*"}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildCorsPolicy(corsConfigMap(corsExtension, tt.data))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestCreateExtensionRefFilter(t *testing.T) {
+	route := config.Config{Meta: config.Meta{GroupVersionKind: gvk.HTTPRoute, Name: "route", Namespace: "default"}}
+	ref := &k8sv1.LocalObjectReference{Group: "", Kind: "ConfigMap", Name: "cors"}
+	newContext := func(cms ...*corev1.ConfigMap) configContext {
+		ctx := configContext{
+			GatewayResources:   GatewayResources{ExtensionConfigMaps: map[types.NamespacedName]*corev1.ConfigMap{}},
+			resourceReferences: map[model.ConfigKey][]model.ConfigKey{},
+		}
+		for _, cm := range cms {
+			ctx.ExtensionConfigMaps[config.NamespacedName(cm)] = cm
+		}
+		return ctx
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		ctx := newContext(corsConfigMap(corsExtension, `allowOrigins: ["https://example.com"]`))
+		ext, err := createExtensionRefFilter(ctx, ref, route)
+		assert.Equal(t, err, nil)
+		assert.Equal(t, len(ext.cors.AllowOrigins), 1)
+		assert.Equal(t, ctx.resourceReferences, map[model.ConfigKey][]model.ConfigKey{
+			{Kind: kind.ConfigMap, Name: "cors", Namespace: "default"}: {{Kind: kind.HTTPRoute, Name: "route", Namespace: "default"}},
+		})
+	})
+	t.Run("missing configmap is still tracked", func(t *testing.T) {
+		ctx := newContext()
+		_, err := createExtensionRefFilter(ctx, ref, route)
+		assert.Equal(t, err.Reason, InvalidFilter)
+		assert.Equal(t, len(ctx.resourceReferences), 1)
+	})
+	t.Run("unsupported kind", func(t *testing.T) {
+		_, err := createExtensionRefFilter(newContext(), &k8sv1.LocalObjectReference{Group: "example.com", Kind: "Cors", Name: "cors"}, route)
+		assert.Equal(t, err.Reason, InvalidFilter)
+	})
+	t.Run("unsupported extension type", func(t *testing.T) {
+		ctx := newContext(corsConfigMap("ratelimit", `allowOrigins: ["*"]`))
+		_, err := createExtensionRefFilter(ctx, ref, route)
+		assert.Equal(t, err.Reason, InvalidFilter)
+	})
+}
@@ -20,7 +20,10 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,6 +39,7 @@ import (
 	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pkg/cluster"
+	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/config/constants"
 	"istio.io/istio/pkg/config/protocol"
 	"istio.io/istio/pkg/config/schema/gvk"
@@ -44,6 +48,8 @@ import (
 	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/inject"
 	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/kubetypes"
+	"istio.io/istio/pkg/kube/namespace"
 	istiolog "istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/test/util/tmpl"
 	"istio.io/istio/pkg/test/util/yml"
@@ -88,8 +94,23 @@ type DeploymentController struct {
 	services        kclient.Client[*corev1.Service]
 	serviceAccounts kclient.Client[*corev1.ServiceAccount]
 	namespaces      kclient.Client[*corev1.Namespace]
+	configMaps      kclient.Client[*corev1.ConfigMap]
+	httpRoutes      kclient.Client[*gateway.HTTPRoute]
 	revision        string
 	defaultLabels   map[string]string
+	// identity determines the GatewayClasses owned by the controller, shared with the gateway controller
+	identity *Identity
+
+	// monitoring watches the monitoring resources of the Prometheus operator, whose CRDs may not be installed
+	monitoring []kclient.Informer[controllers.Object]
+
+	// members holds the namespaces of the member roll instead of namespaces in multi-tenant mode
+	members *namespace.MemberNamespaces
+
+	// violations are the Gateways exceeding their quotas. They are determined for all the Gateways at once, and
+	// again after a Gateway, a namespace or a class changes. Access is guarded by violationsMu.
+	violations   map[types.NamespacedName]string
+	violationsMu sync.Mutex
 }
 
 // Patcher is a function that abstracts patching logic. This is largely because client-go fakes do not handle patching
@@ -114,11 +135,7 @@ type classInfo struct {
 	addressType gateway.AddressType
 }
 
-var classInfos = getClassInfos()
-
-var builtinClasses = getBuiltinClasses()
-
-func getBuiltinClasses() map[gateway.ObjectName]gateway.GatewayController {
+func getBuiltinClasses(defaultClassName gateway.ObjectName, controllerName gateway.GatewayController) map[gateway.ObjectName]gateway.GatewayController {
 	res := map[gateway.ObjectName]gateway.GatewayController{
 		defaultClassName:                 controllerName,
 		constants.RemoteGatewayClassName: constants.UnmanagedGatewayController,
@@ -129,7 +146,7 @@ func getBuiltinClasses() map[gateway.ObjectName]gateway.GatewayController {
 	return res
 }
 
-func getClassInfos() map[gateway.GatewayController]classInfo {
+func getClassInfos(controllerName gateway.GatewayController) map[gateway.GatewayController]classInfo {
 	m := map[gateway.GatewayController]classInfo{
 		controllerName: {
 			controller:         string(controllerName),
@@ -160,9 +177,10 @@ func getClassInfos() map[gateway.GatewayController]classInfo {
 }
 
 // NewDeploymentController constructs a DeploymentController and registers required informers.
-// The controller will not start until Run() is called.
+// The controller will not start until Run() is called. The identity is the one of the gateway controller, see
+// Controller.Identity.
 func NewDeploymentController(client kube.Client, clusterID cluster.ID, env *model.Environment,
-	webhookConfig func() inject.WebhookConfig, injectionHandler func(fn func()), revision string,
+	webhookConfig func() inject.WebhookConfig, injectionHandler func(fn func()), revision string, identity *Identity,
 ) *DeploymentController {
 	dc := &DeploymentController{
 		client:    client,
@@ -174,12 +192,13 @@ func NewDeploymentController(client kube.Client, clusterID cluster.ID, env *mode
 			t := true
 			_, err := c.Patch(context.Background(), name, types.ApplyPatchType, data, metav1.PatchOptions{
 				Force:        &t,
-				FieldManager: string(controllerName),
+				FieldManager: string(identity.load().controllerName),
 			}, subresources...)
 			return err
 		},
 		injectConfig: webhookConfig,
 		revision:     revision,
+		identity:     identity,
 	}
 
 	dc.queue = controllers.NewQueue("gateway deployment",
@@ -203,12 +222,38 @@ func NewDeploymentController(client kube.Client, clusterID cluster.ID, env *mode
 	dc.serviceAccounts.AddEventHandler(parentHandler)
 	dc.clients[gvr.ServiceAccount] = NewUntypedWrapper(dc.serviceAccounts)
 
+	// The monitoring resources are only generated for the classes requesting them, so they are watched once their
+	// CRDs are installed. Without a CRD watcher, they are applied without checking who manages them.
+	if client.CrdWatcher() != nil {
+		for _, r := range []schema.GroupVersionResource{serviceMonitorGVR, prometheusRuleGVR} {
+			inf := kclient.NewDelayedInformer[controllers.Object](client, r, kubetypes.DynamicInformer, kclient.Filter{})
+			inf.AddEventHandler(parentHandler)
+			dc.clients[r] = UntypedWrapper[controllers.Object]{inf}
+			dc.monitoring = append(dc.monitoring, inf)
+		}
+	}
+
+	// Routes change the hostnames published for external-dns on the Service of the gateways they are attached to
+	dc.httpRoutes = kclient.New[*gateway.HTTPRoute](client)
+	dc.httpRoutes.AddEventHandler(dc.enqueueRouteParents(httpRouteAttachment))
+
 	dc.gateways = kclient.New[*gateway.Gateway](client)
-	dc.gateways.AddEventHandler(controllers.ObjectHandler(dc.queue.AddObject))
+	dc.gateways.AddEventHandler(controllers.FromEventHandler(func(e controllers.Event) {
+		dc.invalidateQuotas()
+		if e.Event != controllers.EventDelete {
+			dc.queue.AddObject(e.Latest())
+			return
+		}
+		// Removing a gateway may release quota to the gateways exceeding it
+		for _, gw := range dc.gateways.List(metav1.NamespaceAll, klabels.Everything()) {
+			dc.queue.AddObject(gw)
+		}
+	}))
 
 	if !client.IsMultiTenant() {
 		dc.namespaces = kclient.New[*corev1.Namespace](client)
 		dc.namespaces.AddEventHandler(controllers.ObjectHandler(func(o controllers.Object) {
+			dc.invalidateQuotas()
 			// TODO: make this more intelligent, checking if something we care about has changed
 			// requeue this namespace
 			for _, gw := range dc.gateways.List(o.GetName(), klabels.Everything()) {
@@ -217,12 +262,42 @@ func NewDeploymentController(client kube.Client, clusterID cluster.ID, env *mode
 		}))
 		dc.gatewayClasses = kclient.New[*gateway.GatewayClass](client)
 		dc.gatewayClasses.AddEventHandler(controllers.ObjectHandler(func(o controllers.Object) {
+			dc.invalidateQuotas()
 			for _, g := range dc.gateways.List(metav1.NamespaceAll, klabels.Everything()) {
 				if string(g.Spec.GatewayClassName) == o.GetName() {
 					dc.queue.AddObject(g)
 				}
 			}
 		}))
+		// The parameters of the classes configure the termination of the generated deployments
+		dc.configMaps = kclient.NewFiltered[*corev1.ConfigMap](client, kclient.Filter{LabelSelector: gatewayExtensionLabel})
+		dc.configMaps.AddEventHandler(controllers.ObjectHandler(func(o controllers.Object) {
+			for _, gc := range dc.gatewayClasses.List(metav1.NamespaceAll, klabels.Everything()) {
+				ref := gc.Spec.ParametersRef
+				if ref == nil || ref.Name != o.GetName() || ref.Namespace == nil || string(*ref.Namespace) != o.GetNamespace() {
+					continue
+				}
+				for _, g := range dc.gateways.List(metav1.NamespaceAll, klabels.Everything()) {
+					if string(g.Spec.GatewayClassName) == gc.Name {
+						dc.queue.AddObject(g)
+					}
+				}
+			}
+		}))
+	}
+
+	if client.IsMultiTenant() {
+		// GatewayClasses are cluster-scoped, so they cannot be watched in multi-tenant mode, as in the crd controller
+		log.Infof("GatewayClass parameters are not supported in multi-tenant mode, " +
+			"the deployment settings of the parametersRef of the classes are ignored")
+		// The annotations of the members set their quotas, as in the gateway controller
+		dc.members = namespace.NewMemberNamespaces(client.Kube(), client.GetMemberRollController(), "gateway-deployment-controller")
+		dc.members.AddHandler(func(ns string) {
+			dc.invalidateQuotas()
+			for _, gw := range dc.gateways.List(ns, klabels.Everything()) {
+				dc.queue.AddObject(gw)
+			}
+		})
 	}
 
 	// On injection template change, requeue all gateways
@@ -244,15 +319,34 @@ func NewDeploymentController(client kube.Client, clusterID cluster.ID, env *mode
 }
 
 func (d *DeploymentController) Run(stop <-chan struct{}) {
-	syncFuncs := []cache.InformerSynced{d.deployments.HasSynced, d.services.HasSynced, d.serviceAccounts.HasSynced, d.gateways.HasSynced}
-	shutdownFuncs := []controllers.Shutdowner{d.deployments, d.services, d.serviceAccounts, d.gateways}
+	syncFuncs := []cache.InformerSynced{
+		d.deployments.HasSynced, d.services.HasSynced, d.serviceAccounts.HasSynced, d.gateways.HasSynced, d.httpRoutes.HasSynced,
+	}
+	shutdownFuncs := []controllers.Shutdowner{d.deployments, d.services, d.serviceAccounts, d.gateways, d.httpRoutes}
+	for _, inf := range d.monitoring {
+		syncFuncs = append(syncFuncs, inf.HasSynced)
+		shutdownFuncs = append(shutdownFuncs, inf)
+	}
 	if !d.client.IsMultiTenant() {
-		syncFuncs = append(syncFuncs, d.namespaces.HasSynced, d.gatewayClasses.HasSynced)
-		shutdownFuncs = append(shutdownFuncs, d.namespaces, d.gatewayClasses)
+		syncFuncs = append(syncFuncs, d.namespaces.HasSynced, d.gatewayClasses.HasSynced, d.configMaps.HasSynced)
+		shutdownFuncs = append(shutdownFuncs, d.namespaces, d.gatewayClasses, d.configMaps)
+	} else {
+		syncFuncs = append(syncFuncs, d.members.HasSynced)
 	}
 	kube.WaitForCacheSync("deployment controller", stop, syncFuncs...)
+	// The GatewayClasses owned by the controller may change at runtime
+	removeIdentityHandler := d.identity.onChange(func() {
+		d.invalidateQuotas()
+		for _, gw := range d.gateways.List(metav1.NamespaceAll, klabels.Everything()) {
+			d.queue.AddObject(gw)
+		}
+	})
 	d.queue.Run(stop)
+	removeIdentityHandler()
 	controllers.ShutdownAll(shutdownFuncs...)
+	if d.members != nil {
+		d.members.Shutdown()
+	}
 }
 
 // Reconcile takes in the name of a Gateway and ensures the cluster is in the desired state
@@ -268,6 +362,19 @@ func (d *DeploymentController) Reconcile(req types.NamespacedName) error {
 		return nil
 	}
 
+	ci, f := d.classInfo(*gw)
+	if !f {
+		log.Debugf("skipping unknown class %q", gw.Spec.GatewayClassName)
+		return nil
+	}
+
+	// Matched class, reconcile it
+	return d.configureIstioGateway(log, *gw, ci)
+}
+
+// classInfo returns the class info of the controller of the gateway's class, if the controller is known.
+func (d *DeploymentController) classInfo(gw gateway.Gateway) (classInfo, bool) {
+	id := d.identity.load()
 	var controller gateway.GatewayController
 	var gc *gateway.GatewayClass
 	if d.gatewayClasses != nil {
@@ -276,18 +383,65 @@ func (d *DeploymentController) Reconcile(req types.NamespacedName) error {
 	if gc != nil {
 		controller = gc.Spec.ControllerName
 	} else {
-		if builtin, f := builtinClasses[gw.Spec.GatewayClassName]; f {
+		if builtin, f := id.builtinClasses[gw.Spec.GatewayClassName]; f {
 			controller = builtin
 		}
 	}
-	ci, f := classInfos[controller]
-	if !f {
-		log.Debugf("skipping unknown controller %q", controller)
-		return nil
+	ci, f := id.classInfos[controller]
+	return ci, f
+}
+
+// quotaViolation returns the quota exceeded by the gateway, if any. The gateway controller reports the
+// violation on the status of the gateway, see convertGateways.
+func (d *DeploymentController) quotaViolation(gw gateway.Gateway) (string, bool) {
+	d.violationsMu.Lock()
+	defer d.violationsMu.Unlock()
+	if d.violations == nil {
+		d.violations = d.quotaViolations()
 	}
+	msg, f := d.violations[types.NamespacedName{Name: gw.Name, Namespace: gw.Namespace}]
+	return msg, f
+}
 
-	// Matched class, reconcile it
-	return d.configureIstioGateway(log, *gw, ci)
+// quotaViolations determines the Gateways exceeding their quotas, as gatewayQuotaViolations does for the gateway
+// controller.
+func (d *DeploymentController) quotaViolations() map[types.NamespacedName]string {
+	var candidates []quotaCandidate
+	for _, g := range d.gateways.List(metav1.NamespaceAll, klabels.Everything()) {
+		ci, f := d.classInfo(*g)
+		if !f {
+			continue
+		}
+		meta := config.Meta{
+			Name:              g.Name,
+			Namespace:         g.Namespace,
+			Annotations:       g.Annotations,
+			CreationTimestamp: g.CreationTimestamp.Time,
+		}
+		if c, ok := newQuotaCandidate(meta, &g.Spec, ci); ok {
+			candidates = append(candidates, c)
+		}
+	}
+	return quotaViolations(candidates, tenantGatewayQuota(), func(ns string) gatewayQuota {
+		return namespaceGatewayQuota(d.namespace(ns))
+	})
+}
+
+// invalidateQuotas determines the quota violations again on the next reconciliation.
+func (d *DeploymentController) invalidateQuotas() {
+	d.violationsMu.Lock()
+	defer d.violationsMu.Unlock()
+	d.violations = nil
+}
+
+func (d *DeploymentController) namespace(name string) *corev1.Namespace {
+	if d.members != nil {
+		return d.members.Get(name)
+	}
+	if d.namespaces == nil {
+		return nil
+	}
+	return d.namespaces.Get(name, "")
 }
 
 func (d *DeploymentController) configureIstioGateway(log *istiolog.Scope, gw gateway.Gateway, gi classInfo) error {
@@ -306,36 +460,17 @@ func (d *DeploymentController) configureIstioGateway(log *istiolog.Scope, gw gat
 		log.Debugf("skipping gateway which is managed by controller version %v", existingControllerVersion)
 		return nil
 	}
-	log.Info("reconciling")
-
-	var ns *corev1.Namespace
-	if d.namespaces != nil {
-		ns = d.namespaces.Get(gw.Namespace, "")
-	}
-	proxyUID, proxyGID := inject.GetProxyIDs(ns)
-
-	defaultName := getDefaultName(gw.Name, &gw.Spec)
-
-	serviceType := gi.defaultServiceType
-	if o, f := gw.Annotations[serviceTypeOverride]; f {
-		serviceType = corev1.ServiceType(o)
+	if msg, f := d.quotaViolation(gw); f {
+		log.Infof("skipping gateway: %v", msg)
+		return nil
 	}
-
-	input := TemplateInput{
-		Gateway:        &gw,
-		DeploymentName: model.GetOrDefault(gw.Annotations[gatewayNameOverride], defaultName),
-		ServiceAccount: model.GetOrDefault(gw.Annotations[gatewaySAOverride], defaultName),
-		Ports:          extractServicePorts(gw),
-		ClusterID:      d.clusterID.String(),
-
-		KubeVersion122: kube.IsAtLeastVersion(d.client, 22),
-		Revision:       d.revision,
-		ServiceType:    serviceType,
-		ProxyUID:       proxyUID,
-		ProxyGID:       proxyGID,
+	if gatewayPaused(gw) {
+		log.Infof("skipping gateway: reconciliation paused by the %s annotation", gatewayPausedAnnotation)
+		return nil
 	}
+	log.Info("reconciling")
 
-	d.setDefaultLabels(input.Gateway)
+	input := d.templateInput(gw, gi)
 
 	if overwriteControllerVersion {
 		log.Debugf("write controller version, existing=%v", existingControllerVersion)
@@ -355,11 +490,76 @@ func (d *DeploymentController) configureIstioGateway(log *istiolog.Scope, gw gat
 			return fmt.Errorf("apply failed: %v", err)
 		}
 	}
+	if err := d.deleteStaleMonitoring(input); err != nil {
+		return fmt.Errorf("delete failed: %v", err)
+	}
 
 	log.Info("gateway updated")
 	return nil
 }
 
+// Preview renders the resources the controller would generate for the Gateway, without applying them.
+func (d *DeploymentController) Preview(name types.NamespacedName) ([]string, error) {
+	gw := d.gateways.Get(name.Name, name.Namespace)
+	if gw == nil {
+		return nil, fmt.Errorf("gateway %v not found", name)
+	}
+	gi, f := d.classInfo(*gw)
+	if !f {
+		return nil, fmt.Errorf("gateway class %q is not managed by istiod", gw.Spec.GatewayClassName)
+	}
+	if gi.templates == "" {
+		return nil, fmt.Errorf("gateway class %q does not generate any resources", gw.Spec.GatewayClassName)
+	}
+	if !IsManaged(&gw.Spec) {
+		return nil, fmt.Errorf("gateway %v addresses an existing deployment, no resources are generated", name)
+	}
+	if existing, _, shouldHandle := ManagedGatewayControllerVersion(*gw); !shouldHandle {
+		return nil, fmt.Errorf("gateway %v is managed by controller version %v", name, existing)
+	}
+	if msg, f := d.quotaViolation(*gw); f {
+		return nil, fmt.Errorf("gateway %v is not deployed: %v", name, msg)
+	}
+	// The gateway comes from the informer cache, so work on a copy as default labels are set on it
+	return d.render(gi.templates, d.templateInput(*gw.DeepCopy(), gi))
+}
+
+// templateInput builds the input of the templates generating the resources of the gateway.
+func (d *DeploymentController) templateInput(gw gateway.Gateway, gi classInfo) TemplateInput {
+	proxyUID, proxyGID := inject.GetProxyIDs(d.namespace(gw.Namespace))
+
+	defaultName := getDefaultName(gw.Name, &gw.Spec)
+
+	serviceType := gatewayServiceType(gw.Annotations, gi)
+
+	input := TemplateInput{
+		Gateway:        &gw,
+		DeploymentName: model.GetOrDefault(gw.Annotations[gatewayNameOverride], defaultName),
+		ServiceAccount: model.GetOrDefault(gw.Annotations[gatewaySAOverride], defaultName),
+		Ports:          extractServicePorts(gw),
+		ClusterID:      d.clusterID.String(),
+
+		KubeVersion122: kube.IsAtLeastVersion(d.client, 22),
+		Revision:       d.revision,
+		ServiceType:    serviceType,
+		ProxyUID:       proxyUID,
+		ProxyGID:       proxyGID,
+
+		ServiceAnnotations: d.externalDNSAnnotations(gw),
+		Drain:              d.classDrain(gw),
+		Scheduling:         gatewayScheduling(gw.Name, d.classScheduling(gw)),
+		Environment:        gatewayEnvironment(d.classEnvironment(gw)),
+		Probes:             gatewayProbes(d.classProbes(gw)),
+		Monitoring:         d.classMonitoring(gw),
+		ProxyProtocol:      gatewayProxyProtocol(gw.Annotations, d.classProxyProtocol(gw)),
+	}
+	input.ServiceLabels = gatewayServiceLabels(gw, input.Monitoring)
+	input.ServiceAnnotations = input.ProxyProtocol.withServiceAnnotations(gw.Annotations, input.ServiceAnnotations)
+
+	d.setDefaultLabels(input.Gateway)
+	return input
+}
+
 func (d *DeploymentController) setDefaultLabels(gateway *gateway.Gateway) {
 	for key, value := range d.defaultLabels {
 		if gateway.Labels == nil {
@@ -440,6 +640,20 @@ func (d *DeploymentController) render(templateName string, mi TemplateInput) ([]
 
 	labelToMatch := map[string]string{constants.GatewayNameLabel: mi.Name}
 	proxyConfig := d.env.GetProxyConfigOrDefault(mi.Namespace, labelToMatch, nil, cfg.MeshConfig)
+	if mi.Drain != nil {
+		// Envoy is drained once the pod is deregistered from the load balancers, for the duration of the class if set
+		mi.Drain = mi.Drain.withDrainDuration(proxyConfig.GetTerminationDrainDuration().AsDuration())
+		proxyConfig = proto.Clone(proxyConfig).(*meshapi.ProxyConfig)
+		proxyConfig.TerminationDrainDuration = durationpb.New(mi.Drain.DrainDuration)
+	}
+	if mi.ProxyProtocol != nil {
+		// The listeners of the gateway expect the PROXY protocol header sent by the load balancers
+		proxyConfig = proto.Clone(proxyConfig).(*meshapi.ProxyConfig)
+		if proxyConfig.GatewayTopology == nil {
+			proxyConfig.GatewayTopology = &meshapi.Topology{}
+		}
+		proxyConfig.GatewayTopology.ProxyProtocol = &meshapi.Topology_ProxyProtocolConfiguration{}
+	}
 	input := derivedInput{
 		TemplateInput: mi,
 		ProxyImage: inject.ProxyImage(
@@ -543,6 +757,23 @@ type TemplateInput struct {
 	Revision       string
 	ProxyUID       int64
 	ProxyGID       int64
+	// ServiceAnnotations are the annotations set on the Service in addition to those of the Gateway
+	ServiceAnnotations map[string]string
+	// ServiceLabels are the labels set on the Service in addition to those of the Gateway
+	ServiceLabels map[string]string
+	// Drain configures the termination of the pods behind external load balancers, if set by the GatewayClass
+	Drain *GatewayDrain
+	// Scheduling spreads the pods across zones and nodes, unless overridden by the GatewayClass
+	Scheduling *GatewayScheduling
+	// Environment holds the environment variables, volumes and trust bundles injected by the GatewayClass, if any
+	Environment *GatewayEnvironment
+	// Probes are the probes of the proxy, the defaults being overridden by the GatewayClass
+	Probes *GatewayProbes
+	// Monitoring configures the ServiceMonitor and PrometheusRule of the gateway, if requested by the GatewayClass
+	Monitoring *GatewayMonitoring
+	// ProxyProtocol makes the listeners accept the PROXY protocol sent by the load balancers, if enabled by the
+	// GatewayClass or the Gateway
+	ProxyProtocol *GatewayProxyProtocol
 }
 
 func extractServicePorts(gw gateway.Gateway) []corev1.ServicePort {
@@ -553,24 +784,8 @@ func extractServicePorts(gw gateway.Gateway) []corev1.ServicePort {
 		Port:        int32(15021),
 		AppProtocol: &tcp,
 	})
-	portNums := sets.New[int32]()
-	for i, l := range gw.Spec.Listeners {
-		if portNums.Contains(int32(l.Port)) {
-			continue
-		}
-		portNums.Insert(int32(l.Port))
-		name := string(l.Name)
-		if name == "" {
-			// Should not happen since name is required, but in case an invalid resource gets in...
-			name = fmt.Sprintf("%s-%d", strings.ToLower(string(l.Protocol)), i)
-		}
-		appProtocol := strings.ToLower(string(l.Protocol))
-		svcPorts = append(svcPorts, corev1.ServicePort{
-			Name:        name,
-			Port:        int32(l.Port),
-			AppProtocol: &appProtocol,
-		})
-	}
+	// Listeners conflicting with a previous listener are not served, and reported as conflicted in their status
+	svcPorts = append(svcPorts, resolveListenerPorts(gw.Annotations, gw.Spec.Listeners).servicePorts...)
 	return svcPorts
 }
 
@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
@@ -61,7 +62,7 @@ func TestConfigureIstioGateway(t *testing.T) {
 			Name: "custom",
 		},
 		Spec: v1beta1.GatewayClassSpec{
-			ControllerName: controllerName,
+			ControllerName: controllerName(),
 		},
 	}
 	defaultObjects := []runtime.Object{defaultNamespace}
@@ -100,7 +101,7 @@ func TestConfigureIstioGateway(t *testing.T) {
 					Namespace: "default",
 				},
 				Spec: v1alpha2.GatewaySpec{
-					GatewayClassName: defaultClassName,
+					GatewayClassName: defaultClassName(),
 				},
 			},
 			objects: defaultObjects,
@@ -114,7 +115,7 @@ func TestConfigureIstioGateway(t *testing.T) {
 					Annotations: map[string]string{gatewaySAOverride: "custom-sa"},
 				},
 				Spec: v1alpha2.GatewaySpec{
-					GatewayClassName: defaultClassName,
+					GatewayClassName: defaultClassName(),
 				},
 			},
 			objects: defaultObjects,
@@ -128,7 +129,7 @@ func TestConfigureIstioGateway(t *testing.T) {
 					Annotations: map[string]string{gatewayNameOverride: "default"},
 				},
 				Spec: v1beta1.GatewaySpec{
-					GatewayClassName: defaultClassName,
+					GatewayClassName: defaultClassName(),
 					Addresses: []v1beta1.GatewayAddress{{
 						Type:  func() *v1beta1.AddressType { x := v1beta1.IPAddressType; return &x }(),
 						Value: "1.2.3.4",
@@ -149,7 +150,7 @@ func TestConfigureIstioGateway(t *testing.T) {
 					},
 				},
 				Spec: v1beta1.GatewaySpec{
-					GatewayClassName: defaultClassName,
+					GatewayClassName: defaultClassName(),
 					Listeners: []v1beta1.Listener{{
 						Name:     "http",
 						Port:     v1beta1.PortNumber(80),
@@ -169,7 +170,7 @@ func TestConfigureIstioGateway(t *testing.T) {
 					Annotations: map[string]string{gatewayNameOverride: "default"},
 				},
 				Spec: v1beta1.GatewaySpec{
-					GatewayClassName: defaultClassName,
+					GatewayClassName: defaultClassName(),
 					Listeners: []v1beta1.Listener{{
 						Name:     "http",
 						Port:     v1beta1.PortNumber(80),
@@ -208,7 +209,7 @@ func TestConfigureIstioGateway(t *testing.T) {
 					Namespace: "default",
 				},
 				Spec: v1alpha2.GatewaySpec{
-					GatewayClassName: defaultClassName,
+					GatewayClassName: defaultClassName(),
 				},
 			},
 			objects: defaultObjects,
@@ -239,7 +240,7 @@ func TestConfigureIstioGateway(t *testing.T) {
 			env.PushContext().ProxyConfigs = tt.pcs
 			d := NewDeploymentController(
 				client, cluster.ID(features.ClusterName), env, testInjectionConfig(t), func(fn func()) {
-				}, "")
+				}, "", NewIdentity())
 			d.patcher = func(gvr schema.GroupVersionResource, name string, namespace string, data []byte, subresources ...string) error {
 				b, err := yaml.JSONToYAML(data)
 				if err != nil {
@@ -268,7 +269,7 @@ func TestVersionManagement(t *testing.T) {
 		},
 	})
 	env := &model.Environment{}
-	d := NewDeploymentController(c, "", env, testInjectionConfig(t), func(fn func()) {}, "")
+	d := NewDeploymentController(c, "", env, testInjectionConfig(t), func(fn func()) {}, "", NewIdentity())
 	reconciles := atomic.NewInt32(0)
 	wantReconcile := int32(0)
 	expectReconciled := func() {
@@ -302,7 +303,7 @@ func TestVersionManagement(t *testing.T) {
 			Namespace: "default",
 		},
 		Spec: v1beta1.GatewaySpec{
-			GatewayClassName: defaultClassName,
+			GatewayClassName: defaultClassName(),
 		},
 	}
 	gws.Create(defaultGateway)
@@ -357,12 +358,73 @@ func TestVersionManagement(t *testing.T) {
 	assert.Equal(t, reconciles.Load(), wantReconcile)
 }
 
+func TestPreview(t *testing.T) {
+	c := kube.NewFakeClient(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default",
+		},
+	})
+	env := model.NewEnvironment()
+	d := NewDeploymentController(c, "", env, testInjectionConfig(t), func(fn func()) {}, "", NewIdentity())
+	d.patcher = func(g schema.GroupVersionResource, name string, namespace string, data []byte, subresources ...string) error {
+		t.Fatalf("unexpected patch of %v %v/%v", g, namespace, name)
+		return nil
+	}
+	gws := clienttest.Wrap(t, d.gateways)
+	stop := test.NewStop(t)
+	c.RunAndWait(stop)
+	gws.Create(&v1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+		},
+		Spec: v1beta1.GatewaySpec{
+			GatewayClassName: defaultClassName(),
+		},
+	})
+	gws.Create(&v1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "external",
+			Namespace: "default",
+		},
+		Spec: v1beta1.GatewaySpec{
+			GatewayClassName: defaultClassName(),
+			Addresses: []v1beta1.GatewayAddress{{
+				Type:  func() *v1beta1.AddressType { x := v1beta1.HostnameAddressType; return &x }(),
+				Value: "gateway.example.com",
+			}},
+		},
+	})
+
+	rendered, err := d.Preview(types.NamespacedName{Name: "gw", Namespace: "default"})
+	assert.NoError(t, err)
+	var kinds []string
+	for _, r := range rendered {
+		obj := map[string]any{}
+		assert.NoError(t, yaml.Unmarshal([]byte(r), &obj))
+		kinds = append(kinds, fmt.Sprint(obj["kind"]))
+	}
+	assert.Equal(t, kinds, []string{"ServiceAccount", "Deployment", "Service"})
+
+	_, err = d.Preview(types.NamespacedName{Name: "external", Namespace: "default"})
+	assert.Error(t, err)
+	_, err = d.Preview(types.NamespacedName{Name: "missing", Namespace: "default"})
+	assert.Error(t, err)
+}
+
 func testInjectionConfig(t test.Failer) func() inject.WebhookConfig {
+	return testInjectionConfigWithValues(t, "")
+}
+
+// testInjectionConfigWithValues returns the injection config with additional global values, indented as children of
+// global.
+func testInjectionConfigWithValues(t test.Failer, globalValues string) func() inject.WebhookConfig {
 	vc, err := inject.NewValuesConfig(fmt.Sprintf(`
 global:
   hub: test
   tag: test
-  caCertConfigMapName: %s`, features.CACertConfigMapName))
+  caCertConfigMapName: %s
+%s`, features.CACertConfigMapName, globalValues))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -0,0 +1,119 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gateway "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// classDefaultsDrainKey is the key of the GatewayClass defaults holding how the generated gateway deployments
+	// leave external load balancers on termination.
+	classDefaultsDrainKey = "drain"
+	// drainGracePeriodMargin is added to the termination grace period of the pods, so the proxy is not killed
+	// before it is done draining.
+	drainGracePeriodMargin = 5 * time.Second
+	// maxDrainDuration bounds each step of the termination.
+	maxDrainDuration = time.Hour
+)
+
+// GatewayDrain configures the termination of the pods of a generated gateway deployment which is behind an
+// externally-managed load balancer. The load balancer keeps sending traffic to a terminating pod until its health
+// checks fail, so the pod first fails its health endpoint, then waits for the load balancer to deregister it, and
+// only then drains Envoy.
+type GatewayDrain struct {
+	// FailHealthChecks fails the health endpoint of the proxy when the pod starts terminating.
+	FailHealthChecks bool
+	// DeregistrationDelay is how long the pod keeps serving once terminating, before Envoy is drained.
+	DeregistrationDelay time.Duration
+	// DrainDuration is how long Envoy drains its connections. If unset, the terminationDrainDuration of the proxy
+	// config is used.
+	DrainDuration time.Duration
+	// TerminationGracePeriodSeconds is the grace period of the pods covering all the steps of the termination.
+	// It is set when rendering the deployment.
+	TerminationGracePeriodSeconds int64
+}
+
+// gatewayDrainSpec is the YAML format of the drain settings of a GatewayClass.
+type gatewayDrainSpec struct {
+	FailHealthChecks    *bool            `json:"failHealthChecks,omitempty"`
+	DeregistrationDelay *metav1.Duration `json:"deregistrationDelay,omitempty"`
+	DrainDuration       *metav1.Duration `json:"drainDuration,omitempty"`
+}
+
+// parseGatewayDrain parses and validates the drain settings of a GatewayClass.
+func parseGatewayDrain(data string) (*GatewayDrain, error) {
+	spec := gatewayDrainSpec{}
+	if err := yaml.UnmarshalStrict([]byte(data), &spec); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", classDefaultsDrainKey, err)
+	}
+	drain := &GatewayDrain{FailHealthChecks: true}
+	if spec.FailHealthChecks != nil {
+		drain.FailHealthChecks = *spec.FailHealthChecks
+	}
+	if spec.DeregistrationDelay != nil {
+		drain.DeregistrationDelay = spec.DeregistrationDelay.Duration
+	}
+	if spec.DrainDuration != nil {
+		drain.DrainDuration = spec.DrainDuration.Duration
+	}
+	if err := validateDrainDuration("deregistrationDelay", drain.DeregistrationDelay); err != nil {
+		return nil, err
+	}
+	if err := validateDrainDuration("drainDuration", drain.DrainDuration); err != nil {
+		return nil, err
+	}
+	return drain, nil
+}
+
+func validateDrainDuration(name string, d time.Duration) error {
+	if d < 0 || d > maxDrainDuration {
+		return fmt.Errorf("invalid %s: %s must be between 0s and %v, got %v", classDefaultsDrainKey, name, maxDrainDuration, d)
+	}
+	return nil
+}
+
+// withDrainDuration returns a copy of the drain settings for a proxy whose default drain duration is
+// defaultDrainDuration, with the termination grace period covering all the steps of the termination.
+func (d *GatewayDrain) withDrainDuration(defaultDrainDuration time.Duration) *GatewayDrain {
+	out := *d
+	if out.DrainDuration == 0 {
+		out.DrainDuration = defaultDrainDuration
+	}
+	grace := out.DeregistrationDelay + out.DrainDuration + drainGracePeriodMargin
+	out.TerminationGracePeriodSeconds = int64(math.Ceil(grace.Seconds()))
+	return &out
+}
+
+// classDrain returns the drain settings of the class of the gateway, if any. Invalid settings are ignored here, and
+// reported on the status of the class by the gateway controller.
+func (d *DeploymentController) classDrain(gw gateway.Gateway) *GatewayDrain {
+	class, data, f := d.classDefault(gw, classDefaultsDrainKey)
+	if !f {
+		return nil
+	}
+	drain, err := parseGatewayDrain(data)
+	if err != nil {
+		log.Warnf("ignoring drain settings of gateway class %s: %v", class, err)
+		return nil
+	}
+	return drain
+}
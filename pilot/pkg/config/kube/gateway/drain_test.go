@@ -0,0 +1,209 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestParseGatewayDrain(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    string
+		want    *GatewayDrain
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			data: "{}",
+			want: &GatewayDrain{FailHealthChecks: true},
+		},
+		{
+			name: "full",
+			data: "{failHealthChecks: false, deregistrationDelay: 15s, drainDuration: 1m}",
+			want: &GatewayDrain{DeregistrationDelay: 15 * time.Second, DrainDuration: time.Minute},
+		},
+		{
+			name:    "negative delay",
+			data:    "{deregistrationDelay: -5s}",
+			wantErr: true,
+		},
+		{
+			name:    "too long drain",
+			data:    "{drainDuration: 2h}",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    "{deregistrationDelays: 15s}",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseGatewayDrain(tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestGatewayDrainWithDrainDuration(t *testing.T) {
+	drain := &GatewayDrain{FailHealthChecks: true, DeregistrationDelay: 15 * time.Second}
+	got := drain.withDrainDuration(5 * time.Second)
+	assert.Equal(t, got, &GatewayDrain{
+		FailHealthChecks:              true,
+		DeregistrationDelay:           15 * time.Second,
+		DrainDuration:                 5 * time.Second,
+		TerminationGracePeriodSeconds: 25,
+	})
+	// The settings of the class are not modified
+	assert.Equal(t, drain.DrainDuration, 0)
+
+	drain = &GatewayDrain{DeregistrationDelay: 1500 * time.Millisecond, DrainDuration: 10 * time.Second}
+	assert.Equal(t, drain.withDrainDuration(5*time.Second).TerminationGracePeriodSeconds, 17)
+}
+
+func TestGatewayDrainLifecycle(t *testing.T) {
+	cases := []struct {
+		name      string
+		lifecycle string
+		postStart []string
+		wantErr   bool
+	}{
+		{
+			name: "no lifecycle",
+		},
+		{
+			name: "postStart is kept",
+			lifecycle: `
+  proxy:
+    lifecycle:
+      postStart:
+        exec:
+          command: ["start"]`,
+			postStart: []string{"start"},
+		},
+		{
+			name: "conflicting preStop",
+			lifecycle: `
+  proxy:
+    lifecycle:
+      preStop:
+        exec:
+          command: ["stop"]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			c := kube.NewFakeClient(
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+				classDefaultsConfigMap(gatewayClassDefaultsExtension, map[string]string{classDefaultsDrainKey: "{deregistrationDelay: 15s}"}),
+				&v1beta1.GatewayClass{
+					ObjectMeta: metav1.ObjectMeta{Name: "drained"},
+					Spec: v1beta1.GatewayClassSpec{
+						ControllerName: controllerName(),
+						ParametersRef: &k8sv1.ParametersReference{
+							Kind:      "ConfigMap",
+							Name:      "defaults",
+							Namespace: ptr.Of(k8sv1.Namespace("istio-system")),
+						},
+					},
+				},
+				&v1beta1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"},
+					Spec:       v1beta1.GatewaySpec{GatewayClassName: "drained"},
+				},
+			)
+			d := NewDeploymentController(c, "", model.NewEnvironment(), testInjectionConfigWithValues(t, tt.lifecycle), func(fn func()) {}, "",
+				NewIdentity())
+			d.patcher = func(g schema.GroupVersionResource, name string, namespace string, data []byte, subresources ...string) error {
+				t.Fatalf("unexpected patch of %v %v/%v", g, namespace, name)
+				return nil
+			}
+			stop := test.NewStop(t)
+			c.RunAndWait(stop)
+
+			rendered, err := d.Preview(types.NamespacedName{Name: "gw", Namespace: "default"})
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			var lifecycle *corev1.Lifecycle
+			for _, r := range rendered {
+				deployment := &appsv1.Deployment{}
+				assert.NoError(t, yaml.Unmarshal([]byte(r), deployment))
+				if deployment.Kind == "Deployment" {
+					lifecycle = deployment.Spec.Template.Spec.Containers[0].Lifecycle
+				}
+			}
+			assert.Equal(t, lifecycle.PreStop.Exec.Command[:2], []string{"pilot-agent", "prestop"})
+			var postStart []string
+			if lifecycle.PostStart != nil {
+				postStart = lifecycle.PostStart.Exec.Command
+			}
+			assert.Equal(t, postStart, tt.postStart)
+		})
+	}
+}
+
+func TestGatewayDrainMultiTenant(t *testing.T) {
+	c := kube.NewFakeClient(
+		classDefaultsConfigMap(gatewayClassDefaultsExtension, map[string]string{classDefaultsDrainKey: "{deregistrationDelay: 15s}"}),
+		&v1beta1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "drained"},
+			Spec: v1beta1.GatewayClassSpec{
+				ControllerName: controllerName(),
+				ParametersRef: &k8sv1.ParametersReference{
+					Kind:      "ConfigMap",
+					Name:      "defaults",
+					Namespace: ptr.Of(k8sv1.Namespace("istio-system")),
+				},
+			},
+		},
+	)
+	assert.NoError(t, c.AddMemberRollController("istio-system", "default"))
+	d := NewDeploymentController(c, "", model.NewEnvironment(), testInjectionConfig(t), func(fn func()) {}, "", NewIdentity())
+
+	// The GatewayClasses are not watched in multi-tenant mode, so their parameters are ignored
+	gw := v1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"},
+		Spec:       v1beta1.GatewaySpec{GatewayClassName: "drained"},
+	}
+	assert.Equal(t, d.gatewayClasses, nil)
+	assert.Equal(t, d.classDrain(gw), nil)
+}
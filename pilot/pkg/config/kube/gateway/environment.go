@@ -0,0 +1,221 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	gateway "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	"istio.io/istio/pkg/util/sets"
+)
+
+const (
+	// classDefaultsEnvironmentKey is the key of the GatewayClass defaults holding the environment variables, volumes
+	// and trust bundles injected into the pods of the generated gateway deployments.
+	classDefaultsEnvironmentKey = "environment"
+
+	// trustBundlesDir is the directory the trust bundles are mounted in, each in <name>/ca.crt, so that the
+	// DestinationRules of the backends can reference them as caCertificates.
+	trustBundlesDir = "/etc/istio/trust-bundles"
+	// trustBundleVolumePrefix is the prefix of the names of the volumes of the trust bundles.
+	trustBundleVolumePrefix = "trust-bundle-"
+	// defaultTrustBundleKey is the key of the ConfigMap or Secret holding a trust bundle, if not set.
+	defaultTrustBundleKey = "ca.crt"
+)
+
+var (
+	// reservedGatewayEnv are the environment variables set by the gateway template, which cannot be overridden.
+	reservedGatewayEnv = sets.New(
+		"JWT_POLICY", "PILOT_CERT_PROVIDER", "CA_ADDR", "POD_NAME", "POD_NAMESPACE", "INSTANCE_IP", "SERVICE_ACCOUNT",
+		"HOST_IP", "PROXY_CONFIG", "GOMEMLIMIT", "GOMAXPROCS", "TRUST_DOMAIN",
+	)
+	// reservedGatewayVolumes are the volumes of the gateway template, which cannot be replaced.
+	reservedGatewayVolumes = sets.New(
+		"workload-socket", "credential-socket", "gke-workload-certificate", "workload-certs", "istio-envoy", "istio-data",
+		"istio-podinfo", "istio-token", "istiod-ca-cert",
+	)
+	// reservedGatewayMountPaths are the directories of the gateway template, in which nothing can be mounted.
+	reservedGatewayMountPaths = []string{"/var/run/secrets", "/etc/istio", "/var/lib/istio"}
+)
+
+// GatewayEnvironment holds the environment variables and volumes injected into the istio-proxy container of the pods
+// of a generated gateway deployment.
+type GatewayEnvironment struct {
+	Env          []corev1.EnvVar
+	Volumes      []corev1.Volume
+	VolumeMounts []corev1.VolumeMount
+}
+
+// gatewayEnvironmentSpec is the YAML format of the environment settings of a GatewayClass.
+type gatewayEnvironmentSpec struct {
+	// Env are environment variables, set from a value, a ConfigMap or a Secret of the namespace of the gateway.
+	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Volumes are ConfigMaps or Secrets of the namespace of the gateway, mounted by VolumeMounts.
+	Volumes      []corev1.Volume      `json:"volumes,omitempty"`
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
+	// TrustBundles are CA certificates, e.g. of a private PKI, used to verify the backends of the gateway.
+	TrustBundles []gatewayTrustBundle `json:"trustBundles,omitempty"`
+}
+
+// gatewayTrustBundle is a CA certificate bundle held by a ConfigMap or a Secret of the namespace of the gateway.
+type gatewayTrustBundle struct {
+	Name      string `json:"name"`
+	ConfigMap string `json:"configMap,omitempty"`
+	Secret    string `json:"secret,omitempty"`
+	// Key is the key of the bundle in the ConfigMap or Secret, ca.crt by default.
+	Key string `json:"key,omitempty"`
+}
+
+// parseGatewayEnvironment parses and validates the environment settings of a GatewayClass. Only ConfigMaps and
+// Secrets can be mounted, outside the directories used by the proxy, and the settings of the template cannot be
+// overridden.
+func parseGatewayEnvironment(data string) (*gatewayEnvironmentSpec, error) {
+	spec := &gatewayEnvironmentSpec{}
+	if err := yaml.UnmarshalStrict([]byte(data), spec); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", classDefaultsEnvironmentKey, err)
+	}
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("invalid %s: %s", classDefaultsEnvironmentKey, fmt.Sprintf(format, args...))
+	}
+
+	envNames := sets.New[string]()
+	for i, e := range spec.Env {
+		if errs := validation.IsEnvVarName(e.Name); len(errs) > 0 {
+			return nil, invalid("name of env[%d]: %s", i, strings.Join(errs, ", "))
+		}
+		if reservedGatewayEnv.Contains(e.Name) || strings.HasPrefix(e.Name, "ISTIO_") {
+			return nil, invalid("env %s is set by the gateway template", e.Name)
+		}
+		if envNames.InsertContains(e.Name) {
+			return nil, invalid("env %s is set more than once", e.Name)
+		}
+		if from := e.ValueFrom; from != nil && (from.FieldRef != nil || from.ResourceFieldRef != nil) {
+			return nil, invalid("env %s can only be set from a value, a ConfigMap or a Secret", e.Name)
+		}
+	}
+
+	volumeNames := sets.New[string]()
+	checkVolumeName := func(name string) error {
+		if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+			return invalid("volume name %q: %s", name, strings.Join(errs, ", "))
+		}
+		if reservedGatewayVolumes.Contains(name) {
+			return invalid("volume %s is defined by the gateway template", name)
+		}
+		if volumeNames.InsertContains(name) {
+			return invalid("volume %s is defined more than once", name)
+		}
+		return nil
+	}
+	for _, v := range spec.Volumes {
+		if strings.HasPrefix(v.Name, trustBundleVolumePrefix) {
+			return nil, invalid("volume %s uses the prefix of the trust bundles", v.Name)
+		}
+		if err := checkVolumeName(v.Name); err != nil {
+			return nil, err
+		}
+		if v.VolumeSource != (corev1.VolumeSource{ConfigMap: v.ConfigMap, Secret: v.Secret}) || (v.ConfigMap == nil) == (v.Secret == nil) {
+			return nil, invalid("volume %s must be a ConfigMap or a Secret", v.Name)
+		}
+	}
+	for _, tb := range spec.TrustBundles {
+		if (tb.ConfigMap == "") == (tb.Secret == "") {
+			return nil, invalid("trust bundle %s must set one of configMap or secret", tb.Name)
+		}
+		if err := checkVolumeName(trustBundleVolumePrefix + tb.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	mountPaths := sets.New[string]()
+	for _, m := range spec.VolumeMounts {
+		if !volumeNames.Contains(m.Name) || strings.HasPrefix(m.Name, trustBundleVolumePrefix) {
+			return nil, invalid("volume mount %s does not reference a volume", m.Name)
+		}
+		if !path.IsAbs(m.MountPath) {
+			return nil, invalid("mount path %q of volume %s must be absolute", m.MountPath, m.Name)
+		}
+		p := path.Clean(m.MountPath)
+		for _, reserved := range reservedGatewayMountPaths {
+			if p == reserved || strings.HasPrefix(p, reserved+"/") {
+				return nil, invalid("mount path %q of volume %s is used by the gateway template", m.MountPath, m.Name)
+			}
+		}
+		if mountPaths.InsertContains(p) {
+			return nil, invalid("mount path %q is used more than once", m.MountPath)
+		}
+	}
+	return spec, nil
+}
+
+// gatewayEnvironment returns the environment injected by the settings of a GatewayClass, with the trust bundles
+// turned into read-only volumes mounted in trustBundlesDir.
+func gatewayEnvironment(spec *gatewayEnvironmentSpec) *GatewayEnvironment {
+	if spec == nil {
+		return nil
+	}
+	env := &GatewayEnvironment{
+		Env:          spec.Env,
+		Volumes:      spec.Volumes,
+		VolumeMounts: spec.VolumeMounts,
+	}
+	for _, tb := range spec.TrustBundles {
+		key := tb.Key
+		if key == "" {
+			key = defaultTrustBundleKey
+		}
+		items := []corev1.KeyToPath{{Key: key, Path: defaultTrustBundleKey}}
+		volume := corev1.Volume{Name: trustBundleVolumePrefix + tb.Name}
+		if tb.ConfigMap != "" {
+			volume.ConfigMap = &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: tb.ConfigMap},
+				Items:                items,
+			}
+		} else {
+			volume.Secret = &corev1.SecretVolumeSource{SecretName: tb.Secret, Items: items}
+		}
+		env.Volumes = append(env.Volumes, volume)
+		env.VolumeMounts = append(env.VolumeMounts, corev1.VolumeMount{
+			Name:      volume.Name,
+			MountPath: path.Join(trustBundlesDir, tb.Name),
+			ReadOnly:  true,
+		})
+	}
+	if len(env.Env) == 0 && len(env.Volumes) == 0 {
+		return nil
+	}
+	return env
+}
+
+// classEnvironment returns the environment settings of the class of the gateway, if any. Invalid settings are ignored
+// here, and reported on the status of the class by the gateway controller.
+func (d *DeploymentController) classEnvironment(gw gateway.Gateway) *gatewayEnvironmentSpec {
+	class, data, f := d.classDefault(gw, classDefaultsEnvironmentKey)
+	if !f {
+		return nil
+	}
+	spec, err := parseGatewayEnvironment(data)
+	if err != nil {
+		log.Warnf("ignoring environment settings of gateway class %s: %v", class, err)
+		return nil
+	}
+	return spec
+}
@@ -0,0 +1,159 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestParseGatewayEnvironment(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: "{}",
+		},
+		{
+			name: "env",
+			data: "{env: [{name: HTTPS_PROXY, value: http://proxy:3128}, {name: TOKEN, valueFrom: {secretKeyRef: {name: creds, key: token}}}]}",
+		},
+		{
+			name: "volumes",
+			data: "{volumes: [{name: certs, secret: {secretName: certs}}], volumeMounts: [{name: certs, mountPath: /etc/certs}]}",
+		},
+		{
+			name: "trust bundles",
+			data: "{trustBundles: [{name: private-pki, configMap: pki}, {name: partner, secret: partner-ca, key: root.pem}]}",
+		},
+		{
+			name:    "reserved env",
+			data:    "{env: [{name: PROXY_CONFIG, value: '{}'}]}",
+			wantErr: true,
+		},
+		{
+			name:    "istio env",
+			data:    "{env: [{name: ISTIO_META_CLUSTER_ID, value: other}]}",
+			wantErr: true,
+		},
+		{
+			name:    "duplicate env",
+			data:    "{env: [{name: A, value: a}, {name: A, value: b}]}",
+			wantErr: true,
+		},
+		{
+			name:    "env from field",
+			data:    "{env: [{name: NODE, valueFrom: {fieldRef: {fieldPath: spec.nodeName}}}]}",
+			wantErr: true,
+		},
+		{
+			name:    "host path volume",
+			data:    "{volumes: [{name: host, hostPath: {path: /}}]}",
+			wantErr: true,
+		},
+		{
+			name:    "reserved volume",
+			data:    "{volumes: [{name: istio-envoy, configMap: {name: envoy}}]}",
+			wantErr: true,
+		},
+		{
+			name:    "trust bundle volume prefix",
+			data:    "{volumes: [{name: trust-bundle-pki, configMap: {name: pki}}]}",
+			wantErr: true,
+		},
+		{
+			name:    "reserved mount path",
+			data:    "{volumes: [{name: certs, secret: {secretName: certs}}], volumeMounts: [{name: certs, mountPath: /etc/istio/proxy}]}",
+			wantErr: true,
+		},
+		{
+			name:    "relative mount path",
+			data:    "{volumes: [{name: certs, secret: {secretName: certs}}], volumeMounts: [{name: certs, mountPath: certs}]}",
+			wantErr: true,
+		},
+		{
+			name:    "unknown volume mount",
+			data:    "{volumeMounts: [{name: certs, mountPath: /etc/certs}]}",
+			wantErr: true,
+		},
+		{
+			name:    "trust bundle without source",
+			data:    "{trustBundles: [{name: pki}]}",
+			wantErr: true,
+		},
+		{
+			name:    "trust bundle with both sources",
+			data:    "{trustBundles: [{name: pki, configMap: pki, secret: pki}]}",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    "{initContainers: []}",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseGatewayEnvironment(tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGatewayEnvironment(t *testing.T) {
+	assert.Equal(t, gatewayEnvironment(nil), nil)
+
+	spec, err := parseGatewayEnvironment("{}")
+	assert.NoError(t, err)
+	assert.Equal(t, gatewayEnvironment(spec), nil)
+
+	spec, err = parseGatewayEnvironment(`
+env: [{name: HTTPS_PROXY, value: http://proxy:3128}]
+trustBundles: [{name: private-pki, configMap: pki}, {name: partner, secret: partner-ca, key: root.pem}]`)
+	assert.NoError(t, err)
+	assert.Equal(t, gatewayEnvironment(spec), &GatewayEnvironment{
+		Env: []corev1.EnvVar{{Name: "HTTPS_PROXY", Value: "http://proxy:3128"}},
+		Volumes: []corev1.Volume{
+			{
+				Name: "trust-bundle-private-pki",
+				VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "pki"},
+					Items:                []corev1.KeyToPath{{Key: "ca.crt", Path: "ca.crt"}},
+				}},
+			},
+			{
+				Name: "trust-bundle-partner",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{
+					SecretName: "partner-ca",
+					Items:      []corev1.KeyToPath{{Key: "root.pem", Path: "ca.crt"}},
+				}},
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "trust-bundle-private-pki", MountPath: "/etc/istio/trust-bundles/private-pki", ReadOnly: true},
+			{Name: "trust-bundle-partner", MountPath: "/etc/istio/trust-bundles/partner", ReadOnly: true},
+		},
+	})
+}
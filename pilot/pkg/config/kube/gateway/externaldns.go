@@ -0,0 +1,174 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gateway "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"istio.io/istio/pkg/config/host"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// externalDNSHostnameAnnotation is the Service annotation external-dns creates DNS records from.
+const externalDNSHostnameAnnotation = "external-dns.alpha.kubernetes.io/hostname"
+
+// routeAttachment is the part of a route determining the hostnames it serves on its parent gateways.
+type routeAttachment struct {
+	namespace  string
+	hostnames  []k8sv1.Hostname
+	parentRefs []k8sv1.ParentReference
+}
+
+func httpRouteAttachment(o controllers.Object) routeAttachment {
+	r := o.(*gateway.HTTPRoute)
+	return routeAttachment{namespace: r.Namespace, hostnames: r.Spec.Hostnames, parentRefs: r.Spec.ParentRefs}
+}
+
+// externalDNSEnabled returns whether the Service of the gateway publishes its hostnames for external-dns.
+func externalDNSEnabled(gw *gateway.Gateway) bool {
+	enabled, _ := strconv.ParseBool(gw.Annotations[gatewayExternalDNSKey])
+	return enabled
+}
+
+// externalDNSAnnotations returns the annotations publishing the hostnames of the gateway on its Service, if enabled.
+// The hostnames are kept in sync with the routes attached to the gateway, so that external-dns manages the DNS records
+// of the gateway as its routes change.
+func (d *DeploymentController) externalDNSAnnotations(gw gateway.Gateway) map[string]string {
+	if !externalDNSEnabled(&gw) {
+		return nil
+	}
+	namespace := d.namespace
+	if d.members != nil {
+		// As in the gateway controller, the namespace selectors are ignored in multi-tenant mode
+		namespace = nil
+	}
+	hostnames := externalDNSHostnames(gw, d.attachedRoutes(), namespace)
+	if len(hostnames) == 0 {
+		return nil
+	}
+	key := gw.Annotations[gatewayExternalDNSAnnotationKey]
+	if key == "" {
+		key = externalDNSHostnameAnnotation
+	}
+	return map[string]string{key: strings.Join(hostnames, ",")}
+}
+
+// attachedRoutes returns the routes which can serve hostnames on a gateway. Only HTTPRoutes are considered, as the
+// other route kinds are not part of the standard channel.
+func (d *DeploymentController) attachedRoutes() []routeAttachment {
+	var routes []routeAttachment
+	for _, r := range d.httpRoutes.List(metav1.NamespaceAll, klabels.Everything()) {
+		routes = append(routes, httpRouteAttachment(r))
+	}
+	return routes
+}
+
+// enqueueRouteParents returns a handler adding the gateways publishing their hostnames for external-dns, which a route
+// is attached to, before or after its change, onto the queue.
+func (d *DeploymentController) enqueueRouteParents(attachment func(controllers.Object) routeAttachment) cache.ResourceEventHandler {
+	return controllers.FromEventHandler(func(e controllers.Event) {
+		for _, o := range []controllers.Object{e.Old, e.New} {
+			if o == nil {
+				continue
+			}
+			r := attachment(o)
+			for _, ref := range r.parentRefs {
+				if !isGatewayParentRef(ref) {
+					continue
+				}
+				ns := r.namespace
+				if ref.Namespace != nil {
+					ns = string(*ref.Namespace)
+				}
+				if gw := d.gateways.Get(string(ref.Name), ns); gw != nil && externalDNSEnabled(gw) {
+					d.queue.AddObject(gw)
+				}
+			}
+		}
+	})
+}
+
+func isGatewayParentRef(ref k8sv1.ParentReference) bool {
+	return (ref.Group == nil || *ref.Group == k8sv1.GroupName) && (ref.Kind == nil || *ref.Kind == "Gateway")
+}
+
+// externalDNSHostnames returns the hostnames served by the gateway: the hostnames of its listeners, and the hostnames of
+// the routes attached to its listeners. Wildcard hostnames are kept, as external-dns creates wildcard records. The
+// namespaces are looked up with namespace for the listeners selecting them; if it is nil, the selectors are ignored.
+func externalDNSHostnames(gw gateway.Gateway, routes []routeAttachment, namespace func(string) *corev1.Namespace) []string {
+	hostnames := sets.New[string]()
+	for _, l := range gw.Spec.Listeners {
+		if l.Hostname != nil {
+			hostnames.Insert(string(*l.Hostname))
+		}
+	}
+	for _, r := range routes {
+		for _, ref := range r.parentRefs {
+			if !isGatewayParentRef(ref) || string(ref.Name) != gw.Name {
+				continue
+			}
+			if ref.Namespace != nil && string(*ref.Namespace) != gw.Namespace || ref.Namespace == nil && r.namespace != gw.Namespace {
+				continue
+			}
+			for _, l := range gw.Spec.Listeners {
+				if ref.SectionName != nil && *ref.SectionName != l.Name || ref.Port != nil && *ref.Port != l.Port {
+					continue
+				}
+				if !listenerAllowsNamespace(gw.Namespace, l.AllowedRoutes, r.namespace, namespace) {
+					continue
+				}
+				for _, h := range r.hostnames {
+					// Routes only serve the hostnames matching the listener, and the listener hostname is already published
+					// if it is the more specific one
+					if l.Hostname == nil || host.Name(h).SubsetOf(host.Name(*l.Hostname)) {
+						hostnames.Insert(string(h))
+					}
+				}
+			}
+		}
+	}
+	return sets.SortedList(hostnames)
+}
+
+// listenerAllowsNamespace returns whether the listener accepts routes from the namespace, as namespacesFromSelector
+// does. The namespaces are looked up for selectors; namespaces which cannot be looked up are not allowed. Listeners
+// without selector, or all listeners if namespace is nil, accept all the namespaces.
+func listenerAllowsNamespace(gwNamespace string, lr *k8sv1.AllowedRoutes, routeNamespace string, namespace func(string) *corev1.Namespace) bool {
+	// Default is to allow only the same namespace
+	if lr == nil || lr.Namespaces == nil || lr.Namespaces.From == nil || *lr.Namespaces.From == k8sv1.NamespacesFromSame {
+		return routeNamespace == gwNamespace
+	}
+	if *lr.Namespaces.From == k8sv1.NamespacesFromAll {
+		return true
+	}
+	if lr.Namespaces.Selector == nil || namespace == nil {
+		return true
+	}
+	ls, err := metav1.LabelSelectorAsSelector(lr.Namespaces.Selector)
+	if err != nil {
+		return false
+	}
+	ns := namespace(routeNamespace)
+	return ns != nil && ls.Matches(toNamespaceSet(ns.Name, ns.Labels))
+}
@@ -0,0 +1,109 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestExternalDNSHostnames(t *testing.T) {
+	selected := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "selected", Labels: map[string]string{"dns": "true"}}}
+	namespace := func(name string) *corev1.Namespace {
+		if name == selected.Name {
+			return selected
+		}
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	}
+	gw := v1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "tenant"},
+		Spec: v1beta1.GatewaySpec{
+			Listeners: []v1beta1.Listener{
+				{
+					Name:     "wildcard",
+					Hostname: ptr.Of(k8sv1.Hostname("*.example.com")),
+					Port:     443,
+				},
+				{
+					Name: "any",
+					Port: 80,
+					AllowedRoutes: &k8sv1.AllowedRoutes{Namespaces: &k8sv1.RouteNamespaces{
+						From:     ptr.Of(k8sv1.NamespacesFromSelector),
+						Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"dns": "true"}},
+					}},
+				},
+			},
+		},
+	}
+	parent := func(sectionName string) []k8sv1.ParentReference {
+		ref := k8sv1.ParentReference{Name: "gw", Namespace: ptr.Of(k8sv1.Namespace("tenant"))}
+		if sectionName != "" {
+			ref.SectionName = ptr.Of(k8sv1.SectionName(sectionName))
+		}
+		return []k8sv1.ParentReference{ref}
+	}
+	routes := []routeAttachment{
+		// Attached to both listeners, but only matches the wildcard listener
+		{namespace: "tenant", hostnames: []k8sv1.Hostname{"api.example.com", "api.example.org"}, parentRefs: parent("")},
+		// Only attached to the listener with any hostname, from a selected namespace
+		{namespace: "selected", hostnames: []k8sv1.Hostname{"selected.example.org"}, parentRefs: parent("any")},
+		// Not allowed by the namespace selector
+		{namespace: "other", hostnames: []k8sv1.Hostname{"other.example.org"}, parentRefs: parent("any")},
+		// Attached to another gateway
+		{namespace: "tenant", hostnames: []k8sv1.Hostname{"unrelated.example.com"}, parentRefs: []k8sv1.ParentReference{{Name: "other"}}},
+	}
+	assert.Equal(t, externalDNSHostnames(gw, routes, namespace), []string{
+		"*.example.com",
+		"api.example.com",
+		"selected.example.org",
+	})
+	// Without namespaces, in multi-tenant mode, the selectors are ignored as in the conversion
+	assert.Equal(t, externalDNSHostnames(gw, routes, nil), []string{
+		"*.example.com",
+		"api.example.com",
+		"other.example.org",
+		"selected.example.org",
+	})
+}
+
+func TestExternalDNSAnnotations(t *testing.T) {
+	gw := v1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "tenant"},
+		Spec: v1beta1.GatewaySpec{
+			Listeners: []v1beta1.Listener{{Name: "http", Hostname: ptr.Of(k8sv1.Hostname("www.example.com")), Port: 80}},
+		},
+	}
+	d := &DeploymentController{}
+	assert.Equal(t, d.externalDNSAnnotations(gw), nil)
+
+	gw.Annotations = map[string]string{gatewayExternalDNSKey: "true"}
+	client := kube.NewFakeClient()
+	d.httpRoutes = kclient.New[*v1beta1.HTTPRoute](client)
+	client.RunAndWait(test.NewStop(t))
+	assert.Equal(t, d.externalDNSAnnotations(gw), map[string]string{externalDNSHostnameAnnotation: "www.example.com"})
+
+	gw.Annotations[gatewayExternalDNSAnnotationKey] = "example.com/hostnames"
+	assert.Equal(t, d.externalDNSAnnotations(gw), map[string]string{"example.com/hostnames": "www.example.com"})
+}
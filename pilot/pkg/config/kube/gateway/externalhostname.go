@@ -0,0 +1,218 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/types"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/maps"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/util/sets"
+)
+
+const (
+	// externalHostnamesKey, set to true on an HTTPRoute, generates the ServiceEntries of the external hostnames
+	// referenced by its backendRefs of kind Hostname, so they do not have to be declared separately.
+	externalHostnamesKey = "gateway.istio.io/external-hostnames"
+	// externalTLSPortsKey lists the ports of the external hostnames the requests are sent to over TLS, 443 by default.
+	// An empty value disables the TLS origination. Only SIMPLE TLS is originated, verified with the system CAs and
+	// without client certificate: external hostnames requiring mutual TLS or a private CA need their own
+	// DestinationRule.
+	externalTLSPortsKey = "gateway.istio.io/external-tls-ports"
+
+	defaultExternalTLSPort = 443
+)
+
+// externalHostname collects the references to an external hostname by the HTTPRoutes of a namespace.
+type externalHostname struct {
+	// creationTimestamp is the one of the oldest route referencing the hostname.
+	creationTimestamp time.Time
+	ports             sets.Set[int]
+	tlsPorts          sets.Set[int]
+	// exportTo are the namespaces the generated configs are exported to: the namespace of the routes and of the
+	// Gateways they are attached to.
+	exportTo sets.String
+	parents  []string
+}
+
+// convertExternalHostnames generates the ServiceEntries, and the DestinationRules originating TLS, of the external
+// hostnames referenced by the HTTPRoutes opting in with externalHostnamesKey. The configs are merged per namespace
+// and hostname, and only exported to the namespaces of the routes and of the Gateways they are attached to, so a
+// route cannot publish a hostname to a Gateway which does not accept it. It must run after the routes are attached.
+func convertExternalHostnames(ctx configContext) ([]config.Config, []config.Config) {
+	r := ctx.GatewayResources
+	hosts := map[string]map[string]*externalHostname{}
+	for _, obj := range r.HTTPRoute {
+		if obj.Annotations[externalHostnamesKey] != "true" {
+			continue
+		}
+		tlsPorts, err := parseExternalTLSPorts(obj)
+		if err != nil {
+			log.Warnf("ignoring external hostnames of HTTPRoute %s/%s: %v", obj.Namespace, obj.Name, err)
+			continue
+		}
+		route := obj.Spec.(*k8s.HTTPRouteSpec)
+		exportTo := sets.New(obj.Namespace).Merge(ctx.routeGatewayNamespaces[obj.NamespacedName()])
+		for _, rule := range route.Rules {
+			for _, ref := range rule.BackendRefs {
+				// Invalid references are reported by buildDestination
+				if ptr.OrEmpty((*string)(ref.Group)) != gvk.ServiceEntry.Group || ptr.OrEmpty((*string)(ref.Kind)) != "Hostname" ||
+					ref.Port == nil || ref.Namespace != nil || strings.HasPrefix(string(ref.Name), "*") {
+					continue
+				}
+				if hosts[obj.Namespace] == nil {
+					hosts[obj.Namespace] = map[string]*externalHostname{}
+				}
+				h := hosts[obj.Namespace][string(ref.Name)]
+				if h == nil {
+					h = &externalHostname{creationTimestamp: obj.CreationTimestamp, ports: sets.New[int](), tlsPorts: sets.New[int](), exportTo: sets.New[string]()}
+					hosts[obj.Namespace][string(ref.Name)] = h
+				}
+				port := int(*ref.Port)
+				h.ports.Insert(port)
+				if tlsPorts.Contains(port) {
+					h.tlsPorts.Insert(port)
+				}
+				h.exportTo.Merge(exportTo)
+				if parent := parentMeta(obj, nil)[constants.InternalParentNames]; !slices.Contains(h.parents, parent) {
+					h.parents = append(h.parents, parent)
+				}
+			}
+		}
+	}
+
+	var serviceEntries, destinationRules []config.Config
+	for _, ns := range slices.Sort(maps.Keys(hosts)) {
+		for _, hostname := range slices.Sort(maps.Keys(hosts[ns])) {
+			h := hosts[ns][hostname]
+			meta := config.Meta{
+				Name:              externalHostnameConfigName(hostname),
+				Namespace:         ns,
+				Domain:            r.Domain,
+				CreationTimestamp: h.creationTimestamp,
+				Annotations:       map[string]string{constants.InternalParentNames: strings.Join(h.parents, ",")},
+			}
+			se := &istio.ServiceEntry{
+				Hosts:      []string{hostname},
+				Location:   istio.ServiceEntry_MESH_EXTERNAL,
+				Resolution: istio.ServiceEntry_DNS,
+				ExportTo:   sets.SortedList(h.exportTo),
+			}
+			for _, port := range sets.SortedList(h.ports) {
+				// TLS is originated by the proxies, so the requests are routed as HTTP on every port
+				se.Ports = append(se.Ports, &istio.ServicePort{Number: uint32(port), Name: fmt.Sprintf("http-%d", port), Protocol: "HTTP"})
+			}
+			seMeta := meta
+			seMeta.GroupVersionKind = gvk.ServiceEntry
+			serviceEntries = append(serviceEntries, config.Config{Meta: seMeta, Spec: se})
+
+			if h.tlsPorts.IsEmpty() {
+				continue
+			}
+			dr := &istio.DestinationRule{
+				Host:          hostname,
+				ExportTo:      sets.SortedList(h.exportTo),
+				TrafficPolicy: &istio.TrafficPolicy{},
+			}
+			for _, port := range sets.SortedList(h.tlsPorts) {
+				dr.TrafficPolicy.PortLevelSettings = append(dr.TrafficPolicy.PortLevelSettings, &istio.TrafficPolicy_PortTrafficPolicy{
+					Port: &istio.PortSelector{Number: uint32(port)},
+					Tls:  &istio.ClientTLSSettings{Mode: istio.ClientTLSSettings_SIMPLE, Sni: hostname},
+				})
+			}
+			drMeta := meta
+			drMeta.GroupVersionKind = gvk.DestinationRule
+			drMeta.Annotations = map[string]string{constants.InternalParentNames: meta.Annotations[constants.InternalParentNames]}
+			destinationRules = append(destinationRules, config.Config{Meta: drMeta, Spec: dr})
+		}
+	}
+	return serviceEntries, destinationRules
+}
+
+// parseExternalTLSPorts returns the ports of the external hostnames of the route originating TLS.
+func parseExternalTLSPorts(obj config.Config) (sets.Set[int], error) {
+	value, f := obj.Annotations[externalTLSPortsKey]
+	if !f {
+		return sets.New(defaultExternalTLSPort), nil
+	}
+	ports := sets.New[int]()
+	for _, p := range strings.Split(value, ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+		port, err := strconv.Atoi(p)
+		if err != nil || port <= 0 || port > 65535 {
+			return nil, fmt.Errorf("invalid %s port %q", externalTLSPortsKey, p)
+		}
+		ports.Insert(port)
+	}
+	return ports, nil
+}
+
+// externalHostnameConfigName returns the name of the configs generated for an external hostname. The hostname is
+// hashed, as replacing its dots would make a-b.example.com and a.b-example.com collide.
+func externalHostnameConfigName(hostname string) string {
+	sum := sha256.Sum256([]byte(hostname))
+	return fmt.Sprintf("external-%s-%s", hex.EncodeToString(sum[:])[:16], constants.KubernetesGatewayName)
+}
+
+// notifyGeneratedConfigs notifies the handlers of the configs of a kind added, updated or removed by a Reconcile.
+// Unchanged configs are not notified, as each notification triggers a push, reconciling the gateway-api types again.
+func (c *Controller) notifyGeneratedConfigs(typ config.GroupVersionKind, old, cur []config.Config) {
+	handlers := c.generatedHandlers[typ]
+	if len(handlers) == 0 || (len(old) == 0 && len(cur) == 0) {
+		return
+	}
+	notify := func(prev, curr config.Config, event model.Event) {
+		log.Debugf("generated %v %s/%s %v", typ.Kind, curr.Namespace, curr.Name, event)
+		for _, h := range handlers {
+			h(prev, curr, event)
+		}
+	}
+	previous := make(map[types.NamespacedName]config.Config, len(old))
+	for _, cfg := range old {
+		previous[cfg.NamespacedName()] = cfg
+	}
+	for _, cfg := range cur {
+		prev, f := previous[cfg.NamespacedName()]
+		delete(previous, cfg.NamespacedName())
+		switch {
+		case !f:
+			notify(config.Config{}, cfg, model.EventAdd)
+		case !proto.Equal(prev.Spec.(proto.Message), cfg.Spec.(proto.Message)) || !maps.Equal(prev.Annotations, cfg.Annotations):
+			notify(prev, cfg, model.EventUpdate)
+		}
+	}
+	for _, cfg := range old {
+		if _, f := previous[cfg.NamespacedName()]; f {
+			notify(cfg, cfg, model.EventDelete)
+		}
+	}
+}
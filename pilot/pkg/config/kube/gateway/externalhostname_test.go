@@ -0,0 +1,155 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/pkg/util/sets"
+)
+
+func externalHostnameRoute(name string, annotations map[string]string, parents []k8s.ParentReference, refs ...k8s.HTTPBackendRef) config.Config {
+	return config.Config{
+		Meta: config.Meta{
+			GroupVersionKind: gvk.HTTPRoute,
+			Name:             name,
+			Namespace:        "apps",
+			Annotations:      annotations,
+		},
+		Spec: &k8s.HTTPRouteSpec{
+			CommonRouteSpec: k8s.CommonRouteSpec{ParentRefs: parents},
+			Rules:           []k8s.HTTPRouteRule{{BackendRefs: refs}},
+		},
+	}
+}
+
+func hostnameBackendRef(hostname string, port int32) k8s.HTTPBackendRef {
+	return k8s.HTTPBackendRef{BackendRef: k8s.BackendRef{BackendObjectReference: k8s.BackendObjectReference{
+		Group: (*k8s.Group)(ptr.Of(gvk.ServiceEntry.Group)),
+		Kind:  (*k8s.Kind)(ptr.Of("Hostname")),
+		Name:  k8s.ObjectName(hostname),
+		Port:  (*k8s.PortNumber)(ptr.Of(port)),
+	}}}
+}
+
+func externalHostnameContext(r GatewayResources, attached map[string]sets.String) configContext {
+	ctx := configContext{GatewayResources: r, routeGatewayNamespaces: map[types.NamespacedName]sets.String{}}
+	for route, namespaces := range attached {
+		ctx.routeGatewayNamespaces[types.NamespacedName{Namespace: "apps", Name: route}] = namespaces
+	}
+	return ctx
+}
+
+func TestConvertExternalHostnames(t *testing.T) {
+	optIn := map[string]string{externalHostnamesKey: "true"}
+	gateway := []k8s.ParentReference{{Name: "gateway", Namespace: (*k8s.Namespace)(ptr.Of("istio-system"))}}
+	rejected := []k8s.ParentReference{{Name: "gateway", Namespace: (*k8s.Namespace)(ptr.Of("tenant-b"))}}
+	r := GatewayResources{
+		Domain: "cluster.local",
+		HTTPRoute: []config.Config{
+			externalHostnameRoute("api", optIn, gateway, hostnameBackendRef("api.example.com", 443), hostnameBackendRef("api.example.com", 80)),
+			externalHostnameRoute("api-v2", optIn, nil, hostnameBackendRef("api.example.com", 443)),
+			// The Gateway of tenant-b does not accept the route, so the hostname is not exported to it
+			externalHostnameRoute("api-rejected", optIn, rejected, hostnameBackendRef("api.example.com", 443)),
+			externalHostnameRoute("plaintext", map[string]string{externalHostnamesKey: "true", externalTLSPortsKey: ""}, nil,
+				hostnameBackendRef("plain.example.com", 443)),
+			externalHostnameRoute("mesh", optIn, []k8s.ParentReference{{
+				Group: (*k8s.Group)(ptr.Of(gvk.Service.Group)),
+				Kind:  (*k8s.Kind)(ptr.Of(gvk.Service.Kind)),
+				Name:  "reviews",
+			}}, hostnameBackendRef("mesh.example.com", 8443)),
+			externalHostnameRoute("wildcard", optIn, nil, hostnameBackendRef("*.example.com", 443)),
+			externalHostnameRoute("invalid", map[string]string{externalHostnamesKey: "true", externalTLSPortsKey: "https"}, nil,
+				hostnameBackendRef("invalid.example.com", 443)),
+			externalHostnameRoute("disabled", nil, nil, hostnameBackendRef("disabled.example.com", 443)),
+		},
+	}
+
+	serviceEntries, destinationRules := convertExternalHostnames(externalHostnameContext(r, map[string]sets.String{"api": sets.New("istio-system")}))
+	assert.Equal(t, len(serviceEntries), 3)
+	assert.Equal(t, len(destinationRules), 1)
+
+	se := serviceEntries[0]
+	assert.Equal(t, se.Name, externalHostnameConfigName("api.example.com"))
+	assert.Equal(t, se.Namespace, "apps")
+	assert.Equal(t, se.Annotations[constants.InternalParentNames], "HTTPRoute/api.apps,HTTPRoute/api-v2.apps,HTTPRoute/api-rejected.apps")
+	assert.Equal(t, se.Spec, config.Spec(&istio.ServiceEntry{
+		Hosts:      []string{"api.example.com"},
+		Location:   istio.ServiceEntry_MESH_EXTERNAL,
+		Resolution: istio.ServiceEntry_DNS,
+		ExportTo:   []string{"apps", "istio-system"},
+		Ports: []*istio.ServicePort{
+			{Number: 80, Name: "http-80", Protocol: "HTTP"},
+			{Number: 443, Name: "http-443", Protocol: "HTTP"},
+		},
+	}))
+	assert.Equal(t, destinationRules[0].Name, se.Name)
+	assert.Equal(t, destinationRules[0].Spec, config.Spec(&istio.DestinationRule{
+		Host:     "api.example.com",
+		ExportTo: []string{"apps", "istio-system"},
+		TrafficPolicy: &istio.TrafficPolicy{PortLevelSettings: []*istio.TrafficPolicy_PortTrafficPolicy{{
+			Port: &istio.PortSelector{Number: 443},
+			Tls:  &istio.ClientTLSSettings{Mode: istio.ClientTLSSettings_SIMPLE, Sni: "api.example.com"},
+		}}},
+	}))
+
+	// Routes attached to the mesh are only consumed by their own namespace
+	assert.Equal(t, serviceEntries[1].Spec.(*istio.ServiceEntry).Hosts, []string{"mesh.example.com"})
+	assert.Equal(t, serviceEntries[1].Spec.(*istio.ServiceEntry).ExportTo, []string{"apps"})
+	assert.Equal(t, serviceEntries[2].Spec.(*istio.ServiceEntry).Hosts, []string{"plain.example.com"})
+}
+
+func TestExternalHostnameConfigName(t *testing.T) {
+	assert.Equal(t, externalHostnameConfigName("a-b.example.com") == externalHostnameConfigName("a.b-example.com"), false)
+	assert.Equal(t, externalHostnameConfigName("api.example.com"), externalHostnameConfigName("api.example.com"))
+}
+
+func TestNotifyGeneratedConfigs(t *testing.T) {
+	var events []model.Event
+	c := &Controller{generatedHandlers: map[config.GroupVersionKind][]model.EventHandler{
+		gvk.ServiceEntry: {func(_, _ config.Config, event model.Event) { events = append(events, event) }},
+	}}
+	r := GatewayResources{HTTPRoute: []config.Config{
+		externalHostnameRoute("api", map[string]string{externalHostnamesKey: "true"}, nil, hostnameBackendRef("api.example.com", 443)),
+	}}
+	added, _ := convertExternalHostnames(externalHostnameContext(r, nil))
+	c.notifyGeneratedConfigs(gvk.ServiceEntry, nil, added)
+	assert.Equal(t, events, []model.Event{model.EventAdd})
+
+	// Reconciling the same routes again does not trigger another push
+	unchanged, _ := convertExternalHostnames(externalHostnameContext(r, nil))
+	c.notifyGeneratedConfigs(gvk.ServiceEntry, added, unchanged)
+	assert.Equal(t, events, []model.Event{model.EventAdd})
+
+	r.HTTPRoute = append(r.HTTPRoute, externalHostnameRoute("api-v2", map[string]string{externalHostnamesKey: "true"}, nil,
+		hostnameBackendRef("api.example.com", 8443)))
+	updated, _ := convertExternalHostnames(externalHostnameContext(r, nil))
+	c.notifyGeneratedConfigs(gvk.ServiceEntry, unchanged, updated)
+	assert.Equal(t, events, []model.Event{model.EventAdd, model.EventUpdate})
+
+	c.notifyGeneratedConfigs(gvk.ServiceEntry, updated, nil)
+	assert.Equal(t, events, []model.Event{model.EventAdd, model.EventUpdate, model.EventDelete})
+}
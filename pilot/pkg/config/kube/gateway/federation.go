@@ -0,0 +1,106 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"strings"
+
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/ptr"
+)
+
+// The parentRefs of the routes attached to a Gateway of a federated peer mesh have the federatedGatewayGroup group and
+// the federatedGatewayKind kind. Their name is <gateway>.<mesh>, the mesh being the name of the ServiceMeshPeer, and
+// their namespace is the namespace of the Gateway in the peer mesh, e.g.
+//
+//	parentRefs:
+//	- group: federation.maistra.io
+//	  kind: Gateway
+//	  name: public.mesh-b
+//	  namespace: ingress
+//
+// The peer only exports the Gateways whose federation.maistra.io/export-to annotation lists the mesh.
+const (
+	federatedGatewayGroup = "federation.maistra.io"
+	federatedGatewayKind  = "Gateway"
+	// federatedParentPrefix prefixes the internal names of the parents of the federated Gateways.
+	federatedParentPrefix = "federation://"
+)
+
+var federatedGatewayGVK = config.GroupVersionKind{
+	Group:   federatedGatewayGroup,
+	Version: "v1",
+	Kind:    federatedGatewayKind,
+}
+
+// IsFederated returns whether the parent is a Gateway of a federated peer mesh. The routes attached to such a parent
+// are programmed by the peer, so no configuration is generated for them.
+func (r routeParentReference) IsFederated() bool {
+	return strings.HasPrefix(r.InternalName, federatedParentPrefix)
+}
+
+// federatedParentDenial returns the reason a route is not accepted by its parent. The routes are not sent to the
+// federated peer meshes, so a route bound to the Gateway of a peer is reported as pending rather than accepted, as
+// no mesh serves it.
+func federatedParentDenial(r routeParentReference) *ParentError {
+	if r.DeniedReason != nil || !r.IsFederated() {
+		return r.DeniedReason
+	}
+	return &ParentError{
+		Reason: ParentErrorPending,
+		Message: fmt.Sprintf("the route is bound to the federated Gateway %s, but is not served until the peer mesh "+
+			"programs it", r.OriginalReference.Name),
+	}
+}
+
+// federatedGatewayName is the name of the parentRefs to a Gateway of a federated peer mesh.
+func federatedGatewayName(gw model.FederatedGateway) string {
+	return gw.Name + "." + gw.Mesh
+}
+
+// addFederatedGatewayParents indexes the listeners of the Gateways exported by the federated peer meshes, so that the
+// routes can attach to them. As the peer chose the meshes its Gateway is exported to, routes of any namespace are
+// allowed, but only HTTPRoutes are supported.
+func addFederatedGatewayParents(gwMap map[parentKey][]*parentInfo, gateways []model.FederatedGateway) {
+	for _, gw := range gateways {
+		ref := parentKey{
+			Kind:      federatedGatewayGVK,
+			Name:      federatedGatewayName(gw),
+			Namespace: gw.Namespace,
+		}
+		for _, l := range gw.Listeners {
+			hostname := l.Hostname
+			if hostname == "" {
+				hostname = "*"
+			}
+			gwMap[ref] = append(gwMap[ref], &parentInfo{
+				InternalName: fmt.Sprintf("%s%s/%s/%s/%s", federatedParentPrefix, gw.Mesh, gw.Namespace, gw.Name, l.Name),
+				AllowedKinds: []k8s.RouteGroupKind{
+					{Group: (*k8s.Group)(ptr.Of(gvk.HTTPRoute.Group)), Kind: k8s.Kind(gvk.HTTPRoute.Kind)},
+				},
+				Hostnames:        []string{"*/" + hostname},
+				OriginalHostname: l.Hostname,
+				SectionName:      k8s.SectionName(l.Name),
+				Port:             k8sv1.PortNumber(l.Port),
+			})
+		}
+	}
+}
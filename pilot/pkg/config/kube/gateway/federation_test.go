@@ -0,0 +1,146 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func federatedParentRef(name, namespace, section string) k8s.ParentReference {
+	ref := k8s.ParentReference{
+		Group:     (*k8s.Group)(ptr.Of(federatedGatewayGroup)),
+		Kind:      (*k8s.Kind)(ptr.Of(federatedGatewayKind)),
+		Name:      k8s.ObjectName(name),
+		Namespace: (*k8s.Namespace)(ptr.Of(namespace)),
+	}
+	if section != "" {
+		ref.SectionName = (*k8s.SectionName)(ptr.Of(section))
+	}
+	return ref
+}
+
+func TestFederatedGatewayParents(t *testing.T) {
+	gwMap := map[parentKey][]*parentInfo{}
+	addFederatedGatewayParents(gwMap, []model.FederatedGateway{{
+		Mesh:      "mesh-b",
+		Name:      "public",
+		Namespace: "ingress",
+		Listeners: []model.FederatedGatewayListener{
+			{Name: "http", Port: 80, Protocol: "HTTP"},
+			{Name: "https", Hostname: "*.example.com", Port: 443, Protocol: "HTTPS"},
+		},
+	}})
+
+	cases := []struct {
+		name      string
+		ref       k8s.ParentReference
+		hostnames []k8s.Hostname
+		kind      config.GroupVersionKind
+		accepted  []string
+		denied    []ParentErrorReason
+	}{
+		{
+			name:     "all listeners",
+			ref:      federatedParentRef("public.mesh-b", "ingress", ""),
+			accepted: []string{"federation://mesh-b/ingress/public/http", "federation://mesh-b/ingress/public/https"},
+		},
+		{
+			name:      "hostname",
+			ref:       federatedParentRef("public.mesh-b", "ingress", ""),
+			hostnames: []k8s.Hostname{"foo.example.org"},
+			accepted:  []string{"federation://mesh-b/ingress/public/http"},
+			denied:    []ParentErrorReason{ParentErrorNoHostname},
+		},
+		{
+			name:     "section name",
+			ref:      federatedParentRef("public.mesh-b", "ingress", "https"),
+			accepted: []string{"federation://mesh-b/ingress/public/https"},
+			denied:   []ParentErrorReason{ParentErrorNotAccepted},
+		},
+		{
+			name: "unknown mesh",
+			ref:  federatedParentRef("public.mesh-c", "ingress", ""),
+		},
+		{
+			name: "other namespace",
+			ref:  federatedParentRef("public.mesh-b", "default", ""),
+		},
+		{
+			name:   "unsupported route kind",
+			ref:    federatedParentRef("public.mesh-b", "ingress", "http"),
+			kind:   gvk.GRPCRoute,
+			denied: []ParentErrorReason{ParentErrorNotAccepted, ParentErrorNotAllowed},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			kind := gvk.HTTPRoute
+			if tt.kind != (config.GroupVersionKind{}) {
+				kind = tt.kind
+			}
+			refs := extractParentReferenceInfo(gwMap, []k8s.ParentReference{tt.ref}, tt.hostnames, kind, "default")
+			var accepted []string
+			var denied []ParentErrorReason
+			for _, r := range refs {
+				assert.Equal(t, r.IsFederated(), true)
+				if r.DeniedReason == nil {
+					accepted = append(accepted, r.InternalName)
+				} else {
+					denied = append(denied, r.DeniedReason.Reason)
+				}
+			}
+			// Parents of a single reference have no defined order
+			slices.Sort(accepted)
+			slices.Sort(denied)
+			assert.Equal(t, accepted, tt.accepted)
+			assert.Equal(t, denied, tt.denied)
+		})
+	}
+}
+
+func TestFederatedParentStatus(t *testing.T) {
+	gwMap := map[parentKey][]*parentInfo{}
+	addFederatedGatewayParents(gwMap, []model.FederatedGateway{{
+		Mesh:      "mesh-b",
+		Name:      "public",
+		Namespace: "ingress",
+		Listeners: []model.FederatedGatewayListener{{Name: "http", Port: 80, Protocol: "HTTP"}},
+	}})
+	ref := federatedParentRef("public.mesh-b", "ingress", "")
+	refs := extractParentReferenceInfo(gwMap, []k8s.ParentReference{ref}, nil, gvk.HTTPRoute, "default")
+	results := slices.Map(refs, func(r routeParentReference) RouteParentResult {
+		return RouteParentResult{OriginalReference: r.OriginalReference, DeniedReason: federatedParentDenial(r)}
+	})
+	obj := config.Config{Meta: config.Meta{GroupVersionKind: gvk.HTTPRoute, Namespace: "default", Name: "route"}}
+	parents := createRouteStatus(envIdentity(), results, obj, nil)
+
+	// The route is not sent to the peer, so it is not reported as accepted
+	assert.Equal(t, len(parents), 1)
+	accepted := slices.FindFunc(parents[0].Conditions, func(c metav1.Condition) bool {
+		return c.Type == string(k8s.RouteConditionAccepted)
+	})
+	assert.Equal(t, accepted.Status, metav1.ConditionFalse)
+	assert.Equal(t, accepted.Reason, string(k8s.RouteReasonPending))
+}
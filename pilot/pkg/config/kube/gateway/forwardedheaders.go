@@ -0,0 +1,88 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	meshconfig "istio.io/api/mesh/v1alpha1"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/ptr"
+)
+
+const (
+	// numTrustedProxiesAnnotation overrides the numTrustedProxies of the gateway topology for a Gateway.
+	numTrustedProxiesAnnotation = "gateway.istio.io/num-trusted-proxies"
+	// forwardClientCertAnnotation overrides the forwardClientCertDetails of the gateway topology for a Gateway.
+	forwardClientCertAnnotation = "gateway.istio.io/forward-client-cert-details"
+	// xffModeAnnotation sets whether a Gateway appends the remote address to the X-Forwarded-For header, or preserves
+	// the header as received.
+	xffModeAnnotation = "gateway.istio.io/xff-mode"
+
+	xffModeAppend   = "append"
+	xffModePreserve = "preserve"
+)
+
+// gatewayForwardedHeadersPolicy returns the forwarded headers policy set by the annotations of a Gateway, if any. An
+// invalid policy is ignored as a whole, so the Gateway keeps the gateway topology of its proxies rather than trusting
+// headers it was not meant to.
+func gatewayForwardedHeadersPolicy(obj config.Config) (*model.ForwardedHeadersPolicy, *ConfigError) {
+	var policy *model.ForwardedHeadersPolicy
+	invalid := func(key, value string) (*model.ForwardedHeadersPolicy, *ConfigError) {
+		return nil, &ConfigError{
+			Reason:  InvalidForwardedHeaders,
+			Message: fmt.Sprintf("invalid value %q for annotation %v, ignoring the forwarded headers annotations", value, key),
+		}
+	}
+	if v, f := obj.Annotations[numTrustedProxiesAnnotation]; f {
+		n, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			return invalid(numTrustedProxiesAnnotation, v)
+		}
+		policy = &model.ForwardedHeadersPolicy{NumTrustedProxies: ptr.Of(uint32(n))}
+	}
+	if v, f := obj.Annotations[forwardClientCertAnnotation]; f {
+		if mode, ok := meshconfig.ForwardClientCertDetails_value[v]; !ok || mode == int32(meshconfig.ForwardClientCertDetails_UNDEFINED) {
+			return invalid(forwardClientCertAnnotation, v)
+		}
+		if policy == nil {
+			policy = &model.ForwardedHeadersPolicy{}
+		}
+		policy.ForwardClientCertDetails = v
+	}
+	if v, f := obj.Annotations[xffModeAnnotation]; f {
+		if v != xffModeAppend && v != xffModePreserve {
+			return invalid(xffModeAnnotation, v)
+		}
+		if policy == nil {
+			policy = &model.ForwardedHeadersPolicy{}
+		}
+		policy.SkipXFFAppend = v == xffModePreserve
+	}
+	return policy, nil
+}
+
+// annotateForwardedHeaders sets the forwarded headers policy on the annotations of a generated Istio Gateway.
+func annotateForwardedHeaders(meta map[string]string, policy *model.ForwardedHeadersPolicy) {
+	if policy == nil {
+		return
+	}
+	if js, err := json.Marshal(policy); err == nil {
+		meta[model.InternalGatewayForwardedHeadersAnnotation] = string(js)
+	}
+}
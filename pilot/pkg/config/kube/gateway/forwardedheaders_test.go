@@ -0,0 +1,93 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestGatewayForwardedHeadersPolicy(t *testing.T) {
+	cases := []struct {
+		name        string
+		annotations map[string]string
+		want        *model.ForwardedHeadersPolicy
+		wantMeta    string
+		wantErr     bool
+	}{
+		{
+			name: "none",
+		},
+		{
+			name: "all",
+			annotations: map[string]string{
+				numTrustedProxiesAnnotation: "2",
+				forwardClientCertAnnotation: "APPEND_FORWARD",
+				xffModeAnnotation:           "preserve",
+			},
+			want: &model.ForwardedHeadersPolicy{
+				NumTrustedProxies:        ptr.Of(uint32(2)),
+				ForwardClientCertDetails: "APPEND_FORWARD",
+				SkipXFFAppend:            true,
+			},
+			wantMeta: `{"numTrustedProxies":2,"forwardClientCertDetails":"APPEND_FORWARD","skipXffAppend":true}`,
+		},
+		{
+			name:        "no trusted proxies",
+			annotations: map[string]string{numTrustedProxiesAnnotation: "0"},
+			want:        &model.ForwardedHeadersPolicy{NumTrustedProxies: ptr.Of(uint32(0))},
+			wantMeta:    `{"numTrustedProxies":0}`,
+		},
+		{
+			name:        "append",
+			annotations: map[string]string{xffModeAnnotation: "append"},
+			want:        &model.ForwardedHeadersPolicy{},
+			wantMeta:    `{}`,
+		},
+		{
+			name:        "negative trusted proxies",
+			annotations: map[string]string{numTrustedProxiesAnnotation: "-1", xffModeAnnotation: "preserve"},
+			wantErr:     true,
+		},
+		{
+			name:        "undefined client cert details",
+			annotations: map[string]string{forwardClientCertAnnotation: "UNDEFINED"},
+			wantErr:     true,
+		},
+		{
+			name:        "unknown xff mode",
+			annotations: map[string]string{xffModeAnnotation: "replace"},
+			wantErr:     true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := gatewayForwardedHeadersPolicy(config.Config{Meta: config.Meta{Annotations: tt.annotations}})
+			assert.Equal(t, policy, tt.want)
+			if tt.wantErr {
+				assert.Equal(t, err.Reason, InvalidForwardedHeaders)
+			} else {
+				assert.Equal(t, err, nil)
+			}
+			meta := map[string]string{}
+			annotateForwardedHeaders(meta, policy)
+			assert.Equal(t, meta[model.InternalGatewayForwardedHeadersAnnotation], tt.wantMeta)
+		})
+	}
+}
@@ -37,21 +37,28 @@ import (
 // This controller intentionally does not do leader election for simplicity. Because we only create
 // and not update there is no need; the first controller to create the GatewayClass wins.
 type ClassController struct {
-	queue   controllers.Queue
-	classes kclient.Client[*gateway.GatewayClass]
+	queue    controllers.Queue
+	classes  kclient.Client[*gateway.GatewayClass]
+	identity *Identity
+	// removeIdentityHandler stops creating the default GatewayClass of a new identity of the controller
+	removeIdentityHandler func()
 }
 
-func NewClassController(kc kube.Client) *ClassController {
-	gc := &ClassController{}
+func NewClassController(kc kube.Client, identity *Identity) *ClassController {
+	gc := &ClassController{identity: identity}
 	gc.queue = controllers.NewQueue("gateway class",
 		controllers.WithReconciler(gc.Reconcile),
 		controllers.WithMaxAttempts(25))
 
 	gc.classes = kclient.New[*gateway.GatewayClass](kc)
 	gc.classes.AddEventHandler(controllers.FilteredObjectHandler(gc.queue.AddObject, func(o controllers.Object) bool {
-		_, f := builtinClasses[gateway.ObjectName(o.GetName())]
+		_, f := gc.identity.load().builtinClasses[gateway.ObjectName(o.GetName())]
 		return f
 	}))
+	// The default GatewayClass may be renamed at runtime
+	gc.removeIdentityHandler = identity.onChange(func() {
+		gc.queue.Add(types.NamespacedName{})
+	})
 	return gc
 }
 
@@ -59,23 +66,25 @@ func (c *ClassController) Run(stop <-chan struct{}) {
 	// Ensure we initially reconcile the current state
 	c.queue.Add(types.NamespacedName{})
 	c.queue.Run(stop)
+	c.removeIdentityHandler()
 }
 
 func (c *ClassController) Reconcile(types.NamespacedName) error {
 	err := istiomultierror.New()
-	for class := range builtinClasses {
-		err = multierror.Append(err, c.reconcileClass(class))
+	id := c.identity.load()
+	for class := range id.builtinClasses {
+		err = multierror.Append(err, c.reconcileClass(id, class))
 	}
 	return err.ErrorOrNil()
 }
 
-func (c *ClassController) reconcileClass(class gateway.ObjectName) error {
+func (c *ClassController) reconcileClass(id *controllerIdentity, class gateway.ObjectName) error {
 	if c.classes.Get(string(class), "") != nil {
 		log.Debugf("GatewayClass/%v already exists, no action", class)
 		return nil
 	}
-	controller := builtinClasses[class]
-	classInfo, f := classInfos[controller]
+	controller := id.builtinClasses[class]
+	classInfo, f := id.classInfos[controller]
 	if !f {
 		// Should only happen when ambient is disabled; otherwise builtinClasses and classInfos should be consistent
 		return nil
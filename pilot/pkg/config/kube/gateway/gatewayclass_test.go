@@ -30,7 +30,8 @@ import (
 
 func TestClassController(t *testing.T) {
 	client := kube.NewFakeClient()
-	cc := NewClassController(client)
+	identity := NewIdentity()
+	cc := NewClassController(client, identity)
 	classes := clienttest.Wrap(t, cc.classes)
 	stop := test.NewStop(t)
 	client.RunAndWait(stop)
@@ -70,23 +71,27 @@ func TestClassController(t *testing.T) {
 	}
 
 	// Class should be created initially
-	expectClass(string(defaultClassName), string(controllerName))
+	expectClass(string(defaultClassName()), string(controllerName()))
 
 	// Once we delete it, it should be added back
-	deleteClass(string(defaultClassName))
-	expectClass(string(defaultClassName), string(controllerName))
+	deleteClass(string(defaultClassName()))
+	expectClass(string(defaultClassName()), string(controllerName()))
 
 	// Overwrite the class, controller should not reconcile it back
-	createClass(string(defaultClassName), "different-controller")
-	expectClass(string(defaultClassName), "different-controller")
+	createClass(string(defaultClassName()), "different-controller")
+	expectClass(string(defaultClassName()), "different-controller")
 
 	// Once we delete it, it should be added back
-	deleteClass(string(defaultClassName))
-	expectClass(string(defaultClassName), string(controllerName))
+	deleteClass(string(defaultClassName()))
+	expectClass(string(defaultClassName()), string(controllerName()))
 
 	// Create an unrelated GatewayClass, we should not do anything to it
 	createClass("something-else", "different-controller")
 	expectClass("something-else", "different-controller")
 	deleteClass("something-else")
 	expectClass("something-else", "")
+
+	// The default class of a new identity is created without a restart
+	identity.set("renamed", "example.com/renamed-controller")
+	expectClass("renamed", "example.com/renamed-controller")
 }
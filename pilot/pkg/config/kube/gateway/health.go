@@ -0,0 +1,86 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"sync"
+	"time"
+
+	"istio.io/istio/pilot/pkg/model"
+)
+
+// Health reports the state of the Gateway API controller.
+type Health = model.GatewayControllerHealth
+
+// reconcileTracker records the outcome of the reconciliations of the controller.
+type reconcileTracker struct {
+	mu          sync.RWMutex
+	last        time.Time
+	lastSuccess time.Time
+	lastErr     error
+}
+
+func (r *reconcileTracker) record(t time.Time, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.last = t
+	r.lastErr = err
+	if err == nil {
+		r.lastSuccess = t
+	}
+}
+
+func (r *reconcileTracker) report(h *Health) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if !r.last.IsZero() {
+		last := r.last
+		h.LastReconcile = &last
+	}
+	if !r.lastSuccess.IsZero() {
+		success := r.lastSuccess
+		h.LastSuccessfulReconcile = &success
+	}
+	if r.lastErr != nil {
+		h.LastReconcileError = r.lastErr.Error()
+	}
+}
+
+// Health returns the state of the controller.
+func (c *Controller) Health() Health {
+	h := Health{
+		Informers: map[string]bool{
+			"gateway-api":          c.cache.HasSynced(),
+			"extension-configmaps": c.configMaps.HasSynced(),
+		},
+	}
+	if c.namespaces != nil {
+		h.Informers["namespaces"] = c.namespaces.HasSynced()
+	}
+	if c.runtimeConfig != nil {
+		h.Informers["runtime-configmap"] = c.runtimeConfig.HasSynced()
+	}
+	if sc := c.statusController; sc != nil && c.statusEnabled.Load() {
+		h.StatusWriter = true
+		h.PendingStatusWrites = sc.Pending()
+	}
+	c.reconciles.report(&h)
+
+	h.Ready = h.LastReconcileError == ""
+	for _, synced := range h.Informers {
+		h.Ready = h.Ready && synced
+	}
+	return h
+}
@@ -0,0 +1,48 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestReconcileTracker(t *testing.T) {
+	var r reconcileTracker
+	h := Health{}
+	r.report(&h)
+	assert.Equal(t, h, Health{})
+
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r.record(t0, nil)
+	t1 := t0.Add(time.Minute)
+	r.record(t1, errors.New("failed to get credentials"))
+
+	h = Health{}
+	r.report(&h)
+	assert.Equal(t, *h.LastReconcile, t1)
+	assert.Equal(t, *h.LastSuccessfulReconcile, t0)
+	assert.Equal(t, h.LastReconcileError, "failed to get credentials")
+
+	// A successful reconciliation clears the error
+	r.record(t1.Add(time.Minute), nil)
+	h = Health{}
+	r.report(&h)
+	assert.Equal(t, *h.LastSuccessfulReconcile, t1.Add(time.Minute))
+	assert.Equal(t, h.LastReconcileError, "")
+}
@@ -0,0 +1,111 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"net/netip"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/idna"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+const (
+	maxHostnameLength      = 253
+	maxHostnameLabelLength = 63
+)
+
+var hostnameLabelRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// validateHostname checks a listener or route hostname. The CRD schema rejects most invalid hostnames, but
+// it is not enforced everywhere, and a hostname it rejects would otherwise just never match any traffic.
+// The error describes the issue, including the form to use instead where it can be derived.
+func validateHostname(h k8s.Hostname) error {
+	hostname := string(h)
+	if hostname == "" {
+		return fmt.Errorf("hostname must not be empty")
+	}
+	if _, err := netip.ParseAddr(hostname); err == nil {
+		return fmt.Errorf("hostname %q must not be an IP address", hostname)
+	}
+	if !isASCII(hostname) {
+		ascii, err := idna.Lookup.ToASCII(hostname)
+		if err != nil {
+			return fmt.Errorf("hostname %q is not a valid internationalized domain name: %v", hostname, err)
+		}
+		return fmt.Errorf("hostname %q must be ASCII; use its punycode form %q", hostname, ascii)
+	}
+	if lower := strings.ToLower(hostname); lower != hostname {
+		return fmt.Errorf("hostname %q must be lowercase; use %q", hostname, lower)
+	}
+	if len(hostname) > maxHostnameLength {
+		return fmt.Errorf("hostname %q is %d characters long, exceeding the limit of %d", hostname, len(hostname), maxHostnameLength)
+	}
+	if strings.HasSuffix(hostname, ".") {
+		return fmt.Errorf("hostname %q must not end with a dot; use %q", hostname, strings.TrimSuffix(hostname, "."))
+	}
+
+	labels := strings.Split(hostname, ".")
+	for i, label := range labels {
+		switch {
+		case label == "*":
+			if i != 0 {
+				return fmt.Errorf("hostname %q has a wildcard in label %d; a wildcard is only allowed as the first label, such as *.example.com",
+					hostname, i+1)
+			}
+			if len(labels) == 1 {
+				return fmt.Errorf("hostname %q must have at least one label after the wildcard, such as *.example.com", hostname)
+			}
+		case strings.Contains(label, "*"):
+			return fmt.Errorf("hostname %q has a partial wildcard label %q; a wildcard must be a full label, such as *.example.com",
+				hostname, label)
+		case label == "":
+			return fmt.Errorf("hostname %q has an empty label", hostname)
+		case len(label) > maxHostnameLabelLength:
+			return fmt.Errorf("hostname %q has label %q of %d characters, exceeding the limit of %d",
+				hostname, label, len(label), maxHostnameLabelLength)
+		case !hostnameLabelRegex.MatchString(label):
+			return fmt.Errorf("hostname %q has invalid label %q; labels must consist of lowercase alphanumeric characters or '-', "+
+				"and start and end with an alphanumeric character", hostname, label)
+		case strings.HasPrefix(label, "xn--"):
+			if _, err := idna.Lookup.ToUnicode(label); err != nil {
+				return fmt.Errorf("hostname %q has label %q which is not valid punycode: %v", hostname, label, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateHostnames checks all hostnames, see validateHostname.
+func validateHostnames(hostnames []k8s.Hostname) error {
+	for _, h := range hostnames {
+		if err := validateHostname(h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,80 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"strings"
+	"testing"
+
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestValidateHostname(t *testing.T) {
+	cases := []struct {
+		hostname string
+		// err is a substring of the expected error, empty if the hostname is valid
+		err string
+	}{
+		{hostname: "example.com"},
+		{hostname: "*.example.com"},
+		{hostname: "a-b.c1.example"},
+		{hostname: "xn--bcher-kva.example"},
+		{hostname: "", err: "must not be empty"},
+		{hostname: "10.0.0.1", err: "must not be an IP address"},
+		{hostname: "::1", err: "must not be an IP address"},
+		{hostname: "bücher.example", err: `use its punycode form "xn--bcher-kva.example"`},
+		{hostname: "Example.com", err: `must be lowercase; use "example.com"`},
+		{hostname: "example.com.", err: `must not end with a dot; use "example.com"`},
+		{hostname: "*", err: "at least one label after the wildcard"},
+		{hostname: "*.*.example.com", err: "wildcard in label 2"},
+		{hostname: "foo.*.example.com", err: "wildcard in label 2"},
+		{hostname: "*foo.example.com", err: `partial wildcard label "*foo"`},
+		{hostname: "foo..example.com", err: "empty label"},
+		{hostname: "-foo.example.com", err: `invalid label "-foo"`},
+		{hostname: "foo_bar.example.com", err: `invalid label "foo_bar"`},
+		{hostname: strings.Repeat("a", 64) + ".example", err: "of 64 characters, exceeding the limit of 63"},
+		{hostname: strings.Repeat("a.", 127) + "aa", err: "is 256 characters long, exceeding the limit of 253"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.hostname, func(t *testing.T) {
+			err := validateHostname(k8s.Hostname(tt.hostname))
+			if tt.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected error containing %q", tt.err)
+			}
+			if !strings.Contains(err.Error(), tt.err) {
+				t.Fatalf("expected error containing %q, got %q", tt.err, err)
+			}
+		})
+	}
+}
+
+func TestReferenceAllowedInvalidHostname(t *testing.T) {
+	parent := &parentInfo{
+		AllowedKinds: []k8s.RouteGroupKind{{Kind: k8s.Kind(gvk.HTTPRoute.Kind)}},
+		Hostnames:    []string{"*/*.example.com"},
+	}
+	ref := parentReference{parentKey: parentKey{Kind: gvk.KubernetesGateway, Name: "gateway", Namespace: "default"}}
+
+	assert.Equal(t, referenceAllowed(parent, gvk.HTTPRoute, ref, []k8s.Hostname{"foo.example.com"}, "default"), nil)
+	denied := referenceAllowed(parent, gvk.HTTPRoute, ref, []k8s.Hostname{"foo.example.com", "foo.*.example.com"}, "default")
+	assert.Equal(t, denied.Reason, ParentErrorInvalidHostname)
+}
@@ -0,0 +1,143 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"sync"
+	"sync/atomic"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pkg/util/sets"
+)
+
+const (
+	// runtimeDefaultGatewayClassKey is the key of the runtime ConfigMap overriding PILOT_GATEWAY_API_DEFAULT_GATEWAYCLASS.
+	runtimeDefaultGatewayClassKey = "defaultGatewayClass"
+	// runtimeControllerNameKey is the key of the runtime ConfigMap overriding PILOT_GATEWAY_API_CONTROLLER_NAME.
+	runtimeControllerNameKey = "controllerName"
+)
+
+// controllerIdentity determines the GatewayClasses owned by istiod: the name of its default GatewayClass, and the
+// controller name of the classes it reconciles. It can change at runtime, so it is never modified once created; a
+// change stores a new identity in its Identity instead.
+type controllerIdentity struct {
+	defaultClassName k8s.ObjectName
+	controllerName   k8s.GatewayController
+	// previousControllerNames are the names this controller was previously known as
+	previousControllerNames sets.String
+	classInfos              map[k8s.GatewayController]classInfo
+	builtinClasses          map[k8s.ObjectName]k8s.GatewayController
+}
+
+func newControllerIdentity(defaultClass k8s.ObjectName, controller k8s.GatewayController, previous sets.String) *controllerIdentity {
+	return &controllerIdentity{
+		defaultClassName:        defaultClass,
+		controllerName:          controller,
+		previousControllerNames: previous,
+		classInfos:              getClassInfos(controller),
+		builtinClasses:          getBuiltinClasses(defaultClass, controller),
+	}
+}
+
+// envIdentity returns the identity of the controller set by the environment.
+func envIdentity() *controllerIdentity {
+	return newControllerIdentity(k8s.ObjectName(features.GatewayAPIDefaultGatewayClass),
+		k8s.GatewayController(features.GatewayAPIControllerName), features.GatewayAPIPreviousControllerNames)
+}
+
+// Identity holds the identity of a gateway controller. It is shared by the controllers converting the Gateway API
+// resources, creating the default GatewayClass and deploying the Gateways, so they agree on the GatewayClasses they
+// own. It starts with the identity set by the environment, and changes with the runtime ConfigMap.
+type Identity struct {
+	current atomic.Pointer[controllerIdentity]
+
+	handlersMu sync.Mutex
+	handlers   map[int]func()
+	handlerID  int
+}
+
+// NewIdentity returns the identity set by the environment.
+func NewIdentity() *Identity {
+	i := &Identity{handlers: map[int]func(){}}
+	i.current.Store(envIdentity())
+	return i
+}
+
+// load returns the current identity of the controller. Callers needing several of its fields should use the same
+// identity, so they are consistent if it changes concurrently.
+func (i *Identity) load() *controllerIdentity {
+	return i.current.Load()
+}
+
+// set changes the default GatewayClass and the controller name, returning whether they changed. The current
+// controller name becomes a previous name, so the route statuses written under it are replaced.
+func (i *Identity) set(defaultClass k8s.ObjectName, controller k8s.GatewayController) bool {
+	old := i.load()
+	if old.defaultClassName == defaultClass && old.controllerName == controller {
+		return false
+	}
+	previous := old.previousControllerNames.Copy()
+	if old.controllerName != controller {
+		previous.Insert(string(old.controllerName))
+		previous.Delete(string(controller))
+	}
+	i.current.Store(newControllerIdentity(defaultClass, controller, previous))
+	log.Infof("gateway controller identity changed: default GatewayClass %q, controller name %q", defaultClass, controller)
+
+	i.handlersMu.Lock()
+	handlers := make([]func(), 0, len(i.handlers))
+	for _, h := range i.handlers {
+		handlers = append(handlers, h)
+	}
+	i.handlersMu.Unlock()
+	for _, h := range handlers {
+		h()
+	}
+	return true
+}
+
+// onChange registers a handler called when the identity of the controller changes. The returned function removes
+// the handler.
+func (i *Identity) onChange(h func()) func() {
+	i.handlersMu.Lock()
+	defer i.handlersMu.Unlock()
+	i.handlerID++
+	id := i.handlerID
+	i.handlers[id] = h
+	return func() {
+		i.handlersMu.Lock()
+		defer i.handlersMu.Unlock()
+		delete(i.handlers, id)
+	}
+}
+
+// runtimeIdentity returns the identity set by the runtime ConfigMap. Keys missing from the ConfigMap, or a missing
+// ConfigMap, use the values of the environment.
+func runtimeIdentity(cm *corev1.ConfigMap) (k8s.ObjectName, k8s.GatewayController) {
+	defaultClass := features.GatewayAPIDefaultGatewayClass
+	controller := features.GatewayAPIControllerName
+	if cm != nil {
+		if v := cm.Data[runtimeDefaultGatewayClassKey]; v != "" {
+			defaultClass = v
+		}
+		if v := cm.Data[runtimeControllerNameKey]; v != "" {
+			controller = v
+		}
+	}
+	return k8s.ObjectName(defaultClass), k8s.GatewayController(controller)
+}
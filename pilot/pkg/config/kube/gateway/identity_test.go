@@ -0,0 +1,94 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// defaultClassName returns the default GatewayClass set by the environment, which the controllers start with.
+func defaultClassName() k8s.ObjectName {
+	return envIdentity().defaultClassName
+}
+
+// controllerName returns the controller name set by the environment, which the controllers start with.
+func controllerName() k8s.GatewayController {
+	return envIdentity().controllerName
+}
+
+func TestSetIdentity(t *testing.T) {
+	identity := NewIdentity()
+	identity.current.Store(newControllerIdentity(defaultClassName(), controllerName(), sets.New("example.com/previous-controller")))
+	original := controllerName()
+	calls := 0
+	remove := identity.onChange(func() {
+		calls++
+	})
+	defer remove()
+
+	assert.Equal(t, identity.set(defaultClassName(), controllerName()), false)
+	assert.Equal(t, calls, 0)
+
+	// The former name becomes a previous name, so its statuses are replaced
+	assert.Equal(t, identity.set("renamed", "example.com/renamed-controller"), true)
+	assert.Equal(t, calls, 1)
+	id := identity.load()
+	assert.Equal(t, id.defaultClassName, k8s.ObjectName("renamed"))
+	assert.Equal(t, id.controllerName, k8s.GatewayController("example.com/renamed-controller"))
+	assert.Equal(t, id.builtinClasses["renamed"], k8s.GatewayController("example.com/renamed-controller"))
+	_, known := id.classInfos[original]
+	assert.Equal(t, known, false)
+	_, known = id.classInfos["example.com/renamed-controller"]
+	assert.Equal(t, known, true)
+	assert.Equal(t, sets.SortedList(id.previousControllerNames), []string{"example.com/previous-controller", string(original)})
+
+	// Going back to a previous name does not consider it stale
+	assert.Equal(t, identity.set("renamed", original), true)
+	assert.Equal(t, calls, 2)
+	assert.Equal(t, sets.SortedList(identity.load().previousControllerNames),
+		[]string{"example.com/previous-controller", "example.com/renamed-controller"})
+
+	remove()
+	identity.set("other", original)
+	assert.Equal(t, calls, 2)
+
+	// The identities of the controllers are independent
+	assert.Equal(t, NewIdentity().load().controllerName, original)
+	assert.Equal(t, NewIdentity().load().defaultClassName, defaultClassName())
+}
+
+func TestRuntimeIdentity(t *testing.T) {
+	class, controller := runtimeIdentity(nil)
+	assert.Equal(t, class, k8s.ObjectName(features.GatewayAPIDefaultGatewayClass))
+	assert.Equal(t, controller, k8s.GatewayController(features.GatewayAPIControllerName))
+
+	class, controller = runtimeIdentity(&corev1.ConfigMap{Data: map[string]string{runtimeControllerNameKey: "openshift.io/gateway-controller"}})
+	assert.Equal(t, class, k8s.ObjectName(features.GatewayAPIDefaultGatewayClass))
+	assert.Equal(t, controller, k8s.GatewayController("openshift.io/gateway-controller"))
+
+	class, controller = runtimeIdentity(&corev1.ConfigMap{Data: map[string]string{
+		runtimeDefaultGatewayClassKey: "openshift",
+		runtimeControllerNameKey:      "openshift.io/gateway-controller",
+	}})
+	assert.Equal(t, class, k8s.ObjectName("openshift"))
+	assert.Equal(t, controller, k8s.GatewayController("openshift.io/gateway-controller"))
+}
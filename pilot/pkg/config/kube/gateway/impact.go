@@ -0,0 +1,101 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/serviceregistry/kube"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/schema/kind"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// routeKinds are the kinds of the routes attaching to Gateways and Services.
+var routeKinds = []config.GroupVersionKind{gvk.HTTPRoute, gvk.GRPCRoute, gvk.TCPRoute, gvk.TLSRoute}
+
+// Dependents returns the Gateway API resources affected by the deletion of a resource: the routes attached to a
+// Gateway, the routes attached to a Service or sending traffic to it, and the resources referencing a Secret or a
+// ConfigMap.
+func (c *Controller) Dependents(ref model.ConfigKey) []model.ConfigKey {
+	c.stateMu.RLock()
+	state := c.state
+	c.stateMu.RUnlock()
+	var routes []config.Config
+	for _, k := range routeKinds {
+		routes = append(routes, c.cache.List(k, metav1.NamespaceAll)...)
+	}
+	return dependents(state, routes, ref, c.domain)
+}
+
+func dependents(state IstioResources, routes []config.Config, ref model.ConfigKey, domain string) []model.ConfigKey {
+	out := sets.New[model.ConfigKey]()
+	switch ref.Kind {
+	case kind.Secret, kind.ConfigMap:
+		out.InsertAll(state.ResourceReferences[ref]...)
+	case kind.KubernetesGateway:
+		out.InsertAll(attachedRoutes(routes, gvk.KubernetesGateway, ref)...)
+	case kind.Service:
+		out.InsertAll(attachedRoutes(routes, gvk.Service, ref)...)
+		hostname := string(kube.ServiceHostname(ref.Name, ref.Namespace, domain))
+		for _, vs := range state.VirtualService {
+			if slices.Contains(model.VirtualServiceDestinationHosts(vs.Spec.(*istio.VirtualService)), hostname) {
+				out.InsertAll(model.VirtualServiceDependencies(vs)...)
+			}
+		}
+	}
+	res := out.UnsortedList()
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].String() < res[j].String()
+	})
+	return res
+}
+
+// attachedRoutes returns the routes with a parent reference to the resource of the given type.
+func attachedRoutes(routes []config.Config, parent config.GroupVersionKind, ref model.ConfigKey) []model.ConfigKey {
+	var out []model.ConfigKey
+	for _, r := range routes {
+		for _, p := range routeParentRefs(r) {
+			pk, err := toInternalParentReference(p, r.Namespace)
+			if err != nil || pk.Kind != parent || pk.Name != ref.Name || pk.Namespace != ref.Namespace {
+				continue
+			}
+			out = append(out, model.ConfigKey{Kind: kind.MustFromGVK(r.GroupVersionKind), Name: r.Name, Namespace: r.Namespace})
+			break
+		}
+	}
+	return out
+}
+
+func routeParentRefs(r config.Config) []k8s.ParentReference {
+	switch spec := r.Spec.(type) {
+	case *k8s.HTTPRouteSpec:
+		return spec.ParentRefs
+	case *k8s.GRPCRouteSpec:
+		return spec.ParentRefs
+	case *k8s.TCPRouteSpec:
+		return spec.ParentRefs
+	case *k8s.TLSRouteSpec:
+		return spec.ParentRefs
+	}
+	return nil
+}
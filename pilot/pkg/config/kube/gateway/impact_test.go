@@ -0,0 +1,81 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/schema/kind"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestDependents(t *testing.T) {
+	route := func(k config.GroupVersionKind, ns, name string, spec config.Spec) config.Config {
+		return config.Config{Meta: config.Meta{GroupVersionKind: k, Namespace: ns, Name: name}, Spec: spec}
+	}
+	gatewayRef := k8s.ParentReference{Name: "gw", Namespace: ptr.Of(k8s.Namespace("infra"))}
+	serviceRef := k8s.ParentReference{Name: "reviews", Kind: ptr.Of(k8s.Kind("Service")), Group: ptr.Of(k8s.Group(""))}
+	routes := []config.Config{
+		route(gvk.HTTPRoute, "team-a", "api", &k8s.HTTPRouteSpec{CommonRouteSpec: k8s.CommonRouteSpec{ParentRefs: []k8s.ParentReference{gatewayRef}}}),
+		route(gvk.TCPRoute, "team-b", "db", &k8s.TCPRouteSpec{CommonRouteSpec: k8s.CommonRouteSpec{ParentRefs: []k8s.ParentReference{gatewayRef}}}),
+		route(gvk.HTTPRoute, "infra", "local", &k8s.HTTPRouteSpec{CommonRouteSpec: k8s.CommonRouteSpec{
+			ParentRefs: []k8s.ParentReference{{Name: "gw"}},
+		}}),
+		route(gvk.HTTPRoute, "default", "mesh", &k8s.HTTPRouteSpec{CommonRouteSpec: k8s.CommonRouteSpec{ParentRefs: []k8s.ParentReference{serviceRef}}}),
+	}
+	secret := model.ConfigKey{Kind: kind.Secret, Name: "cert", Namespace: "infra"}
+	state := IstioResources{
+		ResourceReferences: map[model.ConfigKey][]model.ConfigKey{
+			secret: {{Kind: kind.KubernetesGateway, Name: "gw", Namespace: "infra"}},
+		},
+		VirtualService: []config.Config{{
+			Meta: config.Meta{
+				GroupVersionKind: gvk.VirtualService, Namespace: "team-a", Name: "api-0-istio-autogenerated-k8s-gateway",
+				Annotations: map[string]string{
+					constants.InternalRouteSemantics: constants.RouteSemanticsGateway,
+					constants.InternalParentNames:    "HTTPRoute/api.team-a,GRPCRoute/grpc.team-c",
+				},
+			},
+			Spec: &istio.VirtualService{Http: []*istio.HTTPRoute{{
+				Route: []*istio.HTTPRouteDestination{{Destination: &istio.Destination{Host: "reviews.default.svc.cluster.local"}}},
+			}}},
+		}},
+	}
+
+	assert.Equal(t, dependents(state, routes, secret, "cluster.local"), []model.ConfigKey{
+		{Kind: kind.KubernetesGateway, Name: "gw", Namespace: "infra"},
+	})
+	assert.Equal(t, dependents(state, routes, model.ConfigKey{Kind: kind.KubernetesGateway, Name: "gw", Namespace: "infra"}, "cluster.local"),
+		[]model.ConfigKey{
+			{Kind: kind.HTTPRoute, Name: "local", Namespace: "infra"},
+			{Kind: kind.HTTPRoute, Name: "api", Namespace: "team-a"},
+			{Kind: kind.TCPRoute, Name: "db", Namespace: "team-b"},
+		})
+	assert.Equal(t, dependents(state, routes, model.ConfigKey{Kind: kind.Service, Name: "reviews", Namespace: "default"}, "cluster.local"),
+		[]model.ConfigKey{
+			{Kind: kind.GRPCRoute, Name: "grpc", Namespace: "team-c"},
+			{Kind: kind.HTTPRoute, Name: "mesh", Namespace: "default"},
+			{Kind: kind.HTTPRoute, Name: "api", Namespace: "team-a"},
+		})
+	assert.Equal(t, len(dependents(state, routes, model.ConfigKey{Kind: kind.Service, Name: "ratings", Namespace: "default"}, "cluster.local")), 0)
+}
@@ -0,0 +1,200 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/yaml"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/schema/kind"
+)
+
+// ipAccessExtension is the gatewayExtensionLabel value of an IP access policy, attached to a Gateway or one of its
+// listeners by its targetRef. The policy is read from the ipAccessExtension key of the ConfigMap data.
+const ipAccessExtension = "ip-access"
+
+// ipAccessConfig is the format of an IP access policy stored in an extension ConfigMap.
+type ipAccessConfig struct {
+	TargetRef ipAccessTargetRef `json:"targetRef"`
+	// Allow lists the CIDRs, or addresses, the connections are accepted from. If empty, any address not denied is
+	// accepted.
+	Allow []string `json:"allow,omitempty"`
+	// Deny lists the CIDRs, or addresses, the connections are rejected from, taking precedence over Allow.
+	Deny []string `json:"deny,omitempty"`
+}
+
+// ipAccessTargetRef is the Gateway, in the namespace of the ConfigMap, an IP access policy applies to. If the
+// sectionName is set, the policy only applies to the listener of that name.
+type ipAccessTargetRef struct {
+	Group       string `json:"group,omitempty"`
+	Kind        string `json:"kind,omitempty"`
+	Name        string `json:"name"`
+	SectionName string `json:"sectionName,omitempty"`
+}
+
+// ipAccessPolicy is an IP access policy resolved from an extension ConfigMap.
+type ipAccessPolicy struct {
+	source types.NamespacedName
+	policy *model.IPAccessPolicy
+	// err is set if the policy is invalid. Such a policy denies all the connections, rather than accepting the
+	// addresses it meant to deny.
+	err *ConfigError
+}
+
+// gatewayIPAccessPolicies are the IP access policies attached to a Gateway.
+type gatewayIPAccessPolicies struct {
+	gateway   *ipAccessPolicy
+	listeners map[k8s.SectionName]*ipAccessPolicy
+}
+
+// forListener returns the IP access policy of a listener. A policy attached to the listener takes precedence over a
+// policy attached to the whole Gateway.
+func (g *gatewayIPAccessPolicies) forListener(name k8s.SectionName) *ipAccessPolicy {
+	if g == nil {
+		return nil
+	}
+	if p, f := g.listeners[name]; f {
+		return p
+	}
+	return g.gateway
+}
+
+// annotate sets the policy on the annotations of a generated Istio Gateway.
+func (p *ipAccessPolicy) annotate(meta map[string]string) {
+	if p == nil || p.policy.IsEmpty() {
+		return
+	}
+	if js, err := json.Marshal(p.policy); err == nil {
+		meta[model.InternalGatewayIPAccessAnnotation] = string(js)
+	}
+}
+
+// getGatewayIPAccessPolicies resolves the IP access policies from the extension ConfigMaps, indexed by the Gateway
+// they target. When several policies target the same Gateway or listener, the oldest one applies.
+func getGatewayIPAccessPolicies(r configContext) map[types.NamespacedName]*gatewayIPAccessPolicies {
+	var cms []*corev1.ConfigMap
+	for _, cm := range r.ExtensionConfigMaps {
+		if cm.Labels[gatewayExtensionLabel] == ipAccessExtension {
+			cms = append(cms, cm)
+		}
+	}
+	sort.Slice(cms, func(i, j int) bool {
+		if cms[i].CreationTimestamp.Equal(&cms[j].CreationTimestamp) {
+			return cms[i].Namespace+"/"+cms[i].Name < cms[j].Namespace+"/"+cms[j].Name
+		}
+		return cms[i].CreationTimestamp.Before(&cms[j].CreationTimestamp)
+	})
+
+	res := map[types.NamespacedName]*gatewayIPAccessPolicies{}
+	for _, cm := range cms {
+		cfg, err := parseIPAccessConfig(cm)
+		if err != nil {
+			// Without a target, there is no status to report the error on
+			log.Warnf("ignoring IP access policy %s/%s: %v", cm.Namespace, cm.Name, err)
+			continue
+		}
+		target := types.NamespacedName{Namespace: cm.Namespace, Name: cfg.TargetRef.Name}
+		// Track the reference, so changing the policy updates the gateway.
+		key := model.ConfigKey{Kind: kind.ConfigMap, Name: cm.Name, Namespace: cm.Namespace}
+		r.resourceReferences[key] = append(r.resourceReferences[key], model.ConfigKey{
+			Kind:      kind.KubernetesGateway,
+			Name:      target.Name,
+			Namespace: target.Namespace,
+		})
+
+		policy := buildIPAccessPolicy(cm, cfg)
+		gw := res[target]
+		if gw == nil {
+			gw = &gatewayIPAccessPolicies{listeners: map[k8s.SectionName]*ipAccessPolicy{}}
+			res[target] = gw
+		}
+		if cfg.TargetRef.SectionName == "" {
+			if gw.gateway != nil {
+				log.Warnf("ignoring IP access policy %s/%s: Gateway %s already has the policy %s",
+					cm.Namespace, cm.Name, target, gw.gateway.source)
+				continue
+			}
+			gw.gateway = policy
+			continue
+		}
+		section := k8s.SectionName(cfg.TargetRef.SectionName)
+		if cur, f := gw.listeners[section]; f {
+			log.Warnf("ignoring IP access policy %s/%s: listener %s of Gateway %s already has the policy %s",
+				cm.Namespace, cm.Name, section, target, cur.source)
+			continue
+		}
+		gw.listeners[section] = policy
+	}
+	return res
+}
+
+// parseIPAccessConfig parses an IP access policy and validates its target.
+func parseIPAccessConfig(cm *corev1.ConfigMap) (*ipAccessConfig, error) {
+	data, f := cm.Data[ipAccessExtension]
+	if !f {
+		return nil, fmt.Errorf("missing %q key", ipAccessExtension)
+	}
+	cfg := &ipAccessConfig{}
+	if err := yaml.UnmarshalStrict([]byte(data), cfg); err != nil {
+		return nil, err
+	}
+	ref := cfg.TargetRef
+	if (ref.Group != "" && ref.Group != gvk.KubernetesGateway.Group) || (ref.Kind != "" && ref.Kind != gvk.KubernetesGateway.Kind) {
+		return nil, fmt.Errorf("unsupported targetRef %s/%s, only Gateway is allowed", ref.Group, ref.Kind)
+	}
+	if ref.Name == "" {
+		return nil, fmt.Errorf("targetRef name must be set")
+	}
+	return cfg, nil
+}
+
+// buildIPAccessPolicy validates the CIDRs of a policy. An invalid policy denies all the connections.
+func buildIPAccessPolicy(cm *corev1.ConfigMap, cfg *ipAccessConfig) *ipAccessPolicy {
+	res := &ipAccessPolicy{
+		source: types.NamespacedName{Namespace: cm.Namespace, Name: cm.Name},
+		policy: &model.IPAccessPolicy{Allow: cfg.Allow, Deny: cfg.Deny},
+	}
+	for _, cidr := range append(append([]string{}, cfg.Allow...), cfg.Deny...) {
+		if err := validateIPAccessCIDR(cidr); err != nil {
+			res.policy = &model.IPAccessPolicy{Deny: []string{"0.0.0.0/0", "::/0"}}
+			res.err = &ConfigError{
+				Reason:  InvalidIPAccessPolicy,
+				Message: fmt.Sprintf("invalid CIDR %q in IP access policy %s, denying all connections", cidr, res.source),
+			}
+			break
+		}
+	}
+	return res
+}
+
+// validateIPAccessCIDR validates a CIDR, or a single address, of an IP access policy.
+func validateIPAccessCIDR(cidr string) error {
+	if strings.Contains(cidr, "/") {
+		_, err := netip.ParsePrefix(cidr)
+		return err
+	}
+	_, err := netip.ParseAddr(cidr)
+	return err
+}
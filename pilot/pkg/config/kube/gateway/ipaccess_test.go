@@ -0,0 +1,90 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/kind"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func ipAccessConfigMap(name string, age time.Duration, data string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			Labels:            map[string]string{gatewayExtensionLabel: ipAccessExtension},
+			CreationTimestamp: metav1.NewTime(time.Unix(0, 0).Add(-age)),
+		},
+		Data: map[string]string{ipAccessExtension: data},
+	}
+}
+
+func TestGetGatewayIPAccessPolicies(t *testing.T) {
+	ctx := configContext{
+		GatewayResources:   GatewayResources{ExtensionConfigMaps: map[types.NamespacedName]*corev1.ConfigMap{}},
+		resourceReferences: map[model.ConfigKey][]model.ConfigKey{},
+	}
+	for _, cm := range []*corev1.ConfigMap{
+		ipAccessConfigMap("gateway", time.Hour, `{targetRef: {name: gw}, deny: [10.1.0.0/16]}`),
+		ipAccessConfigMap("newer", time.Minute, `{targetRef: {name: gw}, allow: [0.0.0.0/0]}`),
+		ipAccessConfigMap("https", time.Minute, `{targetRef: {group: gateway.networking.k8s.io, kind: Gateway, name: gw, sectionName: https},
+  allow: [10.0.0.0/8, 192.168.1.1]}`),
+		ipAccessConfigMap("invalid", time.Minute, `{targetRef: {name: gw, sectionName: tcp}, allow: [10.0.0.0/33]}`),
+		ipAccessConfigMap("route", time.Minute, `{targetRef: {kind: HTTPRoute, name: gw}, allow: [10.0.0.0/8]}`),
+		ipAccessConfigMap("malformed", time.Minute, `{targetRef: {name: gw}, allowed: [10.0.0.0/8]}`),
+	} {
+		ctx.ExtensionConfigMaps[config.NamespacedName(cm)] = cm
+	}
+	cors := ipAccessConfigMap("cors", time.Minute, `{targetRef: {name: gw}, allow: [10.0.0.0/8]}`)
+	cors.Labels[gatewayExtensionLabel] = corsExtension
+	ctx.ExtensionConfigMaps[config.NamespacedName(cors)] = cors
+
+	policies := getGatewayIPAccessPolicies(ctx)
+	assert.Equal(t, len(policies), 1)
+	gw := policies[types.NamespacedName{Namespace: "default", Name: "gw"}]
+
+	// The oldest policy of the Gateway applies to the listeners without their own policy
+	http := gw.forListener("http")
+	assert.Equal(t, http.source.Name, "gateway")
+	assert.Equal(t, http.policy, &model.IPAccessPolicy{Deny: []string{"10.1.0.0/16"}})
+	assert.Equal(t, http.err, nil)
+
+	https := gw.forListener("https")
+	assert.Equal(t, https.policy, &model.IPAccessPolicy{Allow: []string{"10.0.0.0/8", "192.168.1.1"}})
+	meta := map[string]string{}
+	https.annotate(meta)
+	assert.Equal(t, meta, map[string]string{model.InternalGatewayIPAccessAnnotation: `{"allow":["10.0.0.0/8","192.168.1.1"]}`})
+
+	// An invalid policy denies everything, rather than opening the listener
+	tcp := gw.forListener("tcp")
+	assert.Equal(t, tcp.policy, &model.IPAccessPolicy{Deny: []string{"0.0.0.0/0", "::/0"}})
+	assert.Equal(t, tcp.err.Reason, InvalidIPAccessPolicy)
+
+	assert.Equal(t, len(ctx.resourceReferences), 4)
+	assert.Equal(t, ctx.resourceReferences[model.ConfigKey{Kind: kind.ConfigMap, Name: "https", Namespace: "default"}],
+		[]model.ConfigKey{{Kind: kind.KubernetesGateway, Name: "gw", Namespace: "default"}})
+
+	var none *gatewayIPAccessPolicies
+	assert.Equal(t, none.forListener("http"), nil)
+}
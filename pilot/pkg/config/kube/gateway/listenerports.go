@@ -0,0 +1,168 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pkg/util/sets"
+)
+
+// listenerTargetPortsAnnotation maps listeners of a managed Gateway to dedicated ports of the gateway pods, as a comma
+// separated list of <listener name>=<port>. The generated Service forwards the port of each mapped listener to its
+// target port, other listeners are served on their own port.
+const listenerTargetPortsAnnotation = "gateway.istio.io/listener-target-ports"
+
+// reservedTargetPorts are the ports used by the proxy itself, which listeners cannot be mapped to.
+var reservedTargetPorts = sets.New[int32](15000, 15001, 15004, 15006, 15008, 15020, 15021, 15053, 15090)
+
+// listenerPortConflict explains why a listener cannot be served by the Service of its Gateway.
+type listenerPortConflict struct {
+	// protocol is set if the listener shares its port with a listener of an incompatible protocol. Otherwise, the
+	// target port of the listener is unavailable.
+	protocol bool
+	message  string
+}
+
+// listenerPorts are the ports of the Service generated for a Gateway.
+type listenerPorts struct {
+	servicePorts []corev1.ServicePort
+	// conflicts holds the listeners which cannot be served, by listener index
+	conflicts map[int]*listenerPortConflict
+	// err is set if the target ports annotation is invalid, in which case it is ignored
+	err *ConfigError
+}
+
+// resolveListenerPorts assigns the listeners of a Gateway to the ports of its Service. Listeners sharing a port must
+// have compatible protocols and the same target port; otherwise the first listener is served and the others are
+// reported as conflicted, rather than silently dropped from the Service.
+func resolveListenerPorts(annotations map[string]string, listeners []k8s.Listener) listenerPorts {
+	res := listenerPorts{conflicts: map[int]*listenerPortConflict{}}
+	targets, err := parseListenerTargetPorts(annotations[listenerTargetPortsAnnotation], listeners)
+	if err != nil {
+		res.err = &ConfigError{
+			Reason:  InvalidTargetPorts,
+			Message: fmt.Sprintf("invalid annotation %v: %v, serving all listeners on their own port", listenerTargetPortsAnnotation, err),
+		}
+		targets = nil
+	}
+
+	// The first listener using each port, by port
+	portOwners := map[int32]int{}
+	// The port forwarded to each target port
+	targetOwners := map[int32]int32{}
+	targetOf := func(l k8s.Listener) (int32, bool) {
+		if t, f := targets[string(l.Name)]; f {
+			return t, true
+		}
+		return int32(l.Port), false
+	}
+	for i, l := range listeners {
+		port := int32(l.Port)
+		target, mapped := targetOf(l)
+		if owner, f := portOwners[port]; f {
+			ol := listeners[owner]
+			ownerTarget, _ := targetOf(ol)
+			if protocolClass(ol.Protocol) != protocolClass(l.Protocol) {
+				res.conflicts[i] = &listenerPortConflict{
+					protocol: true,
+					message: fmt.Sprintf("port %d is already used by listener %q with protocol %v, which is incompatible with protocol %v",
+						port, ol.Name, ol.Protocol, l.Protocol),
+				}
+			} else if ownerTarget != target {
+				res.conflicts[i] = &listenerPortConflict{
+					message: fmt.Sprintf("port %d is already forwarded to target port %d by listener %q", port, ownerTarget, ol.Name),
+				}
+			}
+			continue
+		}
+		if other, f := targetOwners[target]; f {
+			res.conflicts[i] = &listenerPortConflict{
+				message: fmt.Sprintf("target port %d is already used by port %d", target, other),
+			}
+			continue
+		}
+		portOwners[port] = i
+		targetOwners[target] = port
+
+		name := string(l.Name)
+		if name == "" {
+			// Should not happen since name is required, but in case an invalid resource gets in...
+			name = fmt.Sprintf("%s-%d", strings.ToLower(string(l.Protocol)), i)
+		}
+		appProtocol := strings.ToLower(string(l.Protocol))
+		sp := corev1.ServicePort{
+			Name:        name,
+			Port:        port,
+			AppProtocol: &appProtocol,
+		}
+		if mapped {
+			sp.TargetPort = intstr.FromInt32(target)
+		}
+		res.servicePorts = append(res.servicePorts, sp)
+	}
+	return res
+}
+
+// parseListenerTargetPorts parses the target ports annotation, returning the target port of each mapped listener.
+func parseListenerTargetPorts(v string, listeners []k8s.Listener) (map[string]int32, error) {
+	if v == "" {
+		return nil, nil
+	}
+	names := sets.New[string]()
+	for _, l := range listeners {
+		names.Insert(string(l.Name))
+	}
+	targets := map[string]int32{}
+	for _, entry := range strings.Split(v, ",") {
+		name, port, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			return nil, fmt.Errorf("expected <listener name>=<port>, got %q", entry)
+		}
+		name = strings.TrimSpace(name)
+		if !names.Contains(name) {
+			return nil, fmt.Errorf("unknown listener %q", name)
+		}
+		if _, f := targets[name]; f {
+			return nil, fmt.Errorf("listener %q is mapped more than once", name)
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(port), 10, 32)
+		if err != nil || n < 1 || n > 65535 {
+			return nil, fmt.Errorf("invalid port %q for listener %q", port, name)
+		}
+		if reservedTargetPorts.Contains(int32(n)) {
+			return nil, fmt.Errorf("port %d of listener %q is reserved by the proxy", n, name)
+		}
+		targets[name] = int32(n)
+	}
+	return targets, nil
+}
+
+// protocolClass groups the listener protocols which can share a port. HTTPS and TLS listeners are both served with
+// TLS, and matched on SNI.
+func protocolClass(p k8s.ProtocolType) string {
+	switch p {
+	case k8s.HTTPSProtocolType, k8s.TLSProtocolType:
+		return string(k8s.TLSProtocolType)
+	default:
+		return string(p)
+	}
+}
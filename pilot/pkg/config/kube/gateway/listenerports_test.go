@@ -0,0 +1,111 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestResolveListenerPorts(t *testing.T) {
+	listener := func(name string, port k8s.PortNumber, protocol k8s.ProtocolType) k8s.Listener {
+		return k8s.Listener{Name: k8s.SectionName(name), Port: port, Protocol: protocol}
+	}
+	type servicePort struct {
+		name   string
+		port   int32
+		target int32
+	}
+	cases := []struct {
+		name      string
+		targets   string
+		listeners []k8s.Listener
+		ports     []servicePort
+		// conflicts holds whether each conflicted listener has a protocol conflict, by index
+		conflicts map[int]bool
+		invalid   bool
+	}{
+		{
+			name:      "shared port",
+			listeners: []k8s.Listener{listener("https", 443, k8s.HTTPSProtocolType), listener("tls", 443, k8s.TLSProtocolType)},
+			ports:     []servicePort{{name: "https", port: 443}},
+		},
+		{
+			name:      "protocol conflict",
+			listeners: []k8s.Listener{listener("http", 80, k8s.HTTPProtocolType), listener("tcp", 80, k8s.TCPProtocolType)},
+			ports:     []servicePort{{name: "http", port: 80}},
+			conflicts: map[int]bool{1: true},
+		},
+		{
+			name:    "target ports",
+			targets: "http=8080, tcp=9000",
+			listeners: []k8s.Listener{
+				listener("http", 80, k8s.HTTPProtocolType),
+				listener("tcp", 9000, k8s.TCPProtocolType),
+				listener("other", 8080, k8s.TCPProtocolType),
+			},
+			ports: []servicePort{{name: "http", port: 80, target: 8080}, {name: "tcp", port: 9000, target: 9000}},
+			// The last listener would be served on the target port of the first one
+			conflicts: map[int]bool{2: false},
+		},
+		{
+			name:      "shared port with different target ports",
+			targets:   "a=8443,b=9443",
+			listeners: []k8s.Listener{listener("a", 443, k8s.HTTPSProtocolType), listener("b", 443, k8s.HTTPSProtocolType)},
+			ports:     []servicePort{{name: "a", port: 443, target: 8443}},
+			conflicts: map[int]bool{1: false},
+		},
+		{
+			name:      "reserved target port",
+			targets:   "http=15021",
+			listeners: []k8s.Listener{listener("http", 80, k8s.HTTPProtocolType)},
+			ports:     []servicePort{{name: "http", port: 80}},
+			invalid:   true,
+		},
+		{
+			name:      "unknown listener",
+			targets:   "missing=8080",
+			listeners: []k8s.Listener{listener("http", 80, k8s.HTTPProtocolType)},
+			ports:     []servicePort{{name: "http", port: 80}},
+			invalid:   true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := resolveListenerPorts(map[string]string{listenerTargetPortsAnnotation: tt.targets}, tt.listeners)
+			var ports []servicePort
+			for _, p := range res.servicePorts {
+				ports = append(ports, servicePort{name: p.Name, port: p.Port, target: p.TargetPort.IntVal})
+				if p.TargetPort.IntVal != 0 {
+					assert.Equal(t, p.TargetPort.Type, intstr.Int)
+				}
+			}
+			assert.Equal(t, ports, tt.ports)
+			conflicts := map[int]bool{}
+			for i, c := range res.conflicts {
+				conflicts[i] = c.protocol
+			}
+			if tt.conflicts == nil {
+				tt.conflicts = map[int]bool{}
+			}
+			assert.Equal(t, conflicts, tt.conflicts)
+			assert.Equal(t, res.err != nil, tt.invalid)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"strings"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/util/sets"
+)
+
+const (
+	// unmatchedMethodAnnotation sets the response of a Gateway to the requests matching the rest of an HTTPRoute rule
+	// with a method match, but none of its methods. By default, such requests are not matched by any rule and get a
+	// 404, like in the mesh. With "405", they get a 405 with an Allow header listing the methods of the rules.
+	unmatchedMethodAnnotation = "gateway.istio.io/unmatched-method-response"
+
+	unmatchedMethodNotFound         = "404"
+	unmatchedMethodMethodNotAllowed = "405"
+)
+
+// gatewayMethodNotAllowed returns whether the Gateway responds to the requests of unmatched methods with a 405. An
+// invalid value is ignored, keeping the default 404.
+func gatewayMethodNotAllowed(obj config.Config) (bool, *ConfigError) {
+	v, f := obj.Annotations[unmatchedMethodAnnotation]
+	if !f {
+		return false, nil
+	}
+	switch v {
+	case unmatchedMethodNotFound:
+		return false, nil
+	case unmatchedMethodMethodNotAllowed:
+		return true, nil
+	default:
+		return false, &ConfigError{
+			Reason: InvalidUnmatchedMethodResponse,
+			Message: fmt.Sprintf("invalid value %q for annotation %v, expected %q or %q",
+				v, unmatchedMethodAnnotation, unmatchedMethodNotFound, unmatchedMethodMethodNotAllowed),
+		}
+	}
+}
+
+// appendMethodNotAllowedRoutes appends a route responding with a 405 for each match of the HTTP routes of a generated
+// VirtualService restricted to methods. It matches the same requests as the rules regardless of their method, and is
+// only reached if none of the routes matches, as it comes after all of them.
+func appendMethodNotAllowedRoutes(cfg *config.Config) {
+	vs := cfg.Spec.(*istio.VirtualService)
+	type fallback struct {
+		match   *istio.HTTPMatchRequest
+		methods sets.String
+	}
+	var fallbacks []*fallback
+	byMatch := map[string]*fallback{}
+	for _, route := range vs.Http {
+		for _, m := range route.Match {
+			method := m.GetMethod().GetExact()
+			if method == "" {
+				continue
+			}
+			match := m.DeepCopy()
+			match.Method = nil
+			key := match.String()
+			fb, f := byMatch[key]
+			if !f {
+				fb = &fallback{match: match, methods: sets.New[string]()}
+				byMatch[key] = fb
+				fallbacks = append(fallbacks, fb)
+			}
+			fb.methods.Insert(method)
+		}
+	}
+	routes := make([]*istio.HTTPRoute, 0, len(fallbacks))
+	for i, fb := range fallbacks {
+		routes = append(routes, &istio.HTTPRoute{
+			Name:  fmt.Sprintf("%s.%s.method-not-allowed.%d", cfg.Namespace, cfg.Name, i),
+			Match: []*istio.HTTPMatchRequest{fb.match},
+			DirectResponse: &istio.HTTPDirectResponse{
+				Status: 405,
+			},
+			Headers: &istio.Headers{
+				Response: &istio.Headers_HeaderOperations{
+					Set: map[string]string{"Allow": strings.Join(sets.SortedList(fb.methods), ", ")},
+				},
+			},
+		})
+	}
+	// The most specific matches come first, as for the routes, so the Allow header is the one of the closest match
+	sortHTTPRoutes(routes)
+	vs.Http = append(vs.Http, routes...)
+}
@@ -0,0 +1,90 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestCreateMethodMatch(t *testing.T) {
+	m, err := createMethodMatch(k8s.HTTPRouteMatch{})
+	assert.Equal(t, err, nil)
+	assert.Equal(t, m, nil)
+
+	m, err = createMethodMatch(k8s.HTTPRouteMatch{Method: ptr.Of(k8s.HTTPMethod("PATCH"))})
+	assert.Equal(t, err, nil)
+	assert.Equal(t, m, &istio.StringMatch{MatchType: &istio.StringMatch_Exact{Exact: "PATCH"}})
+}
+
+func TestGatewayMethodNotAllowed(t *testing.T) {
+	cases := []struct {
+		annotations map[string]string
+		want        bool
+		wantErr     bool
+	}{
+		{annotations: nil, want: false},
+		{annotations: map[string]string{unmatchedMethodAnnotation: "404"}, want: false},
+		{annotations: map[string]string{unmatchedMethodAnnotation: "405"}, want: true},
+		{annotations: map[string]string{unmatchedMethodAnnotation: "true"}, want: false, wantErr: true},
+	}
+	for _, tt := range cases {
+		got, err := gatewayMethodNotAllowed(config.Config{Meta: config.Meta{Annotations: tt.annotations}})
+		assert.Equal(t, got, tt.want)
+		assert.Equal(t, err != nil, tt.wantErr)
+	}
+}
+
+func TestAppendMethodNotAllowedRoutes(t *testing.T) {
+	exact := func(s string) *istio.StringMatch {
+		return &istio.StringMatch{MatchType: &istio.StringMatch_Exact{Exact: s}}
+	}
+	prefix := func(s string) *istio.StringMatch {
+		return &istio.StringMatch{MatchType: &istio.StringMatch_Prefix{Prefix: s}}
+	}
+	cfg := &config.Config{
+		Meta: config.Meta{Name: "api-0-istio-autogenerated-k8s-gateway", Namespace: "default"},
+		Spec: &istio.VirtualService{Http: []*istio.HTTPRoute{
+			{Name: "get", Match: []*istio.HTTPMatchRequest{{Uri: exact("/users"), Method: exact("GET")}}},
+			{Name: "post", Match: []*istio.HTTPMatchRequest{{Uri: exact("/users"), Method: exact("POST")}}},
+			{Name: "docs", Match: []*istio.HTTPMatchRequest{{Uri: prefix("/"), Method: exact("GET")}}},
+			{Name: "health", Match: []*istio.HTTPMatchRequest{{Uri: exact("/healthz")}}},
+		}},
+	}
+	appendMethodNotAllowedRoutes(cfg)
+
+	routes := cfg.Spec.(*istio.VirtualService).Http
+	assert.Equal(t, len(routes), 6)
+	// The fallbacks come after the routes, the most specific first
+	users, root := routes[4], routes[5]
+	assert.Equal(t, users.Match, []*istio.HTTPMatchRequest{{Uri: exact("/users")}})
+	assert.Equal(t, users.DirectResponse.Status, uint32(405))
+	assert.Equal(t, users.Headers.Response.Set, map[string]string{"Allow": "GET, POST"})
+	assert.Equal(t, root.Match, []*istio.HTTPMatchRequest{{Uri: prefix("/")}})
+	assert.Equal(t, root.Headers.Response.Set, map[string]string{"Allow": "GET"})
+
+	// Routes without method matches are left as they are
+	cfg = &config.Config{Spec: &istio.VirtualService{Http: []*istio.HTTPRoute{
+		{Name: "health", Match: []*istio.HTTPMatchRequest{{Uri: exact("/healthz")}}},
+	}}}
+	appendMethodNotAllowedRoutes(cfg)
+	assert.Equal(t, len(cfg.Spec.(*istio.VirtualService).Http), 1)
+}
@@ -0,0 +1,155 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/labels"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// mirrorComparisonAnnotation, set on an HTTPRoute or GRPCRoute, enables the comparison of the responses of the
+// backends its RequestMirror filters mirror the requests to. Its value is the percentage of the requests mirrored,
+// between 0 (exclusive) and 100.
+//
+// The mirrored requests of the route are sent by its Gateways to a dedicated subset of the mirror backend,
+// mirror-<route namespace>-<route name>, so the status codes and latencies of the shadow responses are reported by the
+// Envoy cluster outbound|<port>|<subset>|<backend hostname> of the Gateways, e.g. as upstream_rq_<status code> and
+// upstream_rq_time. As the cluster statistics are not reported by default, they must be included with the
+// sidecar.istio.io/statsInclusionRegexps annotation of the Gateway, e.g. "cluster\.outbound\|.*\|mirror-.*".
+// Comparing them with the statistics of the primary backend allows evaluating a canary backend before shifting
+// traffic to it.
+const mirrorComparisonAnnotation = "gateway.istio.io/mirror-comparison"
+
+// mirrorComparisonSubsetPrefix prefixes the subsets of the mirror backends generated for the compared routes.
+const mirrorComparisonSubsetPrefix = "mirror-"
+
+// parseMirrorComparison returns the percentage of the requests mirrored by a route, if its mirrors are compared.
+func parseMirrorComparison(obj config.Config) (*istio.Percent, *ConfigError) {
+	value, f := obj.Annotations[mirrorComparisonAnnotation]
+	if !f {
+		return nil, nil
+	}
+	percent, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(value, "%")), 64)
+	if err != nil || percent <= 0 || percent > 100 {
+		return nil, &ConfigError{
+			Reason: InvalidMirrorComparison,
+			Message: fmt.Sprintf("invalid %s %q, expected a percentage between 0 (exclusive) and 100, mirroring all the requests without comparison",
+				mirrorComparisonAnnotation, value),
+		}
+	}
+	return &istio.Percent{Value: percent}, nil
+}
+
+// applyMirrorComparison samples the requests mirrored by a gateway route, and sends them to the subset of the mirror
+// backend dedicated to the route. Mesh routes are not compared, as the proxies of the mesh would all need the subset.
+func applyMirrorComparison(obj config.Config, mirror *istio.HTTPMirrorPolicy, gateway bool) {
+	if !gateway || mirror == nil || mirror.Destination == nil {
+		return
+	}
+	percent, err := parseMirrorComparison(obj)
+	if percent == nil || err != nil {
+		return
+	}
+	mirror.Percentage = percent
+	mirror.Destination.Subset = mirrorComparisonSubset(obj)
+}
+
+// mirrorComparisonSubset returns the subset of the mirror backends dedicated to a route. Its name is the one of the
+// route when it fits in a subset name, so the statistics are easily attributed.
+func mirrorComparisonSubset(obj config.Config) string {
+	name := mirrorComparisonSubsetPrefix + obj.Namespace + "-" + strings.ReplaceAll(obj.Name, ".", "-")
+	if labels.IsDNS1123Label(name) {
+		return name
+	}
+	sum := sha256.Sum256([]byte(obj.Namespace + "/" + obj.Name))
+	return mirrorComparisonSubsetPrefix + hex.EncodeToString(sum[:])[:16]
+}
+
+// convertMirrorComparisons generates the DestinationRules declaring the subsets of the mirror backends of the compared
+// routes. They are generated in the namespaces of the Gateways, and only declare additional subsets: they are added to
+// the DestinationRule applying to the backend on the Gateways, wherever it is defined, so its traffic policy and its
+// other subsets are kept.
+func convertMirrorComparisons(ctx configContext, virtualServices []config.Config) []config.Config {
+	type key struct {
+		namespace string
+		host      string
+	}
+	subsets := map[key]sets.String{}
+	parents := map[key]sets.String{}
+	for _, cfg := range virtualServices {
+		vs := cfg.Spec.(*istio.VirtualService)
+		if len(vs.Gateways) != 1 || vs.Gateways[0] == constants.IstioMeshGateway {
+			continue
+		}
+		gwNamespace, _, f := strings.Cut(vs.Gateways[0], "/")
+		if !f {
+			continue
+		}
+		for _, route := range vs.Http {
+			for _, mirror := range route.Mirrors {
+				dst := mirror.GetDestination()
+				if !strings.HasPrefix(dst.GetSubset(), mirrorComparisonSubsetPrefix) {
+					continue
+				}
+				k := key{namespace: gwNamespace, host: dst.Host}
+				if subsets[k] == nil {
+					subsets[k] = sets.New[string]()
+					parents[k] = sets.New[string]()
+				}
+				subsets[k].Insert(dst.Subset)
+				parents[k].InsertAll(strings.Split(cfg.Annotations[constants.InternalParentNames], ",")...)
+			}
+		}
+	}
+
+	res := make([]config.Config, 0, len(subsets))
+	for k, names := range subsets {
+		dr := &istio.DestinationRule{
+			Host:     k.host,
+			ExportTo: []string{"."},
+		}
+		for _, name := range sets.SortedList(names) {
+			dr.Subsets = append(dr.Subsets, &istio.Subset{Name: name})
+		}
+		res = append(res, config.Config{
+			Meta: config.Meta{
+				GroupVersionKind: gvk.DestinationRule,
+				Name:             strings.ReplaceAll(k.host, ".", "-") + "-mirror-" + constants.KubernetesGatewayName,
+				Namespace:        k.namespace,
+				Domain:           ctx.Domain,
+				Annotations: map[string]string{
+					constants.InternalParentNames:       strings.Join(sets.SortedList(parents[k]), ","),
+					constants.InternalAdditionalSubsets: "true",
+				},
+			},
+			Spec: dr,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Namespace+"/"+res[i].Name < res[j].Namespace+"/"+res[j].Name
+	})
+	return res
+}
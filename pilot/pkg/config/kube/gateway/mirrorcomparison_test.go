@@ -0,0 +1,104 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"strings"
+	"testing"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestParseMirrorComparison(t *testing.T) {
+	cases := []struct {
+		value   string
+		percent float64
+		invalid bool
+	}{
+		{value: "10", percent: 10},
+		{value: "2.5%", percent: 2.5},
+		{value: "100", percent: 100},
+		{value: "0", invalid: true},
+		{value: "150", invalid: true},
+		{value: "all", invalid: true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.value, func(t *testing.T) {
+			obj := config.Config{Meta: config.Meta{Annotations: map[string]string{mirrorComparisonAnnotation: tt.value}}}
+			percent, err := parseMirrorComparison(obj)
+			assert.Equal(t, err != nil, tt.invalid)
+			assert.Equal(t, percent.GetValue(), tt.percent)
+		})
+	}
+}
+
+func TestConvertMirrorComparisons(t *testing.T) {
+	route := config.Config{Meta: config.Meta{
+		GroupVersionKind: gvk.HTTPRoute,
+		Name:             "checkout.v2",
+		Namespace:        "apps",
+		Annotations:      map[string]string{mirrorComparisonAnnotation: "25"},
+	}}
+	mirror := func() *istio.HTTPMirrorPolicy {
+		return &istio.HTTPMirrorPolicy{Destination: &istio.Destination{Host: "checkout-canary.apps.svc.cluster.local"}}
+	}
+
+	// Mesh routes are mirrored without comparison
+	mesh := mirror()
+	applyMirrorComparison(route, mesh, false)
+	assert.Equal(t, mesh, mirror())
+
+	compared := mirror()
+	applyMirrorComparison(route, compared, true)
+	assert.Equal(t, compared.Percentage.GetValue(), 25.0)
+	assert.Equal(t, compared.Destination.Subset, "mirror-apps-checkout-v2")
+
+	long := route
+	long.Name = strings.Repeat("a", 63)
+	assert.Equal(t, len(mirrorComparisonSubset(long)), len(mirrorComparisonSubsetPrefix)+16)
+
+	vs := func(gateway string, mirrors ...*istio.HTTPMirrorPolicy) config.Config {
+		return config.Config{
+			Meta: config.Meta{
+				GroupVersionKind: gvk.VirtualService,
+				Namespace:        "apps",
+				Annotations:      map[string]string{constants.InternalParentNames: "HTTPRoute/checkout.v2.apps"},
+			},
+			Spec: &istio.VirtualService{
+				Gateways: []string{gateway},
+				Http:     []*istio.HTTPRoute{{Mirrors: mirrors}},
+			},
+		}
+	}
+	drs := convertMirrorComparisons(configContext{GatewayResources: GatewayResources{Domain: "cluster.local"}}, []config.Config{
+		vs("istio-system/gateway", compared, mirror()),
+		vs("edge/gateway", compared),
+		vs(constants.IstioMeshGateway, mesh),
+	})
+	assert.Equal(t, len(drs), 2)
+	assert.Equal(t, drs[0].Namespace, "edge")
+	assert.Equal(t, drs[1].Namespace, "istio-system")
+	assert.Equal(t, drs[1].Annotations[constants.InternalParentNames], "HTTPRoute/checkout.v2.apps")
+	assert.Equal(t, drs[1].Annotations[constants.InternalAdditionalSubsets], "true")
+	assert.Equal(t, drs[1].Spec, &istio.DestinationRule{
+		Host:     "checkout-canary.apps.svc.cluster.local",
+		ExportTo: []string{"."},
+		Subsets:  []*istio.Subset{{Name: "mirror-apps-checkout-v2"}},
+	})
+}
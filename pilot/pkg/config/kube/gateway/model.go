@@ -16,10 +16,10 @@ package gateway
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	"istio.io/istio/pilot/pkg/credentials"
-	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
 	creds "istio.io/istio/pilot/pkg/model/credentials"
 	"istio.io/istio/pkg/config"
@@ -27,17 +27,20 @@ import (
 	"istio.io/istio/pkg/util/sets"
 )
 
-var (
-	defaultClassName = k8s.ObjectName(features.GatewayAPIDefaultGatewayClass)
-	controllerName   = k8s.GatewayController(features.GatewayAPIControllerName)
-)
-
 const (
 	gatewayAliasForAnnotationKey = "gateway.istio.io/alias-for"
 	gatewayTLSTerminateModeKey   = "gateway.istio.io/tls-terminate-mode"
+	gatewayTLSMinVersionKey      = "gateway.istio.io/tls-min-version"
+	gatewayTLSMaxVersionKey      = "gateway.istio.io/tls-max-version"
+	gatewayTLSCipherSuitesKey    = "gateway.istio.io/tls-cipher-suites"
+	gatewayTLSALPNProtocolsKey   = "gateway.istio.io/tls-alpn-protocols"
 	gatewayNameOverride          = "gateway.istio.io/name-override"
 	gatewaySAOverride            = "gateway.istio.io/service-account"
 	serviceTypeOverride          = "networking.istio.io/service-type"
+	// gatewayExternalDNSKey, set to true, publishes the hostnames of the gateway on its Service for external-dns
+	gatewayExternalDNSKey = "gateway.istio.io/external-dns"
+	// gatewayExternalDNSAnnotationKey overrides the Service annotation the hostnames are published with
+	gatewayExternalDNSAnnotationKey = "gateway.istio.io/external-dns-annotation"
 )
 
 // GatewayResources stores all gateway resources used for our conversion.
@@ -54,9 +57,34 @@ type GatewayResources struct {
 	// Credentials stores all credentials in the cluster
 	Credentials credentials.Controller
 
+	// ExtensionConfigMaps stores all ConfigMaps that can be referenced by ExtensionRef filters, keyed by name
+	ExtensionConfigMaps map[types.NamespacedName]*corev1.ConfigMap
+
+	// Rejections stores the configuration rejections reported by the proxies of each Gateway, keyed by type URL
+	Rejections map[types.NamespacedName]map[string]string
+
+	// ListenerAcks stores the latest generation of each Gateway whose listeners were accepted by its proxies.
+	// If nil, Gateways are reported as programmed without waiting for their proxies.
+	ListenerAcks map[types.NamespacedName]int64
+
+	// FederatedGateways are the Gateways exported by the federated peer meshes, which the routes can attach to
+	FederatedGateways []model.FederatedGateway
+
 	// Domain for the cluster. Typically, cluster.local
 	Domain  string
 	Context GatewayContext
+
+	// controllerID is the identity of the controller converting the resources, see identity
+	controllerID *controllerIdentity
+}
+
+// identity returns the identity of the controller converting the resources, or the one set by the environment if
+// the resources are converted outside a controller.
+func (r GatewayResources) identity() *controllerIdentity {
+	if r.controllerID == nil {
+		return envIdentity()
+	}
+	return r.controllerID
 }
 
 type Grants struct {
@@ -110,6 +138,20 @@ type IstioResources struct {
 	// determine if a resource update could have impacted any Gateways.
 	// key: referenced resources(e.g. secrets), value: gateway-api resources(e.g. gateways)
 	ResourceReferences map[model.ConfigKey][]model.ConfigKey
+
+	// DeniedRouteParents stores the parent references each HTTPRoute could not attach to, for example because of the
+	// AllowedRoutes of the listeners. This allows explaining why a route does not apply to a Gateway, see TraceRoutes.
+	DeniedRouteParents map[types.NamespacedName][]DeniedRouteParent
+
+	// ServiceEntry and DestinationRule store the configs generated for the external hostnames referenced by
+	// HTTPRoutes, see convertExternalHostnames.
+	ServiceEntry    []config.Config
+	DestinationRule []config.Config
+
+	// RequestAuthentication and AuthorizationPolicy store the policies generated for the authentication required by
+	// HTTPRoutes on their Gateways, see convertRouteAuthentications.
+	RequestAuthentication []config.Config
+	AuthorizationPolicy   []config.Config
 }
 
 // Reference stores a reference to a namespaced GVK, as used by ReferencePolicy
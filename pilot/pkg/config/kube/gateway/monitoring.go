@@ -0,0 +1,218 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gateway "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/labels"
+	"istio.io/istio/pkg/ptr"
+)
+
+const (
+	// classDefaultsMonitoringKey is the key of the GatewayClass defaults enabling the generation of the monitoring
+	// resources of the gateways, for the Prometheus operator.
+	classDefaultsMonitoringKey = "monitoring"
+)
+
+var (
+	serviceMonitorGVR = schema.GroupVersionResource{Group: "monitoring.coreos.com", Version: "v1", Resource: "servicemonitors"}
+	prometheusRuleGVR = schema.GroupVersionResource{Group: "monitoring.coreos.com", Version: "v1", Resource: "prometheusrules"}
+)
+
+// GatewayMonitoring configures the monitoring resources generated along with a gateway: a ServiceMonitor scraping the
+// merged metrics of its pods, and a PrometheusRule alerting on its error rate and latency.
+type GatewayMonitoring struct {
+	// Interval is the scrape interval, in the Prometheus duration format.
+	Interval string
+	// Labels are set on the monitoring resources, to be selected by the Prometheus instance.
+	Labels map[string]string
+	// Alerts configures the alert rules, if not disabled.
+	Alerts *GatewayAlerts
+}
+
+// GatewayAlerts are the thresholds of the default alert rules of a gateway.
+type GatewayAlerts struct {
+	// ErrorRate is the ratio of 5xx responses over which the gateway is alerted on.
+	ErrorRate string
+	// LatencyMilliseconds is the 99th percentile of the request duration over which the gateway is alerted on.
+	LatencyMilliseconds int64
+	// For is how long a threshold must be exceeded before alerting, in the Prometheus duration format.
+	For string
+	// Severity is the severity label of the alerts.
+	Severity string
+}
+
+// gatewayMonitoringSpec is the YAML format of the monitoring settings of a GatewayClass.
+type gatewayMonitoringSpec struct {
+	Interval *metav1.Duration  `json:"interval,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Alerts   *struct {
+		Enabled   *bool            `json:"enabled,omitempty"`
+		ErrorRate *float64         `json:"errorRate,omitempty"`
+		Latency   *metav1.Duration `json:"latency,omitempty"`
+		For       *metav1.Duration `json:"for,omitempty"`
+		Severity  *string          `json:"severity,omitempty"`
+	} `json:"alerts,omitempty"`
+}
+
+// parseGatewayMonitoring parses and validates the monitoring settings of a GatewayClass.
+func parseGatewayMonitoring(data string) (*GatewayMonitoring, error) {
+	spec := gatewayMonitoringSpec{}
+	if err := yaml.UnmarshalStrict([]byte(data), &spec); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", classDefaultsMonitoringKey, err)
+	}
+	interval := 30 * time.Second
+	if spec.Interval != nil {
+		interval = spec.Interval.Duration
+	}
+	if err := validatePrometheusDuration("interval", interval); err != nil {
+		return nil, err
+	}
+	if err := labels.Instance(spec.Labels).Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", classDefaultsMonitoringKey, err)
+	}
+	monitoring := &GatewayMonitoring{
+		Interval: prometheusDuration(interval),
+		Labels:   spec.Labels,
+	}
+	if spec.Alerts != nil && spec.Alerts.Enabled != nil && !*spec.Alerts.Enabled {
+		return monitoring, nil
+	}
+
+	errorRate, latency, pending, severity := 0.05, time.Second, 5*time.Minute, "warning"
+	if a := spec.Alerts; a != nil {
+		if a.ErrorRate != nil {
+			errorRate = *a.ErrorRate
+		}
+		if a.Latency != nil {
+			latency = a.Latency.Duration
+		}
+		if a.For != nil {
+			pending = a.For.Duration
+		}
+		if a.Severity != nil {
+			severity = *a.Severity
+		}
+	}
+	if errorRate <= 0 || errorRate > 1 {
+		return nil, fmt.Errorf("invalid %s: alerts.errorRate must be in (0, 1], got %v", classDefaultsMonitoringKey, errorRate)
+	}
+	if latency < time.Millisecond {
+		return nil, fmt.Errorf("invalid %s: alerts.latency must be at least 1ms, got %v", classDefaultsMonitoringKey, latency)
+	}
+	if err := validatePrometheusDuration("alerts.for", pending); err != nil {
+		return nil, err
+	}
+	if severity == "" {
+		return nil, fmt.Errorf("invalid %s: alerts.severity must not be empty", classDefaultsMonitoringKey)
+	}
+	monitoring.Alerts = &GatewayAlerts{
+		ErrorRate:           strconv.FormatFloat(errorRate, 'f', -1, 64),
+		LatencyMilliseconds: latency.Milliseconds(),
+		For:                 prometheusDuration(pending),
+		Severity:            severity,
+	}
+	return monitoring, nil
+}
+
+// validatePrometheusDuration checks that a duration is a whole, positive number of seconds, as Prometheus durations
+// cannot be fractional.
+func validatePrometheusDuration(name string, d time.Duration) error {
+	if d < time.Second || d%time.Second != 0 {
+		return fmt.Errorf("invalid %s: %s must be a whole number of seconds, got %v", classDefaultsMonitoringKey, name, d)
+	}
+	return nil
+}
+
+func prometheusDuration(d time.Duration) string {
+	return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+}
+
+// gatewayServiceLabels returns the labels set on the Service of the gateway in addition to those of the Gateway. With
+// monitoring, the Service is labeled with the name of the gateway, for the ServiceMonitor to select it.
+func gatewayServiceLabels(gw gateway.Gateway, monitoring *GatewayMonitoring) map[string]string {
+	if monitoring == nil {
+		return nil
+	}
+	return map[string]string{constants.GatewayNameLabel: gw.Name}
+}
+
+// classMonitoring returns the monitoring settings of the class of the gateway, if any. Invalid settings are ignored
+// here, and reported on the status of the class by the gateway controller.
+func (d *DeploymentController) classMonitoring(gw gateway.Gateway) *GatewayMonitoring {
+	class, data, f := d.classDefault(gw, classDefaultsMonitoringKey)
+	if !f {
+		return nil
+	}
+	monitoring, err := parseGatewayMonitoring(data)
+	if err != nil {
+		log.Warnf("ignoring monitoring settings of gateway class %s: %v", class, err)
+		return nil
+	}
+	return monitoring
+}
+
+// staleMonitoring returns the monitoring resources of a gateway which are not generated with the settings of its
+// class. They may have been generated before the settings changed.
+func staleMonitoring(monitoring *GatewayMonitoring) []schema.GroupVersionResource {
+	switch {
+	case monitoring == nil:
+		return []schema.GroupVersionResource{serviceMonitorGVR, prometheusRuleGVR}
+	case monitoring.Alerts == nil:
+		return []schema.GroupVersionResource{prometheusRuleGVR}
+	default:
+		return nil
+	}
+}
+
+// deleteStaleMonitoring deletes the monitoring resources of the gateway which are no longer requested by its class,
+// as they are otherwise only garbage collected with the Gateway. Only the resources managed by the controller are
+// deleted, so those created by users with the same name are left alone.
+func (d *DeploymentController) deleteStaleMonitoring(input TemplateInput) error {
+	for _, r := range staleMonitoring(input.Monitoring) {
+		store, f := d.clients[r]
+		if !f {
+			// Not watched, so whether it exists and is managed by us is unknown
+			continue
+		}
+		obj := store.Get(input.DeploymentName, input.Namespace)
+		if obj == nil {
+			continue
+		}
+		if _, managed := obj.GetLabels()[constants.ManagedGatewayLabel]; !managed {
+			continue
+		}
+		log.Debugf("deleting %v/%v/%v, monitoring disabled", r, input.Namespace, input.DeploymentName)
+		err := d.client.Dynamic().Resource(r).Namespace(input.Namespace).Delete(context.Background(), input.DeploymentName, metav1.DeleteOptions{
+			// Ensure the resource was not taken over since it was read
+			Preconditions: &metav1.Preconditions{ResourceVersion: ptr.Of(obj.GetResourceVersion())},
+		})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return fmt.Errorf("delete %v/%v/%v: %v", r, input.Namespace, input.DeploymentName, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	gateway "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestParseGatewayMonitoring(t *testing.T) {
+	defaultAlerts := &GatewayAlerts{ErrorRate: "0.05", LatencyMilliseconds: 1000, For: "300s", Severity: "warning"}
+	cases := []struct {
+		name    string
+		data    string
+		want    *GatewayMonitoring
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			data: "{}",
+			want: &GatewayMonitoring{Interval: "30s", Alerts: defaultAlerts},
+		},
+		{
+			name: "full",
+			data: "{interval: 1m, labels: {release: prometheus}, alerts: {errorRate: 0.1, latency: 250ms, for: 10m, severity: critical}}",
+			want: &GatewayMonitoring{
+				Interval: "60s",
+				Labels:   map[string]string{"release": "prometheus"},
+				Alerts:   &GatewayAlerts{ErrorRate: "0.1", LatencyMilliseconds: 250, For: "600s", Severity: "critical"},
+			},
+		},
+		{
+			name: "alerts disabled",
+			data: "{alerts: {enabled: false}}",
+			want: &GatewayMonitoring{Interval: "30s"},
+		},
+		{
+			name:    "fractional interval",
+			data:    "{interval: 1500ms}",
+			wantErr: true,
+		},
+		{
+			name:    "invalid label",
+			data:    "{labels: {'not a label': x}}",
+			wantErr: true,
+		},
+		{
+			name:    "invalid error rate",
+			data:    "{alerts: {errorRate: 5}}",
+			wantErr: true,
+		},
+		{
+			name:    "empty severity",
+			data:    "{alerts: {severity: ''}}",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    "{intervals: 30s}",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseGatewayMonitoring(tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestGatewayServiceLabels(t *testing.T) {
+	gw := gateway.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gateway", Namespace: "default"}}
+	assert.Equal(t, gatewayServiceLabels(gw, nil), nil)
+	assert.Equal(t, gatewayServiceLabels(gw, &GatewayMonitoring{Interval: "30s"}), map[string]string{constants.GatewayNameLabel: "gateway"})
+}
+
+func TestStaleMonitoring(t *testing.T) {
+	assert.Equal(t, staleMonitoring(nil), []schema.GroupVersionResource{serviceMonitorGVR, prometheusRuleGVR})
+	assert.Equal(t, staleMonitoring(&GatewayMonitoring{Interval: "30s"}), []schema.GroupVersionResource{prometheusRuleGVR})
+	assert.Equal(t, staleMonitoring(&GatewayMonitoring{Interval: "30s", Alerts: &GatewayAlerts{}}), nil)
+}
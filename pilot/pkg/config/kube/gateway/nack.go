@@ -0,0 +1,148 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	v3 "istio.io/istio/pilot/pkg/xds/v3"
+	"istio.io/istio/pkg/maps"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// nackTracker records configuration rejected by the proxies deployed for a Gateway. Rejections are keyed by Gateway,
+// proxy connection and xDS type, and are cleared once the connection accepts a later version of the same type, or
+// closes. A Gateway is rejected while any of its connected proxies rejects its configuration, so a proxy accepting
+// the configuration does not hide the rejection of another one.
+// Only the proxies connected to this istiod are known.
+type nackTracker struct {
+	mu sync.RWMutex
+	// nacks is keyed by Gateway, then by connection ID, then by type URL
+	nacks map[types.NamespacedName]map[string]map[string]string
+}
+
+func newNackTracker() *nackTracker {
+	return &nackTracker{nacks: map[types.NamespacedName]map[string]map[string]string{}}
+}
+
+// update applies fn to the rejections of the given Gateway, and returns true if this changed the rejections reported
+// for the Gateway.
+func (n *nackTracker) update(gateway types.NamespacedName, fn func(byConnection map[string]map[string]string)) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	byConnection, f := n.nacks[gateway]
+	if !f {
+		byConnection = map[string]map[string]string{}
+	}
+	before := mergeRejections(byConnection)
+	fn(byConnection)
+	for conID, byType := range byConnection {
+		if len(byType) == 0 {
+			delete(byConnection, conID)
+		}
+	}
+	if len(byConnection) == 0 {
+		delete(n.nacks, gateway)
+	} else {
+		n.nacks[gateway] = byConnection
+	}
+	return !maps.Equal(before, mergeRejections(byConnection))
+}
+
+func (n *nackTracker) report(gateway types.NamespacedName, conID string, typeURL string, message string) bool {
+	return n.update(gateway, func(byConnection map[string]map[string]string) {
+		byType, f := byConnection[conID]
+		if !f {
+			byType = map[string]string{}
+			byConnection[conID] = byType
+		}
+		byType[typeURL] = message
+	})
+}
+
+func (n *nackTracker) clear(gateway types.NamespacedName, conID string, typeURL string) bool {
+	return n.update(gateway, func(byConnection map[string]map[string]string) {
+		delete(byConnection[conID], typeURL)
+	})
+}
+
+func (n *nackTracker) forget(gateway types.NamespacedName, conID string) bool {
+	return n.update(gateway, func(byConnection map[string]map[string]string) {
+		delete(byConnection, conID)
+	})
+}
+
+// snapshot returns the outstanding rejections of each Gateway, keyed by type URL.
+func (n *nackTracker) snapshot() map[types.NamespacedName]map[string]string {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	res := make(map[types.NamespacedName]map[string]string, len(n.nacks))
+	for gw, byConnection := range n.nacks {
+		res[gw] = mergeRejections(byConnection)
+	}
+	return res
+}
+
+// mergeRejections merges the rejections of each connection, keyed by type URL. The distinct messages for the same
+// type are joined, in a stable order.
+func mergeRejections(byConnection map[string]map[string]string) map[string]string {
+	messages := map[string]sets.String{}
+	for _, byType := range byConnection {
+		for typeURL, message := range byType {
+			if messages[typeURL] == nil {
+				messages[typeURL] = sets.New[string]()
+			}
+			messages[typeURL].Insert(message)
+		}
+	}
+	res := make(map[string]string, len(messages))
+	for typeURL, msgs := range messages {
+		res[typeURL] = strings.Join(sets.SortedList(msgs), "; ")
+	}
+	return res
+}
+
+// rejectionMessage returns a human-readable message for the rejections of a Gateway.
+func rejectionMessage(byType map[string]string) string {
+	msgs := make([]string, 0, len(byType))
+	for _, typeURL := range slices.Sort(maps.Keys(byType)) {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", v3.GetShortType(typeURL), byType[typeURL]))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ReportNack records that a proxy deployed for the Gateway rejected the generated configuration.
+func (c *Controller) ReportNack(gateway types.NamespacedName, conID string, typeURL string, message string) bool {
+	changed := c.nacks.report(gateway, conID, typeURL, message)
+	if changed {
+		log.Warnf("gateway %v rejected %v configuration: %v", gateway, v3.GetShortType(typeURL), message)
+	}
+	return changed
+}
+
+// ClearNack records that a proxy deployed for the Gateway accepted the generated configuration.
+func (c *Controller) ClearNack(gateway types.NamespacedName, conID string, typeURL string) bool {
+	return c.nacks.clear(gateway, conID, typeURL)
+}
+
+// ForgetNacks drops the rejections of a proxy deployed for the Gateway, once it disconnects.
+func (c *Controller) ForgetNacks(gateway types.NamespacedName, conID string) bool {
+	return c.nacks.forget(gateway, conID)
+}
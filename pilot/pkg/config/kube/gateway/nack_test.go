@@ -0,0 +1,133 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pilot/pkg/model/kstatus"
+	"istio.io/istio/pilot/pkg/networking/core/v1alpha3"
+	v3 "istio.io/istio/pilot/pkg/xds/v3"
+	crdvalidation "istio.io/istio/pkg/config/crd"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestNackTracker(t *testing.T) {
+	gw := types.NamespacedName{Namespace: "ns", Name: "gateway"}
+	n := newNackTracker()
+
+	assert.Equal(t, n.report(gw, "a", v3.ListenerType, "invalid listener"), true)
+	// Same rejection is not a change
+	assert.Equal(t, n.report(gw, "a", v3.ListenerType, "invalid listener"), false)
+	// Neither is the same rejection from another proxy
+	assert.Equal(t, n.report(gw, "b", v3.ListenerType, "invalid listener"), false)
+	assert.Equal(t, n.report(gw, "a", v3.RouteType, "invalid route"), true)
+	assert.Equal(t, n.snapshot(), map[types.NamespacedName]map[string]string{
+		gw: {v3.ListenerType: "invalid listener", v3.RouteType: "invalid route"},
+	})
+
+	// The listeners are still rejected by the other proxy
+	assert.Equal(t, n.clear(gw, "a", v3.ListenerType), false)
+	assert.Equal(t, n.clear(gw, "b", v3.ListenerType), true)
+	assert.Equal(t, n.clear(gw, "b", v3.ListenerType), false)
+	assert.Equal(t, n.snapshot(), map[types.NamespacedName]map[string]string{
+		gw: {v3.RouteType: "invalid route"},
+	})
+
+	// Rejections are dropped once the proxy disconnects
+	assert.Equal(t, n.forget(gw, "a"), true)
+	assert.Equal(t, n.forget(gw, "a"), false)
+	assert.Equal(t, n.snapshot(), map[types.NamespacedName]map[string]string{})
+}
+
+func TestNackTrackerMessages(t *testing.T) {
+	gw := types.NamespacedName{Namespace: "ns", Name: "gateway"}
+	n := newNackTracker()
+
+	assert.Equal(t, n.report(gw, "a", v3.RouteType, "route b"), true)
+	assert.Equal(t, n.report(gw, "b", v3.RouteType, "route a"), true)
+	assert.Equal(t, n.report(gw, "a", v3.ListenerType, "listener"), true)
+	assert.Equal(t, rejectionMessage(n.snapshot()[gw]), "LDS: listener; RDS: route a; route b")
+}
+
+func TestRejectionStatus(t *testing.T) {
+	input := readConfigString(t, `apiVersion: gateway.networking.k8s.io/v1beta1
+kind: GatewayClass
+metadata:
+  name: istio
+spec:
+  controllerName: istio.io/gateway-controller
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: Gateway
+metadata:
+  name: gateway
+  namespace: istio-system
+spec:
+  addresses:
+  - value: istio-ingressgateway
+    type: Hostname
+  gatewayClassName: istio
+  listeners:
+  - name: default
+    hostname: "*.domain.example"
+    port: 80
+    protocol: HTTP
+    allowedRoutes:
+      namespaces:
+        from: All
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: HTTPRoute
+metadata:
+  name: http
+  namespace: default
+spec:
+  parentRefs:
+  - name: gateway
+    namespace: istio-system
+  hostnames: ["first.domain.example"]
+  rules:
+  - backendRefs:
+    - name: httpbin
+      port: 80
+`, crdvalidation.NewIstioValidator(t), nil)
+	cg := v1alpha3.NewConfigGenTest(t, v1alpha3.TestOptions{Services: services})
+	kr := splitInput(t, input)
+	kr.Context = NewGatewayContext(cg.PushContext())
+	kr.Rejections = map[types.NamespacedName]map[string]string{
+		{Namespace: "istio-system", Name: "gateway"}: {v3.RouteType: "invalid route"},
+	}
+	convertResources(kr)
+
+	gs := kr.Gateway[0].Status.(*kstatus.WrappedStatus).Status.(*k8s.GatewayStatus)
+	programmed := kmeta.FindStatusCondition(gs.Conditions, string(k8sv1.GatewayConditionProgrammed))
+	assert.Equal(t, programmed.Status, metav1.ConditionFalse)
+	assert.Equal(t, programmed.Reason, string(k8sv1.GatewayReasonInvalid))
+	assert.Equal(t, programmed.Message, "Generated configuration was rejected by the gateway proxy: RDS: invalid route")
+
+	// The rejected RouteConfiguration holds the routes of every route of the Gateway, so the healthy routes are not
+	// flagged with the rejection
+	rs := kr.HTTPRoute[0].Status.(*kstatus.WrappedStatus).Status.(*k8s.HTTPRouteStatus)
+	assert.Equal(t, len(rs.Parents), 1)
+	resolved := kmeta.FindStatusCondition(rs.Parents[0].Conditions, string(k8s.RouteConditionResolvedRefs))
+	assert.Equal(t, resolved.Status, metav1.ConditionTrue)
+}
@@ -0,0 +1,92 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	k8s "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/monitoring"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/util/sets"
+)
+
+var orphanedHTTPRoutes = monitoring.NewGauge(
+	"pilot_gateway_orphaned_httproutes",
+	"Number of HTTPRoutes attached to a Gateway which was deleted.",
+)
+
+// orphanedParents returns the results of the parents of a route which were deleted: the Gateways the route refers to,
+// and was attached to according to the status written by this controller, which no longer exist. Gateways which exist
+// but are not ours, or were never attached, are left to their controller.
+func orphanedParents(
+	ctx configContext,
+	obj config.Config,
+	refs []k8s.ParentReference,
+	current []k8s.RouteParentStatus,
+) []RouteParentResult {
+	id := ctx.identity()
+	var res []RouteParentResult
+	for _, ref := range refs {
+		ir, err := toInternalParentReference(ref, obj.Namespace)
+		if err != nil || ir.Kind != gvk.KubernetesGateway || len(ctx.GatewayReferences[ir]) > 0 {
+			continue
+		}
+		if ctx.existingGateways.Contains(types.NamespacedName{Namespace: ir.Namespace, Name: ir.Name}) {
+			continue
+		}
+		attached := slices.FindFunc(current, func(s k8s.RouteParentStatus) bool {
+			return parentRefString(s.ParentRef) == parentRefString(ref) &&
+				(s.ControllerName == id.controllerName || id.previousControllerNames.Contains(string(s.ControllerName)))
+		}) != nil
+		if !attached {
+			continue
+		}
+		res = append(res, RouteParentResult{
+			OriginalReference: ref,
+			DeniedReason: &ParentError{
+				Reason:  ParentErrorParentNotFound,
+				Message: fmt.Sprintf("parent gateway %s was not found, it may have been deleted", parentName(ref, obj.Namespace)),
+			},
+		})
+	}
+	return res
+}
+
+// existingGateways returns the names of all the Gateways, of any class.
+func existingGateways(gateways []config.Config) sets.Set[types.NamespacedName] {
+	res := sets.NewWithLength[types.NamespacedName](len(gateways))
+	for _, gw := range gateways {
+		res.Insert(gw.NamespacedName())
+	}
+	return res
+}
+
+// reportOrphanedRoutes publishes the number of HTTPRoutes with a deleted parent.
+func reportOrphanedRoutes(denied map[types.NamespacedName][]DeniedRouteParent) {
+	count := 0
+	for _, parents := range denied {
+		if slices.FindFunc(parents, func(p DeniedRouteParent) bool {
+			return p.Reason == string(ParentErrorParentNotFound)
+		}) != nil {
+			count++
+		}
+	}
+	orphanedHTTPRoutes.Record(float64(count))
+}
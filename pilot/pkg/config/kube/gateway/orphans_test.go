@@ -0,0 +1,103 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8s "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/monitoring/monitortest"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/pkg/util/sets"
+)
+
+func TestOrphanedParents(t *testing.T) {
+	gatewayRef := func(name string) k8s.ParentReference {
+		return k8s.ParentReference{
+			Group: ptr.Of(k8s.Group(gvk.KubernetesGateway.Group)),
+			Kind:  ptr.Of(k8s.Kind(gvk.KubernetesGateway.Kind)),
+			Name:  k8s.ObjectName(name),
+		}
+	}
+	deleted, foreign, neverAttached, attached := gatewayRef("deleted"), gatewayRef("foreign"), gatewayRef("never"), gatewayRef("attached")
+	ctx := configContext{
+		GatewayReferences: map[parentKey][]*parentInfo{
+			{Kind: gvk.KubernetesGateway, Name: "attached", Namespace: "ns"}: {{InternalName: "ns/attached"}},
+		},
+		existingGateways: sets.New(
+			types.NamespacedName{Namespace: "ns", Name: "attached"},
+			types.NamespacedName{Namespace: "ns", Name: "foreign"},
+		),
+	}
+	obj := config.Config{Meta: config.Meta{GroupVersionKind: gvk.HTTPRoute, Namespace: "ns", Name: "route"}}
+	ours := func(ref k8s.ParentReference) k8s.RouteParentStatus {
+		return k8s.RouteParentStatus{ParentRef: ref, ControllerName: controllerName()}
+	}
+	current := []k8s.RouteParentStatus{
+		ours(deleted),
+		ours(foreign),
+		ours(attached),
+		{ParentRef: neverAttached, ControllerName: "example.com/other"},
+	}
+
+	got := orphanedParents(ctx, obj, []k8s.ParentReference{deleted, foreign, neverAttached, attached}, current)
+	assert.Equal(t, len(got), 1)
+	assert.Equal(t, got[0].OriginalReference, deleted)
+	assert.Equal(t, got[0].DeniedReason.Reason, ParentErrorParentNotFound)
+}
+
+func TestCreateRouteStatusOrphanedParent(t *testing.T) {
+	parentRef := httpRouteSpec.ParentRefs[0]
+	deletedRef := k8s.ParentReference{Name: "deleted"}
+	obj := config.Config{Meta: config.Meta{GroupVersionKind: gvk.HTTPRoute, Namespace: "foo", Name: "bar", Generation: 1}}
+
+	got := createRouteStatus(envIdentity(), []RouteParentResult{
+		{OriginalReference: parentRef},
+		{OriginalReference: deletedRef, DeniedReason: &ParentError{Reason: ParentErrorParentNotFound, Message: "not found"}},
+	}, obj, nil)
+	assert.Equal(t, len(got), 2)
+	for _, p := range got {
+		accepted := p.Conditions[0]
+		for _, c := range p.Conditions {
+			if c.Type == string(k8s.RouteConditionAccepted) {
+				accepted = c
+			}
+		}
+		if p.ParentRef == deletedRef {
+			assert.Equal(t, accepted.Status, metav1.ConditionFalse)
+			assert.Equal(t, accepted.Reason, string(ParentErrorParentNotFound))
+		} else {
+			assert.Equal(t, accepted.Status, metav1.ConditionTrue)
+		}
+	}
+}
+
+func TestReportOrphanedRoutes(t *testing.T) {
+	mt := monitortest.New(t)
+	reportOrphanedRoutes(map[types.NamespacedName][]DeniedRouteParent{
+		{Namespace: "ns", Name: "orphan"}: {
+			{Parent: "ns/deleted", Reason: string(ParentErrorParentNotFound)},
+			{Parent: "ns/other-deleted", Reason: string(ParentErrorParentNotFound)},
+		},
+		{Namespace: "ns", Name: "denied"}: {{Parent: "ns/gw", Reason: string(ParentErrorNotAllowed)}},
+	})
+	mt.Assert(orphanedHTTPRoutes.Name(), nil, monitortest.Exactly(1))
+}
@@ -0,0 +1,32 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"strconv"
+
+	gateway "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// gatewayPausedAnnotation stops the deployment controller from reconciling the resources generated for a Gateway
+// when set to true, e.g. to hotfix its Deployment during an incident without the controller reverting the change.
+// The routing config of the Gateway is still served, and the resources are reconciled again once it is unset.
+const gatewayPausedAnnotation = "gateway.istio.io/paused"
+
+// gatewayPaused returns whether the reconciliation of the resources generated for the gateway is paused.
+func gatewayPaused(gw gateway.Gateway) bool {
+	paused, _ := strconv.ParseBool(gw.Annotations[gatewayPausedAnnotation])
+	return paused
+}
@@ -0,0 +1,98 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/kclient/clienttest"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestGatewayPaused(t *testing.T) {
+	cases := []struct {
+		value string
+		want  bool
+	}{
+		{"", false},
+		{"true", true},
+		{"True", true},
+		{"false", false},
+		{"yes", false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.value, func(t *testing.T) {
+			gw := v1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{gatewayPausedAnnotation: tt.value}}}
+			assert.Equal(t, gatewayPaused(gw), tt.want)
+		})
+	}
+}
+
+func TestPausedGatewayReconciliation(t *testing.T) {
+	c := kube.NewFakeClient(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "default",
+		},
+	})
+	d := NewDeploymentController(c, "", model.NewEnvironment(), testInjectionConfig(t), func(fn func()) {}, "", NewIdentity())
+	var patched []schema.GroupVersionResource
+	d.patcher = func(g schema.GroupVersionResource, name string, namespace string, data []byte, subresources ...string) error {
+		patched = append(patched, g)
+		return nil
+	}
+	gws := clienttest.Wrap(t, d.gateways)
+	stop := test.NewStop(t)
+	c.RunAndWait(stop)
+
+	gw := &v1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gw",
+			Namespace: "default",
+			Annotations: map[string]string{
+				ControllerVersionAnnotation: fmt.Sprint(ControllerVersion),
+				gatewayPausedAnnotation:     "true",
+			},
+		},
+		Spec: v1beta1.GatewaySpec{
+			GatewayClassName: defaultClassName(),
+		},
+	}
+	gws.Create(gw)
+	name := types.NamespacedName{Name: "gw", Namespace: "default"}
+
+	// The generated resources are left as they are while paused
+	assert.NoError(t, d.Reconcile(name))
+	assert.Equal(t, len(patched), 0)
+
+	// The resources can still be previewed
+	_, err := d.Preview(name)
+	assert.NoError(t, err)
+
+	// Unpausing reconciles them again
+	delete(gw.Annotations, gatewayPausedAnnotation)
+	gws.Update(gw)
+	assert.NoError(t, d.Reconcile(name))
+	assert.Equal(t, len(patched) > 0, true)
+}
@@ -0,0 +1,210 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"strings"
+
+	gateway "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// classDefaultsProbesKey is the key of the GatewayClass defaults overriding the probes of the proxy of the
+	// generated gateway deployments.
+	classDefaultsProbesKey = "probes"
+	// gatewayStatusPort is the port of the health endpoints of the proxy.
+	gatewayStatusPort = 15021
+	// gatewayReadinessPath is the health endpoint of the proxy, ready once it received its configuration.
+	gatewayReadinessPath = "/healthz/ready"
+)
+
+// GatewayProbes holds the probes of the proxy of a generated gateway deployment. The probes are part of the pod
+// template, so changing them rolls the pods following the strategy of the deployment; settings of the class which
+// do not change the rendered probes leave the pods untouched.
+type GatewayProbes struct {
+	Startup   *GatewayProbe
+	Readiness *GatewayProbe
+	// Liveness is only set if enabled by the class, as restarting a proxy which is slow to start can make it fail
+	// the disruption budget of the gateway.
+	Liveness *GatewayProbe
+}
+
+// GatewayProbe is an HTTP probe of the proxy.
+type GatewayProbe struct {
+	Path                string
+	Port                int32
+	InitialDelaySeconds int32
+	PeriodSeconds       int32
+	TimeoutSeconds      int32
+	SuccessThreshold    int32
+	FailureThreshold    int32
+}
+
+// gatewayProbesSpec is the YAML format of the probe settings of a GatewayClass. Each probe overrides the fields of
+// the default one which are set.
+type gatewayProbesSpec struct {
+	Startup   *gatewayProbeSpec `json:"startup,omitempty"`
+	Readiness *gatewayProbeSpec `json:"readiness,omitempty"`
+	Liveness  *gatewayProbeSpec `json:"liveness,omitempty"`
+}
+
+type gatewayProbeSpec struct {
+	Path                *string `json:"path,omitempty"`
+	Port                *int32  `json:"port,omitempty"`
+	InitialDelaySeconds *int32  `json:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       *int32  `json:"periodSeconds,omitempty"`
+	TimeoutSeconds      *int32  `json:"timeoutSeconds,omitempty"`
+	SuccessThreshold    *int32  `json:"successThreshold,omitempty"`
+	FailureThreshold    *int32  `json:"failureThreshold,omitempty"`
+}
+
+// parseGatewayProbes parses and validates the probe settings of a GatewayClass.
+func parseGatewayProbes(data string) (*gatewayProbesSpec, error) {
+	spec := &gatewayProbesSpec{}
+	if err := yaml.UnmarshalStrict([]byte(data), spec); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", classDefaultsProbesKey, err)
+	}
+	// As validated by Kubernetes, only readiness probes may require several successes
+	defaults := defaultGatewayProbes()
+	for _, p := range []struct {
+		name          string
+		spec          *gatewayProbeSpec
+		def           *GatewayProbe
+		singleSuccess bool
+	}{
+		{"startup", spec.Startup, defaults.Startup, true},
+		{"readiness", spec.Readiness, defaults.Readiness, false},
+		{"liveness", spec.Liveness, defaultGatewayLivenessProbe(), true},
+	} {
+		if p.spec == nil {
+			continue
+		}
+		if err := p.spec.apply(p.def).validate(p.singleSuccess); err != nil {
+			return nil, fmt.Errorf("invalid %s: %s probe: %v", classDefaultsProbesKey, p.name, err)
+		}
+	}
+	return spec, nil
+}
+
+// apply returns a copy of the probe with the settings set in the spec.
+func (s *gatewayProbeSpec) apply(p *GatewayProbe) *GatewayProbe {
+	out := *p
+	set := func(dst *int32, v *int32) {
+		if v != nil {
+			*dst = *v
+		}
+	}
+	if s.Path != nil {
+		out.Path = *s.Path
+	}
+	set(&out.Port, s.Port)
+	set(&out.InitialDelaySeconds, s.InitialDelaySeconds)
+	set(&out.PeriodSeconds, s.PeriodSeconds)
+	set(&out.TimeoutSeconds, s.TimeoutSeconds)
+	set(&out.SuccessThreshold, s.SuccessThreshold)
+	set(&out.FailureThreshold, s.FailureThreshold)
+	return &out
+}
+
+func (p *GatewayProbe) validate(singleSuccess bool) error {
+	if !strings.HasPrefix(p.Path, "/") {
+		return fmt.Errorf("path %q must start with /", p.Path)
+	}
+	if p.Port < 1 || p.Port > 65535 {
+		return fmt.Errorf("port %d must be between 1 and 65535", p.Port)
+	}
+	if p.InitialDelaySeconds < 0 {
+		return fmt.Errorf("initialDelaySeconds must not be negative")
+	}
+	if p.PeriodSeconds < 1 || p.TimeoutSeconds < 1 || p.SuccessThreshold < 1 || p.FailureThreshold < 1 {
+		return fmt.Errorf("periodSeconds, timeoutSeconds, successThreshold and failureThreshold must be at least 1")
+	}
+	if singleSuccess && p.SuccessThreshold != 1 {
+		return fmt.Errorf("successThreshold must be 1")
+	}
+	return nil
+}
+
+// defaultGatewayProbes returns the probes of the proxy if not overridden by the class. The startup probe lets the
+// proxy take up to 30s to get its configuration, after which the readiness probe takes over.
+func defaultGatewayProbes() *GatewayProbes {
+	return &GatewayProbes{
+		Startup: &GatewayProbe{
+			Path:                gatewayReadinessPath,
+			Port:                gatewayStatusPort,
+			InitialDelaySeconds: 1,
+			PeriodSeconds:       1,
+			TimeoutSeconds:      1,
+			SuccessThreshold:    1,
+			FailureThreshold:    30,
+		},
+		Readiness: &GatewayProbe{
+			Path:             gatewayReadinessPath,
+			Port:             gatewayStatusPort,
+			PeriodSeconds:    15,
+			TimeoutSeconds:   1,
+			SuccessThreshold: 1,
+			FailureThreshold: 4,
+		},
+	}
+}
+
+// defaultGatewayLivenessProbe is the liveness probe of the proxy once enabled by the class, restarting a proxy
+// which is unhealthy for a minute.
+func defaultGatewayLivenessProbe() *GatewayProbe {
+	return &GatewayProbe{
+		Path:             gatewayReadinessPath,
+		Port:             gatewayStatusPort,
+		PeriodSeconds:    15,
+		TimeoutSeconds:   1,
+		SuccessThreshold: 1,
+		FailureThreshold: 4,
+	}
+}
+
+// gatewayProbes returns the probes of the proxy, the settings of its class overriding the defaults.
+func gatewayProbes(spec *gatewayProbesSpec) *GatewayProbes {
+	probes := defaultGatewayProbes()
+	if spec == nil {
+		return probes
+	}
+	if spec.Startup != nil {
+		probes.Startup = spec.Startup.apply(probes.Startup)
+	}
+	if spec.Readiness != nil {
+		probes.Readiness = spec.Readiness.apply(probes.Readiness)
+	}
+	if spec.Liveness != nil {
+		probes.Liveness = spec.Liveness.apply(defaultGatewayLivenessProbe())
+	}
+	return probes
+}
+
+// classProbes returns the probe settings of the class of the gateway, if any. Invalid settings are ignored here, and
+// reported on the status of the class by the gateway controller.
+func (d *DeploymentController) classProbes(gw gateway.Gateway) *gatewayProbesSpec {
+	class, data, f := d.classDefault(gw, classDefaultsProbesKey)
+	if !f {
+		return nil
+	}
+	spec, err := parseGatewayProbes(data)
+	if err != nil {
+		log.Warnf("ignoring probe settings of gateway class %s: %v", class, err)
+		return nil
+	}
+	return spec
+}
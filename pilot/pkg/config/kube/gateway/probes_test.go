@@ -0,0 +1,105 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestParseGatewayProbes(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: "{}",
+		},
+		{
+			name: "slow start",
+			data: "{startup: {failureThreshold: 120, periodSeconds: 2}, readiness: {successThreshold: 2, path: /healthz/ready}}",
+		},
+		{
+			name: "liveness",
+			data: "{liveness: {failureThreshold: 8}}",
+		},
+		{
+			name:    "relative path",
+			data:    "{readiness: {path: healthz}}",
+			wantErr: true,
+		},
+		{
+			name:    "invalid port",
+			data:    "{readiness: {port: 0}}",
+			wantErr: true,
+		},
+		{
+			name:    "invalid period",
+			data:    "{startup: {periodSeconds: 0}}",
+			wantErr: true,
+		},
+		{
+			name:    "liveness success threshold",
+			data:    "{liveness: {successThreshold: 2}}",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    "{readiness: {exec: {}}}",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseGatewayProbes(tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGatewayProbes(t *testing.T) {
+	parse := func(data string) *gatewayProbesSpec {
+		spec, err := parseGatewayProbes(data)
+		assert.NoError(t, err)
+		return spec
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		got := gatewayProbes(nil)
+		assert.Equal(t, got, defaultGatewayProbes())
+		assert.Equal(t, got.Liveness == nil, true)
+		assert.Equal(t, gatewayProbes(parse("{}")), got)
+	})
+
+	t.Run("override", func(t *testing.T) {
+		got := gatewayProbes(parse("{startup: {failureThreshold: 120}, readiness: {path: /ready, port: 8080}, liveness: {}}"))
+		// Only the fields set are overridden
+		startup := *defaultGatewayProbes().Startup
+		startup.FailureThreshold = 120
+		assert.Equal(t, got.Startup, &startup)
+		readiness := *defaultGatewayProbes().Readiness
+		readiness.Path = "/ready"
+		readiness.Port = 8080
+		assert.Equal(t, got.Readiness, &readiness)
+		assert.Equal(t, got.Liveness, defaultGatewayLivenessProbe())
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"istio.io/istio/pkg/config"
+)
+
+// maxTrackedPushVersions bounds the push versions remembered by the listenerAckTracker. Proxies are pushed every
+// version, so an ACK refers to one of the latest versions unless the proxy is lagging far behind.
+const maxTrackedPushVersions = 64
+
+// listenerAckTracker records, for each Gateway, the latest generation whose listeners were accepted by one of the
+// proxies deployed for it. ACKs refer to the push version the listeners were generated for, which is mapped to the
+// generations of the Gateways converted for that version.
+type listenerAckTracker struct {
+	mu sync.Mutex
+	// seq is the sequence number of the latest push version
+	seq uint64
+	// versions maps the latest push versions to their sequence number
+	versions map[string]uint64
+	// versionOrder lists the push versions in versions, oldest first
+	versionOrder []string
+	// observed stores the latest generation of each Gateway, and the sequence number of the first push version
+	// including it
+	observed map[types.NamespacedName]observedGeneration
+	// acked stores the latest generation of each Gateway whose listeners were accepted
+	acked map[types.NamespacedName]int64
+}
+
+type observedGeneration struct {
+	generation int64
+	seq        uint64
+}
+
+func newListenerAckTracker() *listenerAckTracker {
+	return &listenerAckTracker{
+		versions: map[string]uint64{},
+		observed: map[types.NamespacedName]observedGeneration{},
+		acked:    map[types.NamespacedName]int64{},
+	}
+}
+
+// observe records the generations of the Gateways converted for the push version, and returns the latest accepted
+// generation of each Gateway.
+func (l *listenerAckTracker) observe(pushVersion string, gateways []config.Config) map[types.NamespacedName]int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, f := l.versions[pushVersion]; !f {
+		l.seq++
+		l.versions[pushVersion] = l.seq
+		l.versionOrder = append(l.versionOrder, pushVersion)
+		if len(l.versionOrder) > maxTrackedPushVersions {
+			delete(l.versions, l.versionOrder[0])
+			l.versionOrder = l.versionOrder[1:]
+		}
+	}
+	seq := l.versions[pushVersion]
+
+	observed := make(map[types.NamespacedName]observedGeneration, len(gateways))
+	for _, gw := range gateways {
+		name := types.NamespacedName{Namespace: gw.Namespace, Name: gw.Name}
+		if o, f := l.observed[name]; f && o.generation == gw.Generation {
+			observed[name] = o
+		} else {
+			observed[name] = observedGeneration{generation: gw.Generation, seq: seq}
+		}
+	}
+	// Forget the Gateways which were removed
+	for name := range l.acked {
+		if _, f := observed[name]; !f {
+			delete(l.acked, name)
+		}
+	}
+	l.observed = observed
+
+	res := make(map[types.NamespacedName]int64, len(l.acked))
+	for name, generation := range l.acked {
+		res[name] = generation
+	}
+	return res
+}
+
+// ack records that a proxy deployed for the Gateway accepted the listeners generated for the push version.
+// Returns true if a new generation of the Gateway was accepted.
+func (l *listenerAckTracker) ack(gateway types.NamespacedName, pushVersion string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	seq, f := l.versions[pushVersion]
+	if !f {
+		return false
+	}
+	o, f := l.observed[gateway]
+	if !f || seq < o.seq {
+		// The listeners predate the latest generation of the Gateway
+		return false
+	}
+	if acked, f := l.acked[gateway]; f && acked == o.generation {
+		return false
+	}
+	l.acked[gateway] = o.generation
+	return true
+}
+
+// ReportListenerAck records that a proxy deployed for the Gateway accepted the listeners generated for the push
+// version.
+func (c *Controller) ReportListenerAck(gateway types.NamespacedName, pushVersion string) bool {
+	changed := c.listenerAcks.ack(gateway, pushVersion)
+	if changed {
+		log.Debugf("gateway %v accepted the listeners of push %v", gateway, pushVersion)
+	}
+	return changed
+}
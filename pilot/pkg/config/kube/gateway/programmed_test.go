@@ -0,0 +1,59 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestListenerAckTracker(t *testing.T) {
+	name := types.NamespacedName{Namespace: "ns", Name: "gateway"}
+	gateway := func(generation int64) []config.Config {
+		return []config.Config{{Meta: config.Meta{Namespace: "ns", Name: "gateway", Generation: generation}}}
+	}
+	l := newListenerAckTracker()
+
+	assert.Equal(t, l.observe("v1", gateway(1)), map[types.NamespacedName]int64{})
+	// Unknown versions are ignored
+	assert.Equal(t, l.ack(name, "v0"), false)
+	assert.Equal(t, l.ack(name, "v1"), true)
+	// Another replica accepting the same generation is not a change
+	assert.Equal(t, l.ack(name, "v1"), false)
+	assert.Equal(t, l.observe("v2", gateway(1)), map[types.NamespacedName]int64{name: 1})
+	assert.Equal(t, l.ack(name, "v2"), false)
+
+	// Listeners generated before the new generation do not make it programmed
+	assert.Equal(t, l.observe("v3", gateway(2)), map[types.NamespacedName]int64{name: 1})
+	assert.Equal(t, l.ack(name, "v2"), false)
+	assert.Equal(t, l.ack(name, "v3"), true)
+	assert.Equal(t, l.observe("v4", gateway(2)), map[types.NamespacedName]int64{name: 2})
+
+	// Removed Gateways are forgotten
+	assert.Equal(t, l.observe("v5", nil), map[types.NamespacedName]int64{})
+	assert.Equal(t, l.ack(name, "v5"), false)
+
+	// Old versions are eventually forgotten
+	for i := 0; i < maxTrackedPushVersions; i++ {
+		l.observe(fmt.Sprintf("v%d", 10+i), gateway(3))
+	}
+	assert.Equal(t, l.ack(name, "v5"), false)
+	assert.Equal(t, len(l.versions), maxTrackedPushVersions)
+}
@@ -0,0 +1,123 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"strconv"
+
+	gateway "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	"istio.io/istio/pkg/maps"
+)
+
+const (
+	// classDefaultsProxyProtocolKey is the key of the GatewayClass defaults enabling the PROXY protocol on the
+	// listeners of the generated gateway deployments.
+	classDefaultsProxyProtocolKey = "proxyProtocol"
+	// gatewayProxyProtocolKey, set to true or false, overrides whether the listeners of the gateway accept the PROXY
+	// protocol, regardless of its class.
+	gatewayProxyProtocolKey = "gateway.istio.io/proxy-protocol"
+	// awsProxyProtocolAnnotation is the Service annotation making AWS load balancers send the PROXY protocol header.
+	awsProxyProtocolAnnotation = "service.beta.kubernetes.io/aws-load-balancer-proxy-protocol"
+)
+
+// defaultProxyProtocolServiceAnnotations are the annotations set on the Service of a gateway accepting the PROXY
+// protocol, unless the class sets its own.
+var defaultProxyProtocolServiceAnnotations = map[string]string{awsProxyProtocolAnnotation: "*"}
+
+// GatewayProxyProtocol configures a generated gateway deployment to preserve the client addresses behind load
+// balancers sending the PROXY protocol header. The listeners of the proxy expect the header, and the Service is
+// annotated so that the load balancers provisioned for it send it.
+type GatewayProxyProtocol struct {
+	// ServiceAnnotations are the annotations set on the Service to make its load balancers send the header
+	ServiceAnnotations map[string]string
+}
+
+// gatewayProxyProtocolSpec is the YAML format of the PROXY protocol settings of a GatewayClass.
+type gatewayProxyProtocolSpec struct {
+	// Enabled defaults to true. If false, only the gateways annotated to accept the PROXY protocol use the settings.
+	Enabled *bool `json:"enabled,omitempty"`
+	// ServiceAnnotations replace the default annotations, for load balancers other than the AWS ones. An empty map
+	// sets no annotation, for load balancers configured out of the cluster, such as the OpenShift routers.
+	ServiceAnnotations map[string]string `json:"serviceAnnotations,omitempty"`
+}
+
+// parseGatewayProxyProtocol parses and validates the PROXY protocol settings of a GatewayClass.
+func parseGatewayProxyProtocol(data string) (*gatewayProxyProtocolSpec, error) {
+	spec := &gatewayProxyProtocolSpec{}
+	if err := yaml.UnmarshalStrict([]byte(data), spec); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", classDefaultsProxyProtocolKey, err)
+	}
+	for k := range spec.ServiceAnnotations {
+		if k == "" {
+			return nil, fmt.Errorf("invalid %s: serviceAnnotations must not have an empty key", classDefaultsProxyProtocolKey)
+		}
+	}
+	return spec, nil
+}
+
+// gatewayProxyProtocol returns the PROXY protocol settings of a gateway, from its annotation and the settings of its
+// class, or nil if the gateway does not accept the PROXY protocol.
+func gatewayProxyProtocol(annotations map[string]string, class *gatewayProxyProtocolSpec) *GatewayProxyProtocol {
+	enabled := class != nil && (class.Enabled == nil || *class.Enabled)
+	if v, f := annotations[gatewayProxyProtocolKey]; f {
+		override, err := strconv.ParseBool(v)
+		if err != nil {
+			log.Warnf("ignoring invalid %s annotation %q: %v", gatewayProxyProtocolKey, v, err)
+		} else {
+			enabled = override
+		}
+	}
+	if !enabled {
+		return nil
+	}
+	serviceAnnotations := defaultProxyProtocolServiceAnnotations
+	if class != nil && class.ServiceAnnotations != nil {
+		serviceAnnotations = class.ServiceAnnotations
+	}
+	return &GatewayProxyProtocol{ServiceAnnotations: maps.Clone(serviceAnnotations)}
+}
+
+// withServiceAnnotations returns the Service annotations of a gateway with the ones of its PROXY protocol settings.
+// The annotations set on the gateway itself are rendered on the Service as well, and are left to take precedence.
+func (p *GatewayProxyProtocol) withServiceAnnotations(gatewayAnnotations, annotations map[string]string) map[string]string {
+	if p == nil || len(p.ServiceAnnotations) == 0 {
+		return annotations
+	}
+	out := map[string]string{}
+	for k, v := range p.ServiceAnnotations {
+		if _, f := gatewayAnnotations[k]; !f {
+			out[k] = v
+		}
+	}
+	return maps.MergeCopy(out, annotations)
+}
+
+// classProxyProtocol returns the PROXY protocol settings of the class of the gateway, if any. Invalid settings are
+// ignored here, and reported on the status of the class by the gateway controller.
+func (d *DeploymentController) classProxyProtocol(gw gateway.Gateway) *gatewayProxyProtocolSpec {
+	class, data, f := d.classDefault(gw, classDefaultsProxyProtocolKey)
+	if !f {
+		return nil
+	}
+	spec, err := parseGatewayProxyProtocol(data)
+	if err != nil {
+		log.Warnf("ignoring PROXY protocol settings of gateway class %s: %v", class, err)
+		return nil
+	}
+	return spec
+}
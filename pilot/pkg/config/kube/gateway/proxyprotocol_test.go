@@ -0,0 +1,126 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestParseGatewayProxyProtocol(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    string
+		want    *gatewayProxyProtocolSpec
+		wantErr bool
+	}{
+		{
+			name: "defaults",
+			data: "{}",
+			want: &gatewayProxyProtocolSpec{},
+		},
+		{
+			name: "full",
+			data: "{enabled: false, serviceAnnotations: {service.kubernetes.io/lb-proxy-protocol: v2}}",
+			want: &gatewayProxyProtocolSpec{
+				Enabled:            ptr.Of(false),
+				ServiceAnnotations: map[string]string{"service.kubernetes.io/lb-proxy-protocol": "v2"},
+			},
+		},
+		{
+			name:    "empty annotation",
+			data:    `{serviceAnnotations: {"": "*"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    "{enable: true}",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseGatewayProxyProtocol(tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestGatewayProxyProtocol(t *testing.T) {
+	aws := &GatewayProxyProtocol{ServiceAnnotations: map[string]string{awsProxyProtocolAnnotation: "*"}}
+	cases := []struct {
+		name        string
+		annotations map[string]string
+		class       *gatewayProxyProtocolSpec
+		want        *GatewayProxyProtocol
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name:  "class",
+			class: &gatewayProxyProtocolSpec{},
+			want:  aws,
+		},
+		{
+			name:        "gateway",
+			annotations: map[string]string{gatewayProxyProtocolKey: "true"},
+			want:        aws,
+		},
+		{
+			name:        "opt out",
+			annotations: map[string]string{gatewayProxyProtocolKey: "false"},
+			class:       &gatewayProxyProtocolSpec{},
+		},
+		{
+			name:        "opt in",
+			annotations: map[string]string{gatewayProxyProtocolKey: "true"},
+			class:       &gatewayProxyProtocolSpec{Enabled: ptr.Of(false), ServiceAnnotations: map[string]string{}},
+			want:        &GatewayProxyProtocol{ServiceAnnotations: map[string]string{}},
+		},
+		{
+			name:        "invalid annotation",
+			annotations: map[string]string{gatewayProxyProtocolKey: "yes please"},
+			class:       &gatewayProxyProtocolSpec{ServiceAnnotations: map[string]string{"a": "b"}},
+			want:        &GatewayProxyProtocol{ServiceAnnotations: map[string]string{"a": "b"}},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, gatewayProxyProtocol(tt.annotations, tt.class), tt.want)
+		})
+	}
+}
+
+func TestProxyProtocolServiceAnnotations(t *testing.T) {
+	var disabled *GatewayProxyProtocol
+	dns := map[string]string{externalDNSHostnameAnnotation: "example.com"}
+	assert.Equal(t, disabled.withServiceAnnotations(nil, dns), dns)
+
+	p := &GatewayProxyProtocol{ServiceAnnotations: map[string]string{awsProxyProtocolAnnotation: "*"}}
+	assert.Equal(t, p.withServiceAnnotations(nil, dns), map[string]string{
+		externalDNSHostnameAnnotation: "example.com",
+		awsProxyProtocolAnnotation:    "*",
+	})
+	// The value set on the gateway is rendered on the Service instead
+	assert.Equal(t, p.withServiceAnnotations(map[string]string{awsProxyProtocolAnnotation: "80"}, nil), map[string]string{})
+}
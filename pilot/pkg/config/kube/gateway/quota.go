@@ -0,0 +1,169 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pkg/config"
+)
+
+const (
+	// maxManagedGatewaysAnnotation overrides PILOT_MAX_MANAGED_GATEWAYS_PER_NAMESPACE for a namespace.
+	maxManagedGatewaysAnnotation = "gateway.istio.io/max-managed-gateways"
+	// maxLoadBalancersAnnotation overrides PILOT_MAX_GATEWAY_LOAD_BALANCERS_PER_NAMESPACE for a namespace.
+	maxLoadBalancersAnnotation = "gateway.istio.io/max-load-balancers"
+)
+
+// gatewayQuota limits the number of managed Gateways. A limit of 0 means no limit.
+type gatewayQuota struct {
+	gateways      int
+	loadBalancers int
+}
+
+func (q gatewayQuota) unlimited() bool {
+	return q.gateways <= 0 && q.loadBalancers <= 0
+}
+
+// quotaCandidate is a managed Gateway, counting against the quotas.
+type quotaCandidate struct {
+	name         types.NamespacedName
+	created      time.Time
+	loadBalancer bool
+}
+
+// gatewayServiceType returns the type of the Service deployed for a managed Gateway.
+func gatewayServiceType(annotations map[string]string, ci classInfo) corev1.ServiceType {
+	if o, f := annotations[serviceTypeOverride]; f {
+		return corev1.ServiceType(o)
+	}
+	return ci.defaultServiceType
+}
+
+// newQuotaCandidate returns the quota candidate for a Gateway, if it is deployed by us.
+func newQuotaCandidate(meta config.Meta, spec *k8s.GatewaySpec, ci classInfo) (quotaCandidate, bool) {
+	if ci.templates == "" || !IsManaged(spec) {
+		return quotaCandidate{}, false
+	}
+	return quotaCandidate{
+		name:         types.NamespacedName{Name: meta.Name, Namespace: meta.Namespace},
+		created:      meta.CreationTimestamp,
+		loadBalancer: gatewayServiceType(meta.Annotations, ci) == corev1.ServiceTypeLoadBalancer,
+	}, true
+}
+
+// tenantGatewayQuota returns the quota applying to all namespaces of the mesh.
+func tenantGatewayQuota() gatewayQuota {
+	return gatewayQuota{
+		gateways:      features.MaxManagedGatewaysPerTenant,
+		loadBalancers: features.MaxGatewayLoadBalancersPerTenant,
+	}
+}
+
+// namespaceGatewayQuota returns the quota of a namespace. The namespace may be nil, if it is not known.
+func namespaceGatewayQuota(ns *corev1.Namespace) gatewayQuota {
+	q := gatewayQuota{
+		gateways:      features.MaxManagedGatewaysPerNamespace,
+		loadBalancers: features.MaxGatewayLoadBalancersPerNamespace,
+	}
+	if ns == nil {
+		return q
+	}
+	q.gateways = quotaOverride(ns, maxManagedGatewaysAnnotation, q.gateways)
+	q.loadBalancers = quotaOverride(ns, maxLoadBalancersAnnotation, q.loadBalancers)
+	return q
+}
+
+func quotaOverride(ns *corev1.Namespace, annotation string, def int) int {
+	v, f := ns.Annotations[annotation]
+	if !f {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		log.Warnf("invalid %s annotation %q on namespace %s, using %d", annotation, v, ns.Name, def)
+		return def
+	}
+	return n
+}
+
+// quotaViolations determines the Gateways exceeding the tenant or namespace quotas, with a message describing
+// the exceeded quota. Gateways are admitted in order of creation, so that existing Gateways are never
+// displaced by newer ones.
+func quotaViolations(candidates []quotaCandidate, tenant gatewayQuota, namespace func(ns string) gatewayQuota) map[types.NamespacedName]string {
+	sort.Slice(candidates, func(i, j int) bool {
+		if !candidates[i].created.Equal(candidates[j].created) {
+			return candidates[i].created.Before(candidates[j].created)
+		}
+		if candidates[i].name.Namespace != candidates[j].name.Namespace {
+			return candidates[i].name.Namespace < candidates[j].name.Namespace
+		}
+		return candidates[i].name.Name < candidates[j].name.Name
+	})
+
+	violations := map[types.NamespacedName]string{}
+	namespaceQuotas := map[string]gatewayQuota{}
+	namespaceUsage := map[string]gatewayQuota{}
+	tenantUsage := gatewayQuota{}
+	for _, c := range candidates {
+		ns := c.name.Namespace
+		nsQuota, f := namespaceQuotas[ns]
+		if !f {
+			nsQuota = namespace(ns)
+			namespaceQuotas[ns] = nsQuota
+		}
+		if tenant.unlimited() && nsQuota.unlimited() {
+			continue
+		}
+		nsUsage := namespaceUsage[ns]
+
+		var msg string
+		switch {
+		case exceeds(tenant.gateways, tenantUsage.gateways):
+			msg = fmt.Sprintf("Gateway quota exceeded: the mesh is limited to %d managed gateways", tenant.gateways)
+		case exceeds(nsQuota.gateways, nsUsage.gateways):
+			msg = fmt.Sprintf("Gateway quota exceeded: namespace %s is limited to %d managed gateways", ns, nsQuota.gateways)
+		case c.loadBalancer && exceeds(tenant.loadBalancers, tenantUsage.loadBalancers):
+			msg = fmt.Sprintf("Gateway quota exceeded: the mesh is limited to %d LoadBalancer services", tenant.loadBalancers)
+		case c.loadBalancer && exceeds(nsQuota.loadBalancers, nsUsage.loadBalancers):
+			msg = fmt.Sprintf("Gateway quota exceeded: namespace %s is limited to %d LoadBalancer services", ns, nsQuota.loadBalancers)
+		}
+		if msg != "" {
+			violations[c.name] = msg
+			continue
+		}
+
+		tenantUsage.gateways++
+		nsUsage.gateways++
+		if c.loadBalancer {
+			tenantUsage.loadBalancers++
+			nsUsage.loadBalancers++
+		}
+		namespaceUsage[ns] = nsUsage
+	}
+	return violations
+}
+
+func exceeds(limit, usage int) bool {
+	return limit > 0 && usage >= limit
+}
@@ -0,0 +1,131 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/pkg/util/sets"
+)
+
+func TestQuotaViolations(t *testing.T) {
+	now := time.Now()
+	candidate := func(ns, name string, age int, lb bool) quotaCandidate {
+		return quotaCandidate{
+			name:         types.NamespacedName{Namespace: ns, Name: name},
+			created:      now.Add(-time.Duration(age) * time.Minute),
+			loadBalancer: lb,
+		}
+	}
+	candidates := []quotaCandidate{
+		candidate("a", "new", 1, true),
+		candidate("a", "old", 10, true),
+		candidate("a", "internal", 5, false),
+		candidate("b", "old", 10, true),
+		candidate("b", "new", 1, true),
+		candidate("c", "gw", 3, true),
+	}
+	unlimited := func(string) gatewayQuota { return gatewayQuota{} }
+
+	cases := []struct {
+		name      string
+		tenant    gatewayQuota
+		namespace func(string) gatewayQuota
+		want      sets.Set[types.NamespacedName]
+	}{
+		{
+			name:      "unlimited",
+			namespace: unlimited,
+			want:      sets.New[types.NamespacedName](),
+		},
+		{
+			name:      "namespace gateways",
+			namespace: func(string) gatewayQuota { return gatewayQuota{gateways: 1} },
+			want: sets.New(
+				types.NamespacedName{Namespace: "a", Name: "internal"},
+				types.NamespacedName{Namespace: "a", Name: "new"},
+				types.NamespacedName{Namespace: "b", Name: "new"},
+			),
+		},
+		{
+			name:      "namespace load balancers",
+			namespace: func(string) gatewayQuota { return gatewayQuota{loadBalancers: 1} },
+			want: sets.New(
+				types.NamespacedName{Namespace: "a", Name: "new"},
+				types.NamespacedName{Namespace: "b", Name: "new"},
+			),
+		},
+		{
+			name:   "single namespace",
+			tenant: gatewayQuota{},
+			namespace: func(ns string) gatewayQuota {
+				if ns == "b" {
+					return gatewayQuota{gateways: 1}
+				}
+				return gatewayQuota{}
+			},
+			want: sets.New(types.NamespacedName{Namespace: "b", Name: "new"}),
+		},
+		{
+			name:      "tenant",
+			tenant:    gatewayQuota{gateways: 4, loadBalancers: 2},
+			namespace: unlimited,
+			want: sets.New(
+				// a/old and b/old are admitted first, using up the load balancers
+				types.NamespacedName{Namespace: "a", Name: "new"},
+				types.NamespacedName{Namespace: "b", Name: "new"},
+				types.NamespacedName{Namespace: "c", Name: "gw"},
+			),
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := quotaViolations(append([]quotaCandidate{}, candidates...), tt.tenant, tt.namespace)
+			names := sets.New[types.NamespacedName]()
+			for name, msg := range got {
+				assert.Equal(t, msg != "", true)
+				names.Insert(name)
+			}
+			assert.Equal(t, names, tt.want)
+		})
+	}
+}
+
+func TestNamespaceGatewayQuota(t *testing.T) {
+	test.SetForTest(t, &features.MaxManagedGatewaysPerNamespace, 3)
+	test.SetForTest(t, &features.MaxGatewayLoadBalancersPerNamespace, 1)
+	ns := func(annotations map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns", Annotations: annotations}}
+	}
+
+	assert.Equal(t, namespaceGatewayQuota(nil), gatewayQuota{gateways: 3, loadBalancers: 1})
+	assert.Equal(t, namespaceGatewayQuota(ns(nil)), gatewayQuota{gateways: 3, loadBalancers: 1})
+	assert.Equal(t, namespaceGatewayQuota(ns(map[string]string{
+		maxManagedGatewaysAnnotation: "5",
+		maxLoadBalancersAnnotation:   "0",
+	})), gatewayQuota{gateways: 5, loadBalancers: 0})
+	assert.Equal(t, namespaceGatewayQuota(ns(map[string]string{
+		maxManagedGatewaysAnnotation: "invalid",
+		maxLoadBalancersAnnotation:   "-1",
+	})), gatewayQuota{gateways: 3, loadBalancers: 1})
+}
@@ -0,0 +1,132 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/host"
+	"istio.io/istio/pkg/config/validation"
+)
+
+const (
+	// retryBudgetExtension is the gatewayExtensionLabel value of a retry budget. The budget is read from the
+	// retryBudgetExtension key of the ConfigMap data.
+	retryBudgetExtension = "retry-budget"
+
+	defaultRetryBudgetAttempts = 2
+	defaultRetryBudgetRetryOn  = "503,504"
+)
+
+// retryBudgetConfig is the format of a retry budget stored in an extension ConfigMap. The retries of the route are
+// limited by the budget of its backends, so that a brownout of a backend does not turn into a retry storm.
+type retryBudgetConfig struct {
+	// Attempts is the number of retries of a request, 2 by default.
+	Attempts int32 `json:"attempts,omitempty"`
+	// RetryOn lists the conditions to retry on, in the format of the Istio retryOn field. Requests failing with
+	// a 503 or a 504 are retried by default.
+	RetryOn       string `json:"retryOn,omitempty"`
+	PerTryTimeout string `json:"perTryTimeout,omitempty"`
+	// BudgetPercent is the maximum number of concurrent retries, as a percentage of the active requests.
+	BudgetPercent float64 `json:"budgetPercent"`
+	// MinRetryConcurrency is the number of concurrent retries always allowed, regardless of the active requests.
+	// Envoy's default applies when it is not set.
+	MinRetryConcurrency *uint32 `json:"minRetryConcurrency,omitempty"`
+}
+
+// buildRetryBudget converts the retry budget of an extension ConfigMap into the retry policy of the route and the
+// budget of its backends.
+func buildRetryBudget(cm *corev1.ConfigMap) (*istio.HTTPRetry, *model.RetryBudget, error) {
+	data, f := cm.Data[retryBudgetExtension]
+	if !f {
+		return nil, nil, fmt.Errorf("missing %q key", retryBudgetExtension)
+	}
+	cfg := retryBudgetConfig{}
+	if err := yaml.UnmarshalStrict([]byte(data), &cfg); err != nil {
+		return nil, nil, err
+	}
+	if cfg.BudgetPercent <= 0 || cfg.BudgetPercent > 100 {
+		return nil, nil, fmt.Errorf("budgetPercent must be in (0, 100], got %v", cfg.BudgetPercent)
+	}
+
+	retries := &istio.HTTPRetry{
+		Attempts: cfg.Attempts,
+		RetryOn:  cfg.RetryOn,
+	}
+	if retries.Attempts == 0 {
+		retries.Attempts = defaultRetryBudgetAttempts
+	}
+	if retries.RetryOn == "" {
+		retries.RetryOn = defaultRetryBudgetRetryOn
+	}
+	if cfg.PerTryTimeout != "" {
+		perTryTimeout, err := time.ParseDuration(cfg.PerTryTimeout)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid perTryTimeout: %v", err)
+		}
+		retries.PerTryTimeout = durationpb.New(perTryTimeout)
+	}
+	// Validate the retry policy as if it was set on a VirtualService, so it is bounded like any other setting
+	if _, err := validation.ValidateVirtualService(config.Config{
+		Meta: config.Meta{Name: cm.Name, Namespace: cm.Namespace},
+		Spec: &istio.VirtualService{
+			Hosts: []string{"default"},
+			Http: []*istio.HTTPRoute{{
+				Route:   []*istio.HTTPRouteDestination{{Destination: &istio.Destination{Host: "default"}}},
+				Retries: retries,
+			}},
+		},
+	}); err != nil {
+		return nil, nil, err
+	}
+	return retries, &model.RetryBudget{Percent: cfg.BudgetPercent, MinConcurrency: cfg.MinRetryConcurrency}, nil
+}
+
+// annotateRetryBudgets sets the retry budgets of the backends of the routes of a generated gateway VirtualService.
+// The budgets are enforced on the clusters of the gateway, so a backend of several routes gets the most
+// restrictive budget.
+func annotateRetryBudgets(ctx configContext, cfg *config.Config) {
+	if len(ctx.routeRetryBudgets) == 0 {
+		return
+	}
+	budgets := map[host.Name]model.RetryBudget{}
+	for _, route := range cfg.Spec.(*istio.VirtualService).Http {
+		budget, f := ctx.routeRetryBudgets[route.Name]
+		if !f {
+			continue
+		}
+		for _, dest := range route.Route {
+			model.MergeRetryBudget(budgets, host.Name(dest.GetDestination().GetHost()), budget)
+		}
+	}
+	if len(budgets) == 0 {
+		return
+	}
+	js, err := json.Marshal(budgets)
+	if err != nil {
+		log.Errorf("failed to marshal retry budgets of %s/%s: %v", cfg.Namespace, cfg.Name, err)
+		return
+	}
+	cfg.Annotations[model.InternalRouteRetryBudgetsAnnotation] = string(js)
+}
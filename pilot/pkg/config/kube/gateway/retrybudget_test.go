@@ -0,0 +1,165 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/host"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func retryBudgetConfigMap(extension string, data string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "budget",
+			Namespace: "default",
+			Labels:    map[string]string{gatewayExtensionLabel: extension},
+		},
+		Data: map[string]string{retryBudgetExtension: data},
+	}
+}
+
+func TestBuildRetryBudget(t *testing.T) {
+	cases := []struct {
+		name        string
+		data        string
+		wantRetries *istio.HTTPRetry
+		wantBudget  *model.RetryBudget
+		wantErr     bool
+	}{
+		{
+			name:        "defaults",
+			data:        `budgetPercent: 20`,
+			wantRetries: &istio.HTTPRetry{Attempts: 2, RetryOn: "503,504"},
+			wantBudget:  &model.RetryBudget{Percent: 20},
+		},
+		{
+			name: "full",
+			data: `
+attempts: 3
+retryOn: connect-failure,503
+perTryTimeout: 2s
+budgetPercent: 12.5
+minRetryConcurrency: 5`,
+			wantRetries: &istio.HTTPRetry{Attempts: 3, RetryOn: "connect-failure,503", PerTryTimeout: durationpb.New(2 * time.Second)},
+			wantBudget:  &model.RetryBudget{Percent: 12.5, MinConcurrency: ptr.Of[uint32](5)},
+		},
+		{
+			name:    "missing budget",
+			data:    `attempts: 3`,
+			wantErr: true,
+		},
+		{
+			name:    "budget over 100",
+			data:    `budgetPercent: 120`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid retryOn",
+			data:    `{budgetPercent: 20, retryOn: sometimes}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid perTryTimeout",
+			data:    `{budgetPercent: 20, perTryTimeout: soon}`,
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    `{budgetPercent: 20, maxRetries: 3}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			retries, budget, err := buildRetryBudget(retryBudgetConfigMap(retryBudgetExtension, tt.data))
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, retries, tt.wantRetries)
+			assert.Equal(t, budget, tt.wantBudget)
+		})
+	}
+}
+
+func TestRetryBudgetExtensionRef(t *testing.T) {
+	route := config.Config{Meta: config.Meta{GroupVersionKind: gvk.HTTPRoute, Name: "route", Namespace: "default"}}
+	ctx := configContext{
+		GatewayResources: GatewayResources{ExtensionConfigMaps: map[types.NamespacedName]*corev1.ConfigMap{
+			{Namespace: "default", Name: "budget"}: retryBudgetConfigMap(retryBudgetExtension, `{budgetPercent: 20, minRetryConcurrency: 3}`),
+		}},
+		resourceReferences: map[model.ConfigKey][]model.ConfigKey{},
+		routeRetryBudgets:  map[string]model.RetryBudget{},
+	}
+	ext, err := createExtensionRefFilter(ctx, &k8sv1.LocalObjectReference{Kind: "ConfigMap", Name: "budget"}, route)
+	assert.Equal(t, err, nil)
+	assert.Equal(t, ext.retries, &istio.HTTPRetry{Attempts: 2, RetryOn: "503,504"})
+	assert.Equal(t, ext.retryBudget, &model.RetryBudget{Percent: 20, MinConcurrency: ptr.Of[uint32](3)})
+	assert.Equal(t, ext.cors, nil)
+}
+
+func TestAnnotateRetryBudgets(t *testing.T) {
+	ctx := configContext{routeRetryBudgets: map[string]model.RetryBudget{
+		"default.a.0": {Percent: 20},
+		"default.b.0": {Percent: 10, MinConcurrency: ptr.Of[uint32](3)},
+	}}
+	vs := &config.Config{
+		Meta: config.Meta{Name: "a-0-istio-autogenerated-k8s-gateway", Namespace: "default", Annotations: map[string]string{}},
+		Spec: &istio.VirtualService{Http: []*istio.HTTPRoute{
+			{
+				Name: "default.a.0",
+				Route: []*istio.HTTPRouteDestination{
+					{Destination: &istio.Destination{Host: "shared.default.svc.cluster.local"}},
+					{Destination: &istio.Destination{Host: "a.default.svc.cluster.local"}},
+				},
+			},
+			{
+				Name:  "default.b.0",
+				Route: []*istio.HTTPRouteDestination{{Destination: &istio.Destination{Host: "shared.default.svc.cluster.local"}}},
+			},
+			{
+				Name:  "default.c.0",
+				Route: []*istio.HTTPRouteDestination{{Destination: &istio.Destination{Host: "c.default.svc.cluster.local"}}},
+			},
+		}},
+	}
+	annotateRetryBudgets(ctx, vs)
+	budgets, err := model.ParseRouteRetryBudgets(*vs)
+	assert.NoError(t, err)
+	assert.Equal(t, budgets, map[host.Name]model.RetryBudget{
+		"a.default.svc.cluster.local":      {Percent: 20},
+		"shared.default.svc.cluster.local": {Percent: 10, MinConcurrency: ptr.Of[uint32](3)},
+	})
+
+	// Routes without a budget are not annotated
+	vs.Annotations = map[string]string{}
+	annotateRetryBudgets(configContext{}, vs)
+	assert.Equal(t, vs.Annotations, map[string]string{})
+}
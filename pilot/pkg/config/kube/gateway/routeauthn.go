@@ -0,0 +1,316 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	securityapi "istio.io/api/security/v1beta1"
+	typeapi "istio.io/api/type/v1beta1"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/security"
+	"istio.io/istio/pkg/maps"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/util/sets"
+)
+
+const (
+	// jwtIssuersAnnotation lists the JWT issuers an HTTPRoute requires, on the Gateways it is attached to. Requests
+	// matched by the route without a valid token of one of the issuers are denied. The tokens are validated by the
+	// whole Gateway workload though: on every route of the Gateway, requests carrying an invalid token, or a token of
+	// an issuer no route of the Gateway requires, are rejected.
+	jwtIssuersAnnotation = "gateway.istio.io/jwt-issuers"
+	// jwtAudiencesAnnotation lists the audiences the token must have one of. Any audience is accepted if not set.
+	jwtAudiencesAnnotation = "gateway.istio.io/jwt-audiences"
+	// jwtJwksURIAnnotation is the URI of the keys of the issuer, if it does not serve the OpenID discovery document.
+	// It can only be set with a single issuer.
+	jwtJwksURIAnnotation = "gateway.istio.io/jwt-jwks-uri"
+)
+
+// routeAuthentication is the authentication an HTTPRoute requires, from its annotations.
+type routeAuthentication struct {
+	issuers   []string
+	audiences []string
+	jwksURI   string
+	// err is set if the annotations are invalid. Such a route denies all its requests, rather than accepting the
+	// requests it meant to authenticate.
+	err *ConfigError
+}
+
+// routeAuthnAttachment is the authentication required by an HTTPRoute on a Gateway it is attached to, scoped to the
+// hosts and paths the route matches on that Gateway.
+type routeAuthnAttachment struct {
+	creationTimestamp time.Time
+	parents           string
+	gateway           types.NamespacedName
+	authn             *routeAuthentication
+	// hosts and paths are the scope of the requirement. A nil scope matches any host, or path.
+	hosts sets.String
+	paths []string
+}
+
+// parseRouteAuthentication returns the authentication required by the annotations of an HTTPRoute, or nil if it does
+// not require any.
+func parseRouteAuthentication(obj config.Config) *routeAuthentication {
+	value, f := obj.Annotations[jwtIssuersAnnotation]
+	if !f {
+		return nil
+	}
+	invalid := func(format string, args ...any) *routeAuthentication {
+		return &routeAuthentication{err: &ConfigError{
+			Reason:  InvalidAuthentication,
+			Message: fmt.Sprintf(format, args...) + ", denying all the requests of the route on its Gateways",
+		}}
+	}
+	res := &routeAuthentication{
+		issuers:   splitAnnotationList(value),
+		audiences: splitAnnotationList(obj.Annotations[jwtAudiencesAnnotation]),
+		jwksURI:   strings.TrimSpace(obj.Annotations[jwtJwksURIAnnotation]),
+	}
+	if len(res.issuers) == 0 {
+		return invalid("%s must list at least one issuer", jwtIssuersAnnotation)
+	}
+	for _, iss := range res.issuers {
+		if strings.Contains(iss, "*") {
+			return invalid("invalid issuer %q in %s, wildcards are not supported", iss, jwtIssuersAnnotation)
+		}
+	}
+	for _, aud := range res.audiences {
+		if strings.Contains(aud, "*") {
+			return invalid("invalid audience %q in %s, wildcards are not supported", aud, jwtAudiencesAnnotation)
+		}
+	}
+	if res.jwksURI != "" {
+		if len(res.issuers) > 1 {
+			return invalid("%s can only be set with a single issuer", jwtJwksURIAnnotation)
+		}
+		if _, err := security.ParseJwksURI(res.jwksURI); err != nil {
+			return invalid("invalid %s %q: %v", jwtJwksURIAnnotation, res.jwksURI, err)
+		}
+	}
+	return res
+}
+
+// splitAnnotationList splits a comma separated annotation value, ignoring the empty entries.
+func splitAnnotationList(value string) []string {
+	var res []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// authnScoped returns whether the authentication required by an HTTPRoute can be scoped to its requests on a parent:
+// otherwise, the route matches any hostname and any path of the listener, and the policies would apply to all the
+// routes of the Gateway.
+func authnScoped(route *k8s.HTTPRouteSpec, parent routeParentReference) bool {
+	return len(route.Hostnames) > 0 || parent.Hostname != "" || routeAuthnPaths(route) != nil
+}
+
+// unscopedAuthnError returns an error if the authentication required by an HTTPRoute cannot be scoped to its requests
+// on one of its Gateways. The route is not served on such a Gateway, rather than serving it unauthenticated.
+func unscopedAuthnError(route *k8s.HTTPRouteSpec, authn *routeAuthentication, parents []routeParentReference) *ConfigError {
+	if authn == nil {
+		return nil
+	}
+	for _, parent := range filteredReferences(parents) {
+		if parent.IsMesh() || parent.IsFederated() || authnScoped(route, parent) {
+			continue
+		}
+		return &ConfigError{
+			Reason: InvalidAuthentication,
+			Message: fmt.Sprintf("the authentication of the route cannot be scoped to its requests on %s, as it matches any hostname "+
+				"and path of the listener: the route is not served there, set its hostnames or match its paths", parent.InternalName),
+		}
+	}
+	return nil
+}
+
+// attachRouteAuthentication records the authentication required by an HTTPRoute on a Gateway it is attached to.
+// Routes attached to the mesh, or to federated Gateways, are not authenticated by this Gateway controller.
+// Returns false if the authentication cannot be scoped to the route on the Gateway, in which case the route must not be
+// served there.
+func attachRouteAuthentication(ctx configContext, obj config.Config, authn *routeAuthentication, parent routeParentReference) bool {
+	if authn == nil || parent.IsMesh() || parent.IsFederated() {
+		return true
+	}
+	ref := parent.OriginalReference
+	if ptr.OrDefault((*string)(ref.Kind), gvk.KubernetesGateway.Kind) != gvk.KubernetesGateway.Kind {
+		return true
+	}
+	route := obj.Spec.(*k8s.HTTPRouteSpec)
+	if !authnScoped(route, parent) {
+		return false
+	}
+	gateway := types.NamespacedName{
+		Namespace: string(ptr.OrDefault(ref.Namespace, k8s.Namespace(obj.Namespace))),
+		Name:      string(ref.Name),
+	}
+	// The policies are generated in the namespace of the Gateway, which must select its workload
+	key := types.NamespacedName{
+		Namespace: gateway.Namespace,
+		Name:      fmt.Sprintf("%s-%s-%s-%s", gateway.Name, obj.Namespace, obj.Name, constants.KubernetesGatewayName),
+	}
+	a := ctx.routeAuthentications[key]
+	if a == nil {
+		a = &routeAuthnAttachment{
+			creationTimestamp: obj.CreationTimestamp,
+			parents:           parentMeta(obj, nil)[constants.InternalParentNames],
+			gateway:           gateway,
+			authn:             authn,
+			hosts:             sets.New[string](),
+			paths:             routeAuthnPaths(route),
+		}
+		ctx.routeAuthentications[key] = a
+	}
+	if a.hosts == nil {
+		// Already matching any hostname of another listener, so only scoped by paths
+		return true
+	}
+	hosts := slices.Map(route.Hostnames, func(h k8s.Hostname) string { return string(h) })
+	if len(hosts) == 0 && parent.Hostname != "" {
+		// The route matches any hostname of the listener
+		hosts = []string{parent.Hostname}
+	}
+	if len(hosts) == 0 {
+		a.hosts = nil
+		return true
+	}
+	a.hosts.InsertAll(hosts...)
+	return true
+}
+
+// routeAuthnPaths returns the paths matched by the rules of a route, as authorization policy paths, or nil if the
+// route matches requests by other means than path prefixes or exact paths.
+func routeAuthnPaths(route *k8s.HTTPRouteSpec) []string {
+	paths := sets.New[string]()
+	for _, rule := range route.Rules {
+		if len(rule.Matches) == 0 {
+			return nil
+		}
+		for _, m := range rule.Matches {
+			if m.Path == nil || m.Path.Value == nil {
+				return nil
+			}
+			value := *m.Path.Value
+			switch ptr.OrDefault(m.Path.Type, k8s.PathMatchPathPrefix) {
+			case k8s.PathMatchExact:
+				paths.Insert(value)
+			case k8s.PathMatchPathPrefix:
+				value = strings.TrimSuffix(value, "/")
+				if value == "" {
+					return nil
+				}
+				paths.Insert(value, value+"/*")
+			default:
+				return nil
+			}
+		}
+	}
+	if paths.IsEmpty() {
+		return nil
+	}
+	return sets.SortedList(paths)
+}
+
+// convertRouteAuthentications generates, for the authentication required by each HTTPRoute on each of its Gateways,
+// a RequestAuthentication validating the tokens of the issuers and a DENY AuthorizationPolicy rejecting the requests
+// of the route without such a token. Both target the Gateway, so they only apply to its workload. The policy is scoped
+// to the hosts and paths of the route, so the requests of the other routes of the Gateway are not required to carry a
+// token. A RequestAuthentication cannot be scoped though, so the tokens carried by the requests of every route of the
+// Gateway are validated, and the requests with a token that is invalid, or of another issuer, are rejected.
+func convertRouteAuthentications(ctx configContext) ([]config.Config, []config.Config) {
+	var requestAuthns, authzPolicies []config.Config
+	for _, key := range slices.SortBy(maps.Keys(ctx.routeAuthentications), types.NamespacedName.String) {
+		a := ctx.routeAuthentications[key]
+		meta := config.Meta{
+			Name:              key.Name,
+			Namespace:         key.Namespace,
+			Domain:            ctx.Domain,
+			CreationTimestamp: a.creationTimestamp,
+			Annotations:       map[string]string{constants.InternalParentNames: a.parents},
+		}
+		targetRef := &typeapi.PolicyTargetReference{
+			Group: gvk.KubernetesGateway.Group,
+			Kind:  gvk.KubernetesGateway.Kind,
+			Name:  a.gateway.Name,
+		}
+		var scope []*securityapi.Rule_To
+		if hosts := authnHosts(a.hosts); len(hosts) > 0 || len(a.paths) > 0 {
+			scope = []*securityapi.Rule_To{{Operation: &securityapi.Operation{Hosts: hosts, Paths: a.paths}}}
+		}
+
+		policy := &securityapi.AuthorizationPolicy{
+			TargetRef: targetRef,
+			Action:    securityapi.AuthorizationPolicy_DENY,
+		}
+		if a.authn.err != nil {
+			// Deny all the requests of the route
+			policy.Rules = []*securityapi.Rule{{To: scope}}
+		} else {
+			policy.Rules = []*securityapi.Rule{{
+				From: []*securityapi.Rule_From{{Source: &securityapi.Source{
+					NotRequestPrincipals: slices.Map(a.authn.issuers, func(iss string) string { return iss + "/*" }),
+				}}},
+				To: scope,
+			}}
+			if len(a.authn.audiences) > 0 {
+				policy.Rules = append(policy.Rules, &securityapi.Rule{
+					To:   scope,
+					When: []*securityapi.Condition{{Key: "request.auth.audiences", NotValues: a.authn.audiences}},
+				})
+			}
+
+			ra := &securityapi.RequestAuthentication{TargetRef: targetRef}
+			for _, iss := range a.authn.issuers {
+				ra.JwtRules = append(ra.JwtRules, &securityapi.JWTRule{Issuer: iss, JwksUri: a.authn.jwksURI})
+			}
+			raMeta := meta
+			raMeta.GroupVersionKind = gvk.RequestAuthentication
+			raMeta.Annotations = maps.Clone(meta.Annotations)
+			requestAuthns = append(requestAuthns, config.Config{Meta: raMeta, Spec: ra})
+		}
+		apMeta := meta
+		apMeta.GroupVersionKind = gvk.AuthorizationPolicy
+		authzPolicies = append(authzPolicies, config.Config{Meta: apMeta, Spec: policy})
+	}
+	return requestAuthns, authzPolicies
+}
+
+// authnHosts returns the authorization policy hosts matching the hostnames, with any port.
+func authnHosts(hostnames sets.String) []string {
+	if hostnames == nil {
+		return nil
+	}
+	var hosts []string
+	for _, h := range sets.SortedList(hostnames) {
+		hosts = append(hosts, h)
+		if !strings.HasPrefix(h, "*") {
+			// A wildcard cannot also match the port
+			hosts = append(hosts, h+":*")
+		}
+	}
+	return hosts
+}
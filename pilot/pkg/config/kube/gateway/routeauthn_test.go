@@ -0,0 +1,283 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	securityapi "istio.io/api/security/v1beta1"
+	typeapi "istio.io/api/type/v1beta1"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/validation"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func authnRoute(annotations map[string]string, hostnames []k8s.Hostname, matches ...k8s.HTTPRouteMatch) config.Config {
+	return config.Config{
+		Meta: config.Meta{
+			GroupVersionKind: gvk.HTTPRoute,
+			Name:             "api",
+			Namespace:        "apps",
+			Annotations:      annotations,
+		},
+		Spec: &k8s.HTTPRouteSpec{
+			Hostnames: hostnames,
+			Rules:     []k8s.HTTPRouteRule{{Matches: matches}},
+		},
+	}
+}
+
+func pathMatch(typ k8s.PathMatchType, value string) k8s.HTTPRouteMatch {
+	return k8s.HTTPRouteMatch{Path: &k8s.HTTPPathMatch{Type: &typ, Value: &value}}
+}
+
+func TestParseRouteAuthentication(t *testing.T) {
+	cases := []struct {
+		name        string
+		annotations map[string]string
+		want        *routeAuthentication
+		wantErr     bool
+	}{
+		{
+			name: "not set",
+		},
+		{
+			name: "issuers and audiences",
+			annotations: map[string]string{
+				jwtIssuersAnnotation:   "https://issuer.example.com, https://other.example.com",
+				jwtAudiencesAnnotation: "api,",
+			},
+			want: &routeAuthentication{
+				issuers:   []string{"https://issuer.example.com", "https://other.example.com"},
+				audiences: []string{"api"},
+			},
+		},
+		{
+			name: "jwks URI",
+			annotations: map[string]string{
+				jwtIssuersAnnotation: "https://issuer.example.com",
+				jwtJwksURIAnnotation: "https://issuer.example.com/keys",
+			},
+			want: &routeAuthentication{issuers: []string{"https://issuer.example.com"}, jwksURI: "https://issuer.example.com/keys"},
+		},
+		{
+			name:        "no issuer",
+			annotations: map[string]string{jwtIssuersAnnotation: " , "},
+			wantErr:     true,
+		},
+		{
+			name:        "wildcard issuer",
+			annotations: map[string]string{jwtIssuersAnnotation: "*"},
+			wantErr:     true,
+		},
+		{
+			name: "jwks URI with several issuers",
+			annotations: map[string]string{
+				jwtIssuersAnnotation: "https://issuer.example.com,https://other.example.com",
+				jwtJwksURIAnnotation: "https://issuer.example.com/keys",
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid jwks URI",
+			annotations: map[string]string{
+				jwtIssuersAnnotation: "https://issuer.example.com",
+				jwtJwksURIAnnotation: "issuer.example.com/keys",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseRouteAuthentication(authnRoute(tt.annotations, nil))
+			if tt.wantErr {
+				if got == nil || got.err == nil || got.err.Reason != InvalidAuthentication {
+					t.Fatalf("expected an %s error, got %+v", InvalidAuthentication, got)
+				}
+				return
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Fatalf("expected no authentication, got %+v", got)
+				}
+				return
+			}
+			if got.err != nil {
+				t.Fatalf("unexpected error: %v", got.err.Message)
+			}
+			assert.Equal(t, got.issuers, tt.want.issuers)
+			assert.Equal(t, got.audiences, tt.want.audiences)
+			assert.Equal(t, got.jwksURI, tt.want.jwksURI)
+		})
+	}
+}
+
+func TestRouteAuthnPaths(t *testing.T) {
+	cases := []struct {
+		name    string
+		matches []k8s.HTTPRouteMatch
+		want    []string
+	}{
+		{
+			name: "no match",
+		},
+		{
+			name:    "prefixes and exact paths",
+			matches: []k8s.HTTPRouteMatch{pathMatch(k8s.PathMatchPathPrefix, "/api/"), pathMatch(k8s.PathMatchExact, "/login")},
+			want:    []string{"/api", "/api/*", "/login"},
+		},
+		{
+			name:    "root prefix",
+			matches: []k8s.HTTPRouteMatch{pathMatch(k8s.PathMatchPathPrefix, "/api"), pathMatch(k8s.PathMatchPathPrefix, "/")},
+		},
+		{
+			name:    "regex",
+			matches: []k8s.HTTPRouteMatch{pathMatch(k8s.PathMatchRegularExpression, "/api/.*")},
+		},
+		{
+			name:    "header match",
+			matches: []k8s.HTTPRouteMatch{{Headers: []k8s.HTTPHeaderMatch{{Name: "tenant", Value: "a"}}}},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, routeAuthnPaths(authnRoute(nil, nil, tt.matches...).Spec.(*k8s.HTTPRouteSpec)), tt.want)
+		})
+	}
+}
+
+func TestConvertRouteAuthentications(t *testing.T) {
+	gatewayRef := func(listenerHostname string) routeParentReference {
+		return routeParentReference{
+			InternalName:      "istio-system/gateway-" + constants.KubernetesGatewayName + "-https",
+			OriginalReference: k8s.ParentReference{Name: "gateway", Namespace: (*k8s.Namespace)(ptr.Of("istio-system"))},
+			Hostname:          listenerHostname,
+		}
+	}
+	newContext := func() configContext {
+		return configContext{
+			GatewayResources:     GatewayResources{Domain: "cluster.local"},
+			routeAuthentications: map[types.NamespacedName]*routeAuthnAttachment{},
+		}
+	}
+	targetRef := &typeapi.PolicyTargetReference{Group: gvk.KubernetesGateway.Group, Kind: gvk.KubernetesGateway.Kind, Name: "gateway"}
+	name := "gateway-apps-api-" + constants.KubernetesGatewayName
+
+	t.Run("scoped to the route", func(t *testing.T) {
+		ctx := newContext()
+		obj := authnRoute(map[string]string{
+			jwtIssuersAnnotation:   "https://issuer.example.com",
+			jwtAudiencesAnnotation: "api",
+		}, []k8s.Hostname{"api.example.com", "*.api.example.com"}, pathMatch(k8s.PathMatchPathPrefix, "/v1"))
+		authn := parseRouteAuthentication(obj)
+		attachRouteAuthentication(ctx, obj, authn, gatewayRef("*.example.com"))
+		attachRouteAuthentication(ctx, obj, authn, gatewayRef(""))
+		// The mesh does not enforce the authentication of the route
+		attachRouteAuthentication(ctx, obj, authn, routeParentReference{InternalName: "mesh"})
+
+		ras, aps := convertRouteAuthentications(ctx)
+		assert.Equal(t, len(ras), 1)
+		assert.Equal(t, len(aps), 1)
+		assert.Equal(t, ras[0].Name, name)
+		assert.Equal(t, ras[0].Namespace, "istio-system")
+		assert.Equal(t, ras[0].Annotations[constants.InternalParentNames], "HTTPRoute/api.apps")
+		assert.Equal(t, ras[0].Spec, config.Spec(&securityapi.RequestAuthentication{
+			TargetRef: targetRef,
+			JwtRules:  []*securityapi.JWTRule{{Issuer: "https://issuer.example.com"}},
+		}))
+		scope := []*securityapi.Rule_To{{Operation: &securityapi.Operation{
+			Hosts: []string{"*.api.example.com", "api.example.com", "api.example.com:*"},
+			Paths: []string{"/v1", "/v1/*"},
+		}}}
+		assert.Equal(t, aps[0].Spec, config.Spec(&securityapi.AuthorizationPolicy{
+			TargetRef: targetRef,
+			Action:    securityapi.AuthorizationPolicy_DENY,
+			Rules: []*securityapi.Rule{
+				{
+					From: []*securityapi.Rule_From{{Source: &securityapi.Source{NotRequestPrincipals: []string{"https://issuer.example.com/*"}}}},
+					To:   scope,
+				},
+				{
+					To:   scope,
+					When: []*securityapi.Condition{{Key: "request.auth.audiences", NotValues: []string{"api"}}},
+				},
+			},
+		}))
+		for _, cfg := range append(ras, aps...) {
+			validate := validation.ValidateRequestAuthentication
+			if cfg.GroupVersionKind == gvk.AuthorizationPolicy {
+				validate = validation.ValidateAuthorizationPolicy
+			}
+			if _, err := validate(cfg); err != nil {
+				t.Fatalf("invalid generated %s: %v", cfg.GroupVersionKind.Kind, err)
+			}
+		}
+	})
+
+	t.Run("route matching any hostname of the listener", func(t *testing.T) {
+		ctx := newContext()
+		obj := authnRoute(map[string]string{jwtIssuersAnnotation: "https://issuer.example.com"}, nil)
+		attachRouteAuthentication(ctx, obj, parseRouteAuthentication(obj), gatewayRef("api.example.com"))
+
+		_, aps := convertRouteAuthentications(ctx)
+		assert.Equal(t, aps[0].Spec.(*securityapi.AuthorizationPolicy).Rules[0].To, []*securityapi.Rule_To{{Operation: &securityapi.Operation{
+			Hosts: []string{"api.example.com", "api.example.com:*"},
+		}}})
+	})
+
+	t.Run("invalid annotations deny all the requests of the route", func(t *testing.T) {
+		ctx := newContext()
+		obj := authnRoute(map[string]string{jwtIssuersAnnotation: ""}, []k8s.Hostname{"api.example.com"})
+		attachRouteAuthentication(ctx, obj, parseRouteAuthentication(obj), gatewayRef(""))
+
+		ras, aps := convertRouteAuthentications(ctx)
+		assert.Equal(t, len(ras), 0)
+		assert.Equal(t, aps[0].Spec, config.Spec(&securityapi.AuthorizationPolicy{
+			TargetRef: targetRef,
+			Action:    securityapi.AuthorizationPolicy_DENY,
+			Rules: []*securityapi.Rule{{To: []*securityapi.Rule_To{{Operation: &securityapi.Operation{
+				Hosts: []string{"api.example.com", "api.example.com:*"},
+			}}}}},
+		}))
+		if _, err := validation.ValidateAuthorizationPolicy(aps[0]); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("unscoped route", func(t *testing.T) {
+		ctx := newContext()
+		obj := authnRoute(map[string]string{jwtIssuersAnnotation: "https://issuer.example.com"}, nil)
+		authn := parseRouteAuthentication(obj)
+		route := obj.Spec.(*k8s.HTTPRouteSpec)
+		// The policies would apply to all the routes of the Gateway, so the route is not served there
+		assert.Equal(t, attachRouteAuthentication(ctx, obj, authn, gatewayRef("")), false)
+		assert.Equal(t, unscopedAuthnError(route, authn, []routeParentReference{gatewayRef("")}) != nil, true)
+		ras, aps := convertRouteAuthentications(ctx)
+		assert.Equal(t, len(ras), 0)
+		assert.Equal(t, len(aps), 0)
+
+		// Matching paths is enough to scope it
+		obj = authnRoute(map[string]string{jwtIssuersAnnotation: "https://issuer.example.com"}, nil, pathMatch(k8s.PathMatchExact, "/api"))
+		route = obj.Spec.(*k8s.HTTPRouteSpec)
+		assert.Equal(t, attachRouteAuthentication(ctx, obj, authn, gatewayRef("")), true)
+		assert.Equal(t, unscopedAuthnError(route, authn, []routeParentReference{gatewayRef("")}) == nil, true)
+	})
+}
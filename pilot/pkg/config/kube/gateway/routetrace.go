@@ -0,0 +1,339 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/host"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/slices"
+)
+
+// DeniedRouteParent is a parent reference a route could not attach to.
+type DeniedRouteParent struct {
+	// Parent is the referenced parent, as <namespace>/<name>[/<section>].
+	Parent  string `json:"parent"`
+	Reason  string `json:"reason"`
+	Message string `json:"message,omitempty"`
+}
+
+// RouteTrace explains how the routes competing for a hostname and path are ordered on each Gateway listener, and
+// which one wins.
+type RouteTrace struct {
+	Hostname  string               `json:"hostname"`
+	Path      string               `json:"path"`
+	Listeners []ListenerRouteTrace `json:"listeners,omitempty"`
+	// Filtered lists the HTTPRoutes for the hostname that could not attach to some of their parents.
+	Filtered []FilteredRoute `json:"filtered,omitempty"`
+}
+
+// ListenerRouteTrace holds the routes of a Gateway listener for the hostname, in the order they are evaluated.
+type ListenerRouteTrace struct {
+	// Gateway is the Gateway listener, as <namespace>/<name>/<listener>.
+	Gateway string `json:"gateway"`
+	// Host is the most specific route hostname of the listener matching the hostname. Only its routes are evaluated.
+	Host string `json:"host"`
+	// ShadowedHosts are the less specific route hostnames matching the hostname, whose routes are not evaluated.
+	ShadowedHosts []string      `json:"shadowedHosts,omitempty"`
+	Routes        []TracedRoute `json:"routes"`
+	// Winner is the first route matching the path, if any. If it has other conditions, requests not meeting them
+	// fall through to the next route matching the path.
+	Winner string `json:"winner,omitempty"`
+}
+
+// TracedRoute is a rule of a route, as evaluated by the Gateway.
+type TracedRoute struct {
+	// Name is the name of the generated route, <namespace>.<name>.<rule index>.
+	Name string `json:"name"`
+	// Route is the HTTPRoute or GRPCRoute defining the rule, as <kind>/<namespace>/<name>. If both an HTTPRoute and a
+	// GRPCRoute of that name are attached to the hostname, both are listed.
+	Route             string    `json:"route"`
+	CreationTimestamp time.Time `json:"creationTimestamp"`
+	// Match describes the path match of the rule.
+	Match string `json:"match"`
+	// Conditions describes the other matches of the rule, which are not evaluated by the trace.
+	Conditions []string `json:"conditions,omitempty"`
+	// MatchesPath is true if the path matches the path match of the rule.
+	MatchesPath bool `json:"matchesPath"`
+	// Precedence explains why the rule is evaluated after the previous one.
+	Precedence string `json:"precedence,omitempty"`
+}
+
+// FilteredRoute is an HTTPRoute for the hostname that could not attach to a parent.
+type FilteredRoute struct {
+	Route string `json:"route"`
+	DeniedRouteParent
+}
+
+// TraceRoutes explains how the routes for the hostname and path are ordered on each Gateway listener. The trace is a
+// RouteTrace.
+func (c *Controller) TraceRoutes(hostname, path string) any {
+	c.stateMu.RLock()
+	state := c.state
+	c.stateMu.RUnlock()
+	routes := append(c.cache.List(gvk.HTTPRoute, metav1.NamespaceAll), c.cache.List(gvk.GRPCRoute, metav1.NamespaceAll)...)
+	return traceRoutes(state, routes, hostname, path)
+}
+
+func traceRoutes(state IstioResources, routes []config.Config, hostname, path string) RouteTrace {
+	trace := RouteTrace{Hostname: hostname, Path: path}
+	path, _, _ = strings.Cut(path, "?")
+
+	sources := map[string]config.Config{}
+	for _, r := range routes {
+		sources[routeSourceKey(r.GroupVersionKind.Kind, r.Namespace, r.Name)] = r
+	}
+	listeners := map[string]string{}
+	for _, gw := range state.Gateway {
+		listeners[gw.Namespace+"/"+gw.Name] = gw.Annotations[constants.InternalParentNames]
+	}
+
+	// Envoy only evaluates the routes of the most specific virtual host matching the hostname
+	byGateway := map[string][]config.Config{}
+	for _, vs := range state.VirtualService {
+		spec := vs.Spec.(*istio.VirtualService)
+		if len(spec.Gateways) != 1 || spec.Gateways[0] == constants.IstioMeshGateway || len(spec.Hosts) != 1 || len(spec.Http) == 0 {
+			continue
+		}
+		if host.Name(hostname).SubsetOf(host.Name(spec.Hosts[0])) {
+			byGateway[spec.Gateways[0]] = append(byGateway[spec.Gateways[0]], vs)
+		}
+	}
+	for gw, vss := range byGateway {
+		sort.Slice(vss, func(i, j int) bool {
+			return host.MoreSpecific(host.Name(vsHost(vss[i])), host.Name(vsHost(vss[j])))
+		})
+		spec := vss[0].Spec.(*istio.VirtualService)
+		lt := ListenerRouteTrace{Gateway: listenerName(listeners[gw], gw), Host: spec.Hosts[0]}
+		for _, shadowed := range vss[1:] {
+			lt.ShadowedHosts = append(lt.ShadowedHosts, vsHost(shadowed))
+		}
+		kinds := routeKinds(vss[0])
+		for i, r := range spec.Http {
+			tr := traceRoute(r, sources, kinds, path)
+			if i > 0 {
+				tr.Precedence = precedence(spec.Http[i-1], r, lt.Routes[i-1], tr)
+			}
+			if tr.MatchesPath && lt.Winner == "" {
+				lt.Winner = tr.Name
+			}
+			lt.Routes = append(lt.Routes, tr)
+		}
+		trace.Listeners = append(trace.Listeners, lt)
+	}
+	sort.Slice(trace.Listeners, func(i, j int) bool {
+		return trace.Listeners[i].Gateway < trace.Listeners[j].Gateway
+	})
+
+	// Tenancy filtering: routes for the hostname which were not allowed by some of their parents
+	for _, r := range routes {
+		if r.GroupVersionKind != gvk.HTTPRoute {
+			continue
+		}
+		denied := state.DeniedRouteParents[types.NamespacedName{Namespace: r.Namespace, Name: r.Name}]
+		if len(denied) == 0 || !routeHostnameMatches(r.Spec.(*k8s.HTTPRouteSpec).Hostnames, hostname) {
+			continue
+		}
+		for _, d := range denied {
+			trace.Filtered = append(trace.Filtered, FilteredRoute{Route: r.Namespace + "/" + r.Name, DeniedRouteParent: d})
+		}
+	}
+	sort.SliceStable(trace.Filtered, func(i, j int) bool {
+		return trace.Filtered[i].Route < trace.Filtered[j].Route
+	})
+	return trace
+}
+
+// vsHost returns the single host of a VirtualService generated for a gateway.
+func vsHost(vs config.Config) string {
+	return vs.Spec.(*istio.VirtualService).Hosts[0]
+}
+
+// listenerName returns the <namespace>/<name>/<listener> name of a Gateway listener from its parent annotation,
+// <kind>/<name>/<listener>.<namespace>.
+func listenerName(parent string, fallback string) string {
+	_, nameAndNamespace, found := strings.Cut(parent, "/")
+	if !found {
+		return fallback
+	}
+	dot := strings.LastIndex(nameAndNamespace, ".")
+	if dot < 0 {
+		return fallback
+	}
+	return nameAndNamespace[dot+1:] + "/" + nameAndNamespace[:dot]
+}
+
+func routeHostnameMatches(hostnames []k8s.Hostname, hostname string) bool {
+	if len(hostnames) == 0 {
+		return true
+	}
+	for _, h := range hostnames {
+		if host.Name(hostname).SubsetOf(host.Name(h)) {
+			return true
+		}
+	}
+	return false
+}
+
+// routeSourceKey identifies a route. HTTPRoutes and GRPCRoutes of the same name are distinct routes.
+func routeSourceKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// routeKinds returns the kinds of the routes merged into a VirtualService, keyed by <namespace>.<name>, from its parent
+// annotation listing them as <kind>/<name>.<namespace>.
+func routeKinds(vs config.Config) map[string][]string {
+	kinds := map[string][]string{}
+	for _, parent := range strings.Split(vs.Annotations[constants.InternalParentNames], ",") {
+		kind, nameAndNamespace, found := strings.Cut(parent, "/")
+		dot := strings.LastIndex(nameAndNamespace, ".")
+		if !found || dot < 0 {
+			continue
+		}
+		key := nameAndNamespace[dot+1:] + "." + nameAndNamespace[:dot]
+		if !slices.Contains(kinds[key], kind) {
+			kinds[key] = append(kinds[key], kind)
+		}
+	}
+	return kinds
+}
+
+func traceRoute(r *istio.HTTPRoute, sources map[string]config.Config, kinds map[string][]string, path string) TracedRoute {
+	tr := TracedRoute{Name: r.Name, Match: "any path", MatchesPath: true}
+	// Generated route names are <namespace>.<name>.<rule index>, the kind is found from the routes of the VirtualService
+	if dot := strings.LastIndex(r.Name, "."); dot > 0 {
+		var candidates []string
+		namespace, name, _ := strings.Cut(r.Name[:dot], ".")
+		for _, kind := range kinds[r.Name[:dot]] {
+			candidates = append(candidates, routeSourceKey(kind, namespace, name))
+		}
+		// An HTTPRoute and a GRPCRoute of the same name attached to the same hostname cannot be told apart
+		tr.Route = strings.Join(candidates, " or ")
+		if src, f := sources[tr.Route]; f {
+			tr.CreationTimestamp = src.CreationTimestamp
+		}
+	}
+	if len(r.Match) == 0 {
+		return tr
+	}
+	// We always generate a single match
+	m := r.Match[0]
+	if m.Uri != nil {
+		tr.Match = describeStringMatch(m.Uri)
+		tr.MatchesPath = pathMatches(m.Uri, path)
+	}
+	if m.Method != nil {
+		tr.Conditions = append(tr.Conditions, "method "+describeStringMatch(m.Method))
+	}
+	if len(m.Headers) > 0 {
+		tr.Conditions = append(tr.Conditions, fmt.Sprintf("%d header matches", len(m.Headers)))
+	}
+	if len(m.QueryParams) > 0 {
+		tr.Conditions = append(tr.Conditions, fmt.Sprintf("%d query parameter matches", len(m.QueryParams)))
+	}
+	return tr
+}
+
+func describeStringMatch(m *istio.StringMatch) string {
+	switch m.MatchType.(type) {
+	case *istio.StringMatch_Exact:
+		return fmt.Sprintf("exact %q", m.GetExact())
+	case *istio.StringMatch_Prefix:
+		return fmt.Sprintf("prefix %q", m.GetPrefix())
+	case *istio.StringMatch_Regex:
+		return fmt.Sprintf("regex %q", m.GetRegex())
+	}
+	return "unknown"
+}
+
+// pathMatches evaluates a path match as generated for a gateway route.
+func pathMatches(m *istio.StringMatch, path string) bool {
+	switch m.MatchType.(type) {
+	case *istio.StringMatch_Exact:
+		return path == m.GetExact()
+	case *istio.StringMatch_Prefix:
+		// Gateway API prefixes match path elements
+		prefix := m.GetPrefix()
+		return prefix == "/" || path == prefix || strings.HasPrefix(path, prefix+"/")
+	case *istio.StringMatch_Regex:
+		re, err := regexp.Compile("^(?:" + m.GetRegex() + ")$")
+		return err == nil && re.MatchString(path)
+	}
+	return false
+}
+
+// precedence explains why cur is evaluated after prev, following the ordering of sortHTTPRoutes.
+func precedence(prev, cur *istio.HTTPRoute, prevTrace, curTrace TracedRoute) string {
+	if len(cur.Match) == 0 {
+		if len(prev.Match) > 0 {
+			return "rules without matches are evaluated last"
+		}
+		return sameSpecificity(prevTrace, curTrace)
+	}
+	m1, m2 := prev.Match[0], cur.Match[0]
+	r1, r2 := getURIRank(m1), getURIRank(m2)
+	len1, len2 := getURILength(m1), getURILength(m2)
+	switch {
+	case r1 != r2:
+		return fmt.Sprintf("%s path match is more specific than %s", matchTypeName(r1), matchTypeName(r2))
+	case len1 != len2:
+		return fmt.Sprintf("longer path match (%d characters rather than %d)", len1, len2)
+	case (m1.Method == nil) != (m2.Method == nil):
+		return "method match"
+	case len(m1.Headers) != len(m2.Headers):
+		return fmt.Sprintf("more header matches (%d rather than %d)", len(m1.Headers), len(m2.Headers))
+	case len(m1.QueryParams) != len(m2.QueryParams):
+		return fmt.Sprintf("more query parameter matches (%d rather than %d)", len(m1.QueryParams), len(m2.QueryParams))
+	}
+	return sameSpecificity(prevTrace, curTrace)
+}
+
+// sameSpecificity explains the order of rules with the same specificity, which follows the order of the routes.
+func sameSpecificity(prev, cur TracedRoute) string {
+	switch {
+	case prev.Route == cur.Route:
+		return "same specificity, earlier rule of the same route"
+	case strings.HasPrefix(prev.Route, gvk.HTTPRoute.Kind) != strings.HasPrefix(cur.Route, gvk.HTTPRoute.Kind):
+		return "same specificity, HTTPRoutes are evaluated before GRPCRoutes"
+	case !prev.CreationTimestamp.Equal(cur.CreationTimestamp):
+		return fmt.Sprintf("same specificity, older route (created %s rather than %s)",
+			prev.CreationTimestamp.UTC().Format(time.RFC3339), cur.CreationTimestamp.UTC().Format(time.RFC3339))
+	}
+	return "same specificity and creation time, route ordered by namespace/name"
+}
+
+func matchTypeName(rank int) string {
+	switch rank {
+	case 3:
+		return "exact"
+	case 2:
+		return "prefix"
+	case 1:
+		return "regex"
+	}
+	return "no"
+}
@@ -0,0 +1,130 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	istio "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestTraceRoutes(t *testing.T) {
+	older := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newer := older.Add(time.Hour)
+	httpRoute := func(ns, name string, created time.Time, hostnames ...k8s.Hostname) config.Config {
+		return config.Config{
+			Meta: config.Meta{GroupVersionKind: gvk.HTTPRoute, Namespace: ns, Name: name, CreationTimestamp: created},
+			Spec: &k8s.HTTPRouteSpec{Hostnames: hostnames},
+		}
+	}
+	prefix := func(name, p string) *istio.HTTPRoute {
+		return &istio.HTTPRoute{Name: name, Match: []*istio.HTTPMatchRequest{{
+			Uri: &istio.StringMatch{MatchType: &istio.StringMatch_Prefix{Prefix: p}},
+		}}}
+	}
+	virtualService := func(h, parents string, routes ...*istio.HTTPRoute) config.Config {
+		return config.Config{
+			Meta: config.Meta{
+				GroupVersionKind: gvk.VirtualService, Namespace: "team-a", Name: h,
+				Annotations: map[string]string{constants.InternalParentNames: parents},
+			},
+			Spec: &istio.VirtualService{Hosts: []string{h}, Gateways: []string{"infra/gw-istio-autogenerated-k8s-gateway-http"}, Http: routes},
+		}
+	}
+	exact := &istio.HTTPRoute{Name: "team-b.api.0", Match: []*istio.HTTPMatchRequest{{
+		Uri: &istio.StringMatch{MatchType: &istio.StringMatch_Exact{Exact: "/api/v1"}},
+	}}}
+
+	state := IstioResources{
+		Gateway: []config.Config{{
+			Meta: config.Meta{
+				GroupVersionKind: gvk.Gateway, Namespace: "infra", Name: "gw-istio-autogenerated-k8s-gateway-http",
+				Annotations: map[string]string{constants.InternalParentNames: "KubernetesGateway/gw/http.infra"},
+			},
+		}},
+		VirtualService: []config.Config{
+			virtualService("api.example.com", "HTTPRoute/api.team-a,HTTPRoute/api.team-b",
+				exact, prefix("team-a.api.0", "/api"), prefix("team-b.api.1", "/api"), &istio.HTTPRoute{Name: "team-a.api.1"}),
+			virtualService("*.example.com", "HTTPRoute/all.team-c", prefix("team-c.all.0", "/")),
+			// The GRPCRoute has the same name as an HTTPRoute of another hostname
+			virtualService("grpc.example.com", "GRPCRoute/api.team-a", prefix("team-a.api.0", "/pkg.Service/")),
+		},
+		DeniedRouteParents: map[types.NamespacedName][]DeniedRouteParent{
+			{Namespace: "team-d", Name: "api"}:   {{Parent: "infra/gw/http", Reason: "NotAllowedByListeners", Message: "hostname mismatch"}},
+			{Namespace: "team-e", Name: "other"}: {{Parent: "infra/gw/http", Reason: "NotAllowedByListeners"}},
+		},
+	}
+	grpcRoute := config.Config{
+		Meta: config.Meta{GroupVersionKind: gvk.GRPCRoute, Namespace: "team-a", Name: "api", CreationTimestamp: newer},
+		Spec: &k8s.GRPCRouteSpec{},
+	}
+	routes := []config.Config{
+		grpcRoute,
+		httpRoute("team-a", "api", older),
+		httpRoute("team-b", "api", newer),
+		httpRoute("team-c", "all", older),
+		httpRoute("team-d", "api", older, "api.example.com"),
+		httpRoute("team-e", "other", older, "other.example.com"),
+	}
+
+	trace := traceRoutes(state, routes, "api.example.com", "/api/v2?x=y")
+	assert.Equal(t, len(trace.Listeners), 1)
+	l := trace.Listeners[0]
+	assert.Equal(t, l.Gateway, "infra/gw/http")
+	assert.Equal(t, l.Host, "api.example.com")
+	assert.Equal(t, l.ShadowedHosts, []string{"*.example.com"})
+	assert.Equal(t, l.Winner, "team-a.api.0")
+
+	assert.Equal(t, len(l.Routes), 4)
+	assert.Equal(t, l.Routes[0].Route, "HTTPRoute/team-b/api")
+	assert.Equal(t, l.Routes[0].MatchesPath, false)
+	assert.Equal(t, l.Routes[1].Precedence, "exact path match is more specific than prefix")
+	assert.Equal(t, l.Routes[1].MatchesPath, true)
+	assert.Equal(t, l.Routes[2].Precedence, "same specificity, older route (created 2024-01-01T00:00:00Z rather than 2024-01-01T01:00:00Z)")
+	assert.Equal(t, l.Routes[3].Precedence, "rules without matches are evaluated last")
+
+	assert.Equal(t, l.Routes[1].Route, "HTTPRoute/team-a/api")
+	assert.Equal(t, l.Routes[1].CreationTimestamp, older)
+
+	assert.Equal(t, trace.Filtered, []FilteredRoute{{
+		Route:             "team-d/api",
+		DeniedRouteParent: DeniedRouteParent{Parent: "infra/gw/http", Reason: "NotAllowedByListeners", Message: "hostname mismatch"},
+	}})
+
+	trace = traceRoutes(state, routes, "grpc.example.com", "/pkg.Service/Method")
+	assert.Equal(t, len(trace.Listeners), 1)
+	l = trace.Listeners[0]
+	assert.Equal(t, l.Host, "grpc.example.com")
+	assert.Equal(t, l.Routes[0].Route, "GRPCRoute/team-a/api")
+	assert.Equal(t, l.Routes[0].CreationTimestamp, newer)
+}
+
+func TestPathMatches(t *testing.T) {
+	prefix := &istio.StringMatch{MatchType: &istio.StringMatch_Prefix{Prefix: "/foo"}}
+	assert.Equal(t, pathMatches(prefix, "/foo"), true)
+	assert.Equal(t, pathMatches(prefix, "/foo/bar"), true)
+	assert.Equal(t, pathMatches(prefix, "/foobar"), false)
+	regex := &istio.StringMatch{MatchType: &istio.StringMatch_Regex{Regex: "/v[0-9]+"}}
+	assert.Equal(t, pathMatches(regex, "/v12"), true)
+	assert.Equal(t, pathMatches(regex, "/v12/x"), false)
+}
@@ -0,0 +1,141 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gateway "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	"istio.io/istio/pkg/config/constants"
+)
+
+// classDefaultsSchedulingKey is the key of the GatewayClass defaults overriding how the pods of the generated gateway
+// deployments are spread across the cluster.
+const classDefaultsSchedulingKey = "scheduling"
+
+// GatewayScheduling holds the scheduling constraints of the pods of a generated gateway deployment. By default, the
+// pods are spread across the zones and nodes of the cluster, so that the replicas of a gateway do not share a single
+// point of failure.
+type GatewayScheduling struct {
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint
+	Affinity                  *corev1.Affinity
+}
+
+// gatewaySchedulingSpec is the YAML format of the scheduling settings of a GatewayClass. Each field replaces the
+// default one if set, an empty value removing the default.
+type gatewaySchedulingSpec struct {
+	TopologySpreadConstraints *[]corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	Affinity                  *corev1.Affinity                   `json:"affinity,omitempty"`
+}
+
+// parseGatewayScheduling parses and validates the scheduling settings of a GatewayClass.
+func parseGatewayScheduling(data string) (*gatewaySchedulingSpec, error) {
+	spec := &gatewaySchedulingSpec{}
+	if err := yaml.UnmarshalStrict([]byte(data), spec); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", classDefaultsSchedulingKey, err)
+	}
+	if spec.TopologySpreadConstraints != nil {
+		for i, c := range *spec.TopologySpreadConstraints {
+			if c.MaxSkew <= 0 {
+				return nil, fmt.Errorf("invalid %s: maxSkew of topologySpreadConstraints[%d] must be greater than 0", classDefaultsSchedulingKey, i)
+			}
+			if c.TopologyKey == "" {
+				return nil, fmt.Errorf("invalid %s: topologyKey of topologySpreadConstraints[%d] must be set", classDefaultsSchedulingKey, i)
+			}
+			if c.WhenUnsatisfiable != corev1.DoNotSchedule && c.WhenUnsatisfiable != corev1.ScheduleAnyway {
+				return nil, fmt.Errorf("invalid %s: whenUnsatisfiable of topologySpreadConstraints[%d] must be %s or %s",
+					classDefaultsSchedulingKey, i, corev1.DoNotSchedule, corev1.ScheduleAnyway)
+			}
+		}
+	}
+	return spec, nil
+}
+
+// gatewayScheduling returns the scheduling constraints of the pods of the named gateway, the settings of its class
+// overriding the defaults. The spread constraints without a label selector select the pods of the gateway.
+func gatewayScheduling(name string, spec *gatewaySchedulingSpec) *GatewayScheduling {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{constants.GatewayNameLabel: name}}
+	scheduling := defaultGatewayScheduling(selector)
+	if spec == nil {
+		return scheduling
+	}
+	if spec.TopologySpreadConstraints != nil {
+		scheduling.TopologySpreadConstraints = nil
+		for _, c := range *spec.TopologySpreadConstraints {
+			if c.LabelSelector == nil {
+				c.LabelSelector = selector
+			}
+			scheduling.TopologySpreadConstraints = append(scheduling.TopologySpreadConstraints, c)
+		}
+	}
+	if spec.Affinity != nil {
+		scheduling.Affinity = spec.Affinity
+		if *spec.Affinity == (corev1.Affinity{}) {
+			scheduling.Affinity = nil
+		}
+	}
+	return scheduling
+}
+
+// defaultGatewayScheduling spreads the pods selected by selector across zones and nodes. The constraints are
+// preferences, so that a gateway still scales in a cluster with a single zone or too few nodes.
+func defaultGatewayScheduling(selector *metav1.LabelSelector) *GatewayScheduling {
+	return &GatewayScheduling{
+		TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+			{
+				MaxSkew:           1,
+				TopologyKey:       corev1.LabelTopologyZone,
+				WhenUnsatisfiable: corev1.ScheduleAnyway,
+				LabelSelector:     selector,
+			},
+			{
+				MaxSkew:           1,
+				TopologyKey:       corev1.LabelHostname,
+				WhenUnsatisfiable: corev1.ScheduleAnyway,
+				LabelSelector:     selector,
+			},
+		},
+		Affinity: &corev1.Affinity{
+			PodAntiAffinity: &corev1.PodAntiAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+					Weight: 100,
+					PodAffinityTerm: corev1.PodAffinityTerm{
+						LabelSelector: selector,
+						TopologyKey:   corev1.LabelHostname,
+					},
+				}},
+			},
+		},
+	}
+}
+
+// classScheduling returns the scheduling settings of the class of the gateway, if any. Invalid settings are ignored
+// here, and reported on the status of the class by the gateway controller.
+func (d *DeploymentController) classScheduling(gw gateway.Gateway) *gatewaySchedulingSpec {
+	class, data, f := d.classDefault(gw, classDefaultsSchedulingKey)
+	if !f {
+		return nil
+	}
+	spec, err := parseGatewayScheduling(data)
+	if err != nil {
+		log.Warnf("ignoring scheduling settings of gateway class %s: %v", class, err)
+		return nil
+	}
+	return spec
+}
@@ -0,0 +1,109 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestParseGatewayScheduling(t *testing.T) {
+	cases := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			data: "{}",
+		},
+		{
+			name: "override",
+			data: "{topologySpreadConstraints: [{maxSkew: 2, topologyKey: topology.kubernetes.io/zone, whenUnsatisfiable: DoNotSchedule}]}",
+		},
+		{
+			name: "disable",
+			data: "{topologySpreadConstraints: [], affinity: {}}",
+		},
+		{
+			name:    "missing topology key",
+			data:    "{topologySpreadConstraints: [{maxSkew: 1, whenUnsatisfiable: ScheduleAnyway}]}",
+			wantErr: true,
+		},
+		{
+			name:    "invalid max skew",
+			data:    "{topologySpreadConstraints: [{maxSkew: 0, topologyKey: kubernetes.io/hostname, whenUnsatisfiable: ScheduleAnyway}]}",
+			wantErr: true,
+		},
+		{
+			name:    "invalid when unsatisfiable",
+			data:    "{topologySpreadConstraints: [{maxSkew: 1, topologyKey: kubernetes.io/hostname, whenUnsatisfiable: Never}]}",
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			data:    "{tolerations: []}",
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseGatewayScheduling(tt.data)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGatewayScheduling(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"istio.io/gateway-name": "gw"}}
+	parse := func(data string) *gatewaySchedulingSpec {
+		spec, err := parseGatewayScheduling(data)
+		assert.NoError(t, err)
+		return spec
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		got := gatewayScheduling("gw", nil)
+		assert.Equal(t, got, defaultGatewayScheduling(selector))
+		assert.Equal(t, len(got.TopologySpreadConstraints), 2)
+		assert.Equal(t, gatewayScheduling("gw", parse("{}")), got)
+	})
+
+	t.Run("override", func(t *testing.T) {
+		got := gatewayScheduling("gw", parse(
+			"{topologySpreadConstraints: [{maxSkew: 2, topologyKey: topology.kubernetes.io/zone, whenUnsatisfiable: DoNotSchedule}]}"))
+		// The constraint selects the pods of the gateway, and the default affinity is kept
+		assert.Equal(t, got.TopologySpreadConstraints, []corev1.TopologySpreadConstraint{{
+			MaxSkew:           2,
+			TopologyKey:       corev1.LabelTopologyZone,
+			WhenUnsatisfiable: corev1.DoNotSchedule,
+			LabelSelector:     selector,
+		}})
+		assert.Equal(t, got.Affinity, defaultGatewayScheduling(selector).Affinity)
+	})
+
+	t.Run("disable", func(t *testing.T) {
+		got := gatewayScheduling("gw", parse("{topologySpreadConstraints: [], affinity: {}}"))
+		assert.Equal(t, got, &GatewayScheduling{})
+	})
+}
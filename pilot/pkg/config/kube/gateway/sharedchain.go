@@ -0,0 +1,130 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+)
+
+// chainPolicies are the connection policies of a plain text HTTP listener. The plain text HTTP listeners of a port
+// share a single filter chain, as they cannot be told apart before the HTTP connection manager, so they must all have
+// the same policies.
+type chainPolicies struct {
+	ipAccess         string
+	forwardedHeaders string
+}
+
+// conflict returns the name of the first policy differing from the other listener, or an empty string if none does.
+func (p chainPolicies) conflict(other chainPolicies) string {
+	if p.ipAccess != other.ipAccess {
+		return "IP access policy"
+	}
+	if p.forwardedHeaders != other.forwardedHeaders {
+		return "forwarded headers policy"
+	}
+	return ""
+}
+
+// sharedChainListener is the plain text HTTP listener owning the filter chain of a port of a gateway Service.
+type sharedChainListener struct {
+	gateway  types.NamespacedName
+	listener k8s.SectionName
+	policies chainPolicies
+}
+
+// sharedChainConflicts returns the plain text HTTP listeners whose policies differ from the ones of the first listener
+// sharing their filter chain, that is served on the same port of the same gateway Service. The oldest Gateway owns the
+// chain, and the conflicting listeners are not programmed rather than applying their policies to the other listeners.
+// The result is indexed by Gateway and listener index.
+func sharedChainConflicts(
+	r configContext,
+	classes map[string]k8s.GatewayController,
+	ipAccess map[types.NamespacedName]*gatewayIPAccessPolicies,
+) map[types.NamespacedName]map[int]*ConfigError {
+	gateways := make([]config.Config, 0, len(r.Gateway))
+	for _, obj := range r.Gateway {
+		controllerName, f := classes[string(obj.Spec.(*k8s.GatewaySpec).GatewayClassName)]
+		// Waypoints do not serve plain text HTTP listeners
+		if f && controllerName != constants.ManagedGatewayMeshController {
+			gateways = append(gateways, obj)
+		}
+	}
+	sortConfigByCreationTime(gateways)
+
+	res := map[types.NamespacedName]map[int]*ConfigError{}
+	owners := map[string]sharedChainListener{}
+	for _, obj := range gateways {
+		kgw := obj.Spec.(*k8s.GatewaySpec)
+		services, _ := extractGatewayServices(r.GatewayResources, kgw, obj)
+		nn := config.NamespacedName(obj)
+		// An invalid policy is ignored, like when programming the Gateway
+		forwardedHeaders, _ := gatewayForwardedHeadersPolicy(obj)
+		forwardedHeadersKey := ""
+		if forwardedHeaders != nil {
+			js, _ := json.Marshal(forwardedHeaders)
+			forwardedHeadersKey = string(js)
+		}
+		for i, l := range kgw.Listeners {
+			if l.Protocol != k8sv1.HTTPProtocolType {
+				continue
+			}
+			cur := sharedChainListener{
+				gateway:  nn,
+				listener: l.Name,
+				policies: chainPolicies{
+					ipAccess:         ipAccess[nn].forListener(l.Name).key(),
+					forwardedHeaders: forwardedHeadersKey,
+				},
+			}
+			for _, svc := range services {
+				chain := fmt.Sprintf("%s:%d", svc, l.Port)
+				owner, f := owners[chain]
+				if !f {
+					owners[chain] = cur
+					continue
+				}
+				if policy := cur.policies.conflict(owner.policies); policy != "" {
+					if res[nn] == nil {
+						res[nn] = map[int]*ConfigError{}
+					}
+					res[nn][i] = &ConfigError{
+						Reason: ConflictingListenerPolicy,
+						Message: fmt.Sprintf("port %d of service %s is shared with listener %q of Gateway %s, which has a different %s",
+							l.Port, svc, owner.listener, owner.gateway, policy),
+					}
+					break
+				}
+			}
+		}
+	}
+	return res
+}
+
+// key returns the encoding of the policy, to compare it with the policy of another listener.
+func (p *ipAccessPolicy) key() string {
+	if p == nil || p.policy.IsEmpty() {
+		return ""
+	}
+	js, _ := json.Marshal(p.policy)
+	return string(js)
+}
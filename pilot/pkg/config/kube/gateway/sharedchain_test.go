@@ -0,0 +1,101 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+	k8s "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestSharedChainConflicts(t *testing.T) {
+	gateway := func(name string, age time.Duration, address string, listeners ...k8s.Listener) config.Config {
+		spec := &k8s.GatewaySpec{GatewayClassName: "istio", Listeners: listeners}
+		if address != "" {
+			spec.Addresses = []k8s.GatewayAddress{{Type: ptr.Of(k8s.HostnameAddressType), Value: address}}
+		}
+		return config.Config{
+			Meta: config.Meta{
+				GroupVersionKind:  gvk.KubernetesGateway,
+				Name:              name,
+				Namespace:         "default",
+				CreationTimestamp: time.Unix(0, 0).Add(-age),
+			},
+			Spec: spec,
+		}
+	}
+	listener := func(name string, protocol k8s.ProtocolType, port k8s.PortNumber) k8s.Listener {
+		return k8s.Listener{Name: k8s.SectionName(name), Protocol: protocol, Port: port}
+	}
+	policy := func(allow ...string) *ipAccessPolicy {
+		return &ipAccessPolicy{policy: &model.IPAccessPolicy{Allow: allow}}
+	}
+
+	ctx := configContext{GatewayResources: GatewayResources{
+		Domain: "cluster.local",
+		Gateway: []config.Config{
+			// Shares the Service of the older Gateway, so its plain text HTTP listener shares the chain of port 80
+			gateway("newer", time.Minute, "ingress",
+				listener("http", k8sv1.HTTPProtocolType, 80),
+				listener("https", k8sv1.HTTPSProtocolType, 443),
+				listener("other-port", k8sv1.HTTPProtocolType, 8080)),
+			gateway("older", time.Hour, "ingress",
+				listener("http", k8sv1.HTTPProtocolType, 80),
+				listener("https", k8sv1.HTTPSProtocolType, 443)),
+			gateway("same-policy", time.Minute, "ingress", listener("http", k8sv1.HTTPProtocolType, 80)),
+			// A managed Gateway has its own Service
+			gateway("managed", time.Minute, "", listener("http", k8sv1.HTTPProtocolType, 80)),
+			// Listeners of the same Gateway share the chain too
+			gateway("listeners", time.Minute, "listeners",
+				listener("a", k8sv1.HTTPProtocolType, 80),
+				listener("b", k8sv1.HTTPProtocolType, 80)),
+			gateway("unknown-class", time.Hour, "ingress", listener("http", k8sv1.HTTPProtocolType, 80)),
+			gateway("trusted-hops", time.Hour, "hops", listener("http", k8sv1.HTTPProtocolType, 80)),
+			gateway("default-hops", time.Minute, "hops", listener("http", k8sv1.HTTPProtocolType, 80)),
+		},
+	}}
+	ctx.Gateway[5].Spec.(*k8s.GatewaySpec).GatewayClassName = "other"
+	ctx.Gateway[6].Annotations = map[string]string{numTrustedProxiesAnnotation: "2"}
+	ipAccess := map[types.NamespacedName]*gatewayIPAccessPolicies{
+		{Namespace: "default", Name: "older"}:       {gateway: policy("10.0.0.0/8")},
+		{Namespace: "default", Name: "newer"}:       {gateway: policy("192.168.0.0/16")},
+		{Namespace: "default", Name: "same-policy"}: {gateway: policy("10.0.0.0/8")},
+		{Namespace: "default", Name: "managed"}:     {gateway: policy("192.168.0.0/16")},
+		{Namespace: "default", Name: "listeners"}:   {listeners: map[k8s.SectionName]*ipAccessPolicy{"b": policy("10.0.0.0/8")}},
+	}
+
+	conflicts := sharedChainConflicts(ctx, map[string]k8s.GatewayController{"istio": "istio.io/gateway-controller"}, ipAccess)
+	assert.Equal(t, len(conflicts), 3)
+	newer := conflicts[types.NamespacedName{Namespace: "default", Name: "newer"}]
+	assert.Equal(t, len(newer), 1)
+	assert.Equal(t, newer[0].Reason, ConflictingListenerPolicy)
+	assert.Equal(t, newer[0].Message,
+		`port 80 of service ingress.default.svc.cluster.local is shared with listener "http" of Gateway default/older, which has a different IP access policy`)
+	listeners := conflicts[types.NamespacedName{Namespace: "default", Name: "listeners"}]
+	assert.Equal(t, len(listeners), 1)
+	assert.Equal(t, listeners[1].Reason, ConflictingListenerPolicy)
+	hops := conflicts[types.NamespacedName{Namespace: "default", Name: "default-hops"}]
+	assert.Equal(t, hops[0].Message, `port 80 of service hops.default.svc.cluster.local is shared with listener "http" `+
+		`of Gateway default/trusted-hops, which has a different forwarded headers policy`)
+}
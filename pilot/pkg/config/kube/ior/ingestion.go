@@ -0,0 +1,300 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ior
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	v1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	klabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	gateway "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/maps"
+	"istio.io/istio/pkg/ptr"
+)
+
+const (
+	ingestedValue = "route-ingestion"
+	// defaultRouteWeight is the weight of a Route backend which does not set one
+	defaultRouteWeight = 100
+)
+
+// ingestionController synthesizes a Gateway API HTTPRoute for each OpenShift Route, attached to a designated Gateway.
+// This lets users of Routes move their traffic to a Gateway managed by Istio without rewriting their manifests: the
+// HTTPRoutes are owned by the Routes they are generated from, and are updated and removed along with them.
+// Routes generated by IOR are ignored, as they already expose Istio Gateways.
+type ingestionController struct {
+	gateway types.NamespacedName
+
+	queue      controllers.Queue
+	routes     kclient.Client[*v1.Route]
+	services   kclient.Client[*corev1.Service]
+	httpRoutes kclient.Client[*gateway.HTTPRoute]
+}
+
+// RunIngestion runs the Route ingestion controller, attaching the HTTPRoutes it generates to the gateway.
+func RunIngestion(kubeClient KubeClient, gw types.NamespacedName, stop <-chan struct{}) {
+	iorLog.Infof("setting up Route ingestion into gateway %s", gw)
+	for !kubeClient.IsRouteSupported() {
+		iorLog.Infof("routes are not supported in this cluster; waiting for Route resource to become available...")
+		select {
+		case <-stop:
+			return
+		case <-time.After(10 * time.Second):
+		}
+	}
+	c := newIngestionController(kubeClient, gw)
+	kubeClient.GetActualClient().RunAndWait(stop)
+	c.queue.Run(stop)
+	controllers.ShutdownAll(c.routes, c.services, c.httpRoutes)
+}
+
+func newIngestionController(kubeClient KubeClient, gw types.NamespacedName) *ingestionController {
+	client := kubeClient.GetActualClient()
+	c := &ingestionController{gateway: gw}
+	c.queue = controllers.NewQueue("route ingestion",
+		controllers.WithReconciler(c.Reconcile),
+		controllers.WithMaxAttempts(5))
+
+	c.routes = kclient.New[*v1.Route](client)
+	c.routes.AddEventHandler(controllers.ObjectHandler(c.queue.AddObject))
+
+	c.httpRoutes = kclient.New[*gateway.HTTPRoute](client)
+	c.httpRoutes.AddEventHandler(controllers.FilteredObjectHandler(
+		controllers.EnqueueForParentHandler(c.queue, gvk.Route),
+		isIngested,
+	))
+
+	// Route backends refer to the target port of the Service, which is resolved to a Service port
+	c.services = kclient.New[*corev1.Service](client)
+	c.services.AddEventHandler(controllers.ObjectHandler(func(o controllers.Object) {
+		for _, route := range c.routes.List(o.GetNamespace(), klabels.Everything()) {
+			if routeReferencesService(route, o.GetName()) {
+				c.queue.AddObject(route)
+			}
+		}
+	}))
+	return c
+}
+
+// Reconcile generates the HTTPRoute of the Route, or removes it if the Route cannot be ingested.
+func (c *ingestionController) Reconcile(key types.NamespacedName) error {
+	route := c.routes.Get(key.Name, key.Namespace)
+	existing := c.httpRoutes.Get(key.Name, key.Namespace)
+	if existing != nil && !isIngested(existing) {
+		iorLog.Warnf("HTTPRoute %s was not generated from a Route; leaving it untouched", key)
+		return nil
+	}
+
+	var desired *gateway.HTTPRoute
+	if route != nil {
+		var err error
+		desired, err = c.buildHTTPRoute(route)
+		if err != nil {
+			iorLog.Infof("not ingesting route %s: %v", key, err)
+		}
+	}
+
+	switch {
+	case desired == nil && existing == nil:
+		return nil
+	case desired == nil:
+		iorLog.Infof("removing HTTPRoute %s generated from a Route", key)
+		return controllers.IgnoreNotFound(c.httpRoutes.Delete(key.Name, key.Namespace))
+	case existing == nil:
+		iorLog.Infof("creating HTTPRoute %s from a Route", key)
+		_, err := c.httpRoutes.Create(desired)
+		if kerrors.IsAlreadyExists(err) {
+			// Our informer is stale; we will be requeued by the event of the existing HTTPRoute
+			return nil
+		}
+		return err
+	case maps.Equal(existing.Labels, desired.Labels) && reflect.DeepEqual(existing.Spec, desired.Spec):
+		return nil
+	default:
+		iorLog.Debugf("updating HTTPRoute %s generated from a Route", key)
+		desired.ResourceVersion = existing.ResourceVersion
+		_, err := c.httpRoutes.Update(desired)
+		return err
+	}
+}
+
+// buildHTTPRoute converts the Route. An error is returned if the Route cannot be expressed as an HTTPRoute.
+func (c *ingestionController) buildHTTPRoute(route *v1.Route) (*gateway.HTTPRoute, error) {
+	if route.Labels[generatedByLabel] == generatedByValue {
+		return nil, fmt.Errorf("it was generated by IOR")
+	}
+	if route.Spec.TLS != nil && route.Spec.TLS.Termination == v1.TLSTerminationPassthrough {
+		return nil, fmt.Errorf("passthrough termination cannot be expressed as an HTTPRoute")
+	}
+
+	var hostnames []gateway.Hostname
+	if host := routeHostname(route); host != "" {
+		hostnames = []gateway.Hostname{gateway.Hostname(host)}
+	}
+
+	path := route.Spec.Path
+	if path == "" {
+		path = "/"
+	}
+
+	backends := make([]gateway.HTTPBackendRef, 0, 1+len(route.Spec.AlternateBackends))
+	for _, target := range append([]v1.RouteTargetReference{route.Spec.To}, route.Spec.AlternateBackends...) {
+		backend, err := c.buildBackendRef(route, target)
+		if err != nil {
+			return nil, err
+		}
+		backends = append(backends, backend)
+	}
+
+	labels := map[string]string{
+		generatedByLabel: ingestedValue,
+	}
+	for k, v := range route.Labels {
+		if !filteredRouteLabel(k) {
+			labels[k] = v
+		}
+	}
+
+	return &gateway.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      route.Name,
+			Namespace: route.Namespace,
+			Labels:    labels,
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: gvk.Route.GroupVersion(),
+				Kind:       gvk.Route.Kind,
+				Name:       route.Name,
+				UID:        route.UID,
+				Controller: ptr.Of(true),
+			}},
+		},
+		Spec: gateway.HTTPRouteSpec{
+			CommonRouteSpec: gateway.CommonRouteSpec{
+				ParentRefs: []gateway.ParentReference{{
+					// Set the defaults of the API, so that the generated spec matches the stored one
+					Group:     ptr.Of(gateway.Group(gvk.KubernetesGateway.Group)),
+					Kind:      ptr.Of(gateway.Kind(gvk.KubernetesGateway.Kind)),
+					Namespace: ptr.Of(gateway.Namespace(c.gateway.Namespace)),
+					Name:      gateway.ObjectName(c.gateway.Name),
+				}},
+			},
+			Hostnames: hostnames,
+			Rules: []gateway.HTTPRouteRule{{
+				Matches: []gateway.HTTPRouteMatch{{
+					Path: &gateway.HTTPPathMatch{
+						Type:  ptr.Of(gateway.PathMatchPathPrefix),
+						Value: ptr.Of(path),
+					},
+				}},
+				BackendRefs: backends,
+			}},
+		},
+	}, nil
+}
+
+// buildBackendRef converts a backend of the Route, resolving the target port of the Route to a port of the Service.
+func (c *ingestionController) buildBackendRef(route *v1.Route, target v1.RouteTargetReference) (gateway.HTTPBackendRef, error) {
+	if target.Kind != "" && target.Kind != gvk.Service.Kind {
+		return gateway.HTTPBackendRef{}, fmt.Errorf("backend %s/%s is not a Service", target.Kind, target.Name)
+	}
+	svc := c.services.Get(target.Name, route.Namespace)
+	if svc == nil {
+		return gateway.HTTPBackendRef{}, fmt.Errorf("service %s/%s not found", route.Namespace, target.Name)
+	}
+	var targetPort *intstr.IntOrString
+	if route.Spec.Port != nil {
+		targetPort = &route.Spec.Port.TargetPort
+	}
+	port, err := servicePort(svc, targetPort)
+	if err != nil {
+		return gateway.HTTPBackendRef{}, err
+	}
+	weight := int32(defaultRouteWeight)
+	if target.Weight != nil {
+		weight = *target.Weight
+	}
+	return gateway.HTTPBackendRef{
+		BackendRef: gateway.BackendRef{
+			BackendObjectReference: gateway.BackendObjectReference{
+				Group: ptr.Of(gateway.Group("")),
+				Kind:  ptr.Of(gateway.Kind(gvk.Service.Kind)),
+				Name:  gateway.ObjectName(target.Name),
+				Port:  ptr.Of(gateway.PortNumber(port)),
+			},
+			Weight: ptr.Of(weight),
+		},
+	}, nil
+}
+
+// servicePort returns the port of the Service a Route target port refers to. Like the OpenShift router, a named
+// target port matches the name of a Service port and a numeric one matches its target port. Without a target port,
+// the first port of the Service is used.
+func servicePort(svc *corev1.Service, targetPort *intstr.IntOrString) (int32, error) {
+	if len(svc.Spec.Ports) == 0 {
+		return 0, fmt.Errorf("service %s/%s has no ports", svc.Namespace, svc.Name)
+	}
+	if targetPort == nil || (targetPort.Type == intstr.String && targetPort.StrVal == "") {
+		return svc.Spec.Ports[0].Port, nil
+	}
+	for _, p := range svc.Spec.Ports {
+		if targetPort.Type == intstr.String && p.Name == targetPort.StrVal {
+			return p.Port, nil
+		}
+		if targetPort.Type == intstr.Int && p.TargetPort.IntValue() == targetPort.IntValue() {
+			return p.Port, nil
+		}
+	}
+	return 0, fmt.Errorf("service %s/%s has no port matching target port %s", svc.Namespace, svc.Name, targetPort.String())
+}
+
+// routeHostname returns the hostname matched by the Route. Routes with a Subdomain wildcard policy match every host
+// of the domain of their host.
+func routeHostname(route *v1.Route) string {
+	host := route.Spec.Host
+	if route.Spec.WildcardPolicy == v1.WildcardPolicySubdomain {
+		if _, domain, f := strings.Cut(host, "."); f {
+			return "*." + domain
+		}
+	}
+	return host
+}
+
+func routeReferencesService(route *v1.Route, name string) bool {
+	if route.Spec.To.Name == name {
+		return true
+	}
+	for _, b := range route.Spec.AlternateBackends {
+		if b.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isIngested(o controllers.Object) bool {
+	return o.GetLabels()[generatedByLabel] == ingestedValue
+}
@@ -0,0 +1,182 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ior
+
+import (
+	"testing"
+
+	v1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	gateway "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/kclient/clienttest"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestRouteIngestion(t *testing.T) {
+	stop := test.NewStop(t)
+	client := kube.NewFakeClient()
+	c := newIngestionController(NewFakeKubeClient(client), types.NamespacedName{Namespace: "istio-ingress", Name: "gateway"})
+	client.RunAndWait(stop)
+	go c.queue.Run(stop)
+
+	services := clienttest.NewWriter[*corev1.Service](t, client)
+	routes := clienttest.NewWriter[*v1.Route](t, client)
+	httpRoutes := clienttest.Wrap(t, c.httpRoutes)
+
+	services.Create(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "reviews"},
+			Ports: []corev1.ServicePort{
+				{Name: "grpc", Port: 9090, TargetPort: intstr.FromInt(9091)},
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080)},
+			},
+		},
+	})
+	route := &v1.Route{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo", Labels: map[string]string{"app": "reviews"}},
+		Spec: v1.RouteSpec{
+			Host:              "reviews.apps.example.com",
+			Path:              "/api",
+			Port:              &v1.RoutePort{TargetPort: intstr.FromInt(8080)},
+			To:                v1.RouteTargetReference{Kind: "Service", Name: "reviews", Weight: ptr.Of(int32(90))},
+			AlternateBackends: []v1.RouteTargetReference{{Kind: "Service", Name: "reviews-canary", Weight: ptr.Of(int32(10))}},
+		},
+	}
+	routes.Create(route)
+
+	spec := func() *gateway.HTTPRouteSpec {
+		hr := httpRoutes.Get("reviews", "bookinfo")
+		if hr == nil {
+			return nil
+		}
+		return &hr.Spec
+	}
+
+	// The Route is not ingested until all of its backends can be resolved
+	assert.Equal(t, spec(), nil)
+	services.Create(&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "reviews-canary", Namespace: "bookinfo"},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "reviews", "version": "canary"},
+			Ports:    []corev1.ServicePort{{Name: "http", Port: 8000, TargetPort: intstr.FromInt(8080)}},
+		},
+	})
+	assert.EventuallyEqual(t, spec, &gateway.HTTPRouteSpec{
+		CommonRouteSpec: gateway.CommonRouteSpec{
+			ParentRefs: []gateway.ParentReference{{
+				Group:     ptr.Of(gateway.Group("gateway.networking.k8s.io")),
+				Kind:      ptr.Of(gateway.Kind("Gateway")),
+				Namespace: ptr.Of(gateway.Namespace("istio-ingress")),
+				Name:      "gateway",
+			}},
+		},
+		Hostnames: []gateway.Hostname{"reviews.apps.example.com"},
+		Rules: []gateway.HTTPRouteRule{{
+			Matches: []gateway.HTTPRouteMatch{{
+				Path: &gateway.HTTPPathMatch{Type: ptr.Of(gateway.PathMatchPathPrefix), Value: ptr.Of("/api")},
+			}},
+			BackendRefs: []gateway.HTTPBackendRef{
+				{BackendRef: gateway.BackendRef{
+					BackendObjectReference: gateway.BackendObjectReference{
+						Group: ptr.Of(gateway.Group("")),
+						Kind:  ptr.Of(gateway.Kind("Service")),
+						Name:  "reviews",
+						Port:  ptr.Of(gateway.PortNumber(80)),
+					},
+					Weight: ptr.Of(int32(90)),
+				}},
+				{BackendRef: gateway.BackendRef{
+					BackendObjectReference: gateway.BackendObjectReference{
+						Group: ptr.Of(gateway.Group("")),
+						Kind:  ptr.Of(gateway.Kind("Service")),
+						Name:  "reviews-canary",
+						Port:  ptr.Of(gateway.PortNumber(8000)),
+					},
+					Weight: ptr.Of(int32(10)),
+				}},
+			},
+		}},
+	})
+	assert.Equal(t, httpRoutes.Get("reviews", "bookinfo").Labels, map[string]string{generatedByLabel: ingestedValue, "app": "reviews"})
+
+	// Updates of the Route are reflected
+	route.Spec.Host = "wildcard.apps.example.com"
+	route.Spec.WildcardPolicy = v1.WildcardPolicySubdomain
+	routes.Update(route)
+	assert.EventuallyEqual(t, func() []gateway.Hostname {
+		return spec().Hostnames
+	}, []gateway.Hostname{"*.apps.example.com"})
+
+	// Passthrough Routes cannot be ingested
+	route.Spec.TLS = &v1.TLSConfig{Termination: v1.TLSTerminationPassthrough}
+	routes.Update(route)
+	assert.EventuallyEqual(t, spec, nil)
+
+	// Routes generated by IOR are not ingested
+	route.Spec.TLS = nil
+	route.Labels[generatedByLabel] = generatedByValue
+	routes.Update(route)
+	assert.Equal(t, spec(), nil)
+
+	// HTTPRoutes are removed along with their Route
+	delete(route.Labels, generatedByLabel)
+	routes.Update(route)
+	assert.EventuallyEqual(t, func() bool { return spec() != nil }, true)
+	routes.Delete("reviews", "bookinfo")
+	assert.EventuallyEqual(t, spec, nil)
+
+	// HTTPRoutes which were not generated are left untouched
+	httpRoutes.Create(&gateway.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Name: "reviews", Namespace: "bookinfo"}})
+	assert.NoError(t, c.Reconcile(types.NamespacedName{Namespace: "bookinfo", Name: "reviews"}))
+	assert.Equal(t, spec(), &gateway.HTTPRouteSpec{})
+}
+
+func TestServicePort(t *testing.T) {
+	svc := &corev1.Service{
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: 80, TargetPort: intstr.FromInt(8080)},
+				{Name: "https", Port: 443, TargetPort: intstr.FromString("https")},
+			},
+		},
+	}
+	cases := []struct {
+		name       string
+		targetPort *intstr.IntOrString
+		expected   int32
+		err        bool
+	}{
+		{"no target port", nil, 80, false},
+		{"port name", ptr.Of(intstr.FromString("https")), 443, false},
+		{"target port", ptr.Of(intstr.FromInt(8080)), 80, false},
+		{"unknown name", ptr.Of(intstr.FromString("grpc")), 0, true},
+		{"unknown target port", ptr.Of(intstr.FromInt(80)), 0, true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			port, err := servicePort(svc, tt.targetPort)
+			assert.Equal(t, err != nil, tt.err)
+			assert.Equal(t, port, tt.expected)
+		})
+	}
+}
@@ -74,6 +74,11 @@ var (
 	FilterGatewayClusterConfig = env.Register("PILOT_FILTER_GATEWAY_CLUSTER_CONFIG", false,
 		"If enabled, Pilot will send only clusters that referenced in gateway virtual services attached to gateway").Get()
 
+	// ScopeGatewaySecretPushes controls if Secret changes are only pushed to the gateways referencing the Secret
+	ScopeGatewaySecretPushes = env.Register("PILOT_SCOPE_GATEWAY_SECRET_PUSHES", false,
+		"If enabled, a change to a Secret is only pushed to the gateways with a server or destination referencing it, "+
+			"rather than to all gateways").Get()
+
 	DebounceAfter = env.Register(
 		"PILOT_DEBOUNCE_AFTER",
 		100*time.Millisecond,
@@ -96,6 +101,14 @@ var (
 			" EDS pushes may be delayed, but there will be fewer pushes. By default this is enabled",
 	).Get()
 
+	EnableEagerGatewayEDSPush = env.Register(
+		"PILOT_ENABLE_EAGER_GATEWAY_EDS_PUSH",
+		false,
+		"If enabled, Pilot will push EDS to the gateways deployed for Gateway API Gateways as soon as endpoints of a service"+
+			" are removed or stop being healthy, bypassing the EDS push debouncing, so that they do not route to terminated"+
+			" endpoints in the meantime. The other proxies, including the other gateways, still receive the debounced push.",
+	).Get()
+
 	SendUnhealthyEndpoints = atomic.NewBool(env.Register(
 		"PILOT_SEND_UNHEALTHY_ENDPOINTS",
 		false,
@@ -354,6 +367,69 @@ var (
 	GatewayAPIControllerName = env.Register("PILOT_GATEWAY_API_CONTROLLER_NAME", "istio.io/gateway-controller",
 		"Gateway API controller name. istiod will only reconcile Gateway API resources referencing a GatewayClass with this controller name").Get()
 
+	GatewayAPIRuntimeConfigMap = env.Register("PILOT_GATEWAY_API_RUNTIME_CONFIG_MAP", "",
+		"If set, the name of a ConfigMap in the istiod namespace overriding PILOT_GATEWAY_API_DEFAULT_GATEWAYCLASS and "+
+			"PILOT_GATEWAY_API_CONTROLLER_NAME with its defaultGatewayClass and controllerName keys. Changes to the ConfigMap "+
+			"are applied without restarting istiod: the GatewayClasses are re-evaluated and the route statuses written under "+
+			"the former controller name are replaced.").Get()
+
+	GatewayAPIPreviousControllerNames = func() sets.String {
+		v := env.Register("PILOT_GATEWAY_API_PREVIOUS_CONTROLLER_NAMES", "",
+			"Comma separated list of controller names previously used by this istiod (see PILOT_GATEWAY_API_CONTROLLER_NAME). "+
+				"Route statuses written under these names are considered stale, and are replaced by the status of the current "+
+				"controller name or removed.").Get()
+		return splitList(v)
+	}()
+
+	EnableGatewayAPINackStatus = env.Register("PILOT_ENABLE_GATEWAY_API_NACK_STATUS", false,
+		"If this is set to true, configuration rejected by a Gateway API gateway proxy will be reported on the "+
+			"status of the Gateway that produced it").Get()
+
+	EnableGatewayAPIProgrammedOnAck = env.Register("PILOT_GATEWAY_API_PROGRAMMED_ON_ACK", false,
+		"If this is set to true, an automatically deployed Gateway API Gateway is only reported as Programmed once "+
+			"one of its gateway proxies accepted the listeners generated for its latest generation").Get()
+
+	MaxManagedGatewaysPerNamespace = env.Register("PILOT_MAX_MANAGED_GATEWAYS_PER_NAMESPACE", 0,
+		"Maximum number of managed Gateway API gateways per namespace, 0 for no limit. May be overridden by the "+
+			"gateway.istio.io/max-managed-gateways annotation of the namespace").Get()
+
+	MaxGatewayLoadBalancersPerNamespace = env.Register("PILOT_MAX_GATEWAY_LOAD_BALANCERS_PER_NAMESPACE", 0,
+		"Maximum number of managed Gateway API gateways with a LoadBalancer Service per namespace, 0 for no limit. "+
+			"May be overridden by the gateway.istio.io/max-load-balancers annotation of the namespace").Get()
+
+	MaxManagedGatewaysPerTenant = env.Register("PILOT_MAX_MANAGED_GATEWAYS_PER_TENANT", 0,
+		"Maximum number of managed Gateway API gateways across all namespaces of the mesh (the member namespaces, "+
+			"for multi-tenant istiod), 0 for no limit").Get()
+
+	MaxGatewayLoadBalancersPerTenant = env.Register("PILOT_MAX_GATEWAY_LOAD_BALANCERS_PER_TENANT", 0,
+		"Maximum number of managed Gateway API gateways with a LoadBalancer Service across all namespaces of the mesh "+
+			"(the member namespaces, for multi-tenant istiod), 0 for no limit").Get()
+
+	MaxHTTPRoutesPerGateway = env.Register("PILOT_MAX_HTTPROUTES_PER_GATEWAY", 0,
+		"Maximum number of HTTPRoutes and GRPCRoutes attached to a Gateway API gateway, 0 for no limit. The newest routes are not "+
+			"accepted beyond the limit. May be overridden by the gateway.istio.io/max-routes-per-gateway annotation of the "+
+			"namespace of the gateway").Get()
+
+	MaxVirtualHostsPerGateway = env.Register("PILOT_MAX_VIRTUAL_HOSTS_PER_GATEWAY", 0,
+		"Maximum number of distinct hostnames of the HTTPRoutes and GRPCRoutes attached to a Gateway API gateway, 0 for "+
+			"no limit. May be overridden by the gateway.istio.io/max-virtual-hosts-per-gateway annotation of the "+
+			"namespace of the gateway").Get()
+
+	MaxMatchesPerHTTPRouteRule = env.Register("PILOT_MAX_MATCHES_PER_HTTPROUTE_RULE", 0,
+		"Maximum number of matches of a rule of an HTTPRoute or a GRPCRoute, 0 for no limit. A route exceeding it is "+
+			"not accepted. May be overridden by the gateway.istio.io/max-matches-per-rule annotation of the namespace of "+
+			"the route").Get()
+
+	LazyWatchKinds = func() sets.String {
+		v := env.Register("PILOT_LAZY_WATCH_KINDS", "",
+			"Comma separated list of config kinds (for example `WasmPlugin,Telemetry`) that are only watched in a member "+
+				"namespace once an object of that kind is found there. Only applies to multi-tenant istiod.").Get()
+		return splitList(v)
+	}()
+
+	LazyWatchProbeInterval = env.Register("PILOT_LAZY_WATCH_PROBE_INTERVAL", 30*time.Second,
+		"Interval at which the cluster is checked for the first use of a kind listed in PILOT_LAZY_WATCH_KINDS in a member namespace").Get()
+
 	ClusterName = env.Register("CLUSTER_ID", "Kubernetes",
 		"Defines the cluster and service registry that this Istiod instance belongs to").Get()
 
@@ -466,6 +542,10 @@ var (
 	XDSCacheMaxSize = env.Register("PILOT_XDS_CACHE_SIZE", 60000,
 		"The maximum number of cache entries for the XDS cache.").Get()
 
+	XDSCacheMaxBytes = env.Register("PILOT_XDS_CACHE_MAX_BYTES", 0,
+		"The maximum approximate size, in bytes, of the resources held by each type of XDS cache (CDS, EDS, RDS, SDS). "+
+			"Once exceeded, the least recently used entries are evicted. If 0, only PILOT_XDS_CACHE_SIZE bounds the caches.").Get()
+
 	XDSCacheIndexClearInterval = env.Register("PILOT_XDS_CACHE_INDEX_CLEAR_INTERVAL", 5*time.Second,
 		"The interval for xds cache index clearing.").Get()
 
@@ -677,6 +757,10 @@ var (
 	MetricGracefulDeletionInterval = env.Register("METRIC_GRACEFUL_DELETION_INTERVAL", 5*time.Minute,
 		"Metric expiry graceful deletion interval. No-op if METRIC_ROTATION_INTERVAL is disabled.").Get()
 
+	GatewayAuthzDryRunMetrics = env.Register("PILOT_GATEWAY_AUTHZ_DRY_RUN_METRICS", false,
+		"If enabled, gateways report the istio_authz_dry_run_would_deny_total metric, counting the requests that "+
+			"AuthorizationPolicies in dry-run mode would have denied, per route and source principal.").Get()
+
 	NativeMetadataExchange = env.Register("NATIVE_METADATA_EXCHANGE", true,
 		"If set, uses a native implementation of the HTTP metadata exchange filter").Get()
 
@@ -723,6 +807,10 @@ var (
 	EnableIOR = env.RegisterBoolVar("ENABLE_IOR", false,
 		"Whether to enable IOR component, which provides integration between Istio Gateways and OpenShift Routes").Get()
 
+	RouteIngestionGateway = env.RegisterStringVar("PILOT_ROUTE_INGESTION_GATEWAY", "",
+		"If set to the namespace/name of a Gateway API Gateway, istiod generates an HTTPRoute attached to this Gateway "+
+			"for each OpenShift Route, so that the traffic of the Routes can be moved to the Gateway").Get()
+
 	EnableGatewayControllerMode = env.Register("PILOT_ENABLE_GATEWAY_CONTROLLER_MODE", false,
 		"If enabled, istiod will watch Gateway API and k8s resources in every namespace, but Istio resources will be limited to "+
 			"namespaces that match the meshConfig.discoverySelectors").Get()
@@ -732,8 +820,44 @@ var (
 
 	DefaultLabelsForInjectedGateways = env.RegisterStringVar("PILOT_GATEWAY_API_DEPLOYMENT_DEFAULT_LABELS", "",
 		"Default labels to set on Deployments created by the Gateway API Deployment Controller").Get()
+
+	ConfigAuditBufferSize = env.Register("PILOT_CONFIG_AUDIT_BUFFER_SIZE", 0,
+		"The number of config changes accepted or rejected by the validation webhook kept per namespace, served "+
+			"by the /debug/config_auditz endpoint. 0 disables the config audit. Each istiod replica only serves the "+
+			"changes it validated itself. The deletions are sent to the webhook when this is set in the "+
+			"pilot.env values of the chart, so they fail while istiod is unavailable, like the other changes.").Get()
+
+	EnableConfigAuditConfigMap = env.Register("PILOT_ENABLE_CONFIG_AUDIT_CONFIGMAP", false,
+		"If enabled, the config audit events of each namespace are also written to the istio-config-audit-<namespace> "+
+			"ConfigMap of the istiod namespace, merging the events of all the replicas. The tenants cannot write them, "+
+			"and can be granted read access to the ConfigMap of their namespace.").Get()
+
+	EnableWarmStandby = env.Register("PILOT_ENABLE_WARM_STANDBY", false,
+		"If enabled, only the istiod replica holding the warm standby lock of its revision serves xDS. The other replicas "+
+			"keep their caches and push context up to date, so they can take over without a cold start. They are ready and "+
+			"serve the webhooks and the CA, but refuse the xDS connections, which the proxies retry on another replica.").Get()
+
+	MaxProxyStaleness = env.Register("PILOT_MAX_PROXY_STALENESS", time.Duration(0),
+		"If set, a full push is forced to the proxies which neither ACKed nor NACKed a response within this duration, "+
+			"and the resync is reported by the pilot_xds_stale_proxy_resyncs metric. Disabled if 0.").Get()
+
+	EnableConfigFreeze = env.Register("PILOT_ENABLE_CONFIG_FREEZE", false,
+		"If enabled, the config changes made during the freeze windows set by the config.istio.io/freeze-windows "+
+			"annotation of the root namespace are accepted, but only pushed to the proxies at the end of the window. "+
+			"If PILOT_ENABLE_STATUS is also enabled, the changed resources are reported with a Deferred status condition.").Get()
 )
 
+// splitList returns the entries of a comma separated list, ignoring the spaces around them and the empty entries.
+func splitList(v string) sets.String {
+	res := sets.New[string]()
+	for _, e := range strings.Split(v, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			res.Insert(e)
+		}
+	}
+	return res
+}
+
 // UnsafeFeaturesEnabled returns true if any unsafe features are enabled.
 func UnsafeFeaturesEnabled() bool {
 	return EnableUnsafeAdminEndpoints || EnableUnsafeAssertions
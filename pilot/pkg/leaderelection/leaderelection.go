@@ -55,6 +55,12 @@ const (
 	// * Other types use "prioritized leader election", which isn't implemented for Lease
 	GatewayDeploymentController = "istio-gateway-deployment"
 	IORController               = "ior-leader"
+	RouteIngestionController    = "route-ingestion-leader"
+	// ConfigFreezeStatusController reports the changes deferred by the config freeze windows on the status of the
+	// Istio resources.
+	ConfigFreezeStatusController = "istio-config-freeze-status-leader"
+	// WarmStandbyController selects the istiod replica serving xDS when warm standby is enabled. This is per-revision.
+	WarmStandbyController = "istio-warm-standby"
 )
 
 // Leader election key prefix for remote istiod managed clusters
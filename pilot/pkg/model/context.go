@@ -30,6 +30,7 @@ import (
 	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	anypb "google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/types"
 
 	meshconfig "istio.io/api/mesh/v1alpha1"
 	"istio.io/istio/pilot/pkg/credentials"
@@ -412,6 +413,13 @@ type WatchedResource struct {
 	// NonceAcked is the last acked message.
 	NonceAcked string
 
+	// NonceNacked is the last rejected message.
+	NonceNacked string
+
+	// LastSent is the time the last response was sent. Along with the nonces, it allows detecting the proxies which
+	// neither ACK nor NACK the responses sent to them.
+	LastSent time.Time
+
 	// AlwaysRespond, if true, will ensure that even when a request would otherwise be treated as an
 	// ACK, it will be responded to. This typically happens when a proxy reconnects to another instance of
 	// Istiod. In that case, Envoy expects us to respond to EDS/RDS/SDS requests to finish warming of
@@ -1360,6 +1368,61 @@ type GatewayController interface {
 	SecretAllowed(resourceName string, namespace string) bool
 }
 
+// GatewayNackReporter is implemented by GatewayControllers that can surface configuration rejected by a
+// gateway proxy on the status of the Gateway API resource that produced it.
+// Rejections are tracked per proxy connection, identified by conID.
+type GatewayNackReporter interface {
+	// ReportNack records that a proxy deployed for the given Gateway rejected the configuration of type typeURL.
+	// Returns true if this changed the reported state, and the Gateway status should be recomputed.
+	ReportNack(gateway types.NamespacedName, conID string, typeURL string, message string) bool
+	// ClearNack records that a proxy deployed for the given Gateway accepted the configuration of type typeURL.
+	// Returns true if this changed the reported state, and the Gateway status should be recomputed.
+	ClearNack(gateway types.NamespacedName, conID string, typeURL string) bool
+	// ForgetNacks drops the rejections of a proxy deployed for the given Gateway, once it disconnects.
+	// Returns true if this changed the reported state, and the Gateway status should be recomputed.
+	ForgetNacks(gateway types.NamespacedName, conID string) bool
+}
+
+// GatewayListenerAckReporter is implemented by GatewayControllers that only report a Gateway API resource as
+// programmed once a gateway proxy serves the listeners generated for it.
+type GatewayListenerAckReporter interface {
+	// ReportListenerAck records that a proxy deployed for the given Gateway accepted the listeners generated by the
+	// push context of the given version.
+	// Returns true if this changed the reported state, and the Gateway status should be recomputed.
+	ReportListenerAck(gateway types.NamespacedName, pushVersion string) bool
+}
+
+// GatewayRouteTracer is implemented by GatewayControllers able to explain the precedence of their routes.
+type GatewayRouteTracer interface {
+	// TraceRoutes explains how the routes competing for the hostname and path are ordered on each Gateway listener,
+	// and which one wins. The trace is only meant to be rendered as JSON, for debugging.
+	TraceRoutes(hostname, path string) any
+}
+
+// GatewayHealthReporter is implemented by GatewayControllers able to report their health.
+type GatewayHealthReporter interface {
+	Health() GatewayControllerHealth
+}
+
+// GatewayControllerHealth reports the state of the Gateway API controller, so a wedged controller can be told apart
+// from an unready istiod.
+type GatewayControllerHealth struct {
+	// Ready is true once the informers are synced, if the last reconciliation succeeded.
+	Ready bool `json:"ready"`
+	// Informers holds whether each informer of the controller is synced.
+	Informers map[string]bool `json:"informers"`
+	// StatusWriter is true if this instance writes the status of the Gateway API resources, as the leader.
+	StatusWriter bool `json:"statusWriter"`
+	// PendingStatusWrites is the number of resources whose status is queued for writing.
+	PendingStatusWrites int `json:"pendingStatusWrites"`
+	// LastReconcile is the time of the last reconciliation, which happens on each full push.
+	LastReconcile *time.Time `json:"lastReconcile,omitempty"`
+	// LastSuccessfulReconcile is the time of the last reconciliation which succeeded.
+	LastSuccessfulReconcile *time.Time `json:"lastSuccessfulReconcile,omitempty"`
+	// LastReconcileError is the error of the last reconciliation, if it failed.
+	LastReconcileError string `json:"lastReconcileError,omitempty"`
+}
+
 // OutboundListenerClass is a helper to turn a NodeType for outbound to a ListenerClass.
 func OutboundListenerClass(t NodeType) istionetworking.ListenerClass {
 	if t == Router {
@@ -67,6 +67,16 @@ func ToKubernetesGatewayResource(namespace, name string) string {
 	return fmt.Sprintf("%s://%s/%s", KubernetesGatewaySecretType, namespace, name)
 }
 
+// ToCaResourceName returns the SDS resource name of the CA validating the client certificates of a server. It is the CA
+// of the credential, unless caCertificates is set to a kubernetes-gateway resource, as done for the CA bundle of a
+// Gateway API listener.
+func ToCaResourceName(credentialName, caCertificates string) string {
+	if strings.HasPrefix(caCertificates, kubernetesGatewaySecretTypeURI) {
+		return caCertificates
+	}
+	return credentialName + SdsCaSuffix
+}
+
 // ToResourceName turns a `credentialName` into a resource name used for SDS
 func ToResourceName(name string) string {
 	if strings.HasPrefix(name, BuiltinGatewaySecretTypeURI) {
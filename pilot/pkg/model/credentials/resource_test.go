@@ -144,3 +144,22 @@ func TestToKubernetesGatewayResource(t *testing.T) {
 		})
 	}
 }
+
+func TestToCaResourceName(t *testing.T) {
+	tests := []struct {
+		credentialName string
+		caCertificates string
+		want           string
+	}{
+		{"kubernetes://foo", "", "kubernetes://foo-cacert"},
+		{"kubernetes://foo", "/etc/certs/ca.pem", "kubernetes://foo-cacert"},
+		{"kubernetes-gateway://ns/foo", "kubernetes-gateway://ns/ca-cacert", "kubernetes-gateway://ns/ca-cacert"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.credentialName+"/"+tt.caCertificates, func(t *testing.T) {
+			if got := ToCaResourceName(tt.credentialName, tt.caCertificates); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
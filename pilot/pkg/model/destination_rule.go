@@ -24,6 +24,7 @@ import (
 	"istio.io/istio/pkg/config/host"
 	"istio.io/istio/pkg/config/labels"
 	"istio.io/istio/pkg/config/visibility"
+	"istio.io/istio/pkg/slices"
 	"istio.io/istio/pkg/util/sets"
 )
 
@@ -119,6 +120,37 @@ func (ps *PushContext) mergeDestinationRule(p *consolidatedDestRules, destRuleCo
 	p.exportTo[resolvedHost] = exportToSet
 }
 
+// withAdditionalSubsets returns the destination rules applying to a host in a namespace, with the subsets of the
+// destination rules of the namespace only declaring additional subsets. The other settings of the rules, such as their
+// traffic policies and their other subsets, are kept.
+func (ps *PushContext) withAdditionalSubsets(namespace string, hostname host.Name, drs []*ConsolidatedDestRule) []*ConsolidatedDestRule {
+	index := ps.destinationRuleIndex.additionalSubsets[namespace]
+	if index == nil || len(index.specificDestRules[hostname]) == 0 {
+		return drs
+	}
+	additional := index.specificDestRules[hostname][0]
+	if len(drs) == 0 {
+		return []*ConsolidatedDestRule{additional}
+	}
+	out := make([]*ConsolidatedDestRule, 0, len(drs))
+	for _, dr := range drs {
+		copied := dr.rule.DeepCopy()
+		merged := copied.Spec.(*networking.DestinationRule)
+		existingSubset := sets.String{}
+		for _, subset := range merged.Subsets {
+			existingSubset.Insert(subset.Name)
+		}
+		for _, subset := range additional.rule.Spec.(*networking.DestinationRule).Subsets {
+			if !existingSubset.Contains(subset.Name) {
+				merged.Subsets = append(merged.Subsets, subset)
+			}
+		}
+		from := append(slices.Clone(dr.from), additional.from...)
+		out = append(out, &ConsolidatedDestRule{rule: &copied, from: from})
+	}
+	return out
+}
+
 func ConvertConsolidatedDestRule(cfg *config.Config) *ConsolidatedDestRule {
 	return &ConsolidatedDestRule{
 		rule: cfg,
@@ -97,6 +97,9 @@ type WasmPluginWrapper struct {
 }
 
 func (p *WasmPluginWrapper) MatchListener(opts WorkloadSelectionOpts, li WasmPluginListenerInfo) bool {
+	if !p.matchTargetRefNamespace(opts) {
+		return false
+	}
 	switch getPolicyMatcher(gvk.WasmPlugin, p.Name, opts, p) {
 	case policyMatchDirect:
 		// This plugin is bound directly to this workload; just check traffic selectors
@@ -111,6 +114,28 @@ func (p *WasmPluginWrapper) MatchListener(opts WorkloadSelectionOpts, li WasmPlu
 	return false
 }
 
+// matchTargetRefNamespace checks the tenancy of a plugin attached to a Gateway by its targetRef: the Gateway must be in
+// the namespace of the plugin, or the plugin must be in the root namespace, where it may set the namespace of the Gateway.
+// Without a namespace, the targetRef refers to a Gateway of the namespace of the plugin, except in the root namespace
+// where it keeps referring to the Gateways of that name in every namespace.
+func (p *WasmPluginWrapper) matchTargetRefNamespace(opts WorkloadSelectionOpts) bool {
+	targetRef := p.GetTargetRef()
+	if targetRef == nil {
+		return true
+	}
+	ns := targetRef.GetNamespace()
+	if ns == "" {
+		if p.Namespace == opts.RootNamespace {
+			return true
+		}
+		ns = p.Namespace
+	}
+	if ns != opts.Namespace {
+		return false
+	}
+	return p.Namespace == opts.Namespace || p.Namespace == opts.RootNamespace
+}
+
 func (p *WasmPluginWrapper) MatchType(pluginType WasmPluginType) bool {
 	return pluginType == WasmPluginTypeAny || pluginType == fromPluginType(p.WasmPlugin.Type)
 }
@@ -29,6 +29,8 @@ import (
 	"istio.io/istio/pilot/pkg/networking"
 	"istio.io/istio/pkg/cluster"
 	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/schema/gvk"
 	"istio.io/istio/pkg/test/util/assert"
 )
 
@@ -526,3 +528,70 @@ func TestMatchListener(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchListenerTargetRef(t *testing.T) {
+	plugin := func(ns, targetNs string) *WasmPluginWrapper {
+		return &WasmPluginWrapper{
+			Name:      "plugin",
+			Namespace: ns,
+			WasmPlugin: &extensions.WasmPlugin{
+				TargetRef: &v1beta1.PolicyTargetReference{
+					Group:     gvk.KubernetesGateway.Group,
+					Kind:      gvk.KubernetesGateway.Kind,
+					Name:      "gateway",
+					Namespace: targetNs,
+				},
+			},
+		}
+	}
+	cases := []struct {
+		desc       string
+		wasmPlugin *WasmPluginWrapper
+		want       bool
+	}{
+		{
+			desc:       "plugin in the gateway namespace",
+			wasmPlugin: plugin("ns", ""),
+			want:       true,
+		},
+		{
+			desc:       "plugin in the gateway namespace with explicit namespace",
+			wasmPlugin: plugin("ns", "ns"),
+			want:       true,
+		},
+		{
+			desc:       "plugin in the root namespace targeting the gateway namespace",
+			wasmPlugin: plugin("root", "ns"),
+			want:       true,
+		},
+		{
+			desc:       "plugin in the root namespace without namespace",
+			wasmPlugin: plugin("root", ""),
+			want:       true,
+		},
+		{
+			desc:       "plugin in another namespace",
+			wasmPlugin: plugin("other", "ns"),
+			want:       false,
+		},
+		{
+			desc:       "plugin in the gateway namespace targeting another namespace",
+			wasmPlugin: plugin("ns", "other"),
+			want:       false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			opts := WorkloadSelectionOpts{
+				RootNamespace:  "root",
+				Namespace:      "ns",
+				WorkloadLabels: map[string]string{constants.GatewayNameLabel: "gateway"},
+			}
+			got := tc.wasmPlugin.MatchListener(opts, WasmPluginListenerInfo{Port: 80, Class: networking.ListenerClassGateway})
+			if tc.want != got {
+				t.Errorf("MatchListener got %v want %v", got, tc.want)
+			}
+		})
+	}
+}
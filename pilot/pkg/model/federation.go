@@ -0,0 +1,39 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// FederatedGateway is a Gateway API Gateway of a federated peer mesh, which the local routes can attach to.
+type FederatedGateway struct {
+	// Mesh is the name of the ServiceMeshPeer of the mesh the Gateway belongs to.
+	Mesh      string
+	Name      string
+	Namespace string
+	Listeners []FederatedGatewayListener
+}
+
+// FederatedGatewayListener is an HTTP listener of a FederatedGateway.
+type FederatedGatewayListener struct {
+	Name     string
+	Hostname string
+	Port     int
+	Protocol string
+}
+
+// FederatedGatewayDiscovery is implemented by the service registries of the federated peer meshes, which advertise
+// the Gateways exported by the peers over the federation discovery channel.
+type FederatedGatewayDiscovery interface {
+	// FederatedGateways returns the Gateways exported by the peer meshes.
+	FederatedGateways() []FederatedGateway
+}
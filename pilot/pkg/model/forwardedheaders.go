@@ -0,0 +1,54 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+
+	meshconfig "istio.io/api/mesh/v1alpha1"
+	"istio.io/istio/pkg/config"
+)
+
+// ForwardedHeadersPolicy configures how a gateway server handles the X-Forwarded-For and X-Forwarded-Client-Cert
+// headers, overriding the gateway topology of the proxy.
+type ForwardedHeadersPolicy struct {
+	// NumTrustedProxies is the number of trusted proxies in front of the gateway, if set.
+	NumTrustedProxies *uint32 `json:"numTrustedProxies,omitempty"`
+	// ForwardClientCertDetails is the name of the ForwardClientCertDetails mode of the gateway, if set.
+	ForwardClientCertDetails string `json:"forwardClientCertDetails,omitempty"`
+	// SkipXFFAppend preserves the X-Forwarded-For header, rather than appending the remote address to it.
+	SkipXFFAppend bool `json:"skipXffAppend,omitempty"`
+}
+
+// ClientCertDetails returns the ForwardClientCertDetails mode of the policy, UNDEFINED if it is not set.
+func (p *ForwardedHeadersPolicy) ClientCertDetails() meshconfig.ForwardClientCertDetails {
+	return meshconfig.ForwardClientCertDetails(meshconfig.ForwardClientCertDetails_value[p.ForwardClientCertDetails])
+}
+
+// gatewayForwardedHeadersPolicy returns the forwarded headers policy set on a gateway generated from the Kubernetes
+// Gateway API. The values are validated when generating the gateway.
+func gatewayForwardedHeadersPolicy(cfg config.Config) *ForwardedHeadersPolicy {
+	s := cfg.Annotations[InternalGatewayForwardedHeadersAnnotation]
+	if s == "" {
+		return nil
+	}
+	policy := &ForwardedHeadersPolicy{}
+	if err := json.Unmarshal([]byte(s), policy); err != nil {
+		// The proxy defaults trust no proxy in front of the gateway, which is the safe choice
+		log.Warnf("invalid forwarded headers policy on gateway %s/%s: %v", cfg.Namespace, cfg.Name, err)
+		return nil
+	}
+	return policy
+}
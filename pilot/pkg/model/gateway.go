@@ -26,7 +26,9 @@ import (
 	"istio.io/istio/pkg/config/gateway"
 	"istio.io/istio/pkg/config/protocol"
 	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/schema/kind"
 	"istio.io/istio/pkg/monitoring"
+	"istio.io/istio/pkg/util/protomarshal"
 	"istio.io/istio/pkg/util/sets"
 )
 
@@ -50,6 +52,8 @@ type MergedServers struct {
 type TLSServerInfo struct {
 	RouteName string
 	SNIHosts  []string
+	// ALPNProtocols overrides the ALPN protocols advertised by the server, if set.
+	ALPNProtocols []string
 }
 
 // MergedGateway describes a set of gateways for a workload merged into a single logical gateway.
@@ -95,6 +99,58 @@ type MergedGateway struct {
 	// Note: Secrets that are not referenced by any Gateway, but are in the same namespace as the pod, are explicitly *not*
 	// included. This ensures we don't give permission to unexpected secrets, such as the citadel root key/cert.
 	VerifiedCertificateReferences sets.String
+
+	// CertificateReferences contains the Secrets referenced by the credentialName of any server, with implicit
+	// namespaces resolved against the proxy. This is used to scope pushes on Secret changes to the gateways
+	// that actually serve the Secret.
+	CertificateReferences sets.Set[ConfigKey]
+
+	// DefaultRetryPolicy is the retry policy of the routes which do not set one, as configured by the class of the
+	// gateways. If unset, the mesh default applies.
+	DefaultRetryPolicy *networking.HTTPRetry
+
+	// DefaultTrafficPolicy holds the connection pool and outlier detection settings of the clusters whose destination
+	// rule does not set them, as configured by the class of the gateways.
+	DefaultTrafficPolicy *networking.TrafficPolicy
+
+	// IPAccessPolicies maps from server to the source addresses it accepts connections from, as configured by the IP
+	// access policies of the Kubernetes Gateway API listeners. Servers without a policy accept any address.
+	IPAccessPolicies map[*networking.Server]*IPAccessPolicy
+
+	// ForwardedHeadersPolicies maps from server to the handling of its forwarded headers, as configured by the
+	// annotations of the Kubernetes Gateway API Gateways. Servers without a policy use the gateway topology.
+	ForwardedHeadersPolicies map[*networking.Server]*ForwardedHeadersPolicy
+}
+
+// gatewayClassDefaults returns the default retry policy and traffic policy set on a gateway generated from the
+// Kubernetes Gateway API. Invalid values are ignored, as they are validated when generating the gateway.
+func gatewayClassDefaults(cfg config.Config) (*networking.HTTPRetry, *networking.TrafficPolicy) {
+	var retries *networking.HTTPRetry
+	if s := cfg.Annotations[InternalGatewayRetryPolicyAnnotation]; s != "" {
+		retries = &networking.HTTPRetry{}
+		if err := protomarshal.UnmarshalString(s, retries); err != nil {
+			log.Warnf("invalid retry policy on gateway %s/%s: %v", cfg.Namespace, cfg.Name, err)
+			retries = nil
+		}
+	}
+	var trafficPolicy *networking.TrafficPolicy
+	if s := cfg.Annotations[InternalGatewayTrafficPolicyAnnotation]; s != "" {
+		trafficPolicy = &networking.TrafficPolicy{}
+		if err := protomarshal.UnmarshalString(s, trafficPolicy); err != nil {
+			log.Warnf("invalid traffic policy on gateway %s/%s: %v", cfg.Namespace, cfg.Name, err)
+			trafficPolicy = nil
+		}
+	}
+	return retries, trafficPolicy
+}
+
+// gatewayALPNProtocols returns the ALPN protocols set on a gateway generated from the Kubernetes Gateway API.
+func gatewayALPNProtocols(cfg config.Config) []string {
+	alpn := cfg.Annotations[InternalGatewayALPNAnnotation]
+	if alpn == "" {
+		return nil
+	}
+	return strings.Split(alpn, ",")
 }
 
 func (g *MergedGateway) HasAutoPassthroughGateways() bool {
@@ -164,15 +220,34 @@ func MergeGateways(gateways []gatewayWithInstances, proxy *Proxy, ps *PushContex
 	tlsServerInfo := make(map[*networking.Server]*TLSServerInfo)
 	gatewayNameForServer := make(map[*networking.Server]string)
 	verifiedCertificateReferences := sets.New[string]()
+	certificateReferences := sets.New[ConfigKey]()
+	proxyNamespace := proxy.GetNamespace()
+	if proxy.VerifiedIdentity != nil {
+		proxyNamespace = proxy.VerifiedIdentity.Namespace
+	}
 	http3AdvertisingRoutes := sets.New[string]()
 	tlsHostsByPort := map[uint32]map[string]string{} // port -> host/bind map
 	autoPassthrough := false
+	var defaultRetryPolicy *networking.HTTPRetry
+	var defaultTrafficPolicy *networking.TrafficPolicy
+	ipAccessPolicies := map[*networking.Server]*IPAccessPolicy{}
+	forwardedHeadersPolicies := map[*networking.Server]*ForwardedHeadersPolicy{}
 
 	log.Debugf("MergeGateways: merging %d gateways", len(gateways))
 	for _, gwAndInstance := range gateways {
 		gatewayConfig := gwAndInstance.gateway
 		gatewayName := gatewayConfig.Namespace + "/" + gatewayConfig.Name // Format: %s/%s
 		gatewayCfg := gatewayConfig.Spec.(*networking.Gateway)
+		// All the listeners of a Kubernetes Gateway carry the defaults of its class; the first one set wins
+		retries, trafficPolicy := gatewayClassDefaults(gatewayConfig)
+		if defaultRetryPolicy == nil {
+			defaultRetryPolicy = retries
+		}
+		if defaultTrafficPolicy == nil {
+			defaultTrafficPolicy = trafficPolicy
+		}
+		ipAccess := gatewayIPAccessPolicy(gatewayConfig)
+		forwardedHeaders := gatewayForwardedHeadersPolicy(gatewayConfig)
 		log.Debugf("MergeGateways: merging gateway %q :\n%v", gatewayName, gatewayCfg)
 		snames := sets.String{}
 		for _, s := range gatewayCfg.Servers {
@@ -190,17 +265,40 @@ func MergeGateways(gateways []gatewayWithInstances, proxy *Proxy, ps *PushContex
 			}
 			sanitizeServerHostNamespace(s, gatewayConfig.Namespace)
 			gatewayNameForServer[s] = gatewayName
+			if ipAccess != nil {
+				ipAccessPolicies[s] = ipAccess
+			}
+			if forwardedHeaders != nil {
+				forwardedHeadersPolicies[s] = forwardedHeaders
+			}
 			log.Debugf("MergeGateways: gateway %q processing server %s :%v", gatewayName, s.Name, s.Hosts)
 
 			cn := s.GetTls().GetCredentialName()
+			if cn != "" {
+				if parse, err := credentials.ParseResourceName(credentials.ToResourceName(cn), proxyNamespace, "", ""); err == nil {
+					certificateReferences.Insert(ConfigKey{Kind: kind.Secret, Name: parse.Name, Namespace: parse.Namespace})
+				}
+			}
+			// The CA bundle validating the client certificates of a Gateway API listener may be a secret of its own
+			ca := credentials.ToCaResourceName(credentials.ToResourceName(cn), s.GetTls().GetCaCertificates())
+			caParse, caErr := credentials.ParseResourceName(ca, proxyNamespace, "", "")
+			if cn != "" && caErr == nil && ca == s.GetTls().GetCaCertificates() {
+				certificateReferences.Insert(ConfigKey{
+					Kind:      kind.Secret,
+					Name:      strings.TrimSuffix(caParse.Name, credentials.SdsCaSuffix),
+					Namespace: caParse.Namespace,
+				})
+			}
 			if cn != "" && proxy.VerifiedIdentity != nil {
 				rn := credentials.ToResourceName(cn)
 				parse, _ := credentials.ParseResourceName(rn, proxy.VerifiedIdentity.Namespace, "", "")
 				if gatewayConfig.Namespace == proxy.VerifiedIdentity.Namespace && parse.Namespace == proxy.VerifiedIdentity.Namespace {
 					// Same namespace is always allowed
 					verifiedCertificateReferences.Insert(rn)
-					if s.GetTls().GetMode() == networking.ServerTLSSettings_MUTUAL {
-						verifiedCertificateReferences.Insert(rn + credentials.SdsCaSuffix)
+					mode := s.GetTls().GetMode()
+					if (mode == networking.ServerTLSSettings_MUTUAL || mode == networking.ServerTLSSettings_OPTIONAL_MUTUAL) &&
+						caErr == nil && caParse.Namespace == proxy.VerifiedIdentity.Namespace {
+						verifiedCertificateReferences.Insert(ca)
 					}
 				} else if ps.ReferenceAllowed(gvk.Secret, rn, proxy.VerifiedIdentity.Namespace) {
 					// Explicitly allowed by some policy
@@ -225,7 +323,11 @@ func MergeGateways(gateways []gatewayWithInstances, proxy *Proxy, ps *PushContex
 						RecordRejectedConfig(gatewayName)
 						continue
 					}
-					tlsServerInfo[s] = &TLSServerInfo{SNIHosts: GetSNIHostsForServer(s), RouteName: routeName}
+					tlsServerInfo[s] = &TLSServerInfo{
+						SNIHosts:      GetSNIHostsForServer(s),
+						RouteName:     routeName,
+						ALPNProtocols: gatewayALPNProtocols(gatewayConfig),
+					}
 					if s.Tls.Mode == networking.ServerTLSSettings_AUTO_PASSTHROUGH {
 						autoPassthrough = true
 					}
@@ -321,7 +423,8 @@ func MergeGateways(gateways []gatewayWithInstances, proxy *Proxy, ps *PushContex
 						// We have TLS settings defined and we have already taken care of unique route names
 						// if it is HTTPS. So we can construct a QUIC server on the same port. It is okay as
 						// QUIC listens on UDP port, not TCP
-						if features.EnableQUICListeners && gateway.IsEligibleForHTTP3Upgrade(s) &&
+						// IP access policies cannot be enforced on QUIC, so the servers with one are not upgraded
+						if features.EnableQUICListeners && gateway.IsEligibleForHTTP3Upgrade(s) && ipAccess == nil &&
 							udpSupportedPort(s.GetPort().GetNumber(), gwAndInstance.instances) {
 							log.Debugf("Server at port %d eligible for HTTP3 upgrade. Add UDP listener for QUIC", serverPort.Number)
 							if mergedQUICServers[serverPort] == nil {
@@ -340,7 +443,8 @@ func MergeGateways(gateways []gatewayWithInstances, proxy *Proxy, ps *PushContex
 					if gateway.IsHTTPServer(s) {
 						serversByRouteName[routeName] = []*networking.Server{s}
 
-						if features.EnableQUICListeners && gateway.IsEligibleForHTTP3Upgrade(s) &&
+						// IP access policies cannot be enforced on QUIC, so the servers with one are not upgraded
+						if features.EnableQUICListeners && gateway.IsEligibleForHTTP3Upgrade(s) && ipAccess == nil &&
 							udpSupportedPort(s.GetPort().GetNumber(), gwAndInstance.instances) {
 							log.Debugf("Server at port %d eligible for HTTP3 upgrade. So QUIC listener will be added", serverPort.Number)
 							http3AdvertisingRoutes.Insert(routeName)
@@ -373,6 +477,11 @@ func MergeGateways(gateways []gatewayWithInstances, proxy *Proxy, ps *PushContex
 		ContainsAutoPassthroughGateways: autoPassthrough,
 		PortMap:                         getTargetPortMap(serversByRouteName),
 		VerifiedCertificateReferences:   verifiedCertificateReferences,
+		CertificateReferences:           certificateReferences,
+		DefaultRetryPolicy:              defaultRetryPolicy,
+		DefaultTrafficPolicy:            defaultTrafficPolicy,
+		IPAccessPolicies:                ipAccessPolicies,
+		ForwardedHeadersPolicies:        forwardedHeadersPolicies,
 	}
 }
 
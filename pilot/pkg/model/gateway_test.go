@@ -296,3 +296,27 @@ func TestParseGatewayRDSRouteName(t *testing.T) {
 		})
 	}
 }
+
+func TestGatewayClassDefaults(t *testing.T) {
+	cfg := config.Config{Meta: config.Meta{
+		Name:      "gw",
+		Namespace: "default",
+		Annotations: map[string]string{
+			InternalGatewayRetryPolicyAnnotation:   `{"attempts":2,"retryOn":"5xx"}`,
+			InternalGatewayTrafficPolicyAnnotation: `{"outlierDetection":{"consecutive5xxErrors":5}}`,
+		},
+	}}
+	retries, trafficPolicy := gatewayClassDefaults(cfg)
+	if retries.GetAttempts() != 2 || retries.GetRetryOn() != "5xx" {
+		t.Errorf("unexpected retry policy: %v", retries)
+	}
+	if trafficPolicy.GetOutlierDetection().GetConsecutive_5XxErrors().GetValue() != 5 {
+		t.Errorf("unexpected traffic policy: %v", trafficPolicy)
+	}
+
+	cfg.Annotations[InternalGatewayRetryPolicyAnnotation] = "invalid"
+	delete(cfg.Annotations, InternalGatewayTrafficPolicyAnnotation)
+	if retries, trafficPolicy := gatewayClassDefaults(cfg); retries != nil || trafficPolicy != nil {
+		t.Errorf("expected no defaults, got %v and %v", retries, trafficPolicy)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"strings"
+
+	"istio.io/istio/pkg/config"
+)
+
+// IPAccessPolicy restricts the source addresses of the connections accepted by a gateway server.
+type IPAccessPolicy struct {
+	// Allow lists the CIDRs the connections are accepted from. If empty, any address not denied is accepted.
+	Allow []string `json:"allow,omitempty"`
+	// Deny lists the CIDRs the connections are rejected from, taking precedence over Allow.
+	Deny []string `json:"deny,omitempty"`
+}
+
+// IsEmpty returns true if the policy does not restrict any address.
+func (p *IPAccessPolicy) IsEmpty() bool {
+	return p == nil || (len(p.Allow) == 0 && len(p.Deny) == 0)
+}
+
+func (p *IPAccessPolicy) String() string {
+	return "allow=" + strings.Join(p.Allow, ",") + " deny=" + strings.Join(p.Deny, ",")
+}
+
+// gatewayIPAccessPolicy returns the IP access policy set on a gateway generated from the Kubernetes Gateway API.
+// The CIDRs are validated when generating the gateway.
+func gatewayIPAccessPolicy(cfg config.Config) *IPAccessPolicy {
+	s := cfg.Annotations[InternalGatewayIPAccessAnnotation]
+	if s == "" {
+		return nil
+	}
+	policy := &IPAccessPolicy{}
+	if err := json.Unmarshal([]byte(s), policy); err != nil {
+		// Rejecting everything is safer than silently accepting the addresses the policy meant to deny
+		log.Warnf("invalid IP access policy on gateway %s/%s, denying all connections: %v", cfg.Namespace, cfg.Name, err)
+		return &IPAccessPolicy{Deny: []string{"0.0.0.0/0", "::/0"}}
+	}
+	if policy.IsEmpty() {
+		return nil
+	}
+	return policy
+}
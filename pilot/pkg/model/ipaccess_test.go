@@ -0,0 +1,52 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestMergeGatewaysIPAccess(t *testing.T) {
+	gw := func(name string, port uint32, ipAccess string) config.Config {
+		c := makeConfig(name, "default", name+".example.com", "http", "HTTP", port, "ingress", "", networking.ServerTLSSettings_SIMPLE)
+		c.Spec.(*networking.Gateway).Servers[0].Tls = nil
+		if ipAccess != "" {
+			c.Annotations = map[string]string{InternalGatewayIPAccessAnnotation: ipAccess}
+		}
+		return c
+	}
+	allowed := gw("allowed", 80, `{"allow":["10.0.0.0/8"],"deny":["10.1.0.0/16"]}`)
+	open := gw("open", 8080, "")
+	empty := gw("empty", 8081, `{}`)
+	invalid := gw("invalid", 8082, `not json`)
+
+	instances := []gatewayWithInstances{}
+	for _, c := range []config.Config{allowed, open, empty, invalid} {
+		instances = append(instances, gatewayWithInstances{c, true, nil})
+	}
+	mgw := MergeGateways(instances, &Proxy{}, nil)
+	server := func(c config.Config) *networking.Server {
+		return c.Spec.(*networking.Gateway).Servers[0]
+	}
+	assert.Equal(t, mgw.IPAccessPolicies[server(allowed)], &IPAccessPolicy{Allow: []string{"10.0.0.0/8"}, Deny: []string{"10.1.0.0/16"}})
+	assert.Equal(t, mgw.IPAccessPolicies[server(open)], nil)
+	assert.Equal(t, mgw.IPAccessPolicies[server(empty)], nil)
+	// A policy which cannot be read does not open the server
+	assert.Equal(t, mgw.IPAccessPolicies[server(invalid)], &IPAccessPolicy{Deny: []string{"0.0.0.0/0", "::/0"}})
+}
@@ -37,6 +37,7 @@ import (
 	envoy_jwt "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
 
 	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pkg/clock"
 	"istio.io/istio/pkg/monitoring"
 )
 
@@ -227,7 +228,7 @@ var errEmptyPubKeyFoundInCache = errors.New("empty public key found in cache")
 // or fetch with from jwksuri if there is a error while fetching then it adds the
 // jwksURI in the cache to fetch the public key in the background process
 func (r *JwksResolver) GetPublicKey(issuer string, jwksURI string) (string, error) {
-	now := time.Now()
+	now := clock.Now()
 	key := jwtKey{issuer: issuer, jwksURI: jwksURI}
 	if val, found := r.keyEntries.Load(key); found {
 		e := val.(jwtPubKeyEntry)
@@ -429,7 +430,7 @@ func (r *JwksResolver) refresh(jwksURIBackgroundChannel bool) bool {
 	var wg sync.WaitGroup
 	var hasChange, hasErrors atomic.Bool
 	r.keyEntries.Range(func(key any, value any) bool {
-		now := time.Now()
+		now := clock.Now()
 		k := key.(jwtKey)
 		e := value.(jwtPubKeyEntry)
 
@@ -132,12 +132,16 @@ type destinationRuleIndex struct {
 	//  exportedByNamespace contains all dest rules pertaining to a service exported by a namespace.
 	exportedByNamespace map[string]*consolidatedDestRules
 	rootNamespaceLocal  *consolidatedDestRules
+	// additionalSubsets contains the dest rules only declaring subsets, which are added to the dest rules applying in
+	// their namespace.
+	additionalSubsets map[string]*consolidatedDestRules
 }
 
 func newDestinationRuleIndex() destinationRuleIndex {
 	return destinationRuleIndex{
 		namespaceLocal:      map[string]*consolidatedDestRules{},
 		exportedByNamespace: map[string]*consolidatedDestRules{},
+		additionalSubsets:   map[string]*consolidatedDestRules{},
 	}
 }
 
@@ -464,6 +468,10 @@ const (
 	NamespaceUpdate TriggerReason = "namespace"
 	// ClusterUpdate describes a push triggered by a Cluster change
 	ClusterUpdate TriggerReason = "cluster"
+	// StaleProxy describes a push triggered to resync a proxy which did not ACK the last responses in time
+	StaleProxy TriggerReason = "staleproxy"
+	// ConfigFreeze describes a push triggered by the end of a config freeze window, pushing the deferred changes
+	ConfigFreeze TriggerReason = "configfreeze"
 )
 
 // Merge two update requests together
@@ -750,6 +758,17 @@ func (ps *PushContext) UpdateMetrics() {
 	}
 }
 
+// VirtualServiceDestinationHosts returns the hosts the virtual service sends traffic to, including mirrored traffic.
+func VirtualServiceDestinationHosts(v *networking.VirtualService) []string {
+	destinations := virtualServiceDestinations(v)
+	hosts := make([]string, 0, len(destinations))
+	for h := range destinations {
+		hosts = append(hosts, h)
+	}
+	sort.Strings(hosts)
+	return hosts
+}
+
 // It is called after virtual service short host name is resolved to FQDN
 func virtualServiceDestinations(v *networking.VirtualService) map[string]sets.Set[int] {
 	if v == nil {
@@ -1116,6 +1135,11 @@ func (ps *PushContext) destinationRule(proxyNameSpace string, service *Service)
 	if service == nil {
 		return nil
 	}
+	return ps.withAdditionalSubsets(proxyNameSpace, service.Hostname, ps.precedingDestinationRule(proxyNameSpace, service))
+}
+
+// precedingDestinationRule returns the destination rule taking precedence for a service name in a given namespace.
+func (ps *PushContext) precedingDestinationRule(proxyNameSpace string, service *Service) []*ConsolidatedDestRule {
 	// If the proxy config namespace is same as the root config namespace
 	// look for dest rules in the service's namespace first. This hack is needed
 	// because sometimes, istio-system tends to become the root config namespace.
@@ -1863,11 +1887,19 @@ func (ps *PushContext) setDestinationRules(configs []config.Config) {
 	namespaceLocalDestRules := make(map[string]*consolidatedDestRules)
 	exportedDestRulesByNamespace := make(map[string]*consolidatedDestRules)
 	rootNamespaceLocalDestRules := newConsolidatedDestRules()
+	additionalSubsets := make(map[string]*consolidatedDestRules)
 
 	for i := range configs {
 		rule := configs[i].Spec.(*networking.DestinationRule)
 
 		rule.Host = string(ResolveShortnameToFQDN(rule.Host, configs[i].Meta))
+		if configs[i].Annotations[constants.InternalAdditionalSubsets] == "true" {
+			if _, exist := additionalSubsets[configs[i].Namespace]; !exist {
+				additionalSubsets[configs[i].Namespace] = newConsolidatedDestRules()
+			}
+			ps.mergeDestinationRule(additionalSubsets[configs[i].Namespace], configs[i], nil)
+			continue
+		}
 		var exportToSet sets.Set[visibility.Instance]
 
 		// destination rules with workloadSelector should not be exported to other namespaces
@@ -1920,6 +1952,7 @@ func (ps *PushContext) setDestinationRules(configs []config.Config) {
 	ps.destinationRuleIndex.namespaceLocal = namespaceLocalDestRules
 	ps.destinationRuleIndex.exportedByNamespace = exportedDestRulesByNamespace
 	ps.destinationRuleIndex.rootNamespaceLocal = rootNamespaceLocalDestRules
+	ps.destinationRuleIndex.additionalSubsets = additionalSubsets
 }
 
 // pre computes all AuthorizationPolicies per namespace
@@ -2148,6 +2181,44 @@ func (ps *PushContext) initAmbient(env *Environment) {
 // The Gateway will apply to all ServiceInstances of these services, *in the same namespace as the Gateway*.
 const InternalGatewayServiceAnnotation = "internal.istio.io/gateway-service"
 
+// InternalGatewayALPNAnnotation represents the ALPN protocols a gateway advertises when terminating TLS. This is
+// only used internally to transfer the listener TLS options of the Kubernetes Gateway API to the Istio Gateway API,
+// which does not have a field to represent this.
+// The format is a comma separated list of protocols. For example, "h2,http/1.1"
+const InternalGatewayALPNAnnotation = "internal.istio.io/gateway-alpn-protocols"
+
+// InternalGatewayRetryPolicyAnnotation represents the default retry policy of the routes of a gateway, as configured
+// by the parameters of its GatewayClass. This is only used internally to transfer the defaults of the Kubernetes
+// Gateway API to the Istio Gateway API, which does not have a field to represent this.
+// The format is the JSON encoding of an HTTPRetry.
+const InternalGatewayRetryPolicyAnnotation = "internal.istio.io/gateway-retry-policy"
+
+// InternalGatewayTrafficPolicyAnnotation represents the default connection pool and outlier detection settings of the
+// clusters of a gateway, as configured by the parameters of its GatewayClass. This is only used internally to transfer
+// the defaults of the Kubernetes Gateway API to the Istio Gateway API, which does not have a field to represent this.
+// The format is the JSON encoding of a TrafficPolicy.
+const InternalGatewayTrafficPolicyAnnotation = "internal.istio.io/gateway-traffic-policy"
+
+// InternalGatewayIPAccessAnnotation represents the source addresses a gateway server accepts connections from, as
+// configured by the IP access policies attached to the Kubernetes Gateway API listeners. This is only used internally
+// to transfer the policies to the listeners of the gateways, as the Istio API does not have a field to represent this.
+// The format is the JSON encoding of an IPAccessPolicy.
+const InternalGatewayIPAccessAnnotation = "internal.istio.io/gateway-ip-access"
+
+// InternalGatewayForwardedHeadersAnnotation represents how a gateway handles the X-Forwarded-For and
+// X-Forwarded-Client-Cert headers, as configured by the annotations of the Kubernetes Gateway API Gateways. This is only
+// used internally to override the gateway topology of the proxy per gateway, as the Istio API does not have a field to
+// represent this.
+// The format is the JSON encoding of a ForwardedHeadersPolicy.
+const InternalGatewayForwardedHeadersAnnotation = "internal.istio.io/gateway-forwarded-headers"
+
+// InternalRouteRetryBudgetsAnnotation represents the retry budgets of the destinations of the routes of a virtual
+// service, as configured by the retry budget extensions of the Kubernetes Gateway API routes. This is only used
+// internally to transfer the budgets to the clusters of the gateways, as the Istio API does not have a field to
+// represent this.
+// The format is the JSON encoding of a map from destination hostname to RetryBudget.
+const InternalRouteRetryBudgetsAnnotation = "internal.istio.io/route-retry-budgets"
+
 type gatewayWithInstances struct {
 	gateway config.Config
 	// If true, ports that are not present in any instance will be used directly (without targetPort translation)
@@ -2102,6 +2102,53 @@ func TestSetDestinationRuleMerging(t *testing.T) {
 	}
 }
 
+func TestSetDestinationRuleAdditionalSubsets(t *testing.T) {
+	ps := NewPushContext()
+	ps.Mesh = &meshconfig.MeshConfig{RootNamespace: "istio-system"}
+	testhost := "canary.apps.svc.cluster.local"
+	serviceRule := config.Config{
+		Meta: config.Meta{Name: "canary", Namespace: "apps"},
+		Spec: &networking.DestinationRule{
+			Host:          testhost,
+			TrafficPolicy: &networking.TrafficPolicy{Tls: &networking.ClientTLSSettings{Mode: networking.ClientTLSSettings_ISTIO_MUTUAL}},
+			Subsets:       []*networking.Subset{{Name: "v2", Labels: map[string]string{"version": "v2"}}},
+		},
+	}
+	additionalRule := config.Config{
+		Meta: config.Meta{
+			Name:        "canary-mirror",
+			Namespace:   "gateway",
+			Annotations: map[string]string{constants.InternalAdditionalSubsets: "true"},
+		},
+		Spec: &networking.DestinationRule{
+			Host:     testhost,
+			ExportTo: []string{"."},
+			Subsets:  []*networking.Subset{{Name: "mirror-apps-route"}},
+		},
+	}
+	ps.setDestinationRules([]config.Config{serviceRule, additionalRule})
+	svc := &Service{Hostname: host.Name(testhost), Attributes: ServiceAttributes{Namespace: "apps"}}
+
+	// The subsets are added to the rule of the service namespace, which keeps applying
+	drs := ps.destinationRule("gateway", svc)
+	assert.Equal(t, len(drs), 1)
+	rule := drs[0].rule.Spec.(*networking.DestinationRule)
+	assert.Equal(t, rule.TrafficPolicy, serviceRule.Spec.(*networking.DestinationRule).TrafficPolicy)
+	assert.Equal(t, len(rule.Subsets), 2)
+	assert.Equal(t, rule.Subsets[1].Name, "mirror-apps-route")
+	assert.Equal(t, drs[0].from, []types.NamespacedName{{Namespace: "apps", Name: "canary"}, {Namespace: "gateway", Name: "canary-mirror"}})
+
+	// The rule of the service namespace is left untouched for the other namespaces
+	drs = ps.destinationRule("other", svc)
+	assert.Equal(t, len(drs[0].rule.Spec.(*networking.DestinationRule).Subsets), 1)
+
+	// Without any other rule, the subsets apply alone
+	ps.setDestinationRules([]config.Config{additionalRule})
+	drs = ps.destinationRule("gateway", svc)
+	assert.Equal(t, len(drs), 1)
+	assert.Equal(t, drs[0].rule.Name, "canary-mirror")
+}
+
 func TestSetDestinationRuleWithExportTo(t *testing.T) {
 	ps := NewPushContext()
 	ps.Mesh = &meshconfig.MeshConfig{RootNamespace: "istio-system"}
@@ -0,0 +1,106 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/host"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// RetryBudget limits the concurrent retries to a destination to a percentage of its active requests.
+type RetryBudget struct {
+	// Percent is the maximum number of concurrent retries, as a percentage of the active requests.
+	Percent float64 `json:"percent"`
+	// MinConcurrency is the number of concurrent retries always allowed, regardless of the active requests.
+	// Envoy allows 3 when it is not set.
+	MinConcurrency *uint32 `json:"minConcurrency,omitempty"`
+}
+
+// defaultMinRetryConcurrency is the min_retry_concurrency Envoy uses when the budget does not set it.
+const defaultMinRetryConcurrency = 3
+
+func (b RetryBudget) String() string {
+	if b.MinConcurrency == nil {
+		return fmt.Sprintf("%v", b.Percent)
+	}
+	return fmt.Sprintf("%v/%d", b.Percent, *b.MinConcurrency)
+}
+
+func (b RetryBudget) minConcurrency() uint32 {
+	return ptr.OrDefault(b.MinConcurrency, defaultMinRetryConcurrency)
+}
+
+// MoreRestrictive returns true if the budget allows fewer retries than the other one.
+func (b RetryBudget) MoreRestrictive(other RetryBudget) bool {
+	if b.Percent != other.Percent {
+		return b.Percent < other.Percent
+	}
+	return b.minConcurrency() < other.minConcurrency()
+}
+
+// MergeRetryBudget adds the budget of a destination to the budgets, keeping the most restrictive one when the
+// destination already has a budget.
+func MergeRetryBudget(budgets map[host.Name]RetryBudget, h host.Name, b RetryBudget) {
+	if cur, f := budgets[h]; !f || b.MoreRestrictive(cur) {
+		budgets[h] = b
+	}
+}
+
+// ParseRouteRetryBudgets returns the retry budgets set on a virtual service generated from the Kubernetes Gateway API.
+func ParseRouteRetryBudgets(cfg config.Config) (map[host.Name]RetryBudget, error) {
+	s := cfg.Annotations[InternalRouteRetryBudgetsAnnotation]
+	if s == "" {
+		return nil, nil
+	}
+	budgets := map[host.Name]RetryBudget{}
+	if err := json.Unmarshal([]byte(s), &budgets); err != nil {
+		return nil, err
+	}
+	return budgets, nil
+}
+
+// GatewayRetryBudgets returns the retry budgets of the destinations of the routes bound to the gateways of the proxy.
+// A destination reached through several routes gets the most restrictive budget.
+func (ps *PushContext) GatewayRetryBudgets(proxy *Proxy) map[host.Name]RetryBudget {
+	if proxy.MergedGateway == nil {
+		return nil
+	}
+	var out map[host.Name]RetryBudget
+	gateways := sets.New[string]()
+	for _, gw := range proxy.MergedGateway.GatewayNameForServer {
+		if gateways.InsertContains(gw) {
+			continue
+		}
+		for _, vs := range ps.VirtualServicesForGateway(proxy.ConfigNamespace, gw) {
+			budgets, err := ParseRouteRetryBudgets(vs)
+			if err != nil {
+				log.Warnf("invalid retry budgets on virtual service %s/%s: %v", vs.Namespace, vs.Name, err)
+				continue
+			}
+			for h, b := range budgets {
+				if out == nil {
+					out = map[host.Name]RetryBudget{}
+				}
+				MergeRetryBudget(out, h, b)
+			}
+		}
+	}
+	return out
+}
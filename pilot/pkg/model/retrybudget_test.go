@@ -0,0 +1,64 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"testing"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/host"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestGatewayRetryBudgets(t *testing.T) {
+	vs := func(name, budgets string) config.Config {
+		return config.Config{Meta: config.Meta{
+			Name:        name,
+			Namespace:   "default",
+			Annotations: map[string]string{InternalRouteRetryBudgetsAnnotation: budgets},
+		}}
+	}
+	ps := NewPushContext()
+	ps.virtualServiceIndex.publicByGateway["default/gw"] = []config.Config{
+		vs("a", `{"a.default.svc.cluster.local":{"percent":20},"shared.default.svc.cluster.local":{"percent":25}}`),
+		vs("b", `{"shared.default.svc.cluster.local":{"percent":10,"minConcurrency":3}}`),
+		vs("invalid", `not json`),
+	}
+	ps.virtualServiceIndex.publicByGateway["default/other"] = []config.Config{
+		vs("c", `{"c.default.svc.cluster.local":{"percent":50}}`),
+	}
+
+	proxy := &Proxy{
+		ConfigNamespace: "default",
+		MergedGateway: &MergedGateway{GatewayNameForServer: map[*networking.Server]string{
+			{Port: &networking.Port{Number: 80}}:  "default/gw",
+			{Port: &networking.Port{Number: 443}}: "default/gw",
+		}},
+	}
+	assert.Equal(t, ps.GatewayRetryBudgets(proxy), map[host.Name]RetryBudget{
+		"a.default.svc.cluster.local":      {Percent: 20},
+		"shared.default.svc.cluster.local": {Percent: 10, MinConcurrency: ptr.Of[uint32](3)},
+	})
+	assert.Equal(t, ps.GatewayRetryBudgets(&Proxy{}), nil)
+}
+
+func TestRetryBudgetMoreRestrictive(t *testing.T) {
+	assert.Equal(t, RetryBudget{Percent: 10}.MoreRestrictive(RetryBudget{Percent: 20}), true)
+	assert.Equal(t, RetryBudget{Percent: 20}.MoreRestrictive(RetryBudget{Percent: 10, MinConcurrency: 1}), false)
+	assert.Equal(t, RetryBudget{Percent: 10, MinConcurrency: 1}.MoreRestrictive(RetryBudget{Percent: 10, MinConcurrency: ptr.Of[uint32](3)}), true)
+	assert.Equal(t, RetryBudget{Percent: 10}.MoreRestrictive(RetryBudget{Percent: 10}), false)
+}
@@ -945,7 +945,7 @@ func buildHTTPTelemetryFilter(class networking.ListenerClass, metricsCfg []telem
 				}
 				res = append(res, f)
 			} else {
-				if statsCfg := generateStatsConfig(class, cfg); statsCfg != nil {
+				if statsCfg := generateStatsConfig(class, networking.ListenerProtocolHTTP, cfg); statsCfg != nil {
 					f := &hcm.HttpFilter{
 						Name:       xds.StatsFilterName,
 						ConfigType: &hcm.HttpFilter_TypedConfig{TypedConfig: statsCfg},
@@ -991,7 +991,7 @@ func buildTCPTelemetryFilter(class networking.ListenerClass, telemetryConfigs []
 				}
 				res = append(res, f)
 			} else {
-				if cfg := generateStatsConfig(class, telemetryCfg); cfg != nil {
+				if cfg := generateStatsConfig(class, networking.ListenerProtocolTCP, telemetryCfg); cfg != nil {
 					f := &listener.Filter{
 						Name:       xds.StatsFilterName,
 						ConfigType: &listener.Filter_TypedConfig{TypedConfig: cfg},
@@ -1144,7 +1144,7 @@ var metricToPrometheusMetric = map[string]string{
 	"GRPC_RESPONSE_MESSAGES": "response_messages_total",
 }
 
-func generateStatsConfig(class networking.ListenerClass, filterConfig telemetryFilterConfig) *anypb.Any {
+func generateStatsConfig(class networking.ListenerClass, protocol networking.ListenerProtocol, filterConfig telemetryFilterConfig) *anypb.Any {
 	if !filterConfig.Metrics {
 		// No metric for prometheus
 		return nil
@@ -1184,9 +1184,57 @@ func generateStatsConfig(class networking.ListenerClass, filterConfig telemetryF
 		cfg.Metrics = append(cfg.Metrics, mc)
 	}
 
+	if class == networking.ListenerClassGateway && protocol == networking.ListenerProtocolHTTP && features.GatewayAuthzDryRunMetrics {
+		cfg.Definitions = append(cfg.Definitions, authzDryRunMetricDefinition)
+		cfg.Metrics = append(cfg.Metrics, authzDryRunMetricConfig())
+	}
+
 	return protoconv.MessageToAny(&cfg)
 }
 
+const (
+	// AuthzDryRunMetric counts the requests to a gateway that AuthorizationPolicies in dry-run mode would have denied.
+	// It is reported as istio_authz_dry_run_would_deny_total.
+	AuthzDryRunMetric = "authz_dry_run_would_deny_total"
+
+	// rbacMetadata holds the results of the shadow rules of the RBAC filters, see the authz builder.
+	rbacMetadata = "metadata.filter_metadata['envoy.filters.http.rbac']"
+	// The keys of the shadow results of the ALLOW and DENY RBAC filters. The allow filter denies when no dry-run
+	// ALLOW policy matched, while the deny filter denies when a dry-run DENY policy matched.
+	dryRunAllowResult = "istio_dry_run_allow_shadow_engine_result"
+	dryRunDenyResult  = "istio_dry_run_deny_shadow_engine_result"
+	dryRunDenyPolicy  = "istio_dry_run_deny_shadow_effective_policy_id"
+)
+
+// rbacMetadataEquals returns a CEL expression checking the value of a key of the RBAC filter metadata.
+func rbacMetadataEquals(key, value string) string {
+	return fmt.Sprintf("('envoy.filters.http.rbac' in metadata.filter_metadata && '%s' in %s && %s['%s'] == '%s')",
+		key, rbacMetadata, rbacMetadata, key, value)
+}
+
+var authzDryRunMetricDefinition = &stats.MetricDefinition{
+	Name: AuthzDryRunMetric,
+	Type: stats.MetricType_COUNTER,
+	Value: fmt.Sprintf("%s || %s ? 1 : 0",
+		rbacMetadataEquals(dryRunAllowResult, "denied"), rbacMetadataEquals(dryRunDenyResult, "denied")),
+}
+
+// authzDryRunMetricConfig reports the would-deny counts per route and source principal. For routes generated from an
+// HTTPRoute, the route name is "<namespace>.<name>.<rule index>". The source principal is the identity of the mutual
+// TLS peer, "unknown" for plaintext clients. The denying dry-run DENY policy is reported as well; requests that no
+// dry-run ALLOW policy matched have no such policy.
+func authzDryRunMetricConfig() *stats.MetricConfig {
+	return &stats.MetricConfig{
+		Name: AuthzDryRunMetric,
+		Dimensions: map[string]string{
+			"route_name":       "xds.route_name",
+			"source_principal": "has(connection.uri_san_peer_certificate) ? connection.uri_san_peer_certificate : 'unknown'",
+			"dry_run_policy": fmt.Sprintf("%s ? string(%s['%s']) : 'none'",
+				rbacMetadataEquals(dryRunDenyResult, "denied"), rbacMetadata, dryRunDenyPolicy),
+		},
+	}
+}
+
 func disableHostHeaderFallback(class networking.ListenerClass) bool {
 	return class == networking.ListenerClassSidecarInbound || class == networking.ListenerClassGateway
 }
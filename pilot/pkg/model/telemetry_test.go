@@ -35,12 +35,14 @@ import (
 	meshconfig "istio.io/api/mesh/v1alpha1"
 	tpb "istio.io/api/telemetry/v1alpha1"
 	"istio.io/api/type/v1beta1"
+	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/networking"
 	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/config/mesh"
 	"istio.io/istio/pkg/config/schema/collection"
 	"istio.io/istio/pkg/config/schema/gvk"
 	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test"
 	"istio.io/istio/pkg/test/util/assert"
 	"istio.io/istio/pkg/util/protomarshal"
 )
@@ -1256,6 +1258,34 @@ func TestTelemetryFilters(t *testing.T) {
 	}
 }
 
+func TestGatewayAuthzDryRunMetric(t *testing.T) {
+	statsConfig := func(class networking.ListenerClass, protocol networking.ListenerProtocol) *stats.PluginConfig {
+		cfg := &stats.PluginConfig{}
+		assert.NoError(t, generateStatsConfig(class, protocol, telemetryFilterConfig{Metrics: true}).UnmarshalTo(cfg))
+		return cfg
+	}
+	assert.Equal(t, len(statsConfig(networking.ListenerClassGateway, networking.ListenerProtocolHTTP).Definitions), 0)
+
+	test.SetForTest(t, &features.GatewayAuthzDryRunMetrics, true)
+	cfg := statsConfig(networking.ListenerClassGateway, networking.ListenerProtocolHTTP)
+	assert.Equal(t, len(cfg.Definitions), 1)
+	assert.Equal(t, cfg.Definitions[0].Name, AuthzDryRunMetric)
+	assert.Equal(t, cfg.Definitions[0].Value, "('envoy.filters.http.rbac' in metadata.filter_metadata && "+
+		"'istio_dry_run_allow_shadow_engine_result' in metadata.filter_metadata['envoy.filters.http.rbac'] && "+
+		"metadata.filter_metadata['envoy.filters.http.rbac']['istio_dry_run_allow_shadow_engine_result'] == 'denied') || "+
+		"('envoy.filters.http.rbac' in metadata.filter_metadata && "+
+		"'istio_dry_run_deny_shadow_engine_result' in metadata.filter_metadata['envoy.filters.http.rbac'] && "+
+		"metadata.filter_metadata['envoy.filters.http.rbac']['istio_dry_run_deny_shadow_engine_result'] == 'denied') ? 1 : 0")
+	assert.Equal(t, len(cfg.Metrics), 1)
+	assert.Equal(t, cfg.Metrics[0].Dimensions["route_name"], "xds.route_name")
+	assert.Equal(t, cfg.Metrics[0].Dimensions["source_principal"],
+		"has(connection.uri_san_peer_certificate) ? connection.uri_san_peer_certificate : 'unknown'")
+
+	// Only gateways report the metric, and only for HTTP
+	assert.Equal(t, len(statsConfig(networking.ListenerClassGateway, networking.ListenerProtocolTCP).Definitions), 0)
+	assert.Equal(t, len(statsConfig(networking.ListenerClassSidecarInbound, networking.ListenerProtocolHTTP).Definitions), 0)
+}
+
 func TestGetInterval(t *testing.T) {
 	cases := []struct {
 		name              string
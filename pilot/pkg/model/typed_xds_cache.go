@@ -17,6 +17,7 @@ package model
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
@@ -53,6 +54,12 @@ var (
 		monitoring.WithEnabled(enableStats),
 	)
 
+	xdsCacheBytes = monitoring.NewGauge(
+		"xds_cache_bytes",
+		"Current approximate size, in bytes, of the resources held by the xds caches",
+		monitoring.WithEnabled(enableStats),
+	)
+
 	dependentConfigSize = monitoring.NewGauge(
 		"xds_cache_dependent_config_size",
 		"Current size of dependent configs",
@@ -63,6 +70,10 @@ var (
 	xdsCacheMisses           = xdsCacheReads.With(typeTag.Value("miss"))
 	xdsCacheEvictionsOnClear = xdsCacheEvictions.With(typeTag.Value("clear"))
 	xdsCacheEvictionsOnSize  = xdsCacheEvictions.With(typeTag.Value("size"))
+	xdsCacheEvictionsOnBytes = xdsCacheEvictions.With(typeTag.Value("bytes"))
+
+	// cachedBytes is the approximate size of the resources held by all the xds caches
+	cachedBytes atomic.Int64
 )
 
 func hit() {
@@ -77,6 +88,18 @@ func size(cs int) {
 	xdsCacheSize.Record(float64(cs))
 }
 
+func addBytes(delta int) {
+	xdsCacheBytes.Record(float64(cachedBytes.Add(int64(delta))))
+}
+
+// resourceSize approximates the memory held by a cached resource with the size of its serialized form.
+func resourceSize(r *discovery.Resource) int {
+	if r == nil {
+		return 0
+	}
+	return len(r.Name) + len(r.GetResource().GetValue())
+}
+
 type CacheToken uint64
 
 type dependents interface {
@@ -109,6 +132,7 @@ type typedXdsCache[K comparable] interface {
 func newTypedXdsCache[K comparable]() typedXdsCache[K] {
 	cache := &lruCache[K]{
 		enableAssertions: features.EnableUnsafeAssertions,
+		maxBytes:         features.XDSCacheMaxBytes,
 		configIndex:      map[ConfigHash]sets.Set[K]{},
 		evictQueue:       make([]evictKeyConfigs[K], 0, 1000),
 	}
@@ -134,6 +158,13 @@ type lruCache[K comparable] struct {
 
 	// mark whether a key is evicted on Clear call, passively.
 	evictedOnClear bool
+
+	// bytes is the approximate size of the cached resources. If maxBytes is set, the least recently used entries
+	// are evicted to keep it under maxBytes.
+	bytes    int
+	maxBytes int
+	// mark whether a key is evicted to honor maxBytes.
+	evictedOnBytes bool
 }
 
 var _ typedXdsCache[uint64] = &lruCache[uint64]{}
@@ -179,11 +210,16 @@ func (l *lruCache[K]) recordDependentConfigSize() {
 
 // This is the callback passed to LRU, it will be called whenever a key is removed.
 func (l *lruCache[K]) onEvict(k K, v cacheValue) {
-	if l.evictedOnClear {
+	switch {
+	case l.evictedOnClear:
 		xdsCacheEvictionsOnClear.Increment()
-	} else {
+	case l.evictedOnBytes:
+		xdsCacheEvictionsOnBytes.Increment()
+	default:
 		xdsCacheEvictionsOnSize.Increment()
 	}
+	l.bytes -= v.size
+	addBytes(-v.size)
 
 	// async clearing indexes
 	l.evictQueue = append(l.evictQueue, evictKeyConfigs[K]{k, v.dependentConfigs})
@@ -262,23 +298,43 @@ func (l *lruCache[K]) Add(k K, entry dependents, pushReq *PushRequest, value *di
 	}
 
 	dependentConfigs := entry.DependentConfigs()
-	toWrite := cacheValue{value: value, token: token, dependentConfigs: dependentConfigs}
+	toWrite := cacheValue{value: value, token: token, dependentConfigs: dependentConfigs, size: resourceSize(value)}
 	l.store.Add(k, toWrite)
 	l.token = token
 	l.updateConfigIndex(k, dependentConfigs)
+	l.bytes += toWrite.size
+	addBytes(toWrite.size)
 
 	// we have to make sure we evict old entries with the same key
 	// to prevent leaking in the index maps
 	if f {
 		l.evictQueue = append(l.evictQueue, evictKeyConfigs[K]{k, cur.dependentConfigs})
+		l.bytes -= cur.size
+		addBytes(-cur.size)
 	}
+	l.evictOverBytes()
 	size(l.store.Len())
 }
 
+// evictOverBytes evicts the least recently used entries until the cached resources fit in maxBytes.
+// The most recent entry is always kept, even if it is larger than maxBytes on its own.
+func (l *lruCache[K]) evictOverBytes() {
+	if l.maxBytes <= 0 {
+		return
+	}
+	l.evictedOnBytes = true
+	for l.bytes > l.maxBytes && l.store.Len() > 1 {
+		l.store.RemoveOldest()
+	}
+	l.evictedOnBytes = false
+}
+
 type cacheValue struct {
 	value            *discovery.Resource
 	token            CacheToken
 	dependentConfigs []ConfigHash
+	// size is the approximate size of value, see resourceSize
+	size int
 }
 
 func (l *lruCache[K]) Get(key K) *discovery.Resource {
@@ -331,6 +387,8 @@ func (l *lruCache[K]) ClearAll() {
 	l.store = newLru(l.onEvict)
 	l.configIndex = map[ConfigHash]sets.Set[K]{}
 	l.evictQueue = l.evictQueue[:0:1000]
+	addBytes(-l.bytes)
+	l.bytes = 0
 	size(l.store.Len())
 }
 
@@ -15,10 +15,12 @@
 package model
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
 	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pkg/config/schema/kind"
@@ -381,3 +383,88 @@ func TestCacheClearAll(t *testing.T) {
 	assert.Equal(t, cache.indexLength(), 0)
 	assert.Equal(t, cache.store.Len(), 0)
 }
+
+func TestEvictOnBytes(t *testing.T) {
+	test.SetForTest(t, &features.XDSCacheMaxBytes, 250)
+	resource := func(name string) *discovery.Resource {
+		// 4 bytes of name and 96 bytes of value
+		return &discovery.Resource{Name: name, Resource: &anypb.Any{Value: make([]byte, 96)}}
+	}
+	service := func(name string) entry {
+		return entry{
+			key:              name,
+			dependentTypes:   []kind.Kind{kind.Service},
+			dependentConfigs: []ConfigHash{ConfigKey{Kind: kind.Service, Name: name, Namespace: "namespace"}.HashCode()},
+		}
+	}
+
+	c := newTypedXdsCache[uint64]()
+	cache := c.(*lruCache[uint64])
+	zeroTime := time.Time{}
+	for i, name := range []string{"key1", "key2"} {
+		e := service(name)
+		c.Add(e.Key(), e, &PushRequest{Start: zeroTime.Add(time.Duration(i + 1))}, resource(name))
+	}
+	assert.Equal(t, cache.bytes, 200)
+
+	// Reading key1 makes key2 the least recently used entry, evicted by the third entry
+	assert.Equal(t, c.Get(service("key1").Key()) != nil, true)
+	e := service("key3")
+	c.Add(e.Key(), e, &PushRequest{Start: zeroTime.Add(time.Duration(3))}, resource("key3"))
+	assert.Equal(t, cache.bytes, 200)
+	assert.Equal(t, cache.store.Len(), 2)
+	assert.Equal(t, c.Get(service("key2").Key()) == nil, true)
+
+	// Replacing an entry accounts for its new size
+	e = service("key1")
+	c.Add(e.Key(), e, &PushRequest{Start: zeroTime.Add(time.Duration(4))}, &discovery.Resource{Name: "key1"})
+	assert.Equal(t, cache.bytes, 104)
+
+	c.Clear(sets.New(ConfigKey{Kind: kind.Service, Name: "key3", Namespace: "namespace"}))
+	assert.Equal(t, cache.bytes, 4)
+	c.ClearAll()
+	assert.Equal(t, cache.bytes, 0)
+}
+
+// BenchmarkXdsCacheBytesBound fills the cache with route configurations, as generated for meshes with tens of
+// thousands of HTTPRoutes, and reports the cached bytes once the entries no longer fit in the byte budget.
+func BenchmarkXdsCacheBytesBound(b *testing.B) {
+	for _, tt := range []struct {
+		name     string
+		maxBytes int
+	}{
+		{"unbounded", 0},
+		{"64MiB", 64 << 20},
+		{"16MiB", 16 << 20},
+	} {
+		b.Run(tt.name, func(b *testing.B) {
+			test.SetForTest(b, &features.XDSCacheMaxSize, 100000)
+			test.SetForTest(b, &features.XDSCacheMaxBytes, tt.maxBytes)
+			routes := make([]*discovery.Resource, 50000)
+			entries := make([]entry, len(routes))
+			for i := range routes {
+				name := fmt.Sprintf("route-%d", i)
+				// Typical size of a route configuration with a few virtual hosts
+				routes[i] = &discovery.Resource{Name: name, Resource: &anypb.Any{Value: make([]byte, 2048)}}
+				entries[i] = entry{
+					key:              name,
+					dependentConfigs: []ConfigHash{ConfigKey{Kind: kind.HTTPRoute, Name: name, Namespace: "namespace"}.HashCode()},
+				}
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			var entriesLen, bytes int
+			for n := 0; n < b.N; n++ {
+				c := newTypedXdsCache[uint64]()
+				for i, e := range entries {
+					c.Add(e.Key(), e, &PushRequest{Start: time.Time{}.Add(time.Duration(i + 1))}, routes[i])
+				}
+				cache := c.(*lruCache[uint64])
+				entriesLen, bytes = cache.store.Len(), cache.bytes
+				c.ClearAll()
+			}
+			b.ReportMetric(float64(entriesLen), "entries")
+			b.ReportMetric(float64(bytes), "cached-bytes")
+		})
+	}
+}
@@ -723,6 +723,8 @@ type buildClusterOpts struct {
 	serviceRegistry provider.ID
 	// Indicates if the destionationRule has a workloadSelector
 	isDrWithSelector bool
+	// The retry budget of the gateway routes to the service, if any.
+	retryBudget *model.RetryBudget
 }
 
 func applyTCPKeepalive(mesh *meshconfig.MeshConfig, c *cluster.Cluster, tcp *networking.ConnectionPoolSettings_TCPSettings) {
@@ -42,6 +42,7 @@ import (
 	"istio.io/istio/pkg/config/host"
 	"istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/security"
+	"istio.io/istio/pkg/util/protomarshal"
 	"istio.io/istio/pkg/util/sets"
 )
 
@@ -100,6 +101,12 @@ type ClusterBuilder struct {
 	req                   *model.PushRequest
 	cache                 model.XdsCache
 	credentialSocketExist bool
+	// Connection pool and outlier detection settings applied to the clusters whose destination rule does not set
+	// them, as configured by the class of the gateway.
+	defaultTrafficPolicy    *networking.TrafficPolicy
+	defaultTrafficPolicyKey string
+	// Retry budgets of the destinations of the gateway routes, keyed by hostname.
+	retryBudgets map[host.Name]model.RetryBudget
 }
 
 // NewClusterBuilder builds an instance of ClusterBuilder.
@@ -135,6 +142,13 @@ func NewClusterBuilder(proxy *model.Proxy, req *model.PushRequest, cache model.X
 			cb.credentialSocketExist = true
 		}
 	}
+	if proxy.Type == model.Router && proxy.MergedGateway != nil && proxy.MergedGateway.DefaultTrafficPolicy != nil {
+		cb.defaultTrafficPolicy = proxy.MergedGateway.DefaultTrafficPolicy
+		cb.defaultTrafficPolicyKey, _ = protomarshal.ToJSON(cb.defaultTrafficPolicy)
+	}
+	if proxy.Type == model.Router && req != nil && req.Push != nil {
+		cb.retryBudgets = req.Push.GatewayRetryBudgets(proxy)
+	}
 	return cb
 }
 
@@ -154,6 +168,23 @@ func (cb *ClusterBuilder) sidecarProxy() bool {
 	return cb.proxyType == model.SidecarProxy
 }
 
+// retryBudget returns the retry budget of the gateway routes to the service, if any.
+func (cb *ClusterBuilder) retryBudget(hostname host.Name) *model.RetryBudget {
+	budget, f := cb.retryBudgets[hostname]
+	if !f {
+		return nil
+	}
+	return &budget
+}
+
+// retryBudgetKey returns the retry budget of the service for the cache key of its clusters.
+func (cb *ClusterBuilder) retryBudgetKey(hostname host.Name) string {
+	if budget, f := cb.retryBudgets[hostname]; f {
+		return budget.String()
+	}
+	return ""
+}
+
 func (cb *ClusterBuilder) buildSubsetCluster(
 	opts buildClusterOpts, destRule *config.Config, subset *networking.Subset, service *model.Service,
 	endpointBuilder *endpoints.EndpointBuilder,
@@ -235,6 +266,7 @@ func (cb *ClusterBuilder) applyDestinationRule(mc *clusterWrapper, clusterMode C
 		opts.meshExternal = service.MeshExternal
 		opts.serviceRegistry = service.Attributes.ServiceRegistry
 		opts.serviceMTLSMode = cb.req.Push.BestEffortInferServiceMTLSMode(destinationRule.GetTrafficPolicy(), service, port)
+		opts.retryBudget = cb.retryBudget(service.Hostname)
 	}
 
 	if destRule != nil {
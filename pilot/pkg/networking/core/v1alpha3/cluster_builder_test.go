@@ -29,6 +29,7 @@ import (
 	endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	http "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	xdstype "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -55,6 +56,7 @@ import (
 	"istio.io/istio/pkg/config/protocol"
 	"istio.io/istio/pkg/config/schema/gvk"
 	"istio.io/istio/pkg/network"
+	"istio.io/istio/pkg/ptr"
 	"istio.io/istio/pkg/security"
 	"istio.io/istio/pkg/test"
 	"istio.io/istio/pkg/test/util/assert"
@@ -2431,6 +2433,49 @@ func TestApplyConnectionPool(t *testing.T) {
 	}
 }
 
+func TestApplyRetryBudget(t *testing.T) {
+	cg := NewConfigGenTest(t, TestOptions{})
+	cb := NewClusterBuilder(cg.SetupProxy(nil), &model.PushRequest{Push: cg.PushContext()}, nil)
+	cb.retryBudgets = map[host.Name]model.RetryBudget{
+		"budget.default.svc.cluster.local":       {Percent: 20, MinConcurrency: ptr.Of[uint32](5)},
+		"percent-only.default.svc.cluster.local": {Percent: 10},
+	}
+
+	cases := []struct {
+		hostname host.Name
+		want     *cluster.CircuitBreakers_Thresholds_RetryBudget
+		wantKey  string
+	}{
+		{
+			hostname: "budget.default.svc.cluster.local",
+			want: &cluster.CircuitBreakers_Thresholds_RetryBudget{
+				BudgetPercent:       &xdstype.Percent{Value: 20},
+				MinRetryConcurrency: &wrappers.UInt32Value{Value: 5},
+			},
+			wantKey: "20/5",
+		},
+		{
+			// Envoy's default min_retry_concurrency applies
+			hostname: "percent-only.default.svc.cluster.local",
+			want:     &cluster.CircuitBreakers_Thresholds_RetryBudget{BudgetPercent: &xdstype.Percent{Value: 10}},
+			wantKey:  "10",
+		},
+		{
+			hostname: "other.default.svc.cluster.local",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(string(tt.hostname), func(t *testing.T) {
+			mc := &clusterWrapper{cluster: &cluster.Cluster{Name: "foo"}}
+			cb.applyConnectionPool(cb.req.Push.Mesh, mc, &networking.ConnectionPoolSettings{})
+			applyRetryBudget(mc.cluster, cb.retryBudget(tt.hostname))
+
+			assert.Equal(t, mc.cluster.CircuitBreakers.Thresholds[0].RetryBudget, tt.want)
+			assert.Equal(t, cb.retryBudgetKey(tt.hostname), tt.wantKey)
+		})
+	}
+}
+
 func TestBuildExternalSDSClusters(t *testing.T) {
 	proxy := &model.Proxy{
 		Metadata: &model.NodeMetadata{
@@ -46,6 +46,10 @@ type clusterCache struct {
 	proxyView       model.ProxyView
 	metadataCerts   *metadataCerts // metadata certificates of proxy
 	endpointBuilder *endpoints.EndpointBuilder
+	// default connection pool and outlier detection of the gateway class of the proxy
+	defaultTrafficPolicy string
+	// retry budget of the gateway routes to the service
+	retryBudget string
 
 	// service attributes
 	http2          bool // http2 identifies if the cluster is for an http2 service
@@ -97,6 +101,11 @@ func (t *clusterCache) Key() any {
 	}
 	h.Write(Separator)
 
+	h.Write([]byte(t.defaultTrafficPolicy))
+	h.Write(Separator)
+	h.Write([]byte(t.retryBudget))
+	h.Write(Separator)
+
 	if t.service != nil {
 		h.Write([]byte(t.service.Hostname))
 		h.Write(Slash)
@@ -205,5 +214,8 @@ func buildClusterKey(service *model.Service, port *model.Port, cb *ClusterBuilde
 		peerAuthVersion: cb.req.Push.AuthnPolicies.GetVersion(),
 		serviceAccounts: cb.req.Push.ServiceAccounts(service.Hostname, service.Attributes.Namespace, port.Port),
 		endpointBuilder: eb,
+		// Gateways of different classes may get different settings for the same cluster
+		defaultTrafficPolicy: cb.defaultTrafficPolicyKey,
+		retryBudget:          cb.retryBudgetKey(service.Hostname),
 	}
 }
@@ -40,6 +40,15 @@ import (
 // which can be called for both outbound and inbound cluster, but only connection pool will be applied to inbound cluster.
 func (cb *ClusterBuilder) applyTrafficPolicy(opts buildClusterOpts) {
 	connectionPool, outlierDetection, loadBalancer, tls := selectTrafficPolicyComponents(opts.policy)
+	if opts.direction != model.TrafficDirectionInbound && cb.defaultTrafficPolicy != nil {
+		// Fall back to the defaults of the gateway class for the settings the destination rule does not set
+		if connectionPool == nil {
+			connectionPool = cb.defaultTrafficPolicy.ConnectionPool
+		}
+		if outlierDetection == nil {
+			outlierDetection = cb.defaultTrafficPolicy.OutlierDetection
+		}
+	}
 	// Connection pool settings are applicable for both inbound and outbound clusters.
 	if connectionPool == nil {
 		connectionPool = &networking.ConnectionPoolSettings{}
@@ -47,6 +56,7 @@ func (cb *ClusterBuilder) applyTrafficPolicy(opts buildClusterOpts) {
 	cb.applyConnectionPool(opts.mesh, opts.mutable, connectionPool)
 	if opts.direction != model.TrafficDirectionInbound {
 		cb.applyH2Upgrade(opts.mutable, opts.port, opts.mesh, connectionPool)
+		applyRetryBudget(opts.mutable.cluster, opts.retryBudget)
 		applyOutlierDetection(opts.mutable.cluster, outlierDetection)
 		applyLoadBalancer(opts.mutable.cluster, loadBalancer, opts.port, cb.locality, cb.proxyLabels, opts.mesh)
 		if opts.clusterMode != SniDnatClusterMode {
@@ -316,6 +326,21 @@ func setSlowStartConfig(dur *durationpb.Duration) *cluster.Cluster_SlowStartConf
 	}
 }
 
+// applyRetryBudget limits the concurrent retries of the cluster to the budget set by the gateway routes. When set, the
+// budget takes precedence over max_retries.
+func applyRetryBudget(c *cluster.Cluster, budget *model.RetryBudget) {
+	if budget == nil || c.CircuitBreakers == nil || len(c.CircuitBreakers.Thresholds) == 0 {
+		return
+	}
+	retryBudget := &cluster.CircuitBreakers_Thresholds_RetryBudget{
+		BudgetPercent: &xdstype.Percent{Value: budget.Percent},
+	}
+	if budget.MinConcurrency != nil {
+		retryBudget.MinRetryConcurrency = &wrapperspb.UInt32Value{Value: *budget.MinConcurrency}
+	}
+	c.CircuitBreakers.Thresholds[0].RetryBudget = retryBudget
+}
+
 // getDefaultCircuitBreakerThresholds returns a copy of the default circuit breaker thresholds for the given traffic direction.
 func getDefaultCircuitBreakerThresholds() *cluster.CircuitBreakers_Thresholds {
 	return &cluster.CircuitBreakers_Thresholds{
@@ -251,6 +251,12 @@ func (configgen *ConfigGeneratorImpl) buildGatewayTCPBasedFilterChains(
 		port := &networking.Port{Number: port.Number, Protocol: port.Protocol}
 		httpFilterChainOpts := configgen.createGatewayHTTPFilterChainOpts(builder.node, port, nil, serversForPort.RouteName,
 			proxyConfig, istionetworking.ListenerProtocolTCP, builder.push)
+		// The plain text servers cannot be told apart before the HTTP connection manager, so the IP access policies of
+		// all of them apply to the shared filter chain. The Gateway API listeners with a different policy than the one
+		// owning the chain are rejected, so the servers normally all have the same policy.
+		httpFilterChainOpts.networkFilters = append(buildGatewayIPAccessFilters(mergedGateway, serversForPort.Servers...),
+			httpFilterChainOpts.networkFilters...)
+		applyGatewayForwardedHeadersPolicy(httpFilterChainOpts.httpOpts.connectionManager, mergedGateway, serversForPort.Servers...)
 		// In HTTP, we need to have RBAC, etc. upfront so that they can enforce policies immediately
 		httpFilterChainOpts.networkFilters = extension.PopAppendNetwork(httpFilterChainOpts.networkFilters, wasm, extensions.PluginPhase_AUTHN)
 		httpFilterChainOpts.networkFilters = extension.PopAppendNetwork(httpFilterChainOpts.networkFilters, wasm, extensions.PluginPhase_AUTHZ)
@@ -269,6 +275,8 @@ func (configgen *ConfigGeneratorImpl) buildGatewayTCPBasedFilterChains(
 				// This is a HTTPS server, where we are doing TLS termination. Build a http connection manager with TLS context
 				httpFilterChainOpts := configgen.createGatewayHTTPFilterChainOpts(builder.node, server.Port, server,
 					routeName, proxyConfig, istionetworking.TransportProtocolTCP, builder.push)
+				httpFilterChainOpts.networkFilters = append(buildGatewayIPAccessFilters(mergedGateway, server), httpFilterChainOpts.networkFilters...)
+				applyGatewayForwardedHeadersPolicy(httpFilterChainOpts.httpOpts.connectionManager, mergedGateway, server)
 				// In HTTP, we need to have RBAC, etc. upfront so that they can enforce policies immediately
 				httpFilterChainOpts.networkFilters = extension.PopAppendNetwork(httpFilterChainOpts.networkFilters, wasm, extensions.PluginPhase_AUTHN)
 				httpFilterChainOpts.networkFilters = extension.PopAppendNetwork(httpFilterChainOpts.networkFilters, wasm, extensions.PluginPhase_AUTHZ)
@@ -282,6 +290,11 @@ func (configgen *ConfigGeneratorImpl) buildGatewayTCPBasedFilterChains(
 				// This is the case of TCP or PASSTHROUGH.
 				tcpChainOpts := builder.createGatewayTCPFilterChainOpts(
 					server, port.Number, mergedGateway.GatewayNameForServer[server], tlsHostsByPort)
+				if ipAccess := buildGatewayIPAccessFilters(mergedGateway, server); len(ipAccess) > 0 {
+					for _, chain := range tcpChainOpts {
+						chain.networkFilters = append(append([]*listener.Filter{}, ipAccess...), chain.networkFilters...)
+					}
+				}
 				opts.filterChainOpts = append(opts.filterChainOpts, tcpChainOpts...)
 			}
 		}
@@ -303,8 +316,10 @@ func (configgen *ConfigGeneratorImpl) buildGatewayHTTP3FilterChains(
 		// Here it is assumed that this HTTP/3 server is a mirror of an existing HTTPS
 		// server. So the same route name would be reused instead of creating new one.
 		routeName := mergedGateway.TLSServerInfo[server].RouteName
-		quicFilterChainOpts = append(quicFilterChainOpts, configgen.createGatewayHTTPFilterChainOpts(builder.node, server.Port, server,
-			routeName, proxyConfig, istionetworking.TransportProtocolQUIC, builder.push))
+		quicOpts := configgen.createGatewayHTTPFilterChainOpts(builder.node, server.Port, server,
+			routeName, proxyConfig, istionetworking.TransportProtocolQUIC, builder.push)
+		applyGatewayForwardedHeadersPolicy(quicOpts.httpOpts.connectionManager, mergedGateway, server)
+		quicFilterChainOpts = append(quicFilterChainOpts, quicOpts)
 	}
 	opts.filterChainOpts = quicFilterChainOpts
 }
@@ -762,7 +777,14 @@ func buildGatewayListenerTLSContext(
 	}
 
 	server.Tls.CipherSuites = security.FilterCipherSuites(server.Tls.CipherSuites)
-	return BuildListenerTLSContext(server.Tls, proxy, mesh, transportProtocol, gateway.IsTCPServerWithTLSTermination(server))
+	ctx := BuildListenerTLSContext(server.Tls, proxy, mesh, transportProtocol, gateway.IsTCPServerWithTLSTermination(server))
+	// ALPN protocols set on the listener of a Kubernetes Gateway override the defaults. QUIC always advertises HTTP/3.
+	if transportProtocol == istionetworking.TransportProtocolTCP && proxy.MergedGateway != nil {
+		if info := proxy.MergedGateway.TLSServerInfo[server]; info != nil && len(info.ALPNProtocols) > 0 {
+			ctx.CommonTlsContext.AlpnProtocols = info.ALPNProtocols
+		}
+	}
+	return ctx
 }
 
 func convertTLSProtocol(in networking.ServerTLSSettings_TLSProtocol, defaultTLSProtocol tls.TlsParameters_TlsProtocol) tls.TlsParameters_TlsProtocol {
@@ -0,0 +1,50 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	meshconfig "istio.io/api/mesh/v1alpha1"
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/networking/util"
+)
+
+// applyGatewayForwardedHeadersPolicy overrides the handling of the forwarded headers by a gateway connection manager
+// with the policy of the servers sharing its filter chain. The plain text servers of a port share a connection
+// manager: the Gateway API listeners with a different policy than the one owning the chain are rejected, so the servers
+// normally all have the same policy, and the first one found applies.
+func applyGatewayForwardedHeadersPolicy(cm *hcm.HttpConnectionManager, mergedGateway *model.MergedGateway,
+	servers ...*networking.Server,
+) {
+	if cm == nil || mergedGateway == nil {
+		return
+	}
+	for _, server := range servers {
+		policy := mergedGateway.ForwardedHeadersPolicies[server]
+		if policy == nil {
+			continue
+		}
+		if policy.NumTrustedProxies != nil {
+			cm.XffNumTrustedHops = *policy.NumTrustedProxies
+		}
+		if details := policy.ClientCertDetails(); details != meshconfig.ForwardClientCertDetails_UNDEFINED {
+			cm.ForwardClientCertDetails = util.MeshConfigToEnvoyForwardClientCertDetails(details)
+		}
+		cm.SkipXffAppend = policy.SkipXFFAppend
+		return
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"testing"
+
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestApplyGatewayForwardedHeadersPolicy(t *testing.T) {
+	tenant := &networking.Server{Port: &networking.Port{Number: 80}}
+	preserve := &networking.Server{Port: &networking.Port{Number: 80}}
+	open := &networking.Server{Port: &networking.Port{Number: 80}}
+	mgw := &model.MergedGateway{ForwardedHeadersPolicies: map[*networking.Server]*model.ForwardedHeadersPolicy{
+		tenant:   {NumTrustedProxies: ptr.Of(uint32(2)), ForwardClientCertDetails: "FORWARD_ONLY"},
+		preserve: {SkipXFFAppend: true},
+	}}
+	defaults := func() *hcm.HttpConnectionManager {
+		return &hcm.HttpConnectionManager{
+			XffNumTrustedHops:        1,
+			ForwardClientCertDetails: hcm.HttpConnectionManager_SANITIZE_SET,
+		}
+	}
+
+	// Servers without a policy keep the gateway topology
+	cm := defaults()
+	applyGatewayForwardedHeadersPolicy(cm, mgw, open)
+	assert.Equal(t, cm, defaults())
+
+	cm = defaults()
+	applyGatewayForwardedHeadersPolicy(cm, mgw, open, tenant, preserve)
+	assert.Equal(t, cm.XffNumTrustedHops, uint32(2))
+	assert.Equal(t, cm.ForwardClientCertDetails, hcm.HttpConnectionManager_FORWARD_ONLY)
+	assert.Equal(t, cm.SkipXffAppend, false)
+
+	// Unset fields of the policy keep the gateway topology
+	cm = defaults()
+	applyGatewayForwardedHeadersPolicy(cm, mgw, preserve)
+	assert.Equal(t, cm.XffNumTrustedHops, uint32(1))
+	assert.Equal(t, cm.ForwardClientCertDetails, hcm.HttpConnectionManager_SANITIZE_SET)
+	assert.Equal(t, cm.SkipXffAppend, true)
+}
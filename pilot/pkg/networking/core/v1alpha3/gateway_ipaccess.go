@@ -0,0 +1,80 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	rbacpb "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	rbactcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/networking/util"
+	"istio.io/istio/pilot/pkg/util/protoconv"
+	"istio.io/istio/pkg/util/sets"
+	"istio.io/istio/pkg/wellknown"
+)
+
+// ipAccessStatPrefix is the stat prefix of the RBAC filters enforcing the IP access policies, so the connections they
+// reject are told apart from the ones rejected by authorization policies.
+const ipAccessStatPrefix = "ip_access."
+
+// buildGatewayIPAccessFilters builds the network RBAC filters enforcing the IP access policies of servers sharing a
+// filter chain. The policies are all enforced, so a connection is only accepted if each of them accepts it; a policy
+// set on several servers is only enforced once. The filters match the remote address of the connection, which is the
+// one announced by the PROXY protocol if enabled.
+func buildGatewayIPAccessFilters(mergedGateway *model.MergedGateway, servers ...*networking.Server) []*listener.Filter {
+	var filters []*listener.Filter
+	applied := sets.New[string]()
+	for _, server := range servers {
+		policy := mergedGateway.IPAccessPolicies[server]
+		if policy.IsEmpty() || applied.InsertContains(policy.String()) {
+			continue
+		}
+		if len(policy.Deny) > 0 {
+			filters = append(filters, buildIPAccessFilter(rbacpb.RBAC_DENY, "deny", policy.Deny))
+		}
+		if len(policy.Allow) > 0 {
+			filters = append(filters, buildIPAccessFilter(rbacpb.RBAC_ALLOW, "allow", policy.Allow))
+		}
+	}
+	return filters
+}
+
+func buildIPAccessFilter(action rbacpb.RBAC_Action, name string, cidrs []string) *listener.Filter {
+	principals := make([]*rbacpb.Principal, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		// The CIDRs are validated when converting the policies
+		if r := util.ConvertAddressToCidr(cidr); r != nil {
+			principals = append(principals, &rbacpb.Principal{Identifier: &rbacpb.Principal_RemoteIp{RemoteIp: r}})
+		}
+	}
+	rbac := &rbactcp.RBAC{
+		Rules: &rbacpb.RBAC{
+			Action: action,
+			Policies: map[string]*rbacpb.Policy{
+				name: {
+					Permissions: []*rbacpb.Permission{{Rule: &rbacpb.Permission_Any{Any: true}}},
+					Principals:  []*rbacpb.Principal{{Identifier: &rbacpb.Principal_OrIds{OrIds: &rbacpb.Principal_Set{Ids: principals}}}},
+				},
+			},
+		},
+		StatPrefix: ipAccessStatPrefix,
+	}
+	return &listener.Filter{
+		Name:       wellknown.RoleBasedAccessControl,
+		ConfigType: &listener.Filter_TypedConfig{TypedConfig: protoconv.MessageToAny(rbac)},
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha3
+
+import (
+	"testing"
+
+	rbacpb "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	rbactcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/test/util/assert"
+	"istio.io/istio/pkg/wellknown"
+)
+
+func TestBuildGatewayIPAccessFilters(t *testing.T) {
+	restricted := &networking.Server{Port: &networking.Port{Number: 80}}
+	allowOnly := &networking.Server{Port: &networking.Port{Number: 80}}
+	open := &networking.Server{Port: &networking.Port{Number: 80}}
+	sameAllowOnly := &networking.Server{Port: &networking.Port{Number: 80}}
+	mgw := &model.MergedGateway{IPAccessPolicies: map[*networking.Server]*model.IPAccessPolicy{
+		restricted:    {Allow: []string{"10.0.0.0/8"}, Deny: []string{"10.1.0.0/16", "10.2.0.1"}},
+		allowOnly:     {Allow: []string{"192.168.0.0/16"}},
+		sameAllowOnly: {Allow: []string{"192.168.0.0/16"}},
+	}}
+
+	assert.Equal(t, len(buildGatewayIPAccessFilters(mgw, open)), 0)
+	assert.Equal(t, len(buildGatewayIPAccessFilters(&model.MergedGateway{}, restricted)), 0)
+
+	// The policies of servers sharing a filter chain are all enforced, once
+	filters := buildGatewayIPAccessFilters(mgw, restricted, open, allowOnly, sameAllowOnly)
+	assert.Equal(t, len(filters), 3)
+	rules := make([]*rbacpb.RBAC, 0, len(filters))
+	for _, f := range filters {
+		assert.Equal(t, f.Name, wellknown.RoleBasedAccessControl)
+		rbac := &rbactcp.RBAC{}
+		assert.NoError(t, f.GetTypedConfig().UnmarshalTo(rbac))
+		assert.Equal(t, rbac.StatPrefix, ipAccessStatPrefix)
+		rules = append(rules, rbac.Rules)
+	}
+	remoteIPs := func(rules *rbacpb.RBAC, policy string) []string {
+		var out []string
+		for _, p := range rules.Policies[policy].Principals[0].GetOrIds().Ids {
+			out = append(out, p.GetRemoteIp().AddressPrefix)
+		}
+		return out
+	}
+	assert.Equal(t, rules[0].Action, rbacpb.RBAC_DENY)
+	assert.Equal(t, remoteIPs(rules[0], "deny"), []string{"10.1.0.0", "10.2.0.1"})
+	assert.Equal(t, rules[0].Policies["deny"].Principals[0].GetOrIds().Ids[1].GetRemoteIp().PrefixLen.GetValue(), uint32(32))
+	assert.Equal(t, rules[1].Action, rbacpb.RBAC_ALLOW)
+	assert.Equal(t, remoteIPs(rules[1], "allow"), []string{"10.0.0.0"})
+	assert.Equal(t, rules[2].Action, rbacpb.RBAC_ALLOW)
+	assert.Equal(t, remoteIPs(rules[2], "allow"), []string{"192.168.0.0"})
+}
@@ -530,6 +530,10 @@ func applyHTTPRouteDestination(
 	hashByDestination DestinationHashMap,
 ) {
 	policy := in.Retries
+	if policy == nil && node.MergedGateway != nil && node.MergedGateway.DefaultRetryPolicy != nil {
+		// No VS policy set, use the defaults of the gateway class
+		policy = node.MergedGateway.DefaultRetryPolicy
+	}
 	if policy == nil {
 		// No VS policy set, use mesh defaults
 		policy = mesh.GetDefaultHttpRetryPolicy()
@@ -373,7 +373,7 @@ func ApplyCredentialSDSToServerCommonTLSContext(tlsContext *tls.CommonTlsContext
 			CombinedValidationContext: &tls.CommonTlsContext_CombinedCertificateValidationContext{
 				DefaultValidationContext: defaultValidationContext,
 				ValidationContextSdsSecretConfig: ConstructSdsSecretConfigForCredential(
-					tlsOpts.CredentialName+SdsCaSuffix, credentialSocketExist),
+					credentials.ToCaResourceName(tlsOpts.CredentialName, tlsOpts.CaCertificates), credentialSocketExist),
 			},
 		}
 	} else if len(tlsOpts.SubjectAltNames) > 0 {
@@ -15,6 +15,7 @@
 package aggregate
 
 import (
+	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/serviceregistry"
 )
 
@@ -29,3 +30,16 @@ func (c *Controller) Unwrap(registry serviceregistry.Instance) serviceregistry.I
 	}
 	return registry
 }
+
+var _ model.FederatedGatewayDiscovery = &Controller{}
+
+// FederatedGateways returns the Gateways advertised by the registries of the federated peer meshes.
+func (c *Controller) FederatedGateways() []model.FederatedGateway {
+	var gateways []model.FederatedGateway
+	for _, r := range c.GetRegistries() {
+		if d, ok := c.Unwrap(r).(model.FederatedGatewayDiscovery); ok {
+			gateways = append(gateways, d.FederatedGateways()...)
+		}
+	}
+	return gateways
+}
@@ -70,6 +70,7 @@ type Controller struct {
 	discoveryURL         string
 	discoveryServiceName string
 	useDirectCalls       bool
+	tenant               bool
 	namespace            string
 	localClusterID       cluster.ID
 	localNetworkID       network.ID
@@ -99,6 +100,8 @@ type Controller struct {
 	gatewayStore   []model.NetworkGateway
 	egressGateways []model.NetworkGateway
 	egressSAs      []string
+	// gateways are the Gateway API Gateways exported by the peer mesh
+	gateways []*federationmodel.GatewayMessage
 
 	lastMessage *federationmodel.ServiceListMessage
 	started     int32
@@ -265,6 +268,7 @@ func (c *Controller) UpdatePeerConfig(peerConfig *v1.ServiceMeshPeer) {
 		c.defaultDomainSuffix = defaultDomainSuffixForMesh(peerConfig)
 		c.namespace = peerConfig.Namespace
 		c.useDirectCalls = peerConfig.Spec.Security.AllowDirectOutbound
+		c.tenant = common.IsTenantPeer(peerConfig)
 		if c.egressName != peerConfig.Spec.Gateways.Egress.Name {
 			c.egressName = peerConfig.Spec.Gateways.Egress.Name
 			c.egressService = fmt.Sprintf("%s.%s.%s",
@@ -382,7 +386,7 @@ func (c *Controller) convertToLocalService(s *federationmodel.ServiceMessage,
 	}
 	// XXX: make this configurable
 	serviceVisibility := visibility.Public
-	return c.createService(createServiceOptions{
+	opts := createServiceOptions{
 		service:           s,
 		serviceName:       serviceName,
 		serviceNamespace:  serviceNamespace,
@@ -393,7 +397,14 @@ func (c *Controller) convertToLocalService(s *federationmodel.ServiceMessage,
 		networkGateways:   c.egressGateways,
 		sas:               c.egressSAs,
 		locality:          c.locality,
-	})
+	}
+	if c.tenant && len(s.Addresses) > 0 {
+		// The services of another tenant are reachable in the cluster, and called with the identities of its workloads
+		opts.networkGateways = nil
+		opts.addresses = s.Addresses
+		opts.sas = s.ServiceAccounts
+	}
+	return c.createService(opts)
 }
 
 type createServiceOptions struct {
@@ -407,6 +418,8 @@ type createServiceOptions struct {
 	networkGateways   []model.NetworkGateway
 	sas               []string
 	locality          *v1.ImportedServiceLocality
+	// addresses, if set, are the addresses of the service called directly on its ports, instead of the gateways
+	addresses []string
 }
 
 func (c *Controller) createService(opts createServiceOptions) (*model.Service, []*model.ServiceInstance) {
@@ -447,7 +460,14 @@ func (c *Controller) createService(opts createServiceOptions) (*model.Service, [
 		localityLabel = fmt.Sprintf("%s/%s/%s", opts.locality.Region, opts.locality.Zone, opts.locality.Subzone)
 	}
 	for _, port := range svc.Ports {
-		for gatewayIndex, networkGateway := range opts.networkGateways {
+		networkGateways := opts.networkGateways
+		if len(opts.addresses) > 0 {
+			networkGateways = make([]model.NetworkGateway, 0, len(opts.addresses))
+			for _, address := range opts.addresses {
+				networkGateways = append(networkGateways, model.NetworkGateway{Addr: address, Port: uint32(port.Port)})
+			}
+		}
+		for gatewayIndex, networkGateway := range networkGateways {
 			c.logger.Debugf("adding endpoint for imported service: addr=%s, port=%d, host=%s",
 				networkGateway.Addr, networkGateway.Port, svc.Hostname)
 			instance := &model.ServiceInstance{
@@ -1115,6 +1135,7 @@ func (c *Controller) resync() uint64 {
 	c.updateGateways()
 	if svcList != nil {
 		c.convertServices(svcList)
+		c.updateFederatedGateways(svcList.Gateways)
 		c.statusHandler.FullSyncComplete()
 		return svcList.Checksum
 	}
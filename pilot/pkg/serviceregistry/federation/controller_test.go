@@ -18,6 +18,10 @@ import (
 	"testing"
 
 	v1 "maistra.io/api/federation/v1"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/servicemesh/federation/common"
+	federationmodel "istio.io/istio/pkg/servicemesh/federation/model"
 )
 
 // TestMergeLocality tests the federation mergeLocality function
@@ -60,3 +64,39 @@ func TestMergeLocality(t *testing.T) {
 			merged.Subzone, "subzone2")
 	}
 }
+
+// TestConvertTenantService tests that the services imported from a tenant peer are called on their own addresses
+func TestConvertTenantService(t *testing.T) {
+	c := &Controller{
+		logger:            common.Logger,
+		clusterID:         "tenant-b",
+		localDomainSuffix: "svc.cluster.local",
+		egressGateways:    []model.NetworkGateway{{Addr: "10.0.0.1", Port: 15443}},
+		egressSAs:         []string{"spiffe://cluster.local/ns/tenant-a/sa/egress"},
+	}
+	s := &federationmodel.ServiceMessage{
+		ServiceKey:      federationmodel.ServiceKey{Name: "ratings", Namespace: "bookinfo", Hostname: "ratings.bookinfo.svc.tenant-b-exports.local"},
+		ServicePorts:    []*federationmodel.ServicePort{{Name: "http", Port: 9080, Protocol: "HTTP"}},
+		ServiceAccounts: []string{"spiffe://tenant-b.local/ns/bookinfo/sa/ratings"},
+		Addresses:       []string{"172.30.0.10"},
+	}
+	importedName := federationmodel.ServiceKey{Name: "ratings", Namespace: "bookinfo", Hostname: "ratings.bookinfo.svc.cluster.local"}
+
+	// Without the tenant annotation, the service is called through the egress gateway
+	_, instances := c.convertToLocalService(s, importedName)
+	if len(instances) != 1 || instances[0].Endpoint.Address != "10.0.0.1" {
+		t.Fatalf("expected the service to be called through the egress gateway, got %v", instances)
+	}
+
+	c.tenant = true
+	svc, instances := c.convertToLocalService(s, importedName)
+	if len(instances) != 1 || instances[0].Endpoint.Address != "172.30.0.10" || instances[0].Endpoint.EndpointPort != 9080 {
+		t.Fatalf("expected the tenant service to be called on its address, got %v", instances)
+	}
+	if instances[0].Endpoint.TLSMode != model.IstioMutualTLSModeLabel {
+		t.Fatalf("expected the tenant service to be called with mutual TLS, got %v", instances[0].Endpoint.TLSMode)
+	}
+	if len(svc.ServiceAccounts) != 1 || svc.ServiceAccounts[0] != s.ServiceAccounts[0] {
+		t.Fatalf("expected the service accounts of the tenant workloads, got %v", svc.ServiceAccounts)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package federation
+
+import (
+	"reflect"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config/schema/kind"
+	federationmodel "istio.io/istio/pkg/servicemesh/federation/model"
+)
+
+var _ model.FederatedGatewayDiscovery = &Controller{}
+
+// FederatedGateways returns the Gateways exported by the peer mesh over its discovery channel.
+func (c *Controller) FederatedGateways() []model.FederatedGateway {
+	c.storeLock.RLock()
+	defer c.storeLock.RUnlock()
+	gateways := make([]model.FederatedGateway, 0, len(c.gateways))
+	for _, gw := range c.gateways {
+		fgw := model.FederatedGateway{
+			Mesh:      string(c.clusterID),
+			Name:      gw.Name,
+			Namespace: gw.Namespace,
+		}
+		for _, l := range gw.Listeners {
+			fgw.Listeners = append(fgw.Listeners, model.FederatedGatewayListener{
+				Name:     l.Name,
+				Hostname: l.Hostname,
+				Port:     l.Port,
+				Protocol: l.Protocol,
+			})
+		}
+		gateways = append(gateways, fgw)
+	}
+	return gateways
+}
+
+// updateFederatedGateways stores the Gateways exported by the peer mesh. If they changed, the routes attached to
+// them are reconciled by a push.
+// store has to be Lock()ed
+func (c *Controller) updateFederatedGateways(gateways []*federationmodel.GatewayMessage) {
+	if reflect.DeepEqual(gateways, c.gateways) {
+		return
+	}
+	updatedConfigs := map[model.ConfigKey]struct{}{}
+	for _, gw := range append(append([]*federationmodel.GatewayMessage{}, c.gateways...), gateways...) {
+		updatedConfigs[model.ConfigKey{Kind: kind.KubernetesGateway, Name: gw.Name, Namespace: gw.Namespace}] = struct{}{}
+	}
+	c.gateways = gateways
+	c.logger.Debugf("pushing XDS config for gateways: %+v", updatedConfigs)
+	c.xdsUpdater.ConfigUpdate(&model.PushRequest{
+		Full:           true,
+		ConfigsUpdated: updatedConfigs,
+	})
+}
@@ -0,0 +1,181 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/namespace"
+)
+
+// AmbientEnrollmentConfigMapName returns the name of the ConfigMap where the control plane of the revision publishes the
+// dataplane mode of the namespaces.
+func AmbientEnrollmentConfigMapName(revision string) string {
+	if revision == "" || revision == "default" {
+		return constants.AmbientEnrollmentConfigMapName
+	}
+	return constants.AmbientEnrollmentConfigMapName + "-" + revision
+}
+
+// AmbientEnrollmentController publishes the dataplane mode of the namespaces of the mesh in a ConfigMap of the system
+// namespace. The CNI agent decides which pods are added to the ambient mesh from it, without relying on the labels
+// injection webhooks set, nor on watching the namespaces, which is not allowed with a member roll.
+type AmbientEnrollmentController struct {
+	kubeClient kube.Client
+	key        types.NamespacedName
+
+	queue controllers.Queue
+
+	configmaps kclient.Client[*v1.ConfigMap]
+	namespaces kclient.Client[*v1.Namespace]
+
+	// members holds the namespaces of the member roll, if any, in which case all the namespaces are not watched.
+	members                   *namespace.MemberNamespaces
+	discoveryNamespacesFilter namespace.DiscoveryNamespacesFilter
+}
+
+// NewAmbientEnrollmentController returns a controller publishing the enrollment ConfigMap of the revision.
+func NewAmbientEnrollmentController(kubeClient kube.Client, systemNamespace, revision string,
+	discoveryNamespacesFilter namespace.DiscoveryNamespacesFilter,
+) *AmbientEnrollmentController {
+	c := &AmbientEnrollmentController{
+		kubeClient:                kubeClient,
+		key:                       types.NamespacedName{Namespace: systemNamespace, Name: AmbientEnrollmentConfigMapName(revision)},
+		discoveryNamespacesFilter: discoveryNamespacesFilter,
+	}
+	c.queue = controllers.NewQueue("ambient enrollment controller",
+		controllers.WithReconciler(c.reconcile),
+		controllers.WithMaxAttempts(maxRetries))
+	enqueue := func() {
+		c.queue.Add(c.key)
+	}
+
+	c.configmaps = kclient.NewFiltered[*v1.ConfigMap](kubeClient, kclient.Filter{
+		Namespace:     systemNamespace,
+		FieldSelector: "metadata.name=" + c.key.Name,
+	})
+	// Restore the published ConfigMap if it is modified or deleted
+	c.configmaps.AddEventHandler(controllers.ObjectHandler(func(controllers.Object) {
+		enqueue()
+	}))
+
+	// With a member roll, the members are watched while they are in the member roll.
+	if mrc := kubeClient.GetMemberRollController(); mrc != nil {
+		c.members = namespace.NewMemberNamespaces(kubeClient.Kube(), mrc, "ambient-enrollment-controller")
+		c.members.AddHandler(func(string) {
+			enqueue()
+		})
+		return c
+	}
+
+	c.namespaces = kclient.New[*v1.Namespace](kubeClient)
+	c.namespaces.AddEventHandler(controllers.ObjectHandler(func(controllers.Object) {
+		enqueue()
+	}))
+	if discoveryNamespacesFilter != nil {
+		discoveryNamespacesFilter.AddHandler(func(string, model.Event) {
+			enqueue()
+		})
+	}
+	return c
+}
+
+// Run starts the AmbientEnrollmentController until a value is sent to stopCh.
+func (c *AmbientEnrollmentController) Run(stopCh <-chan struct{}) {
+	syncFuncs := []cache.InformerSynced{c.configmaps.HasSynced}
+	shutdownFuncs := []controllers.Shutdowner{c.configmaps}
+	// c.namespaces is not set when member roll exists
+	if c.namespaces != nil {
+		syncFuncs = append(syncFuncs, c.namespaces.HasSynced)
+		shutdownFuncs = append(shutdownFuncs, c.namespaces)
+	}
+	if !kube.WaitForCacheSync("ambient enrollment controller", stopCh, syncFuncs...) {
+		log.Error("Failed to sync ambient enrollment controller cache")
+		return
+	}
+
+	// Publish the enrollment even if no namespace is enrolled
+	c.queue.Add(c.key)
+	c.queue.Run(stopCh)
+	controllers.ShutdownAll(shutdownFuncs...)
+	if c.members != nil {
+		c.members.Shutdown()
+	}
+}
+
+func (c *AmbientEnrollmentController) reconcile(types.NamespacedName) error {
+	modes, err := c.namespaceModes()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(modes)
+	if err != nil {
+		return err
+	}
+	if cm := c.configmaps.Get(c.key.Name, c.key.Namespace); cm != nil && cm.Data[constants.AmbientEnrollmentNamespacesKey] == string(data) {
+		return nil
+	}
+	log.Infof("publishing the dataplane mode of %d namespaces in ConfigMap %s", len(modes), c.key)
+	_, err = kclient.CreateOrUpdate[*v1.ConfigMap](c.configmaps, &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      c.key.Name,
+			Namespace: c.key.Namespace,
+			Labels:    configMapLabel,
+		},
+		Data: map[string]string{constants.AmbientEnrollmentNamespacesKey: string(data)},
+	})
+	return err
+}
+
+// namespaceModes returns the dataplane mode of the namespaces of the mesh which set one.
+func (c *AmbientEnrollmentController) namespaceModes() (map[string]string, error) {
+	modes := map[string]string{}
+	addMode := func(ns *v1.Namespace) {
+		if mode, f := ns.Labels[constants.DataplaneMode]; f {
+			modes[ns.Name] = mode
+		}
+	}
+
+	if c.members != nil {
+		if !c.members.HasSynced() {
+			// Publishing without a member would remove its pods from the ambient mesh until it is synced
+			return nil, fmt.Errorf("the member namespaces are not synced yet")
+		}
+		for _, ns := range c.members.List() {
+			addMode(ns)
+		}
+		return modes, nil
+	}
+
+	for _, ns := range c.namespaces.List(metav1.NamespaceAll, labels.Everything()) {
+		if c.discoveryNamespacesFilter != nil && !c.discoveryNamespacesFilter.Filter(ns.Name) {
+			continue
+		}
+		addMode(ns)
+	}
+	return modes, nil
+}
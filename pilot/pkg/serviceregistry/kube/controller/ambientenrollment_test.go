@@ -0,0 +1,78 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+func TestAmbientEnrollmentConfigMapName(t *testing.T) {
+	cases := map[string]string{
+		"":        "istio-ambient-enrollment",
+		"default": "istio-ambient-enrollment",
+		"canary":  "istio-ambient-enrollment-canary",
+	}
+	for revision, want := range cases {
+		if got := AmbientEnrollmentConfigMapName(revision); got != want {
+			t.Errorf("AmbientEnrollmentConfigMapName(%q) = %q, want %q", revision, got, want)
+		}
+	}
+}
+
+func TestAmbientEnrollmentController(t *testing.T) {
+	client := kube.NewFakeClient()
+	t.Cleanup(client.Shutdown)
+	ec := NewAmbientEnrollmentController(client, "istio-system", "", nil)
+	stop := test.NewStop(t)
+	client.RunAndWait(stop)
+	go ec.Run(stop)
+	retry.UntilOrFail(t, ec.queue.HasSynced)
+
+	expectEnrollment := func(enrollment string) {
+		t.Helper()
+		expectConfigMap(t, ec.configmaps, constants.AmbientEnrollmentConfigMapName, "istio-system", map[string]string{
+			constants.AmbientEnrollmentNamespacesKey: enrollment,
+		})
+	}
+	expectEnrollment(`{}`)
+
+	createNamespace(t, client.Kube(), "foo", map[string]string{constants.DataplaneMode: constants.DataplaneModeAmbient})
+	createNamespace(t, client.Kube(), "bar", nil)
+	expectEnrollment(`{"foo":"ambient"}`)
+
+	updateNamespace(t, client.Kube(), "bar", map[string]string{constants.DataplaneMode: "none"})
+	expectEnrollment(`{"bar":"none","foo":"ambient"}`)
+
+	updateNamespace(t, client.Kube(), "foo", nil)
+	expectEnrollment(`{"bar":"none"}`)
+
+	// The published enrollment is restored when modified
+	if _, err := client.Kube().CoreV1().ConfigMaps("istio-system").Update(context.TODO(), &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: constants.AmbientEnrollmentConfigMapName, Namespace: "istio-system"},
+		Data:       map[string]string{constants.AmbientEnrollmentNamespacesKey: `{"foo":"ambient"}`},
+	}, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	expectEnrollment(`{"bar":"none"}`)
+}
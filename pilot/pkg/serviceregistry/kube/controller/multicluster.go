@@ -287,12 +287,20 @@ func (m *Multicluster) initializeCluster(cluster *multicluster.Cluster, kubeCont
 					AddRunFunction(func(leaderStop <-chan struct{}) {
 						log.Infof("starting namespace controller for cluster %s", cluster.ID)
 						nc := NewNamespaceController(client, m.caBundleWatcher, discoveryNamespacesFilter)
+						var ec *AmbientEnrollmentController
+						if features.EnableAmbientControllers && configCluster {
+							// The enrollment is published in the config cluster only, which the CNI agents of the primary cluster read
+							ec = NewAmbientEnrollmentController(client, options.SystemNamespace, m.revision, discoveryNamespacesFilter)
+						}
 						// Start informers again. This fixes the case where informers for namespace do not start,
 						// as we create them only after acquiring the leader lock
 						// Note: stop here should be the overall pilot stop, NOT the leader election stop. We are
 						// basically lazy loading the informer, if we stop it when we lose the lock we will never
 						// recreate it again.
 						client.RunAndWait(clusterStopCh)
+						if ec != nil {
+							go ec.Run(leaderStop)
+						}
 						nc.Run(leaderStop)
 					})
 				election.Run(clusterStopCh)
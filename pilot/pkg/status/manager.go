@@ -123,3 +123,8 @@ func (c *Controller) EnqueueStatusUpdateResource(context any, target Resource) {
 func (c *Controller) Delete(r Resource) {
 	c.workers.Delete(r)
 }
+
+// Pending returns the number of resources whose status update by this controller is not written yet.
+func (c *Controller) Pending() int {
+	return c.workers.Pending(c)
+}
@@ -39,6 +39,8 @@ type WorkerQueue interface {
 	Run(ctx context.Context)
 	// Delete a task
 	Delete(target Resource)
+	// Pending returns the number of queued tasks with an update of the controller
+	Pending(controller *Controller) int
 }
 
 type cacheEntry struct {
@@ -116,6 +118,19 @@ func (wq *WorkQueue) Length() int {
 	return len(wq.tasks)
 }
 
+// Pending returns the number of resources waiting for a status update by the controller.
+func (wq *WorkQueue) Pending(ctl *Controller) int {
+	wq.lock.Lock()
+	defer wq.lock.Unlock()
+	n := 0
+	for _, entry := range wq.cache {
+		if _, f := entry.perControllerStatus[ctl]; f {
+			n++
+		}
+	}
+	return n
+}
+
 func (wq *WorkQueue) Delete(target Resource) {
 	wq.lock.Lock()
 	defer wq.lock.Unlock()
@@ -156,6 +171,10 @@ func (wp *WorkerPool) Delete(target Resource) {
 	wp.q.Delete(target)
 }
 
+func (wp *WorkerPool) Pending(controller *Controller) int {
+	return wp.q.Pending(controller)
+}
+
 func (wp *WorkerPool) Push(target Resource, controller *Controller, context any) {
 	wp.q.Push(target, controller, context)
 	wp.maybeAddWorker()
@@ -274,6 +274,9 @@ func (s *DiscoveryServer) Stream(stream DiscoveryStream) error {
 	if !s.IsServerReady() {
 		return status.Error(codes.Unavailable, "server is not ready to serve discovery information")
 	}
+	if s.IsStandby() {
+		return status.Error(codes.Unavailable, "server is in warm standby")
+	}
 
 	ctx := stream.Context()
 	peerAddr := "0.0.0.0"
@@ -379,9 +382,11 @@ func (s *DiscoveryServer) shouldRespond(con *Connection, request *discovery.Disc
 		errCode := codes.Code(request.ErrorDetail.Code)
 		log.Warnf("ADS:%s: ACK ERROR %s %s:%s", stype, con.conID, errCode.String(), request.ErrorDetail.GetMessage())
 		incrementXDSRejects(request.TypeUrl, con.proxy.ID, errCode.String())
+		con.recordNack(request.TypeUrl, request.ResponseNonce)
 		if s.StatusGen != nil {
 			s.StatusGen.OnNack(con.proxy, request)
 		}
+		s.reportGatewayNack(con, request.TypeUrl, request.ErrorDetail.GetMessage())
 		return false, emptyResourceDelta
 	}
 
@@ -465,6 +470,7 @@ func (s *DiscoveryServer) shouldRespond(con *Connection, request *discovery.Disc
 		}
 
 		log.Debugf("ADS:%s: ACK %s %s %s", stype, con.conID, request.VersionInfo, request.ResponseNonce)
+		s.reportGatewayAck(con, request.TypeUrl, request.VersionInfo)
 		return false, emptyResourceDelta
 	}
 	log.Debugf("ADS:%s: RESOURCE CHANGE added %v removed %v %s %s %s", stype,
@@ -590,6 +596,7 @@ func (s *DiscoveryServer) closeConnection(con *Connection) {
 		s.StatusReporter.RegisterDisconnect(con.conID, AllEventTypesList)
 	}
 	s.WorkloadEntryController.OnDisconnect(con)
+	s.forgetGatewayNacks(con)
 }
 
 func connectionID(node string) string {
@@ -940,6 +947,7 @@ func (conn *Connection) send(res *discovery.DiscoveryResponse) error {
 				conn.proxy.WatchedResources[res.TypeUrl] = &model.WatchedResource{TypeUrl: res.TypeUrl}
 			}
 			conn.proxy.WatchedResources[res.TypeUrl].NonceSent = res.Nonce
+			conn.proxy.WatchedResources[res.TypeUrl].LastSent = time.Now()
 			conn.proxy.Unlock()
 		}
 	} else if status.Convert(err).Code() == codes.DeadlineExceeded {
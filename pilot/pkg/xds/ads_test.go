@@ -21,6 +21,8 @@ import (
 
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	networking "istio.io/api/networking/v1alpha3"
 	"istio.io/istio/pilot/pkg/model"
@@ -153,6 +155,19 @@ func TestAdsUnsubscribe(t *testing.T) {
 	ads.ExpectNoResponse(t)
 }
 
+func TestAdsStandby(t *testing.T) {
+	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
+	s.Discovery.SetStandby(true)
+	// The standby replica refuses the connection, which the proxy retries on another replica
+	err := s.ConnectADS().WithType(v3.ClusterType).ExpectError(t)
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected an unavailable error, got %v", err)
+	}
+
+	s.Discovery.SetStandby(false)
+	s.ConnectADS().WithType(v3.ClusterType).RequestResponseAck(t, nil)
+}
+
 // Regression for envoy restart and overlapping connections
 func TestAdsReconnect(t *testing.T) {
 	s := xds.NewFakeDiscoveryServer(t, xds.FakeOptions{})
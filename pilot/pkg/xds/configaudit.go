@@ -0,0 +1,115 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"istio.io/istio/pkg/config/audit"
+	"istio.io/istio/pkg/spiffe"
+)
+
+// configAuditz serves the audit events of the config changes of a namespace validated by this istiod. With
+// follow=true, the recorded events are followed by the new ones, as server-sent events, until the client disconnects.
+// Authenticated callers outside of the system namespace may only read the events of their own namespace.
+func (s *DiscoveryServer) configAuditz(w http.ResponseWriter, req *http.Request) {
+	namespace := req.URL.Query().Get("namespace")
+	if namespace == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("You must provide a namespace in the query string\n"))
+		return
+	}
+	if !s.debugNamespaceAllowed(req, namespace) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = fmt.Fprintf(w, "The config audit of namespace %s is not available for the current identity\n", namespace)
+		return
+	}
+	if s.ConfigAudit == nil {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("Config audit is disabled\n"))
+		return
+	}
+
+	if req.URL.Query().Get("follow") != "true" {
+		events := s.ConfigAudit.Events(namespace)
+		if events == nil {
+			events = []audit.Event{}
+		}
+		writeJSON(w, events, req)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("Streaming is not supported for this request\n"))
+		return
+	}
+	// Subscribe before reading the recorded events, so no event is missed in between
+	events, cancel := s.ConfigAudit.Subscribe(namespace)
+	defer cancel()
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	for _, ev := range s.ConfigAudit.Events(namespace) {
+		if err := writeAuditEvent(w, ev); err != nil {
+			return
+		}
+	}
+	flusher.Flush()
+	for {
+		select {
+		case <-req.Context().Done():
+			return
+		case ev := <-events:
+			if err := writeAuditEvent(w, ev); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// writeAuditEvent writes an event in the server-sent events format.
+func writeAuditEvent(w io.Writer, ev audit.Event) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: config\ndata: %s\n\n", data)
+	return err
+}
+
+// debugNamespaceAllowed returns true if the caller of a debug request may access the info of the namespace, that is
+// if the request is not authenticated (from localhost or the internal debug mux), or if one of the identities of the
+// caller is in the namespace or in the system namespace.
+func (s *DiscoveryServer) debugNamespaceAllowed(req *http.Request, namespace string) bool {
+	ids := debugIdentities(req)
+	if ids == nil {
+		return true
+	}
+	for _, id := range ids {
+		identity, err := spiffe.ParseIdentity(id)
+		if err != nil {
+			continue
+		}
+		if identity.Namespace == namespace || identity.Namespace == s.systemNamespace {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"istio.io/istio/pkg/config/audit"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestConfigAuditz(t *testing.T) {
+	s := &DiscoveryServer{
+		ConfigAudit:     audit.NewLog(10),
+		systemNamespace: "istio-system",
+	}
+	s.ConfigAudit.Record(audit.Event{Namespace: "tenant", Name: "a", Allowed: true})
+	s.ConfigAudit.Record(audit.Event{Namespace: "other", Name: "b"})
+
+	get := func(namespace string, ids ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/debug/config_auditz?namespace="+namespace, nil)
+		if ids != nil {
+			req = req.WithContext(context.WithValue(req.Context(), debugIdentitiesKey{}, ids))
+		}
+		rec := httptest.NewRecorder()
+		s.configAuditz(rec, req)
+		return rec
+	}
+	events := func(rec *httptest.ResponseRecorder) []string {
+		t.Helper()
+		assert.Equal(t, rec.Code, http.StatusOK)
+		var evs []audit.Event
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &evs))
+		var names []string
+		for _, ev := range evs {
+			names = append(names, ev.Name)
+		}
+		return names
+	}
+
+	assert.Equal(t, get("").Code, http.StatusBadRequest)
+	assert.Equal(t, events(get("tenant")), []string{"a"})
+	assert.Equal(t, events(get("tenant", "spiffe://cluster.local/ns/tenant/sa/default")), []string{"a"})
+	assert.Equal(t, events(get("other", "spiffe://cluster.local/ns/istio-system/sa/istiod")), []string{"b"})
+	assert.Equal(t, get("other", "spiffe://cluster.local/ns/tenant/sa/default").Code, http.StatusForbidden)
+	assert.Equal(t, events(get("empty")), nil)
+}
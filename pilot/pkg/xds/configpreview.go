@@ -0,0 +1,148 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+
+	"istio.io/istio/pilot/pkg/model"
+	v3 "istio.io/istio/pilot/pkg/xds/v3"
+	"istio.io/istio/pkg/util/protomarshal"
+	"istio.io/istio/pkg/util/sets"
+	"istio.io/istio/pkg/wellknown"
+)
+
+// previewConfigDump generates the configuration of a proxy which is not connected to this istiod, from the node the
+// proxy sent to the istiod it is connected to. This lets the configuration generated by two revisions for the same
+// proxy be compared before the proxy is moved to a new revision.
+// The node is given by the node query param, as the URL-safe base64 of its JSON. Only the clusters, listeners and
+// routes are generated: secrets and endpoints are not served to proxies which are not connected.
+func (s *DiscoveryServer) previewConfigDump(w http.ResponseWriter, req *http.Request) {
+	raw := req.URL.Query().Get("node")
+	if raw == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("You must provide the node of the proxy in the query string\n"))
+		return
+	}
+	node, err := decodePreviewNode(raw)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error() + "\n"))
+		return
+	}
+	con, err := s.previewConnection(node)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(fmt.Sprintf("invalid node: %v\n", err)))
+		return
+	}
+	dump, err := s.connectionConfigDump(con, false)
+	if err != nil {
+		handleHTTPError(w, err)
+		return
+	}
+	writeJSON(w, dump, req)
+}
+
+// EncodePreviewNode encodes the node of a proxy for the node query param of /debug/config_dump_preview.
+func EncodePreviewNode(node *core.Node) (string, error) {
+	b, err := protomarshal.Marshal(node)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodePreviewNode(raw string) (*core.Node, error) {
+	b, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node encoding: %v", err)
+	}
+	node := &core.Node{}
+	if err := protomarshal.Unmarshal(b, node); err != nil {
+		return nil, fmt.Errorf("invalid node: %v", err)
+	}
+	return node, nil
+}
+
+// previewConnection initializes a proxy from its node, like a new connection, without registering it: no push is
+// ever sent to it, and the workload entry controller is not notified.
+func (s *DiscoveryServer) previewConnection(node *core.Node) (*Connection, error) {
+	proxy, err := s.initProxyMetadata(node)
+	if err != nil {
+		return nil, err
+	}
+	if alias, exists := s.ClusterAliases[proxy.Metadata.ClusterID]; exists {
+		proxy.Metadata.ClusterID = alias
+	}
+	proxy.LastPushContext = s.globalPushContext()
+	con := newConnection("", nil)
+	con.conID = connectionID(proxy.ID)
+	con.node = node
+	con.proxy = proxy
+
+	s.computeProxyState(proxy, nil)
+	proxy.DiscoverIPMode()
+	if proxy.Metadata.Generator != "" {
+		proxy.XdsResourceGenerator = s.Generators[proxy.Metadata.Generator]
+	}
+	proxy.WatchedResources = map[string]*model.WatchedResource{
+		v3.ClusterType:  {TypeUrl: v3.ClusterType},
+		v3.ListenerType: {TypeUrl: v3.ListenerType},
+	}
+	// The routes are those the listeners refer to, as requested by Envoy once it got the listeners
+	req := &model.PushRequest{Push: proxy.LastPushContext, Start: time.Now(), Full: true}
+	listeners := s.getConfigDumpByResourceType(con, req, []string{v3.ListenerType})[v3.ListenerType]
+	proxy.WatchedResources[v3.RouteType] = &model.WatchedResource{TypeUrl: v3.RouteType, ResourceNames: rdsRouteNames(listeners)}
+	return con, nil
+}
+
+// rdsRouteNames returns the names of the route configurations the listeners get by RDS.
+func rdsRouteNames(listeners []*discoveryv3.Resource) []string {
+	names := sets.New[string]()
+	for _, r := range listeners {
+		l := &listener.Listener{}
+		if err := r.Resource.UnmarshalTo(l); err != nil {
+			continue
+		}
+		chains := l.FilterChains
+		if l.DefaultFilterChain != nil {
+			chains = append(chains, l.DefaultFilterChain)
+		}
+		for _, fc := range chains {
+			for _, f := range fc.Filters {
+				if f.Name != wellknown.HTTPConnectionManager {
+					continue
+				}
+				h := &hcm.HttpConnectionManager{}
+				if err := f.GetTypedConfig().UnmarshalTo(h); err != nil {
+					continue
+				}
+				if rds := h.GetRds(); rds != nil {
+					names.Insert(rds.RouteConfigName)
+				}
+			}
+		}
+	}
+	return sets.SortedList(names)
+}
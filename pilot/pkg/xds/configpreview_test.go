@@ -0,0 +1,62 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"testing"
+
+	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+
+	"istio.io/istio/pilot/pkg/model"
+	v3 "istio.io/istio/pilot/pkg/xds/v3"
+	"istio.io/istio/pkg/config/protocol"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestPreviewConfigDump(t *testing.T) {
+	s := NewFakeDiscoveryServer(t, FakeOptions{ConfigString: snapshotConfigs})
+	s.MemRegistry.AddService(&model.Service{
+		Hostname:       "reviews.default.svc.cluster.local",
+		DefaultAddress: "10.0.0.1",
+		Ports:          model.PortList{{Name: "http", Port: 80, Protocol: protocol.HTTP}},
+		Attributes:     model.ServiceAttributes{Name: "reviews", Namespace: "default"},
+	})
+	s.Discovery.ConfigUpdate(&model.PushRequest{Full: true})
+	s.EnsureSynced(t)
+
+	node := &core.Node{
+		Id:       "sidecar~10.1.0.2~productpage.default~default.svc.cluster.local",
+		Metadata: model.NodeMetadata{Namespace: "default"}.ToStruct(),
+	}
+	encoded, err := EncodePreviewNode(node)
+	assert.NoError(t, err)
+	decoded, err := decodePreviewNode(encoded)
+	assert.NoError(t, err)
+	assert.Equal(t, decoded, node)
+
+	con, err := s.Discovery.previewConnection(decoded)
+	assert.NoError(t, err)
+	// The proxy is never registered, so no push is sent to it
+	assert.Equal(t, len(s.Discovery.Clients()), 0)
+	assert.Equal(t, slices.Contains(con.Watched(v3.RouteType).ResourceNames, "80"), true)
+
+	dump, err := s.Discovery.connectionConfigDump(con, false)
+	assert.NoError(t, err)
+	assert.Equal(t, len(dump.Configs) > 0, true)
+
+	_, err = decodePreviewNode("not base64!")
+	assert.Error(t, err)
+}
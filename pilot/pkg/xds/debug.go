@@ -15,6 +15,7 @@
 package xds
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"html/template"
@@ -24,6 +25,7 @@ import (
 	"net/netip"
 	"runtime"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -35,7 +37,10 @@ import (
 	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	"google.golang.org/protobuf/proto"
 	anypb "google.golang.org/protobuf/types/known/anypb"
+	"k8s.io/apimachinery/pkg/types"
 
+	"istio.io/api/annotation"
+	networking "istio.io/api/networking/v1alpha3"
 	"istio.io/istio/pilot/pkg/config/kube/crd"
 	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
@@ -48,6 +53,7 @@ import (
 	"istio.io/istio/pkg/config/xds"
 	istiolog "istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/security"
+	"istio.io/istio/pkg/slices"
 	"istio.io/istio/pkg/util/protomarshal"
 	"istio.io/istio/pkg/util/sets"
 )
@@ -193,11 +199,20 @@ func (s *DiscoveryServer) AddDebugHandlers(mux, internalMux *http.ServeMux, enab
 	s.addDebugHandler(mux, internalMux, "/debug/configz", "Debug support for config", s.configz)
 	s.addDebugHandler(mux, internalMux, "/debug/sidecarz", "Debug sidecar scope for a proxy", s.sidecarz)
 	s.addDebugHandler(mux, internalMux, "/debug/resourcesz", "Debug support for watched resources", s.resourcez)
+	s.addDebugHandler(mux, internalMux, "/debug/watchz", "Config kinds watched and, if watched lazily, the namespaces with an active watch", s.watchz)
 	s.addDebugHandler(mux, internalMux, "/debug/instancesz", "Debug support for service instances", s.instancesz)
 
 	s.addDebugHandler(mux, internalMux, "/debug/authorizationz", "Internal authorization policies", s.authorizationz)
 	s.addDebugHandler(mux, internalMux, "/debug/telemetryz", "Debug Telemetry configuration", s.telemetryz)
+	s.addDebugHandler(mux, internalMux, "/debug/authz_dry_runz",
+		"Dry-run authorization policies of a gateway and the routes their would-deny counts are reported for", s.authzDryRunz)
 	s.addDebugHandler(mux, internalMux, "/debug/config_dump", "ConfigDump in the form of the Envoy admin config dump API for passed in proxyID", s.ConfigDump)
+	s.addDebugHandler(mux, internalMux, "/debug/config_dump_preview",
+		"ConfigDump generated for the proxy given by the node query param, which does not need to be connected to this Pilot", s.previewConfigDump)
+	s.addDebugHandler(mux, internalMux, "/debug/config_snapshot",
+		"Exports the config state of the passed in proxyID as a tarball, to reproduce its configuration offline", s.configSnapshot)
+	s.addDebugHandler(mux, internalMux, "/debug/config_auditz",
+		"Config changes accepted or rejected by this istiod in the passed in namespace, streamed with follow=true", s.configAuditz)
 	s.addDebugHandler(mux, internalMux, "/debug/push_status", "Last PushContext Details", s.pushStatusHandler)
 	s.addDebugHandler(mux, internalMux, "/debug/pushcontext", "Debug support for current push context", s.pushContextHandler)
 	s.addDebugHandler(mux, internalMux, "/debug/connections", "Info about the connected XDS clients", s.connectionsHandler)
@@ -207,6 +222,15 @@ func (s *DiscoveryServer) AddDebugHandlers(mux, internalMux *http.ServeMux, enab
 	s.addDebugHandler(mux, internalMux, "/debug/clusterz", "List remote clusters where istiod reads endpoints", s.clusterz)
 	s.addDebugHandler(mux, internalMux, "/debug/networkz", "List cross-network gateways", s.networkz)
 	s.addDebugHandler(mux, internalMux, "/debug/mcsz", "List information about Kubernetes MCS services", s.mcsz)
+	s.addDebugHandler(mux, internalMux, "/debug/gateway_preview",
+		"Renders, without applying, the resources generated for the Gateway given by the gateway and namespace query params", s.gatewayPreview)
+	s.addDebugHandler(mux, internalMux, "/debug/gateway_route_trace",
+		"Explains the precedence of the Gateway API routes competing for the hostname and path query params", s.gatewayRouteTrace)
+	s.addDebugHandler(mux, internalMux, "/debug/gatewayapi-status",
+		"Informer sync, pending status writes and last reconciliation of the Gateway API controller", s.gatewayAPIStatus)
+	s.addDebugHandler(mux, internalMux, "/debug/impactz",
+		"Resources and connected proxies affected by the deletion of the Service, Secret or Gateway given by the kind, name and namespace query params",
+		s.impactz)
 
 	s.addDebugHandler(mux, internalMux, "/debug/list", "List all supported debug commands in json", s.list)
 }
@@ -251,10 +275,20 @@ func (s *DiscoveryServer) allowAuthenticatedOrLocalhost(next http.Handler) http.
 		}
 		// TODO: Check that the identity contains istio-system namespace, else block or restrict to only info that
 		// is visible to the authenticated SA. Will require changes in docs and istioctl too.
-		next.ServeHTTP(w, req)
+		next.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), debugIdentitiesKey{}, ids)))
 	}
 }
 
+// debugIdentitiesKey is the request context key of the identities of an authenticated debug request.
+type debugIdentitiesKey struct{}
+
+// debugIdentities returns the identities of an authenticated debug request, or nil if the request is not
+// authenticated, such as requests from localhost or from the internal debug mux.
+func debugIdentities(req *http.Request) []string {
+	ids, _ := req.Context().Value(debugIdentitiesKey{}).([]string)
+	return ids
+}
+
 func isRequestFromLocalhost(r *http.Request) bool {
 	ip, _, err := net.SplitHostPort(r.RemoteAddr)
 	if err != nil {
@@ -483,6 +517,82 @@ func (s *DiscoveryServer) authorizationz(w http.ResponseWriter, req *http.Reques
 	writeJSON(w, info, req)
 }
 
+// AuthzDryRunDebug describes how the would-deny results of the dry-run authorization policies of a gateway are
+// reported.
+type AuthzDryRunDebug struct {
+	// Metric counts the requests the dry-run policies would have denied, if reported.
+	Metric string `json:"metric,omitempty"`
+	// Policies holds the dry-run policies applied to the gateway.
+	Policies []string `json:"policies"`
+	// Routes maps the routes of the gateway to the route_name label of the metric, per rule.
+	Routes map[string][]string `json:"routes"`
+}
+
+// authzDryRunz describes the dry-run authorization policies of a gateway and the routes the would-deny counts are
+// reported for, so that the metric can be related to the Gateway API routes.
+func (s *DiscoveryServer) authzDryRunz(w http.ResponseWriter, req *http.Request) {
+	proxyID, con := s.getDebugConnection(req)
+	if con == nil {
+		s.errorHandler(w, proxyID, con)
+		return
+	}
+	if con.proxy.Type != model.Router {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("Proxy is not a gateway\n"))
+		return
+	}
+	push := s.globalPushContext()
+	writeJSON(w, authzDryRunDebug(push, con.proxy), req)
+}
+
+func authzDryRunDebug(push *model.PushContext, proxy *model.Proxy) AuthzDryRunDebug {
+	info := AuthzDryRunDebug{
+		Policies: []string{},
+		Routes:   map[string][]string{},
+	}
+	if features.GatewayAuthzDryRunMetrics {
+		info.Metric = "istio_" + model.AuthzDryRunMetric
+	}
+
+	policies := push.AuthzPolicies.ListAuthorizationPolicies(model.WorkloadSelectionOpts{
+		Namespace:      proxy.ConfigNamespace,
+		WorkloadLabels: proxy.Labels,
+	})
+	for _, list := range [][]model.AuthorizationPolicy{policies.Deny, policies.Allow} {
+		for _, policy := range list {
+			if dryRun, _ := strconv.ParseBool(policy.Annotations[annotation.IoIstioDryRun.Name]); dryRun {
+				info.Policies = append(info.Policies, fmt.Sprintf("%s/%s (%s)", policy.Namespace, policy.Name, policy.Spec.GetAction()))
+			}
+		}
+	}
+
+	if proxy.MergedGateway == nil {
+		return info
+	}
+	gateways := sets.New[string]()
+	for _, gw := range proxy.MergedGateway.GatewayNameForServer {
+		gateways.Insert(gw)
+	}
+	for _, gw := range sets.SortedList(gateways) {
+		for _, vs := range push.VirtualServicesForGateway(proxy.ConfigNamespace, gw) {
+			if !model.UseGatewaySemantics(vs) {
+				continue
+			}
+			for _, parent := range model.VirtualServiceDependencies(vs) {
+				// Routes generated from a Gateway API route are named after it, see the gateway conversion
+				prefix := parent.Namespace + "." + parent.Name + "."
+				key := parent.String()
+				for _, r := range vs.Spec.(*networking.VirtualService).GetHttp() {
+					if strings.HasPrefix(r.Name, prefix) && !slices.Contains(info.Routes[key], r.Name) {
+						info.Routes[key] = append(info.Routes[key], r.Name)
+					}
+				}
+			}
+		}
+	}
+	return info
+}
+
 // AuthorizationDebug holds debug information for authorization policy.
 type TelemetryDebug struct {
 	Telemetries *model.Telemetries `json:"telemetries"`
@@ -1075,6 +1185,74 @@ func (s *DiscoveryServer) networkz(w http.ResponseWriter, req *http.Request) {
 	writeJSON(w, s.Env.NetworkManager.AllGateways(), req)
 }
 
+func (s *DiscoveryServer) watchz(w http.ResponseWriter, req *http.Request) {
+	if s.ListConfigWatches == nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	writeJSON(w, s.ListConfigWatches(), req)
+}
+
+// GatewayPreview holds the resources generated for a Gateway, or the reason none could be rendered.
+type GatewayPreview struct {
+	Resources []string `json:"resources,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// gatewayPreview renders the resources for a Gateway. Only the istiod instance running the gateway deployment
+// controller can render them; the other instances report an error in the response, rather than a failed status,
+// so that clients querying all instances can pick the rendered one.
+func (s *DiscoveryServer) gatewayPreview(w http.ResponseWriter, req *http.Request) {
+	name := types.NamespacedName{Name: req.URL.Query().Get("gateway"), Namespace: req.URL.Query().Get("namespace")}
+	if name.Name == "" || name.Namespace == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("You must provide the gateway and namespace query parameters"))
+		return
+	}
+	if s.PreviewGateway == nil {
+		writeJSON(w, GatewayPreview{Error: "the gateway deployment controller is not enabled"}, req)
+		return
+	}
+	resources, err := s.PreviewGateway(name)
+	if err != nil {
+		writeJSON(w, GatewayPreview{Error: err.Error()}, req)
+		return
+	}
+	writeJSON(w, GatewayPreview{Resources: resources}, req)
+}
+
+// gatewayRouteTrace explains how the Gateway API routes competing for a hostname and path were ordered on each
+// Gateway listener, and which one won.
+func (s *DiscoveryServer) gatewayRouteTrace(w http.ResponseWriter, req *http.Request) {
+	hostname, path := req.URL.Query().Get("hostname"), req.URL.Query().Get("path")
+	if hostname == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("You must provide the hostname query parameter"))
+		return
+	}
+	if path == "" {
+		path = "/"
+	}
+	tracer, ok := s.Env.GatewayAPIController.(model.GatewayRouteTracer)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("Gateway API support is not enabled\n"))
+		return
+	}
+	writeJSON(w, tracer.TraceRoutes(hostname, path), req)
+}
+
+// gatewayAPIStatus reports the health of the Gateway API controller, which may be wedged while istiod is ready.
+func (s *DiscoveryServer) gatewayAPIStatus(w http.ResponseWriter, req *http.Request) {
+	reporter, ok := s.Env.GatewayAPIController.(model.GatewayHealthReporter)
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("Gateway API support is not enabled\n"))
+		return
+	}
+	writeJSON(w, reporter.Health(), req)
+}
+
 func (s *DiscoveryServer) mcsz(w http.ResponseWriter, req *http.Request) {
 	svcs := sortMCSServices(s.Env.MCSServices())
 	writeJSON(w, svcs, req)
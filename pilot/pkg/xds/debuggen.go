@@ -34,6 +34,8 @@ var activeNamespaceDebuggers = map[string]struct{}{
 	"config_dump": {},
 	"ndsz":        {},
 	"edsz":        {},
+	// Restricted to the namespace of the identity, see Generate
+	"config_auditz": {},
 }
 
 // DebugGen is a Generator for istio debug info
@@ -104,6 +106,9 @@ func (dg *DebugGen) Generate(proxy *model.Proxy, w *model.WatchedResource, req *
 		if _, ok := activeNamespaceDebuggers[debugType]; ok {
 			shouldAllow = true
 		}
+		if debugType == "config_auditz" && u.Query().Get("namespace") != identity.Namespace {
+			shouldAllow = false
+		}
 		if !shouldAllow {
 			return res, model.DefaultXdsLogDetails, status.Errorf(codes.PermissionDenied, "the debug info is not available for current identity: %q", identity)
 		}
@@ -58,6 +58,9 @@ func (s *DiscoveryServer) StreamDeltas(stream DeltaDiscoveryStream) error {
 	if !s.IsServerReady() {
 		return errors.New("server is not ready to serve discovery information")
 	}
+	if s.IsStandby() {
+		return status.Error(codes.Unavailable, "server is in warm standby")
+	}
 
 	ctx := stream.Context()
 	peerAddr := "0.0.0.0"
@@ -252,6 +255,7 @@ func (conn *Connection) sendDelta(res *discovery.DeltaDiscoveryResponse) error {
 				conn.proxy.WatchedResources[res.TypeUrl] = &model.WatchedResource{TypeUrl: res.TypeUrl}
 			}
 			conn.proxy.WatchedResources[res.TypeUrl].NonceSent = res.Nonce
+			conn.proxy.WatchedResources[res.TypeUrl].LastSent = time.Now()
 			if features.EnableUnsafeDeltaTest {
 				conn.proxy.WatchedResources[res.TypeUrl].LastResources = applyDelta(conn.proxy.WatchedResources[res.TypeUrl].LastResources, res)
 			}
@@ -326,9 +330,11 @@ func (s *DiscoveryServer) shouldRespondDelta(con *Connection, request *discovery
 		errCode := codes.Code(request.ErrorDetail.Code)
 		deltaLog.Warnf("ADS:%s: ACK ERROR %s %s:%s", stype, con.conID, errCode.String(), request.ErrorDetail.GetMessage())
 		incrementXDSRejects(request.TypeUrl, con.proxy.ID, errCode.String())
+		con.recordNack(request.TypeUrl, request.ResponseNonce)
 		if s.StatusGen != nil {
 			s.StatusGen.OnNack(con.proxy, deltaToSotwRequest(request))
 		}
+		s.reportGatewayNack(con, request.TypeUrl, request.ErrorDetail.GetMessage())
 		return false
 	}
 
@@ -420,6 +426,7 @@ func (s *DiscoveryServer) shouldRespondDelta(con *Connection, request *discovery
 		}
 
 		deltaLog.Debugf("ADS:%s: ACK %s %s", stype, con.conID, request.ResponseNonce)
+		s.reportGatewayAck(con, request.TypeUrl, "")
 		return false
 	}
 	deltaLog.Debugf("ADS:%s: RESOURCE CHANGE previous resources: %v, new resources: %v %s %s", stype,
@@ -28,8 +28,10 @@ import (
 	"go.uber.org/atomic"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
+	"k8s.io/apimachinery/pkg/types"
 
 	"istio.io/istio/pilot/pkg/autoregistration"
+	"istio.io/istio/pilot/pkg/config/kube/crdclient"
 	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/networking/apigen"
@@ -38,6 +40,7 @@ import (
 	"istio.io/istio/pilot/pkg/networking/grpcgen"
 	v3 "istio.io/istio/pilot/pkg/xds/v3"
 	"istio.io/istio/pkg/cluster"
+	"istio.io/istio/pkg/config/audit"
 	"istio.io/istio/pkg/config/schema/kind"
 	"istio.io/istio/pkg/maps"
 	"istio.io/istio/pkg/security"
@@ -61,6 +64,9 @@ type debounceOptions struct {
 
 	// enableEDSDebounce indicates whether EDS pushes should be debounced.
 	enableEDSDebounce bool
+
+	// eagerGatewayEDS indicates whether removed endpoints are pushed to the gateways without debouncing.
+	eagerGatewayEDS bool
 }
 
 // DiscoveryServer is Pilot's gRPC implementation for Envoy's xds APIs
@@ -116,12 +122,22 @@ type DiscoveryServer struct {
 	// Authenticators for XDS requests. Should be same/subset of the CA authenticators.
 	Authenticators []security.Authenticator
 
+	// ConfigAudit records the config changes validated by istiod, per namespace.
+	ConfigAudit *audit.Log
+	// systemNamespace is the namespace of istiod, whose identities may access the debug info of any namespace.
+	systemNamespace string
+
 	// StatusGen is notified of connect/disconnect/nack on all connections
 	StatusGen               *StatusGen
 	WorkloadEntryController *autoregistration.Controller
 
 	// serverReady indicates caches have been synced up and server is ready to process requests.
 	serverReady atomic.Bool
+	// standby indicates the replica is in warm standby, and refuses the xDS connections until it takes over.
+	standby atomic.Bool
+
+	// gatewayStatus coalesces the recomputations of the Gateways whose proxies accepted or rejected configuration.
+	gatewayStatus *gatewayStatusUpdates
 
 	debounceOptions debounceOptions
 
@@ -134,6 +150,12 @@ type DiscoveryServer struct {
 	// ListRemoteClusters collects debug information about other clusters this istiod reads from.
 	ListRemoteClusters func() []cluster.DebugInfo
 
+	// ListConfigWatches collects debug information about the config kinds istiod watches.
+	ListConfigWatches func() []crdclient.WatchDebugInfo
+
+	// PreviewGateway renders the resources generated for a Gateway by the gateway deployment controller.
+	PreviewGateway func(name types.NamespacedName) ([]string, error)
+
 	// ClusterAliases are alias names for cluster. When a proxy connects with a cluster ID
 	// and if it has a different alias we should use that a cluster ID for proxy.
 	ClusterAliases map[cluster.ID]cluster.ID
@@ -163,9 +185,11 @@ func NewDiscoveryServer(env *model.Environment, clusterAliases map[string]string
 			debounceAfter:     features.DebounceAfter,
 			debounceMax:       features.DebounceMax,
 			enableEDSDebounce: features.EnableEDSDebounce,
+			eagerGatewayEDS:   features.EnableEagerGatewayEDSPush,
 		},
 		Cache:              env.Cache,
 		discoveryStartTime: processStartTime,
+		gatewayStatus:      newGatewayStatusUpdates(gatewayStatusDebounce),
 	}
 
 	out.ClusterAliases = make(map[cluster.ID]cluster.ID)
@@ -220,12 +244,26 @@ func (s *DiscoveryServer) IsServerReady() bool {
 	return s.serverReady.Load()
 }
 
+// SetStandby sets whether the replica is in warm standby. A standby replica is ready, so it serves the webhooks and the
+// CA like any other replica, but refuses the xDS connections, which the proxies retry on another replica.
+func (s *DiscoveryServer) SetStandby(standby bool) {
+	s.standby.Store(standby)
+}
+
+// IsStandby returns whether the replica is in warm standby.
+func (s *DiscoveryServer) IsStandby() bool {
+	return s.standby.Load()
+}
+
 func (s *DiscoveryServer) Start(stopCh <-chan struct{}) {
 	go s.WorkloadEntryController.Run(stopCh)
 	go s.handleUpdates(stopCh)
 	go s.periodicRefreshMetrics(stopCh)
 	go s.sendPushes(stopCh)
 	go s.Cache.Run(stopCh)
+	if features.MaxProxyStaleness > 0 {
+		go s.resyncStaleProxies(stopCh, features.MaxProxyStaleness)
+	}
 }
 
 // Push metrics are updated periodically (10s default)
@@ -528,6 +566,7 @@ func (s *DiscoveryServer) sendPushes(stopCh <-chan struct{}) {
 
 // InitGenerators initializes generators to be used by XdsServer.
 func (s *DiscoveryServer) InitGenerators(env *model.Environment, systemNameSpace string, clusterID cluster.ID, internalDebugMux *http.ServeMux) {
+	s.systemNamespace = systemNameSpace
 	edsGen := &EdsGenerator{Server: s}
 	s.StatusGen = NewStatusGen(s)
 	s.Generators[v3.ClusterType] = &CdsGenerator{Server: s}
@@ -492,3 +492,62 @@ func TestShouldRespond(t *testing.T) {
 		})
 	}
 }
+
+func TestEndpointsRemoved(t *testing.T) {
+	shard := model.ShardKey{Cluster: "cluster1", Provider: "Kubernetes"}
+	endpoint := func(address string, status model.HealthStatus) *model.IstioEndpoint {
+		return &model.IstioEndpoint{Address: address, EndpointPort: 80, ServicePortName: "http", HealthStatus: status}
+	}
+	tests := []struct {
+		name    string
+		old     []*model.IstioEndpoint
+		updated []*model.IstioEndpoint
+		want    bool
+	}{
+		{
+			name:    "new service",
+			updated: []*model.IstioEndpoint{endpoint("10.0.0.1", model.Healthy)},
+			want:    false,
+		},
+		{
+			name:    "endpoint added",
+			old:     []*model.IstioEndpoint{endpoint("10.0.0.1", model.Healthy)},
+			updated: []*model.IstioEndpoint{endpoint("10.0.0.1", model.Healthy), endpoint("10.0.0.2", model.Healthy)},
+			want:    false,
+		},
+		{
+			name:    "endpoint removed",
+			old:     []*model.IstioEndpoint{endpoint("10.0.0.1", model.Healthy), endpoint("10.0.0.2", model.Healthy)},
+			updated: []*model.IstioEndpoint{endpoint("10.0.0.1", model.Healthy)},
+			want:    true,
+		},
+		{
+			name:    "endpoint draining",
+			old:     []*model.IstioEndpoint{endpoint("10.0.0.1", model.Healthy)},
+			updated: []*model.IstioEndpoint{endpoint("10.0.0.1", model.Draining)},
+			want:    true,
+		},
+		{
+			name:    "unhealthy endpoint removed",
+			old:     []*model.IstioEndpoint{endpoint("10.0.0.1", model.Healthy), endpoint("10.0.0.2", model.UnHealthy)},
+			updated: []*model.IstioEndpoint{endpoint("10.0.0.1", model.Healthy)},
+			want:    false,
+		},
+		{
+			name: "all endpoints removed",
+			old:  []*model.IstioEndpoint{endpoint("10.0.0.1", model.Healthy)},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &DiscoveryServer{Env: &model.Environment{EndpointIndex: model.NewEndpointIndex(model.DisabledCache{})}}
+			if tt.old != nil {
+				s.Env.EndpointIndex.UpdateServiceEndpoints(shard, "svc.ns.svc.cluster.local", "ns", tt.old)
+			}
+			if got := s.endpointsRemoved(shard, "svc.ns.svc.cluster.local", "ns", tt.updated); got != tt.want {
+				t.Fatalf("expected endpointsRemoved %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@ package xds
 
 import (
 	"fmt"
+	"time"
 
 	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	anypb "google.golang.org/protobuf/types/known/anypb"
@@ -50,9 +51,14 @@ func (s *DiscoveryServer) EDSUpdate(shard model.ShardKey, serviceName string, na
 	istioEndpoints []*model.IstioEndpoint,
 ) {
 	inboundEDSUpdates.Increment()
+	eager := s.debounceOptions.eagerGatewayEDS && s.debounceOptions.enableEDSDebounce &&
+		s.endpointsRemoved(shard, serviceName, namespace, istioEndpoints)
 	// Update the endpoint shards
 	pushType := s.Env.EndpointIndex.UpdateServiceEndpoints(shard, serviceName, namespace, istioEndpoints)
 	if pushType == model.IncrementalPush || pushType == model.FullPush {
+		if eager && pushType == model.IncrementalPush {
+			s.pushGatewayEndpoints(serviceName, namespace)
+		}
 		// Trigger a push
 		s.ConfigUpdate(&model.PushRequest{
 			Full:           pushType == model.FullPush,
@@ -62,6 +68,59 @@ func (s *DiscoveryServer) EDSUpdate(shard model.ShardKey, serviceName string, na
 	}
 }
 
+// endpointsRemoved returns whether some serving endpoints of the service in the shard are removed, or stop being healthy,
+// with the update.
+func (s *DiscoveryServer) endpointsRemoved(shard model.ShardKey, serviceName string, namespace string,
+	istioEndpoints []*model.IstioEndpoint,
+) bool {
+	ep, f := s.Env.EndpointIndex.ShardsForService(serviceName, namespace)
+	if !f {
+		return false
+	}
+	serving := sets.New[string]()
+	for _, e := range istioEndpoints {
+		if isServing(e) {
+			serving.Insert(e.Address)
+		}
+	}
+	ep.RLock()
+	defer ep.RUnlock()
+	for _, e := range ep.Shards[shard] {
+		if isServing(e) && !serving.Contains(e.Address) {
+			return true
+		}
+	}
+	return false
+}
+
+func isServing(e *model.IstioEndpoint) bool {
+	return e.HealthStatus != model.UnHealthy && e.HealthStatus != model.Draining
+}
+
+// pushGatewayEndpoints pushes the endpoints of the service to the gateways deployed for Gateway API Gateways right away,
+// bypassing the debouncing. Until the debounced push, gateways would route to the removed endpoints, which fails at the
+// edge, while sidecars can rely on the retries of their applications. The other gateways are not managed by the mesh,
+// and may be too many to push to on every endpoint removal.
+func (s *DiscoveryServer) pushGatewayEndpoints(serviceName string, namespace string) {
+	req := &model.PushRequest{
+		ConfigsUpdated: sets.New(model.ConfigKey{Kind: kind.ServiceEntry, Name: serviceName, Namespace: namespace}),
+		Push:           s.globalPushContext(),
+		Start:          time.Now(),
+		Reason:         model.NewReasonStats(model.EndpointUpdate),
+	}
+	pushed := false
+	for _, con := range s.AllClients() {
+		if _, f := deployedGateway(con.proxy); f {
+			s.pushQueue.Enqueue(con, req)
+			pushed = true
+		}
+	}
+	if pushed {
+		log.Debugf("eager EDS push to gateways for removed endpoints of %s/%s", namespace, serviceName)
+		eagerGatewayEDSPushes.Increment()
+	}
+}
+
 // EDSCacheUpdate computes destination address membership across all clusters and networks.
 // This is the main method implementing EDS.
 // It replaces InstancesByPort in model - instead of iterating over all endpoints it uses
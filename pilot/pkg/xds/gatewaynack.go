@@ -0,0 +1,172 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pilot/pkg/model"
+	v3 "istio.io/istio/pilot/pkg/xds/v3"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/schema/kind"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// deployedGateway returns the Gateway API Gateway the proxy was deployed for, if any.
+func deployedGateway(proxy *model.Proxy) (types.NamespacedName, bool) {
+	if proxy.Type != model.Router {
+		return types.NamespacedName{}, false
+	}
+	name, f := proxy.Labels[constants.GatewayNameLabel]
+	if !f {
+		return types.NamespacedName{}, false
+	}
+	return types.NamespacedName{Namespace: proxy.ConfigNamespace, Name: name}, true
+}
+
+// gatewayNackReporter returns the Gateway API controller, if it supports reporting rejected configuration,
+// along with the Gateway the proxy was deployed for. Only proxies deployed for a Gateway API Gateway are considered.
+func (s *DiscoveryServer) gatewayNackReporter(proxy *model.Proxy) (model.GatewayNackReporter, types.NamespacedName, bool) {
+	if !features.EnableGatewayAPINackStatus {
+		return nil, types.NamespacedName{}, false
+	}
+	gw, f := deployedGateway(proxy)
+	if !f {
+		return nil, types.NamespacedName{}, false
+	}
+	reporter, ok := s.Env.GatewayAPIController.(model.GatewayNackReporter)
+	if !ok {
+		return nil, types.NamespacedName{}, false
+	}
+	return reporter, gw, true
+}
+
+// reportGatewayNack records a rejection of typeURL from a gateway proxy against the Gateway it was deployed for.
+func (s *DiscoveryServer) reportGatewayNack(con *Connection, typeURL string, message string) {
+	reporter, gw, ok := s.gatewayNackReporter(con.proxy)
+	if !ok {
+		return
+	}
+	if reporter.ReportNack(gw, con.conID, typeURL, message) {
+		s.gatewayStatusChanged(gw)
+	}
+}
+
+// reportGatewayAck clears any rejection of typeURL previously recorded for the connection of a gateway proxy.
+// version is the accepted version, if known.
+func (s *DiscoveryServer) reportGatewayAck(con *Connection, typeURL string, version string) {
+	if typeURL == v3.ListenerType {
+		s.reportGatewayListenerAck(con.proxy, version)
+	}
+	reporter, gw, ok := s.gatewayNackReporter(con.proxy)
+	if !ok {
+		return
+	}
+	if reporter.ClearNack(gw, con.conID, typeURL) {
+		s.gatewayStatusChanged(gw)
+	}
+}
+
+// forgetGatewayNacks drops the rejections recorded for the connection of a gateway proxy, once it disconnects.
+func (s *DiscoveryServer) forgetGatewayNacks(con *Connection) {
+	if con.proxy == nil {
+		return
+	}
+	reporter, gw, ok := s.gatewayNackReporter(con.proxy)
+	if !ok {
+		return
+	}
+	if reporter.ForgetNacks(gw, con.conID) {
+		s.gatewayStatusChanged(gw)
+	}
+}
+
+// reportGatewayListenerAck records that the listeners of the given push version were accepted by a gateway proxy,
+// so that the Gateway it was deployed for can be reported as programmed. Delta xDS ACKs carry no version, in which
+// case the listeners are assumed to be from the last push to the proxy.
+func (s *DiscoveryServer) reportGatewayListenerAck(proxy *model.Proxy, version string) {
+	if !features.EnableGatewayAPIProgrammedOnAck {
+		return
+	}
+	gw, f := deployedGateway(proxy)
+	if !f {
+		return
+	}
+	reporter, ok := s.Env.GatewayAPIController.(model.GatewayListenerAckReporter)
+	if !ok {
+		return
+	}
+	if version == "" && proxy.LastPushContext != nil {
+		version = proxy.LastPushContext.PushVersion
+	}
+	if reporter.ReportListenerAck(gw, version) {
+		s.gatewayStatusChanged(gw)
+	}
+}
+
+// gatewayStatusDebounce is how long the changes of Gateway status are coalesced before the Gateways are recomputed,
+// so the proxies of a Gateway rejecting and accepting configuration in quick succession trigger a single push.
+const gatewayStatusDebounce = time.Second
+
+// gatewayStatusUpdates holds the Gateways whose status changed since the last recomputation.
+type gatewayStatusUpdates struct {
+	mu      sync.Mutex
+	pending sets.Set[types.NamespacedName]
+	delay   time.Duration
+}
+
+func newGatewayStatusUpdates(delay time.Duration) *gatewayStatusUpdates {
+	return &gatewayStatusUpdates{pending: sets.New[types.NamespacedName](), delay: delay}
+}
+
+// gatewayStatusChanged schedules a recomputation of the Gateway, so its status reflects the latest rejections.
+func (s *DiscoveryServer) gatewayStatusChanged(gw types.NamespacedName) {
+	u := s.gatewayStatus
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if len(u.pending) == 0 {
+		time.AfterFunc(u.delay, s.flushGatewayStatus)
+	}
+	u.pending.Insert(gw)
+}
+
+// flushGatewayStatus triggers a single recomputation of all the Gateways whose status changed.
+func (s *DiscoveryServer) flushGatewayStatus() {
+	u := s.gatewayStatus
+	u.mu.Lock()
+	pending := u.pending
+	u.pending = sets.New[types.NamespacedName]()
+	u.mu.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+	updated := sets.NewWithLength[model.ConfigKey](len(pending))
+	for gw := range pending {
+		updated.Insert(model.ConfigKey{
+			Kind:      kind.KubernetesGateway,
+			Name:      gw.Name,
+			Namespace: gw.Namespace,
+		})
+	}
+	s.ConfigUpdate(&model.PushRequest{
+		Full:           true,
+		ConfigsUpdated: updated,
+		Reason:         model.NewReasonStats(model.ConfigUpdate),
+	})
+}
@@ -0,0 +1,229 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/serviceregistry/kube"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/labels"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/schema/kind"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// ImpactAnalysis lists what would be affected by the deletion of a resource, see /debug/impactz.
+type ImpactAnalysis struct {
+	// Resource is the analyzed resource, as <kind>/<namespace>/<name>.
+	Resource string `json:"resource"`
+	// Resources are the Istio and Gateway API resources depending on the resource, as <kind>/<namespace>/<name>.
+	Resources []string `json:"resources,omitempty"`
+	// Proxies are the proxies connected to this istiod whose config depends on the resource.
+	Proxies []string `json:"proxies,omitempty"`
+}
+
+// gatewayImpactAnalyzer is implemented by GatewayControllers able to list the resources depending on a resource.
+type gatewayImpactAnalyzer interface {
+	Dependents(ref model.ConfigKey) []model.ConfigKey
+}
+
+// impactKinds are the kinds of the resources which can be analyzed, by the name of their kind.
+var impactKinds = map[string]kind.Kind{
+	gvk.Service.Kind:           kind.Service,
+	gvk.Secret.Kind:            kind.Secret,
+	gvk.KubernetesGateway.Kind: kind.KubernetesGateway,
+}
+
+// impactz lists the resources and the connected proxies depending on a Service, Secret or Gateway API Gateway, which
+// would be affected by its deletion.
+func (s *DiscoveryServer) impactz(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	k, f := impactKinds[q.Get("kind")]
+	if !f || q.Get("name") == "" || q.Get("namespace") == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte("You must provide the kind (Service, Secret or Gateway), name and namespace query parameters"))
+		return
+	}
+	writeJSON(w, s.impactAnalysis(model.ConfigKey{Kind: k, Name: q.Get("name"), Namespace: q.Get("namespace")}), req)
+}
+
+func (s *DiscoveryServer) impactAnalysis(ref model.ConfigKey) ImpactAnalysis {
+	resources := sets.New[string]()
+	if analyzer, ok := s.Env.GatewayAPIController.(gatewayImpactAnalyzer); ok {
+		for _, d := range analyzer.Dependents(ref) {
+			resources.Insert(d.String())
+		}
+	}
+	var workloads []labels.Instance
+	if ref.Kind == kind.Secret {
+		workloads = s.workloadLabels(ref.Namespace)
+	}
+	for _, d := range istioDependents(s.Env.ConfigStore, ref, s.Env.DomainSuffix, workloads) {
+		resources.Insert(d.String())
+	}
+
+	push := s.globalPushContext()
+	proxies := sets.New[string]()
+	for _, con := range s.Clients() {
+		if proxyDependsOn(con.proxy, ref, push, s.Env.DomainSuffix) {
+			proxies.Insert(con.proxy.ID)
+		}
+	}
+	return ImpactAnalysis{
+		Resource:  ref.String(),
+		Resources: sets.SortedList(resources),
+		Proxies:   sets.SortedList(proxies),
+	}
+}
+
+// workloadLabels returns the labels of the workloads of the namespace, known from the Services of the namespace and
+// from the proxies connected to this istiod.
+func (s *DiscoveryServer) workloadLabels(namespace string) []labels.Instance {
+	var out []labels.Instance
+	for _, con := range s.Clients() {
+		if con.proxy.ConfigNamespace == namespace {
+			out = append(out, con.proxy.Labels)
+		}
+	}
+	if s.Env.ServiceDiscovery == nil {
+		return out
+	}
+	for _, svc := range s.Env.ServiceDiscovery.Services() {
+		if svc.Attributes.Namespace != namespace {
+			continue
+		}
+		for _, port := range svc.Ports {
+			for _, si := range s.Env.ServiceDiscovery.InstancesByPort(svc, port.Port) {
+				if si.Endpoint != nil && si.Endpoint.Namespace == namespace {
+					out = append(out, si.Endpoint.Labels)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// proxyDependsOn returns whether the config of the proxy depends on the resource, as tracked to scope the pushes.
+// A Gateway API Gateway is depended on by the proxies serving the Istio Gateways generated for its listeners.
+func proxyDependsOn(proxy *model.Proxy, ref model.ConfigKey, push *model.PushContext, domain string) bool {
+	switch ref.Kind {
+	case kind.Service:
+		hostname := string(kube.ServiceHostname(ref.Name, ref.Namespace, domain))
+		key := model.ConfigKey{Kind: kind.ServiceEntry, Name: hostname, Namespace: ref.Namespace}
+		return ConfigAffectsProxy(&model.PushRequest{ConfigsUpdated: sets.New(key), Push: push}, proxy)
+	case kind.Secret:
+		return gatewayDependsOnSecret(proxy, ref, push)
+	case kind.KubernetesGateway:
+		if proxy.MergedGateway == nil {
+			return false
+		}
+		prefix := fmt.Sprintf("%s/%s-%s-", ref.Namespace, ref.Name, constants.KubernetesGatewayName)
+		for _, name := range proxy.MergedGateway.GatewayNameForServer {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// istioDependents returns the Istio resources referencing the resource: the VirtualServices sending traffic to a
+// Service and the DestinationRules of a Service, or the Gateways and DestinationRules using a Secret as credentialName.
+// The credentials of a Gateway are read from the namespace of the workloads it selects, so a Gateway depends on the
+// Secrets of its own namespace and of the namespace of the workloads, given by their labels. The VirtualServices
+// generated for Gateway API routes are left to the GatewayController.
+func istioDependents(store model.ConfigStore, ref model.ConfigKey, domain string, workloads []labels.Instance) []model.ConfigKey {
+	if store == nil {
+		return nil
+	}
+	var out []model.ConfigKey
+	key := func(c config.Config) model.ConfigKey {
+		return model.ConfigKey{Kind: kind.MustFromGVK(c.GroupVersionKind), Name: c.Name, Namespace: c.Namespace}
+	}
+	switch ref.Kind {
+	case kind.Service:
+		hostname := kube.ServiceHostname(ref.Name, ref.Namespace, domain)
+		for _, vs := range store.List(gvk.VirtualService, metav1.NamespaceAll) {
+			if model.UseGatewaySemantics(vs) {
+				continue
+			}
+			for _, h := range model.VirtualServiceDestinationHosts(vs.Spec.(*networking.VirtualService)) {
+				if model.ResolveShortnameToFQDN(h, vs.Meta) == hostname {
+					out = append(out, key(vs))
+					break
+				}
+			}
+		}
+		for _, dr := range store.List(gvk.DestinationRule, metav1.NamespaceAll) {
+			if model.ResolveShortnameToFQDN(dr.Spec.(*networking.DestinationRule).Host, dr.Meta) == hostname {
+				out = append(out, key(dr))
+			}
+		}
+	case kind.Secret:
+		for _, gw := range store.List(gvk.Gateway, metav1.NamespaceAll) {
+			if !gatewaySelectsNamespace(gw, ref.Namespace, workloads) {
+				continue
+			}
+			for _, server := range gw.Spec.(*networking.Gateway).Servers {
+				if credentialNameOf(server.GetTls().GetCredentialName(), ref.Name) {
+					out = append(out, key(gw))
+					break
+				}
+			}
+		}
+		for _, dr := range store.List(gvk.DestinationRule, ref.Namespace) {
+			policy := dr.Spec.(*networking.DestinationRule).GetTrafficPolicy()
+			uses := credentialNameOf(policy.GetTls().GetCredentialName(), ref.Name)
+			for _, pl := range policy.GetPortLevelSettings() {
+				uses = uses || credentialNameOf(pl.GetTls().GetCredentialName(), ref.Name)
+			}
+			if uses {
+				out = append(out, key(dr))
+			}
+		}
+	}
+	return out
+}
+
+// gatewaySelectsNamespace returns whether the Gateway is in the namespace, or selects one of its workloads.
+func gatewaySelectsNamespace(gw config.Config, namespace string, workloads []labels.Instance) bool {
+	if gw.Namespace == namespace {
+		return true
+	}
+	if features.ScopeGatewayToNamespace {
+		return false
+	}
+	selector := labels.Instance(gw.Spec.(*networking.Gateway).GetSelector())
+	for _, w := range workloads {
+		if selector.SubsetOf(w) {
+			return true
+		}
+	}
+	return false
+}
+
+// credentialNameOf returns whether the credentialName refers to the Secret, possibly to its CA certificate.
+func credentialNameOf(credentialName, secret string) bool {
+	return credentialName != "" && (credentialName == secret || credentialName == secret+"-cacert")
+}
@@ -0,0 +1,103 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"sort"
+	"testing"
+
+	networking "istio.io/api/networking/v1alpha3"
+	"istio.io/istio/pilot/pkg/config/memory"
+	"istio.io/istio/pilot/pkg/features"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/labels"
+	"istio.io/istio/pkg/config/schema/collections"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/config/schema/kind"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestIstioDependents(t *testing.T) {
+	store := memory.MakeSkipValidation(collections.Pilot)
+	create := func(k config.GroupVersionKind, ns, name string, spec config.Spec, annotations map[string]string) {
+		t.Helper()
+		_, err := store.Create(config.Config{
+			Meta: config.Meta{GroupVersionKind: k, Namespace: ns, Name: name, Domain: "cluster.local", Annotations: annotations},
+			Spec: spec,
+		})
+		assert.NoError(t, err)
+	}
+	route := func(host string) []*networking.HTTPRoute {
+		return []*networking.HTTPRoute{{Route: []*networking.HTTPRouteDestination{{Destination: &networking.Destination{Host: host}}}}}
+	}
+	create(gvk.VirtualService, "default", "short", &networking.VirtualService{Http: route("reviews")}, nil)
+	create(gvk.VirtualService, "other", "fqdn", &networking.VirtualService{Http: route("reviews.default.svc.cluster.local")}, nil)
+	create(gvk.VirtualService, "other", "short", &networking.VirtualService{Http: route("reviews")}, nil)
+	create(gvk.VirtualService, "default", "generated", &networking.VirtualService{Http: route("reviews.default.svc.cluster.local")},
+		map[string]string{constants.InternalRouteSemantics: constants.RouteSemanticsGateway})
+	create(gvk.DestinationRule, "default", "reviews", &networking.DestinationRule{Host: "reviews"}, nil)
+	create(gvk.DestinationRule, "infra", "egress", &networking.DestinationRule{
+		Host: "api.example.com",
+		TrafficPolicy: &networking.TrafficPolicy{PortLevelSettings: []*networking.TrafficPolicy_PortTrafficPolicy{{
+			Tls: &networking.ClientTLSSettings{CredentialName: "cert"},
+		}}},
+	}, nil)
+	create(gvk.Gateway, "infra", "ingress", &networking.Gateway{Servers: []*networking.Server{
+		{Port: &networking.Port{Number: 80}},
+		{Port: &networking.Port{Number: 443}, Tls: &networking.ServerTLSSettings{CredentialName: "cert"}},
+	}}, nil)
+	create(gvk.Gateway, "other", "ingress", &networking.Gateway{Selector: map[string]string{"istio": "other"}, Servers: []*networking.Server{
+		{Port: &networking.Port{Number: 443}, Tls: &networking.ServerTLSSettings{CredentialName: "cert"}},
+	}}, nil)
+	// Selecting the gateway workloads of the infra namespace, whose credentials are read from there
+	create(gvk.Gateway, "routes", "ingress", &networking.Gateway{Selector: map[string]string{"istio": "ingressgateway"}, Servers: []*networking.Server{
+		{Port: &networking.Port{Number: 443}, Tls: &networking.ServerTLSSettings{CredentialName: "cert"}},
+	}}, nil)
+
+	keys := func(configs []model.ConfigKey) []string {
+		var out []string
+		for _, c := range configs {
+			out = append(out, c.String())
+		}
+		sort.Strings(out)
+		return out
+	}
+	secret := model.ConfigKey{Kind: kind.Secret, Name: "cert", Namespace: "infra"}
+	assert.Equal(t, keys(istioDependents(store, model.ConfigKey{Kind: kind.Service, Name: "reviews", Namespace: "default"}, "cluster.local", nil)),
+		[]string{"DestinationRule/default/reviews", "VirtualService/default/short", "VirtualService/other/fqdn"})
+	assert.Equal(t, keys(istioDependents(store, secret, "cluster.local", nil)),
+		[]string{"DestinationRule/infra/egress", "Gateway/infra/ingress"})
+	workloads := []labels.Instance{{"istio": "ingressgateway", "app": "istio-ingressgateway"}}
+	assert.Equal(t, keys(istioDependents(store, secret, "cluster.local", workloads)),
+		[]string{"DestinationRule/infra/egress", "Gateway/infra/ingress", "Gateway/routes/ingress"})
+
+	test.SetForTest(t, &features.ScopeGatewayToNamespace, true)
+	assert.Equal(t, keys(istioDependents(store, secret, "cluster.local", workloads)),
+		[]string{"DestinationRule/infra/egress", "Gateway/infra/ingress"})
+}
+
+func TestProxyDependsOnKubernetesGateway(t *testing.T) {
+	ref := model.ConfigKey{Kind: kind.KubernetesGateway, Name: "gw", Namespace: "infra"}
+	server := &networking.Server{}
+	proxy := &model.Proxy{Type: model.Router, MergedGateway: &model.MergedGateway{
+		GatewayNameForServer: map[*networking.Server]string{server: "infra/gw-istio-autogenerated-k8s-gateway-https"},
+	}}
+	assert.Equal(t, proxyDependsOn(proxy, ref, nil, "cluster.local"), true)
+	assert.Equal(t, proxyDependsOn(proxy, model.ConfigKey{Kind: kind.KubernetesGateway, Name: "g", Namespace: "infra"}, nil, "cluster.local"), false)
+	assert.Equal(t, proxyDependsOn(&model.Proxy{Type: model.SidecarProxy}, ref, nil, "cluster.local"), false)
+}
@@ -60,11 +60,22 @@ var (
 		"Total number of XDS responses from pilot rejected by proxy.",
 	)
 
+	staleProxyResyncs = monitoring.NewSum(
+		"pilot_xds_stale_proxy_resyncs",
+		"Total number of resyncs forced on proxies which did not ACK the responses sent to them within PILOT_MAX_PROXY_STALENESS, "+
+			"labeled by the type of the unacknowledged resources.",
+	)
+
 	xdsExpiredNonce = monitoring.NewSum(
 		"pilot_xds_expired_nonce",
 		"Total number of XDS requests with an expired nonce.",
 	)
 
+	secretPushesAvoided = monitoring.NewSum(
+		"pilot_secret_pushes_avoided",
+		"Total number of gateway pushes skipped on a Secret change, as the gateway does not reference the Secret.",
+	)
+
 	monServices = monitoring.NewGauge(
 		"pilot_services",
 		"Total services known to pilot.",
@@ -136,6 +147,11 @@ var (
 		[]float64{.1, .5, 1, 3, 5, 10, 20, 30},
 	)
 
+	eagerGatewayEDSPushes = monitoring.NewSum(
+		"pilot_eds_eager_gateway_pushes",
+		"Total number of endpoint removals pushed to gateways ahead of the EDS push debouncing, each avoiding a window of routing to stale endpoints.",
+	)
+
 	pushContextErrors = monitoring.NewSum(
 		"pilot_xds_push_context_errors",
 		"Number of errors (timeouts) initiating push context.",
@@ -193,6 +209,8 @@ var triggerMetric = map[model.TriggerReason]monitoring.Metric{
 	model.ProxyRequest:    pushTriggers.With(typeTag.Value(string(model.ProxyRequest))),
 	model.NamespaceUpdate: pushTriggers.With(typeTag.Value(string(model.NamespaceUpdate))),
 	model.ClusterUpdate:   pushTriggers.With(typeTag.Value(string(model.ClusterUpdate))),
+	model.StaleProxy:      pushTriggers.With(typeTag.Value(string(model.StaleProxy))),
+	model.ConfigFreeze:    pushTriggers.With(typeTag.Value(string(model.ConfigFreeze))),
 }
 
 func recordPushTriggers(reasons model.ReasonStats) {
@@ -17,6 +17,8 @@ package xds
 import (
 	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/model/credentials"
+	v3 "istio.io/istio/pilot/pkg/xds/v3"
 	"istio.io/istio/pkg/config/host"
 	"istio.io/istio/pkg/config/schema/kind"
 	"istio.io/istio/pkg/util/sets"
@@ -49,6 +51,9 @@ func ConfigAffectsProxy(req *model.PushRequest, proxy *model.Proxy) bool {
 		}
 	}
 
+	if proxy.Type == model.Router && features.ScopeGatewaySecretPushes && model.HasConfigsOfKind(req.ConfigsUpdated, kind.Secret) {
+		secretPushesAvoided.Increment()
+	}
 	return false
 }
 
@@ -81,6 +86,9 @@ func proxyDependentOnConfig(proxy *model.Proxy, config model.ConfigKey, push *mo
 				return false
 			}
 		}
+		if config.Kind == kind.Secret && features.ScopeGatewaySecretPushes {
+			return gatewayDependsOnSecret(proxy, config, push)
+		}
 		return true
 	default:
 		// TODO We'll add the check for other proxy types later.
@@ -89,6 +97,47 @@ func proxyDependentOnConfig(proxy *model.Proxy, config model.ConfigKey, push *mo
 	return false
 }
 
+// gatewayDependsOnSecret checks if a gateway uses the Secret: through the credentialName of one of its servers, through
+// any other credential it watches over SDS, such as the credentialName of a DestinationRule, or as a Wasm pull secret.
+func gatewayDependsOnSecret(proxy *model.Proxy, config model.ConfigKey, push *model.PushContext) bool {
+	related := sets.New(relatedConfigs(config)...)
+	if proxy.MergedGateway != nil && containsAny(proxy.MergedGateway.CertificateReferences, relatedConfigs(config)) {
+		return true
+	}
+
+	namespace := proxy.GetNamespace()
+	if proxy.VerifiedIdentity != nil {
+		namespace = proxy.VerifiedIdentity.Namespace
+	}
+	var sdsNames, ecdsNames []string
+	proxy.RLock()
+	if w := proxy.WatchedResources[v3.SecretType]; w != nil {
+		sdsNames = w.ResourceNames
+	}
+	if w := proxy.WatchedResources[v3.ExtensionConfigurationType]; w != nil {
+		ecdsNames = w.ResourceNames
+	}
+	proxy.RUnlock()
+
+	for _, name := range sdsNames {
+		sr, err := credentials.ParseResourceName(name, namespace, "", "")
+		if err != nil {
+			continue
+		}
+		if related.Contains(model.ConfigKey{Kind: kind.Secret, Name: sr.Name, Namespace: sr.Namespace}) {
+			return true
+		}
+	}
+	if len(ecdsNames) > 0 && push != nil {
+		for _, sr := range referencedSecrets(proxy, push, ecdsNames) {
+			if related.Contains(model.ConfigKey{Kind: kind.Secret, Name: sr.Name, Namespace: sr.Namespace}) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // DefaultProxyNeedsPush check if a proxy needs push for this push event.
 func DefaultProxyNeedsPush(proxy *model.Proxy, req *model.PushRequest) bool {
 	if ConfigAffectsProxy(req, proxy) {
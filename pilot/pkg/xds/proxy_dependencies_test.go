@@ -24,12 +24,15 @@ import (
 	"istio.io/istio/pilot/pkg/features"
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/networking/core/v1alpha3"
+	v3 "istio.io/istio/pilot/pkg/xds/v3"
 	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/config/schema/gvk"
 	"istio.io/istio/pkg/config/schema/kind"
 	"istio.io/istio/pkg/config/visibility"
+	"istio.io/istio/pkg/monitoring/monitortest"
 	"istio.io/istio/pkg/spiffe"
 	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
 	"istio.io/istio/pkg/util/sets"
 )
 
@@ -370,6 +373,46 @@ func TestProxyNeedsPush(t *testing.T) {
 	}
 }
 
+func TestProxyNeedsPushSecret(t *testing.T) {
+	test.SetForTest(t, &features.ScopeGatewaySecretPushes, true)
+	mt := monitortest.New(t)
+	gateway := &model.Proxy{
+		Type:     model.Router,
+		Metadata: &model.NodeMetadata{Namespace: "ns1"},
+		MergedGateway: &model.MergedGateway{
+			CertificateReferences: sets.New(model.ConfigKey{Kind: kind.Secret, Name: "server", Namespace: "ns1"}),
+		},
+		WatchedResources: map[string]*model.WatchedResource{
+			v3.SecretType: {TypeUrl: v3.SecretType, ResourceNames: []string{"kubernetes://client", "kubernetes://other/remote"}},
+		},
+	}
+	secret := func(name, namespace string) sets.Set[model.ConfigKey] {
+		return sets.New(model.ConfigKey{Kind: kind.Secret, Name: name, Namespace: namespace})
+	}
+
+	cases := []struct {
+		name    string
+		configs sets.Set[model.ConfigKey]
+		want    bool
+	}{
+		{"server credential", secret("server", "ns1"), true},
+		{"server ca credential", secret("server-cacert", "ns1"), true},
+		{"watched credential", secret("client", "ns1"), true},
+		{"watched credential in other namespace", secret("remote", "other"), true},
+		{"unreferenced credential", secret("server", "ns2"), false},
+		{"unreferenced name", secret("unrelated", "ns1"), false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, DefaultProxyNeedsPush(gateway, &model.PushRequest{ConfigsUpdated: tt.configs}), tt.want)
+		})
+	}
+	mt.Assert(secretPushesAvoided.Name(), nil, monitortest.Exactly(2))
+
+	test.SetForTest(t, &features.ScopeGatewaySecretPushes, false)
+	assert.Equal(t, DefaultProxyNeedsPush(gateway, &model.PushRequest{ConfigsUpdated: secret("unrelated", "ns1")}), true)
+}
+
 func BenchmarkListEquals(b *testing.B) {
 	size := 100
 	var l []string
@@ -0,0 +1,326 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	meshconfig "istio.io/api/mesh/v1alpha1"
+	"istio.io/istio/pilot/pkg/config/kube/crd"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/pkg/serviceregistry/provider"
+	"istio.io/istio/pilot/test/xdstest"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/mesh"
+	"istio.io/istio/pkg/config/schema/resource"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/util/protomarshal"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// Names of the files of a config snapshot archive.
+const (
+	snapshotProxyFile     = "proxy.json"
+	snapshotMeshFile      = "mesh.yaml"
+	snapshotConfigsFile   = "configs.yaml"
+	snapshotServicesFile  = "services.json"
+	snapshotEndpointsFile = "endpoints.json"
+)
+
+// ConfigSnapshot is the config state istiod generates the xDS configuration of a proxy from. It is exported by the
+// /debug/config_snapshot endpoint so that the configuration of a proxy can be reproduced offline, without access to
+// the cluster, with the replay-snapshot command of pilot-discovery; see ReplayConfigSnapshot.
+// Secrets are never exported: listeners referring to credentials are generated, but the credentials are not served.
+type ConfigSnapshot struct {
+	Proxy SnapshotProxy
+	Mesh  *meshconfig.MeshConfig
+	// Configs are the Istio and Gateway API configs of the namespaces visible to the proxy. Configs generated by
+	// istiod, such as those generated from the Gateway API, are not included as they are generated again on replay.
+	Configs []config.Config
+	// Services are the services visible to the proxy, except those of ServiceEntries which are part of the Configs.
+	Services []*model.Service
+	// Endpoints are the endpoints of the Services, keyed by namespace/hostname.
+	Endpoints map[string][]*model.IstioEndpoint
+}
+
+// SnapshotProxy is the identity of the proxy a ConfigSnapshot was taken for.
+type SnapshotProxy struct {
+	ID              string              `json:"id"`
+	Type            model.NodeType      `json:"type"`
+	IPAddresses     []string            `json:"ipAddresses,omitempty"`
+	DNSDomain       string              `json:"dnsDomain,omitempty"`
+	ConfigNamespace string              `json:"configNamespace,omitempty"`
+	Labels          map[string]string   `json:"labels,omitempty"`
+	Metadata        *model.NodeMetadata `json:"metadata,omitempty"`
+}
+
+// configSnapshot exports the config state of a proxy as a gzipped tarball.
+func (s *DiscoveryServer) configSnapshot(w http.ResponseWriter, req *http.Request) {
+	proxyID, con := s.getDebugConnection(req)
+	if con == nil {
+		s.errorHandler(w, proxyID, con)
+		return
+	}
+	var buf bytes.Buffer
+	if err := WriteConfigSnapshot(&buf, s.buildConfigSnapshot(con.proxy)); err != nil {
+		handleHTTPError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", proxyID+".tar.gz"))
+	_, _ = w.Write(buf.Bytes())
+}
+
+// buildConfigSnapshot collects the config state used to generate the xDS configuration of the proxy.
+func (s *DiscoveryServer) buildConfigSnapshot(proxy *model.Proxy) *ConfigSnapshot {
+	snap := &ConfigSnapshot{
+		Proxy: SnapshotProxy{
+			ID:              proxy.ID,
+			Type:            proxy.Type,
+			IPAddresses:     proxy.IPAddresses,
+			DNSDomain:       proxy.DNSDomain,
+			ConfigNamespace: proxy.ConfigNamespace,
+			Labels:          proxy.Labels,
+			Metadata:        proxy.Metadata,
+		},
+		Mesh:      s.Env.Mesh(),
+		Endpoints: map[string][]*model.IstioEndpoint{},
+	}
+
+	namespaces := sets.New(proxy.ConfigNamespace, s.Env.Mesh().GetRootNamespace())
+	var services []*model.Service
+	if proxy.SidecarScope != nil {
+		services = proxy.SidecarScope.Services()
+	}
+	for _, svc := range services {
+		namespaces.Insert(svc.Attributes.Namespace)
+		// Services of ServiceEntries are generated again from the configs
+		if svc.Attributes.ServiceRegistry == provider.External {
+			continue
+		}
+		snap.Services = append(snap.Services, svc)
+		shards, f := s.Env.EndpointIndex.ShardsForService(string(svc.Hostname), svc.Attributes.Namespace)
+		if !f {
+			continue
+		}
+		shards.RLock()
+		for k, eps := range shards.Shards {
+			if k.Provider == provider.External {
+				continue
+			}
+			key := svc.Attributes.Namespace + "/" + string(svc.Hostname)
+			snap.Endpoints[key] = append(snap.Endpoints[key], eps...)
+		}
+		shards.RUnlock()
+	}
+
+	if s.Env.ConfigStore != nil {
+		s.Env.ConfigStore.Schemas().ForEach(func(schema resource.Schema) bool {
+			for _, c := range s.Env.ConfigStore.List(schema.GroupVersionKind(), "") {
+				if _, f := c.Annotations[constants.InternalParentNames]; f {
+					continue
+				}
+				if c.Namespace != "" && !namespaces.Contains(c.Namespace) {
+					continue
+				}
+				snap.Configs = append(snap.Configs, c)
+			}
+			return false
+		})
+	}
+	sort.SliceStable(snap.Configs, func(i, j int) bool {
+		return snap.Configs[i].Key() < snap.Configs[j].Key()
+	})
+	return snap
+}
+
+// WriteConfigSnapshot writes the snapshot as a gzipped tarball.
+func WriteConfigSnapshot(w io.Writer, snap *ConfigSnapshot) error {
+	proxy, err := json.MarshalIndent(snap.Proxy, "", "  ")
+	if err != nil {
+		return err
+	}
+	meshYAML, err := protomarshal.ToYAML(snap.Mesh)
+	if err != nil {
+		return err
+	}
+	configs := make([]string, 0, len(snap.Configs))
+	for _, c := range snap.Configs {
+		obj, err := crd.ConvertConfig(c)
+		if err != nil {
+			return fmt.Errorf("failed to convert %v %s: %v", c.GroupVersionKind, c.Key(), err)
+		}
+		b, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		configs = append(configs, string(b))
+	}
+	services, err := json.MarshalIndent(snap.Services, "", "  ")
+	if err != nil {
+		return err
+	}
+	endpoints, err := json.MarshalIndent(snap.Endpoints, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, f := range []struct {
+		name    string
+		content []byte
+	}{
+		{snapshotProxyFile, proxy},
+		{snapshotMeshFile, []byte(meshYAML)},
+		{snapshotConfigsFile, []byte(strings.Join(configs, "---\n"))},
+		{snapshotServicesFile, services},
+		{snapshotEndpointsFile, endpoints},
+	} {
+		if err := tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0o644, Size: int64(len(f.content)), ModTime: now}); err != nil {
+			return err
+		}
+		if _, err := tw.Write(f.content); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// ReadConfigSnapshot reads a snapshot written by WriteConfigSnapshot.
+func ReadConfigSnapshot(r io.Reader) (*ConfigSnapshot, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[h.Name] = b
+	}
+	for _, name := range []string{snapshotProxyFile, snapshotMeshFile, snapshotConfigsFile, snapshotServicesFile, snapshotEndpointsFile} {
+		if _, f := files[name]; !f {
+			return nil, fmt.Errorf("invalid config snapshot: %s is missing", name)
+		}
+	}
+
+	snap := &ConfigSnapshot{}
+	if err := json.Unmarshal(files[snapshotProxyFile], &snap.Proxy); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", snapshotProxyFile, err)
+	}
+	if snap.Mesh, err = mesh.ApplyMeshConfigDefaults(string(files[snapshotMeshFile])); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", snapshotMeshFile, err)
+	}
+	if snap.Configs, _, err = crd.ParseInputs(string(files[snapshotConfigsFile])); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", snapshotConfigsFile, err)
+	}
+	if err := json.Unmarshal(files[snapshotServicesFile], &snap.Services); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", snapshotServicesFile, err)
+	}
+	if err := json.Unmarshal(files[snapshotEndpointsFile], &snap.Endpoints); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", snapshotEndpointsFile, err)
+	}
+	for _, eps := range snap.Endpoints {
+		for _, ep := range eps {
+			// The policy is not serialized
+			ep.DiscoverabilityPolicy = model.AlwaysDiscoverable
+		}
+	}
+	return snap, nil
+}
+
+// NewFakeDiscoveryServerFromSnapshot replays a snapshot, returning a discovery server loaded with its config state
+// and the proxy it was taken for. The xDS configuration of the proxy can then be generated as usual, for example:
+//
+//	s, proxy := NewFakeDiscoveryServerFromSnapshot(t, snap)
+//	listeners := s.Listeners(proxy)
+func NewFakeDiscoveryServerFromSnapshot(t test.Failer, snap *ConfigSnapshot) (*FakeDiscoveryServer, *model.Proxy) {
+	s := NewFakeDiscoveryServer(t, FakeOptions{
+		Configs:    snap.Configs,
+		MeshConfig: snap.Mesh,
+		Services:   snap.Services,
+	})
+	for key, eps := range snap.Endpoints {
+		ns, hostname, _ := strings.Cut(key, "/")
+		s.MemRegistry.SetEndpoints(hostname, ns, eps)
+	}
+	s.Discovery.ConfigUpdate(&model.PushRequest{Full: true, Reason: model.NewReasonStats(model.GlobalUpdate)})
+	s.EnsureSynced(t)
+
+	proxy := s.SetupProxy(&model.Proxy{
+		ID:              snap.Proxy.ID,
+		Type:            snap.Proxy.Type,
+		IPAddresses:     snap.Proxy.IPAddresses,
+		DNSDomain:       snap.Proxy.DNSDomain,
+		ConfigNamespace: snap.Proxy.ConfigNamespace,
+		Labels:          snap.Proxy.Labels,
+		Metadata:        snap.Proxy.Metadata,
+	})
+	return s, proxy
+}
+
+// ReplayConfigSnapshot replays a snapshot, writing the listeners, clusters, routes and endpoints generated for its
+// proxy as JSON, each kind of resource under a "# <kind>" header.
+func ReplayConfigSnapshot(w io.Writer, snap *ConfigSnapshot) error {
+	return test.Wrap(func(t test.Failer) {
+		s, proxy := NewFakeDiscoveryServerFromSnapshot(t, snap)
+		listeners := s.Listeners(proxy)
+		for _, resources := range []struct {
+			name string
+			dump []string
+		}{
+			{"listeners", xdstest.DumpList(t, listeners)},
+			{"clusters", xdstest.DumpList(t, s.Clusters(proxy))},
+			{"routes", xdstest.DumpList(t, s.RoutesFromListeners(proxy, listeners))},
+			{"endpoints", xdstest.DumpList(t, s.Endpoints(proxy))},
+		} {
+			if _, err := fmt.Fprintf(w, "# %s\n", resources.name); err != nil {
+				t.Fatal(err)
+			}
+			for _, r := range resources.dump {
+				if _, err := fmt.Fprintln(w, r); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pilot/test/xdstest"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/protocol"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+const snapshotConfigs = `
+apiVersion: networking.istio.io/v1alpha3
+kind: ServiceEntry
+metadata:
+  name: external
+  namespace: default
+spec:
+  hosts: [external.example.com]
+  ports:
+  - number: 443
+    name: tls
+    protocol: TLS
+  resolution: DNS
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: VirtualService
+metadata:
+  name: reviews
+  namespace: default
+spec:
+  hosts: [reviews.default.svc.cluster.local]
+  http:
+  - route:
+    - destination:
+        host: reviews.default.svc.cluster.local
+      weight: 100
+---
+apiVersion: networking.istio.io/v1alpha3
+kind: DestinationRule
+metadata:
+  name: unrelated
+  namespace: unrelated
+spec:
+  host: unrelated.unrelated.svc.cluster.local
+`
+
+func TestConfigSnapshot(t *testing.T) {
+	s := NewFakeDiscoveryServer(t, FakeOptions{ConfigString: snapshotConfigs})
+	s.MemRegistry.AddService(&model.Service{
+		Hostname:       "reviews.default.svc.cluster.local",
+		DefaultAddress: "10.0.0.1",
+		Ports:          model.PortList{{Name: "http", Port: 80, Protocol: protocol.HTTP}},
+		Attributes:     model.ServiceAttributes{Name: "reviews", Namespace: "default"},
+	})
+	s.MemRegistry.SetEndpoints("reviews.default.svc.cluster.local", "default", []*model.IstioEndpoint{{
+		Address:         "10.1.0.1",
+		ServicePortName: "http",
+		EndpointPort:    8080,
+		Namespace:       "default",
+	}})
+	s.Discovery.ConfigUpdate(&model.PushRequest{Full: true})
+	s.EnsureSynced(t)
+	proxy := s.SetupProxy(&model.Proxy{ID: "productpage.default", ConfigNamespace: "default", IPAddresses: []string{"10.1.0.2"}})
+
+	snap := s.Discovery.buildConfigSnapshot(proxy)
+	assert.Equal(t, slices.Map(snap.Configs, func(c config.Config) string {
+		return c.GroupVersionKind.Kind + "/" + c.Key()
+	}), []string{"ServiceEntry/default/external", "VirtualService/default/reviews"})
+	assert.Equal(t, slices.Map(snap.Services, func(s *model.Service) string {
+		return string(s.Hostname)
+	}), []string{"reviews.default.svc.cluster.local"})
+	assert.Equal(t, len(snap.Endpoints["default/reviews.default.svc.cluster.local"]), 1)
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteConfigSnapshot(&buf, snap))
+	got, err := ReadConfigSnapshot(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, got.Proxy.ID, snap.Proxy.ID)
+	assert.Equal(t, got.Proxy.Metadata.Namespace, "default")
+	assert.Equal(t, got.Mesh, snap.Mesh)
+	assert.Equal(t, len(got.Configs), len(snap.Configs))
+	assert.Equal(t, got.Configs[0].GroupVersionKind, gvk.ServiceEntry)
+
+	// The replayed proxy gets the same configuration
+	replay, replayProxy := NewFakeDiscoveryServerFromSnapshot(t, got)
+	assert.Equal(t, xdstest.ExtractListenerNames(replay.Listeners(replayProxy)), xdstest.ExtractListenerNames(s.Listeners(proxy)))
+	assert.Equal(t, xdstest.MapKeys(xdstest.ExtractClusters(replay.Clusters(replayProxy))),
+		xdstest.MapKeys(xdstest.ExtractClusters(s.Clusters(proxy))))
+	assert.Equal(t, xdstest.ExtractLoadAssignments(replay.Endpoints(replayProxy)), xdstest.ExtractLoadAssignments(s.Endpoints(proxy)))
+}
+
+func TestReplayConfigSnapshot(t *testing.T) {
+	s := NewFakeDiscoveryServer(t, FakeOptions{ConfigString: snapshotConfigs})
+	s.MemRegistry.AddService(&model.Service{
+		Hostname:       "reviews.default.svc.cluster.local",
+		DefaultAddress: "10.0.0.1",
+		Ports:          model.PortList{{Name: "http", Port: 80, Protocol: protocol.HTTP}},
+		Attributes:     model.ServiceAttributes{Name: "reviews", Namespace: "default"},
+	})
+	s.Discovery.ConfigUpdate(&model.PushRequest{Full: true})
+	s.EnsureSynced(t)
+	proxy := s.SetupProxy(&model.Proxy{ID: "productpage.default", ConfigNamespace: "default", IPAddresses: []string{"10.1.0.2"}})
+
+	var out bytes.Buffer
+	assert.NoError(t, ReplayConfigSnapshot(&out, s.Discovery.buildConfigSnapshot(proxy)))
+	var headers []string
+	for _, line := range strings.Split(out.String(), "\n") {
+		if strings.HasPrefix(line, "# ") {
+			headers = append(headers, line)
+		}
+	}
+	assert.Equal(t, headers, []string{"# listeners", "# clusters", "# routes", "# endpoints"})
+	assert.Equal(t, strings.Contains(out.String(), `"name": "outbound|80||reviews.default.svc.cluster.local"`), true)
+	assert.Equal(t, strings.Contains(out.String(), `"name": "outbound|443||external.example.com"`), true)
+}
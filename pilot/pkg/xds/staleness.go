@@ -0,0 +1,98 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"sort"
+	"time"
+
+	"istio.io/istio/pilot/pkg/model"
+	v3 "istio.io/istio/pilot/pkg/xds/v3"
+)
+
+// recordNack records that the response of the given nonce was rejected by the proxy. A rejected response is not stale:
+// the proxy processed it, and the rejection is reported on its own.
+func (conn *Connection) recordNack(typeURL, nonce string) {
+	conn.proxy.Lock()
+	defer conn.proxy.Unlock()
+	if w := conn.proxy.WatchedResources[typeURL]; w != nil {
+		w.NonceNacked = nonce
+	}
+}
+
+// staleTypes returns the types of the resources whose last response was sent to the proxy more than maxStaleness
+// before now, and was neither ACKed nor NACKed since.
+func (conn *Connection) staleTypes(now time.Time, maxStaleness time.Duration) []string {
+	conn.proxy.RLock()
+	defer conn.proxy.RUnlock()
+	var stale []string
+	for typeURL, w := range conn.proxy.WatchedResources {
+		if w.NonceSent == "" || w.NonceSent == w.NonceAcked || w.NonceSent == w.NonceNacked {
+			continue
+		}
+		if now.Sub(w.LastSent) > maxStaleness {
+			stale = append(stale, typeURL)
+		}
+	}
+	sort.Strings(stale)
+	return stale
+}
+
+// resyncStaleProxies periodically forces a full push to the proxies which did not ACK the responses sent to them
+// within maxStaleness. A stuck proxy otherwise goes unnoticed until its outdated configuration breaks the traffic.
+// A proxy is resynced at most once per maxStaleness, so that a stream which cannot be written to is not pushed to
+// on every check.
+func (s *DiscoveryServer) resyncStaleProxies(stopCh <-chan struct{}, maxStaleness time.Duration) {
+	ticker := time.NewTicker(max(maxStaleness/2, time.Second))
+	defer ticker.Stop()
+	resynced := map[string]time.Time{}
+	for {
+		select {
+		case <-ticker.C:
+			now := time.Now()
+			current := map[string]time.Time{}
+			for _, con := range s.AllClients() {
+				if last, f := resynced[con.conID]; f && now.Sub(last) < maxStaleness {
+					current[con.conID] = last
+					continue
+				}
+				stale := con.staleTypes(now, maxStaleness)
+				if len(stale) == 0 {
+					continue
+				}
+				s.resyncStaleProxy(con, stale, maxStaleness)
+				current[con.conID] = now
+			}
+			resynced = current
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+func (s *DiscoveryServer) resyncStaleProxy(con *Connection, stale []string, maxStaleness time.Duration) {
+	types := make([]string, 0, len(stale))
+	for _, typeURL := range stale {
+		types = append(types, v3.GetShortType(typeURL))
+		staleProxyResyncs.With(typeTag.Value(v3.GetMetricType(typeURL))).Increment()
+	}
+	log.Warnf("ADS: proxy %s did not acknowledge %v within %v, forcing a resync", con.proxy.ID, types, maxStaleness)
+	s.pushQueue.Enqueue(con, &model.PushRequest{
+		Full:   true,
+		Push:   s.globalPushContext(),
+		Start:  time.Now(),
+		Reason: model.NewReasonStats(model.StaleProxy),
+	})
+}
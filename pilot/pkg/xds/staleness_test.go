@@ -0,0 +1,47 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xds
+
+import (
+	"testing"
+	"time"
+
+	"istio.io/istio/pilot/pkg/model"
+	v3 "istio.io/istio/pilot/pkg/xds/v3"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestStaleTypes(t *testing.T) {
+	now := time.Now()
+	con := &Connection{proxy: &model.Proxy{WatchedResources: map[string]*model.WatchedResource{
+		// Never sent
+		v3.SecretType: {TypeUrl: v3.SecretType},
+		// Acked
+		v3.ClusterType: {TypeUrl: v3.ClusterType, NonceSent: "c2", NonceAcked: "c2", LastSent: now.Add(-time.Hour)},
+		// Nacked
+		v3.ListenerType: {TypeUrl: v3.ListenerType, NonceSent: "l2", NonceAcked: "l1", NonceNacked: "l2", LastSent: now.Add(-time.Hour)},
+		// Pending, but recently sent
+		v3.RouteType: {TypeUrl: v3.RouteType, NonceSent: "r2", NonceAcked: "r1", LastSent: now.Add(-time.Second)},
+		// Stale
+		v3.EndpointType: {TypeUrl: v3.EndpointType, NonceSent: "e2", NonceAcked: "e1", LastSent: now.Add(-time.Hour)},
+	}}}
+
+	assert.Equal(t, con.staleTypes(now, time.Minute), []string{v3.EndpointType})
+
+	con.recordNack(v3.EndpointType, "e2")
+	assert.Equal(t, con.staleTypes(now, time.Minute), nil)
+	// The recently sent routes become stale past the maximum staleness
+	assert.Equal(t, con.staleTypes(now.Add(time.Minute), time.Minute), []string{v3.RouteType})
+}
@@ -0,0 +1,65 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package clock provides the time perceived by istiod and the agents when checking expiry times, e.g. of
+// certificates, JWTs and cached entries. It can be skewed to test the behaviors depending on the passage of time in
+// minutes, rather than waiting for hours or forging certificates.
+package clock
+
+import (
+	"time"
+
+	"istio.io/istio/pkg/env"
+	"istio.io/istio/pkg/log"
+)
+
+// skew is added to the current time. Certificates are still issued at the current time, as they are validated by
+// Envoy, whose clock cannot be skewed.
+var skew = validSkew(env.Register("ISTIO_CLOCK_SKEW", time.Duration(0),
+	"For testing only: skews the time perceived by istiod and the agents when checking the expiry of certificates, "+
+		"JWTs and cached entries, e.g. 72h to check the rotation of certificates expiring in 3 days. "+
+		"Negative values are ignored.").Get())
+
+// validSkew only allows the time to be skewed forward, so that the expiry checks can only become stricter: moving
+// the time backwards would accept expired certificates and JWTs.
+func validSkew(skew time.Duration) time.Duration {
+	if skew < 0 {
+		log.Errorf("ignoring the negative ISTIO_CLOCK_SKEW %v: the time can only be skewed forward", skew)
+		return 0
+	}
+	if skew > 0 {
+		log.Warnf("the time is skewed by ISTIO_CLOCK_SKEW %v, which is only meant for testing", skew)
+	}
+	return skew
+}
+
+// Now returns the current time, skewed for testing.
+func Now() time.Time {
+	return time.Now().Add(skew)
+}
+
+// Since returns the time elapsed since t, according to Now.
+func Since(t time.Time) time.Duration {
+	return Now().Sub(t)
+}
+
+// Until returns the duration until t, according to Now.
+func Until(t time.Time) time.Duration {
+	return t.Sub(Now())
+}
+
+// Skew returns the skew of the time, zero outside of tests.
+func Skew() time.Duration {
+	return skew
+}
@@ -0,0 +1,42 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestSkew(t *testing.T) {
+	expiry := time.Now().Add(time.Hour)
+	assert.Equal(t, Until(expiry) > 59*time.Minute, true)
+
+	test.SetForTest(t, &skew, 2*time.Hour)
+	assert.Equal(t, Skew(), 2*time.Hour)
+	// The expiry is an hour in the past once the time is skewed
+	assert.Equal(t, Until(expiry) < -59*time.Minute, true)
+	assert.Equal(t, Since(expiry) > 59*time.Minute, true)
+	assert.Equal(t, Now().After(expiry), true)
+}
+
+func TestValidSkew(t *testing.T) {
+	assert.Equal(t, validSkew(0), time.Duration(0))
+	assert.Equal(t, validSkew(72*time.Hour), 72*time.Hour)
+	// A negative skew would accept expired certificates and JWTs
+	assert.Equal(t, validSkew(-time.Hour), time.Duration(0))
+}
@@ -0,0 +1,233 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audit records the config changes accepted or rejected by istiod, per namespace, for tenants who have no
+// access to the audit log of the cluster. Each istiod replica only records the changes it validated.
+package audit
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	istiolog "istio.io/istio/pkg/log"
+	"istio.io/istio/pkg/maps"
+	"istio.io/istio/pkg/slices"
+)
+
+var log = istiolog.RegisterScope("audit", "config change audit")
+
+// subscriberBuffer is the number of events buffered for a subscriber. Events are dropped for subscribers which do not
+// keep up, rather than blocking the validation of the config.
+const subscriberBuffer = 100
+
+// Event is the audit record of a config change submitted to istiod.
+type Event struct {
+	Time      time.Time `json:"time"`
+	Namespace string    `json:"namespace"`
+	Group     string    `json:"group"`
+	Version   string    `json:"version"`
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	// Operation is the operation of the change, CREATE, UPDATE or DELETE.
+	Operation string `json:"operation"`
+	// User is the user who submitted the change.
+	User string `json:"user"`
+	// Allowed is set if the change was accepted.
+	Allowed bool `json:"allowed"`
+	// Message is the reason the change was rejected for.
+	Message  string   `json:"message,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+	DryRun   bool     `json:"dryRun,omitempty"`
+	// Changes summarizes the fields the change modifies, see DiffSummary.
+	Changes []string `json:"changes,omitempty"`
+}
+
+// Log holds the last events of each namespace, and streams the new events to the subscribers of the namespace.
+// A nil Log records nothing.
+type Log struct {
+	size int
+
+	mu          sync.RWMutex
+	events      map[string][]Event
+	subscribers map[string]map[chan Event]struct{}
+	handlers    []func(namespace string)
+}
+
+// NewLog returns a Log keeping the last size events of each namespace.
+func NewLog(size int) *Log {
+	return &Log{
+		size:        size,
+		events:      map[string][]Event{},
+		subscribers: map[string]map[chan Event]struct{}{},
+	}
+}
+
+// Record adds an event to the log of its namespace.
+func (l *Log) Record(ev Event) {
+	if l == nil {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+	l.mu.Lock()
+	events := append(l.events[ev.Namespace], ev)
+	if len(events) > l.size {
+		// Copy rather than reslice, so the dropped events are released
+		events = append([]Event(nil), events[len(events)-l.size:]...)
+	}
+	l.events[ev.Namespace] = events
+	for ch := range l.subscribers[ev.Namespace] {
+		select {
+		case ch <- ev:
+		default:
+			log.Warnf("dropping audit event of %s/%s for a slow subscriber", ev.Namespace, ev.Name)
+		}
+	}
+	handlers := l.handlers
+	l.mu.Unlock()
+
+	for _, h := range handlers {
+		h(ev.Namespace)
+	}
+}
+
+// Events returns the events of the namespace, oldest first.
+func (l *Log) Events(namespace string) []Event {
+	if l == nil {
+		return nil
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return slices.Clone(l.events[namespace])
+}
+
+// Subscribe returns a channel receiving the new events of the namespace, and a function to cancel the subscription.
+func (l *Log) Subscribe(namespace string) (<-chan Event, func()) {
+	ch := make(chan Event, subscriberBuffer)
+	if l == nil {
+		return ch, func() {}
+	}
+	l.mu.Lock()
+	if l.subscribers[namespace] == nil {
+		l.subscribers[namespace] = map[chan Event]struct{}{}
+	}
+	l.subscribers[namespace][ch] = struct{}{}
+	l.mu.Unlock()
+	return ch, func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		delete(l.subscribers[namespace], ch)
+		if len(l.subscribers[namespace]) == 0 {
+			delete(l.subscribers, namespace)
+		}
+	}
+}
+
+// Namespaces returns the namespaces with events.
+func (l *Log) Namespaces() []string {
+	if l == nil {
+		return nil
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return maps.Keys(l.events)
+}
+
+// HasNamespace returns whether the namespace has events.
+func (l *Log) HasNamespace(namespace string) bool {
+	if l == nil {
+		return false
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	_, f := l.events[namespace]
+	return f
+}
+
+// DeleteNamespace forgets the events of a namespace, once it is deleted. The handlers are called, so that the events
+// are removed from the sinks as well.
+func (l *Log) DeleteNamespace(namespace string) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	if _, f := l.events[namespace]; !f {
+		l.mu.Unlock()
+		return
+	}
+	delete(l.events, namespace)
+	handlers := l.handlers
+	l.mu.Unlock()
+
+	for _, h := range handlers {
+		h(namespace)
+	}
+}
+
+// AddHandler registers a function called with the namespace of each recorded event, or deleted namespace.
+func (l *Log) AddHandler(h func(namespace string)) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.handlers = append(l.handlers, h)
+}
+
+// DiffSummary summarizes the changes between two revisions of a config, given as JSON. It lists the top level fields
+// of the spec which are added, removed or changed, along with the labels and annotations if they changed. The values
+// are not included, as they may hold sensitive data.
+func DiffSummary(oldJSON, newJSON []byte) []string {
+	var oldObj, newObj map[string]any
+	if len(oldJSON) > 0 {
+		_ = json.Unmarshal(oldJSON, &oldObj)
+	}
+	if len(newJSON) > 0 {
+		_ = json.Unmarshal(newJSON, &newObj)
+	}
+	if oldObj == nil {
+		return nil
+	}
+
+	var changes []string
+	oldMeta, _ := oldObj["metadata"].(map[string]any)
+	newMeta, _ := newObj["metadata"].(map[string]any)
+	for _, field := range []string{"labels", "annotations"} {
+		if !reflect.DeepEqual(oldMeta[field], newMeta[field]) {
+			changes = append(changes, "metadata."+field+" changed")
+		}
+	}
+
+	oldSpec, _ := oldObj["spec"].(map[string]any)
+	newSpec, _ := newObj["spec"].(map[string]any)
+	var spec []string
+	for field, v := range newSpec {
+		if ov, f := oldSpec[field]; !f {
+			spec = append(spec, "spec."+field+" added")
+		} else if !reflect.DeepEqual(ov, v) {
+			spec = append(spec, "spec."+field+" changed")
+		}
+	}
+	for field := range oldSpec {
+		if _, f := newSpec[field]; !f {
+			spec = append(spec, "spec."+field+" removed")
+		}
+	}
+	sort.Strings(spec)
+	return append(changes, spec...)
+}
@@ -0,0 +1,164 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func names(events []Event) []string {
+	var res []string
+	for _, ev := range events {
+		res = append(res, ev.Name)
+	}
+	return res
+}
+
+func TestLog(t *testing.T) {
+	l := NewLog(2)
+	events, cancel := l.Subscribe("ns")
+	l.Record(Event{Namespace: "ns", Name: "a"})
+	l.Record(Event{Namespace: "other", Name: "b"})
+	l.Record(Event{Namespace: "ns", Name: "c"})
+	l.Record(Event{Namespace: "ns", Name: "d"})
+
+	assert.Equal(t, names(l.Events("ns")), []string{"c", "d"})
+	assert.Equal(t, names(l.Events("other")), []string{"b"})
+	assert.Equal(t, l.Events("none"), nil)
+	for _, want := range []string{"a", "c", "d"} {
+		ev := <-events
+		assert.Equal(t, ev.Name, want)
+		if ev.Time.IsZero() {
+			t.Fatalf("time of event %s is not set", ev.Name)
+		}
+	}
+
+	cancel()
+	l.Record(Event{Namespace: "ns", Name: "e"})
+	select {
+	case ev := <-events:
+		t.Fatalf("unexpected event %s after cancel", ev.Name)
+	default:
+	}
+}
+
+func TestNilLog(t *testing.T) {
+	var l *Log
+	l.Record(Event{Namespace: "ns", Name: "a"})
+	l.DeleteNamespace("ns")
+	assert.Equal(t, l.Events("ns"), nil)
+	_, cancel := l.Subscribe("ns")
+	cancel()
+}
+
+func TestDiffSummary(t *testing.T) {
+	cases := []struct {
+		name     string
+		old, new string
+		want     []string
+	}{
+		{
+			name: "creation",
+			new:  `{"metadata":{"name":"a"},"spec":{"hosts":["a"]}}`,
+			want: nil,
+		},
+		{
+			name: "unchanged",
+			old:  `{"metadata":{"name":"a","resourceVersion":"1"},"spec":{"hosts":["a"]}}`,
+			new:  `{"metadata":{"name":"a","resourceVersion":"2"},"spec":{"hosts":["a"]}}`,
+			want: nil,
+		},
+		{
+			name: "spec and labels",
+			old:  `{"metadata":{"name":"a"},"spec":{"hosts":["a"],"http":[{"name":"x"}],"tls":[]}}`,
+			new:  `{"metadata":{"name":"a","labels":{"app":"a"}},"spec":{"hosts":["a"],"http":[{"name":"y"}],"tcp":[]}}`,
+			want: []string{"metadata.labels changed", "spec.http changed", "spec.tcp added", "spec.tls removed"},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, DiffSummary([]byte(tt.old), []byte(tt.new)), tt.want)
+		})
+	}
+}
+
+func TestMergeEvents(t *testing.T) {
+	at := func(s int) time.Time {
+		return time.Unix(int64(s), 0)
+	}
+	a := []Event{{Time: at(1), Name: "a"}, {Time: at(3), Name: "c"}}
+	b := []Event{{Time: at(2), Name: "b"}, {Time: at(3), Name: "c"}, {Time: at(4), Name: "d"}}
+	assert.Equal(t, names(mergeEvents(a, b, 10)), []string{"a", "b", "c", "d"})
+	assert.Equal(t, names(mergeEvents(a, b, 2)), []string{"c", "d"})
+}
+
+func TestConfigMapSink(t *testing.T) {
+	client := kube.NewFakeClient()
+	l := NewLog(3)
+	sink := NewConfigMapSink(client, l, "istio-system", time.Hour)
+	l.Record(Event{Time: time.Unix(1, 0), Namespace: "ns", Name: "a"})
+	l.Record(Event{Time: time.Unix(2, 0), Namespace: "ns", Name: "b"})
+	sink.flush()
+
+	read := func() []string {
+		t.Helper()
+		cm, err := client.Kube().CoreV1().ConfigMaps("istio-system").Get(context.TODO(), ConfigMapName("ns"), metav1.GetOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, cm.Labels[ConfigMapNamespaceLabel], "ns")
+		var events []Event
+		assert.NoError(t, json.Unmarshal([]byte(cm.Data[ConfigMapEventsKey]), &events))
+		return names(events)
+	}
+	assert.Equal(t, read(), []string{"a", "b"})
+
+	// The events written by another replica are kept
+	other := NewLog(3)
+	otherSink := NewConfigMapSink(client, other, "istio-system", time.Hour)
+	other.Record(Event{Time: time.Unix(3, 0), Namespace: "ns", Name: "c"})
+	otherSink.flush()
+	assert.Equal(t, read(), []string{"a", "b", "c"})
+
+	l.Record(Event{Time: time.Unix(4, 0), Namespace: "ns", Name: "d"})
+	sink.flush()
+	assert.Equal(t, read(), []string{"b", "c", "d"})
+
+	// The ConfigMap is deleted along with the events of the namespace
+	l.DeleteNamespace("ns")
+	sink.flush()
+	_, err := client.Kube().CoreV1().ConfigMaps("istio-system").Get(context.TODO(), ConfigMapName("ns"), metav1.GetOptions{})
+	assert.Equal(t, kerrors.IsNotFound(err), true)
+}
+
+func TestPruner(t *testing.T) {
+	client := kube.NewFakeClient(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns"}})
+	l := NewLog(3)
+	l.Record(Event{Namespace: "ns", Name: "a"})
+	l.Record(Event{Namespace: "deleted", Name: "b"})
+
+	NewPruner(client, l, time.Hour).prune()
+	assert.Equal(t, l.Namespaces(), []string{"ns"})
+	assert.Equal(t, names(l.Events("ns")), []string{"a"})
+	assert.Equal(t, l.HasNamespace("deleted"), false)
+}
@@ -0,0 +1,184 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/util/sets"
+)
+
+const (
+	// ConfigMapPrefix prefixes the name of the ConfigMaps holding the audit events of each namespace.
+	ConfigMapPrefix = "istio-config-audit-"
+	// ConfigMapNamespaceLabel is the label of the ConfigMaps set to the namespace of their events.
+	ConfigMapNamespaceLabel = "istio.io/config-audit-namespace"
+	// ConfigMapEventsKey is the key of the ConfigMap data holding the JSON list of events, oldest first.
+	ConfigMapEventsKey = "events"
+)
+
+// ConfigMapName returns the name of the ConfigMap holding the audit events of a namespace.
+func ConfigMapName(namespace string) string {
+	return ConfigMapPrefix + namespace
+}
+
+// ConfigMapSink writes the events of each namespace to a ConfigMap of the system namespace, acting as a ring buffer of
+// the last events. The ConfigMaps are kept out of the namespaces of the tenants, who could otherwise forge events.
+// The writes of a namespace are batched, so a burst of changes results in a single update.
+// Each istiod replica only records the changes it validated, so the events of the ConfigMap are merged with those of
+// the replica rather than replaced. The ConfigMap of a namespace is deleted along with its events.
+type ConfigMapSink struct {
+	log             *Log
+	client          kubernetes.Interface
+	systemNamespace string
+	interval        time.Duration
+
+	mu    sync.Mutex
+	dirty sets.String
+}
+
+// NewConfigMapSink returns a sink writing the events of the log to the system namespace every interval.
+func NewConfigMapSink(client kube.Client, l *Log, systemNamespace string, interval time.Duration) *ConfigMapSink {
+	s := &ConfigMapSink{
+		log:             l,
+		client:          client.Kube(),
+		systemNamespace: systemNamespace,
+		interval:        interval,
+		dirty:           sets.New[string](),
+	}
+	l.AddHandler(func(namespace string) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.dirty.Insert(namespace)
+	})
+	return s
+}
+
+// Run writes the ConfigMaps of the namespaces with new events until stop is closed.
+func (s *ConfigMapSink) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			s.flush()
+		}
+	}
+}
+
+func (s *ConfigMapSink) flush() {
+	s.mu.Lock()
+	dirty := s.dirty
+	s.dirty = sets.New[string]()
+	s.mu.Unlock()
+
+	for namespace := range dirty {
+		if err := s.write(namespace); err != nil {
+			log.Warnf("failed to write audit events of namespace %s: %v", namespace, err)
+			// Retry with the next flush
+			s.mu.Lock()
+			s.dirty.Insert(namespace)
+			s.mu.Unlock()
+		}
+	}
+}
+
+func (s *ConfigMapSink) write(namespace string) error {
+	configmaps := s.client.CoreV1().ConfigMaps(s.systemNamespace)
+	if !s.log.HasNamespace(namespace) {
+		err := configmaps.Delete(context.TODO(), ConfigMapName(namespace), metav1.DeleteOptions{})
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	cm, err := configmaps.Get(context.TODO(), ConfigMapName(namespace), metav1.GetOptions{})
+	notFound := kerrors.IsNotFound(err)
+	if err != nil && !notFound {
+		return err
+	}
+	var existing []Event
+	if !notFound {
+		if data := cm.Data[ConfigMapEventsKey]; data != "" {
+			if err := json.Unmarshal([]byte(data), &existing); err != nil {
+				log.Warnf("replacing invalid audit events of namespace %s: %v", namespace, err)
+				existing = nil
+			}
+		}
+	}
+	data, err := json.Marshal(mergeEvents(existing, s.log.Events(namespace), s.log.size))
+	if err != nil {
+		return err
+	}
+
+	if notFound {
+		_, err = configmaps.Create(context.TODO(), &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      ConfigMapName(namespace),
+				Namespace: s.systemNamespace,
+				Labels:    map[string]string{ConfigMapNamespaceLabel: namespace},
+			},
+			Data: map[string]string{ConfigMapEventsKey: string(data)},
+		}, metav1.CreateOptions{})
+		return err
+	}
+	// The resource version is kept, so concurrent writes of other replicas are retried rather than lost
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[ConfigMapEventsKey] = string(data)
+	_, err = configmaps.Update(context.TODO(), cm, metav1.UpdateOptions{})
+	return err
+}
+
+// mergeEvents merges two lists of events sorted by time, without duplicates, keeping the last size events.
+func mergeEvents(a, b []Event, size int) []Event {
+	seen := sets.New[string]()
+	var out []Event
+	for _, ev := range append(slices.Clone(a), b...) {
+		key := eventKey(ev)
+		if seen.InsertContains(key) {
+			continue
+		}
+		out = append(out, ev)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Time.Before(out[j].Time)
+	})
+	if len(out) > size {
+		out = out[len(out)-size:]
+	}
+	return out
+}
+
+func eventKey(ev Event) string {
+	return strings.Join([]string{
+		ev.Time.UTC().Format(time.RFC3339Nano), ev.Namespace, ev.Group, ev.Kind, ev.Name, ev.Operation, ev.User,
+	}, "/")
+}
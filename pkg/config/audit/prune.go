@@ -0,0 +1,70 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"time"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"istio.io/istio/pkg/kube"
+)
+
+// Pruner forgets the events of the deleted namespaces. The namespaces of the log are checked one by one rather than
+// watched, as istiod is not allowed to watch all the namespaces of the cluster in multi-tenant mode.
+type Pruner struct {
+	log      *Log
+	client   kubernetes.Interface
+	interval time.Duration
+}
+
+// NewPruner returns a pruner checking the namespaces of the log every interval.
+func NewPruner(client kube.Client, l *Log, interval time.Duration) *Pruner {
+	return &Pruner{
+		log:      l,
+		client:   client.Kube(),
+		interval: interval,
+	}
+}
+
+// Run prunes the deleted namespaces until stop is closed.
+func (p *Pruner) Run(stop <-chan struct{}) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			p.prune()
+		}
+	}
+}
+
+func (p *Pruner) prune() {
+	for _, namespace := range p.log.Namespaces() {
+		_, err := p.client.CoreV1().Namespaces().Get(context.TODO(), namespace, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			log.Debugf("forgetting the audit events of the deleted namespace %s", namespace)
+			p.log.DeleteNamespace(namespace)
+		} else if err != nil {
+			// The namespace is kept, e.g. if istiod is not allowed to read it
+			log.Debugf("failed to check whether namespace %s exists: %v", namespace, err)
+		}
+	}
+}
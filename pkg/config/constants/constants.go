@@ -138,6 +138,10 @@ const (
 	RouteSemanticsIngress  = "ingress"
 	RouteSemanticsGateway  = "gateway"
 
+	// InternalAdditionalSubsets marks an internally-generated DestinationRule which only declares subsets. Its subsets are
+	// added to the DestinationRules applying to its host in its namespace, instead of taking precedence over them.
+	InternalAdditionalSubsets = "internal.istio.io/additional-subsets"
+
 	// TrustworthyJWTPath is the default 3P token to authenticate with third party services
 	TrustworthyJWTPath = "./var/run/secrets/tokens/istio-token"
 
@@ -188,4 +192,12 @@ const (
 	AmbientRedirectionEnabled = "enabled"
 	// AmbientRedirectionDisabled is an opt-out, configured by user.
 	AmbientRedirectionDisabled = "disabled"
+
+	// AmbientEnrollmentConfigMapName is the name of the ConfigMap of the system namespace where the control plane
+	// publishes the dataplane mode of the namespaces, suffixed by the revision if not default. The CNI agent decides the
+	// enrollment of pods from it, rather than from the labels of the namespaces.
+	AmbientEnrollmentConfigMapName = "istio-ambient-enrollment"
+	// AmbientEnrollmentNamespacesKey is the key of the enrollment ConfigMap data holding the JSON map of namespace
+	// names to their dataplane mode.
+	AmbientEnrollmentNamespacesKey = "namespaces"
 )
@@ -4015,7 +4015,7 @@ var ValidateWasmPlugin = registerValidateFunc("ValidateWasmPlugin",
 		errs = appendValidation(errs,
 			validateOneOfSelectorType(spec.GetSelector(), spec.GetTargetRef()),
 			validateWorkloadSelector(spec.GetSelector()),
-			validatePolicyTargetReference(spec.GetTargetRef()),
+			validateWasmPluginTargetReference(spec.GetTargetRef()),
 			validateWasmPluginURL(spec.Url),
 			validateWasmPluginSHA(spec),
 			validateWasmPluginVMConfig(spec.VmConfig),
@@ -4024,6 +4024,23 @@ var ValidateWasmPlugin = registerValidateFunc("ValidateWasmPlugin",
 		return errs.Unwrap()
 	})
 
+// validateWasmPluginTargetReference validates the targetRef of a WasmPlugin. Unlike other policies, a WasmPlugin may set the
+// namespace of the targeted Gateway, which is only honored for plugins in the root namespace.
+func validateWasmPluginTargetReference(targetRef *type_beta.PolicyTargetReference) (v Validation) {
+	if targetRef == nil {
+		return
+	}
+	v = validatePolicyTargetReference(&type_beta.PolicyTargetReference{
+		Group: targetRef.Group,
+		Kind:  targetRef.Kind,
+		Name:  targetRef.Name,
+	})
+	if targetRef.Namespace != "" && !labels.IsDNS1123Label(targetRef.Namespace) {
+		v = appendErrorf(v, "targetRef namespace %q is not a valid namespace name", targetRef.Namespace)
+	}
+	return
+}
+
 func validateWasmPluginURL(pluginURL string) error {
 	if pluginURL == "" {
 		return fmt.Errorf("url field needs to be set")
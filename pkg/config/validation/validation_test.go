@@ -8972,7 +8972,7 @@ func TestValidateWasmPlugin(t *testing.T) {
 			"", "",
 		},
 		{
-			"target-ref-non-empty-namespace",
+			"target-ref-namespace",
 			&extensions.WasmPlugin{
 				Url: "http://test.com/test",
 				TargetRef: &api.PolicyTargetReference{
@@ -8982,7 +8982,20 @@ func TestValidateWasmPlugin(t *testing.T) {
 					Namespace: "bar",
 				},
 			},
-			"targetRef namespace must not be set", "",
+			"", "",
+		},
+		{
+			"target-ref-invalid-namespace",
+			&extensions.WasmPlugin{
+				Url: "http://test.com/test",
+				TargetRef: &api.PolicyTargetReference{
+					Group:     gvk.KubernetesGateway.Group,
+					Kind:      gvk.KubernetesGateway.Kind,
+					Name:      "foo",
+					Namespace: "Bar_",
+				},
+			},
+			"targetRef namespace \"Bar_\" is not a valid namespace name", "",
 		},
 		{
 			"target-ref-empty-name",
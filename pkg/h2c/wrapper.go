@@ -15,6 +15,7 @@
 package h2c
 
 import (
+	"context"
 	"net/http"
 	"net/textproto"
 
@@ -47,3 +48,40 @@ func isH2CUpgrade(h http.Header) bool {
 	return httpguts.HeaderValuesContainsToken(h[textproto.CanonicalMIMEHeaderKey("Upgrade")], "h2c") &&
 		httpguts.HeaderValuesContainsToken(h[textproto.CanonicalMIMEHeaderKey("Connection")], "HTTP2-Settings")
 }
+
+// Modes of a cleartext HTTP/2 connection, reported by Mode.
+const (
+	ModePriorKnowledge = "prior-knowledge"
+	ModeUpgrade        = "upgrade"
+)
+
+type modeKey struct{}
+
+// NewUpgradeHandler returns an http.Handler that wraps h, intercepting any h2c traffic, including h2c Upgrades.
+// As h2c Upgrades are not safe in Go's implementation, this must only be used by test servers exercising the
+// upgrade path; use NewHandler otherwise.
+// The mode the connection was established with is available to h from the request, see Mode.
+func NewUpgradeHandler(h http.Handler, s *http2.Server) http.Handler {
+	upgrade := h2c.NewHandler(h, s)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mode := ""
+		switch {
+		case r.Method == "PRI" && r.URL.Path == "*" && r.Proto == "HTTP/2.0":
+			mode = ModePriorKnowledge
+		case isH2CUpgrade(r.Header):
+			mode = ModeUpgrade
+		}
+		if mode != "" {
+			// The requests of the HTTP/2 connection inherit the context of the request establishing it
+			r = r.WithContext(context.WithValue(r.Context(), modeKey{}, mode))
+		}
+		upgrade.ServeHTTP(w, r)
+	})
+}
+
+// Mode returns how the cleartext HTTP/2 connection of a request served by a NewUpgradeHandler was established,
+// either ModePriorKnowledge or ModeUpgrade. It is empty for other requests.
+func Mode(r *http.Request) string {
+	mode, _ := r.Context().Value(modeKey{}).(string)
+	return mode
+}
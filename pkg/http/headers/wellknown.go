@@ -21,4 +21,6 @@ const (
 	AccessControlRequestMethod = "Access-Control-Request-Method"
 	Origin                     = "Origin"
 	XForwardedProto            = "X-Forwarded-Proto"
+	AcceptEncoding             = "Accept-Encoding"
+	ContentEncoding            = "Content-Encoding"
 )
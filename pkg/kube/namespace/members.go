@@ -0,0 +1,186 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	memberroll "istio.io/istio/pkg/servicemesh/controller"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// MemberNamespaces holds the namespaces of a member roll. Namespaces cannot be listed nor watched cluster-wide with
+// a member roll, so each member is watched by name while it is in the member roll.
+type MemberNamespaces struct {
+	client kubernetes.Interface
+
+	mu       sync.RWMutex
+	members  map[string]*memberWatch
+	handlers []func(ns string)
+	stopped  bool
+}
+
+// memberWatch watches a single member namespace, until stop is closed.
+type memberWatch struct {
+	informer cache.SharedIndexInformer
+	stop     chan struct{}
+}
+
+var _ memberroll.MemberRollListener = &MemberNamespaces{}
+
+// NewMemberNamespaces returns the namespaces of the member roll, watched until Shutdown is called.
+func NewMemberNamespaces(client kubernetes.Interface, mrc memberroll.MemberRollController, name string) *MemberNamespaces {
+	m := &MemberNamespaces{client: client, members: map[string]*memberWatch{}}
+	mrc.Register(m, name)
+	return m
+}
+
+// SetNamespaces watches the namespaces added to the member roll, and stops watching the removed ones.
+func (m *MemberNamespaces) SetNamespaces(namespaces []string) {
+	m.mu.Lock()
+	if m.stopped {
+		m.mu.Unlock()
+		return
+	}
+	want := sets.New(namespaces...)
+	var changed []string
+	for ns, w := range m.members {
+		if !want.Contains(ns) {
+			close(w.stop)
+			delete(m.members, ns)
+			changed = append(changed, ns)
+		}
+	}
+	for ns := range want {
+		if _, f := m.members[ns]; !f {
+			m.members[ns] = m.watch(ns)
+			changed = append(changed, ns)
+		}
+	}
+	handlers := m.handlers
+	m.mu.Unlock()
+
+	for _, ns := range changed {
+		for _, h := range handlers {
+			h(ns)
+		}
+	}
+}
+
+// watch starts an informer listing and watching the namespace by name.
+func (m *MemberNamespaces) watch(ns string) *memberWatch {
+	selector := fields.OneTermEqualSelector(metav1.ObjectNameField, ns).String()
+	namespaces := m.client.CoreV1().Namespaces()
+	informer := cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				options.FieldSelector = selector
+				return namespaces.List(context.Background(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				options.FieldSelector = selector
+				return namespaces.Watch(context.Background(), options)
+			},
+		},
+		&corev1.Namespace{},
+		0,
+		cache.Indexers{},
+	)
+	_, _ = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(any) { m.notify(ns) },
+		UpdateFunc: func(any, any) { m.notify(ns) },
+		DeleteFunc: func(any) { m.notify(ns) },
+	})
+	w := &memberWatch{informer: informer, stop: make(chan struct{})}
+	go informer.Run(w.stop)
+	return w
+}
+
+func (m *MemberNamespaces) notify(ns string) {
+	m.mu.RLock()
+	handlers := m.handlers
+	m.mu.RUnlock()
+	for _, h := range handlers {
+		h(ns)
+	}
+}
+
+// AddHandler registers a handler called with the name of a namespace when it is added to or removed from the member
+// roll, or when it changes.
+func (m *MemberNamespaces) AddHandler(f func(ns string)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.handlers = append(m.handlers, f)
+}
+
+// Get returns the member namespace, or nil if it is not a member or does not exist.
+func (m *MemberNamespaces) Get(name string) *corev1.Namespace {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	w, f := m.members[name]
+	if !f {
+		return nil
+	}
+	obj, exists, err := w.informer.GetStore().GetByKey(name)
+	if err != nil || !exists {
+		return nil
+	}
+	return obj.(*corev1.Namespace)
+}
+
+// List returns the member namespaces which exist.
+func (m *MemberNamespaces) List() []*corev1.Namespace {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	namespaces := make([]*corev1.Namespace, 0, len(m.members))
+	for _, w := range m.members {
+		for _, obj := range w.informer.GetStore().List() {
+			namespaces = append(namespaces, obj.(*corev1.Namespace))
+		}
+	}
+	return namespaces
+}
+
+// HasSynced returns whether all the members are synced. A member which is not synced yet is missing from Get and List.
+func (m *MemberNamespaces) HasSynced() bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, w := range m.members {
+		if !w.informer.HasSynced() {
+			return false
+		}
+	}
+	return true
+}
+
+// Shutdown stops watching the members.
+func (m *MemberNamespaces) Shutdown() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopped = true
+	for ns, w := range m.members {
+		close(w.stop)
+		delete(m.members, ns)
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// WithTeeToJSONFile configures a parallel logging pipeline that writes the log messages as JSON lines to a rotating
+// file, whatever the encoding of the other outputs. The file is rotated once it reaches maxSize megabytes, and at most
+// maxBackups rotated files are retained, so the disk usage is bounded. This is typically used to persist the logs of
+// a process to its host, so they outlive the process for postmortems.
+func (o *Options) WithTeeToJSONFile(path string, maxSize, maxBackups int) *Options {
+	// The pipelines of the standard and captured logs share the file, so they must share its rotation
+	sink := &lumberjack.Logger{
+		Filename:   path,
+		MaxSize:    maxSize,
+		MaxBackups: maxBackups,
+	}
+	return o.WithExtension(func(c zapcore.Core) (zapcore.Core, func() error, error) {
+		fileCore := zapcore.NewCore(zapcore.NewJSONEncoder(defaultEncoderConfig), zapcore.AddSync(sink), zap.LevelEnablerFunc(c.Enabled))
+		return zapcore.NewTee(c, fileCore), sink.Close, nil
+	})
+}
@@ -0,0 +1,65 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestJSONFileLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "test.log")
+	// The standard output is not JSON encoded, but the file is
+	loggingOptions := DefaultOptions()
+	if err := Configure(loggingOptions.WithTeeToJSONFile(path, 1, 1)); err != nil {
+		t.Fatal(err)
+	}
+
+	WithLabels("k", "v").Info("test")
+	Debug("hidden")
+	Warn("test2")
+	Sync()
+
+	type testMessage struct {
+		Msg   string `json:"msg"`
+		Level string `json:"level"`
+		K     string `json:"k"`
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	var got []testMessage
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var m testMessage
+		if err := json.Unmarshal(scanner.Bytes(), &m); err != nil {
+			t.Fatalf("invalid log line %q: %v", scanner.Text(), err)
+		}
+		got = append(got, m)
+	}
+	want := []testMessage{
+		{Msg: "test", Level: "info", K: "v"},
+		{Msg: "test2", Level: "warn"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("logged messages, got %v want %v", got, want)
+	}
+}
@@ -26,6 +26,15 @@ const (
 	DefaultResyncPeriod           = 60 * time.Second
 	DefaultFederationPort         = 15443
 	DefaultFederationRootCertName = "root-cert.pem"
+	// GatewayExportAnnotation lists the ServiceMeshPeers, comma separated, whose routes can attach to the HTTP
+	// listeners of a Gateway API Gateway. "*" exports the Gateway to all the peers.
+	GatewayExportAnnotation = "federation.maistra.io/export-to"
+	// TenantPeerAnnotation marks a ServiceMeshPeer as another tenant of the same cluster, e.g. another control plane
+	// with its own member roll. The services selected by the ExportedServiceSet and ImportedServiceSet of the peer are
+	// called directly on their addresses with mutual TLS, rather than through the federation gateways. The peer must
+	// have its own trust domain, see ValidateTenantPeer. No AuthorizationPolicy is generated: the network policies and
+	// the authorization policies of the exporting tenant must allow the principals of the peer's trust domain.
+	TenantPeerAnnotation = "federation.maistra.io/tenant"
 )
 
 var Logger = log.RegisterScope("federation", "federation")
@@ -38,6 +38,24 @@ func DiscoveryServiceHostname(instance *v1.ServiceMeshPeer) string {
 	return fmt.Sprintf("discovery.%s.svc.%s.local", instance.Namespace, instance.Name)
 }
 
+// IsTenantPeer returns whether a ServiceMeshPeer is another tenant of the same cluster.
+func IsTenantPeer(instance *v1.ServiceMeshPeer) bool {
+	return instance != nil && instance.Annotations[TenantPeerAnnotation] == "true"
+}
+
+// ValidateTenantPeer returns an error if a tenant peer shares the trust domain of the local mesh. The workloads of the
+// tenants authenticate each other directly, which requires the root certificate of the peer to be trusted for its
+// own trust domain.
+func ValidateTenantPeer(instance *v1.ServiceMeshPeer, localTrustDomain string) error {
+	if !IsTenantPeer(instance) {
+		return nil
+	}
+	if trustDomain := instance.Spec.Security.TrustDomain; trustDomain == "" || trustDomain == localTrustDomain {
+		return fmt.Errorf("tenant ServiceMeshPeer %s/%s must specify a trust domain other than %q", instance.Namespace, instance.Name, localTrustDomain)
+	}
+	return nil
+}
+
 // DefaultFederationCARootResourceName is the default name used for the resource
 // containing the root CA for a remote mesh.
 func DefaultFederationCARootResourceName(instance *v1.ServiceMeshPeer) string {
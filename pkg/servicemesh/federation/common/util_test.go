@@ -17,6 +17,9 @@ package common
 import (
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "maistra.io/api/federation/v1"
+
 	"istio.io/istio/pkg/cluster"
 	"istio.io/istio/pkg/config/labels"
 	federationmodel "istio.io/istio/pkg/servicemesh/federation/model"
@@ -86,3 +89,30 @@ func TestFormatResourceName(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTenantPeer(t *testing.T) {
+	peer := func(annotations map[string]string, trustDomain string) *v1.ServiceMeshPeer {
+		return &v1.ServiceMeshPeer{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenant-b", Namespace: "tenant-a-system", Annotations: annotations},
+			Spec:       v1.ServiceMeshPeerSpec{Security: v1.ServiceMeshPeerSecurity{TrustDomain: trustDomain}},
+		}
+	}
+	tenant := map[string]string{TenantPeerAnnotation: "true"}
+	testCases := []struct {
+		name    string
+		peer    *v1.ServiceMeshPeer
+		invalid bool
+	}{
+		{name: "federated mesh sharing the trust domain", peer: peer(nil, "cluster.local")},
+		{name: "tenant with its own trust domain", peer: peer(tenant, "tenant-b.local")},
+		{name: "tenant sharing the trust domain", peer: peer(tenant, "cluster.local"), invalid: true},
+		{name: "tenant without trust domain", peer: peer(tenant, ""), invalid: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := ValidateTenantPeer(tc.peer, "cluster.local"); (err != nil) != tc.invalid {
+				t.Fatalf("expected invalid=%v, got %v", tc.invalid, err)
+			}
+		})
+	}
+}
@@ -162,6 +162,9 @@ func (c *Controller) reconcile(resourceName string) error {
 }
 
 func (c *Controller) update(ctx context.Context, instance *v1.ServiceMeshPeer) error {
+	if err := common.ValidateTenantPeer(instance, c.env.Mesh().GetTrustDomain()); err != nil {
+		return err
+	}
 	if instance.Spec.Security.TrustDomain != "" && instance.Spec.Security.TrustDomain != c.env.Mesh().GetTrustDomain() {
 		rootCert, err := c.getRootCertForMesh(instance)
 		if err != nil {
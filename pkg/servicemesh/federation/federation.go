@@ -28,6 +28,7 @@ import (
 	"istio.io/istio/pilot/pkg/model"
 	"istio.io/istio/pilot/pkg/serviceregistry/aggregate"
 	"istio.io/istio/pkg/config/schema/collection"
+	"istio.io/istio/pkg/config/schema/gvk"
 	"istio.io/istio/pkg/config/schema/resource"
 	"istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/log"
@@ -172,6 +173,11 @@ func (f *Federation) RegisterServiceHandlers(serviceController *aggregate.Contro
 	serviceController.AppendServiceHandler(f.server.UpdateService)
 }
 
+// RegisterConfigHandlers exports the Gateway API Gateways to the peers as they change.
+func (f *Federation) RegisterConfigHandlers(configController model.ConfigStoreController) {
+	configController.RegisterEventHandler(gvk.KubernetesGateway, f.server.UpdateGateway)
+}
+
 func (f *Federation) StartControllers(stopCh <-chan struct{}) {
 	go f.leaderElection.Run(stopCh)
 	go f.exportController.Start(stopCh)
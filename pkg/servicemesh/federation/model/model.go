@@ -25,6 +25,8 @@ type ServiceKey struct {
 type ServiceListMessage struct {
 	Checksum uint64            `json:"checksum" hash:"ignore"`
 	Services []*ServiceMessage `json:"services,omitempty" hash:"set"`
+	// Gateways are hashed apart, see GenerateChecksum
+	Gateways []*GatewayMessage `json:"gateways,omitempty" hash:"ignore"`
 }
 
 type ServiceMessage struct {
@@ -34,6 +36,9 @@ type ServiceMessage struct {
 	ServiceKey      `json:",inline"`
 	ServicePorts    []*ServicePort `json:"servicePorts,omitempty"`
 	ServiceAccounts []string       `json:"serviceAccounts,omitempty"`
+	// Addresses are the cluster addresses of the service, only exported to tenant peers which call it directly.
+	// They are hashed apart, see addressesChecksum.
+	Addresses []string `json:"addresses,omitempty" hash:"ignore"`
 }
 
 type ServicePort struct {
@@ -47,6 +52,20 @@ type ServiceEndpoint struct {
 	Hostname string `json:"hostname,omitempty"`
 }
 
+// GatewayMessage is a Gateway API Gateway of the mesh, which the routes of the peer can attach to.
+type GatewayMessage struct {
+	Name      string             `json:"name,omitempty"`
+	Namespace string             `json:"namespace,omitempty"`
+	Listeners []*GatewayListener `json:"listeners,omitempty"`
+}
+
+type GatewayListener struct {
+	Name     string `json:"name,omitempty"`
+	Hostname string `json:"hostname,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	Protocol string `json:"protocol,omitempty"`
+}
+
 type WatchEvent struct {
 	Action   string          `json:"action,omitempty"`
 	Service  *ServiceMessage `json:"service,omitempty"`
@@ -64,6 +83,17 @@ func (s *ServiceListMessage) GenerateChecksum() uint64 {
 	if err != nil {
 		return 0
 	}
+	if len(s.Gateways) > 0 {
+		// Without gateways, the checksum is the one computed by the peers which do not know about them
+		gateways, err := hashstructure.Hash(s.Gateways, hashstructure.FormatV2, &hashstructure.HashOptions{SlicesAsSets: true})
+		if err != nil {
+			return 0
+		}
+		checksum ^= gateways
+	}
+	for _, svc := range s.Services {
+		checksum ^= svc.addressesChecksum()
+	}
 	return checksum
 }
 
@@ -72,6 +102,22 @@ func (s *ServiceMessage) GenerateChecksum() uint64 {
 	if err != nil {
 		return 0
 	}
+	return checksum ^ s.addressesChecksum()
+}
+
+// addressesChecksum hashes the addresses of a service, if any. Without addresses, the checksums are the ones computed
+// by the peers which do not know about them.
+func (s *ServiceMessage) addressesChecksum() uint64 {
+	if len(s.Addresses) == 0 {
+		return 0
+	}
+	checksum, err := hashstructure.Hash(struct {
+		Hostname  string
+		Addresses []string
+	}{s.Hostname, s.Addresses}, hashstructure.FormatV2, &hashstructure.HashOptions{SlicesAsSets: true})
+	if err != nil {
+		return 0
+	}
 	return checksum
 }
 
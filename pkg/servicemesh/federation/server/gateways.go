@@ -0,0 +1,106 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/servicemesh/federation/common"
+	federationmodel "istio.io/istio/pkg/servicemesh/federation/model"
+)
+
+// UpdateGateway refreshes the Gateways exported to the peers when a Gateway API Gateway changes.
+func (s *Server) UpdateGateway(_, _ config.Config, _ model.Event) {
+	s.meshes.Range(func(_, value any) bool {
+		ms := value.(*meshServer)
+		ms.Lock()
+		defer ms.Unlock()
+		ms.updateGateways()
+		return true
+	})
+}
+
+// gatewayExportedTo returns whether the value of the GatewayExportAnnotation of a Gateway exports it to the mesh.
+func gatewayExportedTo(annotation, mesh string) bool {
+	for _, name := range strings.Split(annotation, ",") {
+		name = strings.TrimSpace(name)
+		if name == "*" || name == mesh {
+			return true
+		}
+	}
+	return false
+}
+
+// exportedGateways returns the Gateways exported to the mesh, with their HTTP listeners. The routes of the peer can
+// only attach to those.
+func (s *meshServer) exportedGateways() []*federationmodel.GatewayMessage {
+	if s.env.ConfigStore == nil {
+		return nil
+	}
+	var gateways []*federationmodel.GatewayMessage
+	for _, cfg := range s.env.List(gvk.KubernetesGateway, metav1.NamespaceAll) {
+		if !gatewayExportedTo(cfg.Annotations[common.GatewayExportAnnotation], s.mesh.Name) {
+			continue
+		}
+		gw := &federationmodel.GatewayMessage{
+			Name:      cfg.Name,
+			Namespace: cfg.Namespace,
+		}
+		for _, l := range cfg.Spec.(*k8s.GatewaySpec).Listeners {
+			if l.Protocol != k8s.HTTPProtocolType && l.Protocol != k8s.HTTPSProtocolType {
+				continue
+			}
+			gw.Listeners = append(gw.Listeners, &federationmodel.GatewayListener{
+				Name:     string(l.Name),
+				Hostname: string(ptr.OrEmpty(l.Hostname)),
+				Port:     int(l.Port),
+				Protocol: string(l.Protocol),
+			})
+		}
+		if len(gw.Listeners) == 0 {
+			s.logger.Debugf("skipping export of gateway %s/%s, as it has no HTTP listener", cfg.Namespace, cfg.Name)
+			continue
+		}
+		gateways = append(gateways, gw)
+	}
+	sort.Slice(gateways, func(i, j int) bool {
+		return gateways[i].Namespace+"/"+gateways[i].Name < gateways[j].Namespace+"/"+gateways[j].Name
+	})
+	return gateways
+}
+
+// updateGateways refreshes the exported Gateways, notifying the watches if they changed.
+// s has to be Lock()ed
+func (s *meshServer) updateGateways() {
+	gateways := s.exportedGateways()
+	if reflect.DeepEqual(gateways, s.currentGateways) {
+		return
+	}
+	s.logger.Debugf("exported gateways changed, %d gateways exported", len(gateways))
+	s.currentGateways = gateways
+	// The event carries no service, so the peers resync the whole list
+	s.pushWatchEvent(&federationmodel.WatchEvent{
+		Action: federationmodel.ActionUpdate,
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "maistra.io/api/federation/v1"
+	k8s "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	configmemory "istio.io/istio/pilot/pkg/config/memory"
+	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config"
+	"istio.io/istio/pkg/config/schema/collection"
+	"istio.io/istio/pkg/config/schema/collections"
+	"istio.io/istio/pkg/config/schema/gvk"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/servicemesh/federation/common"
+	federationmodel "istio.io/istio/pkg/servicemesh/federation/model"
+)
+
+func TestGatewayExportedTo(t *testing.T) {
+	cases := []struct {
+		annotation string
+		expected   bool
+	}{
+		{annotation: "", expected: false},
+		{annotation: "mesh-b", expected: true},
+		{annotation: "mesh-a, mesh-b", expected: true},
+		{annotation: "mesh-a", expected: false},
+		{annotation: "mesh-bb", expected: false},
+		{annotation: "*", expected: true},
+	}
+	for _, tt := range cases {
+		if got := gatewayExportedTo(tt.annotation, "mesh-b"); got != tt.expected {
+			t.Errorf("gatewayExportedTo(%q): expected %v, got %v", tt.annotation, tt.expected, got)
+		}
+	}
+}
+
+func TestExportedGateways(t *testing.T) {
+	store := configmemory.MakeSkipValidation(collection.SchemasFor(collections.KubernetesGateway))
+	gateway := func(name, exportTo string, listeners ...k8s.Listener) config.Config {
+		cfg := config.Config{
+			Meta: config.Meta{
+				GroupVersionKind: gvk.KubernetesGateway,
+				Name:             name,
+				Namespace:        "ingress",
+			},
+			Spec: &k8s.GatewaySpec{Listeners: listeners},
+		}
+		if exportTo != "" {
+			cfg.Annotations = map[string]string{common.GatewayExportAnnotation: exportTo}
+		}
+		return cfg
+	}
+	http := k8s.Listener{Name: "http", Port: 80, Protocol: k8s.HTTPProtocolType}
+	https := k8s.Listener{Name: "https", Port: 443, Protocol: k8s.HTTPSProtocolType, Hostname: ptr.Of[k8s.Hostname]("*.example.com")}
+	tcp := k8s.Listener{Name: "tcp", Port: 9000, Protocol: k8s.TCPProtocolType}
+	for _, cfg := range []config.Config{
+		gateway("public", "mesh-b", https, tcp, http),
+		gateway("all", "*", http),
+		gateway("private", "", http),
+		gateway("other", "mesh-c", http),
+		gateway("tcp", "mesh-b", tcp),
+	} {
+		if _, err := store.Create(cfg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := &meshServer{
+		logger: common.Logger,
+		env:    &model.Environment{ConfigStore: store},
+		mesh:   &v1.ServiceMeshPeer{ObjectMeta: metav1.ObjectMeta{Name: "mesh-b"}},
+	}
+	expected := []*federationmodel.GatewayMessage{
+		{
+			Name:      "all",
+			Namespace: "ingress",
+			Listeners: []*federationmodel.GatewayListener{{Name: "http", Port: 80, Protocol: "HTTP"}},
+		},
+		{
+			Name:      "public",
+			Namespace: "ingress",
+			Listeners: []*federationmodel.GatewayListener{
+				{Name: "https", Hostname: "*.example.com", Port: 443, Protocol: "HTTPS"},
+				{Name: "http", Port: 80, Protocol: "HTTP"},
+			},
+		},
+	}
+	if diff := cmp.Diff(expected, s.exportedGateways()); diff != "" {
+		t.Errorf("unexpected exported gateways: %s", diff)
+	}
+}
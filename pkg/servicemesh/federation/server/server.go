@@ -31,6 +31,7 @@ import (
 	v1 "maistra.io/api/federation/v1"
 
 	"istio.io/istio/pilot/pkg/model"
+	"istio.io/istio/pkg/config/constants"
 	"istio.io/istio/pkg/config/host"
 	"istio.io/istio/pkg/config/visibility"
 	"istio.io/istio/pkg/log"
@@ -261,6 +262,7 @@ type meshServer struct {
 	ingressService  string
 	gatewaySAs      []string
 	currentServices map[federationmodel.ServiceKey]*federationmodel.ServiceMessage
+	currentGateways []*federationmodel.GatewayMessage
 
 	watchMut       sync.RWMutex
 	currentWatches []chan *federationmodel.WatchEvent
@@ -281,7 +283,12 @@ func (s *meshServer) getServiceMessage(svc *model.Service, exportedName *federat
 		ServiceKey:   *exportedName,
 		ServicePorts: make([]*federationmodel.ServicePort, 0),
 	}
-	addServiceSAs := s.mesh.Spec.Security.AllowDirectInbound
+	// Tenants call the service directly, without going through the ingress gateway
+	tenant := common.IsTenantPeer(s.mesh)
+	addServiceSAs := s.mesh.Spec.Security.AllowDirectInbound || tenant
+	if tenant && svc.DefaultAddress != constants.UnspecifiedIP {
+		ret.Addresses = []string{svc.DefaultAddress}
+	}
 	if addServiceSAs {
 		ret.ServiceAccounts = append([]string(nil), svc.ServiceAccounts...)
 	} else {
@@ -311,6 +318,7 @@ func (s *meshServer) getServiceListMessage() *federationmodel.ServiceListMessage
 		ret.Services = append(ret.Services, svcMessage)
 	}
 	sort.Slice(ret.Services, func(i, j int) bool { return strings.Compare(ret.Services[i].Hostname, ret.Services[j].Hostname) < 0 })
+	ret.Gateways = s.currentGateways
 	ret.Checksum = ret.GenerateChecksum()
 	return ret
 }
@@ -449,6 +457,7 @@ func (s *meshServer) resync() {
 			s.addService(svcKey, svcMessage)
 		}
 	}
+	s.updateGateways()
 	if err := s.statusHandler.Flush(); err != nil {
 		s.logger.Errorf("error updating federation export status for mesh %s: %s", s.mesh.Name, err)
 	}
@@ -0,0 +1,382 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tenant onboards the tenants of a multi-tenant mesh, creating the resources of the onboarding runbook: the
+// membership of their namespaces, their default mesh policies, the RBAC of their admins and their initial Gateway.
+package tenant
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+	maistraclient "maistra.io/api/client/versioned"
+	maistrav1 "maistra.io/api/core/v1"
+	k8sv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gateway "sigs.k8s.io/gateway-api/apis/v1beta1"
+	gatewayapiclient "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+
+	networking "istio.io/api/networking/v1alpha3"
+	security "istio.io/api/security/v1beta1"
+	telemetry "istio.io/api/telemetry/v1alpha1"
+	clientnetworking "istio.io/client-go/pkg/apis/networking/v1alpha3"
+	clientsecurity "istio.io/client-go/pkg/apis/security/v1beta1"
+	clienttelemetry "istio.io/client-go/pkg/apis/telemetry/v1alpha1"
+	istioclient "istio.io/client-go/pkg/clientset/versioned"
+	"istio.io/istio/pkg/config/labels"
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/util/sets"
+)
+
+const (
+	// TenantLabel labels the namespaces of a tenant with its name. The initial Gateway of the tenant admits the
+	// routes of the namespaces with the label.
+	TenantLabel = "maistra.io/tenant"
+	// revisionLabel selects the control plane revision injecting the pods of a namespace.
+	revisionLabel = "istio.io/rev"
+
+	// defaultName is the name of the default mesh policies of a namespace.
+	defaultName = "default"
+	// adminRoleBindingName is the name of the RoleBinding granting the tenant admins their role in a namespace.
+	adminRoleBindingName = "mesh-tenant-admin"
+	// DefaultAdminClusterRole is the role of the tenant admins in their namespaces, if not set.
+	DefaultAdminClusterRole = "admin"
+)
+
+// Request is a request to onboard a tenant.
+type Request struct {
+	// Name of the tenant, labelling its namespaces.
+	Name string
+	// Namespaces of the tenant. They are created if they do not exist. The initial Gateway is in the first one.
+	Namespaces []string
+	// Revision of the control plane injecting the pods of the tenant, if not the default one.
+	Revision string
+	// AdminGroup is the group of the tenant admins. No RBAC is created if not set.
+	AdminGroup string
+	// AdminClusterRole is the role of the tenant admins in the namespaces. Defaults to DefaultAdminClusterRole.
+	AdminClusterRole string
+	// GatewayClass of the initial Gateway of the tenant. No Gateway is created if not set.
+	GatewayClass string
+	// StrictExistingNamespaces enforces STRICT mTLS in the namespaces which existed before being added to the tenant.
+	// Their workloads may still receive plaintext traffic from outside the mesh, so their default PeerAuthentication
+	// is PERMISSIVE if not set. The namespaces created for the tenant are always STRICT.
+	StrictExistingNamespaces bool
+}
+
+func (r Request) validate() error {
+	if !labels.IsDNS1123Label(r.Name) {
+		return fmt.Errorf("invalid tenant name %q, must be a DNS label", r.Name)
+	}
+	if len(r.Namespaces) == 0 {
+		return fmt.Errorf("the tenant must have at least one namespace")
+	}
+	for _, ns := range r.Namespaces {
+		if !labels.IsDNS1123Label(ns) {
+			return fmt.Errorf("invalid namespace %q, must be a DNS label", ns)
+		}
+	}
+	if r.Revision != "" && !labels.IsDNS1123Label(r.Revision) {
+		return fmt.Errorf("invalid revision %q, must be a DNS label", r.Revision)
+	}
+	return nil
+}
+
+// Result lists the resources of the onboarding, as "Kind namespace/name". The existing resources are left as they
+// are, so onboarding a tenant again does not revert the changes made since, and only creates what is missing. Only
+// the namespace labels and the members of the ServiceMeshMemberRoll are updated.
+type Result struct {
+	Created  []string
+	Updated  []string
+	Existing []string
+}
+
+type outcome int
+
+const (
+	existing outcome = iota
+	created
+	updated
+)
+
+func (r *Result) record(o outcome, kind, namespace, name string) {
+	ref := kind + " " + name
+	if namespace != "" {
+		ref = kind + " " + namespace + "/" + name
+	}
+	switch o {
+	case created:
+		r.Created = append(r.Created, ref)
+	case updated:
+		r.Updated = append(r.Updated, ref)
+	default:
+		r.Existing = append(r.Existing, ref)
+	}
+}
+
+// createOutcome returns the outcome of the creation of a resource, ignoring the error if it already exists.
+func createOutcome(err error) (outcome, error) {
+	if kerrors.IsAlreadyExists(err) {
+		return existing, nil
+	}
+	return created, err
+}
+
+// Onboarder onboards the tenants of a control plane.
+type Onboarder struct {
+	kube       kubernetes.Interface
+	istio      istioclient.Interface
+	gatewayAPI gatewayapiclient.Interface
+	maistra    maistraclient.Interface
+
+	// controlPlaneNamespace is the namespace of the control plane, and of its ServiceMeshMemberRoll
+	controlPlaneNamespace string
+	// memberRollName is the name of the ServiceMeshMemberRoll the namespaces are added to, if any
+	memberRollName string
+}
+
+// NewOnboarder returns an Onboarder adding the namespaces of the tenants to the given ServiceMeshMemberRoll of the
+// control plane. The member roll is not used if its name is empty, e.g. for a cluster-wide control plane.
+func NewOnboarder(client kube.Client, controlPlaneNamespace, memberRollName string) (*Onboarder, error) {
+	mc, err := maistraclient.NewForConfig(client.RESTConfig())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client for maistra resources: %v", err)
+	}
+	return &Onboarder{
+		kube:                  client.Kube(),
+		istio:                 client.Istio(),
+		gatewayAPI:            client.GatewayAPI(),
+		maistra:               mc,
+		controlPlaneNamespace: controlPlaneNamespace,
+		memberRollName:        memberRollName,
+	}, nil
+}
+
+// Onboard creates the resources of the tenant which do not exist yet. It stops at the first failure, returning the
+// resources handled until then, and can be retried.
+func (o *Onboarder) Onboard(ctx context.Context, req Request) (Result, error) {
+	res := Result{}
+	if err := req.validate(); err != nil {
+		return res, err
+	}
+	adopted := sets.New[string]()
+	for _, ns := range req.Namespaces {
+		existed, err := o.labelNamespace(ctx, req, ns, &res)
+		if err != nil {
+			return res, fmt.Errorf("failed to label namespace %s: %v", ns, err)
+		}
+		if existed {
+			adopted.Insert(ns)
+		}
+	}
+	if o.memberRollName != "" {
+		if err := o.addMembers(ctx, req.Namespaces, &res); err != nil {
+			return res, fmt.Errorf("failed to add the namespaces to ServiceMeshMemberRoll %s/%s: %v",
+				o.controlPlaneNamespace, o.memberRollName, err)
+		}
+	}
+	for _, ns := range req.Namespaces {
+		strict := req.StrictExistingNamespaces || !adopted.Contains(ns)
+		if err := o.createDefaults(ctx, req, ns, strict, &res); err != nil {
+			return res, err
+		}
+	}
+	if req.GatewayClass != "" {
+		if err := o.createGateway(ctx, req, &res); err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+// labelNamespace creates the namespace of the tenant, or labels it if it exists. It returns whether the namespace
+// existed without belonging to the tenant.
+func (o *Onboarder) labelNamespace(ctx context.Context, req Request, name string, res *Result) (bool, error) {
+	want := map[string]string{TenantLabel: req.Name}
+	if req.Revision != "" {
+		want[revisionLabel] = req.Revision
+	}
+	var result outcome
+	adopted := false
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		ns, err := o.kube.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			result, adopted = created, false
+			_, err = o.kube.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Labels: want},
+			}, metav1.CreateOptions{})
+			return err
+		} else if err != nil {
+			return err
+		}
+		owner, f := ns.Labels[TenantLabel]
+		if f && owner != req.Name {
+			return fmt.Errorf("the namespace belongs to tenant %q", owner)
+		}
+		adopted = !f
+		result = existing
+		for k, v := range want {
+			if ns.Labels[k] != v {
+				result = updated
+			}
+		}
+		if result == existing {
+			return nil
+		}
+		if ns.Labels == nil {
+			ns.Labels = map[string]string{}
+		}
+		for k, v := range want {
+			ns.Labels[k] = v
+		}
+		_, err = o.kube.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+		return err
+	})
+	if err == nil {
+		res.record(result, "Namespace", "", name)
+	}
+	return adopted, err
+}
+
+// addMembers adds the namespaces to the ServiceMeshMemberRoll, creating it if it does not exist.
+func (o *Onboarder) addMembers(ctx context.Context, namespaces []string, res *Result) error {
+	smmrs := o.maistra.CoreV1().ServiceMeshMemberRolls(o.controlPlaneNamespace)
+	var result outcome
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		smmr, err := smmrs.Get(ctx, o.memberRollName, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			result = created
+			_, err = smmrs.Create(ctx, &maistrav1.ServiceMeshMemberRoll{
+				ObjectMeta: metav1.ObjectMeta{Name: o.memberRollName, Namespace: o.controlPlaneNamespace},
+				Spec:       maistrav1.ServiceMeshMemberRollSpec{Members: sets.SortedList(sets.New(namespaces...))},
+			}, metav1.CreateOptions{})
+			return err
+		} else if err != nil {
+			return err
+		}
+		members := sets.New(smmr.Spec.Members...)
+		if members.ContainsAll(sets.New(namespaces...)) {
+			result = existing
+			return nil
+		}
+		result = updated
+		smmr.Spec.Members = sets.SortedList(members.InsertAll(namespaces...))
+		_, err = smmrs.Update(ctx, smmr, metav1.UpdateOptions{})
+		return err
+	})
+	if err == nil {
+		res.record(result, "ServiceMeshMemberRoll", o.controlPlaneNamespace, o.memberRollName)
+	}
+	return err
+}
+
+// createDefaults creates the default mesh policies of a namespace of the tenant, and the RBAC of its admins. The
+// default PeerAuthentication is STRICT if strict is set, PERMISSIVE otherwise.
+func (o *Onboarder) createDefaults(ctx context.Context, req Request, ns string, strict bool, res *Result) error {
+	meta := metav1.ObjectMeta{Name: defaultName, Namespace: ns, Labels: map[string]string{TenantLabel: req.Name}}
+	create := func(kind, name string, f func() error) error {
+		result, err := createOutcome(f())
+		if err != nil {
+			return fmt.Errorf("failed to create %s %s/%s: %v", kind, ns, name, err)
+		}
+		res.record(result, kind, ns, name)
+		return nil
+	}
+	mode := security.PeerAuthentication_MutualTLS_PERMISSIVE
+	if strict {
+		mode = security.PeerAuthentication_MutualTLS_STRICT
+	}
+	if err := create("PeerAuthentication", defaultName, func() error {
+		_, err := o.istio.SecurityV1beta1().PeerAuthentications(ns).Create(ctx, &clientsecurity.PeerAuthentication{
+			ObjectMeta: meta,
+			Spec:       security.PeerAuthentication{Mtls: &security.PeerAuthentication_MutualTLS{Mode: mode}},
+		}, metav1.CreateOptions{})
+		return err
+	}); err != nil {
+		return err
+	}
+	egressHosts := []string{o.controlPlaneNamespace + "/*"}
+	for _, member := range req.Namespaces {
+		egressHosts = append(egressHosts, member+"/*")
+	}
+	if err := create("Sidecar", defaultName, func() error {
+		// The workloads of the tenant only see the services of its namespaces and of the control plane by default
+		_, err := o.istio.NetworkingV1alpha3().Sidecars(ns).Create(ctx, &clientnetworking.Sidecar{
+			ObjectMeta: meta,
+			Spec: networking.Sidecar{
+				Egress: []*networking.IstioEgressListener{{Hosts: egressHosts}},
+			},
+		}, metav1.CreateOptions{})
+		return err
+	}); err != nil {
+		return err
+	}
+	if err := create("Telemetry", defaultName, func() error {
+		// Access logs are written with the default providers of the mesh
+		_, err := o.istio.TelemetryV1alpha1().Telemetries(ns).Create(ctx, &clienttelemetry.Telemetry{
+			ObjectMeta: meta,
+			Spec:       telemetry.Telemetry{AccessLogging: []*telemetry.AccessLogging{{}}},
+		}, metav1.CreateOptions{})
+		return err
+	}); err != nil {
+		return err
+	}
+	if req.AdminGroup == "" {
+		return nil
+	}
+	role := req.AdminClusterRole
+	if role == "" {
+		role = DefaultAdminClusterRole
+	}
+	return create("RoleBinding", adminRoleBindingName, func() error {
+		_, err := o.kube.RbacV1().RoleBindings(ns).Create(ctx, &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: adminRoleBindingName, Namespace: ns, Labels: meta.Labels},
+			Subjects:   []rbacv1.Subject{{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: req.AdminGroup}},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", APIGroup: rbacv1.GroupName, Name: role},
+		}, metav1.CreateOptions{})
+		return err
+	})
+}
+
+// createGateway creates the initial Gateway of the tenant in its first namespace, admitting the routes of all its
+// namespaces.
+func (o *Onboarder) createGateway(ctx context.Context, req Request, res *Result) error {
+	ns, name := req.Namespaces[0], req.Name+"-gateway"
+	_, err := o.gatewayAPI.GatewayV1beta1().Gateways(ns).Create(ctx, &gateway.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns, Labels: map[string]string{TenantLabel: req.Name}},
+		Spec: gateway.GatewaySpec{
+			GatewayClassName: k8sv1.ObjectName(req.GatewayClass),
+			Listeners: []gateway.Listener{{
+				Name:     "http",
+				Port:     80,
+				Protocol: k8sv1.HTTPProtocolType,
+				AllowedRoutes: &gateway.AllowedRoutes{Namespaces: &gateway.RouteNamespaces{
+					From:     ptr.Of(k8sv1.NamespacesFromSelector),
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{TenantLabel: req.Name}},
+				}},
+			}},
+		},
+	}, metav1.CreateOptions{})
+	result, err := createOutcome(err)
+	if err != nil {
+		return fmt.Errorf("failed to create Gateway %s/%s: %v", ns, name, err)
+	}
+	res.record(result, "Gateway", ns, name)
+	return nil
+}
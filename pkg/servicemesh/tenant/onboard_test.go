@@ -0,0 +1,126 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	maistrafake "maistra.io/api/client/versioned/fake"
+	maistrav1 "maistra.io/api/core/v1"
+	gatewayapifake "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+
+	security "istio.io/api/security/v1beta1"
+	istiofake "istio.io/client-go/pkg/clientset/versioned/fake"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func newTestOnboarder(objects ...*corev1.Namespace) *Onboarder {
+	kube := kubefake.NewSimpleClientset()
+	for _, ns := range objects {
+		_, _ = kube.CoreV1().Namespaces().Create(context.Background(), ns, metav1.CreateOptions{})
+	}
+	return &Onboarder{
+		kube:       kube,
+		istio:      istiofake.NewSimpleClientset(),
+		gatewayAPI: gatewayapifake.NewSimpleClientset(),
+		maistra: maistrafake.NewSimpleClientset(&maistrav1.ServiceMeshMemberRoll{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", Namespace: "istio-system"},
+			Spec:       maistrav1.ServiceMeshMemberRollSpec{Members: []string{"other"}},
+		}),
+		controlPlaneNamespace: "istio-system",
+		memberRollName:        "default",
+	}
+}
+
+func TestOnboard(t *testing.T) {
+	ctx := context.Background()
+	o := newTestOnboarder(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}})
+	req := Request{
+		Name:         "team",
+		Namespaces:   []string{"team-a", "team-b"},
+		Revision:     "canary",
+		AdminGroup:   "team-admins",
+		GatewayClass: "istio",
+	}
+
+	res, err := o.Onboard(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, res.Created, []string{
+		"Namespace team-b",
+		"PeerAuthentication team-a/default", "Sidecar team-a/default", "Telemetry team-a/default", "RoleBinding team-a/mesh-tenant-admin",
+		"PeerAuthentication team-b/default", "Sidecar team-b/default", "Telemetry team-b/default", "RoleBinding team-b/mesh-tenant-admin",
+		"Gateway team-a/team-gateway",
+	})
+	assert.Equal(t, res.Updated, []string{"Namespace team-a", "ServiceMeshMemberRoll istio-system/default"})
+
+	ns, err := o.kube.CoreV1().Namespaces().Get(ctx, "team-a", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, ns.Labels, map[string]string{TenantLabel: "team", revisionLabel: "canary"})
+	smmr, err := o.maistra.CoreV1().ServiceMeshMemberRolls("istio-system").Get(ctx, "default", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, smmr.Spec.Members, []string{"other", "team-a", "team-b"})
+	pa, err := o.istio.SecurityV1beta1().PeerAuthentications("team-b").Get(ctx, "default", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, pa.Spec.GetMtls().GetMode(), security.PeerAuthentication_MutualTLS_STRICT)
+	// team-a existed before the onboarding, its workloads may still receive plaintext traffic
+	pa, err = o.istio.SecurityV1beta1().PeerAuthentications("team-a").Get(ctx, "default", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, pa.Spec.GetMtls().GetMode(), security.PeerAuthentication_MutualTLS_PERMISSIVE)
+	sc, err := o.istio.NetworkingV1alpha3().Sidecars("team-b").Get(ctx, "default", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, sc.Spec.Egress[0].Hosts, []string{"istio-system/*", "team-a/*", "team-b/*"})
+	rb, err := o.kube.RbacV1().RoleBindings("team-b").Get(ctx, adminRoleBindingName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, rb.Subjects[0].Name, "team-admins")
+	assert.Equal(t, rb.RoleRef.Name, DefaultAdminClusterRole)
+	gw, err := o.gatewayAPI.GatewayV1beta1().Gateways("team-a").Get(ctx, "team-gateway", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, gw.Spec.Listeners[0].AllowedRoutes.Namespaces.Selector.MatchLabels, map[string]string{TenantLabel: "team"})
+
+	// Onboarding the tenant again leaves its resources as they are
+	res, err = o.Onboard(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, len(res.Created), 0)
+	assert.Equal(t, len(res.Updated), 0)
+	assert.Equal(t, len(res.Existing), 12)
+}
+
+func TestOnboardStrictExistingNamespaces(t *testing.T) {
+	ctx := context.Background()
+	o := newTestOnboarder(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}})
+	_, err := o.Onboard(ctx, Request{Name: "team", Namespaces: []string{"team-a"}, StrictExistingNamespaces: true})
+	assert.NoError(t, err)
+	pa, err := o.istio.SecurityV1beta1().PeerAuthentications("team-a").Get(ctx, "default", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, pa.Spec.GetMtls().GetMode(), security.PeerAuthentication_MutualTLS_STRICT)
+}
+
+func TestOnboardRejectsNamespaceOfAnotherTenant(t *testing.T) {
+	o := newTestOnboarder(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shared", Labels: map[string]string{TenantLabel: "other"}}})
+	_, err := o.Onboard(context.Background(), Request{Name: "team", Namespaces: []string{"shared"}})
+	assert.Error(t, err)
+}
+
+func TestRequestValidate(t *testing.T) {
+	assert.NoError(t, Request{Name: "team", Namespaces: []string{"team-a"}}.validate())
+	assert.Error(t, Request{Name: "Team", Namespaces: []string{"team-a"}}.validate())
+	assert.Error(t, Request{Name: "team"}.validate())
+	assert.Error(t, Request{Name: "team", Namespaces: []string{"team_a"}}.validate())
+	assert.Error(t, Request{Name: "team", Namespaces: []string{"team-a"}, Revision: "1.20"}.validate())
+}
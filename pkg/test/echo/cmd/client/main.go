@@ -19,6 +19,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
 	"strings"
@@ -31,6 +32,7 @@ import (
 
 	"istio.io/istio/pkg/cmd"
 	"istio.io/istio/pkg/log"
+	"istio.io/istio/pkg/test/echo"
 	"istio.io/istio/pkg/test/echo/common"
 	"istio.io/istio/pkg/test/echo/proto"
 	"istio.io/istio/pkg/test/echo/server/forwarder"
@@ -39,25 +41,39 @@ import (
 var (
 	count                   int
 	timeout                 time.Duration
+	connectTimeout          time.Duration
+	tlsHandshakeTimeout     time.Duration
+	responseHeaderTimeout   time.Duration
 	qps                     int
 	uds                     string
 	headers                 []string
 	msg                     string
+	payload                 string
+	expectedPayload         string
 	expect                  string
 	expectSet               bool
 	method                  string
 	http2                   bool
 	http3                   bool
+	h2cUpgrade              bool
 	insecureSkipVerify      bool
 	alpn                    []string
 	serverName              string
 	serverFirst             bool
 	followRedirects         bool
+	maxRedirects            int32
+	cookieJar               bool
 	newConnectionPerRequest bool
 	forceDNSLookup          bool
+	bodySize                int64
+	chunkedBody             bool
+	multipartParts          int32
+	propagateDeadline       bool
 
-	clientCert string
-	clientKey  string
+	clientCert     string
+	clientKey      string
+	namedCerts     []string
+	clientCertName string
 
 	caFile string
 
@@ -89,6 +105,15 @@ where the network configuration doesn't support gRPC to the source pod.'
 				log.Fatal(err)
 			}
 
+			clientCertificates := map[string]common.ClientCertificate{}
+			for _, c := range namedCerts {
+				name, cert, err := common.LoadClientCertificate(c)
+				if err != nil {
+					log.Fatal(err)
+				}
+				clientCertificates[name] = cert
+			}
+
 			// Create a forwarder.
 			f := forwarder.New()
 			defer func() {
@@ -97,8 +122,9 @@ where the network configuration doesn't support gRPC to the source pod.'
 
 			// Forward the requests.
 			response, err := f.ForwardEcho(context.Background(), &forwarder.Config{
-				Request: request,
-				UDS:     uds,
+				Request:            request,
+				UDS:                uds,
+				ClientCertificates: clientCertificates,
 			})
 			if err != nil {
 				log.Fatalf("Error %s\n", err) // nolint: revive
@@ -126,6 +152,11 @@ func init() {
 	rootCmd.PersistentFlags().IntVar(&count, "count", common.DefaultCount, "Number of times to make the request")
 	rootCmd.PersistentFlags().IntVar(&qps, "qps", 0, "Queries per second")
 	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", common.DefaultRequestTimeout, "Request timeout")
+	rootCmd.PersistentFlags().DurationVar(&connectTimeout, "connect-timeout", common.ConnectionTimeout, "Connection timeout")
+	rootCmd.PersistentFlags().DurationVar(&tlsHandshakeTimeout, "tls-handshake-timeout", 0,
+		"TLS handshake timeout. If not set, only the request timeout applies")
+	rootCmd.PersistentFlags().DurationVar(&responseHeaderTimeout, "response-header-timeout", 0,
+		"timeout awaiting the response headers once the request is sent (for HTTP). If not set, only the request timeout applies")
 	rootCmd.PersistentFlags().StringVar(&uds, "uds", "",
 		"Specify the Unix Domain Socket to connect to")
 	rootCmd.PersistentFlags().StringSliceVarP(&headers, "header", "H", headers,
@@ -135,25 +166,47 @@ func init() {
 		"message to send (for websockets)")
 	rootCmd.PersistentFlags().StringVar(&expect, "expect", "",
 		"message to expect (for tcp)")
+	rootCmd.PersistentFlags().StringVar(&payload, "payload", "",
+		"raw bytes to send rather than the message, as hex:<bytes>, base64:<bytes> or fixture:<name> (for tcp)")
+	rootCmd.PersistentFlags().StringVar(&expectedPayload, "expected-payload", "",
+		"raw bytes to expect in the response, in the same format as --payload (for tcp)")
 	rootCmd.PersistentFlags().StringVar(&method, "method", "", "method to use (for HTTP)")
 	rootCmd.PersistentFlags().BoolVar(&http2, "http2", false,
 		"send http requests as HTTP2 with prior knowledge")
 	rootCmd.PersistentFlags().BoolVar(&http3, "http3", false,
 		"send http requests as HTTP 3")
+	rootCmd.PersistentFlags().BoolVar(&h2cUpgrade, "h2c-upgrade", false,
+		"send http requests over HTTP/1.1 with an upgrade to h2c")
 	rootCmd.PersistentFlags().BoolVarP(&insecureSkipVerify, "insecure-skip-verify", "k", insecureSkipVerify,
 		"do not verify TLS")
 	rootCmd.PersistentFlags().BoolVar(&serverFirst, "server-first", false,
 		"Treat as a server first protocol; do not send request until magic string is received")
 	rootCmd.PersistentFlags().BoolVarP(&followRedirects, "follow-redirects", "L", false,
 		"If enabled, will follow 3xx redirects with the Location header")
+	rootCmd.PersistentFlags().Int32Var(&maxRedirects, "max-redirects", 0,
+		"If following redirects, the number of redirects followed before failing. Defaults to 10")
+	rootCmd.PersistentFlags().BoolVar(&cookieJar, "cookie-jar", false,
+		"If enabled, the cookies set by the responses are sent on the following requests and redirects of this invocation")
 	rootCmd.PersistentFlags().BoolVar(&newConnectionPerRequest, "new-connection-per-request", false,
 		"If enabled, a new connection will be made to the server for each individual request. "+
 			"If false, an attempt will be made to re-use the connection for the life of the forward request. "+
 			"This is automatically set for DNS, TCP, TLS, and WebSocket protocols.")
 	rootCmd.PersistentFlags().BoolVar(&forceDNSLookup, "force-dns-lookup", false,
 		"If enabled, each request will force a DNS lookup. Only applies if new-connection-per-request is also enabled.")
+	rootCmd.PersistentFlags().Int64Var(&bodySize, "body-size", 0,
+		"If set, a generated request body of this many bytes is streamed to the server (for HTTP)")
+	rootCmd.PersistentFlags().BoolVar(&chunkedBody, "chunked", false,
+		"send the request body with chunked transfer encoding rather than with a Content-Length")
+	rootCmd.PersistentFlags().Int32Var(&multipartParts, "multipart-parts", 0,
+		"If set, the generated request body is sent as a multipart/form-data upload split into this many parts")
+	rootCmd.PersistentFlags().BoolVar(&propagateDeadline, "propagate-deadline", false,
+		"send the request timeout as the deadline of each request, in the x-envoy-expected-rq-timeout-ms and grpc-timeout headers")
 	rootCmd.PersistentFlags().StringVar(&clientCert, "client-cert", "", "client certificate file to use for request")
 	rootCmd.PersistentFlags().StringVar(&clientKey, "client-key", "", "client certificate key file to use for request")
+	rootCmd.PersistentFlags().StringArrayVar(&namedCerts, "named-client-cert", nil,
+		"client certificate which can be selected with --client-cert-name, in the format name=certFile,keyFile. Can be repeated.")
+	rootCmd.PersistentFlags().StringVar(&clientCertName, "client-cert-name", "",
+		"name of the client certificate to use for request, among the ones set with --named-client-cert")
 	rootCmd.PersistentFlags().StringSliceVarP(&alpn, "alpn", "", nil, "alpn to set")
 	rootCmd.PersistentFlags().StringVarP(&serverName, "server-name", "", serverName, "server name to set")
 
@@ -184,20 +237,29 @@ func defaultScheme(u string) string {
 
 func getRequest(url string) (*proto.ForwardEchoRequest, error) {
 	request := &proto.ForwardEchoRequest{
-		Url:                     defaultScheme(url),
-		TimeoutMicros:           common.DurationToMicros(timeout),
-		Count:                   int32(count),
-		Qps:                     int32(qps),
-		Message:                 msg,
-		Http2:                   http2,
-		Http3:                   http3,
-		ServerFirst:             serverFirst,
-		FollowRedirects:         followRedirects,
-		Method:                  method,
-		ServerName:              serverName,
-		InsecureSkipVerify:      insecureSkipVerify,
-		NewConnectionPerRequest: newConnectionPerRequest,
-		ForceDNSLookup:          forceDNSLookup,
+		Url:                         defaultScheme(url),
+		TimeoutMicros:               common.DurationToMicros(timeout),
+		ConnectTimeoutMicros:        common.DurationToMicros(connectTimeout),
+		TlsHandshakeTimeoutMicros:   common.DurationToMicros(tlsHandshakeTimeout),
+		ResponseHeaderTimeoutMicros: common.DurationToMicros(responseHeaderTimeout),
+		Count:                       int32(count),
+		Qps:                         int32(qps),
+		Message:                     msg,
+		Http2:                       http2,
+		Http3:                       http3,
+		H2CUpgrade:                  h2cUpgrade,
+		ServerFirst:                 serverFirst,
+		FollowRedirects:             followRedirects,
+		MaxRedirects:                maxRedirects,
+		CookieJar:                   cookieJar,
+		Method:                      method,
+		ServerName:                  serverName,
+		InsecureSkipVerify:          insecureSkipVerify,
+		NewConnectionPerRequest:     newConnectionPerRequest,
+		ForceDNSLookup:              forceDNSLookup,
+		BodySize:                    bodySize,
+		ChunkedBody:                 chunkedBody,
+		MultipartParts:              multipartParts,
 	}
 	if len(hboneAddress) > 0 {
 		request.Hbone = &proto.HBONE{
@@ -225,6 +287,21 @@ func getRequest(url string) (*proto.ForwardEchoRequest, error) {
 		request.ExpectedResponse = &wrappers.StringValue{Value: expect}
 	}
 
+	if payload != "" {
+		b, err := echo.ParsePayload(payload)
+		if err != nil {
+			return nil, err
+		}
+		request.Payload = b
+	}
+	if expectedPayload != "" {
+		b, err := echo.ParsePayload(expectedPayload)
+		if err != nil {
+			return nil, err
+		}
+		request.ExpectedPayload = b
+	}
+
 	if alpn != nil {
 		request.Alpn = &proto.Alpn{Value: alpn}
 	}
@@ -243,10 +320,17 @@ func getRequest(url string) (*proto.ForwardEchoRequest, error) {
 		})
 	}
 
+	if propagateDeadline {
+		deadline := http.Header{}
+		common.SetDeadlineHeaders(deadline, timeout)
+		request.Headers = append(request.Headers, common.HTTPToProtoHeaders(deadline)...)
+	}
+
 	if clientCert != "" && clientKey != "" {
 		request.CertFile = clientCert
 		request.KeyFile = clientKey
 	}
+	request.ClientCertName = clientCertName
 	if caFile != "" {
 		request.CaCertFile = caFile
 	}
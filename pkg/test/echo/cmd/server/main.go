@@ -42,6 +42,8 @@ var (
 	localhostIPPorts []int
 	serverFirstPorts []int
 	xdsGRPCServers   []int
+	clientCertPorts  []int
+	h2cUpgradePorts  []int
 	metricsPort      int
 	uds              string
 	version          string
@@ -50,6 +52,7 @@ var (
 	key              string
 	istioVersion     string
 	disableALPN      bool
+	clientCerts      []string
 
 	loggingOptions = log.DefaultOptions()
 
@@ -73,14 +76,24 @@ var (
 			for _, p := range xdsGRPCServers {
 				xdsGRPCByPort[p] = true
 			}
+			clientCertByPort := map[int]bool{}
+			for _, p := range clientCertPorts {
+				clientCertByPort[p] = true
+			}
+			h2cUpgradeByPort := map[int]bool{}
+			for _, p := range h2cUpgradePorts {
+				h2cUpgradeByPort[p] = true
+			}
 			portIndex := 0
 			for i, p := range httpPorts {
 				ports[portIndex] = &common.Port{
-					Name:        "http-" + strconv.Itoa(i),
-					Protocol:    protocol.HTTP,
-					Port:        p,
-					TLS:         tlsByPort[p],
-					ServerFirst: serverFirstByPort[p],
+					Name:              "http-" + strconv.Itoa(i),
+					Protocol:          protocol.HTTP,
+					Port:              p,
+					TLS:               tlsByPort[p],
+					ServerFirst:       serverFirstByPort[p],
+					RequestClientCert: clientCertByPort[p],
+					H2CUpgrade:        h2cUpgradeByPort[p],
 				}
 				portIndex++
 			}
@@ -131,6 +144,16 @@ var (
 				localhostIPByPort[p] = struct{}{}
 			}
 
+			clientCertificates := map[string]common.ClientCertificate{}
+			for _, c := range clientCerts {
+				name, cert, err := common.LoadClientCertificate(c)
+				if err != nil {
+					log.Error(err)
+					os.Exit(-1)
+				}
+				clientCertificates[name] = cert
+			}
+
 			s := server.New(server.Config{
 				Ports:                 ports,
 				Metrics:               metricsPort,
@@ -144,6 +167,7 @@ var (
 				Namespace:             os.Getenv("NAMESPACE"),
 				UDSServer:             uds,
 				DisableALPN:           disableALPN,
+				ClientCertificates:    clientCertificates,
 			})
 
 			if err := s.Start(); err != nil {
@@ -180,6 +204,10 @@ func init() {
 	rootCmd.PersistentFlags().IntSliceVar(&localhostIPPorts, "bind-localhost", []int{}, "Ports that are bound to localhost rather than wildcard IP.")
 	rootCmd.PersistentFlags().IntSliceVar(&serverFirstPorts, "server-first", []int{}, "Ports that are server first. These must be defined as tcp.")
 	rootCmd.PersistentFlags().IntSliceVar(&xdsGRPCServers, "xds-grpc-server", []int{}, "Ports that should rely on XDS configuration to serve.")
+	rootCmd.PersistentFlags().IntSliceVar(&clientCertPorts, "request-client-cert", []int{},
+		"TLS HTTP ports requesting a client certificate, whose identity is reported in the responses.")
+	rootCmd.PersistentFlags().IntSliceVar(&h2cUpgradePorts, "h2c-upgrade", []int{},
+		"Plaintext HTTP ports accepting h2c Upgrades, in addition to h2c with prior knowledge.")
 	rootCmd.PersistentFlags().IntVar(&metricsPort, "metrics", 0, "Metrics port")
 	rootCmd.PersistentFlags().StringVar(&uds, "uds", "", "HTTP server on unix domain socket")
 	rootCmd.PersistentFlags().StringVar(&version, "version", "", "Version string")
@@ -188,6 +216,8 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&key, "key", "", "gRPC TLS server-side key")
 	rootCmd.PersistentFlags().StringVar(&istioVersion, "istio-version", "", "Istio sidecar version")
 	rootCmd.PersistentFlags().BoolVar(&disableALPN, "disable-alpn", disableALPN, "disable ALPN negotiation")
+	rootCmd.PersistentFlags().StringArrayVar(&clientCerts, "client-cert", nil,
+		"Client certificate the forwarded requests can select by name, in the format name=certFile,keyFile. Can be repeated.")
 
 	loggingOptions.AttachCobraFlags(rootCmd)
 
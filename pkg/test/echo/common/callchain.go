@@ -0,0 +1,64 @@
+//  Copyright Red Hat, Inc.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// CallChainHeader is the request header carrying a call chain for the HTTP echo server to execute. The server
+// handling the request calls the first hop, passing the remaining hops along, so that each hop calls the next.
+// The report of every hop is returned in the response, see echo.Response.Hops.
+const CallChainHeader = "X-Echo-Call-Chain"
+
+// CallChainHop is a single call of a call chain.
+type CallChainHop struct {
+	// URL to call, for example http://b:80/path.
+	URL string `json:"url"`
+	// Headers to add to the call.
+	Headers map[string]string `json:"headers,omitempty"`
+	// ForwardHeaders lists the headers of the incoming request to forward on the call.
+	ForwardHeaders []string `json:"forwardHeaders,omitempty"`
+}
+
+// EncodeCallChain encodes the hops as a CallChainHeader value.
+func EncodeCallChain(hops ...CallChainHop) (string, error) {
+	if len(hops) == 0 {
+		return "", fmt.Errorf("call chain must have at least one hop")
+	}
+	b, err := json.Marshal(hops)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// DecodeCallChain decodes a CallChainHeader value.
+func DecodeCallChain(value string) ([]CallChainHop, error) {
+	var hops []CallChainHop
+	if err := json.Unmarshal([]byte(value), &hops); err != nil {
+		return nil, fmt.Errorf("invalid call chain: %v", err)
+	}
+	if len(hops) == 0 {
+		return nil, fmt.Errorf("invalid call chain: no hop")
+	}
+	for _, hop := range hops {
+		if hop.URL == "" {
+			return nil, fmt.Errorf("invalid call chain: hop is missing url")
+		}
+	}
+	return hops, nil
+}
@@ -0,0 +1,41 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestCallChainEncoding(t *testing.T) {
+	hops := []CallChainHop{
+		{URL: "http://b:80/path", Headers: map[string]string{"Host": "b.example.com"}, ForwardHeaders: []string{"X-Request-Id"}},
+		{URL: "http://c:80"},
+	}
+	value, err := EncodeCallChain(hops...)
+	assert.NoError(t, err)
+	assert.Equal(t, value, `[{"url":"http://b:80/path","headers":{"Host":"b.example.com"},"forwardHeaders":["X-Request-Id"]},{"url":"http://c:80"}]`)
+	decoded, err := DecodeCallChain(value)
+	assert.NoError(t, err)
+	assert.Equal(t, decoded, hops)
+
+	_, err = EncodeCallChain()
+	assert.Error(t, err)
+	for _, invalid := range []string{"", "not json", "[]", `[{"url":"http://b"},{"headers":{"a":"b"}}]`, `{"url":"http://b"}`} {
+		_, err := DecodeCallChain(invalid)
+		assert.Error(t, err)
+	}
+}
@@ -0,0 +1,88 @@
+//  Copyright Red Hat, Inc.
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Headers carrying the deadline of a request. Envoy sets the expected timeout on the requests it forwards, reduced by
+// the time already spent, and gRPC clients set grpc-timeout from the deadline of the call.
+const (
+	EnvoyExpectedTimeoutHeader = "X-Envoy-Expected-Rq-Timeout-Ms"
+	GRPCTimeoutHeader          = "Grpc-Timeout"
+)
+
+// SetDeadlineHeaders sets the deadline headers of a request sent with the timeout.
+func SetDeadlineHeaders(h http.Header, timeout time.Duration) {
+	ms := strconv.FormatInt(timeout.Milliseconds(), 10)
+	h.Set(EnvoyExpectedTimeoutHeader, ms)
+	h.Set(GRPCTimeoutHeader, ms+"m")
+}
+
+// ParseDeadlineHeaders returns the deadline carried by the headers of a request. If both headers are set, the
+// shortest deadline is returned.
+func ParseDeadlineHeaders(h http.Header) (time.Duration, bool, error) {
+	var deadline time.Duration
+	found := false
+	if v := h.Get(EnvoyExpectedTimeoutHeader); v != "" {
+		ms, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || ms < 0 {
+			return 0, false, fmt.Errorf("invalid %s %q", EnvoyExpectedTimeoutHeader, v)
+		}
+		deadline, found = time.Duration(ms)*time.Millisecond, true
+	}
+	if v := h.Get(GRPCTimeoutHeader); v != "" {
+		d, err := ParseGRPCTimeout(v)
+		if err != nil {
+			return 0, false, err
+		}
+		if !found || d < deadline {
+			deadline, found = d, true
+		}
+	}
+	return deadline, found, nil
+}
+
+var grpcTimeoutUnits = map[byte]time.Duration{
+	'H': time.Hour,
+	'M': time.Minute,
+	'S': time.Second,
+	'm': time.Millisecond,
+	'u': time.Microsecond,
+	'n': time.Nanosecond,
+}
+
+// ParseGRPCTimeout parses a grpc-timeout value: at most 8 digits followed by a unit, for example 100m.
+func ParseGRPCTimeout(v string) (time.Duration, error) {
+	if len(v) < 2 || len(v) > 9 {
+		return 0, fmt.Errorf("invalid %s %q", GRPCTimeoutHeader, v)
+	}
+	unit, ok := grpcTimeoutUnits[v[len(v)-1]]
+	if !ok {
+		return 0, fmt.Errorf("invalid %s %q: unknown unit", GRPCTimeoutHeader, v)
+	}
+	var n int64
+	for _, c := range v[:len(v)-1] {
+		if c < '0' || c > '9' {
+			return 0, fmt.Errorf("invalid %s %q", GRPCTimeoutHeader, v)
+		}
+		n = n*10 + int64(c-'0')
+	}
+	return time.Duration(n) * unit, nil
+}
@@ -0,0 +1,137 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestParseGRPCTimeout(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "1H", want: time.Hour},
+		{in: "2M", want: 2 * time.Minute},
+		{in: "3S", want: 3 * time.Second},
+		{in: "100m", want: 100 * time.Millisecond},
+		{in: "5u", want: 5 * time.Microsecond},
+		{in: "7n", want: 7 * time.Nanosecond},
+		{in: "0m", want: 0},
+		{in: "99999999S", want: 99999999 * time.Second},
+		{in: "", wantErr: true},
+		{in: "m", wantErr: true},
+		{in: "100", wantErr: true},
+		{in: "100s", wantErr: true},
+		{in: "100ms", wantErr: true},
+		{in: "-1m", wantErr: true},
+		{in: "+1m", wantErr: true},
+		{in: "123456789S", wantErr: true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseGRPCTimeout(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestParseDeadlineHeaders(t *testing.T) {
+	cases := []struct {
+		name      string
+		headers   map[string]string
+		want      time.Duration
+		wantFound bool
+		wantErr   bool
+	}{
+		{
+			name: "none",
+		},
+		{
+			name:      "envoy",
+			headers:   map[string]string{EnvoyExpectedTimeoutHeader: "1500"},
+			want:      1500 * time.Millisecond,
+			wantFound: true,
+		},
+		{
+			name:      "grpc",
+			headers:   map[string]string{GRPCTimeoutHeader: "2S"},
+			want:      2 * time.Second,
+			wantFound: true,
+		},
+		{
+			name:      "shortest grpc",
+			headers:   map[string]string{EnvoyExpectedTimeoutHeader: "1500", GRPCTimeoutHeader: "1S"},
+			want:      time.Second,
+			wantFound: true,
+		},
+		{
+			name:      "shortest envoy",
+			headers:   map[string]string{EnvoyExpectedTimeoutHeader: "500", GRPCTimeoutHeader: "1S"},
+			want:      500 * time.Millisecond,
+			wantFound: true,
+		},
+		{
+			name:    "invalid envoy",
+			headers: map[string]string{EnvoyExpectedTimeoutHeader: "1s"},
+			wantErr: true,
+		},
+		{
+			name:    "negative envoy",
+			headers: map[string]string{EnvoyExpectedTimeoutHeader: "-1"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid grpc",
+			headers: map[string]string{EnvoyExpectedTimeoutHeader: "1500", GRPCTimeoutHeader: "1x"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			for k, v := range tt.headers {
+				h.Set(k, v)
+			}
+			got, found, err := ParseDeadlineHeaders(h)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, found, tt.wantFound)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
+
+func TestSetDeadlineHeaders(t *testing.T) {
+	h := http.Header{}
+	SetDeadlineHeaders(h, 1500*time.Millisecond)
+	got, found, err := ParseDeadlineHeaders(h)
+	assert.NoError(t, err)
+	assert.Equal(t, found, true)
+	assert.Equal(t, got, 1500*time.Millisecond)
+}
@@ -14,7 +14,14 @@
 
 package common
 
-import "istio.io/istio/pkg/config/protocol"
+import (
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+
+	"istio.io/istio/pkg/config/protocol"
+)
 
 // TLSSettings defines TLS configuration for Echo server
 type TLSSettings struct {
@@ -35,6 +42,44 @@ type TLSSettings struct {
 	AcceptAnyALPN bool
 }
 
+// ClientCertificate is a client certificate held by the echo client, which can be selected by name for each call.
+type ClientCertificate struct {
+	// Cert is the PEM encoded certificate chain.
+	Cert string
+	// Key is the PEM encoded private key.
+	Key string
+}
+
+// LoadClientCertificate loads a client certificate from a flag value in the format name=certFile,keyFile.
+func LoadClientCertificate(value string) (string, ClientCertificate, error) {
+	name, files, f := strings.Cut(value, "=")
+	certFile, keyFile, ok := strings.Cut(files, ",")
+	if !f || !ok || name == "" {
+		return "", ClientCertificate{}, fmt.Errorf("invalid client certificate %q, expected name=certFile,keyFile", value)
+	}
+	cert, err := os.ReadFile(certFile)
+	if err != nil {
+		return "", ClientCertificate{}, fmt.Errorf("failed to load client certificate %s: %v", name, err)
+	}
+	key, err := os.ReadFile(keyFile)
+	if err != nil {
+		return "", ClientCertificate{}, fmt.Errorf("failed to load client certificate key %s: %v", name, err)
+	}
+	return name, ClientCertificate{Cert: string(cert), Key: string(key)}, nil
+}
+
+// CertificateIdentity returns the identity of a peer certificate: its first URI SAN, its first DNS SAN or its
+// common name, in that order.
+func CertificateIdentity(cert *x509.Certificate) string {
+	if len(cert.URIs) > 0 {
+		return cert.URIs[0].String()
+	}
+	if len(cert.DNSNames) > 0 {
+		return cert.DNSNames[0]
+	}
+	return cert.Subject.CommonName
+}
+
 // Port represents a network port where a service is listening for
 // connections. The port should be annotated with the type of protocol
 // used by the port.
@@ -57,6 +102,14 @@ type Port struct {
 	// ServerFirst if a port will be server first
 	ServerFirst bool
 
+	// RequestClientCert determines if a TLS HTTP port requests a client certificate, to report its identity back to
+	// the client. The certificate is not verified.
+	RequestClientCert bool
+
+	// H2CUpgrade determines if a plaintext HTTP port accepts h2c Upgrades, to test the upgrade path through the
+	// proxies. Otherwise, only h2c with prior knowledge is accepted.
+	H2CUpgrade bool
+
 	// InstanceIP determines if echo will listen on the instance IP, or wildcard
 	InstanceIP bool
 
@@ -50,6 +50,25 @@ func GetTimeout(request *proto.ForwardEchoRequest) time.Duration {
 	return timeout
 }
 
+// GetConnectTimeout returns the connect timeout as a time.Duration or ConnectionTimeout if not set.
+func GetConnectTimeout(request *proto.ForwardEchoRequest) time.Duration {
+	timeout := MicrosToDuration(request.ConnectTimeoutMicros)
+	if timeout == 0 {
+		timeout = ConnectionTimeout
+	}
+	return timeout
+}
+
+// GetTLSHandshakeTimeout returns the TLS handshake timeout as a time.Duration, or 0 if not set.
+func GetTLSHandshakeTimeout(request *proto.ForwardEchoRequest) time.Duration {
+	return MicrosToDuration(request.TlsHandshakeTimeoutMicros)
+}
+
+// GetResponseHeaderTimeout returns the response header timeout as a time.Duration, or 0 if not set.
+func GetResponseHeaderTimeout(request *proto.ForwardEchoRequest) time.Duration {
+	return MicrosToDuration(request.ResponseHeaderTimeoutMicros)
+}
+
 // GetCount returns the count value or DefaultCount if not set.
 func GetCount(request *proto.ForwardEchoRequest) int {
 	if request.Count > 1 {
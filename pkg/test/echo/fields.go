@@ -40,6 +40,11 @@ func (f Field) WriteKeyValue(out io.StringWriter, key, value string) {
 	f.Write(out, key+":"+value)
 }
 
+// ResponseHeader returns the response header an echo server sets to the value of an IdentityFields field.
+func (f Field) ResponseHeader() string {
+	return "X-Echo-" + string(f)
+}
+
 func (f Field) WriteForRequest(out io.StringWriter, requestID int, value string) {
 	_, _ = out.WriteString(fmt.Sprintf("[%d] %s=%s\n", requestID, f, value))
 }
@@ -56,32 +61,68 @@ func WriteError(out io.StringWriter, requestID int, err error) {
 	_, _ = out.WriteString(fmt.Sprintf("[%d error] %v\n", requestID, err))
 }
 
+// HopPrefix prefixes the lines of the report of a call chain hop.
+const HopPrefix = "[hop] "
+
+// WriteHopLine writes a line of the report of a call chain hop.
+func WriteHopLine(out io.StringWriter, line string) {
+	_, _ = out.WriteString(HopPrefix + line + "\n")
+}
+
+const (
+	RequestIDField           Field = "X-Request-Id"
+	ServiceVersionField      Field = "ServiceVersion"
+	ServicePortField         Field = "ServicePort"
+	StatusCodeField          Field = "StatusCode"
+	URLField                 Field = "URL"
+	ForwarderURLField        Field = "Url"
+	ForwarderMessageField    Field = "Echo"
+	ForwarderHeaderField     Field = "Header"
+	HostField                Field = "Host"
+	HostnameField            Field = "Hostname"
+	NamespaceField           Field = "Namespace"
+	MethodField              Field = "Method"
+	ProtocolField            Field = "Proto"
+	AlpnField                Field = "Alpn"
+	RequestHeaderField       Field = "RequestHeader"
+	ResponseHeaderField      Field = "ResponseHeader"
+	ClusterField             Field = "Cluster"
+	IstioVersionField        Field = "IstioVersion"
+	IPField                  Field = "IP" // The Requester’s IP Address.
+	LatencyField             Field = "Latency"
+	ActiveRequestsField      Field = "ActiveRequests"
+	DNSProtocolField         Field = "Protocol"
+	DNSQueryField            Field = "Query"
+	DNSServerField           Field = "DnsServer"
+	CipherField              Field = "Cipher"
+	TLSVersionField          Field = "Version"
+	TLSServerName            Field = "ServerName"
+	RequestBodyBytesField    Field = "RequestBodyBytes"
+	RequestBodyDurationField Field = "RequestBodyDuration"
+	RequestBodyPartsField    Field = "RequestBodyParts"
+	DeadlineField            Field = "Deadline"
+	CanceledField            Field = "Canceled"
+	PeerIdentityField        Field = "PeerIdentity"
+	H2CModeField             Field = "H2cMode"
+	RedirectField            Field = "Redirect"
+	FailurePhaseField        Field = "FailurePhase"
+	ResponsePayloadField     Field = "ResponsePayload"
+	BodySHA256Field          Field = "BodySHA256"
+	CompressedBodySizeField  Field = "CompressedBodySize"
+	BodyIntegrityField       Field = "BodyIntegrity"
+)
+
+// IdentityHeadersRequestHeader asks an echo server to also return the IdentityFields as response headers. The client
+// sets it when a call requests an encoding, so it still reports the identity of the server when the body is compressed
+// with an encoding it cannot decompress, such as br.
+const IdentityHeadersRequestHeader = "X-Echo-Identity-Headers"
+
+// IdentityFields are the fields of the response body identifying the server, returned as response headers if the
+// request sets IdentityHeadersRequestHeader.
+var IdentityFields = []Field{ServiceVersionField, ServicePortField, ClusterField, NamespaceField, HostnameField}
+
+// Values of the BodyIntegrityField.
 const (
-	RequestIDField        Field = "X-Request-Id"
-	ServiceVersionField   Field = "ServiceVersion"
-	ServicePortField      Field = "ServicePort"
-	StatusCodeField       Field = "StatusCode"
-	URLField              Field = "URL"
-	ForwarderURLField     Field = "Url"
-	ForwarderMessageField Field = "Echo"
-	ForwarderHeaderField  Field = "Header"
-	HostField             Field = "Host"
-	HostnameField         Field = "Hostname"
-	NamespaceField        Field = "Namespace"
-	MethodField           Field = "Method"
-	ProtocolField         Field = "Proto"
-	AlpnField             Field = "Alpn"
-	RequestHeaderField    Field = "RequestHeader"
-	ResponseHeaderField   Field = "ResponseHeader"
-	ClusterField          Field = "Cluster"
-	IstioVersionField     Field = "IstioVersion"
-	IPField               Field = "IP" // The Requester’s IP Address.
-	LatencyField          Field = "Latency"
-	ActiveRequestsField   Field = "ActiveRequests"
-	DNSProtocolField      Field = "Protocol"
-	DNSQueryField         Field = "Query"
-	DNSServerField        Field = "DnsServer"
-	CipherField           Field = "Cipher"
-	TLSVersionField       Field = "Version"
-	TLSServerName         Field = "ServerName"
+	BodyIntact    = "intact"
+	BodyCorrupted = "corrupted"
 )
@@ -15,6 +15,7 @@
 package echo
 
 import (
+	"encoding/hex"
 	"net/http"
 	"regexp"
 	"strings"
@@ -38,8 +39,35 @@ var (
 	methodFieldRegex         = regexp.MustCompile(string(MethodField) + "=(.*)")
 	protocolFieldRegex       = regexp.MustCompile(string(ProtocolField) + "=(.*)")
 	alpnFieldRegex           = regexp.MustCompile(string(AlpnField) + "=(.*)")
+	forwarderURLFieldRegex   = regexp.MustCompile(string(ForwarderURLField) + "=(.*)")
+	requestBodyBytesRegex    = regexp.MustCompile(string(RequestBodyBytesField) + "=(.*)")
+	requestBodyDurationRegex = regexp.MustCompile(string(RequestBodyDurationField) + "=(.*)")
+	requestBodyPartsRegex    = regexp.MustCompile(string(RequestBodyPartsField) + "=(.*)")
+	deadlineFieldRegex       = regexp.MustCompile(string(DeadlineField) + "=(.*)")
+	canceledFieldRegex       = regexp.MustCompile(string(CanceledField) + "=(.*)")
+	peerIdentityFieldRegex   = regexp.MustCompile(string(PeerIdentityField) + "=(.*)")
+	latencyFieldRegex        = regexp.MustCompile(string(LatencyField) + "=(.*)")
+	h2cModeFieldRegex        = regexp.MustCompile(string(H2CModeField) + "=(.*)")
+	redirectFieldRegex       = regexp.MustCompile(string(RedirectField) + "=([0-9]+) (.*)")
+	failurePhaseFieldRegex   = regexp.MustCompile(string(FailurePhaseField) + "=([a-z-]+)")
+	responsePayloadRegex     = regexp.MustCompile(string(ResponsePayloadField) + "=([0-9a-f]*)")
+	compressedBodySizeRegex  = regexp.MustCompile(string(CompressedBodySizeField) + "=([0-9]+)")
+	bodyIntegrityRegex       = regexp.MustCompile(string(BodyIntegrityField) + "=([a-z]+)")
 )
 
+// ParseFailurePhase returns the phase in which the requests of a forward call failed, from the error of the call. It
+// returns an empty phase if the error does not report one.
+func ParseFailurePhase(err error) FailurePhase {
+	if err == nil {
+		return ""
+	}
+	match := failurePhaseFieldRegex.FindStringSubmatch(err.Error())
+	if match == nil {
+		return ""
+	}
+	return FailurePhase(match[1])
+}
+
 func ParseResponses(req *proto.ForwardEchoRequest, resp *proto.ForwardEchoResponse) Responses {
 	responses := make([]Response, len(resp.Output))
 	for i, output := range resp.Output {
@@ -56,6 +84,16 @@ func parseResponse(output string) Response {
 		ResponseHeaders: make(http.Header),
 	}
 
+	// Call chain hop reports are parsed separately, so they don't leak into this response.
+	output, hopOutput := splitHopLines(output)
+	if hopOutput != "" {
+		hop := parseResponse(hopOutput)
+		if match := forwarderURLFieldRegex.FindStringSubmatch(hopOutput); match != nil {
+			hop.RequestURL = match[1]
+		}
+		out.Hops = append([]Response{hop}, hop.Hops...)
+	}
+
 	match := requestIDFieldRegex.FindStringSubmatch(output)
 	if match != nil {
 		out.ID = match[1]
@@ -121,6 +159,65 @@ func parseResponse(output string) Response {
 		out.IP = match[1]
 	}
 
+	match = latencyFieldRegex.FindStringSubmatch(output)
+	if match != nil {
+		out.Latency = match[1]
+	}
+
+	match = requestBodyBytesRegex.FindStringSubmatch(output)
+	if match != nil {
+		out.RequestBodyBytes = match[1]
+	}
+
+	match = requestBodyDurationRegex.FindStringSubmatch(output)
+	if match != nil {
+		out.RequestBodyDuration = match[1]
+	}
+
+	match = requestBodyPartsRegex.FindStringSubmatch(output)
+	if match != nil {
+		out.RequestBodyParts = match[1]
+	}
+
+	match = deadlineFieldRegex.FindStringSubmatch(output)
+	if match != nil {
+		out.Deadline = match[1]
+	}
+
+	match = canceledFieldRegex.FindStringSubmatch(output)
+	if match != nil {
+		out.Canceled = match[1]
+	}
+
+	match = peerIdentityFieldRegex.FindStringSubmatch(output)
+	if match != nil {
+		out.PeerIdentity = match[1]
+	}
+
+	match = h2cModeFieldRegex.FindStringSubmatch(output)
+	if match != nil {
+		out.H2CMode = match[1]
+	}
+
+	match = responsePayloadRegex.FindStringSubmatch(output)
+	if match != nil {
+		out.ResponsePayload, _ = hex.DecodeString(match[1])
+	}
+
+	match = compressedBodySizeRegex.FindStringSubmatch(output)
+	if match != nil {
+		out.CompressedBodySize = match[1]
+	}
+
+	match = bodyIntegrityRegex.FindStringSubmatch(output)
+	if match != nil {
+		out.BodyIntegrity = match[1]
+	}
+
+	for _, m := range redirectFieldRegex.FindAllStringSubmatch(output, -1) {
+		out.Redirects = append(out.Redirects, Redirect{Code: m[1], URL: m[2]})
+	}
+
 	out.rawBody = map[string]string{}
 
 	matches := requestHeaderFieldRegex.FindAllStringSubmatch(output, -1)
@@ -155,3 +252,21 @@ func parseResponse(output string) Response {
 
 	return out
 }
+
+// splitHopLines separates the lines of the report of a call chain hop, removing one level of HopPrefix.
+func splitHopLines(output string) (string, string) {
+	if !strings.Contains(output, HopPrefix) {
+		return output, ""
+	}
+	var own, hop []string
+	for _, l := range strings.Split(output, "\n") {
+		idx := strings.Index(l, HopPrefix)
+		// Lines may be prefixed by the forwarder, for example "[0 body] [hop] ..."
+		if idx < 0 || (idx > 0 && !strings.HasSuffix(l[:idx], "body] ")) {
+			own = append(own, l)
+			continue
+		}
+		hop = append(hop, l[:idx]+l[idx+len(HopPrefix):])
+	}
+	return strings.Join(own, "\n"), strings.Join(hop, "\n")
+}
@@ -0,0 +1,103 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package echo
+
+import (
+	"strings"
+	"testing"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+// callChainOutput is the output of a forwarded request to a, executing the call chain a -> b -> c.
+var callChainOutput = strings.Join([]string{
+	"[0] Url=http://a",
+	"[0] StatusCode=200",
+	"[0 body] Hostname=a",
+	"[0 body] [hop] Url=http://b",
+	"[0 body] [hop] StatusCode=200",
+	"[0 body] [hop] Hostname=b",
+	"[0 body] [hop] [hop] Url=http://c",
+	"[0 body] [hop] [hop] StatusCode=503",
+	"[0 body] [hop] [hop] Hostname=c",
+	"[0 body] Echo=[hop] not a hop",
+	"",
+}, "\n")
+
+func TestSplitHopLines(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		wantOwn string
+		wantHop string
+	}{
+		{
+			name:    "no hop",
+			in:      "[0] StatusCode=200\n[0 body] Hostname=a\n",
+			wantOwn: "[0] StatusCode=200\n[0 body] Hostname=a\n",
+		},
+		{
+			name: "nested hops",
+			in:   callChainOutput,
+			wantOwn: strings.Join([]string{
+				"[0] Url=http://a",
+				"[0] StatusCode=200",
+				"[0 body] Hostname=a",
+				"[0 body] Echo=[hop] not a hop",
+				"",
+			}, "\n"),
+			wantHop: strings.Join([]string{
+				"[0 body] Url=http://b",
+				"[0 body] StatusCode=200",
+				"[0 body] Hostname=b",
+				"[0 body] [hop] Url=http://c",
+				"[0 body] [hop] StatusCode=503",
+				"[0 body] [hop] Hostname=c",
+			}, "\n"),
+		},
+		{
+			// The report of a hop, as written by the server before the forwarder prefixes it
+			name:    "server report",
+			in:      "Hostname=a\n[hop] Hostname=b\n[hop] [hop] Hostname=c",
+			wantOwn: "Hostname=a",
+			wantHop: "Hostname=b\n[hop] Hostname=c",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			own, hop := splitHopLines(tt.in)
+			assert.Equal(t, own, tt.wantOwn)
+			assert.Equal(t, hop, tt.wantHop)
+		})
+	}
+}
+
+func TestParseCallChainResponse(t *testing.T) {
+	r := parseResponse(callChainOutput)
+	assert.Equal(t, r.Hostname, "a")
+	assert.Equal(t, r.Code, "200")
+	assert.Equal(t, len(r.Hops), 2)
+
+	b, c := r.Hops[0], r.Hops[1]
+	assert.Equal(t, b.RequestURL, "http://b")
+	assert.Equal(t, b.Hostname, "b")
+	assert.Equal(t, b.Code, "200")
+	assert.Equal(t, c.RequestURL, "http://c")
+	assert.Equal(t, c.Hostname, "c")
+	assert.Equal(t, c.Code, "503")
+	// Each hop only holds the hops after it
+	assert.Equal(t, len(b.Hops), 1)
+	assert.Equal(t, len(c.Hops), 0)
+}
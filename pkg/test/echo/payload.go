@@ -0,0 +1,80 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package echo
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PayloadFixtures are the first bytes sent by clients of common binary protocols, to test the protocol sniffing and
+// the TCP routing of connections of realistic protocols.
+var PayloadFixtures = map[string][]byte{
+	// The SSLRequest a PostgreSQL client starts its connections with.
+	"postgres-ssl-request": {0x00, 0x00, 0x00, 0x08, 0x04, 0xd2, 0x16, 0x2f},
+	// A Kafka ApiVersions v0 request, with correlation id 1 and client id "echo".
+	"kafka-api-versions": {
+		0x00, 0x00, 0x00, 0x0e, 0x00, 0x12, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x01, 0x00, 0x04, 0x65, 0x63, 0x68, 0x6f,
+	},
+	// A Redis PING command, in the RESP protocol.
+	"redis-ping": []byte("*1\r\n$4\r\nPING\r\n"),
+	// A MongoDB OP_MSG hello command, whose first bytes are not printable.
+	"mongodb-hello": {
+		0x34, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xdd, 0x07, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, 0x00, 0x1f, 0x00, 0x00, 0x00, 0x10, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x00,
+		0x01, 0x00, 0x00, 0x00, 0x02, 0x24, 0x64, 0x62, 0x00, 0x06, 0x00, 0x00, 0x00, 0x61, 0x64, 0x6d,
+		0x69, 0x6e, 0x00, 0x00,
+	},
+}
+
+// ParsePayload parses the raw bytes of a TCP payload, given as hex:<hex bytes>, base64:<base64 bytes> or
+// fixture:<name> of one of the PayloadFixtures. Whitespace is ignored in the hex bytes.
+func ParsePayload(s string) ([]byte, error) {
+	kind, value, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, fmt.Errorf("invalid payload %q, expected hex:<bytes>, base64:<bytes> or fixture:<name>", s)
+	}
+	switch kind {
+	case "hex":
+		value = strings.Join(strings.Fields(value), "")
+		b, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid hex payload: %v", err)
+		}
+		return b, nil
+	case "base64":
+		b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid base64 payload: %v", err)
+		}
+		return b, nil
+	case "fixture":
+		b, f := PayloadFixtures[value]
+		if !f {
+			names := make([]string, 0, len(PayloadFixtures))
+			for name := range PayloadFixtures {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return nil, fmt.Errorf("unknown payload fixture %q, expected one of %v", value, names)
+		}
+		return b, nil
+	}
+	return nil, fmt.Errorf("invalid payload kind %q, expected hex, base64 or fixture", kind)
+}
@@ -0,0 +1,52 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package echo
+
+import (
+	"testing"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestParsePayload(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		want    []byte
+		wantErr bool
+	}{
+		{name: "hex", in: "hex:0001ff", want: []byte{0x00, 0x01, 0xff}},
+		{name: "hex with prefix and spaces", in: "hex:0x00 01\n ff", want: []byte{0x00, 0x01, 0xff}},
+		{name: "base64", in: "base64: AAH/ ", want: []byte{0x00, 0x01, 0xff}},
+		{name: "fixture", in: "fixture:redis-ping", want: []byte("*1\r\n$4\r\nPING\r\n")},
+		{name: "missing kind", in: "0001ff", wantErr: true},
+		{name: "unknown kind", in: "raw:0001ff", wantErr: true},
+		{name: "odd hex", in: "hex:001", wantErr: true},
+		{name: "invalid hex", in: "hex:zz", wantErr: true},
+		{name: "invalid base64", in: "base64:!!", wantErr: true},
+		{name: "unknown fixture", in: "fixture:mysql", wantErr: true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePayload(tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, got, tt.want)
+		})
+	}
+}
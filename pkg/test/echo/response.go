@@ -29,6 +29,32 @@ const (
 	ResponseHeader HeaderType = "response"
 )
 
+// FailurePhase is the phase of a request in which it failed.
+type FailurePhase string
+
+const (
+	// ConnectPhase is the establishment of the connection, including the DNS lookup.
+	ConnectPhase FailurePhase = "connect"
+	// TLSHandshakePhase is the TLS handshake of the connection.
+	TLSHandshakePhase FailurePhase = "tls-handshake"
+	// RequestPhase is the sending of the request over an established connection.
+	RequestPhase FailurePhase = "request"
+	// ResponseHeaderPhase is the wait for the response headers, once the request is sent.
+	ResponseHeaderPhase FailurePhase = "response-header"
+	// ResponsePhase is the reading of the response, once its headers are received.
+	ResponsePhase FailurePhase = "response"
+	// DeadlinePhase is reported when the total deadline of the request expired, whatever the phase it was in.
+	DeadlinePhase FailurePhase = "deadline"
+)
+
+// Redirect is a redirect followed by the client.
+type Redirect struct {
+	// Code is the status code of the redirect response
+	Code string
+	// URL is the URL the client was redirected to
+	URL string
+}
+
 // Response represents a response to a single echo request.
 type Response struct {
 	// RequestURL is the requested URL. This differs from URL, which is the just the path.
@@ -62,10 +88,42 @@ type Response struct {
 	IstioVersion string
 	// IP is the requester's ip address
 	IP string
+	// Latency is the time the client took to receive the response of the request, as measured by the forwarder.
+	Latency string
+	// RequestBodyBytes is the number of bytes of the request body received by the server, if any.
+	RequestBodyBytes string
+	// RequestBodyDuration is how long the server took to receive the request body.
+	RequestBodyDuration string
+	// RequestBodyParts is the number of parts of a multipart request body received by the server.
+	RequestBodyParts string
+	// Deadline is the deadline the server observed for the request, from its deadline headers.
+	Deadline string
+	// Canceled reports whether the request looked up with ?canceled=<request id> was canceled before the server
+	// responded to it.
+	Canceled string
+	// PeerIdentity is the identity of the client certificate presented to the server over TLS, if any.
+	PeerIdentity string
+	// H2CMode is how a cleartext HTTP/2 connection was established with the server, either "prior-knowledge" or
+	// "upgrade". It is empty for HTTP/1 and HTTP/2 over TLS.
+	H2CMode string
+	// Redirects are the redirects followed by the client before receiving the response, in order.
+	Redirects []Redirect
+	// ResponsePayload are the raw bytes received by the client of a TCP request sending a payload.
+	ResponsePayload []byte
+	// CompressedBodySize is the number of bytes of the body received by the client, if it was compressed with the
+	// Content-Encoding of the response.
+	CompressedBodySize string
+	// BodyIntegrity reports whether the body of a response requested with ?checksum was received intact, once
+	// decompressed, either BodyIntact or BodyCorrupted. It is empty if the body has no checksum, or was compressed
+	// with an encoding the client cannot decompress.
+	BodyIntegrity string
 	// rawBody gives a map of all key/values in the body of the response.
 	rawBody         map[string]string
 	RequestHeaders  http.Header
 	ResponseHeaders http.Header
+	// Hops are the responses of the hops of a call chain executed by the server, in order.
+	// See common.CallChainHeader.
+	Hops []Response
 }
 
 // Count occurrences of the given text within the body of this response.
@@ -121,6 +179,33 @@ func (r Response) String() string {
 	out += fmt.Sprintf("Cluster:          %s\n", r.Cluster)
 	out += fmt.Sprintf("IstioVersion:     %s\n", r.IstioVersion)
 	out += fmt.Sprintf("IP:               %s\n", r.IP)
+	if r.Latency != "" {
+		out += fmt.Sprintf("Latency:          %s\n", r.Latency)
+	}
+	if r.RequestBodyBytes != "" {
+		out += fmt.Sprintf("Request Body:     %s bytes in %s\n", r.RequestBodyBytes, r.RequestBodyDuration)
+	}
+	if r.Deadline != "" {
+		out += fmt.Sprintf("Deadline:         %s\n", r.Deadline)
+	}
+	if r.PeerIdentity != "" {
+		out += fmt.Sprintf("PeerIdentity:     %s\n", r.PeerIdentity)
+	}
+	if r.H2CMode != "" {
+		out += fmt.Sprintf("H2CMode:          %s\n", r.H2CMode)
+	}
+	if len(r.ResponsePayload) > 0 {
+		out += fmt.Sprintf("ResponsePayload:  %x\n", r.ResponsePayload)
+	}
+	if r.CompressedBodySize != "" {
+		out += fmt.Sprintf("Compressed Body:  %s bytes\n", r.CompressedBodySize)
+	}
+	if r.BodyIntegrity != "" {
+		out += fmt.Sprintf("BodyIntegrity:    %s\n", r.BodyIntegrity)
+	}
+	for _, redirect := range r.Redirects {
+		out += fmt.Sprintf("Redirect:         %s %s\n", redirect.Code, redirect.URL)
+	}
 	out += fmt.Sprintf("Request Headers:  %v\n", r.RequestHeaders)
 	out += fmt.Sprintf("Response Headers: %v\n", r.ResponseHeaders)
 
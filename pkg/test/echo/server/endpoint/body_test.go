@@ -0,0 +1,97 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpoint
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"istio.io/istio/pkg/test/echo"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestReadRequestBody(t *testing.T) {
+	var multipartBody bytes.Buffer
+	mw := multipart.NewWriter(&multipartBody)
+	for _, content := range []string{"first", "second", ""} {
+		w, err := mw.CreateFormFile("file", "file.txt")
+		assert.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, mw.Close())
+
+	cases := []struct {
+		name        string
+		body        string
+		contentType string
+		wantBytes   string
+		wantParts   string
+	}{
+		{
+			name: "no body",
+		},
+		{
+			name:      "plain",
+			body:      strings.Repeat("a", 1000),
+			wantBytes: "1000",
+		},
+		{
+			name:        "multipart",
+			body:        multipartBody.String(),
+			contentType: mw.FormDataContentType(),
+			wantBytes:   strconv.Itoa(multipartBody.Len()),
+			wantParts:   "3",
+		},
+		{
+			name:        "multipart content type without multipart body",
+			body:        "not multipart",
+			contentType: mw.FormDataContentType(),
+			wantBytes:   "13",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+			if tt.contentType != "" {
+				r.Header.Set("Content-Type", tt.contentType)
+			}
+			var body bytes.Buffer
+			err := readRequestBody(r, &body)
+			if tt.wantParts == "" && tt.contentType != "" {
+				// The malformed multipart body fails to parse, but what was read is still reported
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			fields := map[echo.Field]string{}
+			for _, line := range strings.Split(strings.TrimSpace(body.String()), "\n") {
+				if k, v, ok := strings.Cut(line, "="); ok {
+					fields[echo.Field(k)] = v
+				}
+			}
+			assert.Equal(t, fields[echo.RequestBodyBytesField], tt.wantBytes)
+			assert.Equal(t, fields[echo.RequestBodyPartsField], tt.wantParts)
+			_, hasDuration := fields[echo.RequestBodyDurationField]
+			assert.Equal(t, hasDuration, tt.wantBytes != "")
+		})
+	}
+}
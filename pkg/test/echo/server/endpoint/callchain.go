@@ -0,0 +1,96 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpoint
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"istio.io/istio/pkg/test/echo"
+	"istio.io/istio/pkg/test/echo/common"
+)
+
+// callChainClient executes the hops of call chains. Hops are test traffic, so certificates are not verified.
+var callChainClient = &http.Client{
+	Timeout: common.DefaultRequestTimeout,
+	Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, // nolint: gosec // test only code
+	},
+}
+
+// executeCallChain calls the first hop of the call chain of the request, if any, passing the remaining hops
+// along. The report of the hop, which includes the reports of all later hops, is appended to the body.
+func executeCallChain(r *http.Request, body *bytes.Buffer) {
+	value := r.Header.Get(common.CallChainHeader)
+	if value == "" {
+		return
+	}
+	hops, err := common.DecodeCallChain(value)
+	if err != nil {
+		writeError(body, err.Error())
+		return
+	}
+	hop, rest := hops[0], hops[1:]
+
+	req, err := http.NewRequestWithContext(r.Context(), http.MethodGet, hop.URL, nil)
+	if err != nil {
+		writeError(body, fmt.Sprintf("call chain hop %s: %v", hop.URL, err))
+		return
+	}
+	for _, name := range hop.ForwardHeaders {
+		for _, v := range r.Header.Values(name) {
+			req.Header.Add(name, v)
+		}
+	}
+	for k, v := range hop.Headers {
+		if strings.EqualFold(k, "host") {
+			req.Host = v
+			continue
+		}
+		req.Header.Set(k, v)
+	}
+	if len(rest) > 0 {
+		next, err := common.EncodeCallChain(rest...)
+		if err != nil {
+			writeError(body, fmt.Sprintf("call chain hop %s: %v", hop.URL, err))
+			return
+		}
+		req.Header.Set(common.CallChainHeader, next)
+	}
+
+	epLog.Infof("Executing call chain hop %s, %d hops remaining", hop.URL, len(rest))
+	resp, err := callChainClient.Do(req)
+	if err != nil {
+		writeError(body, fmt.Sprintf("call chain hop %s failed: %v", hop.URL, err))
+		return
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		writeError(body, fmt.Sprintf("call chain hop %s: failed to read response: %v", hop.URL, err))
+		return
+	}
+
+	echo.WriteHopLine(body, fmt.Sprintf("%s=%s", echo.ForwarderURLField, hop.URL))
+	echo.WriteHopLine(body, fmt.Sprintf("%s=%s", echo.StatusCodeField, strconv.Itoa(resp.StatusCode)))
+	for _, line := range strings.Split(strings.TrimSuffix(string(data), "\n"), "\n") {
+		echo.WriteHopLine(body, line)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpoint
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"istio.io/istio/pkg/test/echo"
+	"istio.io/istio/pkg/test/echo/common"
+)
+
+// maxCanceledRequests bounds the number of canceled requests remembered by the server.
+const maxCanceledRequests = 1000
+
+// canceledRequests records the IDs of the requests canceled while the server delayed its response, either by the
+// client or by a proxy enforcing their deadline. As the response of a canceled request is never received, whether it
+// was canceled is reported to a later request of the form ?canceled=<X-Request-Id of the canceled request>.
+var canceledRequests = &requestLog{ids: map[string]struct{}{}}
+
+// requestLog is a set of request IDs, forgetting the oldest IDs once full.
+type requestLog struct {
+	mu    sync.Mutex
+	ids   map[string]struct{}
+	order []string
+}
+
+func (l *requestLog) add(id string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, f := l.ids[id]; f {
+		return
+	}
+	if len(l.order) == maxCanceledRequests {
+		delete(l.ids, l.order[0])
+		l.order = l.order[1:]
+	}
+	l.ids[id] = struct{}{}
+	l.order = append(l.order, id)
+}
+
+func (l *requestLog) contains(id string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, f := l.ids[id]
+	return f
+}
+
+// recordCanceled records that the request was canceled. Requests without an ID cannot be looked up, so are only logged.
+func recordCanceled(r *http.Request) {
+	id := r.Header.Get(string(echo.RequestIDField))
+	epLog.WithLabels("id", id).Infof("request canceled before the response was sent: %v", r.Context().Err())
+	if id != "" {
+		canceledRequests.add(id)
+	}
+}
+
+// writeDeadline writes the deadline carried by the headers of the request, if any.
+func writeDeadline(r *http.Request, body *bytes.Buffer) {
+	deadline, found, err := common.ParseDeadlineHeaders(r.Header)
+	if err != nil {
+		writeError(body, "deadline error: "+err.Error())
+		return
+	}
+	if found {
+		echo.DeadlineField.Write(body, deadline.String())
+	}
+}
+
+// writeCanceled reports whether the request given by the form ?canceled=<request id> was canceled.
+func writeCanceled(r *http.Request, body *bytes.Buffer) {
+	id := r.FormValue("canceled")
+	if id == "" {
+		return
+	}
+	echo.CanceledField.Write(body, strconv.FormatBool(canceledRequests.contains(id)))
+}
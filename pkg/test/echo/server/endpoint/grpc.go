@@ -274,8 +274,12 @@ func (h *EchoGrpcHandler) Echo(ctx context.Context, req *proto.EchoRequest) (*pr
 	}
 
 	ip := "0.0.0.0"
+	peerIdentity := ""
 	if peerInfo, ok := peer.FromContext(ctx); ok {
 		ip, _, _ = net.SplitHostPort(peerInfo.Addr.String())
+		if tlsInfo, ok := peerInfo.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.PeerCertificates) > 0 {
+			peerIdentity = common.CertificateIdentity(tlsInfo.State.PeerCertificates[0])
+		}
 	}
 
 	echo.StatusCodeField.Write(&body, strconv.Itoa(http.StatusOK))
@@ -286,7 +290,12 @@ func (h *EchoGrpcHandler) Echo(ctx context.Context, req *proto.EchoRequest) (*pr
 	echo.IPField.Write(&body, ip)
 	echo.IstioVersionField.WriteNonEmpty(&body, h.IstioVersion)
 	echo.ProtocolField.Write(&body, "GRPC")
+	echo.PeerIdentityField.WriteNonEmpty(&body, peerIdentity)
 	echo.Field("Echo").Write(&body, req.GetMessage())
+	// gRPC servers derive the deadline of the call from grpc-timeout
+	if deadline, ok := ctx.Deadline(); ok {
+		echo.DeadlineField.Write(&body, time.Until(deadline).String())
+	}
 
 	if hostname, err := os.Hostname(); err == nil {
 		echo.HostnameField.Write(&body, hostname)
@@ -302,7 +311,7 @@ func (h *EchoGrpcHandler) ForwardEcho(ctx context.Context, req *proto.ForwardEch
 	l.Infof("ForwardEcho request")
 	t0 := time.Now()
 
-	ret, err := h.Forwarder.ForwardEcho(ctx, &forwarder.Config{Request: req})
+	ret, err := h.Forwarder.ForwardEcho(ctx, &forwarder.Config{Request: req, ClientCertificates: h.ClientCertificates})
 	if err == nil {
 		l.WithLabels("latency", time.Since(t0)).Infof("ForwardEcho response complete: %v", ret.GetOutput())
 	} else {
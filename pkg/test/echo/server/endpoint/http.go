@@ -17,8 +17,10 @@ package endpoint
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"fmt"
+	"io"
 	"math/rand"
 	"net"
 	"net/http"
@@ -72,11 +74,18 @@ func (s *httpInstance) Start(onReady OnReadyFunc) error {
 		IdleTimeout: idleTimeout,
 	}
 
+	var handler http.Handler = &httpHandler{
+		Config: s.Config,
+	}
+	if s.Port != nil && s.Port.H2CUpgrade {
+		// h2c Upgrades are allowed, so the upgrade path through the proxies can be tested
+		handler = h2c.NewUpgradeHandler(handler, h2s)
+	} else {
+		handler = h2c.NewHandler(handler, h2s)
+	}
 	s.server = &http.Server{
 		IdleTimeout: idleTimeout,
-		Handler: h2c.NewHandler(&httpHandler{
-			Config: s.Config,
-		}, h2s),
+		Handler:     handler,
 	}
 
 	var listener net.Listener
@@ -106,6 +115,10 @@ func (s *httpInstance) Start(onReady OnReadyFunc) error {
 			},
 			MinVersion: tls.VersionTLS12,
 		}
+		if s.Port.RequestClientCert {
+			// Client certificates are not verified, only their identity is reported back to the client
+			config.ClientAuth = tls.RequestClientCert
+		}
 		// Listen on the given port and update the port if it changed from what was passed in.
 		listener, port, err = listenOnAddressTLS(s.ListenerIP, s.Port.Port, config)
 		// Store the actual listening port back to the argument.
@@ -249,10 +262,19 @@ func (h *httpHandler) echo(w http.ResponseWriter, r *http.Request, id uuid.UUID)
 		writeError(&body, "ParseForm() error: "+err.Error())
 	}
 
+	// Consume the request body, reporting how much was received and how long it took
+	if err := readRequestBody(r, &body); err != nil {
+		writeError(&body, "request body error: "+err.Error())
+	}
+
 	// If the request has form ?delay=[:duration] wait for duration
 	// For example, ?delay=10s will cause the response to wait 10s before responding
-	if err := delayResponse(r); err != nil {
+	// If the request is canceled meanwhile, the response cannot be received, so the cancellation is recorded instead
+	if canceled, err := delayResponse(r); err != nil {
 		writeError(&body, "error delaying response error: "+err.Error())
+	} else if canceled {
+		recordCanceled(r)
+		return
 	}
 
 	// If the request has form ?headers=name:value[,name:value]* return those headers in response
@@ -269,6 +291,18 @@ func (h *httpHandler) echo(w http.ResponseWriter, r *http.Request, id uuid.UUID)
 	}
 
 	h.addResponsePayload(r, &body)
+	h.addIdentityHeaders(r, w)
+
+	// Report the deadline the request carries, and whether the request of form ?canceled=<request id> was canceled
+	writeDeadline(r, &body)
+	writeCanceled(r, &body)
+
+	// If the request has a call chain, execute it and append the report of each hop
+	executeCallChain(r, &body)
+
+	// If the request has form ?checksum, end the body with the checksum of the rest of it, so that the client can
+	// verify it was received intact, such as once decompressed
+	writeBodyChecksum(r, &body)
 
 	w.Header().Set("Content-Type", "application/text")
 	if _, err := w.Write(body.Bytes()); err != nil {
@@ -337,6 +371,10 @@ func (h *httpHandler) addResponsePayload(r *http.Request, body *bytes.Buffer) {
 		alpn = r.TLS.NegotiatedProtocol
 	}
 	echo.AlpnField.WriteNonEmpty(body, alpn)
+	echo.H2CModeField.WriteNonEmpty(body, h2c.Mode(r))
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+		echo.PeerIdentityField.Write(body, common.CertificateIdentity(r.TLS.PeerCertificates[0]))
+	}
 
 	var keys []string
 	for k := range r.Header {
@@ -355,18 +393,108 @@ func (h *httpHandler) addResponsePayload(r *http.Request, body *bytes.Buffer) {
 	}
 }
 
-func delayResponse(request *http.Request) error {
+// addIdentityHeaders returns the fields identifying the server as response headers, if requested, so the client can
+// read them even if it cannot decompress the body.
+func (h *httpHandler) addIdentityHeaders(r *http.Request, w http.ResponseWriter) {
+	if r.Header.Get(echo.IdentityHeadersRequestHeader) == "" {
+		return
+	}
+	port := ""
+	if h.Port != nil {
+		port = strconv.Itoa(h.Port.Port)
+	}
+	hostname, _ := os.Hostname()
+	for f, v := range map[echo.Field]string{
+		echo.ServiceVersionField: h.Version,
+		echo.ServicePortField:    port,
+		echo.ClusterField:        h.Cluster,
+		echo.NamespaceField:      h.Namespace,
+		echo.HostnameField:       hostname,
+	} {
+		if v != "" {
+			w.Header().Set(f.ResponseHeader(), v)
+		}
+	}
+}
+
+// delayResponse waits for the duration of the delay form value, if any. It returns true if the request was canceled
+// before the delay elapsed.
+func delayResponse(request *http.Request) (bool, error) {
 	d := request.FormValue("delay")
 	if len(d) == 0 {
-		return nil
+		return false, nil
 	}
 
 	t, err := time.ParseDuration(d)
 	if err != nil {
-		return err
+		return false, err
 	}
-	time.Sleep(t)
-	return nil
+	timer := time.NewTimer(t)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return false, nil
+	case <-request.Context().Done():
+		return true, nil
+	}
+}
+
+// countingReader counts the bytes read from the underlying reader.
+type countingReader struct {
+	io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.Reader.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// readRequestBody streams the request body, without buffering it, and writes the number of bytes received and how
+// long receiving them took. Multipart uploads are read part by part, additionally reporting the number of parts.
+// Nothing is written for requests without a body.
+func readRequestBody(r *http.Request, body *bytes.Buffer) error {
+	start := time.Now()
+	counter := &countingReader{Reader: r.Body}
+	r.Body = io.NopCloser(counter)
+
+	parts := 0
+	var err error
+	if mr, merr := r.MultipartReader(); merr == nil {
+		for {
+			part, perr := mr.NextPart()
+			if perr == io.EOF {
+				break
+			}
+			if perr != nil {
+				err = perr
+				break
+			}
+			parts++
+			if _, err = io.Copy(io.Discard, part); err != nil {
+				break
+			}
+		}
+	} else {
+		_, err = io.Copy(io.Discard, r.Body)
+	}
+
+	if counter.n > 0 {
+		echo.RequestBodyBytesField.Write(body, strconv.FormatInt(counter.n, 10))
+		echo.RequestBodyDurationField.Write(body, time.Since(start).String())
+		if parts > 0 {
+			echo.RequestBodyPartsField.Write(body, strconv.Itoa(parts))
+		}
+	}
+	return err
+}
+
+func writeBodyChecksum(r *http.Request, body *bytes.Buffer) {
+	if _, f := r.Form["checksum"]; !f {
+		return
+	}
+	echo.BodySHA256Field.Write(body, fmt.Sprintf("%x", sha256.Sum256(body.Bytes())))
 }
 
 func setHeaderResponseFromHeaders(request *http.Request, response http.ResponseWriter) error {
@@ -42,6 +42,8 @@ type Config struct {
 	IstioVersion  string
 	Namespace     string
 	DisableALPN   bool
+	// ClientCertificates are the client certificates the forwarded requests can select by name.
+	ClientCertificates map[string]common.ClientCertificate
 }
 
 // Instance of an endpoint that serves the Echo application on a single port/protocol.
@@ -0,0 +1,119 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forwarder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+
+	"istio.io/istio/pkg/test/echo/proto"
+)
+
+// bodyPattern is repeated to generate request bodies. It is printable, so that bodies are readable when logged.
+const bodyPattern = "0123456789abcdefghijklmnopqrstuvwxyz\n"
+
+// patternReader endlessly repeats bodyPattern.
+type patternReader struct {
+	offset int
+}
+
+func (p *patternReader) Read(b []byte) (int, error) {
+	for i := range b {
+		b[i] = bodyPattern[p.offset]
+		p.offset = (p.offset + 1) % len(bodyPattern)
+	}
+	return len(b), nil
+}
+
+func generateBody(size int64) io.Reader {
+	return io.LimitReader(&patternReader{}, size)
+}
+
+// setRequestBody sets a generated body of the requested size on the request. The body is streamed rather than held
+// in memory, so that uploads well beyond the proxy buffer limits can be tested.
+func setRequestBody(httpReq *http.Request, r *proto.ForwardEchoRequest) error {
+	size := r.GetBodySize()
+	if size <= 0 {
+		return nil
+	}
+
+	if parts := int64(r.GetMultipartParts()); parts > 0 {
+		body, contentType, contentLength, err := newMultipartBody(size, parts)
+		if err != nil {
+			return err
+		}
+		httpReq.Body = body
+		httpReq.ContentLength = contentLength
+		if httpReq.Header.Get("Content-Type") == "" {
+			httpReq.Header.Set("Content-Type", contentType)
+		}
+	} else {
+		httpReq.Body = io.NopCloser(generateBody(size))
+		httpReq.ContentLength = size
+	}
+
+	if r.GetChunkedBody() {
+		// An unknown length makes HTTP/1.1 requests use chunked encoding. HTTP/2 and HTTP/3 have no chunked encoding,
+		// but the request is still sent without a content-length.
+		httpReq.ContentLength = -1
+		httpReq.TransferEncoding = []string{"chunked"}
+	}
+	return nil
+}
+
+// newMultipartBody returns a multipart/form-data body, with the generated content split evenly across the parts. The
+// body is written through a pipe as it is read; closing the body, which the HTTP client always does, stops the writer.
+func newMultipartBody(size, parts int64) (io.ReadCloser, string, int64, error) {
+	sizes := make([]int64, parts)
+	for i := range sizes {
+		sizes[i] = size / parts
+	}
+	sizes[parts-1] += size % parts
+
+	// The framing of the parts does not depend on their content, so the length is known upfront
+	var framing bytes.Buffer
+	fw := multipart.NewWriter(&framing)
+	if err := writeParts(fw, sizes, false); err != nil {
+		return nil, "", 0, err
+	}
+
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	if err := mw.SetBoundary(fw.Boundary()); err != nil {
+		return nil, "", 0, err
+	}
+	go func() {
+		_ = pw.CloseWithError(writeParts(mw, sizes, true))
+	}()
+	return pr, mw.FormDataContentType(), int64(framing.Len()) + size, nil
+}
+
+func writeParts(mw *multipart.Writer, sizes []int64, content bool) error {
+	for i, size := range sizes {
+		w, err := mw.CreateFormFile(fmt.Sprintf("file%d", i), fmt.Sprintf("file%d.txt", i))
+		if err != nil {
+			return err
+		}
+		if content {
+			if _, err := io.Copy(w, generateBody(size)); err != nil {
+				return err
+			}
+		}
+	}
+	return mw.Close()
+}
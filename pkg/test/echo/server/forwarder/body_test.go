@@ -0,0 +1,134 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forwarder
+
+import (
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+
+	"istio.io/istio/pkg/test/echo/proto"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestGenerateBody(t *testing.T) {
+	b, err := io.ReadAll(generateBody(int64(2*len(bodyPattern) + 3)))
+	assert.NoError(t, err)
+	assert.Equal(t, string(b), bodyPattern+bodyPattern+bodyPattern[:3])
+}
+
+func TestSetRequestBody(t *testing.T) {
+	cases := []struct {
+		name string
+		req  *proto.ForwardEchoRequest
+		// wantParts are the sizes of the parts, for multipart bodies
+		wantParts []int
+		wantSize  int
+		chunked   bool
+	}{
+		{
+			name: "no body",
+			req:  &proto.ForwardEchoRequest{},
+		},
+		{
+			name:     "plain",
+			req:      &proto.ForwardEchoRequest{BodySize: 100},
+			wantSize: 100,
+		},
+		{
+			name:     "chunked",
+			req:      &proto.ForwardEchoRequest{BodySize: 100, ChunkedBody: true},
+			wantSize: 100,
+			chunked:  true,
+		},
+		{
+			name:      "multipart",
+			req:       &proto.ForwardEchoRequest{BodySize: 100, MultipartParts: 4},
+			wantParts: []int{25, 25, 25, 25},
+		},
+		{
+			name:      "multipart with remainder",
+			req:       &proto.ForwardEchoRequest{BodySize: 1000, MultipartParts: 3},
+			wantParts: []int{333, 333, 334},
+		},
+		{
+			name:      "multipart with more parts than bytes",
+			req:       &proto.ForwardEchoRequest{BodySize: 2, MultipartParts: 3},
+			wantParts: []int{0, 0, 2},
+		},
+		{
+			name:      "chunked multipart",
+			req:       &proto.ForwardEchoRequest{BodySize: 100, MultipartParts: 2, ChunkedBody: true},
+			wantParts: []int{50, 50},
+			chunked:   true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			httpReq, err := http.NewRequest(http.MethodPost, "http://example.com", nil)
+			assert.NoError(t, err)
+			assert.NoError(t, setRequestBody(httpReq, tt.req))
+			if tt.req.GetBodySize() == 0 {
+				assert.Equal(t, httpReq.Body, nil)
+				return
+			}
+
+			b, err := io.ReadAll(httpReq.Body)
+			assert.NoError(t, err)
+			assert.NoError(t, httpReq.Body.Close())
+			if tt.chunked {
+				assert.Equal(t, httpReq.ContentLength, int64(-1))
+				assert.Equal(t, httpReq.TransferEncoding, []string{"chunked"})
+			} else {
+				// The announced length must match the bytes actually sent, including the multipart framing
+				assert.Equal(t, httpReq.ContentLength, int64(len(b)))
+			}
+
+			if tt.wantParts == nil {
+				assert.Equal(t, len(b), tt.wantSize)
+				return
+			}
+			mediaType, params, err := mime.ParseMediaType(httpReq.Header.Get("Content-Type"))
+			assert.NoError(t, err)
+			assert.Equal(t, mediaType, "multipart/form-data")
+			mr := multipart.NewReader(strings.NewReader(string(b)), params["boundary"])
+			var parts []int
+			for {
+				part, err := mr.NextPart()
+				if err == io.EOF {
+					break
+				}
+				assert.NoError(t, err)
+				content, err := io.ReadAll(part)
+				assert.NoError(t, err)
+				assert.Equal(t, string(content), strings.Repeat(bodyPattern, len(content)/len(bodyPattern)+1)[:len(content)])
+				parts = append(parts, len(content))
+			}
+			assert.Equal(t, parts, tt.wantParts)
+		})
+	}
+}
+
+func TestSetRequestBodyKeepsContentType(t *testing.T) {
+	httpReq, err := http.NewRequest(http.MethodPost, "http://example.com", nil)
+	assert.NoError(t, err)
+	httpReq.Header.Set("Content-Type", "application/custom")
+	assert.NoError(t, setRequestBody(httpReq, &proto.ForwardEchoRequest{BodySize: 10, MultipartParts: 2}))
+	assert.NoError(t, httpReq.Body.Close())
+	assert.Equal(t, httpReq.Header.Get("Content-Type"), "application/custom")
+}
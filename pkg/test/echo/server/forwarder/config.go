@@ -20,12 +20,15 @@ import (
 	"crypto/x509/pkix"
 	"encoding/asn1"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/cookiejar"
 	"net/url"
 	"os"
 	"strings"
 	"time"
 
+	"istio.io/istio/pkg/test/echo"
 	"istio.io/istio/pkg/test/echo/common"
 	"istio.io/istio/pkg/test/echo/common/scheme"
 	"istio.io/istio/pkg/test/echo/proto"
@@ -38,14 +41,20 @@ type Config struct {
 	XDSTestBootstrap []byte
 	// Http proxy used for connection
 	Proxy string
+	// ClientCertificates are the client certificates the request can select by name.
+	ClientCertificates map[string]common.ClientCertificate
 
 	// Filled in values.
 	scheme                  scheme.Instance
 	tlsConfig               *tls.Config
 	getClientCertificate    func(info *tls.CertificateRequestInfo) (*tls.Certificate, error)
-	checkRedirect           func(req *http.Request, via []*http.Request) error
+	checkRedirect           func(requestID int, out io.StringWriter) func(req *http.Request, via []*http.Request) error
+	cookieJar               http.CookieJar
 	proxyURL                func(*http.Request) (*url.URL, error)
 	timeout                 time.Duration
+	connectTimeout          time.Duration
+	tlsHandshakeTimeout     time.Duration
+	responseHeaderTimeout   time.Duration
 	count                   int
 	headers                 http.Header
 	newConnectionPerRequest bool
@@ -66,7 +75,18 @@ type Config struct {
 
 func (c *Config) fillDefaults() error {
 	c.checkRedirect = checkRedirectFunc(c.Request)
+	if c.Request.CookieJar {
+		// The jar is shared by the requests of this forward request only
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return err
+		}
+		c.cookieJar = jar
+	}
 	c.timeout = common.GetTimeout(c.Request)
+	c.connectTimeout = common.GetConnectTimeout(c.Request)
+	c.tlsHandshakeTimeout = common.GetTLSHandshakeTimeout(c.Request)
+	c.responseHeaderTimeout = common.GetResponseHeaderTimeout(c.Request)
 	c.count = common.GetCount(c.Request)
 	c.headers = common.GetHeaders(c.Request)
 	c.hboneHeaders = common.ProtoToHTTPHeaders(c.Request.Hbone.GetHeaders())
@@ -80,6 +100,10 @@ func (c *Config) fillDefaults() error {
 	c.method = c.Request.Method
 	if c.method == "" {
 		c.method = "GET"
+		if c.Request.BodySize > 0 {
+			// Uploads default to POST, as a body with GET is unusual enough for proxies to treat it differently
+			c.method = "POST"
+		}
 	}
 
 	if i := strings.IndexByte(c.Request.Url, ':'); i > 0 {
@@ -89,7 +113,7 @@ func (c *Config) fillDefaults() error {
 	}
 
 	var err error
-	c.getClientCertificate, err = getClientCertificateFunc(c.Request)
+	c.getClientCertificate, err = getClientCertificateFunc(c.Request, c.ClientCertificates)
 	if err != nil {
 		return err
 	}
@@ -158,8 +182,17 @@ func splitPath(raw string) (url, path string) {
 	return raw[:schemeEnd+pathBegin], raw[schemeEnd+pathBegin:]
 }
 
-func getClientCertificateFunc(r *proto.ForwardEchoRequest) (func(info *tls.CertificateRequestInfo) (*tls.Certificate, error), error) {
-	if r.KeyFile != "" && r.CertFile != "" {
+func getClientCertificateFunc(r *proto.ForwardEchoRequest,
+	certs map[string]common.ClientCertificate,
+) (func(info *tls.CertificateRequestInfo) (*tls.Certificate, error), error) {
+	if r.ClientCertName != "" {
+		cert, f := certs[r.ClientCertName]
+		if !f {
+			return nil, fmt.Errorf("unknown client certificate %q", r.ClientCertName)
+		}
+		r.Cert = cert.Cert
+		r.Key = cert.Key
+	} else if r.KeyFile != "" && r.CertFile != "" {
 		certData, err := os.ReadFile(r.CertFile)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load client certificate: %v", err)
@@ -309,6 +342,9 @@ func newTLSConfig(c *Config) (*tls.Config, error) {
 		if tlsConfig.ServerName == "" {
 			tlsConfig.ServerName = c.hostHeader
 		}
+		if r.H2CUpgrade {
+			return nil, fmt.Errorf("h2c upgrade requires HTTP")
+		}
 		setALPNForHTTP()
 	case scheme.HTTP:
 		if r.Http3 {
@@ -348,13 +384,33 @@ func newHBONETLSConfig(c *Config) (*tls.Config, error) {
 	return tlsConfig, nil
 }
 
-func checkRedirectFunc(req *proto.ForwardEchoRequest) func(req *http.Request, via []*http.Request) error {
-	if req.FollowRedirects {
-		return nil
+// defaultMaxRedirects is the number of redirects followed by default, like the Go HTTP client.
+const defaultMaxRedirects = 10
+
+// checkRedirectFunc returns the redirect policy of the requests. Each redirect followed by a request is reported to
+// its output.
+func checkRedirectFunc(req *proto.ForwardEchoRequest) func(requestID int, out io.StringWriter) func(req *http.Request, via []*http.Request) error {
+	if !req.FollowRedirects {
+		return func(int, io.StringWriter) func(req *http.Request, via []*http.Request) error {
+			return func(req *http.Request, via []*http.Request) error {
+				// Disable redirects
+				return http.ErrUseLastResponse
+			}
+		}
 	}
 
-	return func(req *http.Request, via []*http.Request) error {
-		// Disable redirects
-		return http.ErrUseLastResponse
+	maxRedirects := int(req.MaxRedirects)
+	if maxRedirects <= 0 {
+		maxRedirects = defaultMaxRedirects
+	}
+	return func(requestID int, out io.StringWriter) func(req *http.Request, via []*http.Request) error {
+		return func(req *http.Request, via []*http.Request) error {
+			// via holds the original request, followed by the redirects already followed
+			if len(via) > maxRedirects {
+				return fmt.Errorf("stopped after %d redirects", maxRedirects)
+			}
+			echo.RedirectField.WriteForRequest(out, requestID, fmt.Sprintf("%d %s", req.Response.StatusCode, req.URL))
+			return nil
+		}
 	}
 }
@@ -0,0 +1,78 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forwarder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"istio.io/istio/pkg/http/headers"
+	"istio.io/istio/pkg/test/echo"
+)
+
+// decodeResponseBody decompresses the body of a response with a Content-Encoding. The client only decompresses the
+// responses itself if it set the Accept-Encoding header of the request, so the body is received as is when the call
+// requests an encoding. It returns false if the body is compressed with an encoding it cannot decompress, such as br,
+// in which case only the identity of the server is reported, from the echo.IdentityFields response headers.
+func decodeResponseBody(requestID int, httpResp *http.Response, data []byte, out *bytes.Buffer) ([]byte, bool, error) {
+	encoding := strings.ToLower(httpResp.Header.Get(headers.ContentEncoding))
+	if encoding == "" || encoding == "identity" || httpResp.Uncompressed {
+		return data, true, nil
+	}
+	echo.CompressedBodySizeField.WriteForRequest(out, requestID, strconv.Itoa(len(data)))
+
+	var r io.ReadCloser
+	var err error
+	switch encoding {
+	case "gzip", "x-gzip":
+		r, err = gzip.NewReader(bytes.NewReader(data))
+	case "deflate":
+		r, err = zlib.NewReader(bytes.NewReader(data))
+	default:
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid %s response body: %v", encoding, err)
+	}
+	defer r.Close()
+	decoded, err := io.ReadAll(r)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid %s response body: %v", encoding, err)
+	}
+	return decoded, true, nil
+}
+
+// verifyBodyChecksum reports whether the body of a response ending with its checksum, as requested with ?checksum,
+// matches it.
+func verifyBodyChecksum(requestID int, data []byte, out *bytes.Buffer) {
+	prefix := []byte(echo.BodySHA256Field + "=")
+	i := bytes.LastIndex(data, prefix)
+	if i < 0 || (i > 0 && data[i-1] != '\n') {
+		return
+	}
+	expected := strings.TrimSpace(string(data[i+len(prefix):]))
+	integrity := echo.BodyCorrupted
+	if fmt.Sprintf("%x", sha256.Sum256(data[:i])) == expected {
+		integrity = echo.BodyIntact
+	}
+	echo.BodyIntegrityField.WriteForRequest(out, requestID, integrity)
+}
@@ -0,0 +1,156 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forwarder
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"istio.io/istio/pkg/http/headers"
+	"istio.io/istio/pkg/test/echo"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func compress(t *testing.T, encoding string, data string) []byte {
+	var buf bytes.Buffer
+	var w io.WriteCloser
+	switch encoding {
+	case "gzip":
+		w = gzip.NewWriter(&buf)
+	case "deflate":
+		w = zlib.NewWriter(&buf)
+	default:
+		return []byte(data)
+	}
+	_, err := w.Write([]byte(data))
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func encodedResponse(encoding string, body []byte) *http.Response {
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}
+	if encoding != "" {
+		resp.Header.Set(headers.ContentEncoding, encoding)
+	}
+	return resp
+}
+
+func TestDecodeResponseBody(t *testing.T) {
+	const body = "Hostname=a\nServiceVersion=v1\n"
+	cases := []struct {
+		name        string
+		encoding    string
+		data        []byte
+		want        string
+		wantDecoded bool
+		wantErr     bool
+	}{
+		{name: "identity", encoding: "", data: []byte(body), want: body, wantDecoded: true},
+		{name: "explicit identity", encoding: "identity", data: []byte(body), want: body, wantDecoded: true},
+		{name: "gzip", encoding: "gzip", data: compress(t, "gzip", body), want: body, wantDecoded: true},
+		{name: "x-gzip", encoding: "X-Gzip", data: compress(t, "gzip", body), want: body, wantDecoded: true},
+		{name: "deflate", encoding: "deflate", data: compress(t, "deflate", body), want: body, wantDecoded: true},
+		{name: "br", encoding: "br", data: []byte{0x1b, 0x02}},
+		{name: "invalid gzip", encoding: "gzip", data: []byte(body), wantErr: true},
+		{name: "truncated gzip", encoding: "gzip", data: compress(t, "gzip", body)[:20], wantErr: true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			decoded, ok, err := decodeResponseBody(1, encodedResponse(tt.encoding, tt.data), tt.data, &out)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, ok, tt.wantDecoded)
+			assert.Equal(t, string(decoded), tt.want)
+			if tt.encoding == "" || tt.encoding == "identity" {
+				assert.Equal(t, out.String(), "")
+			} else {
+				assert.Equal(t, out.String(), fmt.Sprintf("[1] %s=%d\n", echo.CompressedBodySizeField, len(tt.data)))
+			}
+		})
+	}
+
+	// A body already decompressed by the transport is used as is
+	resp := encodedResponse("gzip", nil)
+	resp.Uncompressed = true
+	decoded, ok, err := decodeResponseBody(1, resp, []byte(body), &bytes.Buffer{})
+	assert.NoError(t, err)
+	assert.Equal(t, ok, true)
+	assert.Equal(t, string(decoded), body)
+}
+
+func TestVerifyBodyChecksum(t *testing.T) {
+	withChecksum := func(body string) string {
+		return fmt.Sprintf("%s%s=%x\n", body, echo.BodySHA256Field, sha256.Sum256([]byte(body)))
+	}
+	cases := []struct {
+		name string
+		data string
+		want string
+	}{
+		{name: "intact", data: withChecksum("Hostname=a\n"), want: echo.BodyIntact},
+		{name: "empty body", data: withChecksum(""), want: echo.BodyIntact},
+		{name: "corrupted", data: strings.Replace(withChecksum("Hostname=a\n"), "Hostname=a", "Hostname=b", 1), want: echo.BodyCorrupted},
+		{name: "truncated", data: withChecksum("Hostname=a\n")[1:], want: echo.BodyCorrupted},
+		{name: "no checksum", data: "Hostname=a\n"},
+		// The checksum must be on its own line, not the value of another field
+		{name: "not a line", data: "Echo=" + withChecksum("")},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			verifyBodyChecksum(1, []byte(tt.data), &out)
+			if tt.want == "" {
+				assert.Equal(t, out.String(), "")
+				return
+			}
+			assert.Equal(t, out.String(), fmt.Sprintf("[1] %s=%s\n", echo.BodyIntegrityField, tt.want))
+		})
+	}
+}
+
+func TestProcessHTTPResponseUndecodedBody(t *testing.T) {
+	resp := encodedResponse("br", []byte{0x1b, 0x02})
+	resp.Header.Set(echo.HostnameField.ResponseHeader(), "b-v1-abc")
+	resp.Header.Set(echo.ClusterField.ResponseHeader(), "cluster-0")
+
+	// The body cannot be decompressed, but the identity of the server is still reported
+	var out bytes.Buffer
+	assert.NoError(t, processHTTPResponse(1, resp, &out))
+	for _, line := range []string{
+		"[1] StatusCode=200\n",
+		"[1] CompressedBodySize=2\n",
+		"[1] Cluster=cluster-0\n",
+		"[1] Hostname=b-v1-abc\n",
+	} {
+		assert.Equal(t, strings.Contains(out.String(), line), true)
+	}
+	assert.Equal(t, strings.Contains(out.String(), "body]"), false)
+}
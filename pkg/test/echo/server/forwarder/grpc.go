@@ -29,7 +29,6 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"istio.io/istio/pkg/test/echo"
-	"istio.io/istio/pkg/test/echo/common"
 	"istio.io/istio/pkg/test/echo/proto"
 )
 
@@ -161,7 +160,7 @@ func newGRPCConnection(cfg *Config) (*grpc.ClientConn, error) {
 	address := cfg.Request.Url[len(cfg.scheme+"://"):]
 
 	// Connect to the GRPC server.
-	ctx, cancel := context.WithTimeout(context.Background(), common.ConnectionTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.connectTimeout)
 	defer cancel()
 	return grpc.DialContext(ctx, address, opts...)
 }
@@ -0,0 +1,195 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forwarder
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+// h2cUpgradeTransport sends each request over a new HTTP/1.1 connection, requesting an Upgrade to h2c. Go's
+// http2.Transport only supports h2c with prior knowledge, so the HTTP/2 side of the connection is handled here.
+// If the server accepts the upgrade, the response is read from the stream 1 of the HTTP/2 connection. Otherwise,
+// the HTTP/1.1 response is returned as is.
+type h2cUpgradeTransport struct {
+	dial func(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+var _ http.RoundTripper = &h2cUpgradeTransport{}
+
+func newH2CUpgradeTransportGetter(cfg *Config) (httpTransportGetter, func()) {
+	t := &h2cUpgradeTransport{
+		dial: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			if len(cfg.UDS) > 0 {
+				return newDialer(cfg).DialContext(ctx, "unix", cfg.UDS)
+			}
+			return newDialer(cfg).DialContext(ctx, network, addr)
+		},
+	}
+	noCloseFn := func() {}
+
+	// The upgrade only applies to the first request of a connection, so the transport never re-uses them.
+	return func() (http.RoundTripper, func(), error) {
+		return t, noCloseFn, nil
+	}, noCloseFn
+}
+
+func (t *h2cUpgradeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	addr := req.URL.Host
+	if req.URL.Port() == "" {
+		addr = net.JoinHostPort(req.URL.Hostname(), "80")
+	}
+	conn, err := t.dial(req.Context(), "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if deadline, ok := req.Context().Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	upgrade := req.Clone(req.Context())
+	upgrade.Header.Set("Connection", "Upgrade, HTTP2-Settings")
+	upgrade.Header.Set("Upgrade", "h2c")
+	// An empty SETTINGS payload, keeping the defaults.
+	upgrade.Header.Set("HTTP2-Settings", "")
+	if err := upgrade.Write(conn); err != nil {
+		return nil, err
+	}
+
+	br := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(br, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		// The upgrade was declined, the request is answered over HTTP/1.1.
+		return readBody(resp)
+	}
+	_ = resp.Body.Close()
+	return readUpgradedResponse(conn, br, req)
+}
+
+// readUpgradedResponse reads the response to the upgrade request, sent by the server on the stream 1 of the HTTP/2
+// connection.
+func readUpgradedResponse(conn net.Conn, br *bufio.Reader, req *http.Request) (*http.Response, error) {
+	if _, err := io.WriteString(conn, http2.ClientPreface); err != nil {
+		return nil, err
+	}
+	fr := http2.NewFramer(conn, br)
+	fr.ReadMetaHeaders = hpack.NewDecoder(4096, nil)
+	if err := fr.WriteSettings(); err != nil {
+		return nil, err
+	}
+
+	resp := &http.Response{
+		Proto:      "HTTP/2.0",
+		ProtoMajor: 2,
+		Header:     http.Header{},
+		Request:    req,
+	}
+	var body bytes.Buffer
+	for {
+		f, err := fr.ReadFrame()
+		if err != nil {
+			return nil, err
+		}
+		switch f := f.(type) {
+		case *http2.SettingsFrame:
+			if !f.IsAck() {
+				if err := fr.WriteSettingsAck(); err != nil {
+					return nil, err
+				}
+			}
+		case *http2.PingFrame:
+			if !f.IsAck() {
+				if err := fr.WritePing(true, f.Data); err != nil {
+					return nil, err
+				}
+			}
+		case *http2.GoAwayFrame:
+			if f.ErrCode != http2.ErrCodeNo {
+				return nil, fmt.Errorf("h2c connection closed by the server: %v", f.ErrCode)
+			}
+		case *http2.RSTStreamFrame:
+			if f.StreamID == 1 {
+				return nil, fmt.Errorf("h2c upgrade stream reset by the server: %v", f.ErrCode)
+			}
+		case *http2.MetaHeadersFrame:
+			if f.StreamID != 1 {
+				continue
+			}
+			if resp.StatusCode == 0 {
+				status, err := strconv.Atoi(f.PseudoValue("status"))
+				if err != nil {
+					return nil, fmt.Errorf("invalid h2c response status %q", f.PseudoValue("status"))
+				}
+				if status >= 100 && status < 200 {
+					// Informational responses precede the final one.
+					continue
+				}
+				resp.StatusCode = status
+				resp.Status = strconv.Itoa(status) + " " + http.StatusText(status)
+				for _, hf := range f.RegularFields() {
+					resp.Header.Add(http.CanonicalHeaderKey(hf.Name), hf.Value)
+				}
+			}
+			if f.StreamEnded() {
+				resp.ContentLength = int64(body.Len())
+				resp.Body = io.NopCloser(&body)
+				return resp, nil
+			}
+		case *http2.DataFrame:
+			if f.StreamID != 1 {
+				continue
+			}
+			body.Write(f.Data())
+			if n := uint32(len(f.Data())); n > 0 {
+				// Keep the flow control windows open for the rest of the body.
+				if err := fr.WriteWindowUpdate(0, n); err != nil {
+					return nil, err
+				}
+				if err := fr.WriteWindowUpdate(1, n); err != nil {
+					return nil, err
+				}
+			}
+			if f.StreamEnded() {
+				resp.ContentLength = int64(body.Len())
+				resp.Body = io.NopCloser(&body)
+				return resp, nil
+			}
+		}
+	}
+}
+
+// readBody reads the whole body of a response, so it remains readable once its connection is closed.
+func readBody(resp *http.Response) (*http.Response, error) {
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	return resp, nil
+}
@@ -0,0 +1,121 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forwarder
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/http2"
+
+	"istio.io/istio/pkg/h2c"
+	"istio.io/istio/pkg/test/echo/proto"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestH2CTransports(t *testing.T) {
+	// The handler reports the h2c mode of the connection, and answers with a body of the requested size
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		size, _ := strconv.Atoi(r.URL.Query().Get("size"))
+		w.Header().Set("X-H2c-Mode", h2c.Mode(r))
+		_, _ = io.WriteString(w, strings.Repeat("a", size))
+	})
+	upgradeServer := httptest.NewServer(h2c.NewUpgradeHandler(handler, &http2.Server{}))
+	defer upgradeServer.Close()
+	// Without h2c support, the Upgrade header is ignored and the request is answered over HTTP/1.1
+	http1Server := httptest.NewServer(handler)
+	defer http1Server.Close()
+
+	cases := []struct {
+		name      string
+		url       string
+		req       *proto.ForwardEchoRequest
+		size      int
+		wantProto string
+		wantMode  string
+	}{
+		{
+			name:      "prior knowledge",
+			url:       upgradeServer.URL,
+			req:       &proto.ForwardEchoRequest{Http2: true},
+			size:      10,
+			wantProto: "HTTP/2.0",
+			wantMode:  h2c.ModePriorKnowledge,
+		},
+		{
+			name:      "upgrade",
+			url:       upgradeServer.URL,
+			req:       &proto.ForwardEchoRequest{H2CUpgrade: true},
+			size:      10,
+			wantProto: "HTTP/2.0",
+			wantMode:  h2c.ModeUpgrade,
+		},
+		{
+			// Larger than both the default frame size and the initial flow control window
+			name:      "upgrade with multi-frame body",
+			url:       upgradeServer.URL,
+			req:       &proto.ForwardEchoRequest{H2CUpgrade: true},
+			size:      200 * 1024,
+			wantProto: "HTTP/2.0",
+			wantMode:  h2c.ModeUpgrade,
+		},
+		{
+			name:      "declined upgrade",
+			url:       http1Server.URL,
+			req:       &proto.ForwardEchoRequest{H2CUpgrade: true},
+			size:      200 * 1024,
+			wantProto: "HTTP/1.1",
+			wantMode:  "",
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &Config{Request: tt.req}
+			var getTransport httpTransportGetter
+			var closeSharedTransport func()
+			if tt.req.H2CUpgrade {
+				getTransport, closeSharedTransport = newH2CUpgradeTransportGetter(cfg)
+			} else {
+				getTransport, closeSharedTransport = newHTTP2TransportGetter(cfg)
+			}
+			defer closeSharedTransport()
+			transport, closeTransport, err := getTransport()
+			assert.NoError(t, err)
+			defer closeTransport()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, tt.url+"/?size="+strconv.Itoa(tt.size), nil)
+			assert.NoError(t, err)
+			resp, err := transport.RoundTrip(req)
+			assert.NoError(t, err)
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			assert.NoError(t, err)
+
+			assert.Equal(t, resp.StatusCode, http.StatusOK)
+			assert.Equal(t, resp.Proto, tt.wantProto)
+			assert.Equal(t, resp.Header.Get("X-H2c-Mode"), tt.wantMode)
+			assert.Equal(t, len(body), tt.size)
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"net/http/httptrace"
 	"sort"
 	"strconv"
 	"strings"
@@ -31,7 +32,7 @@ import (
 	"github.com/quic-go/quic-go/http3"
 	"golang.org/x/net/http2"
 
-	"istio.io/istio/pkg/hbone"
+	"istio.io/istio/pkg/http/headers"
 	"istio.io/istio/pkg/test/echo"
 	"istio.io/istio/pkg/test/echo/common/scheme"
 	"istio.io/istio/pkg/test/echo/proto"
@@ -56,6 +57,8 @@ func (c *httpProtocol) ForwardEcho(ctx context.Context, cfg *Config) (*proto.For
 	switch {
 	case cfg.Request.Http3:
 		getTransport, closeSharedTransport = newHTTP3TransportGetter(cfg)
+	case cfg.Request.H2CUpgrade:
+		getTransport, closeSharedTransport = newH2CUpgradeTransportGetter(cfg)
 	case cfg.Request.Http2:
 		getTransport, closeSharedTransport = newHTTP2TransportGetter(cfg)
 	default:
@@ -107,8 +110,8 @@ func newHTTP2TransportGetter(cfg *Config) (httpTransportGetter, func()) {
 		if cfg.scheme == scheme.HTTPS {
 			return &http2.Transport{
 				TLSClientConfig: cfg.tlsConfig,
-				DialTLS: func(network, addr string, tlsConfig *tls.Config) (net.Conn, error) {
-					return hbone.TLSDialWithDialer(newDialer(cfg), network, addr, tlsConfig)
+				DialTLSContext: func(ctx context.Context, network, addr string, tlsConfig *tls.Config) (net.Conn, error) {
+					return dialTLS(ctx, cfg, network, addr, tlsConfig)
 				},
 			}
 		}
@@ -157,9 +160,10 @@ func newHTTPTransportGetter(cfg *Config) (httpTransportGetter, func()) {
 		}
 		out := &http.Transport{
 			// No connection pooling.
-			DisableKeepAlives: true,
-			TLSClientConfig:   cfg.tlsConfig,
-			DialContext:       dialContext,
+			DisableKeepAlives:   true,
+			TLSClientConfig:     cfg.tlsConfig,
+			TLSHandshakeTimeout: cfg.tlsHandshakeTimeout,
+			DialContext:         dialContext,
 		}
 
 		// Set the proxy in the transport, if specified.
@@ -184,9 +188,15 @@ type httpCall struct {
 	getTransport httpTransportGetter
 }
 
-func (c *httpCall) makeRequest(ctx context.Context, cfg *Config, requestID int) (string, error) {
+func (c *httpCall) makeRequest(ctx context.Context, cfg *Config, requestID int) (_ string, err error) {
 	start := time.Now()
 
+	// Report the phase in which the request fails.
+	phase := newRequestPhase()
+	defer func() {
+		err = phase.fail(ctx, err)
+	}()
+
 	r := cfg.Request
 	var outBuffer bytes.Buffer
 	echo.ForwarderURLField.WriteForRequest(&outBuffer, requestID, r.Url)
@@ -194,8 +204,10 @@ func (c *httpCall) makeRequest(ctx context.Context, cfg *Config, requestID int)
 	// Set the per-request timeout.
 	ctx, cancel := context.WithTimeout(ctx, cfg.timeout)
 	defer cancel()
+	ctx, cancelResponseHeader := phase.withResponseHeaderTimeout(ctx, cfg.responseHeaderTimeout)
+	defer cancelResponseHeader()
 
-	httpReq, err := http.NewRequestWithContext(ctx, cfg.method, cfg.urlHost, nil)
+	httpReq, err := http.NewRequestWithContext(httptrace.WithClientTrace(ctx, phase.clientTrace()), cfg.method, cfg.urlHost, nil)
 	if err != nil {
 		return outBuffer.String(), err
 	}
@@ -209,6 +221,10 @@ func (c *httpCall) makeRequest(ctx context.Context, cfg *Config, requestID int)
 	// Copy the headers.
 	httpReq.Header = cfg.headers.Clone()
 	writeForwardedHeaders(&outBuffer, requestID, cfg.headers)
+	if httpReq.Header.Get(headers.AcceptEncoding) != "" {
+		// The body may be compressed with an encoding the client cannot decompress
+		httpReq.Header.Set(echo.IdentityHeadersRequestHeader, "true")
+	}
 
 	// Propagate previous response cookies if any
 	if cfg.PropagateResponse != nil {
@@ -221,13 +237,19 @@ func (c *httpCall) makeRequest(ctx context.Context, cfg *Config, requestID int)
 	}
 	defer closeTransport()
 
-	// Create a new HTTP client.
+	// Create a new HTTP client. The request is bounded by the per-request timeout of its context, which reports the
+	// deadline phase once expired.
 	client := &http.Client{
-		CheckRedirect: cfg.checkRedirect,
-		Timeout:       cfg.timeout,
+		CheckRedirect: cfg.checkRedirect(requestID, &outBuffer),
+		Jar:           cfg.cookieJar,
 		Transport:     transport,
 	}
 
+	// Stream the generated body, if any. This is done last, as the client closes the body once it is set.
+	if err := setRequestBody(httpReq, r); err != nil {
+		return outBuffer.String(), err
+	}
+
 	// Make the request.
 	httpResp, err := client.Do(httpReq)
 	if err != nil {
@@ -274,6 +296,22 @@ func processHTTPResponse(requestID int, httpResp *http.Response, outBuffer *byte
 		}
 	}
 
+	// Decompress the body, if the call requested an encoding.
+	data, decoded, err := decodeResponseBody(requestID, httpResp, data, outBuffer)
+	if err != nil {
+		return err
+	}
+	if !decoded {
+		// Still report the identity of the server, from the response headers
+		for _, f := range echo.IdentityFields {
+			if v := httpResp.Header.Get(f.ResponseHeader()); v != "" {
+				f.WriteForRequest(outBuffer, requestID, v)
+			}
+		}
+		return nil
+	}
+	verifyBodyChecksum(requestID, data, outBuffer)
+
 	// Write the lines of the body to the output buffer.
 	for _, line := range strings.Split(string(data), "\n") {
 		if line != "" {
@@ -0,0 +1,199 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forwarder
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	"istio.io/istio/pkg/test/echo"
+)
+
+var (
+	errTLSHandshakeTimeout   = errors.New("TLS handshake timeout")
+	errResponseHeaderTimeout = errors.New("timeout awaiting response headers")
+)
+
+// phaseError is the error of a request, with the phase of the request in which it failed. The phase is reported in
+// the error message, for the caller to parse it with echo.ParseFailurePhase.
+type phaseError struct {
+	phase echo.FailurePhase
+	err   error
+}
+
+func (e *phaseError) Error() string {
+	return fmt.Sprintf("%v %s=%s", e.err, echo.FailurePhaseField, e.phase)
+}
+
+func (e *phaseError) Unwrap() error {
+	return e.err
+}
+
+// requestPhase tracks the phase of a request, to report the phase in which it fails.
+type requestPhase struct {
+	mu    sync.Mutex
+	phase echo.FailurePhase
+
+	// responseHeaderTimeout, if set, cancels the request if its response headers are not received in time once it
+	// is sent.
+	responseHeaderTimeout time.Duration
+	responseHeaderTimer   *time.Timer
+	cancel                context.CancelCauseFunc
+}
+
+func newRequestPhase() *requestPhase {
+	return &requestPhase{phase: echo.ConnectPhase}
+}
+
+func (p *requestPhase) set(phase echo.FailurePhase) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.phase = phase
+	if p.responseHeaderTimer != nil && phase != echo.ResponseHeaderPhase {
+		p.responseHeaderTimer.Stop()
+		p.responseHeaderTimer = nil
+	}
+	if phase == echo.ResponseHeaderPhase && p.cancel != nil && p.responseHeaderTimer == nil {
+		p.responseHeaderTimer = time.AfterFunc(p.responseHeaderTimeout, func() {
+			p.cancel(errResponseHeaderTimeout)
+		})
+	}
+}
+
+func (p *requestPhase) get() echo.FailurePhase {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.phase
+}
+
+// withResponseHeaderTimeout returns a context of the request, canceled if the response headers are not received within
+// the timeout once the request is sent.
+func (p *requestPhase) withResponseHeaderTimeout(ctx context.Context, timeout time.Duration) (context.Context, func()) {
+	if timeout <= 0 {
+		return ctx, func() {}
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	p.mu.Lock()
+	p.responseHeaderTimeout = timeout
+	p.cancel = cancel
+	p.mu.Unlock()
+	return ctx, func() {
+		p.mu.Lock()
+		if p.responseHeaderTimer != nil {
+			p.responseHeaderTimer.Stop()
+		}
+		p.mu.Unlock()
+		cancel(nil)
+	}
+}
+
+// clientTrace returns the trace following the phase of an HTTP request.
+func (p *requestPhase) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			p.set(echo.ConnectPhase)
+		},
+		ConnectStart: func(string, string) {
+			p.set(echo.ConnectPhase)
+		},
+		TLSHandshakeStart: func() {
+			p.set(echo.TLSHandshakePhase)
+		},
+		GotConn: func(httptrace.GotConnInfo) {
+			p.set(echo.RequestPhase)
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			p.set(echo.ResponseHeaderPhase)
+		},
+		GotFirstResponseByte: func() {
+			p.set(echo.ResponsePhase)
+		},
+	}
+}
+
+// fail returns the error of a request, with the phase in which it failed. A request failing once its deadline has
+// expired is reported in the deadline phase, whatever the phase it was in.
+func (p *requestPhase) fail(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	var pe *phaseError
+	if errors.As(err, &pe) {
+		return err
+	}
+	if cause := context.Cause(ctx); errors.Is(cause, errResponseHeaderTimeout) {
+		err = fmt.Errorf("%v: %w", cause, err)
+	}
+	return &phaseError{phase: phaseOrDeadline(ctx, p.get()), err: err}
+}
+
+// phaseOrDeadline returns the deadline phase if the deadline of the request has expired, or the given phase.
+func phaseOrDeadline(ctx context.Context, phase echo.FailurePhase) echo.FailurePhase {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return echo.DeadlinePhase
+	}
+	return phase
+}
+
+// dialTLS establishes a TLS connection, bounding its handshake by the TLS handshake timeout. A failure is reported in
+// the connect or TLS handshake phase.
+func dialTLS(ctx context.Context, cfg *Config, network, addr string, tlsConfig *tls.Config) (*tls.Conn, error) {
+	trace := httptrace.ContextClientTrace(ctx)
+	if trace != nil && trace.ConnectStart != nil {
+		trace.ConnectStart(network, addr)
+	}
+	rawConn, err := newDialer(cfg).DialContext(ctx, network, addr)
+	if err != nil {
+		return nil, &phaseError{phase: phaseOrDeadline(ctx, echo.ConnectPhase), err: err}
+	}
+
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	if tlsConfig.ServerName == "" {
+		// Infer the ServerName from the host we're connecting to, without changing the given config.
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			host = addr
+		}
+		tlsConfig = tlsConfig.Clone()
+		tlsConfig.ServerName = host
+	}
+
+	if trace != nil && trace.TLSHandshakeStart != nil {
+		trace.TLSHandshakeStart()
+	}
+	handshakeCtx := ctx
+	if cfg.tlsHandshakeTimeout > 0 {
+		var cancel context.CancelFunc
+		handshakeCtx, cancel = context.WithTimeoutCause(ctx, cfg.tlsHandshakeTimeout, errTLSHandshakeTimeout)
+		defer cancel()
+	}
+	conn := tls.Client(rawConn, tlsConfig)
+	if err := conn.HandshakeContext(handshakeCtx); err != nil {
+		_ = rawConn.Close()
+		if cause := context.Cause(handshakeCtx); errors.Is(cause, errTLSHandshakeTimeout) && ctx.Err() == nil {
+			err = fmt.Errorf("%v: %w", cause, err)
+		}
+		return nil, &phaseError{phase: phaseOrDeadline(ctx, echo.TLSHandshakePhase), err: err}
+	}
+	return conn, nil
+}
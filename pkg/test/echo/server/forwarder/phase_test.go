@@ -0,0 +1,258 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forwarder
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http/httptrace"
+	"strings"
+	"testing"
+	"time"
+
+	"istio.io/istio/pkg/test/echo"
+	"istio.io/istio/pkg/test/echo/proto"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestRequestPhaseFail(t *testing.T) {
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	headerTimeout, cancelHeader := context.WithCancelCause(context.Background())
+	cancelHeader(errResponseHeaderTimeout)
+
+	cases := []struct {
+		name        string
+		ctx         context.Context
+		trace       func(*httptrace.ClientTrace)
+		err         error
+		wantPhase   echo.FailurePhase
+		wantMessage string
+	}{
+		{
+			name:      "no error",
+			ctx:       context.Background(),
+			wantPhase: "",
+		},
+		{
+			name:      "connect",
+			ctx:       context.Background(),
+			trace:     func(tr *httptrace.ClientTrace) { tr.ConnectStart("tcp", "127.0.0.1:80") },
+			err:       errors.New("connection refused"),
+			wantPhase: echo.ConnectPhase,
+		},
+		{
+			name:      "tls handshake",
+			ctx:       context.Background(),
+			trace:     func(tr *httptrace.ClientTrace) { tr.TLSHandshakeStart() },
+			err:       errors.New("bad certificate"),
+			wantPhase: echo.TLSHandshakePhase,
+		},
+		{
+			name: "request",
+			ctx:  context.Background(),
+			trace: func(tr *httptrace.ClientTrace) {
+				tr.TLSHandshakeStart()
+				tr.GotConn(httptrace.GotConnInfo{})
+			},
+			err:       errors.New("broken pipe"),
+			wantPhase: echo.RequestPhase,
+		},
+		{
+			name: "response",
+			ctx:  context.Background(),
+			trace: func(tr *httptrace.ClientTrace) {
+				tr.GotConn(httptrace.GotConnInfo{})
+				tr.WroteRequest(httptrace.WroteRequestInfo{})
+				tr.GotFirstResponseByte()
+			},
+			err:       errors.New("unexpected EOF"),
+			wantPhase: echo.ResponsePhase,
+		},
+		{
+			name: "expired deadline",
+			ctx:  expired,
+			trace: func(tr *httptrace.ClientTrace) {
+				tr.GotConn(httptrace.GotConnInfo{})
+			},
+			err:       context.DeadlineExceeded,
+			wantPhase: echo.DeadlinePhase,
+		},
+		{
+			name: "response header timeout",
+			ctx:  headerTimeout,
+			trace: func(tr *httptrace.ClientTrace) {
+				tr.GotConn(httptrace.GotConnInfo{})
+				tr.WroteRequest(httptrace.WroteRequestInfo{})
+			},
+			err:         context.Canceled,
+			wantPhase:   echo.ResponseHeaderPhase,
+			wantMessage: "timeout awaiting response headers: context canceled",
+		},
+		{
+			name:      "already reported",
+			ctx:       expired,
+			err:       &phaseError{phase: echo.TLSHandshakePhase, err: errors.New("bad certificate")},
+			wantPhase: echo.TLSHandshakePhase,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newRequestPhase()
+			if tt.trace != nil {
+				tt.trace(p.clientTrace())
+			}
+			err := p.fail(tt.ctx, tt.err)
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, echo.ParseFailurePhase(err), tt.wantPhase)
+			assert.Equal(t, errors.Is(err, tt.err) || errors.As(tt.err, new(*phaseError)), true)
+			if tt.wantMessage != "" {
+				assert.Equal(t, strings.HasPrefix(err.Error(), tt.wantMessage), true)
+			}
+		})
+	}
+}
+
+func TestResponseHeaderTimeout(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		p := newRequestPhase()
+		ctx, cancel := p.withResponseHeaderTimeout(context.Background(), 0)
+		defer cancel()
+		assert.Equal(t, ctx == context.Background(), true)
+	})
+	t.Run("headers not received", func(t *testing.T) {
+		p := newRequestPhase()
+		ctx, cancel := p.withResponseHeaderTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		p.set(echo.ResponseHeaderPhase)
+		select {
+		case <-ctx.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatal("request was not canceled")
+		}
+		assert.Equal(t, context.Cause(ctx), errResponseHeaderTimeout)
+	})
+	t.Run("headers received", func(t *testing.T) {
+		p := newRequestPhase()
+		ctx, cancel := p.withResponseHeaderTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		p.set(echo.ResponseHeaderPhase)
+		p.set(echo.ResponsePhase)
+		select {
+		case <-ctx.Done():
+			t.Fatalf("request was canceled: %v", context.Cause(ctx))
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+}
+
+// listen returns the address of a listener handling its connections with the handler, until the test ends. The
+// connections are closed once handled.
+func listen(t *testing.T, handle func(net.Conn)) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				handle(conn)
+				_ = conn.Close()
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+func TestDialTLSFailures(t *testing.T) {
+	// A closed port, refusing the connections
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	closed := l.Addr().String()
+	assert.NoError(t, l.Close())
+
+	// Never answers, until the client closes the connection
+	silent := func(t *testing.T) string {
+		return listen(t, func(conn net.Conn) { _, _ = io.Copy(io.Discard, conn) })
+	}
+	notTLS := func(t *testing.T) string {
+		return listen(t, func(conn net.Conn) { _, _ = conn.Write([]byte("HTTP/1.1 400 Bad Request\r\n\r\n")) })
+	}
+
+	cases := []struct {
+		name             string
+		addr             func(t *testing.T) string
+		handshakeTimeout time.Duration
+		ctxTimeout       time.Duration
+		wantPhase        echo.FailurePhase
+		wantMessage      string
+	}{
+		{
+			name:      "connection refused",
+			addr:      func(*testing.T) string { return closed },
+			wantPhase: echo.ConnectPhase,
+		},
+		{
+			name:      "not a TLS server",
+			addr:      notTLS,
+			wantPhase: echo.TLSHandshakePhase,
+		},
+		{
+			name:             "handshake timeout",
+			addr:             silent,
+			handshakeTimeout: 50 * time.Millisecond,
+			wantPhase:        echo.TLSHandshakePhase,
+			wantMessage:      "TLS handshake timeout",
+		},
+		{
+			// The deadline of the request expires first, whatever the phase
+			name:             "request deadline",
+			addr:             silent,
+			handshakeTimeout: time.Minute,
+			ctxTimeout:       50 * time.Millisecond,
+			wantPhase:        echo.DeadlinePhase,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.ctxTimeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.ctxTimeout)
+				defer cancel()
+			}
+			cfg := &Config{
+				Request:             &proto.ForwardEchoRequest{},
+				connectTimeout:      5 * time.Second,
+				tlsHandshakeTimeout: tt.handshakeTimeout,
+			}
+			conn, err := dialTLS(ctx, cfg, "tcp", tt.addr(t), nil)
+			assert.Equal(t, conn, nil)
+			assert.Error(t, err)
+			assert.Equal(t, echo.ParseFailurePhase(err), tt.wantPhase)
+			if tt.wantMessage != "" {
+				assert.Equal(t, strings.HasPrefix(err.Error(), tt.wantMessage), true)
+			}
+		})
+	}
+}
@@ -18,15 +18,16 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
 	"strings"
+	"unicode/utf8"
 
 	proxyproto "github.com/pires/go-proxyproto"
 
-	"istio.io/istio/pkg/hbone"
 	"istio.io/istio/pkg/test/echo"
 	"istio.io/istio/pkg/test/echo/common"
 	"istio.io/istio/pkg/test/echo/proto"
@@ -46,12 +47,19 @@ func (c *tcpProtocol) ForwardEcho(ctx context.Context, cfg *Config) (*proto.Forw
 	return doForward(ctx, cfg, c.e, c.makeRequest)
 }
 
-func (c *tcpProtocol) makeRequest(ctx context.Context, cfg *Config, requestID int) (string, error) {
-	conn, err := newTCPConnection(cfg)
+func (c *tcpProtocol) makeRequest(ctx context.Context, cfg *Config, requestID int) (_ string, err error) {
+	// Report the phase in which the request fails.
+	phase := newRequestPhase()
+	defer func() {
+		err = phase.fail(ctx, err)
+	}()
+
+	conn, err := newTCPConnection(ctx, cfg)
 	if err != nil {
 		return "", err
 	}
 	defer func() { _ = conn.Close() }()
+	phase.set(echo.RequestPhase)
 
 	msgBuilder := strings.Builder{}
 	// If we have been asked to do TCP comms with a PROXY protocol header,
@@ -101,6 +109,10 @@ func (c *tcpProtocol) makeRequest(ctx context.Context, cfg *Config, requestID in
 		}
 	}
 
+	if len(cfg.Request.Payload) > 0 {
+		return sendPayload(conn, cfg, requestID, phase, &msgBuilder)
+	}
+
 	// Make sure the client writes something to the buffer
 	message := "HelloWorld"
 	if cfg.Request.Message != "" {
@@ -111,6 +123,7 @@ func (c *tcpProtocol) makeRequest(ctx context.Context, cfg *Config, requestID in
 		fwLog.Warnf("TCP write failed: %v", err)
 		return msgBuilder.String(), err
 	}
+	phase.set(echo.ResponsePhase)
 	var resBuffer bytes.Buffer
 	buf := make([]byte, 1024+len(message))
 	for {
@@ -144,18 +157,69 @@ func (c *tcpProtocol) makeRequest(ctx context.Context, cfg *Config, requestID in
 	return msg, nil
 }
 
+// sendPayload sends the raw payload of a request over a TCP or TLS connection, and reads the response until it
+// contains the expected payload, or the payload echoed back by the server if none is expected.
+func sendPayload(conn net.Conn, cfg *Config, requestID int, phase *requestPhase, out *strings.Builder) (string, error) {
+	if _, err := conn.Write(cfg.Request.Payload); err != nil {
+		fwLog.Warnf("TCP payload write failed: %v", err)
+		return out.String(), err
+	}
+	phase.set(echo.ResponsePhase)
+	until := cfg.Request.ExpectedPayload
+	if len(until) == 0 {
+		until = cfg.Request.Payload
+	}
+	var resBuffer bytes.Buffer
+	buf := make([]byte, 4096)
+	for !bytes.Contains(resBuffer.Bytes(), until) {
+		n, err := conn.Read(buf)
+		resBuffer.Write(buf[:n])
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fwLog.Warnf("TCP read failed (already read %d bytes): %v", resBuffer.Len(), err)
+			return out.String(), err
+		}
+	}
+
+	// The text lines of the response, such as the fields written by the echo server, are reported as for messages
+	for _, line := range strings.Split(resBuffer.String(), "\n") {
+		if line != "" && utf8.ValidString(line) {
+			echo.WriteBodyLine(out, requestID, line)
+		}
+	}
+	echo.ResponsePayloadField.WriteForRequest(out, requestID, hex.EncodeToString(resBuffer.Bytes()))
+
+	msg := out.String()
+	if expected := cfg.Request.ExpectedPayload; len(expected) > 0 && !bytes.Contains(resBuffer.Bytes(), expected) {
+		return msg, fmt.Errorf("expect to recv payload %x, got %x", expected, resBuffer.Bytes())
+	}
+	// A server other than echo does not report a status code, so it is only expected if no payload is
+	expected := ""
+	if cfg.Request.ExpectedResponse != nil {
+		expected = cfg.Request.ExpectedResponse.GetValue()
+	} else if len(cfg.Request.ExpectedPayload) == 0 {
+		expected = fmt.Sprintf("%s=%d", string(echo.StatusCodeField), http.StatusOK)
+	}
+	if !strings.Contains(msg, expected) {
+		return msg, fmt.Errorf("expect to recv message with %s, got %s. Return EOF", expected, msg)
+	}
+	return msg, nil
+}
+
 func (c *tcpProtocol) Close() error {
 	return nil
 }
 
-func newTCPConnection(cfg *Config) (net.Conn, error) {
+func newTCPConnection(ctx context.Context, cfg *Config) (net.Conn, error) {
 	address := cfg.Request.Url[len(cfg.scheme+"://"):]
 
 	if cfg.secure {
-		return hbone.TLSDialWithDialer(newDialer(cfg), "tcp", address, cfg.tlsConfig)
+		return dialTLS(ctx, cfg, "tcp", address, cfg.tlsConfig)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), common.ConnectionTimeout)
+	ctx, cancel := context.WithTimeout(ctx, cfg.connectTimeout)
 	defer cancel()
 
 	return newDialer(cfg).DialContext(ctx, "tcp", address)
@@ -0,0 +1,117 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package forwarder
+
+import (
+	"bytes"
+	"encoding/hex"
+	"net"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"istio.io/istio/pkg/test/echo"
+	"istio.io/istio/pkg/test/echo/proto"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestSendPayload(t *testing.T) {
+	payload := []byte{0x00, 0x01, 0xff}
+	cases := []struct {
+		name string
+		req  *proto.ForwardEchoRequest
+		// response is written by the server once it received the payload, before closing the connection if close
+		response    []byte
+		close       bool
+		wantErr     bool
+		wantPayload []byte
+		wantLines   []string
+	}{
+		{
+			name:        "echo server",
+			req:         &proto.ForwardEchoRequest{Payload: payload},
+			response:    append([]byte("StatusCode=200\nHostname=a\n"), payload...),
+			wantPayload: append([]byte("StatusCode=200\nHostname=a\n"), payload...),
+			wantLines:   []string{"[1 body] StatusCode=200", "[1 body] Hostname=a"},
+		},
+		{
+			name:        "expected payload",
+			req:         &proto.ForwardEchoRequest{Payload: payload, ExpectedPayload: []byte{0xca, 0xfe}},
+			response:    []byte{0x00, 0xca, 0xfe},
+			wantPayload: []byte{0x00, 0xca, 0xfe},
+		},
+		{
+			name:        "missing expected payload",
+			req:         &proto.ForwardEchoRequest{Payload: payload, ExpectedPayload: []byte{0xca, 0xfe}},
+			response:    []byte{0x00, 0xca},
+			close:       true,
+			wantErr:     true,
+			wantPayload: []byte{0x00, 0xca},
+		},
+		{
+			name:        "echoed without status code",
+			req:         &proto.ForwardEchoRequest{Payload: payload},
+			response:    payload,
+			wantErr:     true,
+			wantPayload: payload,
+		},
+		{
+			name: "expected response",
+			req: &proto.ForwardEchoRequest{
+				Payload:          payload,
+				ExpectedPayload:  []byte{0xca, 0xfe},
+				ExpectedResponse: wrapperspb.String("PONG"),
+			},
+			response:    []byte("+PONG\r\n\xca\xfe"),
+			wantPayload: []byte("+PONG\r\n\xca\xfe"),
+			wantLines:   []string{"[1 body] +PONG\r"},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			client, server := net.Pipe()
+			defer client.Close()
+			received := make(chan []byte, 1)
+			go func() {
+				defer server.Close()
+				buf := make([]byte, len(tt.req.Payload))
+				n, _ := server.Read(buf)
+				received <- buf[:n]
+				_, _ = server.Write(tt.response)
+				if !tt.close {
+					// Keep the connection open, the client must stop reading once the payload is received
+					_, _ = server.Read(make([]byte, 1))
+				}
+			}()
+
+			out := &strings.Builder{}
+			msg, err := sendPayload(client, &Config{Request: tt.req}, 1, newRequestPhase(), out)
+			_ = client.Close()
+			assert.Equal(t, <-received, tt.req.Payload)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, strings.Contains(msg, "[1] "+string(echo.ResponsePayloadField)+"="+hex.EncodeToString(tt.wantPayload)+"\n"), true)
+			for _, line := range tt.wantLines {
+				assert.Equal(t, strings.Contains(msg, line+"\n"), true)
+			}
+			// Binary lines are only reported in the payload
+			assert.Equal(t, bytes.Contains([]byte(msg), []byte{0xff}), false)
+		})
+	}
+}
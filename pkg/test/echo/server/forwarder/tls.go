@@ -22,7 +22,6 @@ import (
 	"strings"
 	"time"
 
-	"istio.io/istio/pkg/hbone"
 	"istio.io/istio/pkg/test/echo"
 	"istio.io/istio/pkg/test/echo/proto"
 )
@@ -41,12 +40,19 @@ func (c *tlsProtocol) ForwardEcho(ctx context.Context, cfg *Config) (*proto.Forw
 	return doForward(ctx, cfg, c.e, c.makeRequest)
 }
 
-func (c *tlsProtocol) makeRequest(ctx context.Context, cfg *Config, requestID int) (string, error) {
-	conn, err := newTLSConnection(cfg)
+func (c *tlsProtocol) makeRequest(ctx context.Context, cfg *Config, requestID int) (_ string, err error) {
+	// Report the phase in which the request fails.
+	phase := newRequestPhase()
+	defer func() {
+		err = phase.fail(ctx, err)
+	}()
+
+	conn, err := newTLSConnection(ctx, cfg)
 	if err != nil {
 		return "", err
 	}
 	defer func() { _ = conn.Close() }()
+	phase.set(echo.RequestPhase)
 	msgBuilder := strings.Builder{}
 	echo.ForwarderURLField.WriteForRequest(&msgBuilder, requestID, cfg.Request.Url)
 
@@ -66,6 +72,10 @@ func (c *tlsProtocol) makeRequest(ctx context.Context, cfg *Config, requestID in
 	if err := conn.HandshakeContext(ctx); err != nil {
 		return "", err
 	}
+	if len(cfg.Request.Payload) > 0 {
+		writeConnectionState(&msgBuilder, requestID, conn.ConnectionState())
+		return sendPayload(conn, cfg, requestID, phase, &msgBuilder)
+	}
 	// Make sure the client writes something to the buffer
 	message := "HelloWorld"
 	if cfg.Request.Message != "" {
@@ -78,22 +88,26 @@ func (c *tlsProtocol) makeRequest(ctx context.Context, cfg *Config, requestID in
 		return msgBuilder.String(), err
 	}
 
-	cs := conn.ConnectionState()
 	echo.LatencyField.WriteForRequest(&msgBuilder, requestID, fmt.Sprintf("%v", time.Since(start)))
-	echo.CipherField.WriteForRequest(&msgBuilder, requestID, tls.CipherSuiteName(cs.CipherSuite))
-	echo.TLSVersionField.WriteForRequest(&msgBuilder, requestID, versionName(cs.Version))
-	echo.TLSServerName.WriteForRequest(&msgBuilder, requestID, cs.ServerName)
-	echo.AlpnField.WriteForRequest(&msgBuilder, requestID, cs.NegotiatedProtocol)
+	writeConnectionState(&msgBuilder, requestID, conn.ConnectionState())
+
+	msg := msgBuilder.String()
+	return msg, nil
+}
+
+// writeConnectionState writes the negotiated TLS parameters and the certificates of the server.
+func writeConnectionState(out *strings.Builder, requestID int, cs tls.ConnectionState) {
+	echo.CipherField.WriteForRequest(out, requestID, tls.CipherSuiteName(cs.CipherSuite))
+	echo.TLSVersionField.WriteForRequest(out, requestID, versionName(cs.Version))
+	echo.TLSServerName.WriteForRequest(out, requestID, cs.ServerName)
+	echo.AlpnField.WriteForRequest(out, requestID, cs.NegotiatedProtocol)
 	for n, i := range cs.PeerCertificates {
 		pemBlock := pem.Block{
 			Type:  "CERTIFICATE",
 			Bytes: i.Raw,
 		}
-		echo.WriteBodyLine(&msgBuilder, requestID, fmt.Sprintf("Response%d=%q", n, string(pem.EncodeToMemory(&pemBlock))))
+		echo.WriteBodyLine(out, requestID, fmt.Sprintf("Response%d=%q", n, string(pem.EncodeToMemory(&pemBlock))))
 	}
-
-	msg := msgBuilder.String()
-	return msg, nil
 }
 
 func versionName(v uint16) string {
@@ -115,10 +129,10 @@ func (c *tlsProtocol) Close() error {
 	return nil
 }
 
-func newTLSConnection(cfg *Config) (*tls.Conn, error) {
+func newTLSConnection(ctx context.Context, cfg *Config) (*tls.Conn, error) {
 	address := cfg.Request.Url[len(cfg.scheme+"://"):]
 
-	con, err := hbone.TLSDialWithDialer(newDialer(cfg), "tcp", address, cfg.tlsConfig)
+	con, err := dialTLS(ctx, cfg, "tcp", address, cfg.tlsConfig)
 	if err != nil {
 		return nil, err
 	}
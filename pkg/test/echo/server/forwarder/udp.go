@@ -24,7 +24,6 @@ import (
 	"strings"
 
 	"istio.io/istio/pkg/test/echo"
-	"istio.io/istio/pkg/test/echo/common"
 	"istio.io/istio/pkg/test/echo/proto"
 )
 
@@ -117,7 +116,7 @@ func newUDPConnection(cfg *Config) (net.Conn, error) {
 		return nil, fmt.Errorf("TLS not available")
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), common.ConnectionTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.connectTimeout)
 	defer cancel()
 	return newDialer(cfg).DialContext(ctx, "udp", address)
 }
@@ -30,7 +30,6 @@ import (
 	"istio.io/istio/pkg/hbone"
 	"istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/test/echo"
-	"istio.io/istio/pkg/test/echo/common"
 	"istio.io/istio/pkg/test/echo/proto"
 )
 
@@ -63,10 +62,10 @@ func newDialer(cfg *Config) hbone.Dialer {
 		return dialer.(hbone.Dialer)
 	}
 	out := &net.Dialer{
-		Timeout: common.ConnectionTimeout,
+		Timeout: cfg.connectTimeout,
 	}
 	if cfg.forceDNSLookup {
-		out.Resolver = newResolver(common.ConnectionTimeout, "", "")
+		out.Resolver = newResolver(cfg.connectTimeout, "", "")
 	}
 	return out
 }
@@ -30,7 +30,9 @@ import (
 	"istio.io/istio/pilot/pkg/util/network"
 	"istio.io/istio/pkg/config/protocol"
 	"istio.io/istio/pkg/log"
+	"istio.io/istio/pkg/maps"
 	"istio.io/istio/pkg/monitoring"
+	"istio.io/istio/pkg/slices"
 	"istio.io/istio/pkg/test/echo/common"
 	"istio.io/istio/pkg/test/echo/server/endpoint"
 )
@@ -50,6 +52,8 @@ type Config struct {
 	IstioVersion          string
 	Namespace             string
 	DisableALPN           bool
+	// ClientCertificates are the client certificates the forwarded requests can select by name.
+	ClientCertificates map[string]common.ClientCertificate
 }
 
 func (c Config) String() string {
@@ -65,6 +69,7 @@ func (c Config) String() string {
 	b.WriteString(fmt.Sprintf("Cluster:               %v\n", c.Cluster))
 	b.WriteString(fmt.Sprintf("IstioVersion:          %v\n", c.IstioVersion))
 	b.WriteString(fmt.Sprintf("Namespace:             %v\n", c.Namespace))
+	b.WriteString(fmt.Sprintf("ClientCertificates:    %v\n", slices.Sort(maps.Keys(c.ClientCertificates))))
 
 	return b.String()
 }
@@ -207,17 +212,18 @@ func (s *Instance) getListenerIP(port *common.Port) (string, error) {
 
 func (s *Instance) newEndpoint(port *common.Port, listenerIP string, udsServer string) (endpoint.Instance, error) {
 	return endpoint.New(endpoint.Config{
-		Port:          port,
-		UDSServer:     udsServer,
-		IsServerReady: s.isReady,
-		Version:       s.Version,
-		Cluster:       s.Cluster,
-		TLSCert:       s.TLSCert,
-		TLSKey:        s.TLSKey,
-		Dialer:        s.Dialer,
-		ListenerIP:    listenerIP,
-		DisableALPN:   s.DisableALPN,
-		IstioVersion:  s.IstioVersion,
+		Port:               port,
+		UDSServer:          udsServer,
+		IsServerReady:      s.isReady,
+		Version:            s.Version,
+		Cluster:            s.Cluster,
+		TLSCert:            s.TLSCert,
+		TLSKey:             s.TLSKey,
+		Dialer:             s.Dialer,
+		ListenerIP:         listenerIP,
+		DisableALPN:        s.DisableALPN,
+		IstioVersion:       s.IstioVersion,
+		ClientCertificates: s.ClientCertificates,
 	})
 }
 
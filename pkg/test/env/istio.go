@@ -47,6 +47,10 @@ var (
 	// nolint: revive, stylecheck
 	TAG Variable = "TAG"
 
+	// VARIANT is the variant of the Istio images, e.g. distroless.
+	// nolint: revive, stylecheck
+	VARIANT Variable = "VARIANT"
+
 	// PULL_POLICY is the image pull policy to use when rendering templates.
 	// nolint: revive, stylecheck
 	PULL_POLICY Variable = "PULL_POLICY"
@@ -85,6 +89,9 @@ var (
 
 	// RegistryRedirectorServerInstallFilePath is the registry redirector installation file.
 	RegistryRedirectorServerInstallFilePath = path.Join(IstioSrc, getInstallationFile("registryredirector/registry_redirector_server.yaml"))
+
+	// RegistryMirrorInstallFilePath is the registry mirror installation file.
+	RegistryMirrorInstallFilePath = path.Join(IstioSrc, getInstallationFile("registrymirror/registry_mirror.yaml"))
 )
 
 var (
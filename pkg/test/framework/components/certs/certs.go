@@ -0,0 +1,382 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package certs mints the certificates used by the tests: CA hierarchies, leaf certificates with arbitrary SANs and
+// extended key usages, expired or not yet valid certificates, CRLs, and the Kubernetes secrets holding them.
+package certs
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" // nolint: gosec
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"time"
+
+	"istio.io/istio/pkg/test"
+)
+
+const (
+	defaultValidity = 365 * 24 * time.Hour
+	// clockSkew is the time the certificates are backdated by, so they are valid on hosts with a late clock.
+	clockSkew = time.Hour
+)
+
+// Validity is the validity period of a certificate.
+type Validity struct {
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// ValidFor returns a validity period starting now and lasting d.
+func ValidFor(d time.Duration) Validity {
+	now := time.Now()
+	return Validity{NotBefore: now.Add(-clockSkew), NotAfter: now.Add(d)}
+}
+
+// Expired returns a validity period which ended a day ago.
+func Expired() Validity {
+	now := time.Now()
+	return Validity{NotBefore: now.Add(-2 * defaultValidity), NotAfter: now.Add(-24 * time.Hour)}
+}
+
+// NotYetValid returns a validity period starting in a day.
+func NotYetValid() Validity {
+	now := time.Now()
+	return Validity{NotBefore: now.Add(24 * time.Hour), NotAfter: now.Add(defaultValidity)}
+}
+
+func (v Validity) orDefault() Validity {
+	if v.NotBefore.IsZero() && v.NotAfter.IsZero() {
+		return ValidFor(defaultValidity)
+	}
+	return v
+}
+
+// KeyOptions selects the type of the private key of a certificate. ECDSA P-256 keys are used by default.
+type KeyOptions struct {
+	// RSAKeySize selects an RSA key of the given size, rather than an ECDSA key.
+	RSAKeySize int
+}
+
+func (o KeyOptions) generate() (crypto.Signer, error) {
+	if o.RSAKeySize > 0 {
+		return rsa.GenerateKey(rand.Reader, o.RSAKeySize)
+	}
+	return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+}
+
+// CAOptions are the options of a CA certificate.
+type CAOptions struct {
+	CommonName   string
+	Organization string
+	Validity     Validity
+	Key          KeyOptions
+	// MaxPathLen is the maximum number of intermediate CAs below the CA. Any number of intermediates is allowed if
+	// zero, while -1 forbids any intermediate.
+	MaxPathLen int
+}
+
+// LeafOptions are the options of a leaf certificate.
+type LeafOptions struct {
+	CommonName   string
+	Organization string
+	DNSNames     []string
+	IPAddresses  []net.IP
+	// URIs are the URI SANs of the certificate, such as SPIFFE identities.
+	URIs     []string
+	Validity Validity
+	Key      KeyOptions
+	// ExtKeyUsages are the extended key usages of the certificate. Both server and client authentication are allowed
+	// if empty.
+	ExtKeyUsages []x509.ExtKeyUsage
+	// SerialNumber is the serial number of the certificate. A random serial number is used if nil.
+	SerialNumber *big.Int
+}
+
+// CA is a certificate authority, either a root or an intermediate CA.
+type CA struct {
+	Cert *x509.Certificate
+	Key  crypto.Signer
+	// Parent is the CA which signed this CA, nil for a root CA.
+	Parent *CA
+}
+
+// Leaf is a leaf certificate with its private key.
+type Leaf struct {
+	Cert *x509.Certificate
+	Key  crypto.Signer
+	// CA is the CA which signed the certificate.
+	CA *CA
+}
+
+// NewRootCA creates a self-signed root CA.
+func NewRootCA(opts CAOptions) (*CA, error) {
+	return newCA(opts, nil)
+}
+
+// NewRootCAOrFail calls NewRootCA and fails if an error occurs.
+func NewRootCAOrFail(t test.Failer, opts CAOptions) *CA {
+	t.Helper()
+	ca, err := NewRootCA(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ca
+}
+
+// NewIntermediate creates an intermediate CA signed by the CA.
+func (ca *CA) NewIntermediate(opts CAOptions) (*CA, error) {
+	return newCA(opts, ca)
+}
+
+// NewIntermediateOrFail calls NewIntermediate and fails if an error occurs.
+func (ca *CA) NewIntermediateOrFail(t test.Failer, opts CAOptions) *CA {
+	t.Helper()
+	intermediate, err := ca.NewIntermediate(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return intermediate
+}
+
+// NewLeaf creates a leaf certificate signed by the CA.
+func (ca *CA) NewLeaf(opts LeafOptions) (*Leaf, error) {
+	key, err := opts.Key.generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the key of %s: %v", opts.CommonName, err)
+	}
+	serial := opts.SerialNumber
+	if serial == nil {
+		if serial, err = randomSerial(); err != nil {
+			return nil, err
+		}
+	}
+	uris := make([]*url.URL, 0, len(opts.URIs))
+	for _, u := range opts.URIs {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return nil, fmt.Errorf("invalid URI SAN %q: %v", u, err)
+		}
+		uris = append(uris, parsed)
+	}
+	extKeyUsages := opts.ExtKeyUsages
+	if len(extKeyUsages) == 0 {
+		extKeyUsages = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	}
+	keyUsage := x509.KeyUsageDigitalSignature
+	if _, ok := key.(*rsa.PrivateKey); ok {
+		keyUsage |= x509.KeyUsageKeyEncipherment
+	}
+	validity := opts.Validity.orDefault()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               subject(opts.CommonName, opts.Organization),
+		NotBefore:             validity.NotBefore,
+		NotAfter:              validity.NotAfter,
+		KeyUsage:              keyUsage,
+		ExtKeyUsage:           extKeyUsages,
+		BasicConstraintsValid: true,
+		DNSNames:              opts.DNSNames,
+		IPAddresses:           opts.IPAddresses,
+		URIs:                  uris,
+	}
+	cert, err := ca.sign(template, key.Public())
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the certificate of %s: %v", opts.CommonName, err)
+	}
+	return &Leaf{Cert: cert, Key: key, CA: ca}, nil
+}
+
+// NewLeafOrFail calls NewLeaf and fails if an error occurs.
+func (ca *CA) NewLeafOrFail(t test.Failer, opts LeafOptions) *Leaf {
+	t.Helper()
+	leaf, err := ca.NewLeaf(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return leaf
+}
+
+// CRL returns a PEM encoded CRL of the CA, revoking the certificates with the given serial numbers.
+func (ca *CA) CRL(revoked ...*big.Int) ([]byte, error) {
+	now := time.Now()
+	entries := make([]pkix.RevokedCertificate, 0, len(revoked))
+	for _, serial := range revoked {
+		entries = append(entries, pkix.RevokedCertificate{SerialNumber: serial, RevocationTime: now.Add(-clockSkew)})
+	}
+	number, err := randomSerial()
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:              number,
+		ThisUpdate:          now.Add(-clockSkew),
+		NextUpdate:          now.Add(defaultValidity),
+		RevokedCertificates: entries,
+	}, ca.Cert, ca.Key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the CRL of %s: %v", ca.Cert.Subject.CommonName, err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}), nil
+}
+
+// CertPEM returns the PEM encoded certificate of the CA.
+func (ca *CA) CertPEM() []byte {
+	return certPEM(ca.Cert)
+}
+
+// KeyPEM returns the PEM encoded private key of the CA.
+func (ca *CA) KeyPEM() []byte {
+	return keyPEM(ca.Key)
+}
+
+// RootPEM returns the PEM encoded certificate of the root CA of the hierarchy.
+func (ca *CA) RootPEM() []byte {
+	root := ca
+	for root.Parent != nil {
+		root = root.Parent
+	}
+	return root.CertPEM()
+}
+
+// ChainPEM returns the PEM encoded certificates of the CA and of its parents, excluding the root CA.
+func (ca *CA) ChainPEM() []byte {
+	var buf bytes.Buffer
+	for c := ca; c != nil && c.Parent != nil; c = c.Parent {
+		buf.Write(c.CertPEM())
+	}
+	return buf.Bytes()
+}
+
+// CertPEM returns the PEM encoded certificate.
+func (l *Leaf) CertPEM() []byte {
+	return certPEM(l.Cert)
+}
+
+// KeyPEM returns the PEM encoded private key.
+func (l *Leaf) KeyPEM() []byte {
+	return keyPEM(l.Key)
+}
+
+// ChainPEM returns the PEM encoded certificate followed by those of the intermediate CAs which signed it, as served
+// in a TLS handshake.
+func (l *Leaf) ChainPEM() []byte {
+	return append(l.CertPEM(), l.CA.ChainPEM()...)
+}
+
+// RootPEM returns the PEM encoded certificate of the root CA which the certificate chains to.
+func (l *Leaf) RootPEM() []byte {
+	return l.CA.RootPEM()
+}
+
+func newCA(opts CAOptions, parent *CA) (*CA, error) {
+	key, err := opts.Key.generate()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the key of %s: %v", opts.CommonName, err)
+	}
+	serial, err := randomSerial()
+	if err != nil {
+		return nil, err
+	}
+	ski, err := subjectKeyID(key.Public())
+	if err != nil {
+		return nil, err
+	}
+	validity := opts.Validity.orDefault()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               subject(opts.CommonName, opts.Organization),
+		NotBefore:             validity.NotBefore,
+		NotAfter:              validity.NotAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		SubjectKeyId:          ski,
+	}
+	switch {
+	case opts.MaxPathLen > 0:
+		template.MaxPathLen = opts.MaxPathLen
+	case opts.MaxPathLen < 0:
+		template.MaxPathLenZero = true
+	default:
+		template.MaxPathLen = -1
+	}
+
+	ca := &CA{Key: key, Parent: parent}
+	if parent == nil {
+		// Self-signed
+		ca.Cert = template
+		ca.Cert, err = ca.sign(template, key.Public())
+	} else {
+		ca.Cert, err = parent.sign(template, key.Public())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign the certificate of %s: %v", opts.CommonName, err)
+	}
+	return ca, nil
+}
+
+func (ca *CA) sign(template *x509.Certificate, pub crypto.PublicKey) (*x509.Certificate, error) {
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.Cert, pub, ca.Key)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(der)
+}
+
+func subject(commonName, organization string) pkix.Name {
+	name := pkix.Name{CommonName: commonName}
+	if organization != "" {
+		name.Organization = []string{organization}
+	}
+	return name
+}
+
+func subjectKeyID(pub crypto.PublicKey) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+	// nolint: gosec
+	sum := sha1.Sum(der)
+	return sum[:], nil
+}
+
+func randomSerial() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate a serial number: %v", err)
+	}
+	return serial, nil
+}
+
+func certPEM(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+func keyPEM(key crypto.Signer) []byte {
+	// The keys are generated by this package, so they can always be marshaled.
+	der, _ := x509.MarshalPKCS8PrivateKey(key)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
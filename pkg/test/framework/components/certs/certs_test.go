@@ -0,0 +1,172 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certs
+
+import (
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"testing"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func parsePEMCerts(t *testing.T, data []byte) []*x509.Certificate {
+	t.Helper()
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			return certs
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		assert.NoError(t, err)
+		certs = append(certs, cert)
+	}
+}
+
+func verify(t *testing.T, leaf *Leaf) error {
+	t.Helper()
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(leaf.RootPEM())
+	intermediates := x509.NewCertPool()
+	intermediates.AppendCertsFromPEM(leaf.CA.ChainPEM())
+	_, err := leaf.Cert.Verify(x509.VerifyOptions{DNSName: "server.example.com", Roots: roots, Intermediates: intermediates})
+	return err
+}
+
+func TestHierarchy(t *testing.T) {
+	root := NewRootCAOrFail(t, CAOptions{CommonName: "root", Organization: "example"})
+	intermediate := root.NewIntermediateOrFail(t, CAOptions{CommonName: "intermediate"})
+	leaf := intermediate.NewLeafOrFail(t, LeafOptions{CommonName: "server", DNSNames: []string{"server.example.com"}})
+
+	assert.NoError(t, verify(t, leaf))
+	assert.Equal(t, root.Cert.Subject.Organization, []string{"example"})
+	assert.Equal(t, leaf.Cert.Issuer.CommonName, "intermediate")
+	assert.Equal(t, leaf.Cert.AuthorityKeyId, intermediate.Cert.SubjectKeyId)
+
+	// The chain holds the leaf and the intermediate, but not the root
+	chain := parsePEMCerts(t, leaf.ChainPEM())
+	assert.Equal(t, len(chain), 2)
+	assert.Equal(t, chain[0].Subject.CommonName, "server")
+	assert.Equal(t, chain[1].Subject.CommonName, "intermediate")
+	assert.Equal(t, len(root.ChainPEM()), 0)
+	assert.Equal(t, parsePEMCerts(t, leaf.RootPEM())[0].Subject.CommonName, "root")
+
+	_, err := tls.X509KeyPair(leaf.ChainPEM(), leaf.KeyPEM())
+	assert.NoError(t, err)
+	_, err = tls.X509KeyPair(intermediate.CertPEM(), intermediate.KeyPEM())
+	assert.NoError(t, err)
+}
+
+func TestMaxPathLen(t *testing.T) {
+	root := NewRootCAOrFail(t, CAOptions{CommonName: "root", MaxPathLen: -1})
+	assert.Equal(t, root.Cert.MaxPathLenZero, true)
+	intermediate := root.NewIntermediateOrFail(t, CAOptions{CommonName: "intermediate"})
+	leaf := intermediate.NewLeafOrFail(t, LeafOptions{CommonName: "server", DNSNames: []string{"server.example.com"}})
+	assert.Error(t, verify(t, leaf))
+
+	root = NewRootCAOrFail(t, CAOptions{CommonName: "root", MaxPathLen: 1})
+	assert.Equal(t, root.Cert.MaxPathLen, 1)
+	leaf = root.NewIntermediateOrFail(t, CAOptions{CommonName: "intermediate"}).
+		NewLeafOrFail(t, LeafOptions{CommonName: "server", DNSNames: []string{"server.example.com"}})
+	assert.NoError(t, verify(t, leaf))
+}
+
+func TestValidity(t *testing.T) {
+	root := NewRootCAOrFail(t, CAOptions{CommonName: "root"})
+	cases := []struct {
+		name     string
+		validity Validity
+		expired  bool
+	}{
+		{name: "default", validity: Validity{}},
+		{name: "expired", validity: Expired(), expired: true},
+		{name: "not yet valid", validity: NotYetValid(), expired: true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			leaf := root.NewLeafOrFail(t, LeafOptions{CommonName: "server", DNSNames: []string{"server.example.com"}, Validity: tt.validity})
+			err := verify(t, leaf)
+			if !tt.expired {
+				assert.NoError(t, err)
+				return
+			}
+			var invalid x509.CertificateInvalidError
+			assert.Equal(t, errors.As(err, &invalid), true)
+			assert.Equal(t, invalid.Reason, x509.Expired)
+		})
+	}
+}
+
+func TestLeafOptions(t *testing.T) {
+	root := NewRootCAOrFail(t, CAOptions{CommonName: "root"})
+
+	leaf := root.NewLeafOrFail(t, LeafOptions{CommonName: "client"})
+	assert.Equal(t, leaf.Cert.ExtKeyUsage, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth})
+	assert.Equal(t, leaf.Cert.KeyUsage, x509.KeyUsageDigitalSignature)
+	assert.Equal(t, leaf.Cert.IsCA, false)
+
+	leaf = root.NewLeafOrFail(t, LeafOptions{
+		CommonName:   "client",
+		URIs:         []string{"spiffe://cluster.local/ns/default/sa/client"},
+		Key:          KeyOptions{RSAKeySize: 2048},
+		ExtKeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		SerialNumber: big.NewInt(42),
+	})
+	assert.Equal(t, leaf.Cert.URIs[0].String(), "spiffe://cluster.local/ns/default/sa/client")
+	assert.Equal(t, leaf.Cert.ExtKeyUsage, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+	assert.Equal(t, leaf.Cert.KeyUsage, x509.KeyUsageDigitalSignature|x509.KeyUsageKeyEncipherment)
+	assert.Equal(t, leaf.Cert.SerialNumber.Int64(), 42)
+	_, isRSA := leaf.Key.(*rsa.PrivateKey)
+	assert.Equal(t, isRSA, true)
+
+	_, err := root.NewLeaf(LeafOptions{CommonName: "client", URIs: []string{"://invalid"}})
+	assert.Error(t, err)
+}
+
+func TestCRL(t *testing.T) {
+	root := NewRootCAOrFail(t, CAOptions{CommonName: "root"})
+	revoked := root.NewLeafOrFail(t, LeafOptions{CommonName: "revoked"})
+	kept := root.NewLeafOrFail(t, LeafOptions{CommonName: "kept"})
+
+	data, err := root.CRL(revoked.Cert.SerialNumber)
+	assert.NoError(t, err)
+	block, _ := pem.Decode(data)
+	assert.Equal(t, block.Type, "X509 CRL")
+	crl, err := x509.ParseRevocationList(block.Bytes)
+	assert.NoError(t, err)
+	assert.NoError(t, crl.CheckSignatureFrom(root.Cert))
+	assert.Equal(t, crl.NextUpdate.After(crl.ThisUpdate), true)
+
+	serials := map[string]bool{}
+	for _, entry := range crl.RevokedCertificateEntries {
+		serials[entry.SerialNumber.String()] = true
+	}
+	assert.Equal(t, serials, map[string]bool{revoked.Cert.SerialNumber.String(): true})
+	assert.Equal(t, serials[kept.Cert.SerialNumber.String()], false)
+
+	// A CRL without revoked certificates is still signed by the CA
+	data, err = root.CRL()
+	assert.NoError(t, err)
+	block, _ = pem.Decode(data)
+	crl, err = x509.ParseRevocationList(block.Bytes)
+	assert.NoError(t, err)
+	assert.Equal(t, len(crl.RevokedCertificateEntries), 0)
+}
@@ -0,0 +1,90 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/istio/pkg/test/framework"
+	"istio.io/istio/pkg/test/framework/components/cluster"
+)
+
+// TLSSecret returns a kubernetes.io/tls secret holding the certificate chain and the key of the leaf, along with the
+// root CA in ca.crt, as expected by the gateways for mutual TLS.
+func TLSSecret(name, namespace string, leaf *Leaf) *corev1.Secret {
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       leaf.ChainPEM(),
+			corev1.TLSPrivateKeyKey: leaf.KeyPEM(),
+			"ca.crt":                leaf.RootPEM(),
+		},
+	}
+}
+
+// CreateSecret creates the secret in the clusters, all the clusters of the test if none is given, or updates it if
+// it already exists. The secret is deleted at the end of the test.
+func CreateSecret(t framework.TestContext, secret *corev1.Secret, clusters ...cluster.Cluster) error {
+	if len(clusters) == 0 {
+		clusters = t.Clusters()
+	}
+	t.CleanupConditionally(func() {
+		for _, c := range clusters {
+			err := c.Kube().CoreV1().Secrets(secret.Namespace).Delete(context.TODO(), secret.Name, metav1.DeleteOptions{})
+			if err != nil && !kerrors.IsNotFound(err) {
+				t.Logf("failed to delete secret %s/%s in cluster %s: %v", secret.Namespace, secret.Name, c.Name(), err)
+			}
+		}
+	})
+
+	wg := multierror.Group{}
+	for _, c := range clusters {
+		c := c
+		wg.Go(func() error {
+			secrets := c.Kube().CoreV1().Secrets(secret.Namespace)
+			_, err := secrets.Create(context.TODO(), secret, metav1.CreateOptions{})
+			if kerrors.IsAlreadyExists(err) {
+				_, err = secrets.Update(context.TODO(), secret, metav1.UpdateOptions{})
+			}
+			if err != nil {
+				return fmt.Errorf("failed to create secret %s/%s in cluster %s: %v", secret.Namespace, secret.Name, c.Name(), err)
+			}
+			return nil
+		})
+	}
+	return wg.Wait().ErrorOrNil()
+}
+
+// CreateSecretOrFail calls CreateSecret and fails if an error occurs.
+func CreateSecretOrFail(t framework.TestContext, secret *corev1.Secret, clusters ...cluster.Cluster) {
+	t.Helper()
+	if err := CreateSecret(t, secret, clusters...); err != nil {
+		t.Fatal(err)
+	}
+}
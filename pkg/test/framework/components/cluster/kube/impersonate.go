@@ -0,0 +1,78 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"fmt"
+	"os"
+
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	istioKube "istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/test/framework/components/cluster"
+)
+
+// Impersonate returns a copy of the cluster acting as the given user and groups, so that tests can verify what the user
+// is actually allowed to do, rather than only what the controllers do with the admin credentials of the test. Both the
+// client and the kubeconfig of the copy impersonate the user; the kubeconfig is written to workDir, for the tools
+// working with the Filename of the cluster.
+func Impersonate(c cluster.Cluster, workDir, user string, groups ...string) (cluster.Cluster, error) {
+	kc, ok := c.(*Cluster)
+	if !ok {
+		return nil, fmt.Errorf("cluster %s does not support impersonation", c.Name())
+	}
+	rc := kc.RESTConfig()
+	if rc == nil {
+		return nil, fmt.Errorf("cluster %s has no rest config", c.Name())
+	}
+	rc = rest.CopyConfig(rc)
+	rc.Impersonate = rest.ImpersonationConfig{
+		UserName: user,
+		Groups:   groups,
+	}
+	clientConfig := istioKube.NewClientConfigForRestConfig(rc)
+	client, err := istioKube.NewCLIClient(clientConfig, kc.Revision())
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client impersonating %s: %v", user, err)
+	}
+
+	kubeconfig, err := clientConfig.RawConfig()
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.CreateTemp(workDir, "kubeconfig-*")
+	if err != nil {
+		return nil, err
+	}
+	_ = f.Close()
+	if err := clientcmd.WriteToFile(kubeconfig, f.Name()); err != nil {
+		return nil, fmt.Errorf("failed writing kubeconfig impersonating %s: %v", user, err)
+	}
+
+	return &Cluster{
+		filename:  f.Name(),
+		vmSupport: kc.vmSupport,
+		CLIClient: client,
+		Topology:  kc.Topology,
+	}, nil
+}
+
+// ImpersonateServiceAccount returns a copy of the cluster acting as the ServiceAccount, with the groups the API server
+// gives to its tokens.
+func ImpersonateServiceAccount(c cluster.Cluster, workDir, namespace, name string) (cluster.Cluster, error) {
+	return Impersonate(c, workDir, serviceaccount.MakeUsername(namespace, name), serviceaccount.MakeGroupNames(namespace)...)
+}
@@ -34,6 +34,10 @@ type HTTP struct {
 	// If true, h2c will be used in HTTP requests
 	HTTP2 bool
 
+	// If true, HTTP requests are sent over HTTP/1.1 with an Upgrade to h2c, rather than with the prior knowledge
+	// of HTTP2. Valid only for plaintext HTTP.
+	H2CUpgrade bool
+
 	// If true, HTTP/3 request over QUIC will be used.
 	// It is mandatory to specify TLS settings
 	HTTP3 bool
@@ -52,8 +56,41 @@ type HTTP struct {
 	// is returned directly.
 	FollowRedirects bool
 
+	// MaxRedirects is the number of redirects followed before the call fails, if FollowRedirects is set. Defaults
+	// to 10.
+	MaxRedirects int
+
+	// CookieJar, if set, sends the cookies set by the responses on the following requests of the call, including
+	// the redirects. The jar only lives for the call: it starts empty and is discarded once the Count requests of the
+	// call complete, so cookies are not kept across calls. Sticky sessions are tested by sending the sequence of
+	// requests as the Count requests of a single call.
+	CookieJar bool
+
 	// HTTProxy used for making ingress echo call via proxy
 	HTTPProxy string
+
+	// BodySize, if set, streams a generated request body of this many bytes, to test buffer limits and
+	// request timeouts for large uploads.
+	BodySize int64
+
+	// ChunkedBody sends the request body with chunked transfer encoding rather than with a Content-Length.
+	ChunkedBody bool
+
+	// MultipartParts, if set, sends the generated request body as a multipart/form-data upload split into
+	// this many parts.
+	MultipartParts int
+
+	// Token, if set, is sent as a bearer token in the Authorization header, overriding any Authorization
+	// header in Headers. Tokens with arbitrary claims can be minted with a jwt.Issuer.
+	Token string
+
+	// AcceptEncoding, if set, is sent as the Accept-Encoding header, such as "gzip" or "br", to test the compression
+	// of the responses. The client decompresses gzip and deflate bodies, whose integrity is verified if requested with
+	// ?checksum in the Path. See check.ContentEncoding and check.DecompressedBody. The body of other encodings, such
+	// as br, is not reported: only the identity of the server is, such as its Hostname, from response headers. Note
+	// that Envoy only compresses the configured content types, which do not include the application/text of echo by
+	// default.
+	AcceptEncoding string
 }
 
 // TLS settings
@@ -65,6 +102,10 @@ type TLS struct {
 	// Use the custom certificates file to make the call.
 	CertFile, KeyFile, CaCertFile string
 
+	// Use the client certificate with the given name, among the ClientCertificates of the echo config of the caller.
+	// This allows a single workload to make calls with several identities.
+	ClientCertName string
+
 	// Skip verify peer's certificate.
 	InsecureSkipVerify bool
 
@@ -102,6 +143,15 @@ type Retry struct {
 type TCP struct {
 	// ExpectedResponse asserts this is in the response for TCP requests.
 	ExpectedResponse *wrappers.StringValue
+
+	// Payload, if set, is sent as is over the TCP or TLS connections rather than the Message, to test the handling of
+	// binary protocols. See echo.ParsePayload for hex, base64 and fixture payloads.
+	Payload []byte
+
+	// ExpectedPayload, if set, asserts these bytes are in the response to the Payload. The response is read until
+	// they are received, so a server other than echo can be called. Otherwise, the response is read until the Payload
+	// is echoed back. See check.ResponsePayload to assert on the bytes of the response.
+	ExpectedPayload []byte
 }
 
 // Target of a call.
@@ -142,9 +192,31 @@ type CallOptions struct {
 	// the numWorkloads * DefaultCallsPerWorkload. Otherwise, defaults to 1.
 	Count int
 
-	// Timeout used for each individual request. Must be > 0, otherwise 5 seconds is used.
+	// Timeout used for each individual request. Must be > 0, otherwise 5 seconds is used. This is the total deadline
+	// of the request, including the connection, which is further bounded by the phase timeouts below. A call failing
+	// once its deadline expired is reported in the echo.DeadlinePhase, see check.FailurePhase.
 	Timeout time.Duration
 
+	// ConnectTimeout bounds the establishment of each connection. If <= 0, 2 seconds is used.
+	ConnectTimeout time.Duration
+
+	// TLSHandshakeTimeout bounds the TLS handshake of each connection. If <= 0, only Timeout applies.
+	TLSHandshakeTimeout time.Duration
+
+	// ResponseHeaderTimeout bounds the wait for the response headers of each HTTP request, once it is sent. If <= 0,
+	// only Timeout applies.
+	ResponseHeaderTimeout time.Duration
+
+	// QPS limits the rate at which the requests are sent, for using the caller as a load generator. As the forwarder
+	// bounds the entire set of requests with Timeout, it must cover Count / QPS seconds. If QPS <= 0, the requests
+	// are sent as fast as possible.
+	QPS int
+
+	// PropagateDeadline if true, sends the Timeout of each request as its deadline, in the
+	// X-Envoy-Expected-Rq-Timeout-Ms and Grpc-Timeout headers. The server reports the deadline it
+	// observed, to verify how the timeout budget is propagated through proxies.
+	PropagateDeadline bool
+
 	// NewConnectionPerRequest if true, the forwarder will establish a new connection to the server for
 	// each individual request. If false, it will attempt to reuse the same connection for the duration
 	// of the forward call. This is ignored for DNS, TCP, and TLS protocols, as well as
@@ -240,10 +312,13 @@ func (o *CallOptions) FillDefaults() error {
 
 	// Fill in HTTP headers
 	o.fillHeaders()
+	o.fillToken()
+	o.fillAcceptEncoding()
 
 	if o.Timeout <= 0 {
 		o.Timeout = common.DefaultRequestTimeout
 	}
+	o.fillDeadlineHeaders()
 
 	// Fill the number of calls to make.
 	o.fillCallCount()
@@ -451,6 +526,45 @@ func (o *CallOptions) fillHeaders() {
 	}
 }
 
+func (o *CallOptions) fillDeadlineHeaders() {
+	if !o.PropagateDeadline {
+		return
+	}
+	if o.HTTP.Headers == nil {
+		o.HTTP.Headers = make(http.Header)
+	} else if o.ToWorkload != nil {
+		// Headers were not cloned by fillHeaders, avoid mutating input
+		o.HTTP.Headers = o.HTTP.Headers.Clone()
+	}
+	common.SetDeadlineHeaders(o.HTTP.Headers, o.Timeout)
+}
+
+func (o *CallOptions) fillToken() {
+	if o.HTTP.Token == "" {
+		return
+	}
+	if o.HTTP.Headers == nil {
+		o.HTTP.Headers = make(http.Header)
+	} else if o.ToWorkload != nil {
+		// Headers were not cloned by fillHeaders, avoid mutating input
+		o.HTTP.Headers = o.HTTP.Headers.Clone()
+	}
+	o.HTTP.Headers.Set(headers.Authorization, "Bearer "+o.HTTP.Token)
+}
+
+func (o *CallOptions) fillAcceptEncoding() {
+	if o.HTTP.AcceptEncoding == "" {
+		return
+	}
+	if o.HTTP.Headers == nil {
+		o.HTTP.Headers = make(http.Header)
+	} else if o.ToWorkload != nil {
+		// Headers were not cloned by fillHeaders, avoid mutating input
+		o.HTTP.Headers = o.HTTP.Headers.Clone()
+	}
+	o.HTTP.Headers.Set(headers.AcceptEncoding, o.HTTP.AcceptEncoding)
+}
+
 func (o *CallOptions) fillRetryOptions() {
 	if o.Retry.NoRetry {
 		// User specified no-retry, nothing to do.
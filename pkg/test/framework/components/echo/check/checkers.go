@@ -15,16 +15,23 @@
 package check
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"google.golang.org/grpc/codes"
 
 	"istio.io/istio/pkg/config/protocol"
+	"istio.io/istio/pkg/http/headers"
+	"istio.io/istio/pkg/maps"
+	"istio.io/istio/pkg/slices"
 	echoClient "istio.io/istio/pkg/test/echo"
 	"istio.io/istio/pkg/test/framework"
 	"istio.io/istio/pkg/test/framework/components/cluster"
@@ -265,6 +272,80 @@ func Alpn(expected string) echo.Checker {
 	})
 }
 
+// H2CMode checks how the cleartext HTTP/2 connection was established with the server: "prior-knowledge" or
+// "upgrade". An empty mode checks the request did not reach the server over cleartext HTTP/2.
+func H2CMode(expected string) echo.Checker {
+	return Each(func(r echoClient.Response) error {
+		if r.H2CMode != expected {
+			return fmt.Errorf("expected h2c mode %q, received %q", expected, r.H2CMode)
+		}
+		return nil
+	})
+}
+
+// ResponsePayload checks the raw bytes received by the client of a TCP request sending a payload contain the expected
+// bytes.
+func ResponsePayload(expected []byte) echo.Checker {
+	return Each(func(r echoClient.Response) error {
+		if !bytes.Contains(r.ResponsePayload, expected) {
+			return fmt.Errorf("expected response payload containing %x, received %x", expected, r.ResponsePayload)
+		}
+		return nil
+	})
+}
+
+// ContentEncoding checks the encoding of the response bodies, from their Content-Encoding header. An empty encoding
+// checks the bodies were not compressed.
+func ContentEncoding(expected string) echo.Checker {
+	return Each(func(r echoClient.Response) error {
+		return responseHeader(r, headers.ContentEncoding, expected)
+	})
+}
+
+// DecompressedBody checks the response bodies were compressed, and were received intact once decompressed by the
+// client. The calls must request an encoding the client decompresses, gzip or deflate, and the ?checksum of the bodies.
+func DecompressedBody() echo.Checker {
+	return Each(func(r echoClient.Response) error {
+		if r.CompressedBodySize == "" {
+			return fmt.Errorf("expected a compressed response body, received Content-Encoding `%s`",
+				r.ResponseHeaders.Get(headers.ContentEncoding))
+		}
+		switch r.BodyIntegrity {
+		case echoClient.BodyIntact:
+			return nil
+		case "":
+			return errors.New("response body has no checksum to verify, request it with ?checksum")
+		default:
+			return fmt.Errorf("response body of %s compressed bytes did not match its checksum once decompressed", r.CompressedBodySize)
+		}
+	})
+}
+
+// Redirects checks the URLs the client was redirected to before receiving the response, in order.
+func Redirects(expected ...string) echo.Checker {
+	return Each(func(r echoClient.Response) error {
+		got := make([]string, 0, len(r.Redirects))
+		for _, redirect := range r.Redirects {
+			got = append(got, redirect.URL)
+		}
+		if !slices.Equal(got, expected) {
+			return fmt.Errorf("expected redirects to %v, received %v", expected, got)
+		}
+		return nil
+	})
+}
+
+// PeerIdentity checks the identity of the client certificate received by the server over TLS. The HTTP server port
+// must set RequestClientCert.
+func PeerIdentity(expected string) echo.Checker {
+	return Each(func(r echoClient.Response) error {
+		if r.PeerIdentity != expected {
+			return fmt.Errorf("expected peer identity %s, received %s", expected, r.PeerIdentity)
+		}
+		return nil
+	})
+}
+
 func isHTTPProtocol(r echoClient.Response) bool {
 	return strings.HasPrefix(r.RequestURL, "http://") ||
 		strings.HasPrefix(r.RequestURL, "grpc://") ||
@@ -314,6 +395,233 @@ func Port(expected int) echo.Checker {
 	})
 }
 
+// RequestBodyReceived checks that the server received at least the expected number of bytes of the request body.
+// Multipart uploads add framing around the generated content, so more bytes than HTTP.BodySize may be received.
+func RequestBodyReceived(expected int64) echo.Checker {
+	return Each(func(r echoClient.Response) error {
+		received, err := strconv.ParseInt(r.RequestBodyBytes, 10, 64)
+		if err != nil {
+			return fmt.Errorf("expected a request body of at least %d bytes, but the server reported none: %v", expected, r)
+		}
+		if received < expected {
+			return fmt.Errorf("expected a request body of at least %d bytes, received %d", expected, received)
+		}
+		return nil
+	})
+}
+
+// DeadlineAtMost checks that the server observed a deadline for the request, no longer than the given budget.
+// Proxies reduce the deadline they forward by the time already spent, so this verifies that the timeout budget is
+// propagated, see echo.CallOptions.PropagateDeadline.
+func DeadlineAtMost(budget time.Duration) echo.Checker {
+	return Each(func(r echoClient.Response) error {
+		if r.Deadline == "" {
+			return fmt.Errorf("expected a deadline of at most %v, but the server observed none", budget)
+		}
+		deadline, err := time.ParseDuration(r.Deadline)
+		if err != nil {
+			return fmt.Errorf("invalid deadline %q reported by the server: %v", r.Deadline, err)
+		}
+		if deadline > budget {
+			return fmt.Errorf("expected a deadline of at most %v, the server observed %v", budget, deadline)
+		}
+		return nil
+	})
+}
+
+// FailurePhase checks that the call failed in the given phase, to assert where a deliberately broken path fails.
+// The phase is reported for HTTP, TCP and TLS calls, see echo.CallOptions.Timeout.
+func FailurePhase(expected echoClient.FailurePhase) echo.Checker {
+	return func(_ echo.CallResult, err error) error {
+		if err == nil {
+			return fmt.Errorf("expected the call to fail in the %s phase, but no error occurred", expected)
+		}
+		if phase := echoClient.ParseFailurePhase(err); phase != expected {
+			return fmt.Errorf("expected the call to fail in the %s phase, got %q: %v", expected, phase, err)
+		}
+		return nil
+	}
+}
+
+// RequestCanceled checks whether the request looked up with ?canceled=<request id> was canceled before the server
+// responded to it, for example because a proxy enforced its deadline. Only the replica that received the canceled
+// request knows about it, so the lookup must be sent to every replica of the target, for example with a Count of at
+// least the number of replicas: the request was canceled if any replica reports it, and was not if all of them report
+// it was not.
+func RequestCanceled(expected bool) echo.Checker {
+	return func(result echo.CallResult, _ error) error {
+		if result.Responses.IsEmpty() {
+			return fmt.Errorf("no responses received")
+		}
+		canceled := false
+		reached := map[string]bool{}
+		for _, r := range result.Responses {
+			if r.Canceled == "" {
+				return fmt.Errorf("the server did not report whether the request was canceled; missing ?canceled=<request id>")
+			}
+			canceled = canceled || r.Canceled == "true"
+			reached[r.Hostname] = true
+		}
+		if canceled != expected {
+			if expected {
+				return fmt.Errorf("expected request canceled to be true, but none of the replicas %v reported it", slices.Sort(maps.Keys(reached)))
+			}
+			return fmt.Errorf("expected request canceled to be false, got true")
+		}
+		if !expected && result.Opts.To != nil {
+			// A replica not reached may be the one that received the canceled request
+			workloads, err := result.Opts.To.Workloads()
+			if err != nil {
+				return err
+			}
+			for _, w := range workloads {
+				if !reached[w.PodName()] {
+					return fmt.Errorf("expected request canceled to be false, but replica %s was not asked", w.PodName())
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// P50Below checks that the median latency of the calls is below the given duration.
+func P50Below(d time.Duration) echo.Checker {
+	return LatencyPercentileBelow(50, d)
+}
+
+// P90Below checks that the 90th percentile of the latency of the calls is below the given duration.
+func P90Below(d time.Duration) echo.Checker {
+	return LatencyPercentileBelow(90, d)
+}
+
+// P99Below checks that the 99th percentile of the latency of the calls is below the given duration.
+func P99Below(d time.Duration) echo.Checker {
+	return LatencyPercentileBelow(99, d)
+}
+
+// LatencyPercentileBelow checks that the given percentile of the latency of the calls, as measured by the client, is
+// below the given duration. The measured latencies are reported on failure. The call count should be large enough for
+// the percentile to be meaningful, see echo.CallOptions.Count.
+func LatencyPercentileBelow(percentile float64, d time.Duration) echo.Checker {
+	return func(result echo.CallResult, _ error) error {
+		rs := result.Responses
+		if rs.IsEmpty() {
+			return fmt.Errorf("no responses received")
+		}
+		latencies := make([]time.Duration, 0, len(rs))
+		for i, r := range rs {
+			if r.Latency == "" {
+				return fmt.Errorf("response[%d]: no latency reported by the client", i)
+			}
+			latency, err := time.ParseDuration(r.Latency)
+			if err != nil {
+				return fmt.Errorf("response[%d]: invalid latency %q: %v", i, r.Latency, err)
+			}
+			latencies = append(latencies, latency)
+		}
+		sort.Slice(latencies, func(i, j int) bool {
+			return latencies[i] < latencies[j]
+		})
+		if got := latencyPercentile(latencies, percentile); got >= d {
+			return fmt.Errorf("expected p%v latency below %v, got %v over %d calls (p50=%v, p90=%v, p99=%v, max=%v)",
+				percentile, d, got, len(latencies), latencyPercentile(latencies, 50), latencyPercentile(latencies, 90),
+				latencyPercentile(latencies, 99), latencies[len(latencies)-1])
+		}
+		return nil
+	}
+}
+
+// latencyPercentile returns the percentile of the sorted latencies, using the nearest-rank method.
+func latencyPercentile(sorted []time.Duration, percentile float64) time.Duration {
+	rank := int(math.Ceil(percentile / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	if rank > len(sorted) {
+		rank = len(sorted)
+	}
+	return sorted[rank-1]
+}
+
+// WeightedDistribution checks that the calls were distributed across the backends in proportion to their configured
+// weights, such as the weighted backendRefs of a route. Backends are keyed by service name and a response is attributed
+// to the backend whose name is the longest prefix of its hostname. The share of the calls received by each backend
+// must be within tolerance (a fraction of the calls, e.g. 0.1) of its share of the weights, and calls reaching any
+// other backend fail the check. On failure, the observed and expected counts are reported with the chi-square
+// statistic of the distribution. The call count should be large enough for the tolerance to hold, see
+// echo.CallOptions.Count.
+func WeightedDistribution(weights map[string]int, tolerance float64) echo.Checker {
+	return func(result echo.CallResult, _ error) error {
+		rs := result.Responses
+		if rs.IsEmpty() {
+			return fmt.Errorf("no responses received")
+		}
+		totalWeight := 0
+		for _, w := range weights {
+			totalWeight += w
+		}
+		if totalWeight <= 0 {
+			return fmt.Errorf("no backend has a positive weight: %v", weights)
+		}
+		observed := map[string]int{}
+		for _, r := range rs {
+			observed[weightedBackend(r.Hostname, weights)]++
+		}
+		backends := make([]string, 0, len(weights))
+		for b := range weights {
+			backends = append(backends, b)
+		}
+		sort.Strings(backends)
+
+		total := float64(len(rs))
+		failed := observed[""] > 0
+		chiSquare := 0.0
+		diagnostics := make([]string, 0, len(backends)+1)
+		for _, b := range backends {
+			expected := float64(weights[b]) / float64(totalWeight)
+			got := float64(observed[b]) / total
+			if math.Abs(got-expected) > tolerance {
+				failed = true
+			}
+			if expectedCalls := expected * total; expectedCalls > 0 {
+				chiSquare += math.Pow(float64(observed[b])-expectedCalls, 2) / expectedCalls
+			}
+			diagnostics = append(diagnostics, fmt.Sprintf("%s: expected %.1f%% (%.1f calls), got %.1f%% (%d calls)",
+				b, expected*100, expected*total, got*100, observed[b]))
+		}
+		if observed[""] > 0 {
+			diagnostics = append(diagnostics, fmt.Sprintf("unexpected backends: %d calls", observed[""]))
+		}
+		if !failed {
+			return nil
+		}
+		df := len(backends) - 1
+		return fmt.Errorf("calls not distributed by weight within %.1f%% over %d calls (chi-square=%.2f, df=%d, critical value at p=0.05: %.2f): %s",
+			tolerance*100, len(rs), chiSquare, df, chiSquareCritical(df), strings.Join(diagnostics, "; "))
+	}
+}
+
+// weightedBackend returns the backend whose name is the longest prefix of the hostname, or empty if there is none.
+func weightedBackend(hostname string, weights map[string]int) string {
+	match := ""
+	for b := range weights {
+		if strings.HasPrefix(hostname, b) && len(b) > len(match) {
+			match = b
+		}
+	}
+	return match
+}
+
+// chiSquareCritical approximates the critical value of the chi-square distribution at p=0.05 for the given degrees of
+// freedom, using the Wilson-Hilferty transformation.
+func chiSquareCritical(df int) float64 {
+	if df < 1 {
+		return 0
+	}
+	k := float64(df)
+	return k * math.Pow(1-2/(9*k)+1.645*math.Sqrt(2/(9*k)), 3)
+}
+
 func requestHeader(r echoClient.Response, key, expected string) error {
 	actual := r.RequestHeaders.Get(key)
 	if actual != expected {
@@ -380,6 +688,22 @@ func URL(expected string) echo.Checker {
 	})
 }
 
+// Hops checks the hops of a call chain executed by the server (see common.CallChainHeader). Each visitor
+// is applied to the hop at the same position, and the number of hops must match.
+func Hops(expected ...Visitor) echo.Checker {
+	return Each(func(r echoClient.Response) error {
+		if len(r.Hops) != len(expected) {
+			return fmt.Errorf("expected %d call chain hops, received %d. Response: %s", len(expected), len(r.Hops), r)
+		}
+		for i, v := range expected {
+			if err := v(r.Hops[i]); err != nil {
+				return fmt.Errorf("call chain hop %d (%s): %v", i, r.Hops[i].RequestURL, err)
+			}
+		}
+		return nil
+	})
+}
+
 func IsDNSCaptureEnabled(t framework.TestContext) bool {
 	t.Helper()
 	mc := istio.GetOrFail(t, t).MeshConfigOrFail(t)
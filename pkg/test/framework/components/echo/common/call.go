@@ -124,28 +124,41 @@ func (c *Caller) CallEcho(from echo.Caller, opts echo.CallOptions) (echo.CallRes
 
 func newForwardRequest(opts echo.CallOptions) *proto.ForwardEchoRequest {
 	return &proto.ForwardEchoRequest{
-		Url:                     getTargetURL(opts),
-		Count:                   int32(opts.Count),
-		Headers:                 common.HTTPToProtoHeaders(opts.HTTP.Headers),
-		TimeoutMicros:           common.DurationToMicros(opts.Timeout),
-		Message:                 opts.Message,
-		ExpectedResponse:        opts.TCP.ExpectedResponse,
-		Http2:                   opts.HTTP.HTTP2,
-		Http3:                   opts.HTTP.HTTP3,
-		Method:                  opts.HTTP.Method,
-		ServerFirst:             opts.Port.ServerFirst,
-		Cert:                    opts.TLS.Cert,
-		Key:                     opts.TLS.Key,
-		CaCert:                  opts.TLS.CaCert,
-		CertFile:                opts.TLS.CertFile,
-		KeyFile:                 opts.TLS.KeyFile,
-		CaCertFile:              opts.TLS.CaCertFile,
-		InsecureSkipVerify:      opts.TLS.InsecureSkipVerify,
-		Alpn:                    getProtoALPN(opts.TLS.Alpn),
-		FollowRedirects:         opts.HTTP.FollowRedirects,
-		ServerName:              opts.TLS.ServerName,
-		NewConnectionPerRequest: opts.NewConnectionPerRequest,
-		ForceDNSLookup:          opts.ForceDNSLookup,
+		Url:                         getTargetURL(opts),
+		Count:                       int32(opts.Count),
+		Qps:                         int32(opts.QPS),
+		Headers:                     common.HTTPToProtoHeaders(opts.HTTP.Headers),
+		TimeoutMicros:               common.DurationToMicros(opts.Timeout),
+		ConnectTimeoutMicros:        common.DurationToMicros(opts.ConnectTimeout),
+		TlsHandshakeTimeoutMicros:   common.DurationToMicros(opts.TLSHandshakeTimeout),
+		ResponseHeaderTimeoutMicros: common.DurationToMicros(opts.ResponseHeaderTimeout),
+		Message:                     opts.Message,
+		ExpectedResponse:            opts.TCP.ExpectedResponse,
+		Payload:                     opts.TCP.Payload,
+		ExpectedPayload:             opts.TCP.ExpectedPayload,
+		Http2:                       opts.HTTP.HTTP2,
+		Http3:                       opts.HTTP.HTTP3,
+		H2CUpgrade:                  opts.HTTP.H2CUpgrade,
+		Method:                      opts.HTTP.Method,
+		ServerFirst:                 opts.Port.ServerFirst,
+		Cert:                        opts.TLS.Cert,
+		Key:                         opts.TLS.Key,
+		CaCert:                      opts.TLS.CaCert,
+		CertFile:                    opts.TLS.CertFile,
+		KeyFile:                     opts.TLS.KeyFile,
+		CaCertFile:                  opts.TLS.CaCertFile,
+		ClientCertName:              opts.TLS.ClientCertName,
+		InsecureSkipVerify:          opts.TLS.InsecureSkipVerify,
+		Alpn:                        getProtoALPN(opts.TLS.Alpn),
+		FollowRedirects:             opts.HTTP.FollowRedirects,
+		MaxRedirects:                int32(opts.HTTP.MaxRedirects),
+		CookieJar:                   opts.HTTP.CookieJar,
+		ServerName:                  opts.TLS.ServerName,
+		NewConnectionPerRequest:     opts.NewConnectionPerRequest,
+		ForceDNSLookup:              opts.ForceDNSLookup,
+		BodySize:                    opts.HTTP.BodySize,
+		ChunkedBody:                 opts.HTTP.ChunkedBody,
+		MultipartParts:              int32(opts.HTTP.MultipartParts),
 		Hbone: &proto.HBONE{
 			Address:            opts.HBONE.Address,
 			Headers:            common.HTTPToProtoHeaders(opts.HBONE.Headers),
@@ -145,6 +145,10 @@ type Config struct {
 	// TLS settings for echo server
 	TLSSettings *common.TLSSettings
 
+	// ClientCertificates are the client certificates the echo server can present when it forwards a call, keyed by
+	// name. A call selects one with CallOptions.TLS.ClientCertName.
+	ClientCertificates map[string]common.ClientCertificate
+
 	// If enabled, echo will be deployed as a "VM". This means it will run Envoy in the same pod as echo,
 	// disable sidecar injection, etc.
 	// This aims to simulate a VM, but instead of managing the complex test setup of spinning up a VM,
@@ -16,6 +16,7 @@ package kube
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/netip"
 	"os"
@@ -123,16 +124,8 @@ func newDeployment(ctx resource.Context, cfg echo.Config) (*deployment, error) {
 // This is analogous to `kubectl rollout restart` on the echo deployment and waits for
 // `kubectl rollout status` to complete before returning, but uses direct API calls.
 func (d *deployment) Restart() error {
-	var errs error
-	var deploymentNames []string
-	for _, s := range d.cfg.Subsets {
-		// TODO(Monkeyanator) move to common place so doesn't fall out of sync with templates
-		deploymentNames = append(deploymentNames, fmt.Sprintf("%s-%s", d.cfg.Service, s.Version))
-	}
 	curTimestamp := time.Now().Format(time.RFC3339)
-	for _, deploymentName := range deploymentNames {
-		patchOpts := metav1.PatchOptions{}
-		patchData := fmt.Sprintf(`{
+	patchData := fmt.Sprintf(`{
 			"spec": {
 				"template": {
 					"metadata": {
@@ -143,18 +136,71 @@ func (d *deployment) Restart() error {
 				}
 			}
 		}`, curTimestamp) // e.g., “2006-01-02T15:04:05Z07:00”
+	if err := d.patchAndRollout(types.StrategicMergePatchType, []byte(patchData)); err != nil {
+		return fmt.Errorf("failed to rollout restart (timestamp:%q): %v", curTimestamp, err)
+	}
+	return nil
+}
+
+// SetHostAliases replaces the hostAliases of all the pods of the deployment, and waits for
+// the resulting rollout to complete before returning.
+func (d *deployment) SetHostAliases(aliases []corev1.HostAlias) error {
+	patchData, err := json.Marshal([]map[string]any{{
+		"op":    "add",
+		"path":  "/spec/template/spec/hostAliases",
+		"value": aliases,
+	}})
+	if err != nil {
+		return err
+	}
+	return d.patchAndRollout(types.JSONPatchType, patchData)
+}
+
+// HostAliases returns the hostAliases currently configured on the pods of the deployment.
+func (d *deployment) HostAliases() ([]corev1.HostAlias, error) {
+	deploymentName := d.deploymentNames()[0]
+	appsv1Client := d.cfg.Cluster.Kube().AppsV1()
+	if d.cfg.IsStatefulSet() {
+		sts, err := appsv1Client.StatefulSets(d.cfg.Namespace.Name()).Get(context.TODO(), deploymentName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return sts.Spec.Template.Spec.HostAliases, nil
+	}
+	dep, err := appsv1Client.Deployments(d.cfg.Namespace.Name()).Get(context.TODO(), deploymentName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return dep.Spec.Template.Spec.HostAliases, nil
+}
+
+func (d *deployment) deploymentNames() []string {
+	var deploymentNames []string
+	for _, s := range d.cfg.Subsets {
+		// TODO(Monkeyanator) move to common place so doesn't fall out of sync with templates
+		deploymentNames = append(deploymentNames, fmt.Sprintf("%s-%s", d.cfg.Service, s.Version))
+	}
+	return deploymentNames
+}
+
+// patchAndRollout applies the patch to all the deployments (or statefulsets) of the echo instance, and
+// waits for `kubectl rollout status` to complete before returning.
+func (d *deployment) patchAndRollout(patchType types.PatchType, patchData []byte) error {
+	var errs error
+	for _, deploymentName := range d.deploymentNames() {
+		patchOpts := metav1.PatchOptions{}
 		var err error
 		appsv1Client := d.cfg.Cluster.Kube().AppsV1()
 
 		if d.cfg.IsStatefulSet() {
 			_, err = appsv1Client.StatefulSets(d.cfg.Namespace.Name()).Patch(context.TODO(), deploymentName,
-				types.StrategicMergePatchType, []byte(patchData), patchOpts)
+				patchType, patchData, patchOpts)
 		} else {
 			_, err = appsv1Client.Deployments(d.cfg.Namespace.Name()).Patch(context.TODO(), deploymentName,
-				types.StrategicMergePatchType, []byte(patchData), patchOpts)
+				patchType, patchData, patchOpts)
 		}
 		if err != nil {
-			errs = multierror.Append(errs, fmt.Errorf("failed to rollout restart %v/%v: %v (timestamp:%q)", d.cfg.Namespace.Name(), deploymentName, err, curTimestamp))
+			errs = multierror.Append(errs, fmt.Errorf("failed to patch %v/%v: %v", d.cfg.Namespace.Name(), deploymentName, err))
 			continue
 		}
 
@@ -359,7 +405,7 @@ func deploymentParams(ctx resource.Context, cfg echo.Config, settings *resource.
 
 	params := map[string]any{
 		"ImageHub":                settings.Image.Hub,
-		"ImageTag":                strings.TrimSuffix(settings.Image.Tag, "-distroless"),
+		"ImageTag":                settings.Image.BaseTag(),
 		"ImagePullPolicy":         settings.Image.PullPolicy,
 		"ImagePullSecretName":     imagePullSecretName,
 		"Service":                 cfg.Service,
@@ -373,6 +419,7 @@ func deploymentParams(ctx resource.Context, cfg echo.Config, settings *resource.
 		"ContainerPorts":          getContainerPorts(cfg),
 		"Subsets":                 cfg.Subsets,
 		"TLSSettings":             cfg.TLSSettings,
+		"ClientCertificates":      cfg.ClientCertificates,
 		"Cluster":                 cfg.Cluster.Name(),
 		"ReadinessTCPPort":        cfg.ReadinessTCPPort,
 		"ReadinessGRPCPort":       cfg.ReadinessGRPCPort,
@@ -643,13 +690,15 @@ func getContainerPorts(cfg echo.Config) echoCommon.PortList {
 	for _, p := range ports {
 		// Add the port to the set of application ports.
 		cport := &echoCommon.Port{
-			Name:        p.Name,
-			Protocol:    p.Protocol,
-			Port:        p.WorkloadPort,
-			TLS:         p.TLS,
-			ServerFirst: p.ServerFirst,
-			InstanceIP:  p.InstanceIP,
-			LocalhostIP: p.LocalhostIP,
+			Name:              p.Name,
+			Protocol:          p.Protocol,
+			Port:              p.WorkloadPort,
+			TLS:               p.TLS,
+			ServerFirst:       p.ServerFirst,
+			RequestClientCert: p.RequestClientCert,
+			H2CUpgrade:        p.H2CUpgrade,
+			InstanceIP:        p.InstanceIP,
+			LocalhostIP:       p.LocalhostIP,
 		}
 		containerPorts = append(containerPorts, cport)
 
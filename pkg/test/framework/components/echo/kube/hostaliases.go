@@ -0,0 +1,94 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"istio.io/istio/pkg/maps"
+	"istio.io/istio/pkg/slices"
+	"istio.io/istio/pkg/test/framework/components/echo"
+	"istio.io/istio/pkg/test/framework/resource"
+	"istio.io/istio/pkg/test/scopes"
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+// InjectHostAliases makes the given hostnames resolve to the given IP addresses (hostname -> IP) inside the pods
+// of the echo instances, by adding hostAliases to their pod template. This allows calling a gateway by its external
+// DNS name (for example https://my.domain.example), so SNI and Host are derived from the URL as they would be for
+// a real client. The instances are rolled out and ready when this returns; the original hostAliases are restored
+// once the context completes.
+func InjectHostAliases(ctx resource.Context, aliases map[string]string, instances ...echo.Instance) error {
+	hostAliases := toHostAliases(aliases)
+	for _, inst := range instances {
+		c, ok := inst.(*instance)
+		if !ok {
+			return fmt.Errorf("host aliases are not supported for echo instance %s", inst.NamespacedName())
+		}
+		original, err := c.deployment.HostAliases()
+		if err != nil {
+			return fmt.Errorf("failed to get host aliases for echo %s: %v", c.NamespacedName(), err)
+		}
+		ctx.Cleanup(func() {
+			if err := c.setHostAliases(original); err != nil {
+				scopes.Framework.Errorf("failed to restore host aliases for echo %s: %v", c.NamespacedName(), err)
+			}
+		})
+		if err := c.setHostAliases(hostAliases); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toHostAliases groups the hostname -> IP mappings by IP, with deterministic ordering.
+func toHostAliases(aliases map[string]string) []corev1.HostAlias {
+	hostnamesByIP := map[string][]string{}
+	for _, hostname := range slices.Sort(maps.Keys(aliases)) {
+		ip := aliases[hostname]
+		hostnamesByIP[ip] = append(hostnamesByIP[ip], hostname)
+	}
+	res := make([]corev1.HostAlias, 0, len(hostnamesByIP))
+	for _, ip := range slices.Sort(maps.Keys(hostnamesByIP)) {
+		res = append(res, corev1.HostAlias{IP: ip, Hostnames: hostnamesByIP[ip]})
+	}
+	return res
+}
+
+func (c *instance) setHostAliases(aliases []corev1.HostAlias) error {
+	origWorkloads, err := c.workloadMgr.WaitForReadyWorkloads()
+	if err != nil {
+		return fmt.Errorf("failed to get initial workloads: %v", err)
+	}
+
+	if err := c.deployment.SetHostAliases(aliases); err != nil {
+		return fmt.Errorf("failed to set host aliases for echo %s: %v", c.NamespacedName(), err)
+	}
+
+	// Wait until all pods are ready and match the original count.
+	return retry.UntilSuccess(func() error {
+		workloads, err := c.workloadMgr.WaitForReadyWorkloads()
+		if err != nil {
+			return fmt.Errorf("failed waiting for pods for echo %s: %v", c.NamespacedName(), err)
+		}
+		if len(workloads) != len(origWorkloads) {
+			return fmt.Errorf("number of pods %d for echo %s does not match original %d",
+				len(workloads), c.NamespacedName(), len(origWorkloads))
+		}
+		return nil
+	}, retry.Timeout(c.cfg.ReadinessTimeout), startDelay)
+}
@@ -0,0 +1,36 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kube
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestToHostAliases(t *testing.T) {
+	got := toHostAliases(map[string]string{
+		"b.example.com": "10.0.0.1",
+		"a.example.com": "10.0.0.1",
+		"c.example.com": "10.0.0.2",
+	})
+	assert.Equal(t, got, []corev1.HostAlias{
+		{IP: "10.0.0.1", Hostnames: []string{"a.example.com", "b.example.com"}},
+		{IP: "10.0.0.2", Hostnames: []string{"c.example.com"}},
+	})
+	assert.Equal(t, toHostAliases(nil), []corev1.HostAlias{})
+}
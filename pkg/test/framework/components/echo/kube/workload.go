@@ -22,6 +22,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	istioKube "istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/log"
@@ -179,6 +180,45 @@ func (w *workload) Address() string {
 	return ip
 }
 
+func (w *workload) Addresses() []string {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	out := make([]string, 0, len(w.pod.Status.PodIPs))
+	for _, ip := range w.pod.Status.PodIPs {
+		out = append(out, ip.IP)
+	}
+	return out
+}
+
+func (w *workload) Node() string {
+	w.mutex.Lock()
+	n := w.pod.Spec.NodeName
+	w.mutex.Unlock()
+	return n
+}
+
+// Zone returns the zone label of the node of the pod. The node is looked up on each call, as it is only used to select
+// the workloads of the tests.
+func (w *workload) Zone() string {
+	node := w.Node()
+	if node == "" {
+		return ""
+	}
+	n, err := w.cluster.Kube().CoreV1().Nodes().Get(context.TODO(), node, metav1.GetOptions{})
+	if err != nil {
+		scopes.Framework.Warnf("failed getting node %s of echo pod %s: %v", node, w.PodName(), err)
+		return ""
+	}
+	return n.Labels[corev1.LabelTopologyZone]
+}
+
+func (w *workload) Labels() map[string]string {
+	w.mutex.Lock()
+	l := w.pod.Labels
+	w.mutex.Unlock()
+	return l
+}
+
 func (w *workload) ForwardEcho(ctx context.Context, request *proto.ForwardEchoRequest) (echoClient.Responses, error) {
 	w.mutex.Lock()
 	c := w.client
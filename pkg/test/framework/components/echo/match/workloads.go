@@ -0,0 +1,137 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package match
+
+import (
+	"net/netip"
+
+	"istio.io/api/label"
+	"istio.io/istio/pkg/test/framework/components/echo"
+)
+
+// WorkloadMatcher is used to filter the workloads of instances, e.g. to select the sources of a test in a given
+// topology. Example, selecting the sources running in another zone than the destination workload:
+//
+//	NotWorkload(SameZone(dst)).GetMatches(sources)
+type WorkloadMatcher func(echo.Workload) bool
+
+// GetMatches returns the instances with at least one matching workload, restricted to their matching workloads.
+func (m WorkloadMatcher) GetMatches(instances echo.Instances) echo.Instances {
+	out := make(echo.Instances, 0)
+	for _, i := range instances {
+		wls, err := i.Workloads()
+		if err != nil {
+			continue
+		}
+		var matched echo.Workloads
+		for _, w := range wls {
+			if m(w) {
+				matched = append(matched, w)
+			}
+		}
+		if len(matched) > 0 {
+			out = append(out, i.WithWorkloads(matched...))
+		}
+	}
+	return out
+}
+
+// AnyWorkload matches instances with at least one workload matching m.
+func AnyWorkload(m WorkloadMatcher) Matcher {
+	return func(i echo.Instance) bool {
+		wls, err := i.Workloads()
+		if err != nil {
+			return false
+		}
+		for _, w := range wls {
+			if m(w) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// AllWorkloads is an aggregate WorkloadMatcher that requires all matches return true.
+func AllWorkloads(ms ...WorkloadMatcher) WorkloadMatcher {
+	return func(w echo.Workload) bool {
+		for _, m := range ms {
+			if m != nil && !m(w) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// NotWorkload negates the given workload matcher.
+func NotWorkload(m WorkloadMatcher) WorkloadMatcher {
+	return func(w echo.Workload) bool {
+		return !m(w)
+	}
+}
+
+// IPv4 matches workloads with an IPv4 address.
+var IPv4 WorkloadMatcher = func(w echo.Workload) bool {
+	return hasAddress(w, netip.Addr.Is4)
+}
+
+// IPv6 matches workloads with an IPv6 address.
+var IPv6 WorkloadMatcher = func(w echo.Workload) bool {
+	return hasAddress(w, netip.Addr.Is6)
+}
+
+// DualStack matches workloads with both an IPv4 and an IPv6 address.
+var DualStack = AllWorkloads(IPv4, IPv6)
+
+func hasAddress(w echo.Workload, family func(netip.Addr) bool) bool {
+	for _, a := range w.Addresses() {
+		if ip, err := netip.ParseAddr(a); err == nil && family(ip.Unmap()) {
+			return true
+		}
+	}
+	return false
+}
+
+// Node matches workloads running on the given node.
+func Node(name string) WorkloadMatcher {
+	return func(w echo.Workload) bool {
+		return w.Node() == name
+	}
+}
+
+// SameNode matches workloads running on the same node as the given workload, e.g. the destination of a call.
+func SameNode(other echo.Workload) WorkloadMatcher {
+	return Node(other.Node())
+}
+
+// Zone matches workloads running in the given zone.
+func Zone(zone string) WorkloadMatcher {
+	return func(w echo.Workload) bool {
+		return w.Zone() == zone
+	}
+}
+
+// SameZone matches workloads running in the same zone as the given workload, e.g. the destination of a call.
+func SameZone(other echo.Workload) WorkloadMatcher {
+	return Zone(other.Zone())
+}
+
+// Revision matches workloads injected by the control plane of the given revision, as labeled by the injector.
+func Revision(rev string) WorkloadMatcher {
+	return func(w echo.Workload) bool {
+		return w.Labels()[label.IoIstioRev.Name] == rev
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package match_test
+
+import (
+	"testing"
+
+	"istio.io/istio/pkg/test/framework/components/echo"
+	"istio.io/istio/pkg/test/framework/components/echo/match"
+)
+
+// fakeWorkload only implements the methods used by the workload matchers.
+type fakeWorkload struct {
+	echo.Workload
+	addresses []string
+	node      string
+	zone      string
+	labels    map[string]string
+}
+
+func (w fakeWorkload) Addresses() []string {
+	return w.addresses
+}
+
+func (w fakeWorkload) Node() string {
+	return w.node
+}
+
+func (w fakeWorkload) Zone() string {
+	return w.zone
+}
+
+func (w fakeWorkload) Labels() map[string]string {
+	return w.labels
+}
+
+func TestWorkloadMatchers(t *testing.T) {
+	v4 := fakeWorkload{addresses: []string{"10.0.0.1"}, node: "node-1", zone: "zone-a", labels: map[string]string{"istio.io/rev": "canary"}}
+	v6 := fakeWorkload{addresses: []string{"fd00::1"}, node: "node-2", zone: "zone-a"}
+	dual := fakeWorkload{addresses: []string{"10.0.0.2", "fd00::2"}, node: "node-3", zone: "zone-b"}
+
+	tests := []struct {
+		name    string
+		matcher match.WorkloadMatcher
+		expect  []bool
+	}{
+		{name: "ipv4", matcher: match.IPv4, expect: []bool{true, false, true}},
+		{name: "ipv6", matcher: match.IPv6, expect: []bool{false, true, true}},
+		{name: "dual stack", matcher: match.DualStack, expect: []bool{false, false, true}},
+		{name: "node", matcher: match.Node("node-2"), expect: []bool{false, true, false}},
+		{name: "same node", matcher: match.SameNode(v4), expect: []bool{true, false, false}},
+		{name: "zone", matcher: match.Zone("zone-b"), expect: []bool{false, false, true}},
+		{name: "same zone", matcher: match.SameZone(v4), expect: []bool{true, true, false}},
+		{name: "other zone", matcher: match.NotWorkload(match.SameZone(v4)), expect: []bool{false, false, true}},
+		{name: "revision", matcher: match.Revision("canary"), expect: []bool{true, false, false}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for i, w := range []echo.Workload{v4, v6, dual} {
+				if got := tt.matcher(w); got != tt.expect[i] {
+					t.Errorf("workload %d: got %v expected %v", i, got, tt.expect[i])
+				}
+			}
+		})
+	}
+}
@@ -49,6 +49,14 @@ type Port struct {
 	// ServerFirst determines whether the port will use server first communication, meaning the client will not send the first byte.
 	ServerFirst bool
 
+	// RequestClientCert determines whether a TLS HTTP port requests a client certificate, reporting its identity in
+	// the responses.
+	RequestClientCert bool
+
+	// H2CUpgrade determines whether a plaintext HTTP port accepts h2c Upgrades, in addition to h2c with prior
+	// knowledge.
+	H2CUpgrade bool
+
 	// InstanceIP determines if echo will listen on the instance IP; otherwise, it will listen on wildcard
 	InstanceIP bool
 
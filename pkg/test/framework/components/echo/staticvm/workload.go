@@ -82,6 +82,25 @@ func (w *workload) Address() string {
 	return w.address
 }
 
+func (w *workload) Addresses() []string {
+	if w.address == "" {
+		return nil
+	}
+	return []string{w.address}
+}
+
+func (w *workload) Node() string {
+	return ""
+}
+
+func (w *workload) Zone() string {
+	return ""
+}
+
+func (w *workload) Labels() map[string]string {
+	return nil
+}
+
 func (w *workload) Cluster() cluster.Cluster {
 	return w.cluster
 }
@@ -45,6 +45,18 @@ type Workload interface {
 	// Address returns the network address of the endpoint.
 	Address() string
 
+	// Addresses returns the network addresses of the endpoint, one per IP family for dual-stack workloads.
+	Addresses() []string
+
+	// Node returns the name of the node the workload runs on, if any.
+	Node() string
+
+	// Zone returns the zone of the node the workload runs on, if any.
+	Zone() string
+
+	// Labels returns the labels of the workload.
+	Labels() map[string]string
+
 	// Sidecar if one was specified.
 	Sidecar() Sidecar
 
@@ -98,7 +98,7 @@ func (i *istioImpl) Dump(ctx resource.Context) {
 	}
 	// Dump istio-cni.
 	g.Go(func() error {
-		kube2.DumpPods(ctx, d, "kube-system", []string{"k8s-app=istio-cni-node"})
+		kube2.DumpPods(ctx, d, "kube-system", []string{"k8s-app=istio-cni-node"}, append(kube2.DefaultPodDumpers(), kube2.DumpCNIPersistedLogs)...)
 		return nil
 	})
 }
@@ -20,6 +20,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -211,6 +212,10 @@ type Config struct {
 	ConfigureRemoteCluster bool
 
 	DifferentTrustDomains bool
+
+	// ClockSkew skews the time perceived by istiod and the proxies when checking expiry times, so that the
+	// expiry of certificates, JWTs and cached entries can be tested without waiting for it.
+	ClockSkew time.Duration
 }
 
 func (c *Config) OverridesYAML(s *resource.Settings) string {
@@ -389,6 +394,7 @@ func (c *Config) String() string {
 	result += fmt.Sprintf("IngressGatewayIstioLabel:       %v\n", c.IngressGatewayIstioLabel)
 	result += fmt.Sprintf("ConfigureMultiCluster:          %v\n", c.ConfigureMultiCluster)
 	result += fmt.Sprintf("DifferentTrustDomains:          %v\n", c.DifferentTrustDomains)
+	result += fmt.Sprintf("ClockSkew:                      %v\n", c.ClockSkew)
 
 	return result
 }
@@ -41,6 +41,8 @@ func init() {
 		e.g. components.cni.enabled=true,components.cni.namespace=kube-system`)
 	flag.BoolVar(&settingsFromCommandline.EnableCNI, "istio.test.istio.enableCNI", settingsFromCommandline.EnableCNI,
 		"Deploy Istio with CNI enabled.")
+	flag.DurationVar(&settingsFromCommandline.ClockSkew, "istio.test.istio.clockSkew", settingsFromCommandline.ClockSkew,
+		"Skews the time perceived by istiod and the proxies when checking the expiry of certificates, JWTs and cached entries.")
 	flag.StringVar(&settingsFromCommandline.IngressGatewayServiceName, "istio.test.kube.ingressGatewayServiceName",
 		settingsFromCommandline.IngressGatewayServiceName,
 		`Specifies the name of the ingressgateway service to use when running tests in a preinstalled istio installation.
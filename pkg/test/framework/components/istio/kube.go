@@ -625,6 +625,11 @@ func commonInstallArgs(ctx resource.Context, cfg Config, c cluster.Cluster, defa
 		args.AppendSet("meshConfig.defaultConfig.proxyMetadata.ISTIO_DUAL_STACK", "true")
 	}
 
+	if cfg.ClockSkew != 0 {
+		args.AppendSet("values.pilot.env.ISTIO_CLOCK_SKEW", cfg.ClockSkew.String())
+		args.AppendSet("meshConfig.defaultConfig.proxyMetadata.ISTIO_CLOCK_SKEW", cfg.ClockSkew.String())
+	}
+
 	if cfg.DifferentTrustDomains {
 		delete(cfg.Values, "meshConfig.trustDomain")
 		args.AppendSet("values.meshConfig.trustDomain", c.Name()+".local")
@@ -0,0 +1,50 @@
+// Copyright Istio Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package istio
+
+import (
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/framework/components/cluster"
+	testKube "istio.io/istio/pkg/test/kube"
+)
+
+// StreamIstiodLogs streams the logs of the istiod pods of the cluster until the end of the test, e.g.
+//
+//	logs := istio.StreamIstiodLogs(t, i, t.Clusters().Default())
+//	// ... apply some config
+//	logs.ExpectLog(t, kube.LogPattern{Level: "warn", Scope: "ads", Message: regexp.MustCompile("rejected")})
+func StreamIstiodLogs(t test.Failer, i Instance, c cluster.Cluster) *testKube.LogStream {
+	return testKube.StreamLogs(t, c, i.Settings().SystemNamespace, "discovery", "app=istiod")
+}
+
+// StreamIngressLogs streams the logs of the proxies of the default ingress gateway of the cluster until the end of the
+// test.
+func StreamIngressLogs(t test.Failer, i Instance, c cluster.Cluster) *testKube.LogStream {
+	cfg := i.Settings()
+	namespace := cfg.SystemNamespace
+	if cfg.IngressGatewayServiceNamespace != "" {
+		namespace = cfg.IngressGatewayServiceNamespace
+	}
+	label := "ingressgateway"
+	if cfg.IngressGatewayIstioLabel != "" {
+		label = cfg.IngressGatewayIstioLabel
+	}
+	return testKube.StreamLogs(t, c, namespace, "istio-proxy", "istio="+label)
+}
+
+// StreamZtunnelLogs streams the logs of the ztunnel pods of the cluster until the end of the test.
+func StreamZtunnelLogs(t test.Failer, i Instance, c cluster.Cluster) *testKube.LogStream {
+	return testKube.StreamLogs(t, c, i.Settings().SystemNamespace, "istio-proxy", "app=ztunnel")
+}
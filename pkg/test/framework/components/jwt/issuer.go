@@ -0,0 +1,180 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwt
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/env"
+	"istio.io/istio/pkg/test/framework"
+	"istio.io/istio/pkg/test/framework/components/namespace"
+	"istio.io/istio/pkg/test/framework/resource"
+	"istio.io/istio/security/pkg/pki/util"
+)
+
+// DefaultIssuer is the issuer of the static test tokens, which RequestAuthentication policies of the tests trust.
+const DefaultIssuer = "test-issuer-1@istio.io"
+
+// Claims of a JWT token.
+type Claims map[string]any
+
+// Issuer mints JWT tokens with arbitrary claims. The tokens are signed with the key of the JWKS served in-cluster by
+// the JWT Server, so that RequestAuthentication policies can validate them using JwksURI.
+type Issuer interface {
+	Server
+	// Issuer returns the iss claim of the minted tokens.
+	Issuer() string
+	// Token mints a token with the given claims. The iss, sub, iat and exp claims are set, unless present in claims.
+	Token(claims Claims) (string, error)
+	// TokenOrFail calls Token and fails if an error occurs.
+	TokenOrFail(t test.Failer, claims Claims) string
+}
+
+// IssuerConfig configures an Issuer.
+type IssuerConfig struct {
+	// Namespace where the JWT Server is deployed. If nil, a namespace is created.
+	Namespace namespace.Instance
+	// Issuer is the default iss claim of the tokens. Defaults to DefaultIssuer.
+	Issuer string
+	// TTL is the default lifetime of the tokens. Defaults to one hour.
+	TTL time.Duration
+}
+
+// NewIssuer deploys a JWT Server and creates an Issuer of tokens it can validate.
+func NewIssuer(ctx resource.Context, cfg IssuerConfig) (Issuer, error) {
+	if cfg.Issuer == "" {
+		cfg.Issuer = DefaultIssuer
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = time.Hour
+	}
+	signer, err := newSigner()
+	if err != nil {
+		return nil, err
+	}
+	s, err := New(ctx, cfg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &issuerImpl{Server: s, issuer: cfg.Issuer, ttl: cfg.TTL, signer: signer}, nil
+}
+
+// NewIssuerOrFail calls NewIssuer and fails if an error occurs.
+func NewIssuerOrFail(t framework.TestContext, cfg IssuerConfig) Issuer {
+	t.Helper()
+	i, err := NewIssuer(t, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return i
+}
+
+// SetupIssuer is a utility function for configuring an Issuer.
+func SetupIssuer(issuer *Issuer, ns namespace.Getter, cfg IssuerConfig) resource.SetupFn {
+	if ns == nil {
+		ns = namespace.NilGetter
+	}
+
+	return func(ctx resource.Context) error {
+		cfg.Namespace = ns()
+		i, err := NewIssuer(ctx, cfg)
+		if err != nil {
+			return err
+		}
+
+		// Store the issuer.
+		*issuer = i
+		return nil
+	}
+}
+
+// newSigner creates a signer with the private key of the JWKS served by the JWT Server.
+func newSigner() (jose.Signer, error) {
+	dir := filepath.Join(env.IstioSrc, "tests/common/jwt")
+	jwksJSON, err := os.ReadFile(filepath.Join(dir, "jwks.json"))
+	if err != nil {
+		return nil, err
+	}
+	var jwks jose.JSONWebKeySet
+	if err := json.Unmarshal(jwksJSON, &jwks); err != nil {
+		return nil, fmt.Errorf("failed to parse the JWKS: %v", err)
+	}
+	if len(jwks.Keys) != 1 {
+		return nil, fmt.Errorf("expected a single key in the JWKS, got %d", len(jwks.Keys))
+	}
+	keyPEM, err := os.ReadFile(filepath.Join(dir, "key.pem"))
+	if err != nil {
+		return nil, err
+	}
+	key, err := util.ParsePemEncodedKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.RS256,
+		Key:       jose.JSONWebKey{Key: key, KeyID: jwks.Keys[0].KeyID, Algorithm: string(jose.RS256)},
+	}, (&jose.SignerOptions{}).WithType("JWT"))
+}
+
+type issuerImpl struct {
+	Server
+	issuer string
+	ttl    time.Duration
+	signer jose.Signer
+}
+
+var _ Issuer = &issuerImpl{}
+
+func (i *issuerImpl) Issuer() string {
+	return i.issuer
+}
+
+func (i *issuerImpl) Token(claims Claims) (string, error) {
+	now := time.Now()
+	payload := Claims{
+		"iss": i.issuer,
+		"sub": "sub-1",
+		"iat": now.Unix(),
+		"exp": now.Add(i.ttl).Unix(),
+	}
+	for k, v := range claims {
+		payload[k] = v
+	}
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal the claims: %v", err)
+	}
+	signature, err := i.signer.Sign(b)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign the claims: %v", err)
+	}
+	return signature.CompactSerialize()
+}
+
+func (i *issuerImpl) TokenOrFail(t test.Failer, claims Claims) string {
+	t.Helper()
+	token, err := i.Token(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
@@ -0,0 +1,95 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jwt
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v3"
+	josejwt "github.com/go-jose/go-jose/v3/jwt"
+
+	"istio.io/istio/pkg/test/env"
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestIssuerToken(t *testing.T) {
+	signer, err := newSigner()
+	assert.NoError(t, err)
+	i := &issuerImpl{issuer: "issuer@istio.io", ttl: time.Minute, signer: signer}
+
+	// The tokens are verified with the JWKS served by the JWT Server
+	jwksJSON, err := os.ReadFile(filepath.Join(env.IstioSrc, "tests/common/jwt/jwks.json"))
+	assert.NoError(t, err)
+	var jwks jose.JSONWebKeySet
+	assert.NoError(t, json.Unmarshal(jwksJSON, &jwks))
+
+	expired := time.Now().Add(-time.Hour)
+	cases := []struct {
+		name        string
+		claims      Claims
+		wantSubject string
+		wantCustom  map[string]any
+		wantExpired bool
+	}{
+		{
+			name:        "default claims",
+			wantSubject: "sub-1",
+		},
+		{
+			name:        "custom claims",
+			claims:      Claims{"sub": "alice", "groups": []string{"admin", "dev"}},
+			wantSubject: "alice",
+			wantCustom:  map[string]any{"groups": []any{"admin", "dev"}},
+		},
+		{
+			name:        "expired",
+			claims:      Claims{"exp": expired.Unix()},
+			wantSubject: "sub-1",
+			wantExpired: true,
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			now := time.Now()
+			parsed, err := josejwt.ParseSigned(i.TokenOrFail(t, tt.claims))
+			assert.NoError(t, err)
+			assert.Equal(t, parsed.Headers[0].KeyID, jwks.Keys[0].KeyID)
+
+			var std josejwt.Claims
+			custom := map[string]any{}
+			assert.NoError(t, parsed.Claims(jwks.Keys[0], &std, &custom))
+			assert.Equal(t, std.Issuer, i.Issuer())
+			assert.Equal(t, std.Subject, tt.wantSubject)
+			for k, v := range tt.wantCustom {
+				assert.Equal(t, custom[k], v)
+			}
+
+			err = std.ValidateWithLeeway(josejwt.Expected{Issuer: i.Issuer(), Time: now}, 0)
+			if tt.wantExpired {
+				assert.Equal(t, err, josejwt.ErrExpired)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, std.Expiry.Time().Sub(std.IssuedAt.Time()), i.ttl)
+			// The token expires once its TTL elapsed
+			assert.Equal(t, std.ValidateWithLeeway(josejwt.Expected{Time: now.Add(2 * i.ttl)}, 0), josejwt.ErrExpired)
+		})
+	}
+}
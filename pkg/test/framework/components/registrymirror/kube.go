@@ -0,0 +1,185 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registrymirror
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/hashicorp/go-multierror"
+
+	"istio.io/istio/pkg/kube"
+	"istio.io/istio/pkg/test/env"
+	"istio.io/istio/pkg/test/framework/components/cluster"
+	"istio.io/istio/pkg/test/framework/components/namespace"
+	"istio.io/istio/pkg/test/framework/resource"
+	testKube "istio.io/istio/pkg/test/kube"
+	"istio.io/istio/pkg/test/scopes"
+)
+
+const (
+	service     = "registry-mirror"
+	ns          = "registry-mirror"
+	podSelector = "app=registry-mirror"
+	port        = 5000
+)
+
+var (
+	_ Instance  = &kubeComponent{}
+	_ io.Closer = &kubeComponent{}
+)
+
+type kubeComponent struct {
+	id       resource.ID
+	ns       namespace.Instance
+	settings resource.RegistryMirrorSettings
+	// forwarders to the mirror of each cluster, used to seed the mirrors from the test runner
+	forwarders []kube.PortForwarder
+}
+
+func newKube(ctx resource.Context, s resource.RegistryMirrorSettings) (Instance, error) {
+	// The images are rewritten to the mirror as soon as it is deployed
+	s.Enabled = true
+	c := &kubeComponent{settings: s}
+	c.id = ctx.TrackResource(c)
+	var err error
+	scopes.Framework.Info("=== BEGIN: Deploy registry mirror ===")
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("registry mirror deployment failed: %v", err)
+			scopes.Framework.Infof("=== FAILED: Deploy registry mirror ===")
+			_ = c.Close()
+		} else {
+			scopes.Framework.Info("=== SUCCEEDED: Deploy registry mirror ===")
+		}
+	}()
+
+	// The namespace is created in all the clusters
+	c.ns, err = namespace.New(ctx, namespace.Config{
+		Prefix: ns,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not create %q namespace for registry mirror install; err: %v", ns, err)
+	}
+
+	args := map[string]any{
+		"Image":    s.Image,
+		"Upstream": s.Upstream,
+		"NodePort": s.NodePort,
+	}
+	for _, cluster := range ctx.Clusters().Kube() {
+		var forwarder kube.PortForwarder
+		if forwarder, err = c.deploy(ctx, cluster, args); err != nil {
+			return nil, fmt.Errorf("cluster %s: %v", cluster.Name(), err)
+		}
+		c.forwarders = append(c.forwarders, forwarder)
+	}
+	scopes.Framework.Infof("registry mirror node address: %s", c.Address())
+
+	return c, nil
+}
+
+// deploy deploys the mirror in the cluster, returning a started port forwarder to the mirror.
+func (c *kubeComponent) deploy(ctx resource.Context, cluster cluster.Cluster, args map[string]any) (kube.PortForwarder, error) {
+	if err := ctx.ConfigKube(cluster).EvalFile(c.ns.Name(), args, env.RegistryMirrorInstallFilePath).Apply(); err != nil {
+		return nil, fmt.Errorf("failed to apply rendered %s, err: %v", env.RegistryMirrorInstallFilePath, err)
+	}
+
+	fetchFn := testKube.NewPodFetch(cluster, c.ns.Name(), podSelector)
+	pods, err := testKube.WaitUntilPodsAreReady(fetchFn)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := testKube.WaitUntilServiceEndpointsAreReady(cluster.Kube(), c.ns.Name(), service); err != nil {
+		scopes.Framework.Infof("Error waiting for registry mirror service to be available: %v", err)
+		return nil, err
+	}
+
+	forwarder, err := cluster.NewPortForwarder(pods[0].Name, pods[0].Namespace, "", 0, port)
+	if err != nil {
+		return nil, err
+	}
+	if err := forwarder.Start(); err != nil {
+		return nil, err
+	}
+	return forwarder, nil
+}
+
+func (c *kubeComponent) ID() resource.ID {
+	return c.id
+}
+
+// Close implements io.Closer.
+func (c *kubeComponent) Close() error {
+	for _, f := range c.forwarders {
+		f.Close()
+	}
+	return nil
+}
+
+func (c *kubeComponent) Address() string {
+	return c.settings.Address()
+}
+
+func (c *kubeComponent) Image(image string) string {
+	return c.settings.Image(image)
+}
+
+func (c *kubeComponent) Seed(images ...string) error {
+	var errs error
+	for _, f := range c.forwarders {
+		for _, image := range images {
+			// The mirror is reached over a port forward on localhost, which is pulled from without TLS
+			mirrored := f.Address() + "/" + resource.RepositoryPath(image)
+			var err error
+			if c.settings.Upstream != "" {
+				err = pullThrough(mirrored)
+			} else {
+				err = crane.Copy(image, mirrored)
+			}
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("%s: %v", image, err))
+				continue
+			}
+			scopes.Framework.Infof("seeded the registry mirror with %s", image)
+		}
+	}
+	return errs
+}
+
+// pullThrough pulls the image through the mirror, so that the mirror caches all its layers.
+func pullThrough(image string) error {
+	img, err := crane.Pull(image)
+	if err != nil {
+		return err
+	}
+	layers, err := img.Layers()
+	if err != nil {
+		return err
+	}
+	for _, l := range layers {
+		rc, err := l.Compressed()
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(io.Discard, rc)
+		_ = rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
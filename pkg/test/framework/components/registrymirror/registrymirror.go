@@ -0,0 +1,101 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package registrymirror provides a registry mirror deployed in the clusters, which the images of the tests are pulled
+// from. This allows running the tests in air-gapped clusters, which cannot access the public registries.
+package registrymirror
+
+import (
+	"fmt"
+
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/framework/resource"
+	"istio.io/istio/pkg/test/scopes"
+)
+
+// Instance represents a registry mirror deployed in all the clusters.
+type Instance interface {
+	// Address is the address the nodes pull the images of the mirror from.
+	Address() string
+
+	// Image returns the reference of the image in the mirror.
+	Image(image string) string
+
+	// Seed copies the images to the mirror of each cluster. If the mirror pulls through from an upstream registry,
+	// the images are pulled through the mirror instead, and must be served by the upstream registry.
+	Seed(images ...string) error
+}
+
+// New deploys a registry mirror in all the clusters, as configured by the registry mirror settings.
+func New(ctx resource.Context) (Instance, error) {
+	return newKube(ctx, ctx.Settings().RegistryMirror)
+}
+
+// NewOrFail returns a new registry mirror instance or fails test.
+func NewOrFail(t test.Failer, ctx resource.Context) Instance {
+	t.Helper()
+	i, err := New(ctx)
+	if err != nil {
+		t.Fatalf("registrymirror.NewOrFail: %v", err)
+	}
+
+	return i
+}
+
+// Setup returns a function deploying a registry mirror if enabled by the settings. The mirror is seeded with the images
+// required by the tests and the given images, and the images of the settings are rewritten to the mirror. It must run
+// before Istio and the test applications are deployed.
+func Setup(images ...string) resource.SetupFn {
+	return func(ctx resource.Context) error {
+		s := ctx.Settings()
+		if !s.RegistryMirror.Enabled {
+			return nil
+		}
+		i, err := New(ctx)
+		if err != nil {
+			return err
+		}
+		if err := i.Seed(append(requiredImages(s), images...)...); err != nil {
+			return fmt.Errorf("failed to seed the registry mirror: %v", err)
+		}
+
+		s.Image.Hub = s.RegistryMirror.Hub(s.Image.Hub)
+		if s.EchoImage != "" {
+			s.EchoImage = i.Image(s.EchoImage)
+		}
+		if s.CustomGRPCEchoImage != "" {
+			s.CustomGRPCEchoImage = i.Image(s.CustomGRPCEchoImage)
+		}
+		scopes.Framework.Infof("pulling the images of the tests from the registry mirror at %s", i.Address())
+		return nil
+	}
+}
+
+// requiredImages returns the images the tests pull by default: the Istio images, the test application images, and the
+// images listed by the settings.
+func requiredImages(s *resource.Settings) []string {
+	images := []string{
+		fmt.Sprintf("%s/pilot:%s", s.Image.Hub, s.Image.Tag),
+		fmt.Sprintf("%s/proxyv2:%s", s.Image.Hub, s.Image.Tag),
+	}
+	if s.EchoImage != "" {
+		images = append(images, s.EchoImage)
+	} else {
+		images = append(images, s.Image.AppImage("app"))
+	}
+	if s.CustomGRPCEchoImage != "" {
+		images = append(images, s.CustomGRPCEchoImage)
+	}
+	return append(images, s.RegistryMirror.Seed...)
+}
@@ -0,0 +1,142 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// QuarantineHistory maps the tests of a suite to the number of consecutive runs of the suite they flaked in, i.e.
+// failed in at least one attempt, but passed when retried.
+type QuarantineHistory map[string]int
+
+// QuarantinedTest reports a test run in quarantine.
+type QuarantinedTest struct {
+	Name string
+	// ConsecutiveFlakes is the number of consecutive runs the test flaked in before this run
+	ConsecutiveFlakes int
+	// Failed is set if the test failed in at least one attempt of this run
+	Failed bool
+}
+
+// quarantineFileName returns the name of the flake history file of a suite.
+func quarantineFileName(suite string) string {
+	return "quarantine-" + strings.ReplaceAll(suite, "/", "_") + ".yaml"
+}
+
+// loadQuarantineHistory reads the flake history of a suite. A missing file is an empty history.
+func loadQuarantineHistory(dir, suite string) (QuarantineHistory, error) {
+	h := QuarantineHistory{}
+	b, err := os.ReadFile(filepath.Join(dir, quarantineFileName(suite)))
+	if os.IsNotExist(err) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(b, &h); err != nil {
+		return nil, fmt.Errorf("invalid quarantine file: %v", err)
+	}
+	return h, nil
+}
+
+// writeQuarantineHistory writes the flake history of a suite.
+func writeQuarantineHistory(dir, suite string, h QuarantineHistory) error {
+	b, err := yaml.Marshal(h)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, quarantineFileName(suite)), b, 0o644)
+}
+
+// quarantined returns whether the test, or one of its parents, flaked in at least threshold consecutive runs.
+func (h QuarantineHistory) quarantined(name string, threshold int) bool {
+	if threshold <= 0 {
+		return false
+	}
+	for {
+		if h[name] >= threshold {
+			return true
+		}
+		i := strings.LastIndex(name, "/")
+		if i < 0 {
+			return false
+		}
+		name = name[:i]
+	}
+}
+
+// update returns the history following a run of the suite with the given results. The flake of a test is only
+// counted against the failing subtests it contains, if any, so that a flaky subtest does not get its parent
+// quarantined. A test failing in every attempt is broken rather than flaky, so its history is reset: it is no longer
+// run in quarantine, and keeps failing the suite until fixed. The tests which did not run, or were skipped, keep their
+// history.
+func (h QuarantineHistory) update(results []TestResult) QuarantineHistory {
+	out := QuarantineHistory{}
+	for name, flakes := range h {
+		out[name] = flakes
+	}
+	var failing []string
+	for _, r := range results {
+		if r.Failures > 0 {
+			failing = append(failing, r.Name)
+		}
+	}
+	for _, r := range results {
+		switch {
+		case r.Flaky && !hasFailingSubtest(r.Name, failing):
+			out[r.Name]++
+		case r.Failures > 0 && !r.Flaky:
+			delete(out, r.Name)
+		case r.Failures == 0 && r.Outcome == Passed:
+			delete(out, r.Name)
+		}
+	}
+	return out
+}
+
+func hasFailingSubtest(name string, failing []string) bool {
+	for _, f := range failing {
+		if strings.HasPrefix(f, name+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// quarantineReport lists the tests of the results which were run in quarantine.
+func quarantineReport(h QuarantineHistory, threshold int, results []TestResult) []QuarantinedTest {
+	var report []QuarantinedTest
+	for _, r := range results {
+		if !h.quarantined(r.Name, threshold) {
+			continue
+		}
+		report = append(report, QuarantinedTest{
+			Name:              r.Name,
+			ConsecutiveFlakes: h[r.Name],
+			Failed:            r.Failures > 0,
+		})
+	}
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].Name < report[j].Name
+	})
+	return report
+}
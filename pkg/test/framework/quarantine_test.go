@@ -0,0 +1,99 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestQuarantineHistoryQuarantined(t *testing.T) {
+	g := NewWithT(t)
+
+	h := QuarantineHistory{"TestA/flaky": 3, "TestB": 3, "TestC/sub": 2}
+	g.Expect(h.quarantined("TestA/flaky", 3)).To(BeTrue())
+	g.Expect(h.quarantined("TestA/flaky/nested", 3)).To(BeTrue())
+	g.Expect(h.quarantined("TestA", 3)).To(BeFalse())
+	g.Expect(h.quarantined("TestA/stable", 3)).To(BeFalse())
+	g.Expect(h.quarantined("TestB/sub", 3)).To(BeTrue())
+	g.Expect(h.quarantined("TestC/sub", 3)).To(BeFalse())
+	g.Expect(h.quarantined("TestA/flaky", 0)).To(BeFalse())
+}
+
+func TestQuarantineHistoryUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	h := QuarantineHistory{"TestA/sub": 1, "TestB": 2, "TestC": 2, "TestD": 1, "TestF": 2, "TestG": 3}
+	results := summarizeOutcomes([]TestOutcome{
+		// TestA fails because of its subtest, which passes when retried
+		{Name: "TestA", Outcome: Failed, Attempt: 1},
+		{Name: "TestA/sub", Outcome: Failed, Attempt: 1},
+		{Name: "TestA", Outcome: Passed, Attempt: 2},
+		{Name: "TestA/sub", Outcome: Passed, Attempt: 2},
+		// TestB passes cleanly
+		{Name: "TestB", Outcome: Passed, Attempt: 1},
+		{Name: "TestB", Outcome: Passed, Attempt: 2},
+		// TestC fails in quarantine
+		{Name: "TestC", Outcome: Quarantined, Attempt: 1},
+		{Name: "TestC", Outcome: Passed, Attempt: 2},
+		// TestD is skipped
+		{Name: "TestD", Outcome: Skipped, Attempt: 1},
+		{Name: "TestD", Outcome: Skipped, Attempt: 2},
+		// TestE fails in every attempt, which is not a flake
+		{Name: "TestE", Outcome: Failed, Attempt: 1},
+		{Name: "TestE", Outcome: Failed, Attempt: 2},
+		// TestF flaked before, but now fails in every attempt
+		{Name: "TestF", Outcome: Failed, Attempt: 1},
+		{Name: "TestF", Outcome: Failed, Attempt: 2},
+		// TestG fails in every attempt in quarantine, so it is no longer quarantined
+		{Name: "TestG", Outcome: Quarantined, Attempt: 1},
+		{Name: "TestG", Outcome: Quarantined, Attempt: 2},
+	})
+	g.Expect(h.update(results)).To(Equal(QuarantineHistory{"TestA/sub": 2, "TestC": 3, "TestD": 1}))
+	// The history of the previous run is not modified
+	g.Expect(h).To(Equal(QuarantineHistory{"TestA/sub": 1, "TestB": 2, "TestC": 2, "TestD": 1, "TestF": 2, "TestG": 3}))
+}
+
+func TestQuarantineReport(t *testing.T) {
+	g := NewWithT(t)
+
+	h := QuarantineHistory{"TestA": 3, "TestB": 4}
+	results := summarizeOutcomes([]TestOutcome{
+		{Name: "TestB", Outcome: Passed, Attempt: 1},
+		{Name: "TestA", Outcome: Quarantined, Attempt: 1},
+		{Name: "TestA/sub", Outcome: Quarantined, Attempt: 1},
+		{Name: "TestC", Outcome: Failed, Attempt: 1},
+	})
+	g.Expect(quarantineReport(h, 3, results)).To(Equal([]QuarantinedTest{
+		{Name: "TestA", ConsecutiveFlakes: 3, Failed: true},
+		{Name: "TestA/sub", ConsecutiveFlakes: 0, Failed: true},
+		{Name: "TestB", ConsecutiveFlakes: 4, Failed: false},
+	}))
+}
+
+func TestQuarantineHistoryFile(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	h, err := loadQuarantineHistory(dir, "suite/a")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(h).To(BeEmpty())
+
+	g.Expect(writeQuarantineHistory(dir, "suite/a", QuarantineHistory{"TestA/sub": 2})).To(Succeed())
+	h, err = loadQuarantineHistory(dir, "suite/a")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(h).To(Equal(QuarantineHistory{"TestA/sub": 2}))
+}
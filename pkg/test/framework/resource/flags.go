@@ -75,6 +75,11 @@ func SettingsFromCommandLine(testID string) (*Settings, error) {
 		s.Image.Tag = env.TAG.ValueOrDefault("latest")
 	}
 
+	if s.Image.Variant == "" {
+		s.Image.Variant = env.VARIANT.ValueOrDefault("")
+	}
+	s.Image.normalizeVariant()
+
 	if s.Image.PullPolicy == "" {
 		s.Image.PullPolicy = env.PULL_POLICY.ValueOrDefault("Always")
 	}
@@ -87,6 +92,14 @@ func SettingsFromCommandLine(testID string) (*Settings, error) {
 		s.CustomGRPCEchoImage = env.GRPC_ECHO_IMAGE.ValueOrDefault("")
 	}
 
+	if s.RegistryMirror.Image == "" {
+		s.RegistryMirror.Image = "docker.io/library/registry:2"
+	}
+
+	if s.RegistryMirror.NodePort == 0 {
+		s.RegistryMirror.NodePort = 30500
+	}
+
 	if s.HelmRepo == "" {
 		s.HelmRepo = "https://istio-release.storage.googleapis.com/charts"
 	}
@@ -127,6 +140,10 @@ func validate(s *Settings) error {
 		return fmt.Errorf("values for Hub & Tag are not detected. Please supply them through command-line or via environment")
 	}
 
+	if err := s.Image.validateVariant(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -160,6 +177,13 @@ func init() {
 	flag.IntVar(&settingsFromCommandLine.Retries, "istio.test.retries", settingsFromCommandLine.Retries,
 		"Number of times to retry tests")
 
+	flag.StringVar(&settingsFromCommandLine.QuarantineDir, "istio.test.quarantine.dir", settingsFromCommandLine.QuarantineDir,
+		"Directory of the flake history of the suites. If set, the tests flaking in consecutive runs are quarantined: "+
+			"they are run, but do not fail the suite.")
+
+	flag.IntVar(&settingsFromCommandLine.QuarantineThreshold, "istio.test.quarantine.threshold", settingsFromCommandLine.QuarantineThreshold,
+		"Number of consecutive flaky runs after which a test is quarantined")
+
 	flag.BoolVar(&settingsFromCommandLine.StableNamespaces, "istio.test.stableNamespaces", settingsFromCommandLine.StableNamespaces,
 		"If set, will use consistent namespace rather than randomly generated. Useful with nocleanup to develop tests.")
 
@@ -196,12 +220,25 @@ func init() {
 		"Container registry hub to use")
 	flag.StringVar(&settingsFromCommandLine.Image.Tag, "istio.test.tag", settingsFromCommandLine.Image.Tag,
 		"Common Container tag to use when deploying container images")
+	flag.StringVar(&settingsFromCommandLine.Image.Variant, "istio.test.variant", settingsFromCommandLine.Image.Variant,
+		"Variant of the Istio images (debug, distroless or fips), appended to the tag. Inferred from the tag if not set")
 	flag.StringVar(&settingsFromCommandLine.Image.PullPolicy, "istio.test.pullpolicy", settingsFromCommandLine.Image.PullPolicy,
 		"Common image pull policy to use when deploying container images")
 	flag.StringVar(&settingsFromCommandLine.Image.PullSecret, "istio.test.imagePullSecret", settingsFromCommandLine.Image.PullSecret,
 		"Path to a file containing a DockerConfig secret use for test apps. This will be pushed to all created namespaces."+
 			"Secret should already exist when used with istio.test.stableNamespaces.")
 
+	flag.BoolVar(&settingsFromCommandLine.RegistryMirror.Enabled, "istio.test.registryMirror", settingsFromCommandLine.RegistryMirror.Enabled,
+		"Deploy a registry mirror in the clusters and pull the images of the tests from it, for clusters without access to the public registries.")
+	flag.StringVar(&settingsFromCommandLine.RegistryMirror.Image, "istio.test.registryMirror.image", settingsFromCommandLine.RegistryMirror.Image,
+		"Image of the registry deployed as the mirror. It must be pullable by the clusters.")
+	flag.StringVar(&settingsFromCommandLine.RegistryMirror.Upstream, "istio.test.registryMirror.upstream", settingsFromCommandLine.RegistryMirror.Upstream,
+		"URL of a registry the mirror pulls the images through from. If not set, the images are pushed to the mirror.")
+	flag.IntVar(&settingsFromCommandLine.RegistryMirror.NodePort, "istio.test.registryMirror.nodePort", settingsFromCommandLine.RegistryMirror.NodePort,
+		"NodePort the registry mirror is exposed on.")
+	flag.Var(&settingsFromCommandLine.RegistryMirror.Seed, "istio.test.registryMirror.seed",
+		"Additional images to copy to the registry mirror before the tests.")
+
 	flag.Uint64Var(&settingsFromCommandLine.MaxDumps, "istio.test.maxDumps", settingsFromCommandLine.MaxDumps,
 		"Maximum number of full test dumps that are allowed to occur within a test suite.")
 
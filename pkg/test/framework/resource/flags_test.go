@@ -82,3 +82,101 @@ func TestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestImageVariant(t *testing.T) {
+	tcs := []struct {
+		name        string
+		image       ImageSettings
+		expectErr   bool
+		wantTag     string
+		wantBaseTag string
+		wantVariant string
+	}{
+		{
+			name:        "default variant",
+			image:       ImageSettings{Hub: "hub", Tag: "1.20"},
+			wantTag:     "1.20",
+			wantBaseTag: "1.20",
+		},
+		{
+			name:        "variant inferred from the tag",
+			image:       ImageSettings{Hub: "hub", Tag: "1.20-distroless"},
+			wantTag:     "1.20-distroless",
+			wantBaseTag: "1.20",
+			wantVariant: DistrolessVariant,
+		},
+		{
+			name:        "variant appended to the tag",
+			image:       ImageSettings{Hub: "hub", Tag: "1.20", Variant: FIPSVariant},
+			wantTag:     "1.20-fips",
+			wantBaseTag: "1.20",
+			wantVariant: FIPSVariant,
+		},
+		{
+			name:        "variant already in the tag",
+			image:       ImageSettings{Hub: "hub", Tag: "1.20-debug", Variant: DebugVariant},
+			wantTag:     "1.20-debug",
+			wantBaseTag: "1.20",
+			wantVariant: DebugVariant,
+		},
+		{
+			name:      "unknown variant",
+			image:     ImageSettings{Hub: "hub", Tag: "1.20", Variant: "alpine"},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &Settings{Image: tc.image}
+			s.Image.normalizeVariant()
+			err := validate(s)
+			if tc.expectErr {
+				if err == nil {
+					t.Error("expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.Image.Tag != tc.wantTag || s.Image.BaseTag() != tc.wantBaseTag || s.Image.Variant != tc.wantVariant {
+				t.Errorf("got tag %q, base tag %q and variant %q, want %q, %q and %q",
+					s.Image.Tag, s.Image.BaseTag(), s.Image.Variant, tc.wantTag, tc.wantBaseTag, tc.wantVariant)
+			}
+			if got, want := s.Image.AppImage("app"), "hub/app:"+tc.wantBaseTag; got != want {
+				t.Errorf("got app image %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestRegistryMirrorImage(t *testing.T) {
+	tcs := []struct {
+		image string
+		want  string
+	}{
+		{image: "registry:2", want: "localhost:30500/library/registry:2"},
+		{image: "openshift/origin-haproxy-router:v4.0.0", want: "localhost:30500/openshift/origin-haproxy-router:v4.0.0"},
+		{image: "docker.io/istio/app:1.20", want: "localhost:30500/istio/app:1.20"},
+		{image: "docker.io/library/busybox", want: "localhost:30500/library/busybox"},
+		{image: "quay.io/maistra/proxyv2-ubi8:2.5", want: "localhost:30500/maistra/proxyv2-ubi8:2.5"},
+		{image: "localhost:5000/app@sha256:abcd", want: "localhost:30500/library/app@sha256:abcd"},
+	}
+
+	s := RegistryMirrorSettings{NodePort: 30500}
+	if got := s.Image("registry:2"); got != "registry:2" {
+		t.Errorf("expected the image to be unchanged when the mirror is disabled, got %s", got)
+	}
+	s.Enabled = true
+	if got := s.Hub("istio"); got != "localhost:30500/istio" {
+		t.Errorf("got hub %s, want localhost:30500/istio", got)
+	}
+	for _, tc := range tcs {
+		t.Run(tc.image, func(t *testing.T) {
+			if got := s.Image(tc.image); got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -16,14 +16,17 @@ package resource
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
 	"gopkg.in/yaml.v3"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
+	"istio.io/istio/pkg/slices"
 	"istio.io/istio/pkg/test"
 	"istio.io/istio/pkg/test/framework/label"
 	"istio.io/istio/pkg/util/sets"
@@ -47,6 +50,74 @@ type ImageSettings struct {
 
 	// PullSecret path to a file containing a k8s secret in yaml so test pods can pull from protected registries.
 	PullSecret string
+
+	// Variant of the Istio images, appended to Tag as a suffix. If not specified, it is inferred from the suffix of Tag.
+	Variant string
+}
+
+const (
+	// DebugVariant is the variant of the Istio images including debug tools.
+	DebugVariant = "debug"
+	// DistrolessVariant is the variant of the Istio images based on a distroless image.
+	DistrolessVariant = "distroless"
+	// FIPSVariant is the variant of the Istio images built with FIPS validated cryptography.
+	FIPSVariant = "fips"
+)
+
+// imageVariants are the known variants of the Istio images.
+var imageVariants = []string{DebugVariant, DistrolessVariant, FIPSVariant}
+
+// normalizeVariant infers the Variant from the suffix of the Tag, or appends it to the Tag. Unknown variants are left
+// as they are, to be reported by validateVariant.
+func (s *ImageSettings) normalizeVariant() {
+	if s.Variant == "" {
+		for _, v := range imageVariants {
+			if strings.HasSuffix(s.Tag, "-"+v) {
+				s.Variant = v
+				return
+			}
+		}
+		return
+	}
+	if slices.Contains(imageVariants, s.Variant) && !strings.HasSuffix(s.Tag, "-"+s.Variant) {
+		s.Tag += "-" + s.Variant
+	}
+}
+
+func (s *ImageSettings) validateVariant() error {
+	if s.Variant != "" && !slices.Contains(imageVariants, s.Variant) {
+		return fmt.Errorf("unknown image variant %q, expected one of %v", s.Variant, imageVariants)
+	}
+	return nil
+}
+
+// BaseTag returns the Tag without the Variant suffix. It should be used for the images which are only built in the
+// default variant, such as the test applications.
+func (s *ImageSettings) BaseTag() string {
+	if s.Variant == "" {
+		return s.Tag
+	}
+	return strings.TrimSuffix(s.Tag, "-"+s.Variant)
+}
+
+// AppImage returns the image of a test application, which is only built in the default variant.
+func (s *ImageSettings) AppImage(name string) string {
+	return fmt.Sprintf("%s/%s:%s", s.Hub, name, s.BaseTag())
+}
+
+// IsDistroless returns true if the Istio images are distroless.
+func (s *ImageSettings) IsDistroless() bool {
+	return s.Variant == DistrolessVariant
+}
+
+// IsFIPS returns true if the Istio images are built with FIPS validated cryptography.
+func (s *ImageSettings) IsFIPS() bool {
+	return s.Variant == FIPSVariant
+}
+
+// IsDebug returns true if the Istio images include debug tools.
+func (s *ImageSettings) IsDebug() bool {
+	return s.Variant == DebugVariant
 }
 
 func (s *ImageSettings) PullSecretName() (string, error) {
@@ -73,6 +144,64 @@ func (s *ImageSettings) PullSecretNameOrFail(t test.Failer) string {
 	return out
 }
 
+// RegistryMirrorSettings configures a registry mirror deployed in the clusters, which the images of the tests are
+// pulled from. This allows running the tests in clusters which cannot access the public registries.
+type RegistryMirrorSettings struct {
+	// Enabled deploys the mirror and rewrites the images of the tests to it.
+	Enabled bool
+
+	// Image of the registry deployed as the mirror. It must be pullable by the clusters.
+	Image string
+
+	// Upstream is the URL of a registry the mirror pulls the images it does not have through from. If set, the mirror
+	// is read-only and the images are seeded by pulling them through the mirror.
+	Upstream string
+
+	// NodePort the mirror is exposed on. The nodes pull the images of the mirror from localhost on this port, which
+	// container runtimes trust without TLS.
+	NodePort int
+
+	// Seed lists additional images to copy to the mirror before the tests, from registries reachable by the test
+	// runner. The Istio and test application images are always seeded.
+	Seed ArrayFlags
+}
+
+// Address returns the address the nodes pull the images of the mirror from.
+func (s *RegistryMirrorSettings) Address() string {
+	return net.JoinHostPort("localhost", strconv.Itoa(s.NodePort))
+}
+
+// Image returns the reference of the image in the mirror, or the image itself if the mirror is not enabled. The
+// registry of the image is replaced by the mirror, keeping the repository path.
+func (s *RegistryMirrorSettings) Image(image string) string {
+	if !s.Enabled {
+		return image
+	}
+	return s.Address() + "/" + RepositoryPath(image)
+}
+
+// Hub returns the hub in the mirror, or the hub itself if the mirror is not enabled.
+func (s *RegistryMirrorSettings) Hub(hub string) string {
+	// The hub is the image reference without the image name, which is never a Docker Hub library image
+	return strings.TrimSuffix(s.Image(hub+"/image"), "/image")
+}
+
+// RepositoryPath returns the image reference without its registry. Images of Docker Hub get their implicit "library/"
+// prefix, as the path is looked up in registries other than Docker Hub.
+func RepositoryPath(image string) string {
+	first, rest, found := strings.Cut(image, "/")
+	if found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		if first != "docker.io" && first != "index.docker.io" {
+			return rest
+		}
+		image = rest
+	}
+	if !strings.Contains(image, "/") {
+		return "library/" + image
+	}
+	return image
+}
+
 // Settings is the set of arguments to the test driver.
 type Settings struct {
 	// Name of the test
@@ -97,6 +226,15 @@ type Settings struct {
 	// This should not be depended on as a primary means for reducing test flakes.
 	Retries int
 
+	// QuarantineDir holds the flake history of the suites, updated after each run. The tests which flaked, i.e. failed
+	// in at least one attempt but passed when retried, in QuarantineThreshold consecutive runs are quarantined: they
+	// are run, but their failures do not fail the suite, nor consume its retries. A clean pass, or a failure in every
+	// attempt, takes a test out of quarantine.
+	QuarantineDir string
+
+	// QuarantineThreshold is the number of consecutive flaky runs after which a test is quarantined.
+	QuarantineThreshold int
+
 	// If enabled, namespaces will be reused rather than created with dynamic names each time.
 	// This is useful when combined with NoCleanup, to allow quickly iterating on tests.
 	StableNamespaces bool
@@ -166,6 +304,9 @@ type Settings struct {
 	// CustomGRPCEchoImage if specified will run an extra container in the echo Pods responsible for gRPC ports
 	CustomGRPCEchoImage string
 
+	// RegistryMirror settings
+	RegistryMirror RegistryMirrorSettings
+
 	// MaxDumps is the maximum number of full test dumps that are allowed to occur within a test suite.
 	MaxDumps uint64
 
@@ -231,8 +372,9 @@ func (s *Settings) Clone() *Settings {
 // DefaultSettings returns a default settings instance.
 func DefaultSettings() *Settings {
 	return &Settings{
-		RunID:    uuid.New(),
-		MaxDumps: 10,
+		RunID:               uuid.New(),
+		MaxDumps:            10,
+		QuarantineThreshold: 3,
 	}
 }
 
@@ -248,6 +390,7 @@ func (s *Settings) String() string {
 	result += fmt.Sprintf("FailOnDeprecation: 						 %v\n", s.FailOnDeprecation)
 	result += fmt.Sprintf("CIMode:            						 %v\n", s.CIMode)
 	result += fmt.Sprintf("Retries:           						 %v\n", s.Retries)
+	result += fmt.Sprintf("Quarantine:        						 %s (threshold: %d)\n", s.QuarantineDir, s.QuarantineThreshold)
 	result += fmt.Sprintf("StableNamespaces:  						 %v\n", s.StableNamespaces)
 	result += fmt.Sprintf("Revision:          						 %v\n", s.Revision)
 	result += fmt.Sprintf("SkipWorkloads      						 %v\n", s.SkipWorkloadClasses)
@@ -255,8 +398,10 @@ func (s *Settings) String() string {
 	result += fmt.Sprintf("Revisions:         						 %v\n", s.Revisions.String())
 	result += fmt.Sprintf("Hub:               						 %s\n", s.Image.Hub)
 	result += fmt.Sprintf("Tag:               						 %s\n", s.Image.Tag)
+	result += fmt.Sprintf("Variant:           						 %s\n", s.Image.Variant)
 	result += fmt.Sprintf("PullPolicy:        						 %s\n", s.Image.PullPolicy)
 	result += fmt.Sprintf("PullSecret:        						 %s\n", s.Image.PullSecret)
+	result += fmt.Sprintf("RegistryMirror:    						 %v\n", s.RegistryMirror.Enabled)
 	result += fmt.Sprintf("MaxDumps:          						 %d\n", s.MaxDumps)
 	result += fmt.Sprintf("HelmRepo:          						 %v\n", s.HelmRepo)
 	result += fmt.Sprintf("GatewayConformanceStandardOnly: %v\n", s.GatewayConformanceStandardOnly)
@@ -0,0 +1,172 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// TestResult summarizes the outcomes of a test over all runs of the suite.
+type TestResult struct {
+	Name string
+	// Outcome of the last run of the test
+	Outcome Outcome
+	// Attempts is the number of times the test was run
+	Attempts int
+	// Failures is the number of runs the test failed in
+	Failures int
+	// Flaky is set if the test passed after failing in an earlier run
+	Flaky bool
+	// DurationSeconds of the last run of the test
+	DurationSeconds float64
+	// FailedAttempts lists the runs of the suite the test failed in, with their durations
+	FailedAttempts []FailedAttempt
+	WorkDir        string
+}
+
+type FailedAttempt struct {
+	Attempt         int
+	DurationSeconds float64
+}
+
+// summarizeOutcomes merges the outcomes of the runs of each test, in order of the first run of the tests.
+func summarizeOutcomes(outcomes []TestOutcome) []TestResult {
+	var results []TestResult
+	index := map[string]int{}
+	for _, o := range outcomes {
+		i, f := index[o.Name]
+		if !f {
+			i = len(results)
+			index[o.Name] = i
+			results = append(results, TestResult{Name: o.Name})
+		}
+		r := &results[i]
+		r.Attempts++
+		if o.Outcome == Failed || o.Outcome == Quarantined {
+			r.Failures++
+			r.FailedAttempts = append(r.FailedAttempts, FailedAttempt{Attempt: o.Attempt, DurationSeconds: o.DurationSeconds})
+		}
+		r.Outcome = o.Outcome
+		r.DurationSeconds = o.DurationSeconds
+		r.WorkDir = o.WorkDir
+	}
+	for i := range results {
+		results[i].Flaky = results[i].Outcome == Passed && results[i].Failures > 0
+	}
+	return results
+}
+
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Flaky    int             `xml:"flaky,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name       string          `xml:"name,attr"`
+	ClassName  string          `xml:"classname,attr"`
+	Time       string          `xml:"time,attr"`
+	Properties []junitProperty `xml:"properties>property,omitempty"`
+	Failure    *junitMessage   `xml:"failure,omitempty"`
+	Skipped    *junitMessage   `xml:"skipped,omitempty"`
+	// FlakyFailures reports the failed runs of a test that eventually passed, as done by Maven Surefire
+	FlakyFailures []junitMessage `xml:"flakyFailure,omitempty"`
+	SystemOut     string         `xml:"system-out,omitempty"`
+}
+
+type junitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Time    string `xml:"time,attr,omitempty"`
+}
+
+func junitTime(seconds float64) string {
+	return strconv.FormatFloat(seconds, 'f', 3, 64)
+}
+
+// writeJUnit writes the results of a suite as a JUnit report. Retries and flakiness are reported as
+// properties of each test case, and the work dir of a test is attached following the convention of the
+// Jenkins JUnit attachments plugin.
+func writeJUnit(w io.Writer, suite string, results []TestResult) error {
+	s := junitTestSuite{Name: suite}
+	for _, r := range results {
+		tc := junitTestCase{
+			Name:      r.Name,
+			ClassName: suite,
+			Time:      junitTime(r.DurationSeconds),
+			Properties: []junitProperty{
+				{Name: "attempts", Value: strconv.Itoa(r.Attempts)},
+				{Name: "flaky", Value: strconv.FormatBool(r.Flaky)},
+			},
+		}
+		switch r.Outcome {
+		case Failed:
+			s.Failures++
+			tc.Failure = &junitMessage{Message: fmt.Sprintf("failed in %d of %d attempts", r.Failures, r.Attempts)}
+		case Skipped, NotImplemented:
+			s.Skipped++
+			tc.Skipped = &junitMessage{Message: string(r.Outcome)}
+		case Quarantined:
+			s.Skipped++
+			tc.Skipped = &junitMessage{Message: fmt.Sprintf("quarantined, failed in %d of %d attempts", r.Failures, r.Attempts)}
+		}
+		if r.Flaky {
+			s.Flaky++
+			for _, fa := range r.FailedAttempts {
+				tc.FlakyFailures = append(tc.FlakyFailures, junitMessage{
+					Message: fmt.Sprintf("failed in attempt %d", fa.Attempt),
+					Time:    junitTime(fa.DurationSeconds),
+				})
+			}
+		}
+		if r.WorkDir != "" {
+			tc.SystemOut = fmt.Sprintf("[[ATTACHMENT|%s]]", r.WorkDir)
+		}
+		s.Cases = append(s.Cases, tc)
+	}
+	s.Tests = len(s.Cases)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(junitTestSuites{Suites: []junitTestSuite{s}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// junitFileName returns the name of the JUnit report of a suite.
+func junitFileName(suite string) string {
+	return "junit-" + strings.ReplaceAll(suite, "/", "_") + ".xml"
+}
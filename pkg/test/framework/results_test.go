@@ -0,0 +1,108 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestSummarizeOutcomes(t *testing.T) {
+	g := NewWithT(t)
+
+	results := summarizeOutcomes([]TestOutcome{
+		{Name: "TestA", Outcome: Failed, Attempt: 1, DurationSeconds: 3},
+		{Name: "TestA/sub", Outcome: Failed, Attempt: 1, DurationSeconds: 2},
+		{Name: "TestB", Outcome: Skipped, Attempt: 1},
+		{Name: "TestA", Outcome: Passed, Attempt: 2, DurationSeconds: 1},
+		{Name: "TestA/sub", Outcome: Failed, Attempt: 2, DurationSeconds: 1},
+		{Name: "TestB", Outcome: Skipped, Attempt: 2},
+	})
+	g.Expect(results).To(Equal([]TestResult{
+		{
+			Name:            "TestA",
+			Outcome:         Passed,
+			Attempts:        2,
+			Failures:        1,
+			Flaky:           true,
+			DurationSeconds: 1,
+			FailedAttempts:  []FailedAttempt{{Attempt: 1, DurationSeconds: 3}},
+		},
+		{
+			Name:            "TestA/sub",
+			Outcome:         Failed,
+			Attempts:        2,
+			Failures:        2,
+			DurationSeconds: 1,
+			FailedAttempts:  []FailedAttempt{{Attempt: 1, DurationSeconds: 2}, {Attempt: 2, DurationSeconds: 1}},
+		},
+		{
+			Name:     "TestB",
+			Outcome:  Skipped,
+			Attempts: 2,
+		},
+	}))
+}
+
+func TestWriteJUnit(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+	err := writeJUnit(&out, "suite", []TestResult{
+		{
+			Name:            "TestA",
+			Outcome:         Passed,
+			Attempts:        2,
+			Failures:        1,
+			Flaky:           true,
+			DurationSeconds: 1.5,
+			FailedAttempts:  []FailedAttempt{{Attempt: 1, DurationSeconds: 3}},
+			WorkDir:         "/tmp/suite/TestA",
+		},
+		{Name: "TestB", Outcome: Failed, Attempts: 2, Failures: 2, DurationSeconds: 2},
+		{Name: "TestC", Outcome: Skipped, Attempts: 1},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(out.String()).To(Equal(`<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="suite" tests="3" failures="1" skipped="1" flaky="1">
+    <testcase name="TestA" classname="suite" time="1.500">
+      <properties>
+        <property name="attempts" value="2"></property>
+        <property name="flaky" value="true"></property>
+      </properties>
+      <flakyFailure message="failed in attempt 1" time="3.000"></flakyFailure>
+      <system-out>[[ATTACHMENT|/tmp/suite/TestA]]</system-out>
+    </testcase>
+    <testcase name="TestB" classname="suite" time="2.000">
+      <properties>
+        <property name="attempts" value="2"></property>
+        <property name="flaky" value="false"></property>
+      </properties>
+      <failure message="failed in 2 of 2 attempts"></failure>
+    </testcase>
+    <testcase name="TestC" classname="suite" time="0.000">
+      <properties>
+        <property name="attempts" value="1"></property>
+        <property name="flaky" value="false"></property>
+      </properties>
+      <skipped message="Skipped"></skipped>
+    </testcase>
+  </testsuite>
+</testsuites>
+`))
+}
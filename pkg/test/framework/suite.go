@@ -15,6 +15,7 @@
 package framework
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"os"
@@ -470,9 +471,22 @@ func (s *suiteImpl) run() (errLevel int) {
 		_ = appendToFile(ctx.marshalTraceEvent(), traceFile)
 	}()
 
+	if dir := ctx.settings.QuarantineDir; dir != "" {
+		h, err := loadQuarantineHistory(dir, ctx.Settings().TestID)
+		if err != nil {
+			scopes.Framework.Errorf("Error loading the quarantine history, not quarantining tests: %v", err)
+		}
+		ctx.outcomeMu.Lock()
+		ctx.quarantine = h
+		ctx.outcomeMu.Unlock()
+	}
+
 	attempt := 0
 	for attempt <= ctx.settings.Retries {
 		attempt++
+		ctx.outcomeMu.Lock()
+		ctx.attempt = attempt
+		ctx.outcomeMu.Unlock()
 		scopes.Framework.Infof("=== BEGIN: Test Run: '%s' ===", ctx.Settings().TestID)
 		errLevel = s.mRun(ctx)
 		if errLevel == 0 {
@@ -486,6 +500,7 @@ func (s *suiteImpl) run() (errLevel int) {
 		}
 	}
 	s.writeOutput()
+	s.updateQuarantine()
 
 	return
 }
@@ -495,6 +510,10 @@ type SuiteOutcome struct {
 	Environment  string
 	Multicluster bool
 	TestOutcomes []TestOutcome
+	// TestResults summarizes the outcomes of each test over all runs of the suite
+	TestResults []TestResult
+	// Quarantined reports the tests run in quarantine
+	Quarantined []QuarantinedTest `yaml:",omitempty"`
 }
 
 func environmentName(ctx resource.Context) string {
@@ -533,7 +552,9 @@ func (s *suiteImpl) writeOutput() {
 			Environment:  environmentName(ctx),
 			Multicluster: isMulticluster(ctx),
 			TestOutcomes: ctx.testOutcomes,
+			TestResults:  summarizeOutcomes(ctx.testOutcomes),
 		}
+		out.Quarantined = quarantineReport(ctx.quarantine, ctx.settings.QuarantineThreshold, out.TestResults)
 		ctx.outcomeMu.RUnlock()
 		outbytes, err := yaml.Marshal(out)
 		if err != nil {
@@ -543,6 +564,47 @@ func (s *suiteImpl) writeOutput() {
 		if err != nil {
 			log.Errorf("failed writing test suite outcome to file: %s", err)
 		}
+		var junit bytes.Buffer
+		if err := writeJUnit(&junit, out.Name, out.TestResults); err != nil {
+			log.Errorf("failed writing test suite results to junit: %s", err)
+		}
+		err = os.WriteFile(path.Join(artifactsPath, junitFileName(out.Name)), junit.Bytes(), 0o644)
+		if err != nil {
+			log.Errorf("failed writing test suite results to file: %s", err)
+		}
+		for _, r := range out.TestResults {
+			if r.Flaky {
+				scopes.Framework.Warnf("=== FLAKY: Test: '%s[%s]' passed after failing %d of %d attempts ===",
+					out.Name, r.Name, r.Failures, r.Attempts)
+			}
+		}
+	}
+}
+
+// updateQuarantine records the flakes of the run in the quarantine history, and reports the tests run in quarantine.
+func (s *suiteImpl) updateQuarantine() {
+	ctx := rt.suiteContext()
+	dir := ctx.settings.QuarantineDir
+	if dir == "" {
+		return
+	}
+	ctx.outcomeMu.RLock()
+	results := summarizeOutcomes(ctx.testOutcomes)
+	history := ctx.quarantine
+	ctx.outcomeMu.RUnlock()
+	if history == nil {
+		// The history could not be loaded, do not overwrite it
+		return
+	}
+
+	for _, q := range quarantineReport(history, ctx.settings.QuarantineThreshold, results) {
+		if q.Failed {
+			scopes.Framework.Warnf("=== QUARANTINED: Test: '%s[%s]' failed, not failing the suite (%d consecutive flaky runs) ===",
+				ctx.Settings().TestID, q.Name, q.ConsecutiveFlakes)
+		}
+	}
+	if err := writeQuarantineHistory(dir, ctx.Settings().TestID, history.update(results)); err != nil {
+		scopes.Framework.Errorf("Error writing the quarantine history: %v", err)
 	}
 }
 
@@ -22,6 +22,7 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 	"sigs.k8s.io/yaml"
@@ -64,6 +65,11 @@ type suiteContext struct {
 
 	outcomeMu    sync.RWMutex
 	testOutcomes []TestOutcome
+	// attempt is the current run of the tests of the suite, starting at 1. Tests are run again on failure,
+	// up to Settings.Retries times.
+	attempt int
+	// quarantine is the flake history of the suite, the tests having flaked too often being run in quarantine
+	quarantine QuarantineHistory
 
 	dumpCount *atomic.Uint64
 
@@ -244,6 +250,8 @@ const (
 	Failed         Outcome = "Failed"
 	Skipped        Outcome = "Skipped"
 	NotImplemented Outcome = "NotImplemented"
+	// Quarantined is the outcome of a quarantined test which failed, without failing the suite
+	Quarantined Outcome = "Quarantined"
 )
 
 type TestOutcome struct {
@@ -251,14 +259,21 @@ type TestOutcome struct {
 	Type          string
 	Outcome       Outcome
 	FeatureLabels map[features.Feature][]string
+	// Attempt is the run of the suite the outcome was recorded in, starting at 1
+	Attempt         int
+	DurationSeconds float64
+	// WorkDir holds the artifacts of the test, such as dumps
+	WorkDir string
 }
 
-func (c *suiteContext) registerOutcome(test *testImpl) {
+func (c *suiteContext) registerOutcome(test *testImpl, duration time.Duration) {
 	o := Passed
 	if test.notImplemented {
 		o = NotImplemented
 	} else if test.goTest.Failed() {
 		o = Failed
+	} else if test.quarantineFailed.Load() {
+		o = Quarantined
 	} else if test.goTest.Skipped() {
 		o = Skipped
 	}
@@ -267,12 +282,25 @@ func (c *suiteContext) registerOutcome(test *testImpl) {
 		Type:          "integration",
 		Outcome:       o,
 		FeatureLabels: test.featureLabels,
+
+		DurationSeconds: duration.Seconds(),
 	}
-	c.contextMu.Lock()
-	defer c.contextMu.Unlock()
+	if test.ctx != nil {
+		newOutcome.WorkDir = test.ctx.WorkDir()
+	}
+	c.outcomeMu.Lock()
+	defer c.outcomeMu.Unlock()
+	newOutcome.Attempt = c.attempt
 	c.testOutcomes = append(c.testOutcomes, newOutcome)
 }
 
+// quarantined returns whether the test is run in quarantine.
+func (c *suiteContext) quarantined(name string) bool {
+	c.outcomeMu.RLock()
+	defer c.outcomeMu.RUnlock()
+	return c.quarantine.quarantined(name, c.settings.QuarantineThreshold)
+}
+
 func (c *suiteContext) RecordTraceEvent(key string, value any) {
 	c.traces.Store(key, value)
 }
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	traceapi "go.opentelemetry.io/otel/trace"
+	"go.uber.org/atomic"
 
 	"istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/test/framework/features"
@@ -123,6 +124,10 @@ type testImpl struct {
 	requireLocalIstiod   bool
 	requireSingleNetwork bool
 	minIstioVersion      string
+	// quarantined is set if the test is run in quarantine, its failures being recorded in quarantineFailed rather than
+	// failing the Go test
+	quarantined      bool
+	quarantineFailed atomic.Bool
 
 	ctx *testContext
 	tc  context2.Context
@@ -284,6 +289,11 @@ func (t *testImpl) doRun(ctx *testContext, fn func(ctx TestContext), parallel bo
 
 	start := time.Now()
 	scopes.Framework.Infof("=== BEGIN: Test: '%s[%s]' ===", rt.suiteContext().Settings().TestID, t.goTest.Name())
+	t.quarantined = t.s.quarantined(t.goTest.Name())
+	if t.quarantined {
+		scopes.Framework.Warnf("=== QUARANTINED: Test: '%s[%s]' flaked too often, its failures do not fail the suite ===",
+			rt.suiteContext().Settings().TestID, t.goTest.Name())
+	}
 
 	// Initial setup if we're running in Parallel.
 	if parallel {
@@ -297,6 +307,8 @@ func (t *testImpl) doRun(ctx *testContext, fn func(ctx TestContext), parallel bo
 		message := "passed"
 		if t.goTest.Failed() {
 			message = "failed"
+		} else if t.quarantineFailed.Load() {
+			message = "failed in quarantine"
 		}
 		scopes.Framework.Infof("=== DONE (%s):  Test: '%s[%s] (%v)' ===",
 			message,
@@ -304,7 +316,7 @@ func (t *testImpl) doRun(ctx *testContext, fn func(ctx TestContext), parallel bo
 			t.goTest.Name(),
 			time.Since(start))
 		t.ts.End()
-		rt.suiteContext().registerOutcome(t)
+		rt.suiteContext().registerOutcome(t, time.Since(start))
 	})
 
 	// Run the user's test function.
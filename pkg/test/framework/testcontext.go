@@ -327,38 +327,75 @@ func (c *testContext) close() {
 	scopes.Framework.Debugf("Completed cleaning up testContext: %q", c.id)
 }
 
+// inQuarantine returns whether the test of the context is run in quarantine, in which case its failures are logged and
+// recorded rather than failing the Go test.
+func (c *testContext) inQuarantine() bool {
+	return c.test != nil && c.test.quarantined
+}
+
+// quarantineFailure records a failure of a quarantined test.
+func (c *testContext) quarantineFailure(msg string) {
+	c.Helper()
+	c.test.quarantineFailed.Store(true)
+	c.T.Log("QUARANTINED FAILURE: " + msg)
+}
+
 func (c *testContext) Error(args ...any) {
 	c.Helper()
+	if c.inQuarantine() {
+		c.quarantineFailure(fmt.Sprint(args...))
+		return
+	}
 	c.T.Error(args...)
 }
 
 func (c *testContext) Errorf(format string, args ...any) {
 	c.Helper()
+	if c.inQuarantine() {
+		c.quarantineFailure(fmt.Sprintf(format, args...))
+		return
+	}
 	c.T.Errorf(format, args...)
 }
 
 func (c *testContext) Fail() {
 	c.Helper()
+	if c.inQuarantine() {
+		c.quarantineFailure("test marked as failed")
+		return
+	}
 	c.T.Fail()
 }
 
 func (c *testContext) FailNow() {
 	c.Helper()
+	if c.inQuarantine() {
+		c.quarantineFailure("test stopped")
+		c.T.SkipNow()
+	}
 	c.T.FailNow()
 }
 
 func (c *testContext) Failed() bool {
 	c.Helper()
-	return c.T.Failed()
+	return c.T.Failed() || (c.inQuarantine() && c.test.quarantineFailed.Load())
 }
 
 func (c *testContext) Fatal(args ...any) {
 	c.Helper()
+	if c.inQuarantine() {
+		c.quarantineFailure(fmt.Sprint(args...))
+		c.T.SkipNow()
+	}
 	c.T.Fatal(args...)
 }
 
 func (c *testContext) Fatalf(format string, args ...any) {
 	c.Helper()
+	if c.inQuarantine() {
+		c.quarantineFailure(fmt.Sprintf(format, args...))
+		c.T.SkipNow()
+	}
 	c.T.Fatalf(format, args...)
 }
 
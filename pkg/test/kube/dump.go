@@ -61,6 +61,7 @@ const (
 	discoveryContainer  wellKnownContainer = "discovery"
 	initContainer       wellKnownContainer = "istio-init"
 	validationContainer wellKnownContainer = "istio-validation"
+	cniContainer        wellKnownContainer = "install-cni"
 )
 
 var coreDumpedPods = atomic.NewInt32(0)
@@ -141,19 +142,25 @@ func DumpWebhooks(ctx resource.Context, workDir string) {
 	_ = errG.Wait()
 }
 
+// DefaultPodDumpers returns the dumpers used by DumpPods when none are provided: the resource state, events,
+// container logs and Envoy information of the pods.
+func DefaultPodDumpers() []PodDumper {
+	return []PodDumper{
+		DumpPodState,
+		DumpPodEvents,
+		DumpPodLogs,
+		DumpPodProxies,
+		DumpNdsz,
+		DumpCoreDumps,
+	}
+}
+
 // DumpPods runs each dumper with the selected pods in the given namespace.
 // If selectors is empty, all pods in the namespace will be dumpped.
-// If no dumpers are provided, their resource state, events, container logs and Envoy information will be dumped.
+// If no dumpers are provided, the DefaultPodDumpers are used.
 func DumpPods(ctx resource.Context, workDir, namespace string, selectors []string, dumpers ...PodDumper) {
 	if len(dumpers) == 0 {
-		dumpers = []PodDumper{
-			DumpPodState,
-			DumpPodEvents,
-			DumpPodLogs,
-			DumpPodProxies,
-			DumpNdsz,
-			DumpCoreDumps,
-		}
+		dumpers = DefaultPodDumpers()
 	}
 
 	wg := sync.WaitGroup{}
@@ -239,6 +246,50 @@ func DumpCoreDumps(_ resource.Context, c cluster.Cluster, workDir string, namesp
 	}
 }
 
+// cniPersistedLogDir is the directory of the logs persisted on the node by istio-cni, in the install-cni container.
+const cniPersistedLogDir = "/var/log/istio-cni"
+
+// DumpCNIPersistedLogs dumps the logs persisted on the nodes by the istio-cni pods, when their log persistence is enabled.
+// The rotated files are dumped too, so the logs of the previous istio-cni pods of the node are collected as well.
+func DumpCNIPersistedLogs(_ resource.Context, c cluster.Cluster, workDir string, namespace string, pods ...corev1.Pod) {
+	pods = podsOrFetch(c, pods, namespace)
+	for _, pod := range pods {
+		if !hasContainer(pod, cniContainer) {
+			continue
+		}
+		stdout, _, err := c.PodExec(pod.Name, pod.Namespace, cniContainer.Name(), fmt.Sprintf("find %s -name *.log*", cniPersistedLogDir))
+		if err != nil {
+			// The log persistence is not enabled
+			continue
+		}
+		for _, logFile := range strings.Split(stdout, "\n") {
+			if strings.TrimSpace(logFile) == "" {
+				continue
+			}
+			stdout, _, err := c.PodExec(pod.Name, pod.Namespace, cniContainer.Name(), "cat "+logFile)
+			if err != nil {
+				scopes.Framework.Warnf("Unable to get persisted istio-cni log %v for cluster/pod: %s/%s/%s: %v",
+					logFile, c.Name(), pod.Namespace, pod.Name, err)
+				continue
+			}
+			fname := podOutputPath(workDir, c, pod, "persisted-"+filepath.Base(logFile))
+			if err = os.WriteFile(fname, []byte(stdout), os.ModePerm); err != nil {
+				scopes.Framework.Warnf("Unable to write persisted istio-cni log for cluster/pod: %s/%s/%s: %v",
+					c.Name(), pod.Namespace, pod.Name, err)
+			}
+		}
+	}
+}
+
+func hasContainer(pod corev1.Pod, container wellKnownContainer) bool {
+	for _, c := range pod.Spec.Containers {
+		if container.IsContainer(c) {
+			return true
+		}
+	}
+	return false
+}
+
 func podsOrFetch(c cluster.Cluster, pods []corev1.Pod, namespace string) []corev1.Pod {
 	if len(pods) == 0 {
 		podList, err := c.Kube().CoreV1().Pods(namespace).List(context.TODO(), metav1.ListOptions{})
@@ -0,0 +1,330 @@
+//  Copyright Istio Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package kube
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/istio/pkg/log"
+	"istio.io/istio/pkg/test"
+	"istio.io/istio/pkg/test/framework/components/cluster"
+	"istio.io/istio/pkg/test/scopes"
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+// defaultLogTimeout is how long ExpectLog waits for a line by default.
+var defaultLogTimeout = retry.Timeout(time.Minute)
+
+// LogLine is a line logged by a container, parsed from the Istio text or JSON log format. Lines in another format
+// only have their Message set.
+type LogLine struct {
+	// Pod is the namespace/name of the pod which logged the line.
+	Pod string
+	// Time is the time the line was received by the kubelet.
+	Time    time.Time
+	Level   string
+	Scope   string
+	Message string
+	// Fields are the labels of the line, e.g. set with log.WithLabels.
+	Fields map[string]string
+	Raw    string
+}
+
+func (l LogLine) String() string {
+	return fmt.Sprintf("%s %s: %s", l.Pod, l.Time.Format(time.RFC3339Nano), l.Raw)
+}
+
+// LogPattern matches log lines. The unset fields match any line.
+type LogPattern struct {
+	Level   string
+	Scope   string
+	Message *regexp.Regexp
+	// Fields must all be set on the line, with the same values.
+	Fields map[string]string
+}
+
+// Matches returns whether the line matches the pattern.
+func (p LogPattern) Matches(l LogLine) bool {
+	if p.Level != "" && !strings.EqualFold(p.Level, l.Level) {
+		return false
+	}
+	if p.Scope != "" && p.Scope != l.Scope {
+		return false
+	}
+	if p.Message != nil && !p.Message.MatchString(l.Message) {
+		return false
+	}
+	for k, v := range p.Fields {
+		if got, f := l.Fields[k]; !f || got != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (p LogPattern) String() string {
+	var parts []string
+	if p.Level != "" {
+		parts = append(parts, "level="+p.Level)
+	}
+	if p.Scope != "" {
+		parts = append(parts, "scope="+p.Scope)
+	}
+	if p.Message != nil {
+		parts = append(parts, fmt.Sprintf("message=~%q", p.Message.String()))
+	}
+	for k, v := range p.Fields {
+		parts = append(parts, k+"="+v)
+	}
+	return "{" + strings.Join(parts, " ") + "}"
+}
+
+// LogStream streams the logs of a container of the pods selected when it is created, from its creation until the end
+// of the test, so that the expectations only consider the lines logged by the test. The lines are bounded with the
+// timestamps of the kubelet, so the clocks of the test and of the nodes are assumed to be in sync.
+type LogStream struct {
+	cluster   cluster.Cluster
+	namespace string
+	container string
+	pods      []string
+	start     time.Time
+
+	mu    sync.Mutex
+	lines []LogLine
+}
+
+// StreamLogs starts streaming the logs of the container of the pods matching the selectors, until the end of the
+// test.
+func StreamLogs(t test.Failer, c cluster.Cluster, namespace, container string, selectors ...string) *LogStream {
+	t.Helper()
+	pods, err := NewPodMustFetch(c, namespace, selectors...)()
+	if err != nil {
+		t.Fatalf("failed to get the pods to stream the logs of: %v", err)
+	}
+	s := &LogStream{
+		cluster:   c,
+		namespace: namespace,
+		container: container,
+		start:     time.Now(),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	wg := sync.WaitGroup{}
+	for _, pod := range pods {
+		s.pods = append(s.pods, pod.Name)
+		req := c.Kube().CoreV1().Pods(namespace).GetLogs(pod.Name, s.logOptions(true))
+		stream, err := req.Stream(ctx)
+		if err != nil {
+			cancel()
+			t.Fatalf("failed to stream the logs of %s/%s: %v", namespace, pod.Name, err)
+		}
+		wg.Add(1)
+		go func(pod string) {
+			defer wg.Done()
+			defer stream.Close()
+			s.read(pod, stream, func(l LogLine) {
+				s.mu.Lock()
+				s.lines = append(s.lines, l)
+				s.mu.Unlock()
+			})
+		}(pod.Name)
+	}
+	t.Cleanup(func() {
+		cancel()
+		wg.Wait()
+	})
+	return s
+}
+
+func (s *LogStream) logOptions(follow bool) *corev1.PodLogOptions {
+	return &corev1.PodLogOptions{
+		Container:  s.container,
+		Follow:     follow,
+		Timestamps: true,
+		SinceTime:  &metav1.Time{Time: s.start},
+	}
+}
+
+// read parses the lines of a log, skipping those logged before the stream started.
+func (s *LogStream) read(pod string, r io.Reader, handle func(LogLine)) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		l := ParseLogLine(scanner.Text())
+		if l.Time.Before(s.start) {
+			// The kubelet filters the lines with a second precision
+			continue
+		}
+		l.Pod = s.namespace + "/" + pod
+		handle(l)
+	}
+	if err := scanner.Err(); err != nil && !errors.Is(err, context.Canceled) {
+		scopes.Framework.Debugf("stopped streaming the logs of %s/%s: %v", s.namespace, pod, err)
+	}
+}
+
+// Lines returns the lines matching the pattern received so far.
+func (s *LogStream) Lines(p LogPattern) []LogLine {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var res []LogLine
+	for _, l := range s.lines {
+		if p.Matches(l) {
+			res = append(res, l)
+		}
+	}
+	return res
+}
+
+// ExpectLog waits until a line matching the pattern is logged, failing the test otherwise. It returns the first
+// matching line.
+func (s *LogStream) ExpectLog(t test.Failer, p LogPattern, opts ...retry.Option) LogLine {
+	t.Helper()
+	var first LogLine
+	retry.UntilSuccessOrFail(t, func() error {
+		lines := s.Lines(p)
+		if len(lines) == 0 {
+			return fmt.Errorf("no line matching %v logged by %s in %v", p, s.container, s.pods)
+		}
+		first = lines[0]
+		return nil
+	}, append([]retry.Option{defaultLogTimeout, defaultRetryDelay}, opts...)...)
+	return first
+}
+
+// ExpectNoLog fails the test if a line matching the pattern was logged since the stream started. Rather than relying
+// on the lines streamed so far, which may lag, the logs are read up to now.
+func (s *LogStream) ExpectNoLog(t test.Failer, p LogPattern) {
+	t.Helper()
+	for _, pod := range s.pods {
+		req := s.cluster.Kube().CoreV1().Pods(s.namespace).GetLogs(pod, s.logOptions(false))
+		stream, err := req.Stream(context.Background())
+		if err != nil {
+			t.Fatalf("failed to get the logs of %s/%s: %v", s.namespace, pod, err)
+		}
+		var matched []LogLine
+		s.read(pod, stream, func(l LogLine) {
+			if p.Matches(l) {
+				matched = append(matched, l)
+			}
+		})
+		_ = stream.Close()
+		if len(matched) > 0 {
+			t.Fatalf("unexpected line matching %v logged by %s/%s: %v", p, s.namespace, pod, matched[0])
+		}
+	}
+}
+
+// ParseLogLine parses a line of a log read with timestamps. The Istio text format is
+// <time>\t<level>\t<scope>\t<message>[\t<key>=<value> ...], the JSON format having the same fields. The scope is
+// omitted for the default scope, in which case the line has the default scope.
+func ParseLogLine(line string) LogLine {
+	l := LogLine{Raw: line}
+	// The kubelet prefixes each line with its RFC 3339 timestamp
+	if ts, rest, f := strings.Cut(line, " "); f {
+		if t, err := time.Parse(time.RFC3339Nano, ts); err == nil {
+			l.Time = t
+			l.Raw = rest
+		}
+	}
+	if strings.HasPrefix(l.Raw, "{") {
+		parseJSONLogLine(&l)
+		return l
+	}
+	parts := strings.SplitN(l.Raw, "\t", 5)
+	if len(parts) < 3 {
+		l.Message = l.Raw
+		return l
+	}
+	if _, err := time.Parse(time.RFC3339Nano, parts[0]); err != nil {
+		l.Message = l.Raw
+		return l
+	}
+	if len(parts) == 3 || (len(parts) == 4 && isLogFields(parts[3])) {
+		// No scope column: <time>\t<level>\t<message>[\t<key>=<value> ...]
+		l.Level, l.Scope, l.Message = parts[1], log.DefaultScopeName, parts[2]
+		if len(parts) == 4 {
+			l.Fields = parseLogFields(parts[3])
+		}
+		return l
+	}
+	l.Level, l.Scope, l.Message = parts[1], parts[2], parts[3]
+	if len(parts) == 5 {
+		l.Fields = parseLogFields(parts[4])
+	}
+	return l
+}
+
+// isLogFields returns whether the column only holds <key>=<value> fields.
+func isLogFields(s string) bool {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return false
+	}
+	for _, kv := range fields {
+		if k, _, f := strings.Cut(kv, "="); !f || k == "" {
+			return false
+		}
+	}
+	return true
+}
+
+func parseLogFields(s string) map[string]string {
+	fields := map[string]string{}
+	for _, kv := range strings.Fields(s) {
+		if k, v, f := strings.Cut(kv, "="); f {
+			fields[k] = v
+		}
+	}
+	return fields
+}
+
+func parseJSONLogLine(l *LogLine) {
+	fields := map[string]any{}
+	if err := json.Unmarshal([]byte(l.Raw), &fields); err != nil {
+		l.Message = l.Raw
+		return
+	}
+	l.Scope = log.DefaultScopeName
+	l.Fields = map[string]string{}
+	for k, v := range fields {
+		s, ok := v.(string)
+		if !ok {
+			s = fmt.Sprint(v)
+		}
+		switch k {
+		case "level":
+			l.Level = s
+		case "scope":
+			l.Scope = s
+		case "msg":
+			l.Message = s
+		case "time":
+		default:
+			l.Fields[k] = s
+		}
+	}
+}
@@ -0,0 +1,116 @@
+//  Copyright Istio Authors
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package kube
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+func TestParseLogLine(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 12, 0, 0, 123456789, time.UTC)
+	cases := []struct {
+		name string
+		line string
+		want LogLine
+	}{
+		{
+			name: "text",
+			line: "2024-05-01T12:00:00.123456789Z 2024-05-01T12:00:00.123000Z\twarn\tads\tpush rejected\tnode=gw-1 type=LDS",
+			want: LogLine{
+				Time:    ts,
+				Level:   "warn",
+				Scope:   "ads",
+				Message: "push rejected",
+				Fields:  map[string]string{"node": "gw-1", "type": "LDS"},
+				Raw:     "2024-05-01T12:00:00.123000Z\twarn\tads\tpush rejected\tnode=gw-1 type=LDS",
+			},
+		},
+		{
+			name: "json",
+			line: `2024-05-01T12:00:00.123456789Z {"level":"info","time":"2024-05-01T12:00:00.123000Z","scope":"xds","msg":"connected","retries":2}`,
+			want: LogLine{
+				Time:    ts,
+				Level:   "info",
+				Scope:   "xds",
+				Message: "connected",
+				Fields:  map[string]string{"retries": "2"},
+				Raw:     `{"level":"info","time":"2024-05-01T12:00:00.123000Z","scope":"xds","msg":"connected","retries":2}`,
+			},
+		},
+		{
+			name: "text without scope",
+			line: "2024-05-01T12:00:00.123456789Z 2024-05-01T12:00:00.123000Z\tinfo\tstarting the agent",
+			want: LogLine{
+				Time:    ts,
+				Level:   "info",
+				Scope:   "default",
+				Message: "starting the agent",
+				Raw:     "2024-05-01T12:00:00.123000Z\tinfo\tstarting the agent",
+			},
+		},
+		{
+			name: "text without scope with fields",
+			line: "2024-05-01T12:00:00.123456789Z 2024-05-01T12:00:00.123000Z\tinfo\tready\tnode=gw-1 type=LDS",
+			want: LogLine{
+				Time:    ts,
+				Level:   "info",
+				Scope:   "default",
+				Message: "ready",
+				Fields:  map[string]string{"node": "gw-1", "type": "LDS"},
+				Raw:     "2024-05-01T12:00:00.123000Z\tinfo\tready\tnode=gw-1 type=LDS",
+			},
+		},
+		{
+			name: "json without scope",
+			line: `2024-05-01T12:00:00.123456789Z {"level":"info","time":"2024-05-01T12:00:00.123000Z","msg":"ready"}`,
+			want: LogLine{
+				Time:    ts,
+				Level:   "info",
+				Scope:   "default",
+				Message: "ready",
+				Fields:  map[string]string{},
+				Raw:     `{"level":"info","time":"2024-05-01T12:00:00.123000Z","msg":"ready"}`,
+			},
+		},
+		{
+			name: "tabs without time",
+			line: "2024-05-01T12:00:00.123456789Z a\tb\tc",
+			want: LogLine{Time: ts, Message: "a\tb\tc", Raw: "a\tb\tc"},
+		},
+		{
+			name: "unstructured",
+			line: "2024-05-01T12:00:00.123456789Z starting envoy",
+			want: LogLine{Time: ts, Message: "starting envoy", Raw: "starting envoy"},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, ParseLogLine(tt.line), tt.want)
+		})
+	}
+}
+
+func TestLogPatternMatches(t *testing.T) {
+	line := ParseLogLine("2024-05-01T12:00:00Z 2024-05-01T12:00:00Z\twarn\tads\tpush rejected\tnode=gw-1")
+	assert.Equal(t, LogPattern{}.Matches(line), true)
+	assert.Equal(t, LogPattern{Level: "WARN", Scope: "ads", Message: regexp.MustCompile("^push")}.Matches(line), true)
+	assert.Equal(t, LogPattern{Fields: map[string]string{"node": "gw-1"}}.Matches(line), true)
+	assert.Equal(t, LogPattern{Level: "error"}.Matches(line), false)
+	assert.Equal(t, LogPattern{Fields: map[string]string{"node": "gw-2"}}.Matches(line), false)
+}
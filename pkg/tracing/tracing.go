@@ -123,6 +123,6 @@ func InitializeFullBinary(rootSpan string) (context.Context, func(), error) {
 	}, nil
 }
 
-func Start(ctx context.Context, span string) (context.Context, traceapi.Span) {
-	return tracer().Start(ctx, span)
+func Start(ctx context.Context, span string, opts ...traceapi.SpanStartOption) (context.Context, traceapi.Span) {
+	return tracer().Start(ctx, span, opts...)
 }
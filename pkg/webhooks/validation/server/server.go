@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	multierror "github.com/hashicorp/go-multierror"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 
 	"istio.io/istio/pilot/pkg/config/kube/crd"
+	"istio.io/istio/pkg/config/audit"
 	"istio.io/istio/pkg/config/schema/collection"
 	"istio.io/istio/pkg/config/schema/resource"
 	"istio.io/istio/pkg/config/validation"
@@ -75,6 +77,17 @@ type Options struct {
 
 	// Use an existing mux instead of creating our own.
 	Mux *http.ServeMux
+
+	// Audit records the validation decisions, if set.
+	Audit *audit.Log
+
+	// Freeze reports the changes deferred by a config freeze window, if set.
+	Freeze ConfigFreeze
+}
+
+// ConfigFreeze reports whether the changes of a kind are deferred until the end of a config freeze window.
+type ConfigFreeze interface {
+	DeferredUntil(group, kind string) (time.Time, bool)
 }
 
 // String produces a stringified version of the arguments for debugging.
@@ -99,6 +112,8 @@ type Webhook struct {
 	// pilot
 	schemas      collection.Schemas
 	domainSuffix string
+	audit        *audit.Log
+	freeze       ConfigFreeze
 }
 
 // New creates a new instance of the admission webhook server.
@@ -110,6 +125,8 @@ func New(o Options) (*Webhook, error) {
 	wh := &Webhook{
 		schemas:      o.Schemas,
 		domainSuffix: o.DomainSuffix,
+		audit:        o.Audit,
+		freeze:       o.Freeze,
 	}
 
 	o.Mux.HandleFunc("/validate", wh.serveValidate)
@@ -189,7 +206,64 @@ func serve(w http.ResponseWriter, r *http.Request, admit admitFunc) {
 }
 
 func (wh *Webhook) serveValidate(w http.ResponseWriter, r *http.Request) {
-	serve(w, r, wh.validate)
+	serve(w, r, wh.admit)
+}
+
+// admit validates the request, recording the decision in the audit log of the namespace. The changes accepted during
+// a config freeze window are warned about, as they are not pushed to the proxies before the end of the window.
+// The deletions, which are only sent to the webhook when the config audit is enabled, are always allowed.
+func (wh *Webhook) admit(request *kube.AdmissionRequest) *kube.AdmissionResponse {
+	var response *kube.AdmissionResponse
+	if request.Operation == kube.Delete {
+		response = &kube.AdmissionResponse{Allowed: true}
+	} else {
+		response = wh.validate(request)
+	}
+	if wh.freeze != nil && response.Allowed {
+		if until, deferred := wh.freeze.DeferredUntil(request.Kind.Group, request.Kind.Kind); deferred {
+			response.Warnings = append(response.Warnings, fmt.Sprintf(
+				"the mesh is in a config freeze window: the change is accepted, but not pushed to the proxies before %s",
+				until.Format(time.RFC3339)))
+		}
+	}
+	if wh.audit != nil && request.Namespace != "" &&
+		(request.Operation == kube.Create || request.Operation == kube.Update || request.Operation == kube.Delete) {
+		wh.audit.Record(auditEvent(request, response))
+	}
+	return response
+}
+
+func auditEvent(request *kube.AdmissionRequest, response *kube.AdmissionResponse) audit.Event {
+	ev := audit.Event{
+		Namespace: request.Namespace,
+		Group:     request.Kind.Group,
+		Version:   request.Kind.Version,
+		Kind:      request.Kind.Kind,
+		Name:      request.Name,
+		Operation: request.Operation,
+		User:      request.UserInfo.Username,
+		Allowed:   response.Allowed,
+		Warnings:  response.Warnings,
+		DryRun:    request.DryRun != nil && *request.DryRun,
+	}
+	if ev.Name == "" {
+		// The name is not set on the request of a creation with a generated name
+		raw := request.Object.Raw
+		if request.Operation == kube.Delete {
+			raw = request.OldObject.Raw
+		}
+		var obj metav1.PartialObjectMetadata
+		if err := json.Unmarshal(raw, &obj); err == nil {
+			ev.Name = obj.Name
+		}
+	}
+	if response.Result != nil {
+		ev.Message = response.Result.Message
+	}
+	if request.Operation == kube.Update {
+		ev.Changes = audit.DiffSummary(request.OldObject.Raw, request.Object.Raw)
+	}
+	return ev
 }
 
 func (wh *Webhook) validate(request *kube.AdmissionRequest) *kube.AdmissionResponse {
@@ -26,12 +26,15 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"istio.io/istio/pkg/config/audit"
 	"istio.io/istio/pkg/config/schema/collections"
 	"istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/test/config"
@@ -208,6 +211,76 @@ func TestAdmitPilot(t *testing.T) {
 	}
 }
 
+func TestAdmitAudit(t *testing.T) {
+	valid := makePilotConfig(t, 0, true, false)
+	invalidConfig := makePilotConfig(t, 1, false, false)
+
+	wh := createTestWebhook(t)
+	wh.audit = audit.NewLog(10)
+	request := func(op string, obj, old []byte) *kube.AdmissionRequest {
+		return &kube.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Kind: collections.Mock.Kind()},
+			Name:      "config",
+			Namespace: "tenant",
+			UserInfo:  authenticationv1.UserInfo{Username: "alice"},
+			Object:    runtime.RawExtension{Raw: obj},
+			OldObject: runtime.RawExtension{Raw: old},
+			Operation: op,
+		}
+	}
+	wh.admit(request(kube.Create, valid, nil))
+	wh.admit(request(kube.Update, invalidConfig, valid))
+	wh.admit(request(kube.Delete, nil, valid))
+
+	events := wh.audit.Events("tenant")
+	if len(events) != 3 {
+		t.Fatalf("got %d events, want 3: %+v", len(events), events)
+	}
+	if ev := events[0]; !ev.Allowed || ev.User != "alice" || ev.Operation != kube.Create || ev.Name != "config" || ev.Changes != nil {
+		t.Fatalf("unexpected creation event %+v", ev)
+	}
+	if ev := events[1]; ev.Allowed || ev.Message == "" || ev.Operation != kube.Update || len(ev.Changes) == 0 {
+		t.Fatalf("unexpected update event %+v", ev)
+	}
+	if ev := events[2]; !ev.Allowed || ev.Operation != kube.Delete || ev.Name != "config" || ev.Changes != nil {
+		t.Fatalf("unexpected deletion event %+v", ev)
+	}
+}
+
+type fakeFreeze struct {
+	until time.Time
+}
+
+func (f fakeFreeze) DeferredUntil(group, kind string) (time.Time, bool) {
+	return f.until, !f.until.IsZero() && kind == collections.Mock.Kind()
+}
+
+func TestAdmitFreeze(t *testing.T) {
+	valid := makePilotConfig(t, 0, true, false)
+	invalidConfig := makePilotConfig(t, 1, false, false)
+	request := func(obj []byte) *kube.AdmissionRequest {
+		return &kube.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Kind: collections.Mock.Kind()},
+			Object:    runtime.RawExtension{Raw: obj},
+			Operation: kube.Create,
+		}
+	}
+
+	wh := createTestWebhook(t)
+	wh.freeze = fakeFreeze{}
+	if got := wh.admit(request(valid)); !got.Allowed || len(got.Warnings) != 0 {
+		t.Fatalf("unexpected response outside of a freeze window %+v", got)
+	}
+	wh.freeze = fakeFreeze{until: time.Date(2027, 1, 4, 0, 0, 0, 0, time.UTC)}
+	got := wh.admit(request(valid))
+	if !got.Allowed || len(got.Warnings) != 1 || !strings.Contains(got.Warnings[0], "2027-01-04T00:00:00Z") {
+		t.Fatalf("unexpected response during a freeze window %+v", got)
+	}
+	if got := wh.admit(request(invalidConfig)); got.Allowed || len(got.Warnings) != 0 {
+		t.Fatalf("unexpected response to an invalid change during a freeze window %+v", got)
+	}
+}
+
 func makeTestReview(t *testing.T, valid bool, apiVersion string) []byte {
 	t.Helper()
 	review := admissionv1.AdmissionReview{
@@ -29,6 +29,7 @@ import (
 	"github.com/fsnotify/fsnotify"
 
 	"istio.io/istio/pkg/backoff"
+	"istio.io/istio/pkg/clock"
 	"istio.io/istio/pkg/file"
 	istiolog "istio.io/istio/pkg/log"
 	"istio.io/istio/pkg/queue"
@@ -661,7 +662,7 @@ func (sc *SecretManagerClient) generateNewSecret(resourceName string) (*security
 var rotateTime = func(secret security.SecretItem, graceRatio float64) time.Duration {
 	secretLifeTime := secret.ExpireTime.Sub(secret.CreatedTime)
 	gracePeriod := time.Duration((graceRatio) * float64(secretLifeTime))
-	delay := time.Until(secret.ExpireTime.Add(-gracePeriod))
+	delay := clock.Until(secret.ExpireTime.Add(-gracePeriod))
 	if delay < 0 {
 		delay = 0
 	}
@@ -670,7 +671,7 @@ var rotateTime = func(secret security.SecretItem, graceRatio float64) time.Durat
 
 func (sc *SecretManagerClient) registerSecret(item security.SecretItem) {
 	delay := rotateTime(item, sc.configOptions.SecretRotationGracePeriodRatio)
-	certExpirySeconds.ValueFrom(func() float64 { return time.Until(item.ExpireTime).Seconds() }, ResourceName.Value(item.ResourceName))
+	certExpirySeconds.ValueFrom(func() float64 { return clock.Until(item.ExpireTime).Seconds() }, ResourceName.Value(item.ResourceName))
 	item.ResourceName = security.WorkloadKeyCertResourceName
 	// In case there are two calls to GenerateSecret at once, we don't want both to be concurrently registered
 	if sc.cache.GetWorkload() != nil {
@@ -29,6 +29,7 @@ import (
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
 	"istio.io/istio/pkg/backoff"
+	"istio.io/istio/pkg/clock"
 	"istio.io/istio/pkg/log"
 	"istio.io/istio/security/pkg/cmd"
 	caerror "istio.io/istio/security/pkg/pki/error"
@@ -429,7 +430,7 @@ func (ca *IstioCA) minTTL(defaultCertTTL time.Duration) (time.Duration, error) {
 		return defaultCertTTL, nil
 	}
 
-	certChainExpiration, err := util.TimeBeforeCertExpires(certChainPem, time.Now())
+	certChainExpiration, err := util.TimeBeforeCertExpires(certChainPem, clock.Now())
 	if err != nil {
 		return 0, fmt.Errorf("failed to get cert chain TTL %s", err.Error())
 	}
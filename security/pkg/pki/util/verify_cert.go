@@ -24,6 +24,8 @@ import (
 	"sort"
 	"strings"
 	"time"
+
+	"istio.io/istio/pkg/clock"
 )
 
 // VerifyFields contains the certificate fields to verify in the test.
@@ -192,5 +194,5 @@ func IsCertExpired(filepath string) (bool, error) {
 	if err != nil {
 		return true, fmt.Errorf("failed to parse the cert, err is %v", err)
 	}
-	return x509Cert.NotAfter.Before(time.Now()), nil
+	return x509Cert.NotAfter.Before(clock.Now()), nil
 }
@@ -22,6 +22,7 @@ import (
 	oidc "github.com/coreos/go-oidc/v3/oidc"
 
 	"istio.io/api/security/v1beta1"
+	"istio.io/istio/pkg/clock"
 	"istio.io/istio/pkg/security"
 	"istio.io/istio/pkg/spiffe"
 )
@@ -55,10 +56,10 @@ func NewJwtAuthenticator(jwtRule *v1beta1.JWTRule) (*JwtAuthenticator, error) {
 		if err != nil {
 			return nil, fmt.Errorf("failed at creating an OIDC provider for %v: %v", issuer, err)
 		}
-		verifier = provider.Verifier(&oidc.Config{SkipClientIDCheck: true})
+		verifier = provider.Verifier(&oidc.Config{SkipClientIDCheck: true, Now: clock.Now})
 	} else {
 		keySet := oidc.NewRemoteKeySet(context.Background(), jwksURL)
-		verifier = oidc.NewVerifier(issuer, keySet, &oidc.Config{SkipClientIDCheck: true})
+		verifier = oidc.NewVerifier(issuer, keySet, &oidc.Config{SkipClientIDCheck: true, Now: clock.Now})
 	}
 	return &JwtAuthenticator{
 		verifier:  verifier,
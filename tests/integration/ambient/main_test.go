@@ -77,7 +77,7 @@ func TestMain(m *testing.M) {
 		NewSuite(m).
 		RequireMinVersion(24).
 		SkipIf("https://github.com/istio/istio/issues/43243", func(ctx resource.Context) bool {
-			return strings.Contains(ctx.Settings().Image.Tag, "distroless")
+			return ctx.Settings().Image.IsDistroless()
 		}).
 		Label(label.IPv4). // https://github.com/istio/istio/issues/41008
 		Setup(func(t resource.Context) error {
@@ -903,23 +903,22 @@ spec:
 				return check.And(
 					check.OK(),
 					func(result echo.CallResult, err error) error {
-						errorThreshold := 10
 						if len(split) != len(dests) {
 							// shouldn't happen
 							return fmt.Errorf("split configured for %d destinations, but framework gives %d", len(split), len(dests))
 						}
-						splitPerHost := map[echo.NamespacedName]int{}
+						weights := map[string]int{}
 						destNames := dests.NamespacedNames()
 						for i, pct := range split {
-							splitPerHost[destNames[i]] = pct
+							weights[destNames[i].Name] = pct
 						}
-						for serviceName, exp := range splitPerHost {
+						if err := check.WeightedDistribution(weights, 0.1).Check(result, nil); err != nil {
+							return err
+						}
+						for _, serviceName := range destNames {
 							hostResponses := result.Responses.Match(func(r echoClient.Response) bool {
 								return strings.HasPrefix(r.Hostname, serviceName.Name)
 							})
-							if !AlmostEquals(len(hostResponses), exp, errorThreshold) {
-								return fmt.Errorf("expected %v calls to %s, got %v", exp, serviceName, len(hostResponses))
-							}
 							// echotest should have filtered the deployment to only contain reachable clusters
 							to := match.ServiceName(serviceName).GetMatches(dests.Instances())
 							fromCluster := src.(echo.Instance).Config().Cluster
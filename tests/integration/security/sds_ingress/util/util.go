@@ -33,6 +33,7 @@ import (
 	"istio.io/istio/pkg/config/protocol"
 	"istio.io/istio/pkg/http/headers"
 	"istio.io/istio/pkg/test/framework"
+	"istio.io/istio/pkg/test/framework/components/certs"
 	"istio.io/istio/pkg/test/framework/components/cluster"
 	"istio.io/istio/pkg/test/framework/components/echo"
 	"istio.io/istio/pkg/test/framework/components/echo/check"
@@ -78,6 +79,16 @@ type IngressCredential struct {
 	Crl         string
 }
 
+// NewIngressCredential returns the credential of a gateway serving the certificate of the leaf and its chain, and
+// trusting the root CA of the leaf for mutual TLS.
+func NewIngressCredential(leaf *certs.Leaf) IngressCredential {
+	return IngressCredential{
+		PrivateKey:  string(leaf.KeyPEM()),
+		Certificate: string(leaf.ChainPEM()),
+		CaCert:      string(leaf.RootPEM()),
+	}
+}
+
 var IngressCredentialA = IngressCredential{
 	PrivateKey:  TLSServerKeyA,
 	Certificate: TLSServerCertA,
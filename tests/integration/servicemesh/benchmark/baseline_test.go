@@ -0,0 +1,162 @@
+//go:build integ && benchmark
+// +build integ,benchmark
+
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	baselineEnv  = "ISTIO_TEST_BENCHMARK_BASELINE"
+	outputEnv    = "ISTIO_TEST_BENCHMARK_OUTPUT"
+	toleranceEnv = "ISTIO_TEST_BENCHMARK_TOLERANCE"
+
+	defaultTolerance = 20.0
+)
+
+// Baseline is the machine-readable record of a benchmark run, compared with the runs of later commits.
+type Baseline struct {
+	// Tag is the tag of the images benchmarked, identifying the commit.
+	Tag     string   `json:"tag"`
+	Results []Result `json:"results"`
+}
+
+// Result is the measure of the data path for a scenario.
+type Result struct {
+	Scenario  string `json:"scenario"`
+	TargetQPS int    `json:"targetQps"`
+	Requests  int    `json:"requests"`
+	// Throughput is the rate of the requests achieved, per second.
+	Throughput   float64 `json:"throughput"`
+	LatencyP50Ms float64 `json:"latencyP50Ms"`
+	LatencyP90Ms float64 `json:"latencyP90Ms"`
+	LatencyP99Ms float64 `json:"latencyP99Ms"`
+	// GatewayCPUMillicores is the average CPU usage of the gateway proxy during the run.
+	GatewayCPUMillicores float64 `json:"gatewayCpuMillicores"`
+}
+
+// newResult computes the result of a run from the latencies of its requests.
+func newResult(s scenario, latencies []time.Duration, elapsed, cpu time.Duration) Result {
+	sorted := append([]time.Duration(nil), latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	return Result{
+		Scenario:             s.name,
+		TargetQPS:            s.qps,
+		Requests:             len(latencies),
+		Throughput:           round(float64(len(latencies)) / elapsed.Seconds()),
+		LatencyP50Ms:         percentileMs(sorted, 50),
+		LatencyP90Ms:         percentileMs(sorted, 90),
+		LatencyP99Ms:         percentileMs(sorted, 99),
+		GatewayCPUMillicores: round(float64(cpu.Milliseconds()) / elapsed.Seconds()),
+	}
+}
+
+// percentileMs returns the percentile of sorted latencies, in milliseconds, with the nearest-rank method.
+func percentileMs(sorted []time.Duration, p int) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(float64(p)/100*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return round(float64(sorted[rank].Microseconds()) / 1000)
+}
+
+func round(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+// regressions compares the results of a run with a baseline, returning the measures of the scenarios which regressed
+// by more than tolerance percent. The scenarios missing from the baseline are not compared.
+func regressions(baseline Baseline, results []Result, tolerance float64) []string {
+	previous := map[string]Result{}
+	for _, r := range baseline.Results {
+		previous[r.Scenario] = r
+	}
+	var out []string
+	worse := func(scenario, measure string, base, cur float64, higherIsBetter bool) {
+		if base == 0 {
+			return
+		}
+		change := (cur - base) / base * 100
+		if higherIsBetter {
+			change = -change
+		}
+		if change > tolerance {
+			out = append(out, fmt.Sprintf("%s: %s regressed by %.1f%% (baseline %v of %s, got %v)",
+				scenario, measure, change, base, baseline.Tag, cur))
+		}
+	}
+	for _, r := range results {
+		base, f := previous[r.Scenario]
+		if !f {
+			continue
+		}
+		worse(r.Scenario, "throughput", base.Throughput, r.Throughput, true)
+		worse(r.Scenario, "p50 latency", base.LatencyP50Ms, r.LatencyP50Ms, false)
+		worse(r.Scenario, "p99 latency", base.LatencyP99Ms, r.LatencyP99Ms, false)
+		worse(r.Scenario, "gateway cpu", base.GatewayCPUMillicores, r.GatewayCPUMillicores, false)
+	}
+	return out
+}
+
+// readBaseline reads the baseline to compare the run with, if any.
+func readBaseline() (*Baseline, error) {
+	path := os.Getenv(baselineEnv)
+	if path == "" {
+		return nil, nil
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the baseline: %v", err)
+	}
+	baseline := &Baseline{}
+	if err := json.Unmarshal(b, baseline); err != nil {
+		return nil, fmt.Errorf("invalid baseline %s: %v", path, err)
+	}
+	return baseline, nil
+}
+
+// writeBaseline writes the results of the run to path.
+func writeBaseline(path string, baseline Baseline) error {
+	b, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(b, '\n'), 0o644)
+}
+
+// tolerance returns the regression tolerated by the comparison with the baseline, in percent.
+func tolerance() (float64, error) {
+	v := os.Getenv(toleranceEnv)
+	if v == "" {
+		return defaultTolerance, nil
+	}
+	t, err := strconv.ParseFloat(v, 64)
+	if err != nil || t < 0 {
+		return 0, fmt.Errorf("invalid %s %q", toleranceEnv, v)
+	}
+	return t, nil
+}
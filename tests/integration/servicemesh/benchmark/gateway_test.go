@@ -0,0 +1,209 @@
+//go:build integ && benchmark
+// +build integ,benchmark
+
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"istio.io/istio/pkg/config/constants"
+	"istio.io/istio/pkg/config/protocol"
+	"istio.io/istio/pkg/http/headers"
+	"istio.io/istio/pkg/test/echo/common/scheme"
+	"istio.io/istio/pkg/test/framework"
+	"istio.io/istio/pkg/test/framework/components/echo"
+	"istio.io/istio/pkg/test/framework/components/echo/check"
+	testKube "istio.io/istio/pkg/test/kube"
+	"istio.io/istio/pkg/test/util/retry"
+)
+
+const (
+	gatewayName = "benchmark"
+	gatewayHost = "benchmark.example.com"
+)
+
+// scenario is a fixed load driven through the gateway.
+type scenario struct {
+	name     string
+	qps      int
+	duration time.Duration
+	http2    bool
+}
+
+// scenarios are the loads benchmarked. Their names identify them in the baselines, so they must not be changed.
+var scenarios = []scenario{
+	{name: "http1-100qps", qps: 100, duration: 30 * time.Second},
+	{name: "http1-500qps", qps: 500, duration: 30 * time.Second},
+	{name: "http2-500qps", qps: 500, duration: 30 * time.Second, http2: true},
+}
+
+func TestGatewayDataPath(t *testing.T) {
+	framework.
+		NewTest(t).
+		Run(func(t framework.TestContext) {
+			baseline, err := readBaseline()
+			if err != nil {
+				t.Fatal(err)
+			}
+			tol, err := tolerance()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			t.ConfigIstio().YAML(ns.Name(), fmt.Sprintf(`
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: Gateway
+metadata:
+  name: %s
+spec:
+  gatewayClassName: istio
+  listeners:
+  - name: http
+    hostname: %q
+    port: 80
+    protocol: HTTP
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: HTTPRoute
+metadata:
+  name: %s
+spec:
+  parentRefs:
+  - name: %s
+  hostnames: [%q]
+  rules:
+  - backendRefs:
+    - name: %s
+      port: 80
+`, gatewayName, gatewayHost, gatewayName, gatewayName, gatewayHost, backend.Config().Service)).ApplyOrFail(t)
+
+			pods, err := testKube.WaitUntilPodsAreReady(testKube.NewSinglePodFetch(t.Clusters().Default(), ns.Name(),
+				constants.GatewayNameLabel+"="+gatewayName))
+			if err != nil {
+				t.Fatal(err)
+			}
+			gatewayPod := pods[0]
+
+			// Wait for the route to be programmed before measuring anything
+			client[0].CallOrFail(t, callOptions(scenario{}, 1, time.Second))
+
+			results := make([]Result, 0, len(scenarios))
+			for _, s := range scenarios {
+				results = append(results, run(t, s, gatewayPod))
+			}
+
+			out := os.Getenv(outputEnv)
+			if out == "" {
+				dir, err := t.CreateTmpDirectory("benchmark")
+				if err != nil {
+					t.Fatal(err)
+				}
+				out = filepath.Join(dir, "baseline.json")
+			}
+			if err := writeBaseline(out, Baseline{Tag: t.Settings().Image.Tag, Results: results}); err != nil {
+				t.Fatalf("failed to write the results: %v", err)
+			}
+			t.Logf("benchmark results written to %s", out)
+
+			if baseline != nil {
+				for _, r := range regressions(*baseline, results, tol) {
+					t.Error(r)
+				}
+			}
+		})
+}
+
+// run drives the load of a scenario through the gateway, measuring the data path.
+func run(t framework.TestContext, s scenario, gatewayPod corev1.Pod) Result {
+	count := s.qps * int(s.duration.Seconds())
+	cpuBefore := cpuUsage(t, gatewayPod)
+	start := time.Now()
+	res := client[0].CallOrFail(t, callOptions(s, count, s.duration+time.Minute))
+	elapsed := time.Since(start)
+	cpu := cpuUsage(t, gatewayPod) - cpuBefore
+
+	latencies := make([]time.Duration, 0, len(res.Responses))
+	for _, r := range res.Responses {
+		latency, err := time.ParseDuration(r.Latency)
+		if err != nil {
+			t.Fatalf("invalid latency %q: %v", r.Latency, err)
+		}
+		latencies = append(latencies, latency)
+	}
+	result := newResult(s, latencies, elapsed, cpu)
+	t.Logf("%s: %.2f rps, p50 %vms, p90 %vms, p99 %vms, gateway cpu %vm", s.name, result.Throughput,
+		result.LatencyP50Ms, result.LatencyP90Ms, result.LatencyP99Ms, result.GatewayCPUMillicores)
+	return result
+}
+
+func callOptions(s scenario, count int, timeout time.Duration) echo.CallOptions {
+	opts := echo.CallOptions{
+		Address: fmt.Sprintf("%s-istio.%s.svc.cluster.local", gatewayName, ns.Name()),
+		Port:    echo.Port{Protocol: protocol.HTTP, ServicePort: 80},
+		Scheme:  scheme.HTTP,
+		HTTP: echo.HTTP{
+			HTTP2:   s.http2,
+			Headers: headers.New().WithHost(gatewayHost).Build(),
+		},
+		Count:   count,
+		QPS:     s.qps,
+		Timeout: timeout,
+		Check:   check.OK(),
+	}
+	if count > 1 {
+		// A failed run is not retried, as its measures would be skewed
+		opts.Retry.NoRetry = true
+	} else {
+		opts.Retry.Options = []retry.Option{retry.Timeout(2 * time.Minute)}
+	}
+	return opts
+}
+
+// cpuUsage returns the CPU time consumed by the proxy of the gateway pod, from its cgroup.
+func cpuUsage(t framework.TestContext, pod corev1.Pod) time.Duration {
+	cls := t.Clusters().Default()
+	// cgroup v2 reports the usage in microseconds
+	if out, _, err := cls.PodExec(pod.Name, pod.Namespace, "istio-proxy", "cat /sys/fs/cgroup/cpu.stat"); err == nil {
+		for _, line := range strings.Split(out, "\n") {
+			if f := strings.Fields(line); len(f) == 2 && f[0] == "usage_usec" {
+				usec, err := strconv.ParseInt(f[1], 10, 64)
+				if err != nil {
+					t.Fatalf("invalid cgroup cpu usage %q: %v", line, err)
+				}
+				return time.Duration(usec) * time.Microsecond
+			}
+		}
+	}
+	// cgroup v1 reports the usage in nanoseconds
+	out, _, err := cls.PodExec(pod.Name, pod.Namespace, "istio-proxy", "cat /sys/fs/cgroup/cpuacct/cpuacct.usage")
+	if err != nil {
+		t.Fatalf("failed to read the cpu usage of %s/%s: %v", pod.Namespace, pod.Name, err)
+	}
+	nsec, err := strconv.ParseInt(strings.TrimSpace(out), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid cgroup cpu usage %q: %v", out, err)
+	}
+	return time.Duration(nsec)
+}
@@ -0,0 +1,81 @@
+//go:build integ && benchmark
+// +build integ,benchmark
+
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package benchmark measures the data path of a managed Gateway API gateway, to catch its performance regressions
+// across commits. An echo client drives a fixed rate of requests through the gateway to an echo backend, and the
+// throughput, latency and gateway CPU usage of each scenario are recorded in a machine-readable baseline.
+//
+// The suite only builds with the benchmark tag, as it is slow and needs a cluster dedicated to the run:
+//
+//	go test -tags=integ,benchmark ./tests/integration/servicemesh/benchmark/... -istio.test.kube.config=...
+//
+// The results are written to $ISTIO_TEST_BENCHMARK_OUTPUT, or to the work directory of the test. When
+// $ISTIO_TEST_BENCHMARK_BASELINE points to the results of a previous run, the scenarios regressing by more than
+// $ISTIO_TEST_BENCHMARK_TOLERANCE percent (20 by default) fail the test.
+package benchmark
+
+import (
+	"testing"
+
+	"istio.io/istio/pkg/test/framework"
+	"istio.io/istio/pkg/test/framework/components/crd"
+	"istio.io/istio/pkg/test/framework/components/echo"
+	"istio.io/istio/pkg/test/framework/components/echo/common/ports"
+	"istio.io/istio/pkg/test/framework/components/echo/deployment"
+	"istio.io/istio/pkg/test/framework/components/echo/match"
+	"istio.io/istio/pkg/test/framework/components/istio"
+	"istio.io/istio/pkg/test/framework/components/namespace"
+	"istio.io/istio/pkg/test/framework/resource"
+)
+
+var (
+	i       istio.Instance
+	ns      namespace.Instance
+	client  echo.Instances
+	backend echo.Instances
+)
+
+func TestMain(m *testing.M) {
+	framework.
+		NewSuite(m).
+		RequireMaxClusters(1).
+		Setup(istio.Setup(&i, nil)).
+		Setup(crd.DeployGatewayAPI).
+		Setup(namespace.Setup(&ns, namespace.Config{Prefix: "benchmark", Inject: true})).
+		Setup(func(t resource.Context) error {
+			apps, err := deployment.New(t).
+				WithClusters(t.Clusters().Default()).
+				WithConfig(echo.Config{
+					Service:   "client",
+					Namespace: ns,
+					Ports:     echo.Ports{ports.HTTP},
+				}).
+				WithConfig(echo.Config{
+					Service:   "backend",
+					Namespace: ns,
+					Ports:     echo.Ports{ports.HTTP},
+				}).
+				Build()
+			if err != nil {
+				return err
+			}
+			client = match.ServiceName(echo.NamespacedName{Name: "client", Namespace: ns}).GetMatches(apps)
+			backend = match.ServiceName(echo.NamespacedName{Name: "backend", Namespace: ns}).GetMatches(apps)
+			return nil
+		}).
+		Run()
+}
@@ -34,6 +34,7 @@ import (
 	"istio.io/istio/pkg/test/framework/components/echo/match"
 	"istio.io/istio/pkg/test/framework/components/istio"
 	"istio.io/istio/pkg/test/framework/components/namespace"
+	"istio.io/istio/pkg/test/framework/components/registrymirror"
 	"istio.io/istio/pkg/test/util/retry"
 	"istio.io/istio/tests/integration/servicemesh/federation"
 	"istio.io/istio/tests/integration/servicemesh/maistra"
@@ -67,6 +68,7 @@ func TestMain(m *testing.M) {
 	framework.
 		NewSuite(m).
 		RequireMinClusters(2).
+		Setup(registrymirror.Setup()).
 		Setup(maistra.ApplyServiceMeshCRDs).
 		Setup(istio.Setup(nil, federation.SetupConfig)).
 		Setup(namespace.Setup(&ns, namespace.Config{Prefix: "app", Inject: true})).
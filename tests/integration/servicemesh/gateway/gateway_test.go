@@ -29,7 +29,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -49,6 +48,7 @@ import (
 	"istio.io/istio/pkg/test/framework/components/echo/match"
 	"istio.io/istio/pkg/test/framework/components/istio"
 	"istio.io/istio/pkg/test/framework/components/namespace"
+	"istio.io/istio/pkg/test/framework/components/registrymirror"
 	testKube "istio.io/istio/pkg/test/kube"
 	"istio.io/istio/pkg/test/util/assert"
 	"istio.io/istio/pkg/test/util/retry"
@@ -88,9 +88,10 @@ func TestMain(m *testing.M) {
 	framework.
 		NewSuite(m).
 		RequireMaxClusters(1).
+		Setup(registrymirror.Setup()).
 		SetupParallel(maistra.ApplyServiceMeshCRDs, maistra.ApplyGatewayAPICRDs).
 		SetupParallel(
-			namespace.Setup(&istioNs, namespace.Config{Prefix: "istio-system"}),
+			maistra.SetupControlPlaneNamespace(&istioNs, namespace.Config{Prefix: "istio-system"}),
 			namespace.Setup(&appNs, namespace.Config{Prefix: "app"}),
 			namespace.Setup(&secondaryNs, namespace.Config{Prefix: "secondary", Labels: map[string]string{"test": "test"}})).
 		Setup(maistra.Install(namespace.Future(&istioNs), &maistra.InstallationOptions{EnableGatewayAPI: true, OutboundAllowAny: true})).
@@ -108,10 +109,10 @@ func TestGateway(t *testing.T) {
 				t.Errorf("failed to apply SMMR for namespace %s: %s", appNs.Name(), err)
 			}
 
-			if err := maistra.DeployEchos(&apps, &appsMux, "a", namespace.Future(&appNs), maistra.AppOpts{Revision: istioNs.Prefix()})(t); err != nil {
+			if err := maistra.DeployEchos(&apps, &appsMux, "a", namespace.Future(&appNs), maistra.AppOpts{Revision: maistra.Revision(istioNs)})(t); err != nil {
 				t.Errorf("failed to deploy app 'a': %s", err)
 			}
-			if err := maistra.DeployEchos(&apps, &appsMux, "b", namespace.Future(&appNs), maistra.AppOpts{Revision: istioNs.Prefix()})(t); err != nil {
+			if err := maistra.DeployEchos(&apps, &appsMux, "b", namespace.Future(&appNs), maistra.AppOpts{Revision: maistra.Revision(istioNs)})(t); err != nil {
 				t.Errorf("failed to deploy app 'b': %s", err)
 			}
 
@@ -128,6 +129,10 @@ func TestGateway(t *testing.T) {
 				ManagedGatewayShortNameTest(t, "istio")
 			})
 
+			if maistra.External() {
+				// The custom names are configured by patching istiod, which is not done on an existing control plane
+				return
+			}
 			patchFn := maistra.PatchIstiodAndRestart(namespace.Future(&istioNs), customGatewayClassAndControllerPatch)
 			if err := patchFn(t); err != nil {
 				t.Errorf("failed to patch istiod deployment: %s", err)
@@ -149,7 +154,7 @@ func TestGateway(t *testing.T) {
 }
 
 func ManagedOwnerGatewayTest(t framework.TestContext, gatewayClassName string) {
-	image := fmt.Sprintf("%s/app:%s", t.Settings().Image.Hub, strings.TrimSuffix(t.Settings().Image.Tag, "-distroless"))
+	image := t.Settings().Image.AppImage("app")
 	t.ConfigIstio().YAML(appNs.Name(), fmt.Sprintf(`
 apiVersion: v1
 kind: Service
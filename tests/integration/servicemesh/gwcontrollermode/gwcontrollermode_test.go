@@ -39,6 +39,7 @@ import (
 	"istio.io/istio/pkg/test/framework"
 	"istio.io/istio/pkg/test/framework/components/istio"
 	"istio.io/istio/pkg/test/framework/components/namespace"
+	"istio.io/istio/pkg/test/framework/components/registrymirror"
 	"istio.io/istio/pkg/test/framework/resource"
 	"istio.io/istio/pkg/test/util/retry"
 )
@@ -54,6 +55,7 @@ func TestMain(m *testing.M) {
 	// nolint: staticcheck
 	framework.
 		NewSuite(m).
+		Setup(registrymirror.Setup()).
 		Setup(namespace.Setup(&appNs, namespace.Config{Prefix: "app"})).
 		Setup(namespace.Setup(&ingressNs, namespace.Config{Prefix: "gateway-controller"})).
 		Setup(istio.Setup(&i, setupMeshInstance)).
@@ -0,0 +1,245 @@
+//go:build integ
+// +build integ
+
+//
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maistra
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	maistrav1 "maistra.io/api/client/versioned/typed/core/v1"
+	"sigs.k8s.io/yaml"
+
+	"istio.io/istio/pkg/test/framework"
+	"istio.io/istio/pkg/test/framework/components/cluster"
+	"istio.io/istio/pkg/test/framework/components/namespace"
+	"istio.io/istio/pkg/test/framework/resource"
+	"istio.io/istio/pkg/test/scopes"
+	"istio.io/istio/pkg/test/util/retry"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// The servicemesh suites install their own control planes, unless the namespace of an existing ServiceMeshControlPlane
+// is given. The suites then attach to that control plane, after checking it is compatible with what they expect, so
+// they can be run as conformance checks against environments installed by the operator.
+var (
+	controlPlaneNamespace string
+	controlPlaneName      = "basic"
+	controlPlaneVersion   = "v2.4"
+)
+
+var smcpGVR = schema.GroupVersionResource{Group: "maistra.io", Version: "v2", Resource: "servicemeshcontrolplanes"}
+
+func init() {
+	flag.StringVar(&controlPlaneNamespace, "istio.test.maistra.controlPlaneNamespace", controlPlaneNamespace,
+		"Namespace of an existing ServiceMeshControlPlane to run the tests against. The tests install their own control planes if not set.")
+	flag.StringVar(&controlPlaneName, "istio.test.maistra.controlPlaneName", controlPlaneName,
+		"Name of the existing ServiceMeshControlPlane to run the tests against.")
+	flag.StringVar(&controlPlaneVersion, "istio.test.maistra.controlPlaneVersion", controlPlaneVersion,
+		"Version the existing ServiceMeshControlPlane must have.")
+}
+
+// External returns whether the tests run against an existing control plane rather than installing their own.
+func External() bool {
+	return controlPlaneNamespace != ""
+}
+
+// SkipIfExternal skips the suites which need control planes of their own, e.g. to install several of them.
+func SkipIfExternal(resource.Context) bool {
+	return External()
+}
+
+// SetupControlPlaneNamespace creates the namespace of the control plane, or claims the namespace of the existing
+// control plane. A claimed namespace is not deleted once the tests complete.
+func SetupControlPlaneNamespace(ns *namespace.Instance, cfg namespace.Config) resource.SetupFn {
+	if !External() {
+		return namespace.Setup(ns, cfg)
+	}
+	return func(ctx resource.Context) (err error) {
+		*ns, err = namespace.Claim(ctx, namespace.Config{Prefix: controlPlaneNamespace})
+		return
+	}
+}
+
+// Revision returns the revision of the control plane in the namespace, which is the name of the
+// ServiceMeshControlPlane for an existing control plane.
+func Revision(istioNs namespace.Instance) string {
+	if External() && istioNs.Name() == controlPlaneNamespace {
+		return controlPlaneName
+	}
+	return istioNs.Prefix()
+}
+
+// validateExternalControlPlane checks that the existing control plane is ready and configured with the options the
+// suite would have installed its own control plane with.
+func validateExternalControlPlane(istioNs namespace.Getter, opts *InstallationOptions) resource.SetupFn {
+	return func(ctx resource.Context) error {
+		if istioNs.Get().Name() != controlPlaneNamespace {
+			return fmt.Errorf("the control plane namespace %s was not claimed with SetupControlPlaneNamespace", istioNs.Get().Name())
+		}
+		c := ctx.Clusters().Default()
+		if err := validateSMCP(c); err != nil {
+			return err
+		}
+		var lastSeenGeneration int64
+		if err := waitForIstiod(c.Kube(), istioNs.Get(), &lastSeenGeneration); err != nil {
+			return err
+		}
+
+		enableGatewayAPI := opts != nil && opts.EnableGatewayAPI
+		outboundTrafficPolicyMode := "REGISTRY_ONLY"
+		if opts != nil && opts.OutboundAllowAny {
+			outboundTrafficPolicyMode = "ALLOW_ANY"
+		}
+		gatewayAPI, err := istiodEnv(c, "PILOT_ENABLE_GATEWAY_API")
+		if err != nil {
+			return err
+		}
+		if gatewayAPI != fmt.Sprint(enableGatewayAPI) {
+			return fmt.Errorf("ServiceMeshControlPlane %s/%s has PILOT_ENABLE_GATEWAY_API=%q, the tests require %t",
+				controlPlaneNamespace, controlPlaneName, gatewayAPI, enableGatewayAPI)
+		}
+		mode, err := outboundTrafficPolicy(c)
+		if err != nil {
+			return err
+		}
+		if mode != outboundTrafficPolicyMode {
+			return fmt.Errorf("ServiceMeshControlPlane %s/%s has outbound traffic policy %s, the tests require %s",
+				controlPlaneNamespace, controlPlaneName, mode, outboundTrafficPolicyMode)
+		}
+		scopes.Framework.Infof("Running the tests against ServiceMeshControlPlane %s/%s", controlPlaneNamespace, controlPlaneName)
+		return nil
+	}
+}
+
+func validateSMCP(c cluster.Cluster) error {
+	smcp, err := c.Dynamic().Resource(smcpGVR).Namespace(controlPlaneNamespace).Get(context.TODO(), controlPlaneName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get ServiceMeshControlPlane %s/%s: %v", controlPlaneNamespace, controlPlaneName, err)
+	}
+	version, _, _ := unstructured.NestedString(smcp.Object, "spec", "version")
+	if version != controlPlaneVersion {
+		return fmt.Errorf("ServiceMeshControlPlane %s/%s has version %q, the tests require %s",
+			controlPlaneNamespace, controlPlaneName, version, controlPlaneVersion)
+	}
+	conditions, _, _ := unstructured.NestedSlice(smcp.Object, "status", "conditions")
+	for _, cond := range conditions {
+		cond, ok := cond.(map[string]any)
+		if ok && cond["type"] == "Ready" {
+			if cond["status"] != "True" {
+				return fmt.Errorf("ServiceMeshControlPlane %s/%s is not ready: %v", controlPlaneNamespace, controlPlaneName, cond["message"])
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("ServiceMeshControlPlane %s/%s is not ready: no Ready condition", controlPlaneNamespace, controlPlaneName)
+}
+
+func istiodEnv(c cluster.Cluster, name string) (string, error) {
+	istiod, err := c.Kube().AppsV1().Deployments(controlPlaneNamespace).Get(context.TODO(), "istiod-"+controlPlaneName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get istiod deployment: %v", err)
+	}
+	for _, container := range istiod.Spec.Template.Spec.Containers {
+		if container.Name != "discovery" {
+			continue
+		}
+		for _, env := range container.Env {
+			if env.Name == name {
+				return env.Value, nil
+			}
+		}
+	}
+	return "", nil
+}
+
+func outboundTrafficPolicy(c cluster.Cluster) (string, error) {
+	cm, err := c.Kube().CoreV1().ConfigMaps(controlPlaneNamespace).Get(context.TODO(), "istio-"+controlPlaneName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to get the mesh config of ServiceMeshControlPlane %s/%s: %v", controlPlaneNamespace, controlPlaneName, err)
+	}
+	mesh := struct {
+		OutboundTrafficPolicy struct {
+			Mode string `json:"mode"`
+		} `json:"outboundTrafficPolicy"`
+	}{}
+	if err := yaml.Unmarshal([]byte(cm.Data["mesh"]), &mesh); err != nil {
+		return "", fmt.Errorf("failed to parse the mesh config of ServiceMeshControlPlane %s/%s: %v", controlPlaneNamespace, controlPlaneName, err)
+	}
+	if mesh.OutboundTrafficPolicy.Mode == "" {
+		return "ALLOW_ANY", nil
+	}
+	return strings.ToUpper(mesh.OutboundTrafficPolicy.Mode), nil
+}
+
+// addExternalMembers adds the namespaces to the member roll of the existing control plane, and removes them once the
+// test completes. The roles of the members are managed by the operator.
+func addExternalMembers(ctx framework.TestContext, memberNamespaces ...string) error {
+	client, err := maistrav1.NewForConfig(ctx.Clusters().Default().RESTConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create client for maistra resources: %s", err)
+	}
+	smmrs := client.ServiceMeshMemberRolls(controlPlaneNamespace)
+	update := func(add bool) error {
+		return retry.UntilSuccess(func() error {
+			smmr, err := smmrs.Get(context.TODO(), "default", metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to get SMMR default: %s", err)
+			}
+			members := sets.New(smmr.Spec.Members...)
+			if add {
+				members.InsertAll(memberNamespaces...)
+			} else {
+				members.DeleteAll(memberNamespaces...)
+			}
+			smmr.Spec.Members = sets.SortedList(members)
+			if _, err := smmrs.Update(context.TODO(), smmr, metav1.UpdateOptions{}); err != nil {
+				return fmt.Errorf("failed to update SMMR default: %s", err)
+			}
+			return nil
+		}, retry.Timeout(10*time.Second), retry.Delay(time.Second))
+	}
+	if err := update(true); err != nil {
+		return err
+	}
+	ctx.Cleanup(func() {
+		if err := update(false); err != nil {
+			scopes.Framework.Errorf("failed to remove %v from SMMR default: %v", memberNamespaces, err)
+		}
+	})
+	// Unlike with the control planes installed by the tests, the operator reconciles the status of the member roll
+	return retry.UntilSuccess(func() error {
+		smmr, err := smmrs.Get(context.TODO(), "default", metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get SMMR default: %s", err)
+		}
+		configured := sets.New(smmr.Status.ConfiguredMembers...)
+		for _, ns := range memberNamespaces {
+			if !configured.Contains(ns) {
+				return fmt.Errorf("namespace %s is not a configured member of SMMR default yet", ns)
+			}
+		}
+		return nil
+	}, retry.Timeout(time.Minute), retry.Delay(time.Second))
+}
@@ -43,6 +43,7 @@ import (
 	"istio.io/istio/pkg/test/framework/resource"
 	"istio.io/istio/pkg/test/framework/resource/config"
 	"istio.io/istio/pkg/test/framework/resource/config/apply"
+	"istio.io/istio/pkg/test/scopes"
 	"istio.io/istio/pkg/test/util/retry"
 )
 
@@ -105,11 +106,16 @@ func Install(istioNs namespace.Getter, opts *InstallationOptions) resource.Setup
 			outboundTrafficPolicyMode = "ALLOW_ANY"
 		}
 	}
+	if External() {
+		// Attach to the existing control plane, see SetupControlPlaneNamespace
+		return istio.Setup(nil, func(ctx resource.Context, cfg *istio.Config) {
+			skipUnsupportedWorkloads(ctx)
+			cfg.DeployIstio = false
+			cfg.SystemNamespace = istioNs.Get().Name()
+		}, istio.SetupContextFn(validateExternalControlPlane(istioNs, opts)))
+	}
 	return istio.Setup(nil, func(ctx resource.Context, cfg *istio.Config) {
-		ctx.Settings().SkipWorkloadClasses = append(ctx.Settings().SkipWorkloadClasses, echo.Delta, echo.Headless, echo.TProxy, echo.VM, echo.External)
-		ctx.Settings().SkipDelta = true
-		ctx.Settings().SkipTProxy = true
-		ctx.Settings().SkipVM = true
+		skipUnsupportedWorkloads(ctx)
 
 		cfg.SystemNamespace = istioNs.Get().Name()
 		cfg.Values["global.istioNamespace"] = istioNs.Get().Name()
@@ -146,7 +152,18 @@ values:
 	})
 }
 
+func skipUnsupportedWorkloads(ctx resource.Context) {
+	ctx.Settings().SkipWorkloadClasses = append(ctx.Settings().SkipWorkloadClasses, echo.Delta, echo.Headless, echo.TProxy, echo.VM, echo.External)
+	ctx.Settings().SkipDelta = true
+	ctx.Settings().SkipTProxy = true
+	ctx.Settings().SkipVM = true
+}
+
 func RemoveDefaultRBAC(ctx resource.Context) error {
+	if External() {
+		scopes.Framework.Infof("Keeping the RBAC of the existing control plane")
+		return nil
+	}
 	kubeClient := ctx.Clusters().Default().Kube()
 	if err := kubeClient.RbacV1().ClusterRoleBindings().DeleteCollection(
 		context.TODO(), metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: "app=istio-reader"}); err != nil {
@@ -169,9 +186,13 @@ func RemoveDefaultRBAC(ctx resource.Context) error {
 
 func ApplyRestrictedRBAC(istioNs namespace.Getter) resource.SetupFn {
 	return func(ctx resource.Context) error {
+		if External() {
+			scopes.Framework.Infof("Keeping the RBAC of the existing control plane")
+			return nil
+		}
 		values := map[string]string{
 			"istioNamespace": istioNs.Get().Name(),
-			"revision":       istioNs.Get().Prefix(),
+			"revision":       Revision(istioNs.Get()),
 		}
 		if err := ctx.ConfigIstio().EvalFile(istioNs.Get().Name(), values, clusterRoles).Apply(); err != nil {
 			return err
@@ -185,6 +206,10 @@ func ApplyRestrictedRBAC(istioNs namespace.Getter) resource.SetupFn {
 
 func PatchIstiodAndRestart(istioNs namespace.Getter, patch string) resource.SetupFn {
 	return func(ctx resource.Context) error {
+		if External() {
+			scopes.Framework.Infof("Not patching istiod of the existing control plane: %s", patch)
+			return nil
+		}
 		kubeClient := ctx.Clusters().Default().Kube()
 		var lastSeenGeneration int64
 		if err := waitForIstiod(kubeClient, istioNs.Get(), &lastSeenGeneration); err != nil {
@@ -202,6 +227,10 @@ func PatchIstiodAndRestart(istioNs namespace.Getter, patch string) resource.Setu
 
 func DisableWebhooksAndRestart(istioNs namespace.Getter) resource.SetupFn {
 	return func(ctx resource.Context) error {
+		if External() {
+			scopes.Framework.Infof("Keeping the webhooks of the existing control plane")
+			return nil
+		}
 		kubeClient := ctx.Clusters().Default().Kube()
 		var lastSeenGeneration int64
 		if err := waitForIstiod(kubeClient, istioNs.Get(), &lastSeenGeneration); err != nil {
@@ -219,7 +248,7 @@ func DisableWebhooksAndRestart(istioNs namespace.Getter) resource.SetupFn {
 
 func waitForIstiod(kubeClient kubernetes.Interface, istioNs namespace.Instance, lastSeenGeneration *int64) error {
 	err := retry.UntilSuccess(func() error {
-		istiod, err := kubeClient.AppsV1().Deployments(istioNs.Name()).Get(context.TODO(), "istiod-"+istioNs.Prefix(), metav1.GetOptions{})
+		istiod, err := kubeClient.AppsV1().Deployments(istioNs.Name()).Get(context.TODO(), "istiod-"+Revision(istioNs), metav1.GetOptions{})
 		if err != nil {
 			return fmt.Errorf("failed to get istiod deployment: %v", err)
 		}
@@ -238,7 +267,7 @@ func waitForIstiod(kubeClient kubernetes.Interface, istioNs namespace.Instance,
 func patchIstiodArgs(kubeClient kubernetes.Interface, istioNs namespace.Instance, patch string) error {
 	return retry.UntilSuccess(func() error {
 		_, err := kubeClient.AppsV1().Deployments(istioNs.Name()).
-			Patch(context.TODO(), "istiod-"+istioNs.Prefix(), types.JSONPatchType, []byte(patch), metav1.PatchOptions{})
+			Patch(context.TODO(), "istiod-"+Revision(istioNs), types.JSONPatchType, []byte(patch), metav1.PatchOptions{})
 		if err != nil {
 			return fmt.Errorf("failed to patch istiod deployment: %v", err)
 		}
@@ -247,6 +276,9 @@ func patchIstiodArgs(kubeClient kubernetes.Interface, istioNs namespace.Instance
 }
 
 func ApplyServiceMeshMemberRoll(ctx framework.TestContext, istioNs namespace.Instance, memberNamespaces ...string) error {
+	if External() {
+		return addExternalMembers(ctx, memberNamespaces...)
+	}
 	smmrValues := map[string][]string{"members": memberNamespaces}
 	if err := retry.UntilSuccess(func() error {
 		if err := ctx.ConfigIstio().EvalFile(istioNs.Name(), smmrValues, smmrTmpl).Apply(apply.NoCleanup); err != nil {
@@ -259,7 +291,7 @@ func ApplyServiceMeshMemberRoll(ctx framework.TestContext, istioNs namespace.Ins
 
 	roleValues := map[string]string{
 		"istioNamespace": istioNs.Name(),
-		"revision":       istioNs.Prefix(),
+		"revision":       Revision(istioNs),
 	}
 	if err := applyRolesToMemberNamespaces(ctx.ConfigIstio(), roleValues, memberNamespaces...); err != nil {
 		return err
@@ -268,6 +300,17 @@ func ApplyServiceMeshMemberRoll(ctx framework.TestContext, istioNs namespace.Ins
 }
 
 func EnableIOR(ctx resource.Context, ns namespace.Instance) error {
+	if External() {
+		// IOR is configured by the ServiceMeshControlPlane, in spec.gateways.openshiftRoute
+		enabled, err := istiodEnv(ctx.Clusters().Default(), "ENABLE_IOR")
+		if err != nil {
+			return err
+		}
+		if enabled != "true" {
+			return fmt.Errorf("IOR is not enabled on ServiceMeshControlPlane %s/%s", controlPlaneNamespace, controlPlaneName)
+		}
+		return nil
+	}
 	kubeClient := ctx.Clusters().Default().Kube()
 	var lastSeenGeneration int64
 	if err := waitForIstiod(kubeClient, ns, &lastSeenGeneration); err != nil {
@@ -283,6 +326,10 @@ func EnableIOR(ctx resource.Context, ns namespace.Instance) error {
 }
 
 func DisableIOR(ctx resource.Context, ns namespace.Instance) error {
+	if External() {
+		scopes.Framework.Infof("Keeping IOR of the existing control plane enabled")
+		return nil
+	}
 	kubeClient := ctx.Clusters().Default().Kube()
 	var lastSeenGeneration int64
 	if err := waitForIstiod(kubeClient, ns, &lastSeenGeneration); err != nil {
@@ -0,0 +1,256 @@
+//go:build integ
+// +build integ
+
+//
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package maistra
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"istio.io/istio/pkg/test/framework"
+	"istio.io/istio/pkg/test/framework/components/namespace"
+)
+
+// Scenario is a combination of the install options supported by the fork.
+type Scenario struct {
+	Webhooks         bool
+	GatewayAPI       bool
+	OutboundAllowAny bool
+	RestrictedRBAC   bool
+}
+
+// Name returns the name of the subtest running the scenario.
+func (s Scenario) Name() string {
+	onOff := func(name string, on bool) string {
+		if on {
+			return name + "-on"
+		}
+		return name + "-off"
+	}
+	outbound := "registry-only"
+	if s.OutboundAllowAny {
+		outbound = "allow-any"
+	}
+	rbac := "default-rbac"
+	if s.RestrictedRBAC {
+		rbac = "restricted-rbac"
+	}
+	return strings.Join([]string{onOff("webhooks", s.Webhooks), onOff("gateway-api", s.GatewayAPI), outbound, rbac}, ".")
+}
+
+// Both covers both values of an option in a Matrix.
+var Both = []bool{false, true}
+
+// Matrix lists the values of each install option to cover. An option without values is only covered with the value
+// of the default installation: webhooks on, Gateway API off, REGISTRY_ONLY outbound traffic and default RBAC.
+type Matrix struct {
+	Webhooks         []bool
+	GatewayAPI       []bool
+	OutboundAllowAny []bool
+	RestrictedRBAC   []bool
+}
+
+// FullMatrix covers all the combinations of the install options.
+var FullMatrix = Matrix{
+	Webhooks:         Both,
+	GatewayAPI:       Both,
+	OutboundAllowAny: Both,
+	RestrictedRBAC:   Both,
+}
+
+// Scenarios returns the scenarios of the matrix. The scenarios with default RBAC come first, as restricting the RBAC
+// removes the default cluster roles of all control planes.
+func (m Matrix) Scenarios() []Scenario {
+	values := func(v []bool, def bool) []bool {
+		if len(v) == 0 {
+			return []bool{def}
+		}
+		return v
+	}
+	rbac := append([]bool{}, values(m.RestrictedRBAC, false)...)
+	sort.SliceStable(rbac, func(i, j int) bool {
+		return !rbac[i] && rbac[j]
+	})
+
+	var out []Scenario
+	for _, restricted := range rbac {
+		for _, webhooks := range values(m.Webhooks, true) {
+			for _, gatewayAPI := range values(m.GatewayAPI, false) {
+				for _, allowAny := range values(m.OutboundAllowAny, false) {
+					out = append(out, Scenario{
+						Webhooks:         webhooks,
+						GatewayAPI:       gatewayAPI,
+						OutboundAllowAny: allowAny,
+						RestrictedRBAC:   restricted,
+					})
+				}
+			}
+		}
+	}
+	return out
+}
+
+// ControlPlane is the control plane a scenario runs against.
+type ControlPlane struct {
+	Scenario
+	Namespace namespace.Instance
+}
+
+// controlPlaneKey holds the options that need a dedicated control plane. The RBAC mode is switched on existing
+// control planes, so they are shared by the scenarios only differing in it.
+type controlPlaneKey struct {
+	webhooks   bool
+	gatewayAPI bool
+	allowAny   bool
+}
+
+// RunMatrix runs the body once per scenario of the matrix, each in its own subtest. Control planes are installed as
+// scenarios need them and reused by the later scenarios with the same install options, so a full matrix installs
+// eight control planes rather than sixteen. The control planes are removed once the test completes.
+//
+// The Service Mesh CRDs must have been applied by the suite, see ApplyServiceMeshCRDs. The body is responsible for
+// adding its namespaces to the member roll of the control plane, see ApplyServiceMeshMemberRoll.
+//
+// The default RBAC removed by the restricted RBAC scenarios is restored once the test completes, so the later tests
+// of the suite run with it.
+//
+// The matrix is skipped when the tests run against an existing control plane, as it installs its own.
+func RunMatrix(t framework.TestContext, m Matrix, body func(t framework.TestContext, cp ControlPlane)) {
+	if External() {
+		t.Skip("the install matrix cannot run against an existing control plane")
+	}
+	controlPlanes := map[controlPlaneKey]namespace.Instance{}
+	gatewayAPICRDs := false
+	restricted := false
+	for _, s := range m.Scenarios() {
+		if s.RestrictedRBAC && !restricted {
+			restoreDefaultRBACOnCleanup(t)
+			if err := RemoveDefaultRBAC(t); err != nil {
+				t.Fatalf("failed to remove the default RBAC: %v", err)
+			}
+			for _, ns := range controlPlanes {
+				ns := ns
+				if err := ApplyRestrictedRBAC(namespace.Future(&ns))(t); err != nil {
+					t.Fatalf("failed to apply the restricted RBAC to %s: %v", ns.Name(), err)
+				}
+			}
+			restricted = true
+		}
+
+		key := controlPlaneKey{webhooks: s.Webhooks, gatewayAPI: s.GatewayAPI, allowAny: s.OutboundAllowAny}
+		ns, f := controlPlanes[key]
+		if !f {
+			if s.GatewayAPI && !gatewayAPICRDs {
+				if err := ApplyGatewayAPICRDs(t); err != nil {
+					t.Fatalf("failed to apply the Gateway API CRDs: %v", err)
+				}
+				gatewayAPICRDs = true
+			}
+			ns = installControlPlane(t, s, len(controlPlanes))
+			controlPlanes[key] = ns
+		}
+
+		cp := ControlPlane{Scenario: s, Namespace: ns}
+		t.NewSubTest(s.Name()).Run(func(t framework.TestContext) {
+			body(t, cp)
+		})
+	}
+}
+
+func installControlPlane(t framework.TestContext, s Scenario, index int) namespace.Instance {
+	ns := namespace.NewOrFail(t, t, namespace.Config{Prefix: fmt.Sprintf("istio-matrix-%d", index)})
+	istioNs := namespace.Future(&ns)
+	opts := &InstallationOptions{
+		EnableGatewayAPI: s.GatewayAPI,
+		OutboundAllowAny: s.OutboundAllowAny,
+	}
+	if err := Install(istioNs, opts)(t); err != nil {
+		t.Fatalf("failed to install the control plane for %s: %v", s.Name(), err)
+	}
+	if s.RestrictedRBAC {
+		// The installation adds the default cluster roles again
+		if err := RemoveDefaultRBAC(t); err != nil {
+			t.Fatalf("failed to remove the default RBAC: %v", err)
+		}
+		if err := ApplyRestrictedRBAC(istioNs)(t); err != nil {
+			t.Fatalf("failed to apply the restricted RBAC to %s: %v", ns.Name(), err)
+		}
+	}
+	if !s.Webhooks {
+		if err := DisableWebhooksAndRestart(istioNs)(t); err != nil {
+			t.Fatalf("failed to disable the webhooks of %s: %v", ns.Name(), err)
+		}
+	}
+	return ns
+}
+
+// restoreDefaultRBACOnCleanup saves the default cluster roles and bindings of the existing control planes, and creates
+// them again once the test completes. The ones of the control planes installed by the matrix are not restored, as
+// these control planes are removed with the test.
+func restoreDefaultRBACOnCleanup(t framework.TestContext) {
+	kubeClient := t.Clusters().Default().Kube()
+	var clusterRoles []rbacv1.ClusterRole
+	var clusterRoleBindings []rbacv1.ClusterRoleBinding
+	for _, app := range []string{"istio-reader", "istiod"} {
+		opts := metav1.ListOptions{LabelSelector: "app=" + app}
+		roles, err := kubeClient.RbacV1().ClusterRoles().List(context.TODO(), opts)
+		if err != nil {
+			t.Fatalf("failed to list the default cluster roles: %v", err)
+		}
+		clusterRoles = append(clusterRoles, roles.Items...)
+		bindings, err := kubeClient.RbacV1().ClusterRoleBindings().List(context.TODO(), opts)
+		if err != nil {
+			t.Fatalf("failed to list the default cluster role bindings: %v", err)
+		}
+		clusterRoleBindings = append(clusterRoleBindings, bindings.Items...)
+	}
+
+	t.Cleanup(func() {
+		for _, role := range clusterRoles {
+			role := role
+			role.ObjectMeta = restoredObjectMeta(role.ObjectMeta)
+			if _, err := kubeClient.RbacV1().ClusterRoles().Create(context.TODO(), &role, metav1.CreateOptions{}); err != nil &&
+				!errors.IsAlreadyExists(err) {
+				t.Logf("failed to restore the cluster role %s: %v", role.Name, err)
+			}
+		}
+		for _, binding := range clusterRoleBindings {
+			binding := binding
+			binding.ObjectMeta = restoredObjectMeta(binding.ObjectMeta)
+			if _, err := kubeClient.RbacV1().ClusterRoleBindings().Create(context.TODO(), &binding, metav1.CreateOptions{}); err != nil &&
+				!errors.IsAlreadyExists(err) {
+				t.Logf("failed to restore the cluster role binding %s: %v", binding.Name, err)
+			}
+		}
+	})
+}
+
+// restoredObjectMeta returns the metadata to create a deleted object again with.
+func restoredObjectMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
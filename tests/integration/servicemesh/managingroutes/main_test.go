@@ -32,6 +32,7 @@ import (
 	"istio.io/istio/pkg/test/env"
 	"istio.io/istio/pkg/test/framework"
 	"istio.io/istio/pkg/test/framework/components/namespace"
+	"istio.io/istio/pkg/test/framework/components/registrymirror"
 	"istio.io/istio/pkg/test/util/retry"
 	"istio.io/istio/tests/integration/servicemesh/maistra"
 	"istio.io/istio/tests/integration/servicemesh/router"
@@ -52,9 +53,10 @@ func TestMain(m *testing.M) {
 	framework.
 		NewSuite(m).
 		RequireMaxClusters(1).
+		Setup(registrymirror.Setup(router.Image)).
 		Setup(router.InstallOpenShiftRouter).
 		Setup(maistra.ApplyServiceMeshCRDs).
-		Setup(namespace.Setup(&istioNamespace, namespace.Config{Prefix: "istio-system"})).
+		Setup(maistra.SetupControlPlaneNamespace(&istioNamespace, namespace.Config{Prefix: "istio-system"})).
 		Setup(maistra.Install(namespace.Future(&istioNamespace), nil)).
 		// We cannot apply restricted RBAC before the control plane installation, because the operator always applies
 		// the default RBAC, so we have to remove it and apply after the installation.
@@ -27,16 +27,20 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-multierror"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"istio.io/api/annotation"
+	networkingv1beta1 "istio.io/client-go/pkg/apis/networking/v1beta1"
 	"istio.io/istio/pkg/config/protocol"
 	"istio.io/istio/pkg/test/env"
 	"istio.io/istio/pkg/test/framework"
+	kubecluster "istio.io/istio/pkg/test/framework/components/cluster/kube"
 	"istio.io/istio/pkg/test/framework/components/echo"
 	"istio.io/istio/pkg/test/framework/components/echo/check"
 	"istio.io/istio/pkg/test/framework/components/echo/match"
 	"istio.io/istio/pkg/test/framework/components/namespace"
+	"istio.io/istio/pkg/test/framework/components/registrymirror"
 	"istio.io/istio/pkg/test/framework/resource"
 	"istio.io/istio/pkg/test/util/retry"
 	"istio.io/istio/tests/integration/servicemesh/maistra"
@@ -53,6 +57,50 @@ var (
 	apps    echo.Instances
 	appsMux sync.Mutex
 
+	tenantRBAC = `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: tenant
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: tenant-gateways
+rules:
+- apiGroups: ["networking.istio.io"]
+  resources: ["gateways"]
+  verbs: ["get", "list", "watch", "create", "update", "patch", "delete"]
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: tenant-gateways
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: tenant-gateways
+subjects:
+- kind: ServiceAccount
+  name: tenant
+`
+	tenantGateway = `
+apiVersion: networking.istio.io/v1beta1
+kind: Gateway
+metadata:
+  name: tenant-gateway
+spec:
+  selector:
+    istio: ingressgateway
+  servers:
+  - port:
+      number: 80
+      name: http
+      protocol: HTTP
+    hosts:
+    - "*"
+`
+
 	svcEntryTmpl = filepath.Join(env.IstioSrc, "tests/integration/servicemesh/multitenancy/testdata/service-entry.tmpl.yaml")
 )
 
@@ -62,6 +110,8 @@ func TestMain(m *testing.M) {
 	framework.
 		NewSuite(m).
 		RequireMaxClusters(1).
+		SkipIf("the tests need two control planes of their own", maistra.SkipIfExternal).
+		Setup(registrymirror.Setup()).
 		Setup(maistra.ApplyServiceMeshCRDs).
 		SetupParallel(
 			namespace.Setup(&istioNs1, namespace.Config{Prefix: "istio-system-1"}),
@@ -107,6 +157,28 @@ func TestMultiTenancy(t *testing.T) {
 			}
 		})
 
+		ctx.NewSubTest("tenant users cannot create gateways in other namespaces").Run(func(t framework.TestContext) {
+			t.ConfigKube().YAML(appNs1.Name(), tenantRBAC).ApplyOrFail(t)
+			tenant, err := kubecluster.ImpersonateServiceAccount(t.Clusters().Default(), t.CreateTmpDirectoryOrFail("tenant"),
+				appNs1.Name(), "tenant")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// RBAC updates may take a moment to be enforced
+			retry.UntilSuccessOrFail(t, func() error {
+				return t.ConfigKube(tenant).YAML(appNs1.Name(), tenantGateway).Apply()
+			}, retry.Timeout(30*time.Second), retry.Delay(1*time.Second))
+
+			gw := &networkingv1beta1.Gateway{ObjectMeta: v1.ObjectMeta{Name: "tenant-gateway"}}
+			for _, ns := range []namespace.Instance{appNs2, appNs3} {
+				_, err := tenant.Istio().NetworkingV1beta1().Gateways(ns.Name()).Create(context.TODO(), gw, v1.CreateOptions{})
+				if !kerrors.IsForbidden(err) {
+					t.Errorf("expected the creation of a gateway in namespace %s to be forbidden, got: %v", ns.Name(), err)
+				}
+			}
+		})
+
 		ctx.NewSubTest("add apps to meshes").Run(func(t framework.TestContext) {
 			if err := maistra.ApplyServiceMeshMemberRoll(t, istioNs1, a.NamespaceName(), b.NamespaceName()); err != nil {
 				ctx.Errorf("failed to create SMMR for namespaces: %s, %s", a.NamespaceName(), b.NamespaceName())
@@ -20,7 +20,9 @@ package router
 
 import (
 	"context"
+	"os"
 	"path/filepath"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -30,6 +32,9 @@ import (
 	"istio.io/istio/pkg/test/framework/resource"
 )
 
+// Image is the image of the OpenShift router.
+const Image = "openshift/origin-haproxy-router:v4.0.0"
+
 func InstallOpenShiftRouter(ctx resource.Context) error {
 	c := ctx.Clusters().Default()
 	ns := &corev1.Namespace{
@@ -43,7 +48,12 @@ func InstallOpenShiftRouter(ctx resource.Context) error {
 	if err := c.ApplyYAMLFiles("", filepath.Join(env.IstioSrc, "tests/integration/servicemesh/router/testdata/route_crd.yaml")); err != nil {
 		return err
 	}
-	if err := c.ApplyYAMLFiles("", filepath.Join(env.IstioSrc, "tests/integration/servicemesh/router/testdata/router.yaml")); err != nil {
+	router, err := os.ReadFile(filepath.Join(env.IstioSrc, "tests/integration/servicemesh/router/testdata/router.yaml"))
+	if err != nil {
+		return err
+	}
+	// Pull the router from the registry mirror, if enabled
+	if err := c.ApplyYAMLContents("", strings.ReplaceAll(string(router), Image, ctx.Settings().RegistryMirror.Image(Image))); err != nil {
 		return err
 	}
 	if err := c.ApplyYAMLFiles("", filepath.Join(env.IstioSrc, "tests/integration/servicemesh/router/testdata/router_rbac.yaml")); err != nil {
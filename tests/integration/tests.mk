@@ -105,6 +105,10 @@ test.integration.%.kube: | $(JUNIT_REPORT) check-go-tag
 test.integration-fuzz.%.kube: | $(JUNIT_REPORT) check-go-tag
 	$(call run-test,./tests/integration/$(subst .,/,$*)/...,-tags="integfuzz integ")
 
+# Generate integration benchmark test targets for kubernetes environment, e.g. test.integration-benchmark.servicemesh.benchmark.kube
+test.integration-benchmark.%.kube: | $(JUNIT_REPORT) check-go-tag
+	$(call run-test,./tests/integration/$(subst .,/,$*)/...,-tags="benchmark integ")
+
 # Generate presubmit integration test targets for each component in kubernetes environment
 test.integration.%.kube.presubmit:
 	@make test.integration.$*.kube
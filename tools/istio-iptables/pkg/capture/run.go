@@ -280,6 +280,10 @@ func configureIPv6Addresses(cfg *config.Config) error {
 
 func (cfg *IptablesConfigurator) Run() error {
 	defer func() {
+		// The CNI plugin programs every pod of the node, so only dump the rules when debugging it
+		if cfg.cfg.CNIMode && !log.DebugEnabled() {
+			return
+		}
 		// Best effort since we don't know if the commands exist
 		_ = cfg.ext.Run(constants.IPTABLESSAVE, nil)
 		if cfg.cfg.EnableInboundIPv6 {
@@ -751,6 +755,10 @@ func (cfg *IptablesConfigurator) executeIptablesRestoreCommand(isIpv4 bool) erro
 		data = cfg.iptables.BuildV6Restore()
 		cmd = constants.IP6TABLESRESTORE
 	}
+	if data == "" {
+		// Nothing to program for this IP family, e.g. IPv6 in a single stack IPv4 pod
+		return nil
+	}
 
 	log.Infof("Running %s with the following input:\n%v", cmd, strings.TrimSpace(data))
 	// --noflush to prevent flushing/deleting previous contents from table
@@ -15,6 +15,7 @@
 package capture
 
 import (
+	"io"
 	"net/netip"
 	"path/filepath"
 	"reflect"
@@ -332,6 +333,81 @@ func TestSeparateV4V6(t *testing.T) {
 	}
 }
 
+// commandRecorder records the commands run by the configurator, without running them.
+type commandRecorder struct {
+	commands []string
+}
+
+func (r *commandRecorder) Run(cmd string, _ io.ReadSeeker, _ ...string) error {
+	r.commands = append(r.commands, cmd)
+	return nil
+}
+
+func (r *commandRecorder) RunQuietlyAndIgnore(cmd string, stdin io.ReadSeeker, args ...string) {
+	_ = r.Run(cmd, stdin, args...)
+}
+
+func TestRunCNICommands(t *testing.T) {
+	cases := []struct {
+		name     string
+		ipv6     bool
+		expected []string
+	}{
+		{
+			name:     "ipv4",
+			expected: []string{constants.IPTABLESRESTORE},
+		},
+		{
+			name:     "dual stack",
+			ipv6:     true,
+			expected: []string{constants.IPTABLESRESTORE, constants.IP6TABLESRESTORE},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := constructTestConfig()
+			cfg.CNIMode = true
+			cfg.EnableInboundIPv6 = tt.ipv6
+			ext := &commandRecorder{}
+			if err := NewIptablesConfigurator(cfg, ext).Run(); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(ext.commands, tt.expected) {
+				t.Fatalf("expected commands %v, got %v", tt.expected, ext.commands)
+			}
+		})
+	}
+}
+
+// BenchmarkRun measures the programming of the rules of a pod by the CNI plugin, both with a single iptables-restore
+// and with an iptables invocation per rule.
+func BenchmarkRun(b *testing.B) {
+	for _, restore := range []bool{true, false} {
+		name := "restore"
+		if !restore {
+			name = "commands"
+		}
+		b.Run(name, func(b *testing.B) {
+			cfg := constructTestConfig()
+			cfg.CNIMode = true
+			cfg.RestoreFormat = restore
+			cfg.InboundPortsInclude = "*"
+			cfg.OutboundIPRangesInclude = "*"
+			cfg.RedirectDNS = true
+			cfg.DNSServersV4 = []string{"10.96.0.10"}
+			ext := &commandRecorder{}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := NewIptablesConfigurator(cfg, ext).Run(); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.ReportMetric(float64(len(ext.commands))/float64(b.N), "execs/op")
+		})
+	}
+}
+
 func compareToGolden(t *testing.T, name string, actual []string) {
 	t.Helper()
 	gotBytes := []byte(strings.Join(actual, "\n"))
@@ -138,6 +138,9 @@ func bindCmdlineFlags(cfg *config.Config, cmd *cobra.Command) {
 	flag.BindEnv(fs, constants.CNIMode, "", "Whether to run as CNI plugin.", &cfg.CNIMode)
 
 	flag.BindEnv(fs, constants.IptablesVersion, "", "version of iptables command. If not set, this is automatically detected.", &cfg.IPTablesVersion)
+
+	flag.BindEnv(fs, constants.IptablesBackend, "",
+		"iptables backend (legacy or nft) to program the rules with. If not set, the default iptables commands are used.", &cfg.IPTablesBackend)
 }
 
 func GetCommand() *cobra.Command {
@@ -185,7 +188,11 @@ func ProgramIptables(cfg *config.Config) error {
 	if cfg.DryRun {
 		ext = &dep.StdoutStubDependencies{}
 	} else {
-		ipv, err := dep.DetectIptablesVersion(cfg.IPTablesVersion)
+		backend, err := dep.ParseIptablesBackend(cfg.IPTablesBackend)
+		if err != nil {
+			return err
+		}
+		ipv, err := dep.DetectIptablesVersionForBackend(cfg.IPTablesVersion, backend)
 		if err != nil {
 			return err
 		}
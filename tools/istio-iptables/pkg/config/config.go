@@ -83,6 +83,7 @@ type Config struct {
 	NetworkNamespace        string        `json:"NETWORK_NAMESPACE"`
 	CNIMode                 bool          `json:"CNI_MODE"`
 	IPTablesVersion         string        `json:"IPTABLES_VERSION"`
+	IPTablesBackend         string        `json:"IPTABLES_BACKEND"`
 	TraceLogging            bool          `json:"IPTABLES_TRACE_LOGGING"`
 	DualStack               bool          `json:"DUAL_STACK"`
 	HostIP                  netip.Addr    `json:"HOST_IP"`
@@ -99,6 +100,7 @@ func (c *Config) String() string {
 func (c *Config) Print() {
 	var b strings.Builder
 	b.WriteString(fmt.Sprintf("IPTABLES_VERSION=%s\n", c.IPTablesVersion))
+	b.WriteString(fmt.Sprintf("IPTABLES_BACKEND=%s\n", c.IPTablesBackend))
 	b.WriteString(fmt.Sprintf("PROXY_PORT=%s\n", c.ProxyPort))
 	b.WriteString(fmt.Sprintf("PROXY_INBOUND_CAPTURE_PORT=%s\n", c.InboundCapturePort))
 	b.WriteString(fmt.Sprintf("PROXY_TUNNEL_PORT=%s\n", c.InboundTunnelPort))
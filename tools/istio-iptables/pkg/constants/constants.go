@@ -108,6 +108,7 @@ const (
 	NetworkNamespace          = "network-namespace"
 	CNIMode                   = "cni-mode"
 	IptablesVersion           = "iptables-version"
+	IptablesBackend           = "iptables-backend"
 )
 
 // Environment variables that deliberately have no equivalent command-line flags.
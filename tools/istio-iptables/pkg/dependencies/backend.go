@@ -0,0 +1,183 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"istio.io/istio/tools/istio-iptables/pkg/constants"
+)
+
+// IptablesBackend is the kernel backend used by the iptables commands.
+type IptablesBackend string
+
+const (
+	// IptablesBackendLegacy is the legacy xtables backend.
+	IptablesBackendLegacy IptablesBackend = "legacy"
+	// IptablesBackendNFT is the nf_tables backend.
+	IptablesBackendNFT IptablesBackend = "nft"
+)
+
+// ParseIptablesBackend parses a backend name. An empty name is the backend of the default iptables commands.
+func ParseIptablesBackend(name string) (IptablesBackend, error) {
+	switch b := IptablesBackend(name); b {
+	case "", IptablesBackendLegacy, IptablesBackendNFT:
+		return b, nil
+	default:
+		return "", fmt.Errorf("unknown iptables backend %q, expected %q or %q", name, IptablesBackendLegacy, IptablesBackendNFT)
+	}
+}
+
+// command returns the name of the xtables command for the backend, e.g. iptables-nft-restore for iptables-restore.
+func (b IptablesBackend) command(cmd string) string {
+	if b == "" {
+		return cmd
+	}
+	name, action, found := strings.Cut(cmd, "-")
+	name += "-" + string(b)
+	if found {
+		name += "-" + action
+	}
+	return name
+}
+
+// Backend returns the backend used by the iptables commands.
+func (v IptablesVersion) Backend() IptablesBackend {
+	if v.legacy {
+		return IptablesBackendLegacy
+	}
+	return IptablesBackendNFT
+}
+
+// Command returns the name of the xtables command to run, which is specific to the backend if required.
+func (v IptablesVersion) Command(cmd string) string {
+	return v.backend.command(cmd)
+}
+
+// DetectIptablesVersionForBackend detects the version of the iptables commands using the given backend. The commands
+// dedicated to the backend (e.g. iptables-nft) are used when installed, otherwise the default commands must use the
+// backend. An empty backend selects the default commands.
+func DetectIptablesVersionForBackend(ver string, backend IptablesBackend) (IptablesVersion, error) {
+	if backend == "" {
+		return DetectIptablesVersion(ver)
+	}
+	backendCmd := backend.command(constants.IPTABLES)
+	if _, err := exec.LookPath(backendCmd); err == nil {
+		if ver == "" {
+			verb, err := exec.Command(backendCmd, "--version").CombinedOutput()
+			if err != nil {
+				return IptablesVersion{}, err
+			}
+			ver = string(verb)
+		}
+		v, err := DetectIptablesVersion(ver)
+		if err != nil {
+			return IptablesVersion{}, err
+		}
+		v.backend = backend
+		return v, nil
+	}
+	v, err := DetectIptablesVersion(ver)
+	if err != nil {
+		return IptablesVersion{}, err
+	}
+	if v.Backend() != backend {
+		return IptablesVersion{}, fmt.Errorf("the node uses the %s iptables backend, but %s is not installed and %s uses the %s backend",
+			backend, backendCmd, constants.IPTABLES, v.Backend())
+	}
+	return v, nil
+}
+
+// BackendDetection is the result of the detection of the iptables backends used on a node.
+type BackendDetection struct {
+	// Kubelet is the backend holding the kubelet or kube-proxy chains, if found.
+	Kubelet IptablesBackend
+	// InUse lists the backends holding rules.
+	InUse []IptablesBackend
+}
+
+// Backend returns the backend the rules must be programmed with: the backend of the kubelet and kube-proxy, or the only
+// backend holding rules, or nft if both hold rules. Nodes commonly have a few rules in the other backend, e.g. when
+// the host tools and the container images differ, so a mix of backends is only described by the returned warning. An
+// empty backend is returned if nothing was detected.
+func (d BackendDetection) Backend() (IptablesBackend, string) {
+	var other []IptablesBackend
+	for _, b := range d.InUse {
+		if b != d.Kubelet {
+			other = append(other, b)
+		}
+	}
+	switch {
+	case d.Kubelet != "" && len(other) > 0:
+		return d.Kubelet, fmt.Sprintf("the kubelet uses the %s iptables backend, but the node also has %s rules", d.Kubelet, other[0])
+	case d.Kubelet != "":
+		return d.Kubelet, ""
+	case len(other) > 1:
+		return IptablesBackendNFT, fmt.Sprintf("the node has both %s and %s iptables rules, and no kubelet chains", other[0], other[1])
+	case len(other) == 1:
+		return other[0], ""
+	default:
+		return "", ""
+	}
+}
+
+var kubeletChainsMatcher = regexp.MustCompile(`(?m)^:(KUBE-IPTABLES-HINT|KUBE-KUBELET-CANARY|KUBE-PROXY-CANARY)`)
+
+// DetectIptablesBackends inspects the rules of the current network namespace held by each iptables backend.
+// Backends whose commands are not installed are ignored.
+func DetectIptablesBackends() BackendDetection {
+	return detectIptablesBackends(func(cmd string) ([]byte, error) {
+		return exec.Command(cmd).Output()
+	})
+}
+
+func detectIptablesBackends(save func(cmd string) ([]byte, error)) BackendDetection {
+	// The logic to find the kubelet chains follows https://github.com/kubernetes-sigs/iptables-wrappers.
+	// The whole rules are saved, as passing "-t mangle" to iptables-legacy-save would create the table.
+	var d BackendDetection
+	for _, backend := range []IptablesBackend{IptablesBackendNFT, IptablesBackendLegacy} {
+		inUse := false
+		for _, cmd := range []string{constants.IPTABLESSAVE, constants.IP6TABLESSAVE} {
+			out, err := save(backend.command(cmd))
+			if err != nil {
+				continue
+			}
+			if d.Kubelet == "" && kubeletChainsMatcher.Match(out) {
+				d.Kubelet = backend
+			}
+			inUse = inUse || hasRules(out)
+		}
+		if inUse {
+			d.InUse = append(d.InUse, backend)
+		}
+	}
+	return d
+}
+
+// hasRules returns true if the output of iptables-save contains rules.
+func hasRules(save []byte) bool {
+	scanner := bufio.NewScanner(bytes.NewReader(save))
+	for scanner.Scan() {
+		if strings.HasPrefix(scanner.Text(), "-A ") {
+			return true
+		}
+	}
+	return false
+}
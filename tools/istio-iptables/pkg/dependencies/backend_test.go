@@ -0,0 +1,134 @@
+// Copyright Red Hat, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dependencies
+
+import (
+	"errors"
+	"testing"
+
+	"istio.io/istio/pkg/test/util/assert"
+)
+
+const (
+	kubeletSave = `*mangle
+:PREROUTING ACCEPT [0:0]
+:KUBE-IPTABLES-HINT - [0:0]
+:KUBE-KUBELET-CANARY - [0:0]
+COMMIT
+`
+	kubeProxySave = `*nat
+:KUBE-PROXY-CANARY - [0:0]
+COMMIT
+`
+	rulesSave = `*nat
+:PREROUTING ACCEPT [0:0]
+:CNI-HOSTPORT-DNAT - [0:0]
+-A PREROUTING -m addrtype --dst-type LOCAL -j CNI-HOSTPORT-DNAT
+COMMIT
+`
+	emptySave = `*filter
+:INPUT ACCEPT [0:0]
+COMMIT
+`
+)
+
+func TestIptablesBackendCommand(t *testing.T) {
+	assert.Equal(t, IptablesBackendNFT.command("iptables"), "iptables-nft")
+	assert.Equal(t, IptablesBackendNFT.command("ip6tables-restore"), "ip6tables-nft-restore")
+	assert.Equal(t, IptablesBackendLegacy.command("iptables-save"), "iptables-legacy-save")
+	assert.Equal(t, IptablesBackend("").command("iptables-restore"), "iptables-restore")
+}
+
+func TestDetectIptablesBackends(t *testing.T) {
+	cases := []struct {
+		name    string
+		saves   map[string]string
+		want    BackendDetection
+		backend IptablesBackend
+		warning bool
+	}{
+		{
+			name: "nothing installed",
+		},
+		{
+			name:  "empty rules",
+			saves: map[string]string{"iptables-nft-save": emptySave, "iptables-legacy-save": emptySave},
+		},
+		{
+			name:    "nft kubelet",
+			saves:   map[string]string{"iptables-nft-save": kubeletSave + rulesSave, "iptables-legacy-save": emptySave},
+			want:    BackendDetection{Kubelet: IptablesBackendNFT, InUse: []IptablesBackend{IptablesBackendNFT}},
+			backend: IptablesBackendNFT,
+		},
+		{
+			name:    "legacy kubelet on ipv6",
+			saves:   map[string]string{"ip6tables-legacy-save": kubeletSave},
+			want:    BackendDetection{Kubelet: IptablesBackendLegacy},
+			backend: IptablesBackendLegacy,
+		},
+		{
+			name:    "legacy rules without kubelet",
+			saves:   map[string]string{"iptables-nft-save": emptySave, "iptables-legacy-save": rulesSave},
+			want:    BackendDetection{InUse: []IptablesBackend{IptablesBackendLegacy}},
+			backend: IptablesBackendLegacy,
+		},
+		{
+			name:    "nft kubelet with legacy rules",
+			saves:   map[string]string{"iptables-nft-save": kubeletSave, "iptables-legacy-save": rulesSave},
+			want:    BackendDetection{Kubelet: IptablesBackendNFT, InUse: []IptablesBackend{IptablesBackendLegacy}},
+			backend: IptablesBackendNFT,
+			warning: true,
+		},
+		{
+			name:    "legacy kube-proxy with nft rules",
+			saves:   map[string]string{"iptables-nft-save": rulesSave, "iptables-legacy-save": kubeProxySave + rulesSave},
+			want:    BackendDetection{Kubelet: IptablesBackendLegacy, InUse: []IptablesBackend{IptablesBackendNFT, IptablesBackendLegacy}},
+			backend: IptablesBackendLegacy,
+			warning: true,
+		},
+		{
+			name:    "rules in both backends",
+			saves:   map[string]string{"iptables-nft-save": rulesSave, "ip6tables-legacy-save": rulesSave},
+			want:    BackendDetection{InUse: []IptablesBackend{IptablesBackendNFT, IptablesBackendLegacy}},
+			backend: IptablesBackendNFT,
+			warning: true,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			got := detectIptablesBackends(func(cmd string) ([]byte, error) {
+				save, f := tt.saves[cmd]
+				if !f {
+					return nil, errors.New("not found")
+				}
+				return []byte(save), nil
+			})
+			assert.Equal(t, got, tt.want)
+			backend, warning := got.Backend()
+			assert.Equal(t, backend, tt.backend)
+			assert.Equal(t, warning != "", tt.warning)
+		})
+	}
+}
+
+func TestParseIptablesBackend(t *testing.T) {
+	for _, name := range []string{"", "nft", "legacy"} {
+		b, err := ParseIptablesBackend(name)
+		assert.NoError(t, err)
+		assert.Equal(t, string(b), name)
+	}
+	_, err := ParseIptablesBackend("iptables-nft")
+	assert.Error(t, err)
+}
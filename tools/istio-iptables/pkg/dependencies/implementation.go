@@ -83,6 +83,8 @@ type IptablesVersion struct {
 	version *utilversion.Version
 	// true if legacy mode, false if nf_tables
 	legacy bool
+	// backend is set if the commands dedicated to the backend must be run rather than the default ones
+	backend IptablesBackend
 }
 
 func DetectIptablesVersion(ver string) (IptablesVersion, error) {
@@ -165,6 +165,7 @@ func (r *RealDependencies) executeXTables(cmd string, ignoreErrors bool, stdin i
 	mode := "without lock"
 	var c *exec.Cmd
 	_, isWriteCommand := XTablesWriteCmds[cmd]
+	cmd = r.IptablesVersion.Command(cmd)
 	needLock := isWriteCommand && !r.IptablesVersion.NoLocks()
 	run := func(c *exec.Cmd) error {
 		return c.Run()